@@ -7,16 +7,26 @@ import (
 	"github.com/iotaledger/hive.go/app"
 	"github.com/iotaledger/hive.go/app/components/profiling"
 	"github.com/iotaledger/hive.go/app/components/shutdown"
+	"github.com/iotaledger/iota-core/components/configreload"
 	"github.com/iotaledger/iota-core/components/dashboard"
 	dashboardmetrics "github.com/iotaledger/iota-core/components/dashboard_metrics"
 	"github.com/iotaledger/iota-core/components/debugapi"
+	"github.com/iotaledger/iota-core/components/eventforwarder"
+	"github.com/iotaledger/iota-core/components/faucet"
+	"github.com/iotaledger/iota-core/components/grpcadmin"
 	"github.com/iotaledger/iota-core/components/inx"
 	"github.com/iotaledger/iota-core/components/metrics"
 	"github.com/iotaledger/iota-core/components/metricstracker"
 	"github.com/iotaledger/iota-core/components/p2p"
 	"github.com/iotaledger/iota-core/components/protocol"
+	"github.com/iotaledger/iota-core/components/reattacher"
+	"github.com/iotaledger/iota-core/components/recorder"
 	"github.com/iotaledger/iota-core/components/restapi"
 	coreapi "github.com/iotaledger/iota-core/components/restapi/core"
+	indexerapi "github.com/iotaledger/iota-core/components/restapi/indexer"
+	"github.com/iotaledger/iota-core/components/runtimeprofiler"
+	"github.com/iotaledger/iota-core/components/snapshotter"
+	"github.com/iotaledger/iota-core/components/txbuilder"
 	"github.com/iotaledger/iota-core/pkg/toolset"
 )
 
@@ -40,17 +50,27 @@ Command line flags:
 		app.WithInitComponent(InitComponent),
 		app.WithComponents(
 			shutdown.Component,
+			configreload.Component,
 			p2p.Component,
 			profiling.Component,
 			restapi.Component,
 			coreapi.Component,
+			indexerapi.Component,
 			debugapi.Component,
+			txbuilder.Component,
+			faucet.Component,
+			reattacher.Component,
 			metricstracker.Component,
 			protocol.Component,
+			snapshotter.Component,
+			recorder.Component,
 			dashboardmetrics.Component,
 			dashboard.Component,
 			metrics.Component,
 			inx.Component,
+			grpcadmin.Component,
+			eventforwarder.Component,
+			runtimeprofiler.Component,
 		),
 	)
 }
@@ -0,0 +1,140 @@
+package configreload
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"go.uber.org/dig"
+
+	"github.com/iotaledger/hive.go/app"
+	"github.com/iotaledger/hive.go/app/configuration"
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/log"
+	"github.com/iotaledger/iota-core/pkg/configreload"
+	"github.com/iotaledger/iota-core/pkg/daemon"
+)
+
+func init() {
+	Component = &app.Component{
+		Name:      "ConfigReload",
+		DepsFunc:  func(cDeps dependencies) { deps = cDeps },
+		Provide:   provide,
+		Configure: configure,
+		Run:       run,
+	}
+}
+
+var (
+	Component *app.Component
+	deps      dependencies
+)
+
+type dependencies struct {
+	dig.In
+
+	AppConfig    *configuration.Configuration `name:"app"`
+	ConfigReload *configreload.Registry
+}
+
+func provide(c *dig.Container) error {
+	type registryDeps struct {
+		dig.In
+
+		AppConfigFilePath     *string `name:"appConfigFilePath"`
+		PeeringConfigFilePath *string `name:"peeringConfigFilePath"`
+	}
+
+	if err := c.Provide(func(deps registryDeps) *configreload.Registry {
+		return configreload.NewRegistry(func() (map[string]configreload.Source, error) {
+			sources := make(map[string]configreload.Source)
+
+			for configName, filePath := range map[string]*string{
+				"app":     deps.AppConfigFilePath,
+				"peering": deps.PeeringConfigFilePath,
+			} {
+				if filePath == nil || *filePath == "" {
+					continue
+				}
+
+				if _, err := os.Stat(*filePath); os.IsNotExist(err) {
+					continue
+				}
+
+				config := configuration.New()
+				if err := config.LoadFile(*filePath); err != nil {
+					return nil, ierrors.Wrapf(err, "failed to load %s configuration file %s", configName, *filePath)
+				}
+
+				sources[configName] = config
+			}
+
+			return sources, nil
+		})
+	}); err != nil {
+		Component.LogPanic(err.Error())
+	}
+
+	return nil
+}
+
+func configure() error {
+	configreload.Register(deps.ConfigReload, "app", "logger.level", deps.AppConfig.String("logger.level"), func(level string) error {
+		logLevel, err := log.LevelFromString(level)
+		if err != nil {
+			return err
+		}
+
+		Component.Logger.ParentLogger().SetLogLevel(logLevel)
+
+		return nil
+	}, func(level string) error {
+		_, err := log.LevelFromString(level)
+
+		return err
+	})
+
+	return nil
+}
+
+func run() error {
+	if err := Component.Daemon().BackgroundWorker(Component.Name, func(ctx context.Context) {
+		Component.LogInfo("Starting ConfigReload ... done")
+
+		signalChan := make(chan os.Signal, 1)
+		signal.Notify(signalChan, syscall.SIGHUP)
+		defer signal.Stop(signalChan)
+
+		for {
+			select {
+			case <-ctx.Done():
+				Component.LogInfo("Stopping ConfigReload ... done")
+
+				return
+			case <-signalChan:
+				Reload()
+			}
+		}
+	}, daemon.PriorityConfigReload); err != nil {
+		Component.LogPanicf("failed to start worker: %s", err)
+	}
+
+	return nil
+}
+
+// Reload reloads the configuration files and applies the changed values of the reloadable parameters.
+func Reload() ([]string, error) {
+	Component.LogInfo("Reloading configuration ...")
+
+	changed, err := deps.ConfigReload.Reload()
+	if err != nil {
+		Component.LogErrorf("Reloading configuration ... failed: %s", err)
+
+		return changed, err
+	}
+
+	Component.LogInfo("Reloading configuration ... done", "changed", changed)
+
+	return changed, nil
+}
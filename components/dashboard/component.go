@@ -133,7 +133,7 @@ func currentNodeStatus() *nodestatus {
 	var m runtime.MemStats
 	runtime.ReadMemStats(&m)
 	status := &nodestatus{}
-	status.ID = deps.Host.ID().String()
+	status.ID = deps.P2PManager.LocalPeerID().String()
 
 	// node status
 	status.Version = deps.AppInfo.Version
@@ -190,11 +190,16 @@ func neighborMetrics() []neighbormetric {
 		//	}
 		// }
 
+		latency, _ := neighbor.Latency()
+
 		stats = append(stats, neighbormetric{
 			ID:             neighbor.Peer.ID.String(),
 			Addresses:      fmt.Sprintf("%s", neighbor.Peer.PeerAddresses),
 			PacketsRead:    neighbor.PacketsRead(),
 			PacketsWritten: neighbor.PacketsWritten(),
+			RTT:            latency.RTT.Milliseconds(),
+			Jitter:         latency.Jitter.Milliseconds(),
+			Score:          neighbor.Score(),
 		})
 	}
 	return stats
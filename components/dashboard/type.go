@@ -88,10 +88,13 @@ type memmetrics struct {
 }
 
 type neighbormetric struct {
-	ID             string `json:"id"`
-	Addresses      string `json:"addresses"`
-	PacketsRead    uint64 `json:"packets_read"`
-	PacketsWritten uint64 `json:"packets_written"`
+	ID             string  `json:"id"`
+	Addresses      string  `json:"addresses"`
+	PacketsRead    uint64  `json:"packets_read"`
+	PacketsWritten uint64  `json:"packets_written"`
+	RTT            int64   `json:"rtt_ms"`
+	Jitter         int64   `json:"jitter_ms"`
+	Score          float64 `json:"score"`
 }
 
 type tipsInfo struct {
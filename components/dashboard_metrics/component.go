@@ -16,6 +16,7 @@ import (
 	"github.com/iotaledger/inx-app/pkg/httpserver"
 	"github.com/iotaledger/iota-core/components/restapi"
 	"github.com/iotaledger/iota-core/pkg/daemon"
+	"github.com/iotaledger/iota-core/pkg/metrics"
 	"github.com/iotaledger/iota-core/pkg/model"
 	"github.com/iotaledger/iota-core/pkg/protocol"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
@@ -34,6 +35,10 @@ const (
 	// RouteGossipMetrics is the route to get metrics about gossip.
 	// GET returns the gossip metrics.
 	RouteGossipMetrics = "/gossip"
+
+	// RouteAPIRouteMetrics is the route to get metrics about the requests of the REST API.
+	// GET returns the request counts, latencies and error rates per route.
+	RouteAPIRouteMetrics = "/api-routes"
 )
 
 func init() {
@@ -60,6 +65,7 @@ type dependencies struct {
 	Protocol         *protocol.Protocol
 	RestRouteManager *restapipkg.RestRouteManager
 	AppInfo          *app.Info
+	APIRouteMetrics  *metrics.APIRouteMetrics
 }
 
 func configure() error {
@@ -84,6 +90,10 @@ func configure() error {
 		return httpserver.JSONResponse(c, http.StatusOK, resp)
 	})
 
+	routeGroup.GET(RouteAPIRouteMetrics, func(c echo.Context) error {
+		return httpserver.JSONResponse(c, http.StatusOK, apiRouteMetrics())
+	})
+
 	return nil
 }
 
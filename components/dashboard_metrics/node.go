@@ -33,3 +33,27 @@ func databaseSizesMetrics() (*DatabaseSizesMetric, error) {
 		Time:      time.Now().Unix(),
 	}, nil
 }
+
+func apiRouteMetrics() *APIRouteMetrics {
+	routes := deps.APIRouteMetrics.Routes()
+
+	response := &APIRouteMetrics{
+		Routes: make([]*APIRouteMetric, 0, len(routes)),
+		Time:   time.Now().Unix(),
+	}
+
+	for _, route := range routes {
+		response.Routes = append(response.Routes, &APIRouteMetric{
+			Method:            route.Method,
+			Route:             route.Route,
+			Requests:          route.Requests,
+			ClientErrors:      route.ClientErrors,
+			ServerErrors:      route.ServerErrors,
+			ErrorRate:         route.ErrorRate(),
+			AverageDurationMs: float64(route.AverageDuration()) / float64(time.Millisecond),
+			MaxDurationMs:     float64(route.MaxDuration) / float64(time.Millisecond),
+		})
+	}
+
+	return response
+}
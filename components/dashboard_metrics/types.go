@@ -44,6 +44,24 @@ type DatabaseSizesMetric struct {
 	Time      int64 `json:"ts"`
 }
 
+// APIRouteMetrics represents the metrics of the requests of the REST API.
+type APIRouteMetrics struct {
+	Routes []*APIRouteMetric `json:"routes"`
+	Time   int64             `json:"ts"`
+}
+
+// APIRouteMetric represents the metrics of the requests of a single route of the REST API.
+type APIRouteMetric struct {
+	Method            string  `json:"method"`
+	Route             string  `json:"route"`
+	Requests          uint64  `json:"requests"`
+	ClientErrors      uint64  `json:"clientErrors"`
+	ServerErrors      uint64  `json:"serverErrors"`
+	ErrorRate         float64 `json:"errorRate"`
+	AverageDurationMs float64 `json:"avgDurationMs"`
+	MaxDurationMs     float64 `json:"maxDurationMs"`
+}
+
 // String returns the stringified component type.
 func (c ComponentType) String() string {
 	switch c {
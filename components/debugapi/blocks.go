@@ -3,10 +3,16 @@ package debugapi
 import (
 	"sort"
 
+	"github.com/labstack/echo/v4"
+
 	"github.com/iotaledger/hive.go/ads"
 	"github.com/iotaledger/hive.go/ierrors"
 	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine"
 	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/hexutil"
 )
 
 func getSlotBlockIDs(index iotago.SlotIndex) (*BlockChangesResponse, error) {
@@ -41,3 +47,110 @@ func getSlotBlockIDs(index iotago.SlotIndex) (*BlockChangesResponse, error) {
 		TangleRoot:     tangleTree.Root().String(),
 	}, nil
 }
+
+func getBlockConfirmationPath(blockID iotago.BlockID) (*BlockConfirmationPathResponse, error) {
+	blockMetadata, err := deps.Protocol.Engines.Main.Get().Retainer.BlockMetadata(blockID)
+	if err != nil {
+		return nil, ierrors.Wrapf(echo.ErrNotFound, "block not found: %s", blockID.ToHex())
+	}
+
+	return &BlockConfirmationPathResponse{
+		BlockID:          blockID.ToHex(),
+		BlockState:       blockMetadata.BlockState.String(),
+		TransactionState: blockMetadata.TransactionState.String(),
+		AcceptedSlot:     blockMetadata.AcceptedSlot,
+		ConfirmedSlot:    blockMetadata.ConfirmedSlot,
+		FinalizedSlot:    blockMetadata.FinalizedSlot,
+	}, nil
+}
+
+func getSlotAcceptedBlockIDs(slot iotago.SlotIndex) (*SlotBlockIDsResponse, error) {
+	return slotBlockIDsResponse(slot, (*engine.CommitmentAPI).AcceptedBlockIDs)
+}
+
+func getSlotConfirmedBlockIDs(slot iotago.SlotIndex) (*SlotBlockIDsResponse, error) {
+	return slotBlockIDsResponse(slot, (*engine.CommitmentAPI).ConfirmedBlockIDs)
+}
+
+func slotBlockIDsResponse(slot iotago.SlotIndex, blockIDsFunc func(*engine.CommitmentAPI) (iotago.BlockIDs, error)) (*SlotBlockIDsResponse, error) {
+	engineInstance := deps.Protocol.Engines.Main.Get()
+
+	if latestCommitment := engineInstance.SyncManager.LatestCommitment(); slot > latestCommitment.Slot() {
+		return nil, ierrors.Wrapf(echo.ErrBadRequest, "slot is not committed yet (%d > %d)", slot, latestCommitment.Slot())
+	}
+
+	commitment, err := engineInstance.Storage.Commitments().Load(slot)
+	if err != nil {
+		return nil, ierrors.Wrapf(echo.ErrNotFound, "failed to load commitment, slot: %d, error: %s", slot, err)
+	}
+
+	commitmentAPI := engine.NewCommitmentAPI(engineInstance, commitment.ID())
+
+	blockIDs, err := blockIDsFunc(commitmentAPI)
+	if err != nil {
+		return nil, ierrors.Wrapf(echo.ErrNotFound, "failed to load block IDs, slot: %d, error: %s", slot, err)
+	}
+
+	roots, err := commitmentAPI.Roots()
+	if err != nil {
+		return nil, ierrors.Wrapf(echo.ErrNotFound, "failed to load roots, slot: %d, error: %s", slot, err)
+	}
+
+	tangleProof, err := roots.TangleProof().JSONEncode()
+	if err != nil {
+		return nil, ierrors.Wrapf(echo.ErrInternalServerError, "failed to encode tangle proof, slot: %d, error: %s", slot, err)
+	}
+
+	blockIDStrings := lo.Map(blockIDs, iotago.BlockID.ToHex)
+	sort.Strings(blockIDStrings)
+
+	return &SlotBlockIDsResponse{
+		Slot:         slot,
+		CommitmentID: commitment.ID().ToHex(),
+		BlockIDs:     blockIDStrings,
+		Finalized:    commitmentAPI.IsFinalized(),
+		TangleRoot:   roots.TangleRoot.ToHex(),
+		TangleProof:  tangleProof,
+	}, nil
+}
+
+func getBlockInclusionProof(blockID iotago.BlockID) (*BlockInclusionProofResponse, error) {
+	engineInstance := deps.Protocol.Engines.Main.Get()
+
+	if latestCommitment := engineInstance.SyncManager.LatestCommitment(); blockID.Slot() > latestCommitment.Slot() {
+		return nil, ierrors.Wrapf(echo.ErrBadRequest, "slot of the block is not committed yet (%d > %d)", blockID.Slot(), latestCommitment.Slot())
+	}
+
+	commitment, err := engineInstance.Storage.Commitments().Load(blockID.Slot())
+	if err != nil {
+		return nil, ierrors.Wrapf(echo.ErrNotFound, "failed to load commitment, slot: %d, error: %s", blockID.Slot(), err)
+	}
+
+	proof, err := engine.NewCommitmentAPI(engineInstance, commitment.ID()).BlockInclusionProof(blockID)
+	if err != nil {
+		if ierrors.Is(err, model.ErrBlockNotIncluded) {
+			return nil, ierrors.Wrapf(echo.ErrNotFound, "block %s is not accepted in slot %d", blockID.ToHex(), blockID.Slot())
+		}
+
+		return nil, ierrors.Wrapf(echo.ErrInternalServerError, "failed to create inclusion proof, blockID: %s, error: %s", blockID.ToHex(), err)
+	}
+
+	tangleProof, err := proof.TangleRootProof.JSONEncode()
+	if err != nil {
+		return nil, ierrors.Wrapf(echo.ErrInternalServerError, "failed to encode tangle proof, blockID: %s, error: %s", blockID.ToHex(), err)
+	}
+
+	proofBytes, err := proof.Bytes()
+	if err != nil {
+		return nil, ierrors.Wrapf(echo.ErrInternalServerError, "failed to serialize inclusion proof, blockID: %s, error: %s", blockID.ToHex(), err)
+	}
+
+	return &BlockInclusionProofResponse{
+		BlockID:      blockID.ToHex(),
+		CommitmentID: commitment.ID().ToHex(),
+		TangleRoot:   proof.TangleRoot.ToHex(),
+		SideNodes:    lo.Map(proof.SideNodes, iotago.Identifier.ToHex),
+		TangleProof:  tangleProof,
+		Proof:        hexutil.EncodeHex(proofBytes),
+	}, nil
+}
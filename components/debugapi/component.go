@@ -9,18 +9,21 @@ import (
 
 	"github.com/iotaledger/hive.go/app"
 	"github.com/iotaledger/hive.go/ds/shrinkingmap"
+	"github.com/iotaledger/hive.go/ierrors"
 	hivedb "github.com/iotaledger/hive.go/kvstore/database"
 	"github.com/iotaledger/hive.go/lo"
 	"github.com/iotaledger/hive.go/runtime/event"
 	"github.com/iotaledger/hive.go/runtime/workerpool"
 	"github.com/iotaledger/inx-app/pkg/httpserver"
 	"github.com/iotaledger/iota-core/components/restapi"
+	"github.com/iotaledger/iota-core/pkg/metrics"
 	"github.com/iotaledger/iota-core/pkg/protocol"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/notarization"
 	restapipkg "github.com/iotaledger/iota-core/pkg/restapi"
 	"github.com/iotaledger/iota-core/pkg/storage/database"
 	"github.com/iotaledger/iota-core/pkg/storage/prunable"
+	"github.com/iotaledger/iota-core/pkg/tangleexport"
 	iotago "github.com/iotaledger/iota.go/v4"
 	"github.com/iotaledger/iota.go/v4/api"
 )
@@ -29,12 +32,57 @@ const (
 	RouteValidators    = "/validators"
 	RouteBlockMetadata = "/blocks/:" + api.ParameterBlockID + "/metadata"
 
+	RouteBlockConfirmationPath = "/blocks/:" + api.ParameterBlockID + "/confirmation-path"
+
+	RouteBlockInclusionProof = "/blocks/:" + api.ParameterBlockID + "/inclusion-proof"
+
 	RouteChainManagerAllChainsDot      = "/all-chains"
 	RouteChainManagerAllChainsRendered = "/all-chains/rendered"
 
 	RouteCommitmentBySlotBlockIDs = "/commitments/by-slot/:" + api.ParameterSlot + "/blocks"
 
+	RouteCommitmentBySlotAcceptedBlockIDs = "/commitments/by-slot/:" + api.ParameterSlot + "/blocks/accepted"
+
+	RouteCommitmentBySlotConfirmedBlockIDs = "/commitments/by-slot/:" + api.ParameterSlot + "/blocks/confirmed"
+
 	RouteCommitmentBySlotTransactionIDs = "/commitments/by-slot/:" + api.ParameterSlot + "/transactions"
+
+	RouteCommitmentBySlotTransactionLatencies = "/commitments/by-slot/:" + api.ParameterSlot + "/transactions/latencies"
+
+	RouteCommitmentsCumulativeWeightAudit = "/commitments/cumulative-weight-audit"
+
+	RouteTransactionConflictGroup = "/transactions/:" + api.ParameterTransactionID + "/conflict-group"
+
+	RouteTransactionsPending = "/transactions/pending"
+
+	RouteTransactionsQuarantined = "/transactions/quarantined"
+
+	RouteTransactionQuarantine = "/transactions/:" + api.ParameterTransactionID + "/quarantine"
+
+	RouteTangleExport = "/tangle/export"
+
+	RouteProfileGoroutine = "/profiles/goroutine"
+	RouteProfileCPU       = "/profiles/cpu"
+)
+
+const (
+	// QueryParameterStartSlot is used to specify the first slot of a slot range.
+	QueryParameterStartSlot = "startSlot"
+
+	// QueryParameterEndSlot is used to specify the last slot of a slot range.
+	QueryParameterEndSlot = "endSlot"
+
+	// QueryParameterFormat is used to specify the format of an export.
+	QueryParameterFormat = "format"
+
+	// QueryParameterPool is used to filter a profile by the name of a worker pool.
+	QueryParameterPool = "pool"
+
+	// QueryParameterEngine is used to filter a profile by the alias of an engine ("main" refers to the main engine).
+	QueryParameterEngine = "engine"
+
+	// QueryParameterSeconds is used to specify the duration of a CPU profile.
+	QueryParameterSeconds = "seconds"
 )
 
 const (
@@ -65,9 +113,10 @@ var (
 type dependencies struct {
 	dig.In
 
-	Protocol         *protocol.Protocol
-	AppInfo          *app.Info
-	RestRouteManager *restapipkg.RestRouteManager
+	Protocol             *protocol.Protocol
+	AppInfo              *app.Info
+	RestRouteManager     *restapipkg.RestRouteManager
+	TransactionLatencies *metrics.TransactionLatencies
 }
 
 func configure() error {
@@ -175,6 +224,34 @@ func configure() error {
 		return c.Blob(http.StatusOK, echo.MIMEApplicationJSONCharsetUTF8, blockJSON)
 	})
 
+	routeGroup.GET(RouteBlockConfirmationPath, func(c echo.Context) error {
+		blockID, err := httpserver.ParseBlockIDParam(c, api.ParameterBlockID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := getBlockConfirmationPath(blockID)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	})
+
+	routeGroup.GET(RouteBlockInclusionProof, func(c echo.Context) error {
+		blockID, err := httpserver.ParseBlockIDParam(c, api.ParameterBlockID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := getBlockInclusionProof(blockID)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	})
+
 	routeGroup.GET(RouteValidators, func(c echo.Context) error {
 		resp, err := validatorsSummary()
 		if err != nil {
@@ -221,6 +298,34 @@ func configure() error {
 		return httpserver.JSONResponse(c, http.StatusOK, resp)
 	})
 
+	routeGroup.GET(RouteCommitmentBySlotAcceptedBlockIDs, func(c echo.Context) error {
+		slot, err := httpserver.ParseSlotParam(c, api.ParameterSlot)
+		if err != nil {
+			return err
+		}
+
+		resp, err := getSlotAcceptedBlockIDs(slot)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	})
+
+	routeGroup.GET(RouteCommitmentBySlotConfirmedBlockIDs, func(c echo.Context) error {
+		slot, err := httpserver.ParseSlotParam(c, api.ParameterSlot)
+		if err != nil {
+			return err
+		}
+
+		resp, err := getSlotConfirmedBlockIDs(slot)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	})
+
 	routeGroup.GET(RouteCommitmentBySlotTransactionIDs, func(c echo.Context) error {
 		slot, err := httpserver.ParseSlotParam(c, api.ParameterSlot)
 		if err != nil {
@@ -235,5 +340,110 @@ func configure() error {
 		return httpserver.JSONResponse(c, http.StatusOK, resp)
 	})
 
+	routeGroup.GET(RouteCommitmentBySlotTransactionLatencies, func(c echo.Context) error {
+		slot, err := httpserver.ParseSlotParam(c, api.ParameterSlot)
+		if err != nil {
+			return err
+		}
+
+		resp, err := getSlotTransactionLatencies(slot)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	})
+
+	routeGroup.GET(RouteCommitmentsCumulativeWeightAudit, func(c echo.Context) error {
+		startSlot, err := httpserver.ParseSlotQueryParam(c, QueryParameterStartSlot)
+		if err != nil {
+			return err
+		}
+
+		endSlot, err := httpserver.ParseSlotQueryParam(c, QueryParameterEndSlot)
+		if err != nil {
+			return err
+		}
+
+		resp, err := auditCumulativeWeights(deps.Protocol.Engines.Main.Get(), startSlot, endSlot, ParamsDebugAPI.CumulativeWeightAuditMaxSlots)
+		if err != nil {
+			return err
+		}
+
+		for _, slot := range resp.Slots {
+			if slot.Diverged {
+				Component.LogWarnf("cumulative weight audit: commitment %s diverged (stored weight: %d, recomputed weight: %d, previous commitment matches: %t, attestations root matches: %t)", slot.CommitmentID, slot.StoredCumulativeWeight, slot.RecomputedCumulativeWeight, slot.PreviousCommitmentMatches, slot.AttestationsRootMatches)
+			}
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	})
+
+	routeGroup.GET(RouteTransactionConflictGroup, func(c echo.Context) error {
+		transactionID, err := httpserver.ParseTransactionIDParam(c, api.ParameterTransactionID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := getTransactionConflictGroup(transactionID)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	})
+
+	routeGroup.GET(RouteTransactionsPending, func(c echo.Context) error {
+		return httpserver.JSONResponse(c, http.StatusOK, getPendingTransactions())
+	})
+
+	routeGroup.GET(RouteTransactionsQuarantined, func(c echo.Context) error {
+		resp, err := getQuarantinedTransactions()
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	})
+
+	routeGroup.GET(RouteTransactionQuarantine, func(c echo.Context) error {
+		transactionID, err := httpserver.ParseTransactionIDParam(c, api.ParameterTransactionID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := getQuarantinedTransaction(transactionID)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	})
+
+	routeGroup.GET(RouteTangleExport, func(c echo.Context) error {
+		startSlot, err := httpserver.ParseSlotQueryParam(c, QueryParameterStartSlot)
+		if err != nil {
+			return err
+		}
+
+		endSlot, err := httpserver.ParseSlotQueryParam(c, QueryParameterEndSlot)
+		if err != nil {
+			return err
+		}
+
+		format := tangleexport.FormatJSONL
+		if formatParam := c.QueryParam(QueryParameterFormat); formatParam != "" {
+			if format, err = tangleexport.ParseFormat(formatParam); err != nil {
+				return ierrors.Wrapf(httpserver.ErrInvalidParameter, "invalid format: %s", err)
+			}
+		}
+
+		return exportTangle(c, startSlot, endSlot, format)
+	})
+
+	routeGroup.GET(RouteProfileGoroutine, goroutineProfile)
+
+	routeGroup.GET(RouteProfileCPU, cpuProfile)
+
 	return nil
 }
@@ -0,0 +1,128 @@
+package debugapi
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// auditCumulativeWeights recomputes the cumulative weights of the stored commitments of the given slot range from the
+// committed attestations and reports the divergences from the stored values.
+//
+// The cumulative weight of the commitment before the start slot is used as the baseline of the recalculation, so a
+// divergence in a single slot is visible in all following slots of the range.
+func auditCumulativeWeights(engineInstance *engine.Engine, startSlot iotago.SlotIndex, endSlot iotago.SlotIndex, maxSlots uint32) (*CumulativeWeightAuditResponse, error) {
+	if endSlot < startSlot {
+		return nil, ierrors.Wrapf(echo.ErrBadRequest, "end slot %d is before start slot %d", endSlot, startSlot)
+	}
+
+	if slotCount := uint32(endSlot-startSlot) + 1; slotCount > maxSlots {
+		return nil, ierrors.Wrapf(echo.ErrBadRequest, "slot range contains %d slots, but at most %d slots can be audited", slotCount, maxSlots)
+	}
+
+	if genesisSlot := engineInstance.CommittedAPI().ProtocolParameters().GenesisSlot(); startSlot <= genesisSlot {
+		return nil, ierrors.Wrapf(echo.ErrBadRequest, "start slot %d must be after the genesis slot %d", startSlot, genesisSlot)
+	}
+
+	if latestCommitment := engineInstance.SyncManager.LatestCommitment(); endSlot > latestCommitment.Slot() {
+		return nil, ierrors.Wrapf(echo.ErrBadRequest, "end slot is in the future (%d > %d)", endSlot, latestCommitment.Slot())
+	}
+
+	previousCommitment, err := engineInstance.Storage.Commitments().Load(startSlot - 1)
+	if err != nil {
+		return nil, ierrors.Wrapf(echo.ErrNotFound, "failed to load baseline commitment, slot: %d, error: %s", startSlot-1, err)
+	}
+
+	response := &CumulativeWeightAuditResponse{
+		StartSlot:                startSlot,
+		EndSlot:                  endSlot,
+		BaselineCumulativeWeight: previousCommitment.CumulativeWeight(),
+		Slots:                    make([]*CumulativeWeightAuditSlotResponse, 0, endSlot-startSlot+1),
+	}
+
+	recomputedCumulativeWeight := previousCommitment.CumulativeWeight()
+	for slot := startSlot; slot <= endSlot; slot++ {
+		commitment, err := engineInstance.Storage.Commitments().Load(slot)
+		if err != nil {
+			return nil, ierrors.Wrapf(echo.ErrNotFound, "failed to load commitment, slot: %d, error: %s", slot, err)
+		}
+
+		attestationCount, attestationsRoot, hasAttestations, err := committedAttestations(engineInstance, slot)
+		if err != nil {
+			return nil, err
+		}
+
+		// commitments of slots that are too close to the genesis to contain attestations always have a cumulative
+		// weight of 0.
+		if hasAttestations {
+			recomputedCumulativeWeight += attestationCount
+		} else {
+			recomputedCumulativeWeight = 0
+		}
+
+		slotResponse := &CumulativeWeightAuditSlotResponse{
+			Slot:                       slot,
+			CommitmentID:               commitment.ID().ToHex(),
+			Attestations:               attestationCount,
+			StoredCumulativeWeight:     commitment.CumulativeWeight(),
+			RecomputedCumulativeWeight: recomputedCumulativeWeight,
+			PreviousCommitmentMatches:  commitment.PreviousCommitmentID() == previousCommitment.ID(),
+		}
+
+		// the roots are stored by commitment ID, so there are no roots for a commitment that diverged from the one
+		// that was originally committed.
+		if roots, err := engine.NewCommitmentAPI(engineInstance, commitment.ID()).Roots(); err == nil {
+			slotResponse.AttestationsRootMatches = attestationsRoot == roots.AttestationsRoot
+		}
+
+		slotResponse.Diverged = slotResponse.StoredCumulativeWeight != slotResponse.RecomputedCumulativeWeight || !slotResponse.PreviousCommitmentMatches || !slotResponse.AttestationsRootMatches
+
+		if slotResponse.Diverged {
+			response.Divergences++
+		}
+
+		response.Slots = append(response.Slots, slotResponse)
+		previousCommitment = commitment
+	}
+
+	return response, nil
+}
+
+// committedAttestations returns the number of committed attestations of the given slot that were issued by a member of
+// the committee and the root of the attestations. It returns hasAttestations=false if the slot is too close to the
+// genesis to contain attestations.
+func committedAttestations(engineInstance *engine.Engine, slot iotago.SlotIndex) (count uint64, root iotago.Identifier, hasAttestations bool, err error) {
+	if protocolParams := engineInstance.APIForSlot(slot).ProtocolParameters(); slot < protocolParams.GenesisSlot()+protocolParams.MaxCommittableAge() {
+		return 0, iotago.Identifier{}, false, nil
+	}
+
+	attestations, err := engineInstance.Attestations.GetMap(slot)
+	if err != nil {
+		return 0, iotago.Identifier{}, false, ierrors.Wrapf(echo.ErrNotFound, "failed to load attestations, slot: %d, error: %s", slot, err)
+	}
+
+	if err = attestations.Stream(func(issuerID iotago.AccountID, attestation *iotago.Attestation) error {
+		blockID, err := attestation.BlockID()
+		if err != nil {
+			return ierrors.Wrapf(err, "failed to get block ID of attestation of %s", issuerID)
+		}
+
+		// attestations only carry weight if they were issued by a member of the committee of the slot of the block.
+		committee, exists := engineInstance.SybilProtection.SeatManager().CommitteeInSlot(blockID.Slot())
+		if !exists {
+			return ierrors.Errorf("failed to get committee of slot %d", blockID.Slot())
+		}
+
+		if _, isMember := committee.GetSeat(issuerID); isMember {
+			count++
+		}
+
+		return nil
+	}); err != nil {
+		return 0, iotago.Identifier{}, false, ierrors.Wrapf(echo.ErrInternalServerError, "failed to recompute weight of attestations, slot: %d, error: %s", slot, err)
+	}
+
+	return count, attestations.Root(), true, nil
+}
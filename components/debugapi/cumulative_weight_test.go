@@ -0,0 +1,100 @@
+package debugapi
+
+import (
+	"testing"
+
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/testsuite"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func TestAuditCumulativeWeights(t *testing.T) {
+	ts := testsuite.NewTestSuite(t,
+		testsuite.WithProtocolParametersOptions(
+			iotago.WithTimeProviderOptions(
+				0,
+				testsuite.GenesisTimeWithOffsetBySlots(100, testsuite.DefaultSlotDurationInSeconds),
+				testsuite.DefaultSlotDurationInSeconds,
+				3,
+			),
+			iotago.WithLivenessOptions(
+				10,
+				10,
+				2,
+				4,
+				5,
+			),
+		),
+	)
+	defer ts.Shutdown()
+
+	node0 := ts.AddValidatorNode("node0")
+	ts.AddValidatorNode("node1")
+
+	ts.Run(true, nil)
+
+	ts.IssueBlocksAtSlots("", []iotago.SlotIndex{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, 3, "Genesis", ts.Nodes(), true, false)
+
+	ts.AssertLatestCommitmentSlotIndex(8, ts.Nodes()...)
+
+	engineInstance := node0.Protocol.Engines.Main.Get()
+
+	// the recomputed cumulative weights match the stored ones.
+	audit, err := auditCumulativeWeights(engineInstance, 1, 8, 100)
+	require.NoError(t, err)
+	require.Zero(t, audit.Divergences)
+	require.Len(t, audit.Slots, 8)
+	require.Zero(t, audit.BaselineCumulativeWeight)
+
+	for _, slot := range audit.Slots {
+		commitment := lo.PanicOnErr(engineInstance.Storage.Commitments().Load(slot.Slot))
+
+		require.False(t, slot.Diverged, "slot %d diverged", slot.Slot)
+		require.Equal(t, commitment.ID().ToHex(), slot.CommitmentID)
+		require.Equal(t, commitment.CumulativeWeight(), slot.StoredCumulativeWeight)
+		require.Equal(t, commitment.CumulativeWeight(), slot.RecomputedCumulativeWeight)
+	}
+	require.NotZero(t, audit.Slots[len(audit.Slots)-1].StoredCumulativeWeight)
+
+	// a partial range is recomputed from the stored cumulative weight of the commitment before the range.
+	audit, err = auditCumulativeWeights(engineInstance, 7, 8, 100)
+	require.NoError(t, err)
+	require.Zero(t, audit.Divergences)
+	require.Equal(t, lo.PanicOnErr(engineInstance.Storage.Commitments().Load(6)).CumulativeWeight(), audit.BaselineCumulativeWeight)
+
+	// invalid ranges are rejected.
+	for _, slotRange := range [][2]iotago.SlotIndex{{5, 4}, {0, 8}, {1, 9}} {
+		_, err = auditCumulativeWeights(engineInstance, slotRange[0], slotRange[1], 100)
+		require.ErrorIs(t, err, echo.ErrBadRequest, "slot range %v", slotRange)
+	}
+
+	_, err = auditCumulativeWeights(engineInstance, 1, 8, 7)
+	require.ErrorIs(t, err, echo.ErrBadRequest)
+
+	// a tampered commitment diverges and so does the commitment that references its original version.
+	tamperedCommitment := *lo.PanicOnErr(engineInstance.Storage.Commitments().Load(6)).Commitment()
+	tamperedCommitment.CumulativeWeight++
+	require.NoError(t, engineInstance.Storage.Commitments().Store(lo.PanicOnErr(model.CommitmentFromCommitment(&tamperedCommitment, engineInstance.CommittedAPI()))))
+
+	audit, err = auditCumulativeWeights(engineInstance, 5, 8, 100)
+	require.NoError(t, err)
+	require.Equal(t, 2, audit.Divergences)
+
+	require.False(t, audit.Slots[0].Diverged)
+
+	require.True(t, audit.Slots[1].Diverged)
+	require.Equal(t, audit.Slots[1].RecomputedCumulativeWeight+1, audit.Slots[1].StoredCumulativeWeight)
+	require.True(t, audit.Slots[1].PreviousCommitmentMatches)
+	require.False(t, audit.Slots[1].AttestationsRootMatches)
+
+	require.True(t, audit.Slots[2].Diverged)
+	require.Equal(t, audit.Slots[2].RecomputedCumulativeWeight, audit.Slots[2].StoredCumulativeWeight)
+	require.False(t, audit.Slots[2].PreviousCommitmentMatches)
+	require.True(t, audit.Slots[2].AttestationsRootMatches)
+
+	require.False(t, audit.Slots[3].Diverged)
+}
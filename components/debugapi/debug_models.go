@@ -1,6 +1,7 @@
 package debugapi
 
 import (
+	"encoding/json"
 	"fmt"
 
 	"github.com/iotaledger/hive.go/lo"
@@ -10,6 +11,22 @@ import (
 )
 
 type (
+	// BlockConfirmationPathResponse contains the slots at which a block reached the different levels of finality.
+	BlockConfirmationPathResponse struct {
+		// BlockID is the hex encoded block ID of the block.
+		BlockID string `json:"blockId"`
+		// BlockState is the current state of the block.
+		BlockState string `json:"blockState"`
+		// TransactionState is the current state of the transaction contained in the block (noTransaction if none).
+		TransactionState string `json:"transactionState"`
+		// AcceptedSlot is the slot of the accepted tangle time at which the block was accepted (0 if not accepted yet).
+		AcceptedSlot iotago.SlotIndex `json:"acceptedSlot"`
+		// ConfirmedSlot is the slot of the accepted tangle time at which the block was confirmed (0 if not confirmed yet).
+		ConfirmedSlot iotago.SlotIndex `json:"confirmedSlot"`
+		// FinalizedSlot is the slot of the accepted tangle time at which the block was finalized (0 if not finalized yet).
+		FinalizedSlot iotago.SlotIndex `json:"finalizedSlot"`
+	}
+
 	BlockMetadataResponse struct {
 		// BlockID The hex encoded block ID of the block.
 		BlockID string `json:"blockId"`
@@ -58,6 +75,38 @@ type (
 		TangleRoot string `json:"tangleRoot"`
 	}
 
+	// SlotBlockIDsResponse contains the IDs of the blocks of a committed slot that reached a given level of finality.
+	SlotBlockIDsResponse struct {
+		// The slot of the commitment.
+		Slot iotago.SlotIndex `json:"slot"`
+		// The hex encoded ID of the commitment of the slot.
+		CommitmentID string `json:"commitmentId"`
+		// The hex encoded IDs of the blocks of the slot.
+		BlockIDs []string `json:"blockIds"`
+		// Whether the slot is finalized (all accepted blocks of a finalized slot are final).
+		Finalized bool `json:"finalized"`
+		// The tangle root of the slot that commits to all accepted blocks.
+		TangleRoot string `json:"tangleRoot"`
+		// The proof of the tangle root against the roots ID of the commitment.
+		TangleProof json.RawMessage `json:"tangleProof"`
+	}
+
+	// BlockInclusionProofResponse contains the proof that a block is part of the accepted blocks of its slot commitment.
+	BlockInclusionProofResponse struct {
+		// The hex encoded ID of the block.
+		BlockID string `json:"blockId"`
+		// The hex encoded ID of the commitment of the slot of the block.
+		CommitmentID string `json:"commitmentId"`
+		// The tangle root of the slot that commits to all accepted blocks.
+		TangleRoot string `json:"tangleRoot"`
+		// The hex encoded side nodes of the sparse merkle proof of the block against the tangle root (bottom up).
+		SideNodes []string `json:"sideNodes"`
+		// The proof of the tangle root against the roots ID of the commitment.
+		TangleProof json.RawMessage `json:"tangleProof"`
+		// The hex encoded serialized proof that can be verified with model.BlockInclusionProof.Verify.
+		Proof string `json:"proof"`
+	}
+
 	TransactionsChangesResponse struct {
 		// The index of the requested commitment.
 		Index iotago.SlotIndex `json:"index"`
@@ -66,6 +115,87 @@ type (
 		// The mutations root of the slot.
 		MutationsRoot string `json:"mutationsRoot"`
 	}
+
+	// TransactionConflictGroupResponse contains the pending transactions that conflict with a transaction.
+	TransactionConflictGroupResponse struct {
+		// The hex encoded ID of the requested transaction.
+		TransactionID string `json:"transactionId"`
+		// The pending transactions of the conflicts of the requested transaction, their competitors and future cone.
+		Transactions []*ConflictGroupTransactionResponse `json:"transactions"`
+	}
+
+	// ConflictGroupTransactionResponse contains a pending transaction of a conflict group.
+	ConflictGroupTransactionResponse struct {
+		// The hex encoded ID of the transaction.
+		TransactionID string `json:"transactionId"`
+		// The hex encoded IDs of the conflicts that the transaction belongs to.
+		SpenderIDs []string `json:"spenderIds"`
+		// The hex encoded IDs of the blocks that validly attached the transaction.
+		Attachments []string `json:"attachments"`
+	}
+
+	// PendingTransactionsResponse contains the transactions that are pending in the mempool.
+	PendingTransactionsResponse struct {
+		// The pending transactions ordered by their IDs.
+		Transactions []*ConflictGroupTransactionResponse `json:"transactions"`
+	}
+
+	// QuarantinedTransactionsResponse contains the transactions that turned invalid and are retained for inspection.
+	QuarantinedTransactionsResponse struct {
+		// The quarantined transactions ordered from oldest to newest.
+		Transactions []*QuarantinedTransactionResponse `json:"transactions"`
+	}
+
+	// QuarantinedTransactionResponse contains a transaction that turned invalid together with the reason of its failure.
+	QuarantinedTransactionResponse struct {
+		// The hex encoded ID of the transaction.
+		TransactionID string `json:"transactionId"`
+		// The error that made the transaction invalid.
+		Reason string `json:"reason"`
+		// The hex encoded ID of the block that attached the transaction first.
+		FirstAttachment string `json:"firstAttachment"`
+		// The unix timestamp at which the transaction turned invalid (in milliseconds).
+		QuarantinedAt int64 `json:"quarantinedAt"`
+		// The transaction that turned invalid.
+		Transaction json.RawMessage `json:"transaction"`
+	}
+
+	// CumulativeWeightAuditResponse contains the result of the recalculation of the cumulative weights of a range of
+	// commitments.
+	CumulativeWeightAuditResponse struct {
+		// The first audited slot.
+		StartSlot iotago.SlotIndex `json:"startSlot"`
+		// The last audited slot.
+		EndSlot iotago.SlotIndex `json:"endSlot"`
+		// The stored cumulative weight of the commitment before the start slot that the recalculation is based on.
+		BaselineCumulativeWeight uint64 `json:"baselineCumulativeWeight"`
+		// The number of commitments that diverged.
+		Divergences int `json:"divergences"`
+		// The results of the audited commitments ordered by their slots.
+		Slots []*CumulativeWeightAuditSlotResponse `json:"slots"`
+	}
+
+	// CumulativeWeightAuditSlotResponse contains the result of the recalculation of the cumulative weight of a single
+	// commitment.
+	CumulativeWeightAuditSlotResponse struct {
+		// The slot of the commitment.
+		Slot iotago.SlotIndex `json:"slot"`
+		// The hex encoded ID of the commitment.
+		CommitmentID string `json:"commitmentId"`
+		// The number of committed attestations of the slot that were issued by a member of the committee.
+		Attestations uint64 `json:"attestations"`
+		// The cumulative weight that is stored in the commitment.
+		StoredCumulativeWeight uint64 `json:"storedCumulativeWeight"`
+		// The cumulative weight that was recomputed from the attestations.
+		RecomputedCumulativeWeight uint64 `json:"recomputedCumulativeWeight"`
+		// Whether the commitment references the stored commitment of the previous slot.
+		PreviousCommitmentMatches bool `json:"previousCommitmentMatches"`
+		// Whether the root of the committed attestations matches the attestations root of the commitment. It is false if
+		// the roots of the commitment are not known.
+		AttestationsRootMatches bool `json:"attestationsRootMatches"`
+		// Whether any of the recomputed values diverged from the stored ones.
+		Diverged bool `json:"diverged"`
+	}
 )
 
 func BlockMetadataResponseFromBlock(block *blocks.Block) *BlockMetadataResponse {
@@ -1,6 +1,8 @@
 package debugapi
 
 import (
+	"time"
+
 	"github.com/iotaledger/hive.go/app"
 )
 
@@ -13,6 +15,18 @@ type ParametersDebugAPI struct {
 	MaxOpenDBs       int    `default:"2" usage:"maximum number of open database instances"`
 	PruningThreshold uint64 `default:"1" usage:"how many epochs should be retained"`
 	DBGranularity    int64  `default:"100" usage:"how many slots should be contained in a single DB instance"`
+
+	TangleExportMaxSlots uint32 `default:"100" usage:"the maximum number of slots that can be exported by a single tangle export request"`
+
+	CumulativeWeightAuditMaxSlots uint32 `default:"1000" usage:"the maximum number of commitments that can be audited by a single cumulative weight audit request"`
+
+	// Profiling contains the configuration of the worker pool profiling endpoints.
+	Profiling struct {
+		// DefaultDuration is the duration of a CPU profile if it is not specified in the request.
+		DefaultDuration time.Duration `default:"10s" usage:"the duration of a CPU profile if it is not specified in the request"`
+		// MaxDuration is the maximum duration of a CPU profile.
+		MaxDuration time.Duration `default:"1m" usage:"the maximum duration of a CPU profile"`
+	}
 }
 
 // ParamsDebugAPI is the default configuration parameters for the DebugAPI component.
@@ -0,0 +1,82 @@
+package debugapi
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/inx-app/pkg/httpserver"
+	"github.com/iotaledger/iota-core/pkg/profiling"
+)
+
+// engineMain is the value of the engine query parameter that refers to the main engine.
+const engineMain = "main"
+
+// profileLabels returns the pprof labels that the profile is filtered by.
+func profileLabels(c echo.Context) map[string]string {
+	labels := make(map[string]string)
+
+	if pool := c.QueryParam(QueryParameterPool); pool != "" {
+		labels[profiling.LabelPool] = pool
+	}
+
+	switch engine := c.QueryParam(QueryParameterEngine); engine {
+	case "":
+	case engineMain:
+		labels[profiling.LabelEngine] = deps.Protocol.Engines.Main.Get().Name()
+	default:
+		labels[profiling.LabelEngine] = engine
+	}
+
+	return labels
+}
+
+// goroutineProfile responds with the goroutine profile of the worker pools selected by the query parameters.
+func goroutineProfile(c echo.Context) error {
+	var buffer bytes.Buffer
+	if err := profiling.WriteGoroutineProfile(&buffer, profileLabels(c)); err != nil {
+		return ierrors.Wrapf(echo.ErrInternalServerError, "failed to write goroutine profile: %s", err)
+	}
+
+	return profileResponse(c, "goroutine", buffer.Bytes())
+}
+
+// cpuProfile records a CPU profile and responds with the samples of the worker pools selected by the query parameters.
+func cpuProfile(c echo.Context) error {
+	duration := ParamsDebugAPI.Profiling.DefaultDuration
+	if secondsParam := c.QueryParam(QueryParameterSeconds); secondsParam != "" {
+		seconds, err := strconv.ParseUint(secondsParam, 10, 32)
+		if err != nil || seconds == 0 {
+			return ierrors.Wrapf(httpserver.ErrInvalidParameter, "invalid value for %s: %s", QueryParameterSeconds, secondsParam)
+		}
+
+		duration = time.Duration(seconds) * time.Second
+	}
+
+	if duration > ParamsDebugAPI.Profiling.MaxDuration {
+		return ierrors.Wrapf(echo.ErrBadRequest, "profile duration %s exceeds the maximum of %s", duration, ParamsDebugAPI.Profiling.MaxDuration)
+	}
+
+	var buffer bytes.Buffer
+	if err := profiling.WriteCPUProfile(c.Request().Context(), &buffer, duration, profileLabels(c)); err != nil {
+		if ierrors.Is(err, profiling.ErrCPUProfilingActive) {
+			return ierrors.Wrapf(echo.ErrConflict, "failed to start cpu profile: %s", err)
+		}
+
+		return ierrors.Wrapf(echo.ErrInternalServerError, "failed to write cpu profile: %s", err)
+	}
+
+	return profileResponse(c, "cpu", buffer.Bytes())
+}
+
+// profileResponse responds with the given profile as a file download.
+func profileResponse(c echo.Context, profileName string, profile []byte) error {
+	c.Response().Header().Set(echo.HeaderContentDisposition, fmt.Sprintf("attachment; filename=%s.pb.gz", profileName))
+
+	return c.Blob(http.StatusOK, echo.MIMEOctetStream, profile)
+}
@@ -0,0 +1,47 @@
+package debugapi
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/iota-core/pkg/storage/database"
+	"github.com/iotaledger/iota-core/pkg/tangleexport"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// exportTangle streams the blocks of the given slot range in the given format as the response.
+func exportTangle(c echo.Context, startSlot iotago.SlotIndex, endSlot iotago.SlotIndex, format tangleexport.Format) error {
+	if endSlot < startSlot {
+		return ierrors.Wrapf(echo.ErrBadRequest, "end slot %d is before start slot %d", endSlot, startSlot)
+	}
+
+	if slotCount := uint32(endSlot-startSlot) + 1; slotCount > ParamsDebugAPI.TangleExportMaxSlots {
+		return ierrors.Wrapf(echo.ErrBadRequest, "slot range contains %d slots, but at most %d slots can be exported", slotCount, ParamsDebugAPI.TangleExportMaxSlots)
+	}
+
+	c.Response().Header().Set(echo.HeaderContentType, format.ContentType())
+
+	// the response is only committed when the first bytes are written, so errors that occur before can still be
+	// returned to the client.
+	if err := tangleexport.Export(deps.Protocol.Engines.Main.Get(), startSlot, endSlot, format, c.Response()); err != nil {
+		if c.Response().Committed {
+			Component.LogWarnf("failed to export tangle of slots %d to %d: %s", startSlot, endSlot, err)
+
+			return nil
+		}
+
+		if ierrors.Is(err, database.ErrEpochPruned) {
+			return ierrors.Wrapf(echo.ErrNotFound, "slot range is already pruned: %s", err)
+		}
+
+		return ierrors.Wrapf(echo.ErrInternalServerError, "failed to export tangle: %s", err)
+	}
+
+	if !c.Response().Committed {
+		c.Response().WriteHeader(http.StatusOK)
+	}
+
+	return nil
+}
@@ -1,9 +1,15 @@
 package debugapi
 
 import (
+	"sort"
+
+	"github.com/labstack/echo/v4"
+
 	"github.com/iotaledger/hive.go/ads"
 	"github.com/iotaledger/hive.go/ierrors"
 	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/iota-core/pkg/metrics"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/mempool"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/notarization"
 	iotago "github.com/iotaledger/iota.go/v4"
@@ -59,3 +65,102 @@ func getSlotTransactionIDs(slot iotago.SlotIndex) (*TransactionsChangesResponse,
 
 	return nil, ierrors.Errorf("cannot find transaction storage bucket for slot %d", slot)
 }
+
+func getSlotTransactionLatencies(slot iotago.SlotIndex) (*metrics.SlotTransactionLatencies, error) {
+	if slotLatencies, exists := deps.TransactionLatencies.SlotLatencies(slot); exists {
+		return slotLatencies, nil
+	}
+
+	return nil, ierrors.Wrapf(echo.ErrNotFound, "no transaction latencies recorded for slot %d", slot)
+}
+
+func getTransactionConflictGroup(transactionID iotago.TransactionID) (*TransactionConflictGroupResponse, error) {
+	transactions, exists := deps.Protocol.Engines.Main.Get().Ledger.MemPool().ConflictGroup(transactionID)
+	if !exists {
+		return nil, ierrors.Wrapf(echo.ErrNotFound, "transaction not found in mempool: %s", transactionID)
+	}
+
+	response := &TransactionConflictGroupResponse{
+		TransactionID: transactionID.ToHex(),
+		Transactions:  make([]*ConflictGroupTransactionResponse, 0, len(transactions)),
+	}
+
+	for _, transaction := range transactions {
+		response.Transactions = append(response.Transactions, &ConflictGroupTransactionResponse{
+			TransactionID: transaction.ID().ToHex(),
+			SpenderIDs:    lo.Map(transaction.SpenderIDs().ToSlice(), iotago.TransactionID.ToHex),
+			Attachments:   lo.Map(transaction.ValidAttachments(), iotago.BlockID.ToHex),
+		})
+	}
+
+	sort.Slice(response.Transactions, func(i, j int) bool {
+		return response.Transactions[i].TransactionID < response.Transactions[j].TransactionID
+	})
+
+	return response, nil
+}
+
+func getPendingTransactions() *PendingTransactionsResponse {
+	response := &PendingTransactionsResponse{
+		Transactions: make([]*ConflictGroupTransactionResponse, 0),
+	}
+
+	deps.Protocol.Engines.Main.Get().Ledger.MemPool().ForEachPendingTransaction(func(transaction mempool.TransactionMetadata) bool {
+		response.Transactions = append(response.Transactions, &ConflictGroupTransactionResponse{
+			TransactionID: transaction.ID().ToHex(),
+			SpenderIDs:    lo.Map(transaction.SpenderIDs().ToSlice(), iotago.TransactionID.ToHex),
+			Attachments:   lo.Map(transaction.ValidAttachments(), iotago.BlockID.ToHex),
+		})
+
+		return true
+	})
+
+	sort.Slice(response.Transactions, func(i, j int) bool {
+		return response.Transactions[i].TransactionID < response.Transactions[j].TransactionID
+	})
+
+	return response
+}
+
+func getQuarantinedTransactions() (*QuarantinedTransactionsResponse, error) {
+	quarantinedTransactions := deps.Protocol.Engines.Main.Get().Ledger.MemPool().QuarantinedTransactions()
+
+	response := &QuarantinedTransactionsResponse{
+		Transactions: make([]*QuarantinedTransactionResponse, 0, len(quarantinedTransactions)),
+	}
+
+	for _, quarantinedTransaction := range quarantinedTransactions {
+		transactionResponse, err := quarantinedTransactionResponse(quarantinedTransaction)
+		if err != nil {
+			return nil, err
+		}
+
+		response.Transactions = append(response.Transactions, transactionResponse)
+	}
+
+	return response, nil
+}
+
+func getQuarantinedTransaction(transactionID iotago.TransactionID) (*QuarantinedTransactionResponse, error) {
+	quarantinedTransaction, exists := deps.Protocol.Engines.Main.Get().Ledger.MemPool().QuarantinedTransaction(transactionID)
+	if !exists {
+		return nil, ierrors.Wrapf(echo.ErrNotFound, "transaction not found in quarantine: %s", transactionID)
+	}
+
+	return quarantinedTransactionResponse(quarantinedTransaction)
+}
+
+func quarantinedTransactionResponse(quarantinedTransaction *mempool.QuarantinedTransaction) (*QuarantinedTransactionResponse, error) {
+	transactionJSON, err := deps.Protocol.APIForSlot(quarantinedTransaction.FirstAttachment.Slot()).JSONEncode(quarantinedTransaction.Transaction)
+	if err != nil {
+		return nil, ierrors.Wrapf(echo.ErrInternalServerError, "failed to encode quarantined transaction %s: %s", quarantinedTransaction.ID, err)
+	}
+
+	return &QuarantinedTransactionResponse{
+		TransactionID:   quarantinedTransaction.ID.ToHex(),
+		Reason:          quarantinedTransaction.Reason.Error(),
+		FirstAttachment: quarantinedTransaction.FirstAttachment.ToHex(),
+		QuarantinedAt:   quarantinedTransaction.QuarantinedAt.UnixMilli(),
+		Transaction:     transactionJSON,
+	}, nil
+}
@@ -0,0 +1,219 @@
+package eventforwarder
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"sync"
+
+	"go.uber.org/dig"
+
+	"github.com/iotaledger/hive.go/app"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/hive.go/runtime/event"
+	"github.com/iotaledger/iota-core/pkg/daemon"
+	"github.com/iotaledger/iota-core/pkg/eventforwarder"
+	"github.com/iotaledger/iota-core/pkg/protocol"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/attestation"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/syncmanager"
+)
+
+const (
+	// EventTypeError is the type of the events that are forwarded for engine errors.
+	EventTypeError = "error"
+
+	// EventTypeChainSwitched is the type of the events that are forwarded when the node switches its main chain.
+	EventTypeChainSwitched = "chainSwitched"
+
+	// EventTypeFinalizationStalled is the type of the events that are forwarded when the finalization stalls.
+	EventTypeFinalizationStalled = "finalizationStalled"
+
+	// EventTypeValidatorEquivocated is the type of the events that are forwarded when a validator equivocates.
+	EventTypeValidatorEquivocated = "validatorEquivocated"
+)
+
+func init() {
+	Component = &app.Component{
+		Name:      "EventForwarder",
+		DepsFunc:  func(cDeps dependencies) { deps = cDeps },
+		Params:    params,
+		Configure: configure,
+		Run:       run,
+		IsEnabled: func(_ *dig.Container) bool {
+			return ParamsEventForwarder.Enabled
+		},
+	}
+}
+
+var (
+	Component *app.Component
+	deps      dependencies
+
+	forwarders []*eventforwarder.Forwarder
+)
+
+type dependencies struct {
+	dig.In
+
+	Protocol *protocol.Protocol
+}
+
+func configure() error {
+	sinks, err := configuredSinks()
+	if err != nil {
+		Component.LogPanicf("failed to configure sinks: %s", err)
+	}
+
+	if len(sinks) == 0 {
+		Component.LogPanic("at least one sink (syslog, loki or webhook) needs to be configured to use the EventForwarder")
+	}
+
+	for _, sink := range sinks {
+		forwarders = append(forwarders, eventforwarder.New(sink,
+			eventforwarder.WithQueueSize(ParamsEventForwarder.QueueSize),
+			eventforwarder.WithBatchSize(ParamsEventForwarder.BatchSize),
+			eventforwarder.WithFlushInterval(ParamsEventForwarder.FlushInterval),
+			eventforwarder.WithBackoff(ParamsEventForwarder.MinBackoff, ParamsEventForwarder.MaxBackoff),
+		))
+	}
+
+	return nil
+}
+
+func run() error {
+	if err := Component.Daemon().BackgroundWorker(Component.Name, func(ctx context.Context) {
+		Component.LogInfo("Starting EventForwarder ... done")
+
+		unhook := hookEvents()
+
+		var wg sync.WaitGroup
+		for _, forwarder := range forwarders {
+			wg.Add(1)
+			go func(forwarder *eventforwarder.Forwarder) {
+				defer wg.Done()
+
+				forwarder.Run(ctx, func(err error) {
+					Component.LogWarnf("failed to forward events: %s", err)
+				})
+			}(forwarder)
+		}
+
+		<-ctx.Done()
+		Component.LogInfo("Stopping EventForwarder ...")
+
+		unhook()
+		wg.Wait()
+
+		for _, forwarder := range forwarders {
+			if droppedEvents := forwarder.DroppedEvents(); droppedEvents != 0 {
+				Component.LogWarnf("dropped %d events because the queue was full", droppedEvents)
+			}
+		}
+
+		Component.LogInfo("Stopping EventForwarder ... done")
+	}, daemon.PriorityEventForwarder); err != nil {
+		Component.LogPanicf("failed to start worker: %s", err)
+	}
+
+	return nil
+}
+
+// configuredSinks returns the sinks that are configured in the parameters.
+func configuredSinks() ([]eventforwarder.Sink, error) {
+	var sinks []eventforwarder.Sink
+	httpClient := &http.Client{Timeout: ParamsEventForwarder.RequestTimeout}
+
+	if ParamsEventForwarder.Syslog.Address != "" {
+		syslogSink, err := eventforwarder.NewSyslogSink(ParamsEventForwarder.Syslog.Network, ParamsEventForwarder.Syslog.Address, ParamsEventForwarder.Syslog.Tag)
+		if err != nil {
+			return nil, err
+		}
+
+		sinks = append(sinks, syslogSink)
+	}
+
+	if ParamsEventForwarder.Loki.URL != "" {
+		labels := make(map[string]string)
+		for _, label := range ParamsEventForwarder.Loki.Labels {
+			key, value, found := strings.Cut(label, "=")
+			if !found {
+				Component.LogPanicf("invalid loki label %q, expected key=value", label)
+			}
+
+			labels[key] = value
+		}
+
+		sinks = append(sinks, eventforwarder.NewLokiSink(ParamsEventForwarder.Loki.URL, labels, httpClient))
+	}
+
+	if ParamsEventForwarder.Webhook.URL != "" {
+		sinks = append(sinks, eventforwarder.NewWebhookSink(ParamsEventForwarder.Webhook.URL, httpClient))
+	}
+
+	return sinks, nil
+}
+
+// forward queues the given event for delivery to all sinks.
+func forward(event *eventforwarder.Event) {
+	for _, forwarder := range forwarders {
+		forwarder.Forward(event)
+	}
+}
+
+// hookEvents hooks the forwarding to the configured node events and returns a function that unhooks it again.
+func hookEvents() (unhook func()) {
+	unhookFuncs := make([]func(), 0)
+
+	for _, eventType := range ParamsEventForwarder.Events {
+		switch eventType {
+		case EventTypeError:
+			unhookFuncs = append(unhookFuncs, deps.Protocol.Events.Engine.Error.Hook(func(err error) {
+				forward(eventforwarder.NewEvent(EventTypeError, eventforwarder.SeverityError, "engine error", "err", err))
+			}, event.WithWorkerPool(Component.WorkerPool)).Unhook)
+
+		case EventTypeChainSwitched:
+			unhookFuncs = append(unhookFuncs, deps.Protocol.Chains.Main.OnUpdate(func(previousChain *protocol.Chain, newChain *protocol.Chain) {
+				if previousChain == nil || newChain == nil {
+					return
+				}
+
+				forward(eventforwarder.NewEvent(EventTypeChainSwitched, eventforwarder.SeverityWarning, "switched main chain",
+					"forkingPoint", newChain.ForkingPoint.Get().ID(),
+					"previousForkingPoint", previousChain.ForkingPoint.Get().ID(),
+				))
+			}))
+
+		case EventTypeFinalizationStalled:
+			unhookFuncs = append(unhookFuncs, deps.Protocol.Events.Engine.SyncManager.FinalizationStalled.Hook(func(stall *syncmanager.FinalizationStall) {
+				forward(eventforwarder.NewEvent(EventTypeFinalizationStalled, eventforwarder.SeverityWarning, "finalization stalled",
+					"lastAcceptedBlockSlot", stall.LastAcceptedBlockSlot,
+					"latestFinalizedSlot", stall.LatestFinalizedSlot,
+					"lag", stall.Lag(),
+					"latestCommitmentSlot", stall.LatestCommitmentSlot,
+					"missingAttestations", stall.MissingAttestations,
+				))
+			}, event.WithWorkerPool(Component.WorkerPool)).Unhook)
+
+		case EventTypeValidatorEquivocated:
+			unhookFuncs = append(unhookFuncs, deps.Protocol.Events.Engine.Attestation.ValidatorEquivocated.Hook(func(equivocation *attestation.Equivocation) {
+				blockID, conflictingBlockID, err := equivocation.BlockIDs()
+				if err != nil {
+					Component.LogErrorf("failed to determine the block IDs of the equivocation of %s: %s", equivocation.IssuerID, err)
+
+					return
+				}
+
+				forward(eventforwarder.NewEvent(EventTypeValidatorEquivocated, eventforwarder.SeverityError, "validator equivocated",
+					"issuerID", equivocation.IssuerID,
+					"blockID", blockID,
+					"conflictingBlockID", conflictingBlockID,
+				))
+			}, event.WithWorkerPool(Component.WorkerPool)).Unhook)
+
+		default:
+			Component.LogPanicf("unknown event type %q", eventType)
+		}
+	}
+
+	return lo.Batch(unhookFuncs...)
+}
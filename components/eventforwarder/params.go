@@ -0,0 +1,58 @@
+package eventforwarder
+
+import (
+	"time"
+
+	"github.com/iotaledger/hive.go/app"
+)
+
+// ParametersEventForwarder contains the definition of configuration parameters used by the EventForwarder.
+type ParametersEventForwarder struct {
+	// Enabled defines whether the EventForwarder component is enabled.
+	Enabled bool `default:"false" usage:"whether the EventForwarder component is enabled"`
+	// Events defines the types of the node events that are forwarded.
+	Events []string `default:"error,chainSwitched,finalizationStalled,validatorEquivocated" usage:"the types of the node events that are forwarded (error, chainSwitched, finalizationStalled, validatorEquivocated)"`
+	// QueueSize defines the maximum number of events that are queued per sink before further events are dropped.
+	QueueSize int `default:"1000" usage:"the maximum number of events that are queued per sink before further events are dropped"`
+	// BatchSize defines the maximum number of events that are sent to a sink in a single request.
+	BatchSize int `default:"100" usage:"the maximum number of events that are sent to a sink in a single request"`
+	// FlushInterval defines the interval in which incomplete batches are sent to the sinks.
+	FlushInterval time.Duration `default:"5s" usage:"the interval in which incomplete batches are sent to the sinks"`
+	// MinBackoff defines the delay before a failed request to a sink is retried for the first time.
+	MinBackoff time.Duration `default:"1s" usage:"the delay before a failed request to a sink is retried for the first time"`
+	// MaxBackoff defines the maximum delay between the retries of a failed request to a sink.
+	MaxBackoff time.Duration `default:"1m" usage:"the maximum delay between the retries of a failed request to a sink"`
+	// RequestTimeout defines the timeout of the requests to the HTTP sinks.
+	RequestTimeout time.Duration `default:"10s" usage:"the timeout of the requests to the HTTP sinks"`
+
+	Syslog struct {
+		// Address defines the address of the syslog server that the events are sent to (empty = disabled).
+		Address string `default:"" usage:"the address of the syslog server that the events are sent to (empty = disabled)"`
+		// Network defines the network protocol that is used to connect to the syslog server.
+		Network string `default:"udp" usage:"the network protocol that is used to connect to the syslog server (udp or tcp)"`
+		// Tag defines the app name of the syslog messages.
+		Tag string `default:"iota-core" usage:"the app name of the syslog messages"`
+	}
+
+	Loki struct {
+		// URL defines the URL of the Loki push API that the events are sent to (empty = disabled).
+		URL string `default:"" usage:"the URL of the Loki push API that the events are sent to, e.g. http://localhost:3100/loki/api/v1/push (empty = disabled)"`
+		// Labels defines the labels that are attached to the Loki streams.
+		Labels []string `default:"job=iota-core" usage:"the labels that are attached to the Loki streams (key=value)"`
+	}
+
+	Webhook struct {
+		// URL defines the URL that the events are posted to as JSON (empty = disabled).
+		URL string `default:"" usage:"the URL that the events are posted to as JSON (empty = disabled)"`
+	}
+}
+
+// ParamsEventForwarder is the default configuration parameters for the EventForwarder component.
+var ParamsEventForwarder = &ParametersEventForwarder{}
+
+var params = &app.ComponentParams{
+	Params: map[string]any{
+		"eventForwarder": ParamsEventForwarder,
+	},
+	Masked: []string{"eventForwarder.webhook.url"},
+}
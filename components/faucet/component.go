@@ -0,0 +1,157 @@
+package faucet
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"go.uber.org/dig"
+
+	"github.com/iotaledger/hive.go/app"
+	hivecrypto "github.com/iotaledger/hive.go/crypto"
+	"github.com/iotaledger/hive.go/kvstore"
+	hivedb "github.com/iotaledger/hive.go/kvstore/database"
+	"github.com/iotaledger/inx-app/pkg/httpserver"
+	"github.com/iotaledger/iota-core/components/restapi"
+	"github.com/iotaledger/iota-core/pkg/blockfactory"
+	"github.com/iotaledger/iota-core/pkg/blockhandler"
+	"github.com/iotaledger/iota-core/pkg/daemon"
+	"github.com/iotaledger/iota-core/pkg/faucet"
+	"github.com/iotaledger/iota-core/pkg/protocol"
+	restapipkg "github.com/iotaledger/iota-core/pkg/restapi"
+	"github.com/iotaledger/iota-core/pkg/storage/database"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+const (
+	// RouteEnqueue is the route to request funds from the faucet.
+	// POST enqueues the request and returns the amounts that are dispensed.
+	RouteEnqueue = "/enqueue"
+
+	// RouteInfo is the route to get the info of the faucet.
+	// GET returns the address, the dispensed amounts and the number of queued requests.
+	RouteInfo = "/info"
+)
+
+func init() {
+	Component = &app.Component{
+		Name:      "Faucet",
+		DepsFunc:  func(cDeps dependencies) { deps = cDeps },
+		Configure: configure,
+		Run:       run,
+		Params:    params,
+		IsEnabled: func(c *dig.Container) bool {
+			return restapi.ParamsRestAPI.Enabled && ParamsFaucet.Enabled
+		},
+	}
+}
+
+var (
+	Component *app.Component
+	deps      dependencies
+
+	faucetStore        kvstore.KVStore
+	faucetBlockFactory *blockfactory.Factory
+	faucetInstance     *faucet.Faucet
+)
+
+type dependencies struct {
+	dig.In
+
+	Protocol         *protocol.Protocol
+	BlockHandler     *blockhandler.BlockHandler
+	RestRouteManager *restapipkg.RestRouteManager
+	DatabaseEngine   hivedb.Engine  `name:"databaseEngine"`
+	NodePrivateKey   crypto.PrivKey `name:"nodePrivateKey"`
+}
+
+func configure() error {
+	// check if RestAPI plugin is disabled
+	if !Component.App().IsComponentEnabled(restapi.Component.Identifier()) {
+		Component.LogPanic("RestAPI plugin needs to be enabled to use the Faucet plugin")
+	}
+
+	privateKey, err := hivecrypto.ParseEd25519PrivateKeyFromString(ParamsFaucet.PrivateKey)
+	if err != nil {
+		Component.LogPanicf("invalid faucet private key: %s", err)
+	}
+
+	_, issuerAddress, err := iotago.ParseBech32(ParamsFaucet.IssuerAccountAddress)
+	if err != nil {
+		Component.LogPanicf("invalid faucet issuer account address %s: %s", ParamsFaucet.IssuerAccountAddress, err)
+	}
+
+	issuerAccountAddress, isAccountAddress := issuerAddress.(*iotago.AccountAddress)
+	if !isAccountAddress {
+		Component.LogPanicf("faucet issuer address %s is not an account address", ParamsFaucet.IssuerAccountAddress)
+	}
+
+	if err = blockfactory.ValidateKeySeparation(privateKey, deps.NodePrivateKey.GetPublic()); err != nil {
+		Component.LogPanicf("invalid faucet private key: %s", err)
+	}
+
+	if err = blockfactory.ValidateIssuer(deps.Protocol, issuerAccountAddress.AccountID(), privateKey); err != nil {
+		Component.LogPanicf("invalid faucet issuer account %s: %s", ParamsFaucet.IssuerAccountAddress, err)
+	}
+
+	if faucetStore, err = database.StoreWithDefaultSettings(ParamsFaucet.Database.Path, true, deps.DatabaseEngine); err != nil {
+		Component.LogPanicf("failed to open faucet database: %s", err)
+	}
+
+	faucetBlockFactory = blockfactory.New(deps.Protocol)
+
+	if faucetInstance, err = faucet.New(deps.Protocol, deps.BlockHandler, faucetBlockFactory, privateKey, issuerAccountAddress.AccountID(), faucetStore,
+		faucet.WithBaseTokenAmount(iotago.BaseToken(ParamsFaucet.BaseTokenAmount)),
+		faucet.WithManaAmount(iotago.Mana(ParamsFaucet.ManaAmount)),
+		faucet.WithMaxAddressRequests(ParamsFaucet.MaxAddressRequests),
+		faucet.WithMaxIPRequests(ParamsFaucet.MaxIPRequests),
+		faucet.WithQuotaWindow(ParamsFaucet.QuotaWindow),
+		faucet.WithBatchSize(ParamsFaucet.BatchSize),
+		faucet.WithIssuanceInterval(ParamsFaucet.IssuanceInterval),
+		faucet.WithAcceptanceTimeout(ParamsFaucet.AcceptanceTimeout),
+	); err != nil {
+		Component.LogPanicf("failed to create faucet: %s", err)
+	}
+
+	routeGroup := deps.RestRouteManager.AddRoute("faucet/v1")
+
+	routeGroup.POST(RouteEnqueue, func(c echo.Context) error {
+		resp, err := enqueue(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusAccepted, resp)
+	})
+
+	routeGroup.GET(RouteInfo, func(c echo.Context) error {
+		return httpserver.JSONResponse(c, http.StatusOK, info())
+	})
+
+	return nil
+}
+
+func run() error {
+	if err := Component.Daemon().BackgroundWorker("Faucet", func(ctx context.Context) {
+		Component.LogInfof("Starting Faucet with address %s (%d queued requests)", faucetInstance.Address().Bech32(hrp()), faucetInstance.QueueSize())
+
+		faucetInstance.Run(ctx, func(err error) {
+			Component.LogWarnf("failed to dispense faucet requests: %s", err)
+		})
+
+		Component.LogInfo("Stopping Faucet ...")
+
+		faucetBlockFactory.Shutdown()
+
+		if err := faucetStore.Close(); err != nil {
+			Component.LogErrorf("failed to close faucet database: %s", err)
+		}
+
+		Component.LogInfo("Stopping Faucet ... done")
+	}, daemon.PriorityFaucet); err != nil {
+		Component.LogPanicf("failed to start worker: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,36 @@
+package faucet
+
+import (
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+type (
+	EnqueueRequest struct {
+		// The bech32 address that receives the base tokens.
+		Address string `json:"address"`
+		// The bech32 account address of the account that receives the mana allotment (optional).
+		AccountAddress string `json:"accountAddress,omitempty"`
+	}
+
+	EnqueueResponse struct {
+		// The bech32 address that receives the base tokens.
+		Address string `json:"address"`
+		// The amount of base tokens that is dispensed.
+		Amount iotago.BaseToken `json:"amount,string"`
+		// The amount of mana that is allotted to the account.
+		Mana iotago.Mana `json:"mana,string"`
+		// The number of queued requests including this one.
+		WaitingRequests int `json:"waitingRequests"`
+	}
+
+	InfoResponse struct {
+		// The bech32 address that holds the funds of the faucet.
+		Address string `json:"address"`
+		// The amount of base tokens that is dispensed per request.
+		Amount iotago.BaseToken `json:"amount,string"`
+		// The amount of mana that is allotted per request that contains an account.
+		Mana iotago.Mana `json:"mana,string"`
+		// The number of queued requests.
+		WaitingRequests int `json:"waitingRequests"`
+	}
+)
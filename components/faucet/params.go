@@ -0,0 +1,48 @@
+package faucet
+
+import (
+	"time"
+
+	"github.com/iotaledger/hive.go/app"
+)
+
+// ParametersFaucet contains the definition of configuration parameters used by the Faucet.
+type ParametersFaucet struct {
+	// Enabled whether the Faucet component is enabled.
+	Enabled bool `default:"false" usage:"whether the Faucet component is enabled"`
+	// PrivateKey defines the Ed25519 private key of the faucet address and of the block issuer account.
+	PrivateKey string `default:"" usage:"the Ed25519 private key of the faucet address and of the block issuer account"`
+	// IssuerAccountAddress defines the bech32 account address of the account that issues the blocks of the faucet.
+	IssuerAccountAddress string `default:"" usage:"the bech32 account address of the account that issues the blocks of the faucet"`
+	// BaseTokenAmount defines the amount of base tokens that is dispensed per request.
+	BaseTokenAmount uint64 `default:"1000000000" usage:"the amount of base tokens that is dispensed per request"`
+	// ManaAmount defines the amount of mana that is allotted per request that contains an account.
+	ManaAmount uint64 `default:"0" usage:"the amount of mana that is allotted per request that contains an account (0 disables allotments)"`
+	// MaxAddressRequests defines the maximum number of requests per address within the quota window.
+	MaxAddressRequests int `default:"1" usage:"the maximum number of requests per address within the quota window (0 disables the quota)"`
+	// MaxIPRequests defines the maximum number of requests per IP within the quota window.
+	MaxIPRequests int `default:"10" usage:"the maximum number of requests per IP within the quota window (0 disables the quota)"`
+	// QuotaWindow defines the duration of the sliding window of the quotas.
+	QuotaWindow time.Duration `default:"24h" usage:"the duration of the sliding window of the quotas"`
+	// BatchSize defines the maximum number of requests that are dispensed in a single transaction.
+	BatchSize int `default:"50" usage:"the maximum number of requests that are dispensed in a single transaction"`
+	// IssuanceInterval defines the interval in which batches are dispensed.
+	IssuanceInterval time.Duration `default:"5s" usage:"the interval in which batches are dispensed"`
+	// AcceptanceTimeout defines the duration after which a batch that was not accepted is retried.
+	AcceptanceTimeout time.Duration `default:"1m" usage:"the duration after which a batch that was not accepted is retried"`
+
+	Database struct {
+		// Path defines the path to the database folder that contains the queue of the faucet.
+		Path string `default:"testnet/faucet" usage:"the path to the database folder that contains the queue of the faucet"`
+	}
+}
+
+// ParamsFaucet is the default configuration parameters for the Faucet component.
+var ParamsFaucet = &ParametersFaucet{}
+
+var params = &app.ComponentParams{
+	Params: map[string]any{
+		"faucet": ParamsFaucet,
+	},
+	Masked: []string{"faucet.privateKey"},
+}
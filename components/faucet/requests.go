@@ -0,0 +1,87 @@
+package faucet
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/inx-app/pkg/httpserver"
+	"github.com/iotaledger/iota-core/pkg/faucet"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func enqueue(c echo.Context) (*EnqueueResponse, error) {
+	request := &EnqueueRequest{}
+	if err := c.Bind(request); err != nil {
+		return nil, ierrors.Wrapf(httpserver.ErrInvalidParameter, "invalid request, error: %s", err)
+	}
+
+	address, err := parseBech32Address(request.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	faucetRequest := &faucet.Request{
+		Address: address,
+		IP:      c.RealIP(),
+	}
+
+	var mana iotago.Mana
+	if request.AccountAddress != "" {
+		accountAddress, err := parseBech32Address(request.AccountAddress)
+		if err != nil {
+			return nil, err
+		}
+
+		accountAddressTyped, isAccountAddress := accountAddress.(*iotago.AccountAddress)
+		if !isAccountAddress {
+			return nil, ierrors.Wrapf(httpserver.ErrInvalidParameter, "address %s is not an account address", request.AccountAddress)
+		}
+
+		faucetRequest.AccountID = accountAddressTyped.AccountID()
+		mana = faucetInstance.ManaAmount()
+	}
+
+	if err := faucetInstance.Enqueue(faucetRequest); err != nil {
+		switch {
+		case ierrors.Is(err, faucet.ErrAddressQuotaExceeded), ierrors.Is(err, faucet.ErrIPQuotaExceeded):
+			return nil, ierrors.Wrapf(echo.ErrTooManyRequests, "failed to enqueue request, error: %s", err)
+		case ierrors.Is(err, faucet.ErrInvalidRequest), ierrors.Is(err, faucet.ErrRequestPending):
+			return nil, ierrors.Wrapf(httpserver.ErrInvalidParameter, "failed to enqueue request, error: %s", err)
+		default:
+			return nil, ierrors.Wrapf(echo.ErrInternalServerError, "failed to enqueue request, error: %s", err)
+		}
+	}
+
+	return &EnqueueResponse{
+		Address:         request.Address,
+		Amount:          faucetInstance.BaseTokenAmount(),
+		Mana:            mana,
+		WaitingRequests: faucetInstance.QueueSize(),
+	}, nil
+}
+
+func info() *InfoResponse {
+	return &InfoResponse{
+		Address:         faucetInstance.Address().Bech32(hrp()),
+		Amount:          faucetInstance.BaseTokenAmount(),
+		Mana:            faucetInstance.ManaAmount(),
+		WaitingRequests: faucetInstance.QueueSize(),
+	}
+}
+
+func parseBech32Address(bech32Address string) (iotago.Address, error) {
+	addressHRP, address, err := iotago.ParseBech32(bech32Address)
+	if err != nil {
+		return nil, ierrors.Wrapf(httpserver.ErrInvalidParameter, "invalid address %s, error: %s", bech32Address, err)
+	}
+
+	if expectedHRP := hrp(); addressHRP != expectedHRP {
+		return nil, ierrors.Wrapf(httpserver.ErrInvalidParameter, "invalid bech32 address, expected prefix: %s", expectedHRP)
+	}
+
+	return address, nil
+}
+
+func hrp() iotago.NetworkPrefix {
+	return deps.Protocol.CommittedAPI().ProtocolParameters().Bech32HRP()
+}
@@ -0,0 +1,4 @@
+// Package adminpb contains the protobuf definitions and the generated gRPC stubs of the admin service.
+package adminpb
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative --go-grpc_out=. --go-grpc_opt=paths=source_relative admin.proto
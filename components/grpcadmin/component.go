@@ -0,0 +1,71 @@
+package grpcadmin
+
+import (
+	"context"
+
+	"go.uber.org/dig"
+
+	"github.com/iotaledger/hive.go/app"
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/iota-core/pkg/daemon"
+	"github.com/iotaledger/iota-core/pkg/network/p2p"
+	"github.com/iotaledger/iota-core/pkg/protocol"
+)
+
+func init() {
+	Component = &app.Component{
+		Name:     "GRPCAdmin",
+		DepsFunc: func(cDeps dependencies) { deps = cDeps },
+		Params:   params,
+		IsEnabled: func(c *dig.Container) bool {
+			return ParamsGRPCAdmin.Enabled
+		},
+		Provide: provide,
+		Run:     run,
+	}
+}
+
+var (
+	Component *app.Component
+	deps      dependencies
+)
+
+type dependencies struct {
+	dig.In
+	Protocol        *protocol.Protocol
+	P2PManager      *p2p.Manager
+	GRPCAdminServer *Server
+}
+
+func provide(c *dig.Container) error {
+	if err := c.Provide(func() *Server {
+		server, err := newServer()
+		if err != nil {
+			Component.LogPanicf("failed to create gRPC admin server: %s", err)
+		}
+
+		return server
+	}); err != nil {
+		Component.LogPanic(err.Error())
+	}
+
+	return nil
+}
+
+func run() error {
+	if err := deps.GRPCAdminServer.Start(); err != nil {
+		return ierrors.Wrap(err, "failed to start gRPC admin interface")
+	}
+
+	if err := Component.Daemon().BackgroundWorker("GRPCAdmin", func(ctx context.Context) {
+		Component.LogInfo("Starting gRPC admin interface ... done")
+		<-ctx.Done()
+		Component.LogInfo("Stopping gRPC admin interface ...")
+		deps.GRPCAdminServer.Stop()
+		Component.LogInfo("Stopping gRPC admin interface ... done")
+	}, daemon.PriorityGRPCAdmin); err != nil {
+		Component.LogPanicf("failed to start worker: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,33 @@
+package grpcadmin
+
+import (
+	"github.com/iotaledger/hive.go/app"
+)
+
+// ParametersGRPCAdmin contains the definition of the parameters used by the gRPC admin interface.
+type ParametersGRPCAdmin struct {
+	// Enabled defines whether the gRPC admin interface is enabled.
+	Enabled bool `default:"false" usage:"whether the gRPC admin interface is enabled"`
+	// BindAddress defines the bind address on which the gRPC admin interface can be accessed from.
+	BindAddress string `default:"localhost:9031" usage:"the bind address on which the gRPC admin interface can be accessed from"`
+
+	TLS struct {
+		// CertificatePath defines the path to the PEM encoded certificate of the server.
+		CertificatePath string `default:"" usage:"the path to the PEM encoded certificate of the server"`
+		// PrivateKeyPath defines the path to the PEM encoded private key of the server.
+		PrivateKeyPath string `default:"" usage:"the path to the PEM encoded private key of the server"`
+		// ClientCAPath defines the path to the PEM encoded certificate authorities that the certificates of the clients need to be signed by.
+		ClientCAPath string `default:"" usage:"the path to the PEM encoded certificate authorities that the certificates of the clients need to be signed by"`
+	} `name:"tls"`
+
+	// SnapshotDirectory defines the directory the snapshots that are created via the gRPC admin interface are written to.
+	SnapshotDirectory string `default:"testnet/snapshots" usage:"the directory the snapshots that are created via the gRPC admin interface are written to"`
+}
+
+var ParamsGRPCAdmin = &ParametersGRPCAdmin{}
+
+var params = &app.ComponentParams{
+	Params: map[string]any{
+		"grpcAdmin": ParamsGRPCAdmin,
+	},
+}
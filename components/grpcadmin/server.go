@@ -0,0 +1,100 @@
+package grpcadmin
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"net"
+	"os"
+	"time"
+
+	grpcprometheus "github.com/grpc-ecosystem/go-grpc-prometheus"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/keepalive"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/iota-core/components/grpcadmin/adminpb"
+)
+
+func newServer() (*Server, error) {
+	tlsConfig, err := loadTLSConfig(ParamsGRPCAdmin.TLS.CertificatePath, ParamsGRPCAdmin.TLS.PrivateKeyPath, ParamsGRPCAdmin.TLS.ClientCAPath)
+	if err != nil {
+		return nil, err
+	}
+
+	s := &Server{grpcServer: newGRPCServer(tlsConfig)}
+	adminpb.RegisterAdminServer(s.grpcServer, s)
+
+	return s, nil
+}
+
+// Server is the gRPC server that exposes the management operations of the node.
+type Server struct {
+	adminpb.UnimplementedAdminServer
+
+	grpcServer *grpc.Server
+}
+
+// Start starts listening on the configured bind address and serves the admin service in the background.
+func (s *Server) Start() error {
+	listener, err := net.Listen("tcp", ParamsGRPCAdmin.BindAddress)
+	if err != nil {
+		return ierrors.Wrapf(err, "failed to listen on %s", ParamsGRPCAdmin.BindAddress)
+	}
+
+	go func() {
+		if err := s.grpcServer.Serve(listener); err != nil && !ierrors.Is(err, grpc.ErrServerStopped) {
+			Component.LogErrorf("failed to serve: %s", err)
+		}
+	}()
+
+	return nil
+}
+
+func (s *Server) Stop() {
+	s.grpcServer.Stop()
+}
+
+// newGRPCServer creates the gRPC server that only accepts connections of clients that authenticate according to the
+// given TLS config.
+func newGRPCServer(tlsConfig *tls.Config) *grpc.Server {
+	return grpc.NewServer(
+		grpc.Creds(credentials.NewTLS(tlsConfig)),
+		grpc.UnaryInterceptor(grpcprometheus.UnaryServerInterceptor),
+		grpc.KeepaliveParams(keepalive.ServerParameters{
+			Time:    20 * time.Second,
+			Timeout: 5 * time.Second,
+		}),
+		grpc.MaxConcurrentStreams(10),
+	)
+}
+
+// loadTLSConfig creates the TLS config of the server that requires the clients to authenticate with a certificate that
+// is signed by one of the certificate authorities in the given client CA file.
+func loadTLSConfig(certificatePath string, privateKeyPath string, clientCAPath string) (*tls.Config, error) {
+	if certificatePath == "" || privateKeyPath == "" || clientCAPath == "" {
+		return nil, ierrors.New("the certificate, the private key and the client CA need to be configured")
+	}
+
+	certificate, err := tls.LoadX509KeyPair(certificatePath, privateKeyPath)
+	if err != nil {
+		return nil, ierrors.Wrap(err, "failed to load server certificate")
+	}
+
+	clientCABytes, err := os.ReadFile(clientCAPath)
+	if err != nil {
+		return nil, ierrors.Wrap(err, "failed to read client CA")
+	}
+
+	clientCAs := x509.NewCertPool()
+	if !clientCAs.AppendCertsFromPEM(clientCABytes) {
+		return nil, ierrors.Errorf("no valid certificate found in client CA %s", clientCAPath)
+	}
+
+	return &tls.Config{
+		Certificates: []tls.Certificate{certificate},
+		ClientAuth:   tls.RequireAndVerifyClientCert,
+		ClientCAs:    clientCAs,
+		MinVersion:   tls.VersionTLS12,
+	}, nil
+}
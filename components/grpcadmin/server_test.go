@@ -0,0 +1,184 @@
+package grpcadmin
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/status"
+
+	"github.com/iotaledger/iota-core/components/grpcadmin/adminpb"
+)
+
+type testAdminServer struct {
+	adminpb.UnimplementedAdminServer
+}
+
+func (testAdminServer) Health(_ context.Context, _ *adminpb.HealthRequest) (*adminpb.HealthResponse, error) {
+	return &adminpb.HealthResponse{IsHealthy: true, LatestFinalizedSlot: 42}, nil
+}
+
+type testCertificate struct {
+	certificate *x509.Certificate
+	privateKey  *ecdsa.PrivateKey
+}
+
+func newTestCertificate(t *testing.T, commonName string, parent *testCertificate, isCA bool) *testCertificate {
+	privateKey, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+		BasicConstraintsValid: true,
+		IsCA:                  isCA,
+		DNSNames:              []string{"localhost"},
+		IPAddresses:           []net.IP{net.ParseIP("127.0.0.1")},
+	}
+
+	signer := &testCertificate{certificate: template, privateKey: privateKey}
+	if parent != nil {
+		signer = parent
+	}
+
+	certificateBytes, err := x509.CreateCertificate(rand.Reader, template, signer.certificate, &privateKey.PublicKey, signer.privateKey)
+	require.NoError(t, err)
+
+	certificate, err := x509.ParseCertificate(certificateBytes)
+	require.NoError(t, err)
+
+	return &testCertificate{certificate: certificate, privateKey: privateKey}
+}
+
+func (c *testCertificate) writeTo(t *testing.T, directory string, name string) (certificatePath string, privateKeyPath string) {
+	privateKeyBytes, err := x509.MarshalECPrivateKey(c.privateKey)
+	require.NoError(t, err)
+
+	certificatePath = filepath.Join(directory, name+".crt")
+	require.NoError(t, os.WriteFile(certificatePath, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: c.certificate.Raw}), 0o600))
+
+	privateKeyPath = filepath.Join(directory, name+".key")
+	require.NoError(t, os.WriteFile(privateKeyPath, pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: privateKeyBytes}), 0o600))
+
+	return certificatePath, privateKeyPath
+}
+
+func (c *testCertificate) tlsCertificate() tls.Certificate {
+	return tls.Certificate{Certificate: [][]byte{c.certificate.Raw}, PrivateKey: c.privateKey}
+}
+
+func TestServer_MutualTLS(t *testing.T) {
+	directory := t.TempDir()
+
+	ca := newTestCertificate(t, "ca", nil, true)
+	foreignCA := newTestCertificate(t, "foreign-ca", nil, true)
+	serverCertificate := newTestCertificate(t, "server", ca, false)
+	clientCertificate := newTestCertificate(t, "client", ca, false)
+	foreignClientCertificate := newTestCertificate(t, "foreign-client", foreignCA, false)
+
+	caPath, _ := ca.writeTo(t, directory, "ca")
+	serverCertificatePath, serverPrivateKeyPath := serverCertificate.writeTo(t, directory, "server")
+
+	tlsConfig, err := loadTLSConfig(serverCertificatePath, serverPrivateKeyPath, caPath)
+	require.NoError(t, err)
+
+	grpcServer := newGRPCServer(tlsConfig)
+	adminpb.RegisterAdminServer(grpcServer, testAdminServer{})
+
+	listener, err := net.Listen("tcp", "localhost:0")
+	require.NoError(t, err)
+
+	go func() { _ = grpcServer.Serve(listener) }()
+	t.Cleanup(grpcServer.Stop)
+
+	serverCAs := x509.NewCertPool()
+	serverCAs.AddCert(ca.certificate)
+
+	health := func(clientCertificates ...tls.Certificate) (*adminpb.HealthResponse, error) {
+		conn, err := grpc.Dial(listener.Addr().String(), grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{
+			Certificates: clientCertificates,
+			RootCAs:      serverCAs,
+			ServerName:   "localhost",
+			MinVersion:   tls.VersionTLS12,
+		})))
+		require.NoError(t, err)
+		defer conn.Close()
+
+		ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+		defer cancel()
+
+		return adminpb.NewAdminClient(conn).Health(ctx, &adminpb.HealthRequest{})
+	}
+
+	t.Run("authorized client", func(t *testing.T) {
+		response, err := health(clientCertificate.tlsCertificate())
+		require.NoError(t, err)
+		require.True(t, response.GetIsHealthy())
+		require.EqualValues(t, 42, response.GetLatestFinalizedSlot())
+	})
+
+	t.Run("client without certificate", func(t *testing.T) {
+		_, err := health()
+		require.Error(t, err)
+		require.Equal(t, codes.Unavailable, status.Code(err))
+	})
+
+	t.Run("client with certificate of foreign CA", func(t *testing.T) {
+		_, err := health(foreignClientCertificate.tlsCertificate())
+		require.Error(t, err)
+		require.Equal(t, codes.Unavailable, status.Code(err))
+	})
+
+	t.Run("unimplemented method", func(t *testing.T) {
+		conn, err := grpc.Dial(listener.Addr().String(), grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{
+			Certificates: []tls.Certificate{clientCertificate.tlsCertificate()},
+			RootCAs:      serverCAs,
+			ServerName:   "localhost",
+			MinVersion:   tls.VersionTLS12,
+		})))
+		require.NoError(t, err)
+		defer conn.Close()
+
+		_, err = adminpb.NewAdminClient(conn).ListPeers(context.Background(), &adminpb.ListPeersRequest{})
+		require.Equal(t, codes.Unimplemented, status.Code(err))
+	})
+}
+
+func TestLoadTLSConfig(t *testing.T) {
+	directory := t.TempDir()
+
+	ca := newTestCertificate(t, "ca", nil, true)
+	serverCertificate := newTestCertificate(t, "server", ca, false)
+
+	caPath, caPrivateKeyPath := ca.writeTo(t, directory, "ca")
+	serverCertificatePath, serverPrivateKeyPath := serverCertificate.writeTo(t, directory, "server")
+
+	_, err := loadTLSConfig(serverCertificatePath, serverPrivateKeyPath, "")
+	require.Error(t, err)
+
+	_, err = loadTLSConfig(serverCertificatePath, serverPrivateKeyPath, caPrivateKeyPath)
+	require.Error(t, err)
+
+	tlsConfig, err := loadTLSConfig(serverCertificatePath, serverPrivateKeyPath, caPath)
+	require.NoError(t, err)
+	require.Equal(t, tls.RequireAndVerifyClientCert, tlsConfig.ClientAuth)
+}
@@ -0,0 +1,211 @@
+package grpcadmin
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/labstack/gommon/bytes"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/iota-core/components/grpcadmin/adminpb"
+	"github.com/iotaledger/iota-core/components/restapi/management"
+	"github.com/iotaledger/iota-core/pkg/network"
+	"github.com/iotaledger/iota-core/pkg/protocol"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// Health returns the health of the node.
+func (s *Server) Health(_ context.Context, _ *adminpb.HealthRequest) (*adminpb.HealthResponse, error) {
+	mainEngine := deps.Protocol.Engines.Main.Get()
+
+	isSynced := mainEngine.SyncManager.IsNodeSynced()
+	isFinalizationStalled := mainEngine.SyncManager.IsFinalizationStalled()
+
+	latestCommitmentID := mainEngine.SyncManager.LatestCommitment().ID()
+
+	return &adminpb.HealthResponse{
+		IsHealthy:             isSynced && !isFinalizationStalled,
+		IsSynced:              isSynced,
+		IsFinalizationStalled: isFinalizationStalled,
+		LatestCommitmentId:    latestCommitmentID[:],
+		LatestFinalizedSlot:   uint32(mainEngine.SyncManager.LatestFinalizedSlot()),
+	}, nil
+}
+
+// PruneDatabase prunes the database of the main engine by epoch, depth or target size.
+func (s *Server) PruneDatabase(_ context.Context, request *adminpb.PruneDatabaseRequest) (*adminpb.PruneDatabaseResponse, error) {
+	mainStorage := deps.Protocol.Engines.Main.Get().Storage
+	if mainStorage.IsPruning() {
+		return nil, status.Error(codes.Unavailable, "node is already pruning")
+	}
+
+	// only allow one type of pruning at a time
+	if (request.Epoch == 0 && request.Depth == 0 && request.TargetDatabaseSize == "") ||
+		(request.Epoch != 0 && request.Depth != 0) ||
+		(request.Epoch != 0 && request.TargetDatabaseSize != "") ||
+		(request.Depth != 0 && request.TargetDatabaseSize != "") {
+		return nil, status.Error(codes.InvalidArgument, "either epoch, depth or size has to be specified")
+	}
+
+	if request.Epoch != 0 {
+		if err := mainStorage.PruneByEpochIndex(iotago.EpochIndex(request.Epoch)); err != nil {
+			return nil, status.Errorf(codes.Internal, "pruning database failed: %s", err)
+		}
+	}
+
+	if request.Depth != 0 {
+		if _, _, err := mainStorage.PruneByDepth(iotago.EpochIndex(request.Depth)); err != nil {
+			return nil, status.Errorf(codes.Internal, "pruning database failed: %s", err)
+		}
+	}
+
+	if request.TargetDatabaseSize != "" {
+		pruningTargetDatabaseSizeBytes, err := bytes.Parse(request.TargetDatabaseSize)
+		if err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid target database size: %s", err)
+		}
+
+		if err = mainStorage.PruneBySize(pruningTargetDatabaseSizeBytes); err != nil {
+			return nil, status.Errorf(codes.Internal, "pruning database failed: %s", err)
+		}
+	}
+
+	targetEpoch, hasPruned := mainStorage.LastPrunedEpoch()
+	if hasPruned {
+		targetEpoch++
+	}
+
+	Component.LogWarn("database pruned manually", "epoch", targetEpoch)
+
+	return &adminpb.PruneDatabaseResponse{
+		Epoch: uint32(targetEpoch),
+	}, nil
+}
+
+// CreateSnapshot writes a snapshot of the main engine for the requested slot to the snapshot directory.
+func (s *Server) CreateSnapshot(_ context.Context, request *adminpb.CreateSnapshotRequest) (*adminpb.CreateSnapshotResponse, error) {
+	mainEngine := deps.Protocol.Engines.Main.Get()
+	if mainEngine.Storage.IsPruning() {
+		return nil, status.Error(codes.Unavailable, "node is pruning")
+	}
+
+	latestCommittedSlot := mainEngine.Storage.Settings().LatestCommitment().Slot()
+
+	targetSlot := iotago.SlotIndex(request.Slot)
+	if targetSlot == 0 {
+		targetSlot = latestCommittedSlot
+	} else if targetSlot > latestCommittedSlot {
+		return nil, status.Errorf(codes.InvalidArgument, "slot %d is not committed yet (latest committed slot %d)", targetSlot, latestCommittedSlot)
+	}
+
+	if err := os.MkdirAll(ParamsGRPCAdmin.SnapshotDirectory, 0o700); err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to create snapshot directory: %s", err)
+	}
+
+	filePath := filepath.Join(ParamsGRPCAdmin.SnapshotDirectory, fmt.Sprintf("snapshot_%d.bin", targetSlot))
+	if err := mainEngine.WriteSnapshot(filePath, targetSlot); err != nil {
+		return nil, status.Errorf(codes.Internal, "creating snapshot failed: %s", err)
+	}
+
+	Component.LogInfo("snapshot created manually", "slot", targetSlot, "filePath", filePath)
+
+	return &adminpb.CreateSnapshotResponse{
+		Slot:     uint32(targetSlot),
+		FilePath: filePath,
+	}, nil
+}
+
+// ListPeers returns the neighbors of the node.
+func (s *Server) ListPeers(_ context.Context, _ *adminpb.ListPeersRequest) (*adminpb.ListPeersResponse, error) {
+	neighbors := deps.P2PManager.AllNeighbors()
+
+	peers := make([]*adminpb.PeerInfo, 0, len(neighbors))
+	for _, neighbor := range neighbors {
+		multiAddresses := make([]string, len(neighbor.PeerAddresses))
+		for i, multiAddress := range neighbor.PeerAddresses {
+			multiAddresses[i] = multiAddress.String()
+		}
+
+		peers = append(peers, &adminpb.PeerInfo{
+			Id:             neighbor.ID.String(),
+			MultiAddresses: multiAddresses,
+			Connected:      neighbor.GetConnStatus() == network.ConnStatusConnected,
+		})
+	}
+
+	return &adminpb.ListPeersResponse{
+		Peers: peers,
+	}, nil
+}
+
+// ListChains returns the chains that are managed by the node.
+func (s *Server) ListChains(_ context.Context, _ *adminpb.ListChainsRequest) (*adminpb.ListChainsResponse, error) {
+	chainsResponse := management.NewChainsResponse(deps.Protocol)
+
+	chains := make([]*adminpb.ChainInfo, len(chainsResponse.Chains))
+	for i, chain := range chainsResponse.Chains {
+		chains[i] = &adminpb.ChainInfo{
+			ForkingPointId:              chain.ForkingPointID[:],
+			LatestCommitmentId:          chain.LatestCommitmentID[:],
+			ClaimedWeight:               chain.ClaimedWeight,
+			AttestedWeight:              chain.AttestedWeight,
+			VerifiedWeight:              chain.VerifiedWeight,
+			IsMain:                      chain.IsMain,
+			IsHeaviestAttestedCandidate: chain.IsHeaviestAttestedCandidate,
+			WarpSyncMode:                chain.WarpSyncMode,
+			Engine:                      chain.Engine,
+		}
+	}
+
+	return &adminpb.ListChainsResponse{
+		Chains: chains,
+	}, nil
+}
+
+// StartChainEngine starts an engine for the candidate chain with the given forking point.
+func (s *Server) StartChainEngine(_ context.Context, request *adminpb.ChainEngineRequest) (*adminpb.ChainEngineResponse, error) {
+	forkingPointID, _, err := iotago.CommitmentIDFromBytes(request.ForkingPointId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid forking point ID: %s", err)
+	}
+
+	if err = deps.Protocol.Chains.StartCandidateEngine(forkingPointID); err != nil {
+		return nil, chainErrorStatus(err, "failed to start engine")
+	}
+
+	Component.LogWarn("chain engine started manually", "forkingPointID", forkingPointID)
+
+	return &adminpb.ChainEngineResponse{}, nil
+}
+
+// AbortChainEngine aborts the engine of the candidate chain with the given forking point.
+func (s *Server) AbortChainEngine(_ context.Context, request *adminpb.ChainEngineRequest) (*adminpb.ChainEngineResponse, error) {
+	forkingPointID, _, err := iotago.CommitmentIDFromBytes(request.ForkingPointId)
+	if err != nil {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid forking point ID: %s", err)
+	}
+
+	if err = deps.Protocol.Chains.AbortCandidateEngine(forkingPointID); err != nil {
+		return nil, chainErrorStatus(err, "failed to abort engine")
+	}
+
+	Component.LogWarn("chain engine aborted manually", "forkingPointID", forkingPointID)
+
+	return &adminpb.ChainEngineResponse{}, nil
+}
+
+// chainErrorStatus converts the given error of a chain operation into a gRPC status error.
+func chainErrorStatus(err error, message string) error {
+	switch {
+	case ierrors.Is(err, protocol.ErrorChainNotFound):
+		return status.Errorf(codes.NotFound, "%s: %s", message, err)
+	case ierrors.Is(err, protocol.ErrorMainChain), ierrors.Is(err, protocol.ErrorEngineNotRunning):
+		return status.Errorf(codes.FailedPrecondition, "%s: %s", message, err)
+	default:
+		return status.Errorf(codes.Internal, "%s: %s", message, err)
+	}
+}
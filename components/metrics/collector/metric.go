@@ -20,6 +20,9 @@ const (
 	// Counter is a cumulative metric that represents a single numerical value that only ever goes up.
 	// During metric Update the collected value is added to its current value.
 	Counter
+	// Histogram is a metric that samples observations and counts them in configurable buckets.
+	// During metric Update the collected value is observed.
+	Histogram
 )
 
 // Metric is a single metric that will be registered to prometheus registry and collected with WithCollectFunc callback.
@@ -32,9 +35,11 @@ type Metric struct {
 	Namespace       string
 	help            string
 	labels          []string
+	buckets         []float64
 	pruningExecutor *timed.TaskExecutor[string]
 	pruningDelay    time.Duration
 	collectFunc     func() (value float64, labelValues []string)
+	collectAllFunc  func(update func(value float64, labelValues ...string))
 	initValueFunc   func() (value float64, labelValues []string)
 	initFunc        func()
 
@@ -86,6 +91,23 @@ func (m *Metric) initPromMetric() {
 				Namespace: m.Namespace,
 				Help:      m.help,
 			})
+		case Histogram:
+			if len(m.labels) > 0 {
+				m.promMetric = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+					Name:      m.Name,
+					Namespace: m.Namespace,
+					Help:      m.help,
+					Buckets:   m.buckets,
+				}, m.labels)
+
+				return
+			}
+			m.promMetric = prometheus.NewHistogram(prometheus.HistogramOpts{
+				Name:      m.Name,
+				Namespace: m.Namespace,
+				Help:      m.help,
+				Buckets:   m.buckets,
+			})
 		}
 	})
 }
@@ -98,6 +120,9 @@ func (m *Metric) collect() {
 		value, labelValues := m.collectFunc()
 		m.update(value, labelValues...)
 	}
+	if m.collectAllFunc != nil {
+		m.collectAllFunc(m.update)
+	}
 }
 
 func (m *Metric) update(metricValue float64, labelValues ...string) {
@@ -130,6 +155,10 @@ func (m *Metric) metricUpdate(value float64, labelValues ...string) {
 		metric.Add(value)
 	case *prometheus.CounterVec:
 		metric.WithLabelValues(labelValues...).Add(value)
+	case prometheus.Histogram:
+		metric.Observe(value)
+	case *prometheus.HistogramVec:
+		metric.WithLabelValues(labelValues...).Observe(value)
 	}
 }
 
@@ -160,6 +189,15 @@ func (m *Metric) reset() {
 		})
 	case *prometheus.CounterVec:
 		metric.Reset()
+	case prometheus.Histogram:
+		m.promMetric = prometheus.NewHistogram(prometheus.HistogramOpts{
+			Name:      m.Name,
+			Namespace: m.Namespace,
+			Help:      m.help,
+			Buckets:   m.buckets,
+		})
+	case *prometheus.HistogramVec:
+		metric.Reset()
 	}
 }
 
@@ -174,6 +212,9 @@ func (m *Metric) deleteLabels(labels map[string]string) {
 		case Counter:
 			//nolint:forcetypeassert // we can safely assume that this is a CounterVec
 			m.promMetric.(*prometheus.CounterVec).Delete(labels)
+		case Histogram:
+			//nolint:forcetypeassert // we can safely assume that this is a HistogramVec
+			m.promMetric.(*prometheus.HistogramVec).Delete(labels)
 		}
 	}
 }
@@ -202,13 +243,20 @@ func (m *Metric) shutdown() {
 	}
 }
 
-// WithType sets the metric type: Gauge, GaugeVec, Counter, CounterVec.
+// WithType sets the metric type: Gauge, GaugeVec, Counter, CounterVec, Histogram, HistogramVec.
 func WithType(t MetricType) options.Option[Metric] {
 	return func(m *Metric) {
 		m.Type = t
 	}
 }
 
+// WithBuckets sets the upper bounds of the buckets of a Histogram metric (prometheus.DefBuckets are used if not set).
+func WithBuckets(buckets ...float64) options.Option[Metric] {
+	return func(m *Metric) {
+		m.buckets = buckets
+	}
+}
+
 // WithHelp sets the help text for the metric.
 func WithHelp(help string) options.Option[Metric] {
 	return func(m *Metric) {
@@ -248,6 +296,15 @@ func WithCollectFunc(collectFunc func() (metricValue float64, labelValues []stri
 	}
 }
 
+// WithCollectAllFunc allows to define a function that will be called each time when prometheus will scrap the data and
+// that updates the metric for an arbitrary number of label values. Should be used together with WithResetBeforeCollecting
+// when the set of label values changes over time.
+func WithCollectAllFunc(collectAllFunc func(update func(metricValue float64, labelValues ...string))) options.Option[Metric] {
+	return func(m *Metric) {
+		m.collectAllFunc = collectAllFunc
+	}
+}
+
 // WithInitValueFunc allows to set function that sets an initial value for a metric.
 func WithInitValueFunc(initValueFunc func() (metricValue float64, labelValues []string)) options.Option[Metric] {
 	return func(m *Metric) {
@@ -24,6 +24,8 @@ import (
 	"github.com/iotaledger/hive.go/ierrors"
 	"github.com/iotaledger/iota-core/components/metrics/collector"
 	"github.com/iotaledger/iota-core/pkg/daemon"
+	metricspkg "github.com/iotaledger/iota-core/pkg/metrics"
+	"github.com/iotaledger/iota-core/pkg/network/p2p"
 	"github.com/iotaledger/iota-core/pkg/protocol"
 )
 
@@ -53,9 +55,13 @@ var (
 type dependencies struct {
 	dig.In
 
-	Host      host.Host
-	Protocol  *protocol.Protocol
-	Collector *collector.Collector
+	Host                 host.Host
+	Protocol             *protocol.Protocol
+	Collector            *collector.Collector
+	TransactionLatencies *metricspkg.TransactionLatencies
+	ConflictMetrics      *metricspkg.ConflictMetrics
+	APIRouteMetrics      *metricspkg.APIRouteMetrics
+	P2PManager           *p2p.Manager
 }
 
 func run() error {
@@ -134,4 +140,8 @@ func registerMetrics() {
 	deps.Collector.RegisterCollection(SlotMetrics)
 	deps.Collector.RegisterCollection(AccountMetrics)
 	deps.Collector.RegisterCollection(SchedulerMetrics)
+	deps.Collector.RegisterCollection(MempoolMetrics)
+	deps.Collector.RegisterCollection(P2PMetrics)
+	deps.Collector.RegisterCollection(RequestMetrics)
+	deps.Collector.RegisterCollection(RestAPIMetrics)
 }
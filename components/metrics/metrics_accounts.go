@@ -4,6 +4,7 @@ import (
 	"time"
 
 	"github.com/iotaledger/hive.go/runtime/event"
+	"github.com/iotaledger/hive.go/runtime/syncutils"
 	"github.com/iotaledger/iota-core/components/metrics/collector"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
 )
@@ -13,6 +14,14 @@ const (
 
 	credits     = "credits"
 	activeSeats = "active_seats"
+	cacheHits   = "cache_hits"
+	cacheMisses = "cache_misses"
+	cacheSize   = "cache_size"
+)
+
+var (
+	cacheHitsCounter   = new(monotonicCounter)
+	cacheMissesCounter = new(monotonicCounter)
 )
 
 var AccountMetrics = collector.NewCollection(accountNamespace,
@@ -37,4 +46,58 @@ var AccountMetrics = collector.NewCollection(accountNamespace,
 			return float64(deps.Protocol.Engines.Main.Get().SybilProtection.SeatManager().OnlineCommittee().Size()), nil
 		}),
 	)),
+	collector.WithMetric(collector.NewMetric(cacheHits,
+		collector.WithType(collector.Counter),
+		collector.WithHelp("Number of account lookups that were served from the accounts cache."),
+		collector.WithCollectFunc(func() (metricValue float64, labelValues []string) {
+			if cacheMetrics := deps.Protocol.Engines.Main.Get().Ledger.AccountsCacheMetrics(); cacheMetrics != nil {
+				return float64(cacheHitsCounter.increase(cacheMetrics.Hits)), nil
+			}
+
+			return 0, nil
+		}),
+	)),
+	collector.WithMetric(collector.NewMetric(cacheMisses,
+		collector.WithType(collector.Counter),
+		collector.WithHelp("Number of account lookups that missed the accounts cache."),
+		collector.WithCollectFunc(func() (metricValue float64, labelValues []string) {
+			if cacheMetrics := deps.Protocol.Engines.Main.Get().Ledger.AccountsCacheMetrics(); cacheMetrics != nil {
+				return float64(cacheMissesCounter.increase(cacheMetrics.Misses)), nil
+			}
+
+			return 0, nil
+		}),
+	)),
+	collector.WithMetric(collector.NewMetric(cacheSize,
+		collector.WithType(collector.Gauge),
+		collector.WithHelp("Number of entries in the accounts cache."),
+		collector.WithCollectFunc(func() (metricValue float64, labelValues []string) {
+			if cacheMetrics := deps.Protocol.Engines.Main.Get().Ledger.AccountsCacheMetrics(); cacheMetrics != nil {
+				return float64(cacheMetrics.Size), nil
+			}
+
+			return 0, nil
+		}),
+	)),
 )
+
+// monotonicCounter converts the running totals of the accounts cache into the increments that are added to a prometheus
+// counter, so that the counter keeps increasing when the totals start from zero again (e.g. after an engine switch).
+type monotonicCounter struct {
+	lastTotal uint64
+	mutex     syncutils.Mutex
+}
+
+// increase returns the increment of the given total since the last call.
+func (m *monotonicCounter) increase(total uint64) uint64 {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	increment := total
+	if total >= m.lastTotal {
+		increment = total - m.lastTotal
+	}
+	m.lastTotal = total
+
+	return increment
+}
@@ -20,6 +20,11 @@ const (
 	acceptedBlocks      = "accepted_blocks"
 	transactions        = "accepted_transactions"
 	validators          = "active_validators"
+	warmStandbyLag      = "warm_standby_lag_slots"
+
+	chainCommitmentsPendingVerification = "chain_commitments_pending_verification"
+	chainBlocksPendingBooking           = "chain_blocks_pending_booking"
+	chainVerificationRate               = "chain_verification_rate"
 )
 
 var CommitmentsMetrics = collector.NewCollection(commitmentsNamespace,
@@ -78,4 +83,52 @@ var CommitmentsMetrics = collector.NewCollection(commitmentsNamespace,
 			}, event.WithWorkerPool(Component.WorkerPool))
 		}),
 	)),
+	collector.WithMetric(collector.NewMetric(warmStandbyLag,
+		collector.WithType(collector.Gauge),
+		collector.WithHelp("Number of slots the warm standby engine lags behind the latest attested commitment of its chain."),
+		collector.WithCollectFunc(func() (metricValue float64, labelValues []string) {
+			return float64(deps.Protocol.Chains.WarmStandbyLag.Get()), nil
+		}),
+	)),
+	collector.WithMetric(collector.NewMetric(chainCommitmentsPendingVerification,
+		collector.WithType(collector.Gauge),
+		collector.WithHelp("Number of commitments of each chain that are known but not verified yet."),
+		collector.WithLabels("chain"),
+		collector.WithResetBeforeCollecting(true),
+		collector.WithCollectAllFunc(func(update func(metricValue float64, labelValues ...string)) {
+			collectChainMetric(update, func(chain *protocol.Chain) float64 {
+				return float64(chain.CommitmentsPendingVerification())
+			})
+		}),
+	)),
+	collector.WithMetric(collector.NewMetric(chainBlocksPendingBooking,
+		collector.WithType(collector.Gauge),
+		collector.WithHelp("Number of blocks of each chain that were received but not processed by its engine yet."),
+		collector.WithLabels("chain"),
+		collector.WithResetBeforeCollecting(true),
+		collector.WithCollectAllFunc(func(update func(metricValue float64, labelValues ...string)) {
+			collectChainMetric(update, func(chain *protocol.Chain) float64 {
+				return float64(chain.BlocksPendingBooking())
+			})
+		}),
+	)),
+	collector.WithMetric(collector.NewMetric(chainVerificationRate,
+		collector.WithType(collector.Gauge),
+		collector.WithHelp("Number of commitments of each chain that were verified per second over the last minute."),
+		collector.WithLabels("chain"),
+		collector.WithResetBeforeCollecting(true),
+		collector.WithCollectAllFunc(func(update func(metricValue float64, labelValues ...string)) {
+			collectChainMetric(update, (*protocol.Chain).VerificationRate)
+		}),
+	)),
 )
+
+// collectChainMetric updates a metric for all chains that have a forking point, labeled by the ID of their forking
+// point.
+func collectChainMetric(update func(metricValue float64, labelValues ...string), metricValue func(chain *protocol.Chain) float64) {
+	deps.Protocol.Chains.Range(func(chain *protocol.Chain) {
+		if forkingPoint := chain.ForkingPoint.Get(); forkingPoint != nil {
+			update(metricValue(chain), forkingPoint.ID().ToHex())
+		}
+	})
+}
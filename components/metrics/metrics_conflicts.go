@@ -5,6 +5,7 @@ import (
 
 	"github.com/iotaledger/hive.go/runtime/event"
 	"github.com/iotaledger/iota-core/components/metrics/collector"
+	metricspkg "github.com/iotaledger/iota-core/pkg/metrics"
 	iotago "github.com/iotaledger/iota.go/v4"
 )
 
@@ -14,6 +15,21 @@ const (
 	resolutionTime        = "resolution_time_seconds_total"
 	allConflictCounts     = "created_total"
 	resolvedConflictCount = "resolved_total"
+	acceptedConflictCount = "accepted_total"
+	rejectedConflictCount = "rejected_total"
+	evictedConflictCount  = "evicted_total"
+	openConflictCount     = "open"
+	timeToResolution      = "time_to_resolution_seconds"
+	rejectionRate         = "rejection_rate"
+	liveSpenderCount      = "live_spenders"
+	liveSpendSetCount     = "live_spend_sets"
+	liveSpendersByState   = "live_spenders_by_state"
+	maxSpenderDepth       = "max_spender_depth"
+	maxSpendSetSize       = "max_spend_set_size"
+
+	conflictOutcomeAccepted = "accepted"
+	conflictOutcomeRejected = "rejected"
+	spenderStatePending     = "pending"
 )
 
 var ConflictMetrics = collector.NewCollection(conflictNamespace,
@@ -51,4 +67,99 @@ var ConflictMetrics = collector.NewCollection(conflictNamespace,
 			}, event.WithWorkerPool(Component.WorkerPool))
 		}),
 	)),
+	collector.WithMetric(collector.NewMetric(acceptedConflictCount,
+		collector.WithType(collector.Counter),
+		collector.WithHelp("Number of accepted conflicts"),
+		collector.WithInitFunc(func() {
+			deps.ConflictMetrics.Events.ConflictAccepted.Hook(func(_ time.Duration) {
+				deps.Collector.Increment(conflictNamespace, acceptedConflictCount)
+			}, event.WithWorkerPool(Component.WorkerPool))
+		}),
+	)),
+	collector.WithMetric(collector.NewMetric(rejectedConflictCount,
+		collector.WithType(collector.Counter),
+		collector.WithHelp("Number of rejected conflicts"),
+		collector.WithInitFunc(func() {
+			deps.ConflictMetrics.Events.ConflictRejected.Hook(func(_ time.Duration) {
+				deps.Collector.Increment(conflictNamespace, rejectedConflictCount)
+			}, event.WithWorkerPool(Component.WorkerPool))
+		}),
+	)),
+	collector.WithMetric(collector.NewMetric(evictedConflictCount,
+		collector.WithType(collector.Counter),
+		collector.WithHelp("Number of conflicts that were evicted before they were resolved"),
+		collector.WithInitFunc(func() {
+			deps.ConflictMetrics.Events.ConflictEvicted.Hook(func() {
+				deps.Collector.Increment(conflictNamespace, evictedConflictCount)
+			}, event.WithWorkerPool(Component.WorkerPool))
+		}),
+	)),
+	collector.WithMetric(collector.NewMetric(openConflictCount,
+		collector.WithType(collector.Gauge),
+		collector.WithHelp("Number of conflicts that are neither resolved nor evicted"),
+		collector.WithCollectFunc(func() (metricValue float64, labelValues []string) {
+			return float64(deps.ConflictMetrics.OpenConflicts()), nil
+		}),
+	)),
+	collector.WithMetric(collector.NewMetric(rejectionRate,
+		collector.WithType(collector.Gauge),
+		collector.WithHelp("Share of the resolved conflicts that were rejected"),
+		collector.WithCollectFunc(func() (metricValue float64, labelValues []string) {
+			return deps.ConflictMetrics.RejectionRate(), nil
+		}),
+	)),
+	collector.WithMetric(collector.NewMetric(timeToResolution,
+		collector.WithType(collector.Histogram),
+		collector.WithHelp("Time from the creation of a conflict to its resolution per outcome"),
+		collector.WithLabels("outcome"),
+		collector.WithBuckets(metricspkg.LatencyHistogramBuckets...),
+		collector.WithInitFunc(func() {
+			deps.ConflictMetrics.Events.ConflictAccepted.Hook(func(duration time.Duration) {
+				deps.Collector.Update(conflictNamespace, timeToResolution, duration.Seconds(), conflictOutcomeAccepted)
+			}, event.WithWorkerPool(Component.WorkerPool))
+			deps.ConflictMetrics.Events.ConflictRejected.Hook(func(duration time.Duration) {
+				deps.Collector.Update(conflictNamespace, timeToResolution, duration.Seconds(), conflictOutcomeRejected)
+			}, event.WithWorkerPool(Component.WorkerPool))
+		}),
+	)),
+	collector.WithMetric(collector.NewMetric(liveSpenderCount,
+		collector.WithType(collector.Gauge),
+		collector.WithHelp("Number of spenders that are currently tracked by the SpendDAG"),
+		collector.WithCollectFunc(func() (metricValue float64, labelValues []string) {
+			return float64(deps.Protocol.Engines.Main.Get().Ledger.SpendDAG().Statistics().Spenders), nil
+		}),
+	)),
+	collector.WithMetric(collector.NewMetric(liveSpendSetCount,
+		collector.WithType(collector.Gauge),
+		collector.WithHelp("Number of conflict sets that are currently tracked by the SpendDAG"),
+		collector.WithCollectFunc(func() (metricValue float64, labelValues []string) {
+			return float64(deps.Protocol.Engines.Main.Get().Ledger.SpendDAG().Statistics().SpendSets), nil
+		}),
+	)),
+	collector.WithMetric(collector.NewMetric(liveSpendersByState,
+		collector.WithType(collector.Gauge),
+		collector.WithHelp("Number of spenders that are currently tracked by the SpendDAG per acceptance state"),
+		collector.WithLabels("state"),
+		collector.WithCollectAllFunc(func(update func(metricValue float64, labelValues ...string)) {
+			statistics := deps.Protocol.Engines.Main.Get().Ledger.SpendDAG().Statistics()
+
+			update(float64(statistics.PendingSpenders), spenderStatePending)
+			update(float64(statistics.AcceptedSpenders), conflictOutcomeAccepted)
+			update(float64(statistics.RejectedSpenders), conflictOutcomeRejected)
+		}),
+	)),
+	collector.WithMetric(collector.NewMetric(maxSpenderDepth,
+		collector.WithType(collector.Gauge),
+		collector.WithHelp("Length of the longest chain of spenders in the SpendDAG that inherit from each other"),
+		collector.WithCollectFunc(func() (metricValue float64, labelValues []string) {
+			return float64(deps.Protocol.Engines.Main.Get().Ledger.SpendDAG().Statistics().MaxDepth), nil
+		}),
+	)),
+	collector.WithMetric(collector.NewMetric(maxSpendSetSize,
+		collector.WithType(collector.Gauge),
+		collector.WithHelp("Number of spenders of the largest conflict set in the SpendDAG"),
+		collector.WithCollectFunc(func() (metricValue float64, labelValues []string) {
+			return float64(deps.Protocol.Engines.Main.Get().Ledger.SpendDAG().Statistics().MaxSpendSetSize), nil
+		}),
+	)),
 )
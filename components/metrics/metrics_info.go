@@ -5,7 +5,10 @@ import (
 	"strconv"
 	"time"
 
+	"github.com/iotaledger/hive.go/lo"
 	"github.com/iotaledger/iota-core/components/metrics/collector"
+	"github.com/iotaledger/iota-core/pkg/protocol"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine"
 )
 
 const (
@@ -15,6 +18,7 @@ const (
 	nodeOS     = "node_os"
 	syncStatus = "sync_status"
 	memUsage   = "memory_usage_bytes"
+	engines    = "engines"
 )
 
 var InfoMetrics = collector.NewCollection(infoNamespace,
@@ -43,6 +47,42 @@ var InfoMetrics = collector.NewCollection(infoNamespace,
 			return 0, nil
 		}),
 	)),
+	collector.WithMetric(collector.NewMetric(engines,
+		collector.WithType(collector.Gauge),
+		collector.WithHelp("Engine instances of the node (1 for the main engine, 0 for candidate engines)."),
+		collector.WithLabels("engine", "forkingSlot"),
+		collector.WithInitFunc(func() {
+			updateEngine := func(engineInstance *engine.Engine) {
+				deps.Collector.Update(infoNamespace, engines, lo.Cond(engineInstance == deps.Protocol.Engines.Main.Get(), 1.0, 0.0), engineInstance.Alias(), strconv.FormatUint(uint64(engineInstance.ForkingSlot()), 10))
+			}
+
+			deps.Protocol.Chains.WithElements(func(chain *protocol.Chain) (shutdown func()) {
+				return chain.Engine.OnUpdate(func(_ *engine.Engine, engineInstance *engine.Engine) {
+					if engineInstance == nil {
+						return
+					}
+
+					Component.WorkerPool.Submit(func() { updateEngine(engineInstance) })
+
+					engineInstance.Shutdown.OnTrigger(func() {
+						Component.WorkerPool.Submit(func() {
+							deps.Collector.DeleteLabels(infoNamespace, engines, map[string]string{"engine": engineInstance.Alias(), "forkingSlot": strconv.FormatUint(uint64(engineInstance.ForkingSlot()), 10)})
+						})
+					})
+				})
+			})
+
+			deps.Protocol.Engines.Main.OnUpdate(func(previousMainEngine *engine.Engine, mainEngine *engine.Engine) {
+				Component.WorkerPool.Submit(func() {
+					for _, engineInstance := range []*engine.Engine{previousMainEngine, mainEngine} {
+						if engineInstance != nil && !engineInstance.Shutdown.WasTriggered() {
+							updateEngine(engineInstance)
+						}
+					}
+				})
+			})
+		}),
+	)),
 	collector.WithMetric(collector.NewMetric(memUsage,
 		collector.WithType(collector.Gauge),
 		collector.WithHelp("The memory usage in bytes of allocated heap objects"),
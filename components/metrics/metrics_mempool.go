@@ -0,0 +1,39 @@
+package metrics
+
+import (
+	"time"
+
+	"github.com/iotaledger/hive.go/runtime/event"
+	"github.com/iotaledger/iota-core/components/metrics/collector"
+	metricspkg "github.com/iotaledger/iota-core/pkg/metrics"
+)
+
+const (
+	mempoolNamespace = "mempool"
+
+	acceptanceLatency = "acceptance_latency_seconds"
+	commitmentLatency = "commitment_latency_seconds"
+)
+
+var MempoolMetrics = collector.NewCollection(mempoolNamespace,
+	collector.WithMetric(collector.NewMetric(acceptanceLatency,
+		collector.WithType(collector.Histogram),
+		collector.WithHelp("Time from the attachment of a transaction to its acceptance."),
+		collector.WithBuckets(metricspkg.LatencyHistogramBuckets...),
+		collector.WithInitFunc(func() {
+			deps.TransactionLatencies.Events.AcceptanceLatencyMeasured.Hook(func(latency time.Duration) {
+				deps.Collector.Update(mempoolNamespace, acceptanceLatency, latency.Seconds())
+			}, event.WithWorkerPool(Component.WorkerPool))
+		}),
+	)),
+	collector.WithMetric(collector.NewMetric(commitmentLatency,
+		collector.WithType(collector.Histogram),
+		collector.WithHelp("Time from the attachment of a transaction to its commitment."),
+		collector.WithBuckets(metricspkg.LatencyHistogramBuckets...),
+		collector.WithInitFunc(func() {
+			deps.TransactionLatencies.Events.CommitmentLatencyMeasured.Hook(func(latency time.Duration) {
+				deps.Collector.Update(mempoolNamespace, commitmentLatency, latency.Seconds())
+			}, event.WithWorkerPool(Component.WorkerPool))
+		}),
+	)),
+)
@@ -0,0 +1,68 @@
+package metrics
+
+import (
+	"github.com/iotaledger/iota-core/components/metrics/collector"
+	"github.com/iotaledger/iota-core/pkg/network/p2p"
+)
+
+const (
+	p2pNamespace = "p2p"
+
+	connectionsTCP  = "connections_tcp"
+	connectionsQUIC = "connections_quic"
+	neighborsTCP    = "neighbors_tcp"
+	neighborsQUIC   = "neighbors_quic"
+)
+
+var P2PMetrics = collector.NewCollection(p2pNamespace,
+	collector.WithMetric(collector.NewMetric(connectionsTCP,
+		collector.WithType(collector.Gauge),
+		collector.WithHelp("Number of open p2p connections that use the TCP transport."),
+		collector.WithCollectFunc(func() (metricValue float64, labelValues []string) {
+			return float64(connectionsCount(p2p.TransportTCP)), nil
+		}),
+	)),
+	collector.WithMetric(collector.NewMetric(connectionsQUIC,
+		collector.WithType(collector.Gauge),
+		collector.WithHelp("Number of open p2p connections that use the QUIC transport."),
+		collector.WithCollectFunc(func() (metricValue float64, labelValues []string) {
+			return float64(connectionsCount(p2p.TransportQUIC)), nil
+		}),
+	)),
+	collector.WithMetric(collector.NewMetric(neighborsTCP,
+		collector.WithType(collector.Gauge),
+		collector.WithHelp("Number of neighbors that are connected via the TCP transport."),
+		collector.WithCollectFunc(func() (metricValue float64, labelValues []string) {
+			return float64(neighborsCount(p2p.TransportTCP)), nil
+		}),
+	)),
+	collector.WithMetric(collector.NewMetric(neighborsQUIC,
+		collector.WithType(collector.Gauge),
+		collector.WithHelp("Number of neighbors that are connected via the QUIC transport."),
+		collector.WithCollectFunc(func() (metricValue float64, labelValues []string) {
+			return float64(neighborsCount(p2p.TransportQUIC)), nil
+		}),
+	)),
+)
+
+// connectionsCount returns the number of open connections of the host that use the given transport.
+func connectionsCount(transport string) (count int) {
+	for _, conn := range deps.P2PManager.P2PHost().Network().Conns() {
+		if p2p.TransportName(conn.RemoteMultiaddr()) == transport {
+			count++
+		}
+	}
+
+	return count
+}
+
+// neighborsCount returns the number of neighbors that are connected via the given transport.
+func neighborsCount(transport string) (count int) {
+	for _, neighbor := range deps.P2PManager.AllNeighbors() {
+		if neighbor.Transport() == transport {
+			count++
+		}
+	}
+
+	return count
+}
@@ -0,0 +1,39 @@
+package metrics
+
+import (
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/iotaledger/hive.go/runtime/event"
+	"github.com/iotaledger/iota-core/components/metrics/collector"
+	"github.com/iotaledger/iota-core/pkg/network/protocols/core"
+)
+
+const (
+	requestsNamespace = "requests"
+
+	incomingDropped = "incoming_dropped_total"
+	outgoingDropped = "outgoing_dropped_total"
+)
+
+var RequestMetrics = collector.NewCollection(requestsNamespace,
+	collector.WithMetric(collector.NewMetric(incomingDropped,
+		collector.WithType(collector.Counter),
+		collector.WithLabels("type"),
+		collector.WithHelp("Number of requests of neighbors that were dropped because the request budget was exhausted."),
+		collector.WithInitFunc(func() {
+			deps.Protocol.Network.Events.RequestDropped.Hook(func(requestType core.RequestType, _ peer.ID) {
+				deps.Collector.Increment(requestsNamespace, incomingDropped, string(requestType))
+			}, event.WithWorkerPool(Component.WorkerPool))
+		}),
+	)),
+	collector.WithMetric(collector.NewMetric(outgoingDropped,
+		collector.WithType(collector.Counter),
+		collector.WithLabels("type"),
+		collector.WithHelp("Number of requests that were not sent because too many requests are still unanswered."),
+		collector.WithInitFunc(func() {
+			deps.Protocol.Network.Events.OutgoingRequestDropped.Hook(func(requestType core.RequestType) {
+				deps.Collector.Increment(requestsNamespace, outgoingDropped, string(requestType))
+			}, event.WithWorkerPool(Component.WorkerPool))
+		}),
+	)),
+)
@@ -0,0 +1,39 @@
+package metrics
+
+import (
+	"strconv"
+
+	"github.com/iotaledger/hive.go/runtime/event"
+	"github.com/iotaledger/iota-core/components/metrics/collector"
+	metricspkg "github.com/iotaledger/iota-core/pkg/metrics"
+)
+
+const (
+	restAPINamespace = "restapi"
+
+	requestsTotal   = "requests_total"
+	requestDuration = "request_duration_seconds"
+)
+
+var RestAPIMetrics = collector.NewCollection(restAPINamespace,
+	collector.WithMetric(collector.NewMetric(requestsTotal,
+		collector.WithType(collector.Counter),
+		collector.WithLabels("method", "route", "status"),
+		collector.WithHelp("Number of requests handled by the REST API per route and status code."),
+		collector.WithInitFunc(func() {
+			deps.APIRouteMetrics.Events.RequestTracked.Hook(func(request *metricspkg.APIRequest) {
+				deps.Collector.Increment(restAPINamespace, requestsTotal, request.Method, request.Route, strconv.Itoa(request.StatusCode))
+			}, event.WithWorkerPool(Component.WorkerPool))
+		}),
+	)),
+	collector.WithMetric(collector.NewMetric(requestDuration,
+		collector.WithType(collector.Histogram),
+		collector.WithLabels("method", "route"),
+		collector.WithHelp("Time it took to handle the requests of the REST API per route."),
+		collector.WithInitFunc(func() {
+			deps.APIRouteMetrics.Events.RequestTracked.Hook(func(request *metricspkg.APIRequest) {
+				deps.Collector.Update(restAPINamespace, requestDuration, request.Duration.Seconds(), request.Method, request.Route)
+			}, event.WithWorkerPool(Component.WorkerPool))
+		}),
+	)),
+)
@@ -21,11 +21,15 @@ const (
 	scheduledBlockLabel            = "scheduled"
 	skippedBlockLabel              = "skipped"
 	droppedBlockLabel              = "dropped"
+	evictedBlockLabel              = "evicted"
 	enqueuedBlockLabel             = "enqueued"
 	basicBufferReadyBlockCount     = "buffer_ready_block_total" //nolint:gosec
 	basicBufferTotalSize           = "buffer_size_block_total"
 	basicBufferMaxSize             = "buffer_max_size"
+	basicBufferOldestBlockAge      = "buffer_oldest_block_age_seconds"
 	rate                           = "rate"
+	rateFactor                     = "rate_factor"
+	effectiveRate                  = "effective_rate"
 	validatorBufferTotalSize       = "validator_buffer_size_block_total"
 	validatorQueueMaxSize          = "validator_buffer_max_size"
 )
@@ -150,6 +154,11 @@ var SchedulerMetrics = collector.NewCollection(schedulerNamespace,
 
 			}, event.WithWorkerPool(Component.WorkerPool))
 
+			deps.Protocol.Events.Engine.Scheduler.BlockEvicted.Hook(func(block *blocks.Block) {
+				deps.Collector.Increment(schedulerNamespace, schedulerProcessedBlocks, evictedBlockLabel)
+
+			}, event.WithWorkerPool(Component.WorkerPool))
+
 			deps.Protocol.Events.Engine.Scheduler.BlockSkipped.Hook(func(block *blocks.Block) {
 				deps.Collector.Increment(schedulerNamespace, schedulerProcessedBlocks, skippedBlockLabel)
 
@@ -182,6 +191,13 @@ var SchedulerMetrics = collector.NewCollection(schedulerNamespace,
 			return float64(deps.Protocol.Engines.Main.Get().Scheduler.BasicBufferSize()), []string{}
 		}),
 	)),
+	collector.WithMetric(collector.NewMetric(basicBufferOldestBlockAge,
+		collector.WithType(collector.Gauge),
+		collector.WithHelp("Age of the oldest basic block in the scheduler buffer in seconds."),
+		collector.WithCollectFunc(func() (float64, []string) {
+			return deps.Protocol.Engines.Main.Get().Scheduler.BasicBufferOldestBlockAge().Seconds(), []string{}
+		}),
+	)),
 	collector.WithMetric(collector.NewMetric(rate,
 		collector.WithType(collector.Gauge),
 		collector.WithHelp("Current scheduling rate of basic blocks."),
@@ -189,6 +205,22 @@ var SchedulerMetrics = collector.NewCollection(schedulerNamespace,
 			return float64(deps.Protocol.Engines.Main.Get().CommittedAPI().ProtocolParameters().CongestionControlParameters().SchedulerRate), []string{}
 		}),
 	)),
+	collector.WithMetric(collector.NewMetric(rateFactor,
+		collector.WithType(collector.Gauge),
+		collector.WithHelp("Current factor that the scheduling rate of basic blocks is lowered by due to a local processing backlog."),
+		collector.WithCollectFunc(func() (float64, []string) {
+			return deps.Protocol.Engines.Main.Get().Scheduler.RateFactor(), []string{}
+		}),
+	)),
+	collector.WithMetric(collector.NewMetric(effectiveRate,
+		collector.WithType(collector.Gauge),
+		collector.WithHelp("Current local scheduling rate of basic blocks after the adaptation to the local processing backlog."),
+		collector.WithCollectFunc(func() (float64, []string) {
+			mainEngine := deps.Protocol.Engines.Main.Get()
+
+			return float64(mainEngine.CommittedAPI().ProtocolParameters().CongestionControlParameters().SchedulerRate) * mainEngine.Scheduler.RateFactor(), []string{}
+		}),
+	)),
 	collector.WithMetric(collector.NewMetric(validatorBufferTotalSize,
 		collector.WithType(collector.Gauge),
 		collector.WithHelp("Current number of validation blocks in the scheduling buffer."),
@@ -4,24 +4,22 @@ import (
 	"context"
 	"fmt"
 	"path/filepath"
-	"time"
 
-	"github.com/libp2p/go-libp2p"
 	"github.com/libp2p/go-libp2p/core/crypto"
 	"github.com/libp2p/go-libp2p/core/host"
 	"github.com/libp2p/go-libp2p/core/peer"
-	"github.com/libp2p/go-libp2p/p2p/net/connmgr"
-	"github.com/libp2p/go-libp2p/p2p/transport/tcp"
 	"github.com/multiformats/go-multiaddr"
 	"go.uber.org/dig"
 
 	"github.com/iotaledger/hive.go/app"
 	"github.com/iotaledger/hive.go/app/configuration"
 	hivep2p "github.com/iotaledger/hive.go/crypto/p2p"
+	"github.com/iotaledger/hive.go/crypto/pem"
 	"github.com/iotaledger/hive.go/ierrors"
 	"github.com/iotaledger/hive.go/kvstore"
 	hivedb "github.com/iotaledger/hive.go/kvstore/database"
 	"github.com/iotaledger/hive.go/runtime/event"
+	"github.com/iotaledger/iota-core/pkg/configreload"
 	"github.com/iotaledger/iota-core/pkg/daemon"
 	"github.com/iotaledger/iota-core/pkg/network"
 	"github.com/iotaledger/iota-core/pkg/network/autopeering"
@@ -57,6 +55,9 @@ type dependencies struct {
 	PeerDB               *network.DB
 	Protocol             *protocol.Protocol
 	PeerDBKVSTore        kvstore.KVStore `name:"peerDBKVStore"`
+	ReachabilityMonitor  *p2p.ReachabilityMonitor
+	IdentityManager      *p2p.IdentityManager
+	ConfigReload         *configreload.Registry
 }
 
 func initConfigParams(c *dig.Container) error {
@@ -225,6 +226,7 @@ func provide(c *dig.Container) error {
 		dig.Out
 		NodePrivateKey crypto.PrivKey `name:"nodePrivateKey"`
 		Host           host.Host
+		HostFactory    p2p.HostFactory
 	}
 
 	if err := c.Provide(func(deps p2pDeps) p2pResult {
@@ -248,42 +250,15 @@ func provide(c *dig.Container) error {
 			Component.LogInfof(`loaded existing private key for peer identity from "%s"`, privKeyFilePath)
 		}
 
-		connManager, err := connmgr.NewConnManager(
-			ParamsP2P.ConnectionManager.LowWatermark,
-			ParamsP2P.ConnectionManager.HighWatermark,
-			connmgr.WithGracePeriod(time.Minute),
-		)
+		hostFactory, err := newHostFactory()
 		if err != nil {
-			Component.LogPanicf("unable to initialize connection manager: %s", err)
-		}
-
-		createdHost, err := libp2p.New(
-			libp2p.ListenAddrStrings(ParamsP2P.BindMultiAddresses...),
-			libp2p.Identity(nodePrivateKey),
-			libp2p.Transport(tcp.NewTCPTransport),
-			libp2p.ConnectionManager(connManager),
-			libp2p.NATPortMap(),
-			// Define a custom address factory to inject external addresses to the DHT advertisements.
-			libp2p.AddrsFactory(func() func(addrs []multiaddr.Multiaddr) []multiaddr.Multiaddr {
-				var externalMultiAddrs []multiaddr.Multiaddr
-				if len(ParamsP2P.ExternalMultiAddresses) > 0 {
-					for _, externalMultiAddress := range ParamsP2P.ExternalMultiAddresses {
-						addr, err := multiaddr.NewMultiaddr(externalMultiAddress)
-						if err != nil {
-							Component.LogPanicf("unable to parse external multi address %s: %s", externalMultiAddress, err)
-						}
-
-						externalMultiAddrs = append(externalMultiAddrs, addr)
-					}
-				}
-
-				return func(addrs []multiaddr.Multiaddr) []multiaddr.Multiaddr {
-					return append(addrs, externalMultiAddrs...)
-				}
-			}()),
-		)
+			Component.LogFatal(err.Error())
+		}
+		res.HostFactory = hostFactory
+
+		createdHost, err := hostFactory(nodePrivateKey)
 		if err != nil {
-			Component.LogFatalf("unable to initialize libp2p host: %s", err)
+			Component.LogFatal(err.Error())
 		}
 		res.Host = createdHost
 
@@ -294,8 +269,39 @@ func provide(c *dig.Container) error {
 		Component.LogPanic(err.Error())
 	}
 
-	return c.Provide(func(host host.Host, peerDB *network.DB) *p2p.Manager {
+	if err := c.Provide(func(host host.Host, peerDB *network.DB) *p2p.Manager {
 		return p2p.NewManager(host, peerDB, Component.Logger)
+	}); err != nil {
+		Component.LogPanic(err.Error())
+	}
+
+	type identityManagerDeps struct {
+		dig.In
+		P2PManager      *p2p.Manager
+		PeerDB          *network.DB
+		NodePrivateKey  crypto.PrivKey `name:"nodePrivateKey"`
+		HostFactory     p2p.HostFactory
+		P2PDatabasePath string `name:"p2pDatabasePath"`
+	}
+
+	if err := c.Provide(func(deps identityManagerDeps) *p2p.IdentityManager {
+		privKeyFilePath := filepath.Join(deps.P2PDatabasePath, IdentityPrivateKeyFileName)
+
+		return p2p.NewIdentityManager(deps.P2PManager, deps.PeerDB, deps.NodePrivateKey, deps.HostFactory, func(privateKey crypto.PrivKey) error {
+			return storeIdentityPrivateKey(privKeyFilePath, privateKey)
+		}, Component.NewChildLogger("Identity"),
+			// a configured private key would not match the rotated key stored in the p2p database after a restart.
+			p2p.WithRotationEnabled(ParamsP2P.IdentityPrivateKey == ""),
+		)
+	}); err != nil {
+		Component.LogPanic(err.Error())
+	}
+
+	return c.Provide(func(host host.Host) *p2p.ReachabilityMonitor {
+		return p2p.NewReachabilityMonitor(host, Component.NewChildLogger("Reachability"),
+			p2p.WithPortMapping(ParamsP2P.NAT.PortMapping),
+			p2p.WithReachabilityService(ParamsP2P.NAT.ReachabilityService),
+		)
 	})
 }
 
@@ -320,6 +326,27 @@ func configure() error {
 		Component.LogPanicf("failed to start worker: %s", err)
 	}
 
+	identity, err := deps.IdentityManager.StoreActiveIdentity()
+	if err != nil {
+		Component.LogPanicf("failed to store the active identity: %s", err)
+	}
+	if identity.PreviousPeerID != "" {
+		Component.LogInfof("Active identity %s replaced identity %s at %s", identity.PeerID, identity.PreviousPeerID, identity.ActivatedAt)
+	}
+
+	// move the components that depend on the host to the host of a rotated identity
+	deps.IdentityManager.Events.HostSwitched.Hook(func(newHost host.Host) {
+		deps.ReachabilityMonitor.SwitchHost(newHost)
+
+		if err := deps.AutoPeeringMgr.SwitchHost(newHost); err != nil {
+			Component.LogErrorf("Failed to restart autopeering with the new host: %s", err)
+		}
+	})
+
+	deps.IdentityManager.Events.IdentityRotated.Hook(func(identity *network.Identity) {
+		Component.LogInfof("Rotated identity from %s to %s %s", identity.PreviousPeerID, identity.PeerID, identity.AdvertisedAddresses)
+	}, event.WithWorkerPool(Component.WorkerPool))
+
 	// log the p2p events
 	deps.P2PManager.Events.NeighborAdded.Hook(func(neighbor *p2p.Neighbor) {
 		Component.LogInfof("Neighbor added: %s / %s", neighbor.PeerAddresses, neighbor.ID)
@@ -329,6 +356,22 @@ func configure() error {
 		Component.LogInfof("Neighbor removed: %s / %s", neighbor.PeerAddresses, neighbor.ID)
 	}, event.WithWorkerPool(Component.WorkerPool))
 
+	// feed the latency measurements of the core protocol into the scoring of the neighbors
+	deps.Protocol.Network.OnPeerLatencyUpdated(func(id peer.ID, latency network.Latency) {
+		for _, neighbor := range deps.P2PManager.NeighborsByID([]peer.ID{id}) {
+			neighbor.SetLatency(latency)
+		}
+	})
+
+	// feed the failed proof verifications of the protocol into the scoring of the neighbors
+	deps.Protocol.PeerPenalties.OnPeerPenalized(func(id peer.ID, failedVerifications int) {
+		for _, neighbor := range deps.P2PManager.NeighborsByID([]peer.ID{id}) {
+			neighbor.SetFailedVerifications(failedVerifications)
+		}
+	})
+
+	configurePeersReload()
+
 	return nil
 }
 
@@ -351,6 +394,14 @@ func run() error {
 		Component.LogFatalf("Failed to start as daemon: %s", err)
 	}
 
+	if err := Component.Daemon().BackgroundWorker(fmt.Sprintf("%s-Reachability", Component.Name), func(ctx context.Context) {
+		if err := deps.ReachabilityMonitor.Run(ctx); err != nil {
+			Component.LogErrorf("Failed to monitor reachability: %s", err)
+		}
+	}, daemon.PriorityP2P); err != nil {
+		Component.LogFatalf("Failed to start as daemon: %s", err)
+	}
+
 	if err := Component.Daemon().BackgroundWorker(fmt.Sprintf("%s-P2PManager", Component.Name), func(ctx context.Context) {
 		defer deps.P2PManager.Shutdown()
 		defer func() {
@@ -381,6 +432,20 @@ func getMultiAddrsFromString(peers []string) ([]multiaddr.Multiaddr, error) {
 	return peersMultiAddresses, nil
 }
 
+// storeIdentityPrivateKey writes the given private key to the identity file of the p2p database.
+func storeIdentityPrivateKey(privKeyFilePath string, privateKey crypto.PrivKey) error {
+	ed25519PrivateKey, err := hivep2p.Libp2pPrivateKeyToEd25519PrivateKey(privateKey)
+	if err != nil {
+		return ierrors.Wrap(err, "unable to convert private key")
+	}
+
+	if err := pem.WriteEd25519PrivateKeyToPEMFile(privKeyFilePath, ed25519PrivateKey); err != nil {
+		return ierrors.Wrapf(err, "unable to store private key in %s", privKeyFilePath)
+	}
+
+	return nil
+}
+
 // connects to the peers defined in the config.
 func connectConfigKnownPeers() {
 	for _, p := range deps.PeeringConfigManager.Peers() {
@@ -400,3 +465,84 @@ func connectConfigKnownPeers() {
 		}
 	}
 }
+
+// configurePeersReload registers the static peers of the peering config as a reloadable parameter.
+func configurePeersReload() {
+	var peers []*p2p.PeerConfig
+	if err := deps.PeeringConfig.Unmarshal(CfgPeers, &peers); err != nil {
+		Component.LogPanicf("invalid peer config: %s", err)
+	}
+
+	configreload.Register(deps.ConfigReload, "peering", CfgPeers, peers, applyConfigPeers, func(peers []*p2p.PeerConfig) error {
+		for i, p := range peers {
+			if _, err := p2p.NewPeerConfigItem(p); err != nil {
+				return ierrors.Wrapf(err, "invalid config peer address at pos %d", i)
+			}
+		}
+
+		return nil
+	})
+}
+
+// applyConfigPeers connects to the peers that were added to and disconnects from the peers that were removed from the
+// peering config.
+func applyConfigPeers(peers []*p2p.PeerConfig) error {
+	knownPeers := make(map[string]struct{})
+	for _, p := range deps.PeeringConfigManager.Peers() {
+		knownPeers[p.ID().Key()] = struct{}{}
+	}
+
+	var err error
+	for _, p := range peers {
+		multiAddr, parseErr := multiaddr.NewMultiaddr(p.MultiAddress)
+		if parseErr != nil {
+			err = ierrors.Join(err, ierrors.Wrapf(parseErr, "invalid peer address %s", p.MultiAddress))
+
+			continue
+		}
+
+		addrInfo, parseErr := peer.AddrInfoFromP2pAddr(multiAddr)
+		if parseErr != nil {
+			err = ierrors.Join(err, ierrors.Wrapf(parseErr, "invalid peer address info %s", p.MultiAddress))
+
+			continue
+		}
+
+		if addErr := deps.PeeringConfigManager.AddPeer(multiAddr, p.Alias); addErr != nil {
+			err = ierrors.Join(err, ierrors.Wrapf(addErr, "failed to add peer %s to config manager", p.MultiAddress))
+		}
+
+		if _, known := knownPeers[addrInfo.ID.String()]; known {
+			delete(knownPeers, addrInfo.ID.String())
+
+			continue
+		}
+
+		if addErr := deps.ManualPeeringMgr.AddPeers(multiAddr); addErr != nil {
+			err = ierrors.Join(err, ierrors.Wrapf(addErr, "failed to add peer %s", p.MultiAddress))
+		}
+
+		Component.LogInfof("Added peer %s from the reloaded peering config", p.MultiAddress)
+	}
+
+	for peerIDBase58 := range knownPeers {
+		peerID, decodeErr := peer.Decode(peerIDBase58)
+		if decodeErr != nil {
+			err = ierrors.Join(err, ierrors.Wrapf(decodeErr, "invalid peer ID %s", peerIDBase58))
+
+			continue
+		}
+
+		if removeErr := deps.PeeringConfigManager.RemovePeer(peerID); removeErr != nil {
+			err = ierrors.Join(err, ierrors.Wrapf(removeErr, "failed to remove peer %s from config manager", peerID))
+		}
+
+		if removeErr := deps.ManualPeeringMgr.RemovePeer(peerID); removeErr != nil {
+			err = ierrors.Join(err, ierrors.Wrapf(removeErr, "failed to remove peer %s", peerID))
+		}
+
+		Component.LogInfof("Removed peer %s that is no longer in the reloaded peering config", peerID)
+	}
+
+	return err
+}
@@ -0,0 +1,112 @@
+package p2p
+
+import (
+	"time"
+
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/p2p/net/connmgr"
+	libp2pquic "github.com/libp2p/go-libp2p/p2p/transport/quic"
+	"github.com/libp2p/go-libp2p/p2p/transport/quicreuse"
+	"github.com/libp2p/go-libp2p/p2p/transport/tcp"
+	"github.com/multiformats/go-multiaddr"
+	"github.com/quic-go/quic-go"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/iota-core/pkg/network/p2p"
+)
+
+// quicHost is a lib-p2p host that closes its QUIC connection manager when it is closed.
+// lib-p2p does not close the connection manager on its own, which keeps the UDP sockets of the host bound for a while
+// and prevents a host of a rotated identity from listening on the same addresses.
+type quicHost struct {
+	host.Host
+
+	quicConnManager *quicreuse.ConnManager
+}
+
+// Close closes the host and its QUIC connection manager.
+func (q *quicHost) Close() error {
+	hostErr := q.Host.Close()
+
+	return ierrors.Join(hostErr, q.quicConnManager.Close())
+}
+
+// newHostFactory returns a factory that creates the lib-p2p host of the node for a given identity.
+func newHostFactory() (p2p.HostFactory, error) {
+	bindMultiAddrs, err := getMultiAddrsFromString(ParamsP2P.BindMultiAddresses)
+	if err != nil {
+		return nil, ierrors.Wrap(err, "unable to parse bind multi addresses")
+	}
+
+	switch {
+	case !ParamsP2P.QUIC.Enabled && p2p.UsesQUIC(bindMultiAddrs):
+		return nil, ierrors.New("QUIC bind multi addresses are configured, but the QUIC transport is disabled")
+	case ParamsP2P.QUIC.Enabled && !p2p.UsesQUIC(bindMultiAddrs):
+		bindMultiAddrs = append(bindMultiAddrs, p2p.QUICAddresses(bindMultiAddrs)...)
+	}
+
+	externalMultiAddrs, err := getMultiAddrsFromString(ParamsP2P.ExternalMultiAddresses)
+	if err != nil {
+		return nil, ierrors.Wrap(err, "unable to parse external multi addresses")
+	}
+
+	return func(privateKey crypto.PrivKey) (host.Host, error) {
+		connManager, err := connmgr.NewConnManager(
+			ParamsP2P.ConnectionManager.LowWatermark,
+			ParamsP2P.ConnectionManager.HighWatermark,
+			connmgr.WithGracePeriod(time.Minute),
+		)
+		if err != nil {
+			return nil, ierrors.Wrap(err, "unable to initialize connection manager")
+		}
+
+		hostOptions := []libp2p.Option{
+			libp2p.ListenAddrs(bindMultiAddrs...),
+			libp2p.Identity(privateKey),
+			libp2p.Transport(tcp.NewTCPTransport),
+			libp2p.ConnectionManager(connManager),
+			// Define a custom address factory to inject external addresses to the DHT advertisements and to advertise
+			// the addresses of all listeners in a consistent order.
+			libp2p.AddrsFactory(func(addrs []multiaddr.Multiaddr) []multiaddr.Multiaddr {
+				return p2p.AdvertisedAddresses(addrs, externalMultiAddrs)
+			}),
+		}
+
+		if ParamsP2P.NAT.PortMapping {
+			hostOptions = append(hostOptions, libp2p.NATPortMap())
+		}
+
+		if ParamsP2P.NAT.ReachabilityService {
+			hostOptions = append(hostOptions, libp2p.EnableNATService())
+		}
+
+		var quicConnManager *quicreuse.ConnManager
+		if ParamsP2P.QUIC.Enabled {
+			hostOptions = append(hostOptions,
+				libp2p.Transport(libp2pquic.NewTransport),
+				libp2p.QUICReuse(func(statelessResetKey quic.StatelessResetKey, tokenKey quic.TokenGeneratorKey) (*quicreuse.ConnManager, error) {
+					quicConnManager, err = quicreuse.NewConnManager(statelessResetKey, tokenKey)
+
+					return quicConnManager, err
+				}),
+			)
+		}
+
+		createdHost, err := libp2p.New(hostOptions...)
+		if err != nil {
+			if quicConnManager != nil {
+				_ = quicConnManager.Close()
+			}
+
+			return nil, ierrors.Wrap(err, "unable to initialize libp2p host")
+		}
+
+		if quicConnManager != nil {
+			return &quicHost{Host: createdHost, quicConnManager: quicConnManager}, nil
+		}
+
+		return createdHost, nil
+	}, nil
+}
@@ -13,7 +13,12 @@ const (
 // ParametersP2P contains the definition of configuration parameters used by the p2p plugin.
 type ParametersP2P struct {
 	// BindAddress defines on which multi addresses the p2p service should listen on.
-	BindMultiAddresses []string `default:"/ip4/0.0.0.0/tcp/14666,/ip6/::/tcp/14666" usage:"the bind multi addresses for p2p connections"`
+	BindMultiAddresses []string `default:"/ip4/0.0.0.0/tcp/14666,/ip6/::/tcp/14666" usage:"the bind multi addresses for p2p connections (TCP and QUIC, e.g. /ip4/0.0.0.0/udp/14666/quic-v1)"`
+
+	QUIC struct {
+		// Enabled defines whether the QUIC transport is enabled.
+		Enabled bool `default:"false" usage:"whether to enable the QUIC transport (listens on the UDP ports of the TCP bind multi addresses if no QUIC bind multi addresses are configured)"`
+	} `name:"quic"`
 
 	ConnectionManager struct {
 		// Defines the high watermark to use within the connection manager.
@@ -22,11 +27,18 @@ type ParametersP2P struct {
 		LowWatermark int `default:"5" usage:"the minimum connections count to hold after the high watermark was reached"`
 	}
 
+	NAT struct {
+		// PortMapping defines whether to map the p2p port on the router via UPnP/NAT-PMP.
+		PortMapping bool `default:"false" usage:"whether to map the p2p port on the router via UPnP/NAT-PMP"`
+		// ReachabilityService defines whether to help other peers to determine their reachability by dialing them back.
+		ReachabilityService bool `default:"false" usage:"whether to help other peers to determine their reachability by dialing them back"`
+	} `name:"nat"`
+
 	// ExternalMultiAddress defines additional p2p multiaddresses to be advertised via DHT.
 	ExternalMultiAddresses []string `default:"" usage:"external reacheable multi addresses advertised to the network"`
 
 	// Defines the private key used to derive the node identity (optional).
-	IdentityPrivateKey string `default:"" usage:"private key used to derive the node identity (optional)"`
+	IdentityPrivateKey string `default:"" usage:"private key used to derive the node identity (optional, disables the rotation of the identity)"`
 
 	Database struct {
 		// Defines the path to the p2p database.
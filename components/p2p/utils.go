@@ -16,7 +16,7 @@ func initPeerDB() (peerDB *network.DB, peerDBKVStore kvstore.KVStore, err error)
 		return nil, nil, ierrors.Wrap(err, "invalid peer database path")
 	}
 
-	db, err := database.NewRocksDB(ParamsP2P.Database.Path)
+	db, err := database.NewRocksDB(ParamsP2P.Database.Path, database.Settings{})
 	if err != nil {
 		return nil, nil, ierrors.Wrap(err, "error creating peer database")
 	}
@@ -13,25 +13,44 @@ import (
 	"github.com/iotaledger/hive.go/app"
 	"github.com/iotaledger/hive.go/ierrors"
 	hivedb "github.com/iotaledger/hive.go/kvstore/database"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/hive.go/log"
+	"github.com/iotaledger/hive.go/runtime/module"
+	"github.com/iotaledger/hive.go/runtime/syncutils"
 	"github.com/iotaledger/hive.go/runtime/workerpool"
+	"github.com/iotaledger/iota-core/pkg/configreload"
 	"github.com/iotaledger/iota-core/pkg/core/account"
 	"github.com/iotaledger/iota-core/pkg/daemon"
+	"github.com/iotaledger/iota-core/pkg/loglevels"
+	"github.com/iotaledger/iota-core/pkg/metrics"
 	"github.com/iotaledger/iota-core/pkg/model"
 	"github.com/iotaledger/iota-core/pkg/network/p2p"
+	"github.com/iotaledger/iota-core/pkg/network/protocols/core"
 	"github.com/iotaledger/iota-core/pkg/protocol"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/attestation/slotattestation"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/congestioncontrol/scheduler/drr"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/filter/postsolidfilter"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/filter/presolidfilter"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/filter/presolidfilter/presolidblockfilter"
+	ledger1 "github.com/iotaledger/iota-core/pkg/protocol/engine/ledger/ledger"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/mempool"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/notarization"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/notarization/slotnotarization"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/syncmanager"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/syncmanager/trivialsyncmanager"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/tipmanager"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/tipselection"
+	tipselectionv1 "github.com/iotaledger/iota-core/pkg/protocol/engine/tipselection/v1"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/upgrade/signalingupgradeorchestrator"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/utxoledger"
+	"github.com/iotaledger/iota-core/pkg/protocol/sybilprotection/activitytracker"
+	"github.com/iotaledger/iota-core/pkg/protocol/sybilprotection/seatmanager/topstakers"
 	"github.com/iotaledger/iota-core/pkg/protocol/sybilprotection/sybilprotectionv1"
 	"github.com/iotaledger/iota-core/pkg/storage"
 	"github.com/iotaledger/iota-core/pkg/storage/database"
+	"github.com/iotaledger/iota-core/pkg/storage/permanent"
 	"github.com/iotaledger/iota-core/pkg/storage/prunable"
 	iotago "github.com/iotaledger/iota.go/v4"
 )
@@ -48,6 +67,9 @@ func init() {
 	}
 }
 
+// transactionLatenciesRetentionWindow defines for how many slots the aggregated transaction latencies are kept.
+const transactionLatenciesRetentionWindow = 100
+
 var (
 	Component *app.Component
 	deps      dependencies
@@ -56,7 +78,11 @@ var (
 type dependencies struct {
 	dig.In
 
-	Protocol *protocol.Protocol
+	Protocol             *protocol.Protocol
+	LogLevels            *loglevels.Registry
+	ConfigReload         *configreload.Registry
+	TransactionLatencies *metrics.TransactionLatencies
+	ConflictMetrics      *metrics.ConflictMetrics
 }
 
 type jsonProtocolParameters struct {
@@ -119,6 +145,24 @@ func initConfigParams(c *dig.Container) error {
 }
 
 func provide(c *dig.Container) error {
+	if err := c.Provide(loglevels.NewRegistry); err != nil {
+		return err
+	}
+
+	if err := c.Provide(func() *metrics.TransactionLatencies {
+		return metrics.NewTransactionLatencies(transactionLatenciesRetentionWindow)
+	}); err != nil {
+		return err
+	}
+
+	if err := c.Provide(metrics.NewConflictMetrics); err != nil {
+		return err
+	}
+
+	if err := c.Provide(metrics.NewAPIRouteMetrics); err != nil {
+		return err
+	}
+
 	type protocolDeps struct {
 		dig.In
 
@@ -146,20 +190,60 @@ func provide(c *dig.Container) error {
 			protocol.WithStorageOptions(
 				storage.WithDBEngine(deps.DatabaseEngine),
 				storage.WithPruningDelay(iotago.EpochIndex(ParamsDatabase.PruningThreshold)),
+				storage.WithForkRetainedEpochs(iotago.EpochIndex(ParamsDatabase.ForkRetainedEpochs)),
 				storage.WithPruningSizeEnable(ParamsDatabase.Size.Enabled),
 				storage.WithPruningSizeMaxTargetSizeBytes(pruningTargetDatabaseSizeBytes),
 				storage.WithPruningSizeReductionPercentage(ParamsDatabase.Size.ReductionPercentage),
 				storage.WithPruningSizeCooldownTime(ParamsDatabase.Size.CooldownTime),
+				storage.WithPermanentDBSettings(database.Settings{
+					BloomFilterBitsPerKey:        ParamsDatabase.BloomFilter.PermanentBitsPerKey,
+					TombstoneCompactionThreshold: tombstoneCompactionThreshold(deps.DatabaseEngine),
+				}),
+				storage.WithPrunableDBSettings(database.Settings{
+					BloomFilterBitsPerKey:        ParamsDatabase.BloomFilter.PrunableBitsPerKey,
+					TombstoneCompactionThreshold: tombstoneCompactionThreshold(deps.DatabaseEngine),
+				}),
 				storage.WithBucketManagerOptions(
 					prunable.WithMaxOpenDBs(ParamsDatabase.MaxOpenDBs),
 				),
+				storage.WithPermanentOptions(
+					permanent.WithUTXOLedgerOptions(
+						utxoledger.WithUnspentFilterEnabled(ParamsDatabase.UnspentOutputFilter.Enabled),
+						utxoledger.WithUnspentFilterFalsePositiveRate(ParamsDatabase.UnspentOutputFilter.FalsePositiveRate),
+						utxoledger.WithUnspentFilterCapacity(ParamsDatabase.UnspentOutputFilter.Capacity),
+					),
+					permanent.WithOutputIndexEnabled(ParamsDatabase.OutputIndex.Enabled),
+				),
 			),
 			protocol.WithSnapshotPath(ParamsProtocol.Snapshot.Path),
+			protocol.WithWarmStandby(ParamsProtocol.WarmStandby),
+			protocol.WithWarpSyncStateDiffs(ParamsProtocol.WarpSyncStateDiffs),
+			protocol.WithCommitmentBroadcastRetryInterval(ParamsProtocol.Network.CommitmentBroadcastRetryInterval),
+			protocol.WithCommitmentBroadcastMaxRetries(ParamsProtocol.Network.CommitmentBroadcastMaxRetries),
+			protocol.WithMaxFailedVerificationsPerChain(ParamsProtocol.Network.MaxFailedVerificationsPerChain),
+			protocol.WithChainBlockBufferSize(ParamsProtocol.ChainBlockBuffer.Size),
+			protocol.WithChainBlockBufferMaxPendingTasks(ParamsProtocol.ChainBlockBuffer.MaxPendingTasks),
+			protocol.WithChainBlockBufferSpillToDisk(ParamsProtocol.ChainBlockBuffer.SpillToDisk),
+			protocol.WithNetworkProtocolOptions(
+				core.WithPingInterval(ParamsProtocol.Network.PingInterval),
+				core.WithPingTimeout(ParamsProtocol.Network.PingTimeout),
+				core.WithMaxIncomingRequestsPerPeer(ParamsProtocol.Network.MaxIncomingRequestsPerPeer),
+				core.WithMaxIncomingRequests(ParamsProtocol.Network.MaxIncomingRequests),
+				core.WithMaxOutgoingBlockRequests(ParamsProtocol.Network.MaxOutgoingBlockRequests),
+				core.WithBlockRequestTimeout(ParamsProtocol.Network.BlockRequestTimeout),
+			),
 			protocol.WithSybilProtectionProvider(
-				sybilprotectionv1.NewProvider(),
+				sybilprotectionv1.NewProvider(
+					sybilprotectionv1.WithSeatManagerProvider(
+						topstakers.NewProvider(
+							topstakers.WithActivityWindow(ParamsProtocol.SybilProtection.ActivityWindow),
+							topstakers.WithActivityHysteresis(ParamsProtocol.SybilProtection.ActivityHysteresis),
+						),
+					),
+				),
 			),
 			protocol.WithNotarizationProvider(
-				slotnotarization.NewProvider(),
+				slotnotarization.NewProvider(slotnotarization.WithMinCommittableAge(iotago.SlotIndex(ParamsProtocol.Notarization.MinCommittableAge))),
 			),
 			protocol.WithAttestationProvider(
 				slotattestation.NewProvider(),
@@ -167,143 +251,358 @@ func provide(c *dig.Container) error {
 			protocol.WithPreSolidFilterProvider(
 				presolidblockfilter.NewProvider(
 					presolidblockfilter.WithMaxAllowedWallClockDrift(ParamsProtocol.Filter.MaxAllowedClockDrift),
+					presolidblockfilter.WithIssuerReputationThreshold(ParamsProtocol.Filter.IssuerReputation.Threshold),
+					presolidblockfilter.WithIssuerReputationHalfLife(iotago.SlotIndex(ParamsProtocol.Filter.IssuerReputation.HalfLife)),
+				),
+			),
+			protocol.WithLedgerProvider(
+				ledger1.NewProvider(
+					ledger1.WithSpendDAGPersistence(ParamsProtocol.SpendDAGPersistence),
+					ledger1.WithMemPoolWriteAheadLog(ParamsProtocol.MemPoolWriteAheadLog),
+					ledger1.WithMergeToMaster(ParamsProtocol.MergeToMaster),
 				),
 			),
 			protocol.WithUpgradeOrchestratorProvider(
 				signalingupgradeorchestrator.NewProvider(signalingupgradeorchestrator.WithProtocolParameters(deps.ProtocolParameters...)),
 			),
+			protocol.WithSyncManagerProvider(
+				trivialsyncmanager.NewProvider(trivialsyncmanager.WithFinalizationStallThreshold(iotago.SlotIndex(ParamsProtocol.FinalizationStallThreshold))),
+			),
+			protocol.WithSchedulerProvider(
+				drr.NewProvider(
+					drr.WithMaxBlockLatency(ParamsProtocol.Scheduler.MaxBlockLatency),
+					drr.WithRateAdaptation(ParamsProtocol.Scheduler.RateAdaptation.HighWatermark, ParamsProtocol.Scheduler.RateAdaptation.LowWatermark),
+					drr.WithMinRateFactor(ParamsProtocol.Scheduler.RateAdaptation.MinRateFactor),
+					drr.WithRateAdaptationInterval(ParamsProtocol.Scheduler.RateAdaptation.Interval),
+					drr.WithNewAccountBurst(iotago.WorkScore(ParamsProtocol.Scheduler.NewAccountBurst.Work), ParamsProtocol.Scheduler.NewAccountBurst.MaxAccountsPerSlot),
+				),
+			),
+			protocol.WithTipSelectionProvider(
+				tipSelectionProvider(),
+			),
 		)
 	})
 }
 
+// tombstoneCompactionThreshold returns the tombstone compaction threshold of the databases, which is only supported by
+// the pebble engine (RocksDB drops the tombstones of the deleted prefixes with its periodic compactions).
+func tombstoneCompactionThreshold(dbEngine hivedb.Engine) int {
+	if dbEngine != database.EnginePebble {
+		return 0
+	}
+
+	return ParamsDatabase.Pebble.TombstoneCompactionThreshold
+}
+
+// tipSelectionProvider returns the provider of the tip selection that is configured for the node.
+func tipSelectionProvider() module.Provider[*engine.Engine, tipselection.TipSelection] {
+	if ParamsProtocol.TipSelection.Weighted {
+		return tipselectionv1.NewWeightedProvider()
+	}
+
+	return tipselectionv1.NewProvider()
+}
+
 func configure() error {
+	networkLogger := newModuleLogger("Network")
+	engineLogger := newModuleLogger("Engine")
+	filterLogger := newModuleLogger("Filter")
+	blockDAGLogger := newModuleLogger("BlockDAG")
+	bookerLogger := newModuleLogger("Booker")
+	tipManagerLogger := newModuleLogger("TipManager")
+	consensusLogger := newModuleLogger("Consensus")
+	notarizationLogger := newModuleLogger("Notarization")
+	schedulerLogger := newModuleLogger("Scheduler")
+	sybilProtectionLogger := newModuleLogger("SybilProtection")
+
+	deps.LogLevels.Register("ChainManager", deps.Protocol.Chains.Logger)
+
+	// the modules of the main engine have their own loggers, which are replaced whenever the main engine changes.
+	deps.Protocol.Engines.Main.WithNonEmptyValue(func(mainEngine *engine.Engine) (shutdown func()) {
+		return lo.Batch(
+			deps.LogLevels.RegisterModule("Engine", mainEngine),
+			deps.LogLevels.RegisterModule("Booker", mainEngine.Booker),
+			deps.LogLevels.RegisterModule("Scheduler", mainEngine.Scheduler),
+			deps.LogLevels.RegisterModule("Notarization", mainEngine.Notarization),
+		)
+	})
+
 	deps.Protocol.Network.OnBlockReceived(func(block *model.Block, source peer.ID) {
-		Component.LogDebugf("BlockReceived: %s", block.ID())
+		networkLogger.LogDebug("BlockReceived", "blockID", block.ID(), "peer", source)
 	})
 
 	deps.Protocol.Events.Engine.BlockProcessed.Hook(func(blockID iotago.BlockID) {
-		Component.LogDebugf("BlockProcessed, blockID: %s", blockID)
+		engineLogger.LogDebug("BlockProcessed", "blockID", blockID)
 	})
 
 	deps.Protocol.Events.Engine.AcceptedBlockProcessed.Hook(func(block *blocks.Block) {
-		Component.LogDebugf("AcceptedBlockProcessed, blockID: %s", block.ID())
+		engineLogger.LogDebug("AcceptedBlockProcessed", "blockID", block.ID())
 	})
 
 	deps.Protocol.Events.Engine.PreSolidFilter.BlockPreFiltered.Hook(func(event *presolidfilter.BlockPreFilteredEvent) {
-		Component.LogDebugf("BlockPreFiltered, blockID: %s, reason: %s", event.Block.ID(), event.Reason.Error())
+		filterLogger.LogDebug("BlockPreFiltered", "blockID", event.Block.ID(), "peer", event.Source, "reason", event.Reason)
 	})
 
 	deps.Protocol.Events.Engine.PreSolidFilter.BlockPreAllowed.Hook(func(block *model.Block) {
-		Component.LogDebugf("BlockPreAllowed, blockID: %s", block.ID())
+		filterLogger.LogDebug("BlockPreAllowed", "blockID", block.ID())
 	})
 
 	deps.Protocol.Events.Engine.PostSolidFilter.BlockAllowed.Hook(func(block *blocks.Block) {
-		Component.LogDebugf("PostSolidFilter.BlockAllowed, blockID: %s", block.ID())
+		filterLogger.LogDebug("PostSolidFilter.BlockAllowed", "blockID", block.ID())
 	})
 
 	deps.Protocol.Events.Engine.PostSolidFilter.BlockFiltered.Hook(func(event *postsolidfilter.BlockFilteredEvent) {
-		Component.LogWarnf("PostSolidFilter.BlockFiltered, blockID: %s, reason: %s", event.Block.ID(), event.Reason.Error())
+		filterLogger.LogWarn("PostSolidFilter.BlockFiltered", "blockID", event.Block.ID(), "reason", event.Reason)
 	})
 
 	deps.Protocol.Events.Engine.TipManager.BlockAdded.Hook(func(tip tipmanager.TipMetadata) {
-		Component.LogDebugf("TipManager.BlockAdded, blockID: %s, isStrong: %v, isWeak: %v", tip.ID(), tip.IsStrongTip(), tip.IsWeakTip())
+		tipManagerLogger.LogDebug("TipManager.BlockAdded", "blockID", tip.ID(), "isStrong", tip.IsStrongTip(), "isWeak", tip.IsWeakTip())
 	})
 
 	deps.Protocol.Events.Engine.BlockDAG.BlockSolid.Hook(func(block *blocks.Block) {
-		Component.LogDebugf("BlockDAG.BlockSolid, blockID: %s", block.ID())
+		blockDAGLogger.LogDebug("BlockDAG.BlockSolid", "blockID", block.ID())
 	})
 
 	deps.Protocol.Events.Engine.BlockDAG.BlockInvalid.Hook(func(block *blocks.Block, err error) {
-		Component.LogDebugf("BlockDAG.BlockInvalid, blockID: %s, error: %v", block.ID(), err.Error())
+		blockDAGLogger.LogDebug("BlockDAG.BlockInvalid", "blockID", block.ID(), "err", err)
 	})
 
 	deps.Protocol.Events.Engine.Booker.BlockBooked.Hook(func(block *blocks.Block) {
-		Component.LogDebugf("BlockBooked, blockID: %s", block.ID())
+		bookerLogger.LogDebug("BlockBooked", "blockID", block.ID())
 	})
 
 	deps.Protocol.Events.Engine.Booker.BlockInvalid.Hook(func(block *blocks.Block, err error) {
-		Component.LogDebugf("BlockInvalid in booker, blockID: %s, error: %v", block.ID(), err.Error())
+		bookerLogger.LogWarn("BlockInvalid", "blockID", block.ID(), "err", err)
 	})
 
+	deps.Protocol.Events.Engine.Booker.TransactionInvalid.Hook(func(transaction mempool.TransactionMetadata, reason error) {
+		bookerLogger.LogWarn("TransactionInvalid", "transactionID", transaction.ID(), "err", reason)
+	})
+
+	configureTransactionLatencies()
+	configureConflictMetrics()
+
 	deps.Protocol.Events.Engine.BlockGadget.BlockPreAccepted.Hook(func(block *blocks.Block) {
-		Component.LogDebugf("BlockPreAccepted, blockID: %s", block.ID())
+		consensusLogger.LogDebug("BlockPreAccepted", "blockID", block.ID())
 	})
 
 	deps.Protocol.Events.Engine.BlockGadget.BlockAccepted.Hook(func(block *blocks.Block) {
-		Component.LogDebugf("BlockAccepted, blockID: %s", block.ID())
+		consensusLogger.LogDebug("BlockAccepted", "blockID", block.ID())
 	})
 
 	deps.Protocol.Events.Engine.BlockGadget.BlockPreConfirmed.Hook(func(block *blocks.Block) {
-		Component.LogDebugf("BlockPreConfirmed, blockID: %s", block.ID())
+		consensusLogger.LogDebug("BlockPreConfirmed", "blockID", block.ID())
 	})
 
 	deps.Protocol.Events.Engine.BlockGadget.BlockConfirmed.Hook(func(block *blocks.Block) {
-		Component.LogDebugf("BlockConfirmed, blockID: %s", block.ID())
+		consensusLogger.LogDebug("BlockConfirmed", "blockID", block.ID())
 	})
 
 	deps.Protocol.Events.Engine.Clock.AcceptedTimeUpdated.Hook(func(time time.Time) {
-		Component.LogDebugf("AcceptedTimeUpdated, slot: %d @ %s", deps.Protocol.CommittedAPI().TimeProvider().SlotFromTime(time), time)
+		consensusLogger.LogDebug("AcceptedTimeUpdated", "slot", deps.Protocol.CommittedAPI().TimeProvider().SlotFromTime(time), "time", time)
 	})
 
 	deps.Protocol.Events.Engine.Clock.ConfirmedTimeUpdated.Hook(func(time time.Time) {
-		Component.LogDebugf("ConfirmedTimeUpdated, slot: %d @ %s", deps.Protocol.CommittedAPI().TimeProvider().SlotFromTime(time), time)
+		consensusLogger.LogDebug("ConfirmedTimeUpdated", "slot", deps.Protocol.CommittedAPI().TimeProvider().SlotFromTime(time), "time", time)
 	})
 
-	deps.Protocol.Events.Engine.Notarization.SlotCommitted.Hook(func(details *notarization.SlotCommittedDetails) {
-		Component.LogInfof("SlotCommitted, commitmentID: %s, slot: %d", details.Commitment.ID(), details.Commitment.Slot())
+	deps.Protocol.Events.Engine.SlotGadget.SlotFinalized.Hook(func(slot iotago.SlotIndex) {
+		consensusLogger.LogInfo("SlotFinalized", "slot", slot)
 	})
 
-	deps.Protocol.Events.Engine.SlotGadget.SlotFinalized.Hook(func(slot iotago.SlotIndex) {
-		Component.LogInfof("SlotFinalized, slot: %d", slot)
+	deps.Protocol.Events.Engine.SyncManager.FinalizationStalled.Hook(func(stall *syncmanager.FinalizationStall) {
+		consensusLogger.LogWarn("FinalizationStalled", "lastAcceptedBlockSlot", stall.LastAcceptedBlockSlot, "latestFinalizedSlot", stall.LatestFinalizedSlot, "lag", stall.Lag(), "latestCommitmentSlot", stall.LatestCommitmentSlot, "missingAttestations", stall.MissingAttestations)
+	})
+
+	deps.Protocol.Events.Engine.Notarization.SlotCommitted.Hook(func(details *notarization.SlotCommittedDetails) {
+		notarizationLogger.LogInfo("SlotCommitted", "commitmentID", details.Commitment.ID(), "slot", details.Commitment.Slot())
 	})
 
 	deps.Protocol.Events.Engine.Scheduler.BlockScheduled.Hook(func(block *blocks.Block) {
-		Component.LogDebugf("BlockScheduled, blockID: %s", block.ID())
+		schedulerLogger.LogDebug("BlockScheduled", "blockID", block.ID())
 	})
 
 	deps.Protocol.Events.Engine.Scheduler.BlockDropped.Hook(func(block *blocks.Block, err error) {
-		Component.LogDebugf("BlockDropped, blockID: %s, reason: %s", block.ID(), err)
+		schedulerLogger.LogDebug("BlockDropped", "blockID", block.ID(), "reason", err)
 	})
 
 	deps.Protocol.Events.Engine.Scheduler.BlockSkipped.Hook(func(block *blocks.Block) {
-		Component.LogDebugf("BlockSkipped, blockID: %s", block.ID())
+		schedulerLogger.LogDebug("BlockSkipped", "blockID", block.ID())
 	})
 
-	deps.Protocol.Network.OnCommitmentRequestReceived(func(commitmentID iotago.CommitmentID, id peer.ID) {
-		Component.LogDebugf("SlotCommitmentRequestReceived: %s", commitmentID)
+	deps.Protocol.Events.Engine.Scheduler.BlockEvicted.Hook(func(block *blocks.Block) {
+		schedulerLogger.LogDebug("BlockEvicted", "blockID", block.ID(), "issuingTime", block.IssuingTime())
 	})
 
-	deps.Protocol.Network.OnCommitmentReceived(func(commitment *model.Commitment, id peer.ID) {
-		Component.LogDebugf("SlotCommitmentReceived: %s", commitment.ID())
+	deps.Protocol.Network.OnCommitmentRequestReceived(func(commitmentID iotago.CommitmentID, source peer.ID) {
+		networkLogger.LogDebug("SlotCommitmentRequestReceived", "commitmentID", commitmentID, "peer", source)
 	})
 
-	deps.Protocol.Events.Engine.SybilProtection.CommitteeSelected.Hook(func(committee *account.Accounts, epoch iotago.EpochIndex) {
-		Component.LogInfof("CommitteeSelected, epoch: %d, committeeIDs: %s, reused: %t", epoch, committee.IDs(), committee.IsReused())
+	deps.Protocol.Network.OnCommitmentReceived(func(commitment *model.Commitment, source peer.ID) {
+		networkLogger.LogDebug("SlotCommitmentReceived", "commitmentID", commitment.ID(), "peer", source)
 	})
 
-	deps.Protocol.Events.Engine.SybilProtection.RewardsCommitted.Hook(func(epoch iotago.EpochIndex) {
-		Component.LogInfof("RewardsCommitted, epoch: %d", epoch)
+	deps.Protocol.Events.Engine.SybilProtection.CommitteeSelected.Hook(func(committee *account.Accounts, epoch iotago.EpochIndex) {
+		sybilProtectionLogger.LogInfo("CommitteeSelected", "epoch", epoch, "committeeIDs", committee.IDs(), "reused", committee.IsReused())
 	})
 
-	deps.Protocol.Events.Engine.Booker.BlockInvalid.Hook(func(block *blocks.Block, err error) {
-		Component.LogWarnf("Booker BlockInvalid, blockID: %s, error: %s", block.ID(), err.Error())
+	deps.Protocol.Events.Engine.SybilProtection.RewardsCommitted.Hook(func(epoch iotago.EpochIndex) {
+		sybilProtectionLogger.LogInfo("RewardsCommitted", "epoch", epoch)
 	})
 
 	deps.Protocol.Events.Engine.SeatManager.OnlineCommitteeSeatAdded.Hook(func(seatIndex account.SeatIndex, account iotago.AccountID) {
-		Component.LogWarnf("OnlineCommitteeSeatAdded, accountID: %s, seatIndex: %d", account.ToHex(), seatIndex)
+		sybilProtectionLogger.LogWarn("OnlineCommitteeSeatAdded", "accountID", account, "seatIndex", seatIndex)
 	})
 
 	deps.Protocol.Events.Engine.SeatManager.OnlineCommitteeSeatRemoved.Hook(func(seatIndex account.SeatIndex) {
-		Component.LogWarnf("OnlineCommitteeSeatRemoved, seatIndex: %d", seatIndex)
+		sybilProtectionLogger.LogWarn("OnlineCommitteeSeatRemoved", "seatIndex", seatIndex)
 	})
 
-	deps.Protocol.Events.Engine.Booker.TransactionInvalid.Hook(func(transaction mempool.TransactionMetadata, reason error) {
-		Component.LogWarnf("TransactionInvalid, transactionID: %s, error: %s", transaction.ID(), reason.Error())
+	deps.Protocol.Events.Engine.SeatManager.OnlineWeightChanged.Hook(func(change *activitytracker.OnlineWeightChange) {
+		sybilProtectionLogger.LogInfo("OnlineWeightChanged", "seatIndex", change.Seat, "online", change.Online, "onlineSeats", change.OnlineSeats, "previousOnlineSeats", change.PreviousOnlineSeats, "acceptanceThreshold", change.AcceptanceThreshold, "previousAcceptanceThreshold", change.PreviousAcceptanceThreshold)
 	})
 
+	configurePruningBySizeReload()
+
 	return nil
 }
 
+// pruningBySizeSettings contains the current settings of the pruning by database size that can be changed at runtime.
+type pruningBySizeSettings struct {
+	enabled             bool
+	targetSizeBytes     int64
+	reductionPercentage float64
+	cooldownTime        time.Duration
+	mutex               syncutils.RWMutex
+}
+
+// update changes the settings with the given function and applies them to the storage of all engines.
+func (p *pruningBySizeSettings) update(updateFunc func()) error {
+	p.mutex.Lock()
+	updateFunc()
+	p.mutex.Unlock()
+
+	for _, chain := range deps.Protocol.Chains.ToSlice() {
+		if chainEngine := chain.Engine.Get(); chainEngine != nil {
+			p.applyTo(chainEngine.Storage)
+		}
+	}
+
+	return nil
+}
+
+// applyTo applies the settings to the given storage.
+func (p *pruningBySizeSettings) applyTo(storageInstance *storage.Storage) {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	storageInstance.SetPruningSizeOptions(p.enabled, p.targetSizeBytes, p.reductionPercentage, p.cooldownTime)
+}
+
+// configurePruningBySizeReload registers the parameters of the pruning by database size as reloadable parameters.
+func configurePruningBySizeReload() {
+	targetSizeBytes, err := bytes.Parse(ParamsDatabase.Size.TargetSize)
+	if err != nil {
+		Component.LogPanicf("parameter %s invalid", Component.App().Config().GetParameterPath(&(ParamsDatabase.Size.TargetSize)))
+	}
+
+	settings := &pruningBySizeSettings{
+		enabled:             ParamsDatabase.Size.Enabled,
+		targetSizeBytes:     targetSizeBytes,
+		reductionPercentage: ParamsDatabase.Size.ReductionPercentage,
+		cooldownTime:        ParamsDatabase.Size.CooldownTime,
+	}
+
+	// engines that are created after a reload need to use the reloaded settings as well
+	deps.Protocol.Chains.WithInitializedEngines(func(_ *protocol.Chain, engine *engine.Engine) (shutdown func()) {
+		settings.applyTo(engine.Storage)
+
+		return nil
+	})
+
+	configPath := func(parameter any) string {
+		return Component.App().Config().GetParameterPath(parameter)
+	}
+
+	configreload.Register(deps.ConfigReload, "app", configPath(&(ParamsDatabase.Size.Enabled)), settings.enabled, func(enabled bool) error {
+		return settings.update(func() { settings.enabled = enabled })
+	})
+
+	configreload.Register(deps.ConfigReload, "app", configPath(&(ParamsDatabase.Size.TargetSize)), ParamsDatabase.Size.TargetSize, func(targetSize string) error {
+		return settings.update(func() { settings.targetSizeBytes = lo.PanicOnErr(bytes.Parse(targetSize)) })
+	}, func(targetSize string) error {
+		_, err := bytes.Parse(targetSize)
+
+		return err
+	})
+
+	configreload.Register(deps.ConfigReload, "app", configPath(&(ParamsDatabase.Size.ReductionPercentage)), settings.reductionPercentage, func(reductionPercentage float64) error {
+		return settings.update(func() { settings.reductionPercentage = reductionPercentage })
+	}, func(reductionPercentage float64) error {
+		if reductionPercentage <= 0 {
+			return ierrors.Errorf("reduction percentage must be greater than 0, got %f", reductionPercentage)
+		}
+
+		return nil
+	})
+
+	configreload.Register(deps.ConfigReload, "app", configPath(&(ParamsDatabase.Size.CooldownTime)), settings.cooldownTime, func(cooldownTime time.Duration) error {
+		return settings.update(func() { settings.cooldownTime = cooldownTime })
+	})
+}
+
+// configureTransactionLatencies feeds the transaction latency measurements with the events of the main engine.
+func configureTransactionLatencies() {
+	deps.Protocol.Events.Engine.Booker.TransactionAttached.Hook(func(transaction mempool.TransactionMetadata) {
+		// the slot is derived from the view of the engine rather than the wall clock, so that the transactions that are
+		// attached while the node is syncing are evicted together with the slots they were attached in.
+		engineInstance := deps.Protocol.Engines.Main.Get()
+		attachedSlot := max(engineInstance.LatestAPI().TimeProvider().SlotFromTime(engineInstance.Clock.Accepted().Time()), engineInstance.SyncManager.LatestCommitment().Slot())
+
+		deps.TransactionLatencies.TrackAttached(transaction.ID(), attachedSlot, time.Now())
+	})
+
+	deps.Protocol.Events.Engine.Booker.TransactionAccepted.Hook(func(transaction mempool.TransactionMetadata) {
+		deps.TransactionLatencies.TrackAccepted(transaction.ID(), time.Now())
+	})
+
+	deps.Protocol.Events.Engine.Booker.TransactionCommitted.Hook(func(transaction mempool.TransactionMetadata, slot iotago.SlotIndex) {
+		deps.TransactionLatencies.TrackCommitted(transaction.ID(), slot, time.Now())
+	})
+
+	deps.Protocol.Events.Engine.Booker.TransactionInvalid.Hook(func(transaction mempool.TransactionMetadata, _ error) {
+		deps.TransactionLatencies.StopTracking(transaction.ID())
+	})
+
+	deps.Protocol.Events.Engine.EvictionState.SlotEvicted.Hook(deps.TransactionLatencies.Evict)
+}
+
+// configureConflictMetrics feeds the conflict measurements with the SpendDAG events of the main engine.
+func configureConflictMetrics() {
+	deps.Protocol.Events.Engine.SpendDAG.SpenderCreated.Hook(func(spenderID iotago.TransactionID) {
+		deps.ConflictMetrics.TrackCreated(spenderID, time.Now())
+	})
+
+	deps.Protocol.Events.Engine.SpendDAG.SpenderAccepted.Hook(func(spenderID iotago.TransactionID) {
+		deps.ConflictMetrics.TrackAccepted(spenderID, time.Now())
+	})
+
+	deps.Protocol.Events.Engine.SpendDAG.SpenderRejected.Hook(func(spenderID iotago.TransactionID) {
+		deps.ConflictMetrics.TrackRejected(spenderID, time.Now())
+	})
+
+	deps.Protocol.Events.Engine.SpendDAG.SpenderEvicted.Hook(deps.ConflictMetrics.TrackEvicted)
+}
+
+// newModuleLogger creates a child logger of the component for the given module and registers it in the log level
+// registry so that its log level can be adjusted at runtime.
+func newModuleLogger(module string) log.Logger {
+	logger := Component.NewChildLogger(module)
+	deps.LogLevels.Register(module, logger)
+
+	return logger
+}
+
 func run() error {
 	return Component.Daemon().BackgroundWorker(Component.Name, func(ctx context.Context) {
 		if err := deps.Protocol.Run(ctx); err != nil {
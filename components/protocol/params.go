@@ -19,8 +19,103 @@ type ParametersProtocol struct {
 	Filter struct {
 		// MaxAllowedClockDrift defines the maximum drift our wall clock can have to future blocks being received from the network.
 		MaxAllowedClockDrift time.Duration `default:"5s" usage:"the maximum drift our wall clock can have to future blocks being received from the network"`
+
+		IssuerReputation struct {
+			// Threshold defines the score of recent invalid blocks above which the blocks of an issuer that is not part of the committee are dropped (0 = disabled).
+			Threshold float64 `default:"0" usage:"the score of recent invalid blocks above which the blocks of an issuer that is not part of the committee are dropped (0 = disabled)"`
+			// HalfLife defines the number of slots after which the score of invalid blocks of an issuer is halved.
+			HalfLife uint32 `default:"10" usage:"the number of slots after which the score of invalid blocks of an issuer is halved"`
+		}
+	}
+
+	Notarization struct {
+		// MinCommittableAge defines the minimum age of a slot before it is committed, which can only delay the commitments beyond the minCommittableAge of the protocol parameters (0 = use the protocol parameters).
+		MinCommittableAge uint32 `default:"0" usage:"the minimum age of a slot before it is committed, which can only delay the commitments beyond the minCommittableAge of the protocol parameters (0 = use the protocol parameters)"`
+	}
+
+	Network struct {
+		// PingInterval defines the interval in which the neighbors are pinged to measure the latency of the links to them (0 = disabled).
+		PingInterval time.Duration `default:"10s" usage:"the interval in which the neighbors are pinged to measure the latency of the links to them (0 = disabled)"`
+		// PingTimeout defines the duration after which an unanswered ping is considered to be lost.
+		PingTimeout time.Duration `default:"30s" usage:"the duration after which an unanswered ping is considered to be lost"`
+		// CommitmentBroadcastRetryInterval defines the interval in which the latest commitment is sent again to the neighbors that did not acknowledge it yet (0 = disabled).
+		CommitmentBroadcastRetryInterval time.Duration `default:"2s" usage:"the interval in which the latest commitment is sent again to the neighbors that did not acknowledge it yet (0 = disabled)"`
+		// CommitmentBroadcastMaxRetries defines the maximum number of times the latest commitment is sent again to the neighbors that did not acknowledge it yet.
+		CommitmentBroadcastMaxRetries int `default:"3" usage:"the maximum number of times the latest commitment is sent again to the neighbors that did not acknowledge it yet"`
+		// MaxFailedVerificationsPerChain defines the number of failed proof verifications after which no more data of a chain is requested from a neighbor (0 = disabled).
+		MaxFailedVerificationsPerChain int `default:"3" usage:"the number of failed proof verifications after which no more data of a chain is requested from a neighbor (0 = disabled)"`
+		// MaxIncomingRequestsPerPeer defines the maximum number of block, commitment and attestations requests of a single neighbor that are processed concurrently (0 = unlimited).
+		MaxIncomingRequestsPerPeer int `default:"100" usage:"the maximum number of block, commitment and attestations requests of a single neighbor that are processed concurrently (0 = unlimited)"`
+		// MaxIncomingRequests defines the maximum number of block, commitment and attestations requests of all neighbors that are processed concurrently (0 = unlimited).
+		MaxIncomingRequests int `default:"1000" usage:"the maximum number of block, commitment and attestations requests of all neighbors that are processed concurrently (0 = unlimited)"`
+		// MaxOutgoingBlockRequests defines the maximum number of block requests that are waiting for an answer (0 = unlimited).
+		MaxOutgoingBlockRequests int `default:"10000" usage:"the maximum number of block requests that are waiting for an answer (0 = unlimited)"`
+		// BlockRequestTimeout defines the duration after which an unanswered block request no longer counts towards the maximum number of outgoing block requests.
+		BlockRequestTimeout time.Duration `default:"1m" usage:"the duration after which an unanswered block request no longer counts towards the maximum number of outgoing block requests"`
+	}
+
+	Scheduler struct {
+		// MaxBlockLatency defines the max duration a basic block can wait in the scheduler buffer (measured from its issuing time) before it is evicted (0 = disabled).
+		MaxBlockLatency time.Duration `default:"0s" usage:"the max duration a basic block can wait in the scheduler buffer (measured from its issuing time) before it is evicted (0 = disabled)"`
+
+		RateAdaptation struct {
+			// HighWatermark defines the number of pending tasks of the booker and notarization at which the local scheduler rate is lowered (0 = disabled).
+			HighWatermark int `default:"0" usage:"the number of pending tasks of the booker and notarization at which the local scheduler rate is lowered (0 = disabled)"`
+			// LowWatermark defines the number of pending tasks of the booker and notarization at which the local scheduler rate recovers.
+			LowWatermark int `default:"250" usage:"the number of pending tasks of the booker and notarization at which the local scheduler rate recovers"`
+			// MinRateFactor defines the lower bound of the factor that the local scheduler rate is multiplied with.
+			MinRateFactor float64 `default:"0.25" usage:"the lower bound of the factor that the local scheduler rate is multiplied with"`
+			// Interval defines the minimum duration between two adaptations of the local scheduler rate.
+			Interval time.Duration `default:"1s" usage:"the minimum duration between two adaptations of the local scheduler rate"`
+		}
+
+		NewAccountBurst struct {
+			// Work defines the work that newly created accounts are allowed to get scheduled before they generated mana (0 = disabled).
+			Work uint32 `default:"0" usage:"the work that newly created accounts are allowed to get scheduled before they generated mana (0 = disabled)"`
+			// MaxAccountsPerSlot defines the maximum number of newly created accounts that receive the burst allowance per slot (0 = unlimited).
+			MaxAccountsPerSlot int `default:"10" usage:"the maximum number of newly created accounts that receive the burst allowance per slot (0 = unlimited)"`
+		}
+	}
+
+	ChainBlockBuffer struct {
+		// Size defines the maximum number of blocks that are kept in memory for the engine of a non-main chain before they are spilled to disk or dropped (0 = disabled).
+		Size int `default:"10000" usage:"the maximum number of blocks that are kept in memory for the engine of a non-main chain before they are spilled to disk or dropped (0 = disabled)"`
+		// MaxPendingTasks defines the number of pending tasks in the engine of a non-main chain at which no further blocks are dispatched from its block buffer.
+		MaxPendingTasks int `default:"1000" usage:"the number of pending tasks in the engine of a non-main chain at which no further blocks are dispatched from its block buffer"`
+		// SpillToDisk defines whether blocks that exceed the capacity of the block buffer of a non-main chain are spilled to a temporary bucket of the prunable storage instead of being dropped.
+		SpillToDisk bool `default:"true" usage:"whether blocks that exceed the capacity of the block buffer of a non-main chain are spilled to a temporary bucket of the prunable storage instead of being dropped"`
+	}
+
+	TipSelection struct {
+		// Weighted defines whether the strong tips are selected with a bias towards tips with a higher approval weight and a more recent slot commitment, which lowers the orphan risk of issued blocks at the cost of a narrower DAG.
+		Weighted bool `default:"false" usage:"whether the strong tips are selected with a bias towards tips with a higher approval weight and a more recent slot commitment, which lowers the orphan risk of issued blocks at the cost of a narrower DAG"`
+	}
+
+	SybilProtection struct {
+		// ActivityWindow defines the duration within which a committee member needs to issue a block to be considered online.
+		ActivityWindow time.Duration `default:"30s" usage:"the duration within which a committee member needs to issue a block to be considered online"`
+		// ActivityHysteresis defines the additional time that an online committee member stays online after its activity window elapsed.
+		ActivityHysteresis time.Duration `default:"10s" usage:"the additional time that an online committee member stays online after its activity window elapsed"`
 	}
 
+	// WarmStandby defines whether the engine of the heaviest attested candidate chain is kept in sync before the chain switching threshold is reached.
+	WarmStandby bool `default:"false" usage:"whether the engine of the heaviest attested candidate chain is kept in sync before the chain switching threshold is reached"`
+
+	// WarpSyncStateDiffs defines whether the state diffs of the slots are requested while warp syncing, so that the accepted transactions are applied without re-executing them.
+	WarpSyncStateDiffs bool `default:"false" usage:"whether the state diffs of the slots are requested while warp syncing, so that the accepted transactions are applied without re-executing them"`
+
+	// SpendDAGPersistence defines whether the pending spenders of the SpendDAG are persisted with every commitment and restored on startup.
+	SpendDAGPersistence bool `default:"false" usage:"whether the pending spenders of the SpendDAG are persisted with every commitment and restored on startup"`
+
+	// MemPoolWriteAheadLog defines whether the attached transactions of the MemPool are recorded in a write-ahead log and replayed on startup.
+	MemPoolWriteAheadLog bool `default:"false" usage:"whether the attached transactions of the MemPool are recorded in a write-ahead log and replayed on startup"`
+
+	// MergeToMaster defines whether accepted spenders are removed from the spenders that blocks and transactions inherit.
+	MergeToMaster bool `default:"true" usage:"whether accepted spenders are removed from the spenders that blocks and transactions inherit"`
+
+	// FinalizationStallThreshold defines the number of slots that the latest finalized slot can lag behind the latest accepted block slot before the finalization is considered to be stalled (0 = disabled).
+	FinalizationStallThreshold uint32 `default:"60" usage:"the number of slots that the latest finalized slot can lag behind the latest accepted block slot before the finalization is considered to be stalled (0 = disabled)"`
+
 	ProtocolParametersPath string `default:"testnet/protocol_parameters.json" usage:"the path of the protocol parameters file"`
 
 	BaseToken BaseToken
@@ -41,10 +136,12 @@ type BaseToken struct {
 
 // ParametersDatabase contains the definition of configuration parameters used by the storage layer.
 type ParametersDatabase struct {
-	Engine           string `default:"rocksdb" usage:"the used database engine (rocksdb/mapdb)"`
+	Engine           string `default:"rocksdb" usage:"the used database engine (rocksdb/pebble/mapdb)"`
 	Path             string `default:"testnet/database" usage:"the path to the database folder"`
 	MaxOpenDBs       int    `default:"5" usage:"maximum number of open database instances"`
 	PruningThreshold uint64 `default:"30" usage:"how many finalized epochs should be retained"`
+	// ForkRetainedEpochs defines how many epochs of prunable data before the forking point are copied to the database of a candidate engine (0 = all).
+	ForkRetainedEpochs uint64 `default:"0" usage:"how many epochs of prunable data before the forking point are copied to the database of a candidate engine (0 = all)"`
 
 	Size struct {
 		// Enabled defines whether to delete old block data from the database based on maximum database size
@@ -56,6 +153,32 @@ type ParametersDatabase struct {
 		// CooldownTime defines the cooldown time between two pruning by database size events
 		CooldownTime time.Duration `default:"5m" usage:"cooldown time between two pruning by database size events"`
 	}
+
+	BloomFilter struct {
+		// PermanentBitsPerKey defines the number of bits per key of the bloom filters of the permanent database
+		PermanentBitsPerKey int `default:"10" usage:"the number of bits per key of the bloom filters of the permanent database (0 = disabled)"`
+		// PrunableBitsPerKey defines the number of bits per key of the bloom filters of the prunable databases
+		PrunableBitsPerKey int `default:"10" usage:"the number of bits per key of the bloom filters of the prunable databases (0 = disabled)"`
+	}
+
+	Pebble struct {
+		// TombstoneCompactionThreshold defines the number of deleted key prefixes of a database (e.g. pruned epochs) after which the deleted key ranges are compacted in the background (ignored by other engines)
+		TombstoneCompactionThreshold int `default:"16" usage:"the number of deleted key prefixes of a pebble database after which the deleted key ranges are compacted in the background (0 = disabled)"`
+	}
+
+	UnspentOutputFilter struct {
+		// Enabled defines whether the IDs of the unspent outputs are tracked in an in-memory bloom filter to skip the database lookups of outputs that are not unspent
+		Enabled bool `default:"false" usage:"whether the IDs of the unspent outputs are tracked in an in-memory bloom filter to skip the database lookups of outputs that are not unspent"`
+		// FalsePositiveRate defines the false positive rate the bloom filter is sized for
+		FalsePositiveRate float64 `default:"0.01" usage:"the false positive rate the bloom filter is sized for"`
+		// Capacity defines the minimum number of output IDs the bloom filter is sized for
+		Capacity uint64 `default:"1000000" usage:"the minimum number of output IDs the bloom filter is sized for"`
+	}
+
+	OutputIndex struct {
+		// Enabled defines whether the unspent outputs are indexed by the addresses they reference
+		Enabled bool `default:"false" usage:"whether the unspent outputs are indexed by the addresses they reference to serve the indexer API (the index is rebuilt on startup)"`
+	}
 }
 
 // ParamsProtocol contains the configuration parameters used by the Protocol.
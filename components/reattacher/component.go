@@ -0,0 +1,153 @@
+package reattacher
+
+import (
+	"context"
+
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"go.uber.org/dig"
+
+	"github.com/iotaledger/hive.go/app"
+	hivecrypto "github.com/iotaledger/hive.go/crypto"
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/hive.go/runtime/event"
+	"github.com/iotaledger/iota-core/components/restapi"
+	"github.com/iotaledger/iota-core/pkg/blockfactory"
+	"github.com/iotaledger/iota-core/pkg/blockhandler"
+	"github.com/iotaledger/iota-core/pkg/daemon"
+	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/protocol"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/mempool"
+	"github.com/iotaledger/iota-core/pkg/reattacher"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func init() {
+	Component = &app.Component{
+		Name:      "Reattacher",
+		DepsFunc:  func(cDeps dependencies) { deps = cDeps },
+		Configure: configure,
+		Run:       run,
+		Params:    params,
+		IsEnabled: func(c *dig.Container) bool {
+			return restapi.ParamsRestAPI.Enabled && ParamsReattacher.Enabled
+		},
+	}
+}
+
+var (
+	Component *app.Component
+	deps      dependencies
+
+	reattacherBlockFactory *blockfactory.Factory
+	reattacherInstance     *reattacher.Reattacher
+)
+
+type dependencies struct {
+	dig.In
+
+	Protocol       *protocol.Protocol
+	BlockHandler   *blockhandler.BlockHandler
+	NodePrivateKey crypto.PrivKey `name:"nodePrivateKey"`
+}
+
+func configure() error {
+	// check if RestAPI plugin is disabled
+	if !Component.App().IsComponentEnabled(restapi.Component.Identifier()) {
+		Component.LogPanic("RestAPI plugin needs to be enabled to use the Reattacher plugin")
+	}
+
+	privateKey, err := hivecrypto.ParseEd25519PrivateKeyFromString(ParamsReattacher.PrivateKey)
+	if err != nil {
+		Component.LogPanicf("invalid reattacher private key: %s", err)
+	}
+
+	_, issuerAddress, err := iotago.ParseBech32(ParamsReattacher.IssuerAccountAddress)
+	if err != nil {
+		Component.LogPanicf("invalid reattacher issuer account address %s: %s", ParamsReattacher.IssuerAccountAddress, err)
+	}
+
+	issuerAccountAddress, isAccountAddress := issuerAddress.(*iotago.AccountAddress)
+	if !isAccountAddress {
+		Component.LogPanicf("reattacher issuer address %s is not an account address", ParamsReattacher.IssuerAccountAddress)
+	}
+
+	if err = blockfactory.ValidateKeySeparation(privateKey, deps.NodePrivateKey.GetPublic()); err != nil {
+		Component.LogPanicf("invalid reattacher private key: %s", err)
+	}
+
+	if err = blockfactory.ValidateIssuer(deps.Protocol, issuerAccountAddress.AccountID(), privateKey); err != nil {
+		Component.LogPanicf("invalid reattacher issuer account %s: %s", ParamsReattacher.IssuerAccountAddress, err)
+	}
+
+	reattacherBlockFactory = blockfactory.New(deps.Protocol)
+
+	reattacherInstance = reattacher.New(
+		func() reattacher.MemPool {
+			return deps.Protocol.Engines.Main.Get().Ledger.MemPool()
+		},
+		func(ctx context.Context, signedTransaction mempool.SignedTransaction) (iotago.BlockID, error) {
+			payload, isApplicationPayload := signedTransaction.(iotago.ApplicationPayload)
+			if !isApplicationPayload {
+				return iotago.EmptyBlockID, ierrors.Errorf("unsupported signed transaction type %T", signedTransaction)
+			}
+
+			block, err := reattacherBlockFactory.CreateBlock(payload, issuerAccountAddress.AccountID(), privateKey)
+			if err != nil {
+				return iotago.EmptyBlockID, ierrors.Wrap(err, "failed to create block")
+			}
+
+			return deps.BlockHandler.AttachBlock(ctx, block)
+		},
+		reattacher.WithMaxReattachments(ParamsReattacher.MaxReattachments),
+		reattacher.WithReattachmentDelay(ParamsReattacher.ReattachmentDelay),
+		reattacher.WithCheckInterval(ParamsReattacher.CheckInterval),
+	)
+
+	return nil
+}
+
+func run() error {
+	if err := Component.Daemon().BackgroundWorker(Component.Name, func(ctx context.Context) {
+		Component.LogInfo("Starting Reattacher ... done")
+
+		unhook := lo.Batch(
+			deps.BlockHandler.Events().BlockSubmitted.Hook(func(block *model.Block) {
+				if signedTransaction, isTransaction := block.SignedTransaction(); isTransaction {
+					if transactionID, err := signedTransaction.Transaction.ID(); err == nil {
+						reattacherInstance.Watch(transactionID)
+					}
+				}
+			}).Unhook,
+
+			reattacherInstance.Events.TransactionReattached.Hook(func(transactionID iotago.TransactionID, blockID iotago.BlockID) {
+				Component.LogDebugf("reattached transaction %s in block %s", transactionID, blockID)
+			}, event.WithWorkerPool(Component.WorkerPool)).Unhook,
+
+			reattacherInstance.Events.TransactionFinalized.Hook(func(result *reattacher.TransactionResult) {
+				if result.Status == reattacher.TransactionStatusCommitted {
+					Component.LogDebugf("transaction %s was committed after %d reattachments", result.TransactionID, result.Reattachments)
+
+					return
+				}
+
+				Component.LogWarnf("transaction %s was %s after %d reattachments", result.TransactionID, result.Status, result.Reattachments)
+			}, event.WithWorkerPool(Component.WorkerPool)).Unhook,
+		)
+
+		reattacherInstance.Run(ctx, func(err error) {
+			Component.LogWarnf("failed to check submitted transactions: %s", err)
+		})
+
+		Component.LogInfo("Stopping Reattacher ...")
+
+		unhook()
+		reattacherBlockFactory.Shutdown()
+
+		Component.LogInfo("Stopping Reattacher ... done")
+	}, daemon.PriorityReattacher); err != nil {
+		Component.LogPanicf("failed to start worker: %s", err)
+	}
+
+	return nil
+}
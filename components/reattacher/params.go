@@ -0,0 +1,33 @@
+package reattacher
+
+import (
+	"time"
+
+	"github.com/iotaledger/hive.go/app"
+)
+
+// ParametersReattacher contains the definition of configuration parameters used by the Reattacher.
+type ParametersReattacher struct {
+	// Enabled whether the Reattacher component is enabled.
+	Enabled bool `default:"false" usage:"whether the Reattacher component is enabled"`
+	// PrivateKey defines the Ed25519 private key of the block issuer account that issues the reattachments.
+	PrivateKey string `default:"" usage:"the Ed25519 private key of the block issuer account that issues the reattachments"`
+	// IssuerAccountAddress defines the bech32 account address of the account that issues the reattachments.
+	IssuerAccountAddress string `default:"" usage:"the bech32 account address of the account that issues the reattachments"`
+	// MaxReattachments defines the maximum number of times a submitted transaction is reattached.
+	MaxReattachments int `default:"3" usage:"the maximum number of times a submitted transaction is reattached"`
+	// ReattachmentDelay defines the time after the last issuance of a transaction after which it is reattached if none of its attachments was included.
+	ReattachmentDelay time.Duration `default:"30s" usage:"the time after the last issuance of a transaction after which it is reattached if none of its attachments was included"`
+	// CheckInterval defines the interval in which the submitted transactions are checked.
+	CheckInterval time.Duration `default:"5s" usage:"the interval in which the submitted transactions are checked"`
+}
+
+// ParamsReattacher is the default configuration parameters for the Reattacher component.
+var ParamsReattacher = &ParametersReattacher{}
+
+var params = &app.ComponentParams{
+	Params: map[string]any{
+		"reattacher": ParamsReattacher,
+	},
+	Masked: []string{"reattacher.privateKey"},
+}
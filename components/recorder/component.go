@@ -0,0 +1,97 @@
+package recorder
+
+import (
+	"context"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"go.uber.org/dig"
+
+	"github.com/iotaledger/hive.go/app"
+	"github.com/iotaledger/iota-core/pkg/daemon"
+	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/protocol"
+	"github.com/iotaledger/iota-core/pkg/recorder"
+)
+
+func init() {
+	Component = &app.Component{
+		Name:     "Recorder",
+		DepsFunc: func(cDeps dependencies) { deps = cDeps },
+		Params:   params,
+		Run:      run,
+		IsEnabled: func(_ *dig.Container) bool {
+			return ParamsRecorder.Enabled || ParamsRecorder.Replay.Path != ""
+		},
+	}
+}
+
+var (
+	Component *app.Component
+	deps      dependencies
+)
+
+type dependencies struct {
+	dig.In
+
+	Protocol *protocol.Protocol
+}
+
+func run() error {
+	if ParamsRecorder.Enabled {
+		if err := Component.Daemon().BackgroundWorker("Recorder", runRecorder, daemon.PriorityRecorder); err != nil {
+			Component.LogPanicf("failed to start worker: %s", err)
+		}
+	}
+
+	if ParamsRecorder.Replay.Path != "" {
+		if err := Component.Daemon().BackgroundWorker("Replayer", runReplayer, daemon.PriorityRecorder); err != nil {
+			Component.LogPanicf("failed to start worker: %s", err)
+		}
+	}
+
+	return nil
+}
+
+func runRecorder(ctx context.Context) {
+	blockRecorder, err := recorder.NewRecorder(ParamsRecorder.Path)
+	if err != nil {
+		Component.LogPanicf("failed to create recorder: %s", err)
+	}
+
+	Component.LogInfof("Recording received blocks to %s ...", ParamsRecorder.Path)
+
+	unsubscribe := deps.Protocol.Network.OnBlockReceived(func(block *model.Block, src peer.ID) {
+		if err := blockRecorder.Record(block, src, time.Now()); err != nil {
+			Component.LogErrorf("failed to record block %s: %s", block.ID(), err)
+		}
+	})
+
+	<-ctx.Done()
+	Component.LogInfo("Stopping Recorder ...")
+
+	unsubscribe()
+
+	if err := blockRecorder.Close(); err != nil {
+		Component.LogErrorf("failed to close recording: %s", err)
+	}
+
+	Component.LogInfof("Stopping Recorder ... done (recorded %d blocks)", blockRecorder.RecordedBlocks())
+}
+
+func runReplayer(ctx context.Context) {
+	Component.LogInfof("Replaying recording %s (max speed: %t) ...", ParamsRecorder.Replay.Path, ParamsRecorder.Replay.MaxSpeed)
+
+	replayedBlocks, err := recorder.NewReplayer(
+		ParamsRecorder.Replay.Path,
+		deps.Protocol,
+		recorder.WithMaxSpeed(ParamsRecorder.Replay.MaxSpeed),
+	).Replay(ctx, deps.Protocol.Network.Events.BlockReceived.Trigger)
+	if err != nil && ctx.Err() == nil {
+		Component.LogErrorf("failed to replay recording after %d blocks: %s", replayedBlocks, err)
+
+		return
+	}
+
+	Component.LogInfof("Replaying recording %s ... done (replayed %d blocks)", ParamsRecorder.Replay.Path, replayedBlocks)
+}
@@ -0,0 +1,28 @@
+package recorder
+
+import (
+	"github.com/iotaledger/hive.go/app"
+)
+
+// ParametersRecorder contains the definition of the parameters used by the Recorder.
+type ParametersRecorder struct {
+	// Enabled defines whether all blocks received by the node are recorded to disk (debug only).
+	Enabled bool `default:"false" usage:"whether all blocks received by the node are recorded to disk (debug only)"`
+	// Path defines the path of the file the blocks are recorded to.
+	Path string `default:"testnet/recording.bin" usage:"the path of the file the blocks are recorded to"`
+
+	Replay struct {
+		// Path defines the path of a recording that is fed into the engine of the node at startup.
+		Path string `default:"" usage:"the path of a recording that is fed into the engine of the node at startup (should be used with a fresh database and the same snapshot as the recording node)"`
+		// MaxSpeed defines whether the recording is replayed as fast as possible instead of at the recorded pace.
+		MaxSpeed bool `default:"false" usage:"whether the recording is replayed as fast as possible instead of at the recorded pace"`
+	}
+}
+
+var ParamsRecorder = &ParametersRecorder{}
+
+var params = &app.ComponentParams{
+	Params: map[string]any{
+		"recorder": ParamsRecorder,
+	},
+}
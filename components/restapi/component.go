@@ -4,6 +4,7 @@ import (
 	"context"
 	"net"
 	"net/http"
+	"sync/atomic"
 	"time"
 
 	"github.com/labstack/echo/v4"
@@ -16,8 +17,10 @@ import (
 	"github.com/iotaledger/hive.go/ierrors"
 	"github.com/iotaledger/inx-app/pkg/httpserver"
 	"github.com/iotaledger/iota-core/pkg/blockhandler"
+	"github.com/iotaledger/iota-core/pkg/configreload"
 	"github.com/iotaledger/iota-core/pkg/daemon"
 	"github.com/iotaledger/iota-core/pkg/jwt"
+	"github.com/iotaledger/iota-core/pkg/metrics"
 	protocolpkg "github.com/iotaledger/iota-core/pkg/protocol"
 	"github.com/iotaledger/iota-core/pkg/restapi"
 )
@@ -41,6 +44,13 @@ var (
 	Component *app.Component
 	deps      dependencies
 	jwtAuth   *jwt.Auth
+
+	// maxPageSize and maxRequestedSlotAge contain the current values of the limits that can be reloaded at runtime.
+	maxPageSize         atomic.Uint32
+	maxRequestedSlotAge atomic.Uint32
+
+	// slowRequestThreshold contains the current value of the slow request threshold that can be reloaded at runtime.
+	slowRequestThreshold atomic.Int64
 )
 
 type dependencies struct {
@@ -50,6 +60,8 @@ type dependencies struct {
 	RestAPIBindAddress string         `name:"restAPIBindAddress"`
 	NodePrivateKey     crypto.PrivKey `name:"nodePrivateKey"`
 	RestRouteManager   *restapi.RestRouteManager
+	ConfigReload       *configreload.Registry
+	APIRouteMetrics    *metrics.APIRouteMetrics
 
 	Protocol *protocolpkg.Protocol
 }
@@ -110,12 +122,69 @@ func provide(c *dig.Container) error {
 }
 
 func configure() error {
+	deps.Echo.Use(routeMetricsMiddleware())
 	deps.Echo.Use(apiMiddleware())
 	setupRoutes()
 
+	configureLimitsReload()
+
 	return nil
 }
 
+// MaxPageSize returns the maximum number of results per page.
+func MaxPageSize() uint32 {
+	return maxPageSize.Load()
+}
+
+// MaxRequestedSlotAge returns the maximum age of a request that will be processed.
+func MaxRequestedSlotAge() uint32 {
+	return maxRequestedSlotAge.Load()
+}
+
+// SlowRequestThreshold returns the duration after which a request is logged as slow (0 if the logging is disabled).
+func SlowRequestThreshold() time.Duration {
+	return time.Duration(slowRequestThreshold.Load())
+}
+
+// configureLimitsReload registers the limits of the REST API as reloadable parameters.
+func configureLimitsReload() {
+	maxPageSize.Store(ParamsRestAPI.MaxPageSize)
+	maxRequestedSlotAge.Store(ParamsRestAPI.MaxRequestedSlotAge)
+	slowRequestThreshold.Store(int64(ParamsRestAPI.SlowRequestThreshold))
+
+	greaterThanZero := func(value uint32) error {
+		if value == 0 {
+			return ierrors.New("value must be greater than 0")
+		}
+
+		return nil
+	}
+
+	configreload.Register(deps.ConfigReload, "app", Component.App().Config().GetParameterPath(&(ParamsRestAPI.MaxPageSize)), ParamsRestAPI.MaxPageSize, func(value uint32) error {
+		maxPageSize.Store(value)
+
+		return nil
+	}, greaterThanZero)
+
+	configreload.Register(deps.ConfigReload, "app", Component.App().Config().GetParameterPath(&(ParamsRestAPI.MaxRequestedSlotAge)), ParamsRestAPI.MaxRequestedSlotAge, func(value uint32) error {
+		maxRequestedSlotAge.Store(value)
+
+		return nil
+	}, greaterThanZero)
+
+	configreload.Register(deps.ConfigReload, "app", Component.App().Config().GetParameterPath(&(ParamsRestAPI.SlowRequestThreshold)), ParamsRestAPI.SlowRequestThreshold, func(value time.Duration) error {
+		slowRequestThreshold.Store(int64(value))
+
+		return nil
+	}, func(value time.Duration) error {
+		if value < 0 {
+			return ierrors.New("value must not be negative")
+		}
+
+		return nil
+	})
+}
+
 func run() error {
 	Component.LogInfo("Starting REST-API server ...")
 
@@ -1,13 +1,13 @@
 package core
 
 import (
-	"fmt"
+	"sort"
 	"time"
 
 	"github.com/labstack/echo/v4"
 
 	"github.com/iotaledger/hive.go/ierrors"
-	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/hive.go/kvstore"
 	"github.com/iotaledger/inx-app/pkg/httpserver"
 	"github.com/iotaledger/iota-core/components/restapi"
 	"github.com/iotaledger/iota-core/pkg/core/account"
@@ -16,6 +16,36 @@ import (
 	"github.com/iotaledger/iota.go/v4/api"
 )
 
+func accountsAggregatesBySlot(c echo.Context) (*AccountsAggregatesResponse, error) {
+	slot, err := httpserver.ParseSlotParam(c, api.ParameterSlot)
+	if err != nil {
+		return nil, ierrors.Wrapf(err, "failed to parse slot %s", c.Param(api.ParameterSlot))
+	}
+
+	engineInstance := deps.Protocol.Engines.Main.Get()
+	if latestCommittedSlot := engineInstance.SyncManager.LatestCommitment().Slot(); slot > latestCommittedSlot {
+		return nil, ierrors.Wrapf(echo.ErrBadRequest, "slot %d is not committed yet, latest committed slot: %d", slot, latestCommittedSlot)
+	}
+
+	aggregates, err := engineInstance.Ledger.AccountsAggregates(slot)
+	if err != nil {
+		if ierrors.Is(err, kvstore.ErrKeyNotFound) {
+			return nil, ierrors.Wrapf(echo.ErrNotFound, "accounts aggregates of slot %d are not available: %s", slot, err)
+		}
+
+		return nil, ierrors.Wrapf(echo.ErrInternalServerError, "failed to get accounts aggregates of slot %d: %s", slot, err)
+	}
+
+	return &AccountsAggregatesResponse{
+		Slot:            slot,
+		Epoch:           engineInstance.APIForSlot(slot).TimeProvider().EpochFromSlot(slot),
+		AccountsCount:   aggregates.AccountsCount,
+		ValidatorsCount: aggregates.ValidatorsCount,
+		ValidatorStake:  aggregates.ValidatorStake,
+		DelegatedStake:  aggregates.DelegationStake,
+	}, nil
+}
+
 func congestionByAccountAddress(c echo.Context) (*api.CongestionResponse, error) {
 	commitmentID, err := httpserver.ParseCommitmentIDQueryParam(c, api.ParameterCommitmentID)
 	if err != nil {
@@ -61,35 +91,42 @@ func congestionByAccountAddress(c echo.Context) (*api.CongestionResponse, error)
 
 func validators(c echo.Context) (*api.ValidatorsResponse, error) {
 	var err error
-	pageSize := restapi.ParamsRestAPI.MaxPageSize
+	pageSize := restapi.MaxPageSize()
 	if len(c.QueryParam(restapipkg.QueryParameterPageSize)) > 0 {
 		pageSize, err = httpserver.ParseUint32QueryParam(c, restapipkg.QueryParameterPageSize)
 		if err != nil {
 			return nil, ierrors.Wrapf(err, "failed to parse page size %s", c.Param(restapipkg.QueryParameterPageSize))
 		}
-		if pageSize > restapi.ParamsRestAPI.MaxPageSize {
-			pageSize = restapi.ParamsRestAPI.MaxPageSize
+		if pageSize > restapi.MaxPageSize() {
+			pageSize = restapi.MaxPageSize()
 		}
 	}
 	latestCommittedSlot := deps.Protocol.Engines.Main.Get().SyncManager.LatestCommitment().Slot()
 	// no cursor provided will be the first request
-	requestedSlot := latestCommittedSlot
+	requestedCommitmentID := deps.Protocol.Engines.Main.Get().SyncManager.LatestCommitment().ID()
 	var cursorIndex uint32
-	if len(c.QueryParam(restapipkg.QueryParameterCursor)) != 0 {
-		requestedSlot, cursorIndex, err = httpserver.ParseCursorQueryParam(c, restapipkg.QueryParameterCursor)
-		if err != nil {
-			return nil, ierrors.Wrapf(err, "failed to parse cursor %s", c.Param(restapipkg.QueryParameterCursor))
+
+	cursor, err := restapipkg.ParsePaginationTokenQueryParam(c, restapipkg.QueryParameterCursor)
+	if err != nil {
+		return nil, err
+	}
+
+	if cursor != nil {
+		if err = cursor.Validate(restapipkg.NewStoragePaginationState(deps.Protocol.Engines.Main.Get().Storage)); err != nil {
+			return nil, ierrors.Wrapf(echo.ErrGone, "failed to continue paginated request: %s", err)
 		}
+
+		requestedCommitmentID, cursorIndex = cursor.CommitmentID, cursor.Index
 	}
 
 	// do not respond to really old requests
-	if requestedSlot+iotago.SlotIndex(restapi.ParamsRestAPI.MaxRequestedSlotAge) < latestCommittedSlot {
-		return nil, ierrors.Wrapf(echo.ErrBadRequest, "request is too old, request started at %d, latest committed slot index is %d", requestedSlot, latestCommittedSlot)
+	if requestedCommitmentID.Slot()+iotago.SlotIndex(restapi.MaxRequestedSlotAge()) < latestCommittedSlot {
+		return nil, ierrors.Wrapf(echo.ErrBadRequest, "request is too old, request started at %d, latest committed slot index is %d", requestedCommitmentID.Slot(), latestCommittedSlot)
 	}
 
 	nextEpoch := deps.Protocol.APIForSlot(latestCommittedSlot).TimeProvider().EpochFromSlot(latestCommittedSlot) + 1
 
-	slotRange := uint32(requestedSlot) / restapi.ParamsRestAPI.RequestsMemoryCacheGranularity
+	slotRange := uint32(requestedCommitmentID.Slot()) / restapi.ParamsRestAPI.RequestsMemoryCacheGranularity
 	registeredValidators, exists := deps.Protocol.Engines.Main.Get().Retainer.RegisteredValidatorsCache(slotRange)
 	if !exists {
 		registeredValidators, err = deps.Protocol.Engines.Main.Get().SybilProtection.OrderedRegisteredCandidateValidatorsList(nextEpoch)
@@ -99,19 +136,13 @@ func validators(c echo.Context) (*api.ValidatorsResponse, error) {
 		deps.Protocol.Engines.Main.Get().Retainer.RetainRegisteredValidatorsCache(slotRange, registeredValidators)
 	}
 
-	page := registeredValidators[cursorIndex:lo.Min(cursorIndex+pageSize, uint32(len(registeredValidators)))]
-	resp := &api.ValidatorsResponse{
+	page, nextCursor := restapipkg.Paginate(registeredValidators, requestedCommitmentID, cursorIndex, pageSize)
+
+	return &api.ValidatorsResponse{
 		Validators: page,
 		PageSize:   pageSize,
-	}
-	// this is the last page
-	if int(cursorIndex+pageSize) > len(registeredValidators) {
-		resp.Cursor = ""
-	} else {
-		resp.Cursor = fmt.Sprintf("%d,%d", slotRange, cursorIndex+pageSize)
-	}
-
-	return resp, nil
+		Cursor:     nextCursor,
+	}, nil
 }
 
 func validatorByAccountAddress(c echo.Context) (*api.ValidatorResponse, error) {
@@ -156,6 +187,135 @@ func validatorByAccountAddress(c echo.Context) (*api.ValidatorResponse, error) {
 	}, nil
 }
 
+func validatorsOverview(c echo.Context) (*ValidatorsOverviewResponse, error) {
+	var includeNext bool
+	if len(c.QueryParam(QueryParameterIncludeNext)) > 0 {
+		var err error
+		if includeNext, err = httpserver.ParseBoolQueryParam(c, QueryParameterIncludeNext); err != nil {
+			return nil, ierrors.Wrapf(httpserver.ErrInvalidParameter, "failed to parse %s: %s", QueryParameterIncludeNext, err)
+		}
+	}
+
+	mainEngine := deps.Protocol.Engines.Main.Get()
+	latestCommitment := mainEngine.SyncManager.LatestCommitment()
+	currentEpoch := deps.Protocol.APIForSlot(latestCommitment.Slot()).TimeProvider().EpochFromSlot(latestCommitment.Slot())
+
+	committee, exists := mainEngine.SybilProtection.SeatManager().CommitteeInSlot(latestCommitment.Slot())
+	if !exists {
+		return nil, ierrors.Wrapf(echo.ErrInternalServerError, "committee for slot %d not found", latestCommitment.Slot())
+	}
+
+	committeeAccounts, err := committee.Accounts()
+	if err != nil {
+		return nil, ierrors.Wrapf(echo.ErrInternalServerError, "failed to get accounts from committee for slot %d: %s", latestCommitment.Slot(), err)
+	}
+
+	resp := &ValidatorsOverviewResponse{
+		LatestCommitmentID: latestCommitment.ID(),
+		CurrentEpoch: &EpochValidatorsResponse{
+			Epoch:      currentEpoch,
+			Validators: make([]*ValidatorOverviewResponse, 0, committeeAccounts.Size()),
+		},
+	}
+
+	committeeAccounts.ForEach(func(accountID iotago.AccountID, pool *account.Pool) bool {
+		accountData, exists, accountErr := mainEngine.Ledger.Account(accountID, latestCommitment.Slot())
+		if accountErr != nil {
+			err = ierrors.Wrapf(echo.ErrInternalServerError, "failed to get account %s from the Ledger: %s", accountID.ToHex(), accountErr)
+
+			return false
+		}
+
+		var stakingEndEpoch iotago.EpochIndex
+		if exists {
+			stakingEndEpoch = accountData.StakeEndEpoch
+		}
+
+		validator, validatorErr := validatorOverview(accountID, stakingEndEpoch, pool.PoolStake, pool.ValidatorStake, pool.FixedCost, committee, latestCommitment.Slot())
+		if validatorErr != nil {
+			err = validatorErr
+
+			return false
+		}
+		resp.CurrentEpoch.Validators = append(resp.CurrentEpoch.Validators, validator)
+
+		return true
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if includeNext {
+		registeredValidators, err := mainEngine.SybilProtection.OrderedRegisteredCandidateValidatorsList(currentEpoch + 1)
+		if err != nil {
+			return nil, ierrors.Wrapf(echo.ErrInternalServerError, "failed to get ordered registered validators list for epoch %d: %s", currentEpoch+1, err)
+		}
+
+		resp.NextEpoch = &EpochValidatorsResponse{
+			Epoch:      currentEpoch + 1,
+			Validators: make([]*ValidatorOverviewResponse, 0, len(registeredValidators)),
+		}
+
+		for _, registeredValidator := range registeredValidators {
+			_, address, err := iotago.ParseBech32(registeredValidator.AddressBech32)
+			if err != nil {
+				return nil, ierrors.Wrapf(echo.ErrInternalServerError, "failed to parse validator address %s: %s", registeredValidator.AddressBech32, err)
+			}
+
+			accountAddress, ok := address.(*iotago.AccountAddress)
+			if !ok {
+				return nil, ierrors.Wrapf(echo.ErrInternalServerError, "validator address %s is not an account address", registeredValidator.AddressBech32)
+			}
+
+			validator, err := validatorOverview(accountAddress.AccountID(), registeredValidator.StakingEndEpoch, registeredValidator.PoolStake, registeredValidator.ValidatorStake, registeredValidator.FixedCost, committee, latestCommitment.Slot())
+			if err != nil {
+				return nil, err
+			}
+			resp.NextEpoch.Validators = append(resp.NextEpoch.Validators, validator)
+		}
+	}
+
+	sort.Slice(resp.CurrentEpoch.Validators, func(i, j int) bool {
+		return resp.CurrentEpoch.Validators[i].ValidatorStake > resp.CurrentEpoch.Validators[j].ValidatorStake
+	})
+
+	return resp, nil
+}
+
+// validatorOverview combines the given stake of a validator with its activity, its online status in the given committee
+// and its performance factor in the given slot.
+func validatorOverview(accountID iotago.AccountID, stakingEndEpoch iotago.EpochIndex, poolStake iotago.BaseToken, validatorStake iotago.BaseToken, fixedCost iotago.Mana, committee *account.SeatedAccounts, slot iotago.SlotIndex) (*ValidatorOverviewResponse, error) {
+	sybilProtection := deps.Protocol.Engines.Main.Get().SybilProtection
+	nextEpoch := deps.Protocol.APIForSlot(slot).TimeProvider().EpochFromSlot(slot) + 1
+
+	active, err := sybilProtection.IsCandidateActive(accountID, nextEpoch)
+	if err != nil {
+		return nil, ierrors.Wrapf(echo.ErrInternalServerError, "failed to check if account %s is an active candidate: %s", accountID.ToHex(), err)
+	}
+
+	performanceFactor, err := sybilProtection.ValidatorPerformanceFactor(accountID, slot)
+	if err != nil {
+		return nil, ierrors.Wrapf(echo.ErrInternalServerError, "failed to get performance factor of account %s: %s", accountID.ToHex(), err)
+	}
+
+	var online bool
+	if seat, isMember := committee.GetSeat(accountID); isMember {
+		online = sybilProtection.SeatManager().OnlineCommittee().Has(seat)
+	}
+
+	return &ValidatorOverviewResponse{
+		AddressBech32:           accountID.ToAddress().Bech32(deps.Protocol.CommittedAPI().ProtocolParameters().Bech32HRP()),
+		StakingEndEpoch:         stakingEndEpoch,
+		PoolStake:               poolStake,
+		ValidatorStake:          validatorStake,
+		DelegatedStake:          poolStake - validatorStake,
+		FixedCost:               fixedCost,
+		Active:                  active,
+		Online:                  online,
+		LatestPerformanceFactor: performanceFactor,
+	}, nil
+}
+
 func rewardsByOutputID(c echo.Context) (*api.ManaRewardsResponse, error) {
 	outputID, err := httpserver.ParseOutputIDParam(c, api.ParameterOutputID)
 	if err != nil {
@@ -288,3 +448,79 @@ func selectedCommittee(c echo.Context) (*api.CommitteeResponse, error) {
 		TotalValidatorStake: accounts.TotalValidatorStake(),
 	}, nil
 }
+
+func committeePreview() (*CommitteePreviewResponse, error) {
+	preview, err := deps.Protocol.Engines.Main.Get().SybilProtection.CommitteePreview()
+	if err != nil {
+		return nil, ierrors.Wrapf(echo.ErrInternalServerError, "failed to preview committee: %s", err)
+	}
+
+	hrp := deps.Protocol.APIForSlot(preview.Slot).ProtocolParameters().Bech32HRP()
+
+	candidates := make([]*CommitteeCandidateResponse, 0, len(preview.Candidates))
+	for _, candidate := range preview.Candidates {
+		candidateResponse := &CommitteeCandidateResponse{
+			Rank:            candidate.Rank,
+			AddressBech32:   candidate.AccountID.ToAddress().Bech32(hrp),
+			PoolStake:       candidate.PoolStake,
+			ValidatorStake:  candidate.ValidatorStake,
+			FixedCost:       candidate.FixedCost,
+			StakingEndEpoch: candidate.StakeEndEpoch,
+			Selected:        candidate.Selected,
+		}
+
+		if candidate.Selected {
+			seat := candidate.Seat
+			candidateResponse.Seat = &seat
+		}
+
+		candidates = append(candidates, candidateResponse)
+	}
+
+	return &CommitteePreviewResponse{
+		Epoch:      preview.Epoch,
+		Slot:       preview.Slot,
+		Selected:   preview.Selected,
+		Reused:     preview.Reused,
+		Candidates: candidates,
+	}, nil
+}
+
+func validatorLatestAttestation(c echo.Context) (*ValidatorLatestAttestationResponse, error) {
+	hrp := deps.Protocol.CommittedAPI().ProtocolParameters().Bech32HRP()
+	address, err := httpserver.ParseBech32AddressParam(c, hrp, api.ParameterBech32Address)
+	if err != nil {
+		return nil, err
+	}
+
+	accountAddress, ok := address.(*iotago.AccountAddress)
+	if !ok {
+		return nil, ierrors.Wrapf(httpserver.ErrInvalidParameter, "address %s is not an account address", c.Param(api.ParameterBech32Address))
+	}
+
+	mainEngine := deps.Protocol.Engines.Main.Get()
+	latestCommittedSlot := mainEngine.SyncManager.LatestCommitment().Slot()
+
+	accountID := accountAddress.AccountID()
+	latestAttestation, slot, exists, err := mainEngine.Attestations.LatestAttestation(accountID)
+	if err != nil {
+		return nil, ierrors.Wrapf(echo.ErrInternalServerError, "failed to get latest attestation of account %s: %s", accountID.ToHex(), err)
+	}
+	if !exists {
+		return nil, ierrors.Wrapf(echo.ErrNotFound, "no attestation of account %s is tracked", accountID.ToHex())
+	}
+
+	blockID, err := latestAttestation.BlockID()
+	if err != nil {
+		return nil, ierrors.Wrapf(echo.ErrInternalServerError, "failed to get block ID of the latest attestation of account %s: %s", accountID.ToHex(), err)
+	}
+
+	return &ValidatorLatestAttestationResponse{
+		AddressBech32:       accountAddress.Bech32(hrp),
+		Slot:                slot,
+		BlockID:             blockID,
+		IssuingTime:         latestAttestation.Header.IssuingTime,
+		SlotCommitmentID:    latestAttestation.Header.SlotCommitmentID,
+		LatestCommittedSlot: latestCommittedSlot,
+	}, nil
+}
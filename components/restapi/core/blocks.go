@@ -1,13 +1,21 @@
 package core
 
 import (
+	"bytes"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/labstack/echo/v4"
 
 	"github.com/iotaledger/hive.go/ierrors"
 	"github.com/iotaledger/inx-app/pkg/httpserver"
+	"github.com/iotaledger/iota-core/components/restapi"
 	"github.com/iotaledger/iota-core/pkg/blockhandler"
+	"github.com/iotaledger/iota-core/pkg/model"
+	restapipkg "github.com/iotaledger/iota-core/pkg/restapi"
+	"github.com/iotaledger/iota-core/pkg/retainer"
+	"github.com/iotaledger/iota-core/pkg/storage/database"
 	iotago "github.com/iotaledger/iota.go/v4"
 	"github.com/iotaledger/iota.go/v4/api"
 )
@@ -113,6 +121,104 @@ func blockIssuance() (*api.IssuanceBlockHeaderResponse, error) {
 	return resp, nil
 }
 
+func rootBlocks() (*RootBlocksResponse, error) {
+	latestCommitment, activeRootBlocks, err := deps.Protocol.Engines.Main.Get().ActiveRootBlocks()
+	if err != nil {
+		return nil, ierrors.Wrapf(echo.ErrInternalServerError, "failed to get active root blocks: %s", err)
+	}
+
+	resp := &RootBlocksResponse{
+		LatestCommitmentID: latestCommitment.ID(),
+		RootBlocks:         make([]*RootBlockResponse, 0, len(activeRootBlocks)),
+	}
+
+	for blockID, commitmentID := range activeRootBlocks {
+		resp.RootBlocks = append(resp.RootBlocks, &RootBlockResponse{
+			BlockID:      blockID,
+			CommitmentID: commitmentID,
+		})
+	}
+
+	// the newest root blocks are listed first.
+	sort.Slice(resp.RootBlocks, func(i, j int) bool {
+		if slotI, slotJ := resp.RootBlocks[i].BlockID.Slot(), resp.RootBlocks[j].BlockID.Slot(); slotI != slotJ {
+			return slotI > slotJ
+		}
+
+		return bytes.Compare(resp.RootBlocks[i].BlockID[:], resp.RootBlocks[j].BlockID[:]) < 0
+	})
+
+	return resp, nil
+}
+
+func blockCommitment(c echo.Context) (*BlockCommitmentResponse, error) {
+	blockID, err := httpserver.ParseBlockIDParam(c, api.ParameterBlockID)
+	if err != nil {
+		return nil, ierrors.Wrapf(err, "failed to parse block ID %s", c.Param(api.ParameterBlockID))
+	}
+
+	commitment, err := deps.Protocol.Engines.Main.Get().BlockCommitment(blockID)
+	if err != nil {
+		if ierrors.Is(err, model.ErrBlockNotIncluded) || ierrors.Is(err, database.ErrEpochPruned) {
+			return nil, ierrors.Wrapf(echo.ErrNotFound, "block %s is not included in a commitment: %s", blockID, err)
+		}
+
+		return nil, ierrors.Wrapf(echo.ErrInternalServerError, "failed to get commitment of block %s: %s", blockID, err)
+	}
+
+	return &BlockCommitmentResponse{
+		BlockID:      blockID,
+		CommitmentID: commitment.ID(),
+		Slot:         commitment.Slot(),
+	}, nil
+}
+
+func filteredBlocksBySlot(c echo.Context) (*FilteredBlocksResponse, error) {
+	slot, err := httpserver.ParseSlotParam(c, api.ParameterSlot)
+	if err != nil {
+		return nil, ierrors.Wrapf(err, "failed to parse slot %s", c.Param(api.ParameterSlot))
+	}
+
+	// the decisions of the filters are only persisted up to the max committable age ahead of the latest commitment, so
+	// we do not create storage buckets for slots further in the future.
+	engineInstance := deps.Protocol.Engines.Main.Get()
+	if latestCommittedSlot := engineInstance.SyncManager.LatestCommitment().Slot(); slot > latestCommittedSlot+engineInstance.APIForSlot(slot).ProtocolParameters().MaxCommittableAge() {
+		return nil, ierrors.Wrapf(echo.ErrBadRequest, "slot %d is too far ahead of the latest committed slot %d", slot, latestCommittedSlot)
+	}
+
+	filteredBlocks, err := engineInstance.Retainer.FilteredBlocks(slot)
+	if err != nil {
+		if ierrors.Is(err, database.ErrEpochPruned) {
+			return nil, ierrors.Wrapf(echo.ErrNotFound, "slot %d is already pruned: %s", slot, err)
+		}
+
+		return nil, ierrors.Wrapf(echo.ErrInternalServerError, "failed to get filtered blocks of slot %d: %s", slot, err)
+	}
+
+	hrp := deps.Protocol.APIForSlot(slot).ProtocolParameters().Bech32HRP()
+
+	resp := &FilteredBlocksResponse{
+		Slot:   slot,
+		Blocks: make([]*FilteredBlockResponse, 0, len(filteredBlocks)),
+	}
+
+	for blockID, filteredBlock := range filteredBlocks {
+		resp.Blocks = append(resp.Blocks, &FilteredBlockResponse{
+			BlockID:             blockID,
+			IssuerAddressBech32: filteredBlock.IssuerID.ToAddress().Bech32(hrp),
+			Filter:              filteredBlock.Filter.String(),
+			FailureReason:       filteredBlock.FailureReason,
+			Reason:              filteredBlock.Reason,
+		})
+	}
+
+	sort.Slice(resp.Blocks, func(i, j int) bool {
+		return bytes.Compare(resp.Blocks[i].BlockID[:], resp.Blocks[j].BlockID[:]) < 0
+	})
+
+	return resp, nil
+}
+
 func sendBlock(c echo.Context) (*api.BlockCreatedResponse, error) {
 	iotaBlock, err := httpserver.ParseRequestByHeader(c, deps.Protocol.CommittedAPI(), iotago.BlockFromBytes(deps.Protocol))
 	if err != nil {
@@ -137,3 +243,110 @@ func sendBlock(c echo.Context) (*api.BlockCreatedResponse, error) {
 		BlockID: blockID,
 	}, nil
 }
+
+// queryBlocks returns a page of the retained blocks that match the filters of the query parameters.
+func queryBlocks(c echo.Context) (*BlocksQueryResponse, error) {
+	engineInstance := deps.Protocol.Engines.Main.Get()
+	hrp := deps.Protocol.CommittedAPI().ProtocolParameters().Bech32HRP()
+
+	query := &retainer.BlockQuery{
+		PageSize: int(restapi.MaxPageSize()),
+	}
+
+	if len(c.QueryParam(restapipkg.QueryParameterPageSize)) > 0 {
+		pageSize, err := httpserver.ParseUint32QueryParam(c, restapipkg.QueryParameterPageSize)
+		if err != nil {
+			return nil, ierrors.Wrapf(err, "failed to parse page size %s", c.QueryParam(restapipkg.QueryParameterPageSize))
+		}
+
+		if pageSize != 0 && pageSize < restapi.MaxPageSize() {
+			query.PageSize = int(pageSize)
+		}
+	}
+
+	if cursor := c.QueryParam(restapipkg.QueryParameterCursor); cursor != "" {
+		var err error
+		if query.Cursor, err = iotago.BlockIDFromHexString(cursor); err != nil {
+			return nil, ierrors.Wrapf(httpserver.ErrInvalidParameter, "invalid query parameter %s: %s", restapipkg.QueryParameterCursor, err)
+		}
+	}
+
+	if len(c.QueryParam(QueryParameterIssuer)) > 0 {
+		address, err := httpserver.ParseBech32AddressQueryParam(c, hrp, QueryParameterIssuer)
+		if err != nil {
+			return nil, ierrors.Wrapf(err, "failed to parse issuer %s", c.QueryParam(QueryParameterIssuer))
+		}
+
+		accountAddress, ok := address.(*iotago.AccountAddress)
+		if !ok {
+			return nil, ierrors.Wrapf(httpserver.ErrInvalidParameter, "address %s is not an account address", c.QueryParam(QueryParameterIssuer))
+		}
+
+		issuerID := accountAddress.AccountID()
+		query.IssuerID = &issuerID
+	}
+
+	for _, statuses := range c.QueryParams()[QueryParameterStatus] {
+		for _, statusName := range strings.Split(statuses, ",") {
+			status, err := retainer.BlockStatusFromString(strings.TrimSpace(statusName))
+			if err != nil {
+				return nil, ierrors.Wrapf(httpserver.ErrInvalidParameter, "invalid query parameter %s: %s", QueryParameterStatus, err)
+			}
+
+			query.Statuses = append(query.Statuses, status)
+		}
+	}
+
+	// the blocks are only retained up to the max committable age ahead of the latest commitment, so we do not create
+	// storage buckets for slots further in the future.
+	latestCommittedSlot := engineInstance.SyncManager.LatestCommitment().Slot()
+	query.EndSlot = latestCommittedSlot + engineInstance.LatestAPI().ProtocolParameters().MaxCommittableAge()
+
+	if len(c.QueryParam(QueryParameterEndSlot)) > 0 {
+		endSlot, err := httpserver.ParseSlotQueryParam(c, QueryParameterEndSlot)
+		if err != nil {
+			return nil, ierrors.Wrapf(err, "failed to parse end slot %s", c.QueryParam(QueryParameterEndSlot))
+		}
+
+		query.EndSlot = min(query.EndSlot, endSlot)
+	}
+
+	startSlot, err := httpserver.ParseSlotQueryParam(c, QueryParameterStartSlot)
+	if err != nil {
+		return nil, ierrors.Wrapf(err, "failed to parse start slot %s", c.QueryParam(QueryParameterStartSlot))
+	}
+
+	// skip the slots of pruned epochs instead of iterating them
+	query.StartSlot = startSlot
+	if lastPrunedEpoch, hasPruned := engineInstance.Storage.LastPrunedEpoch(); hasPruned {
+		query.StartSlot = max(query.StartSlot, engineInstance.LatestAPI().TimeProvider().EpochStart(lastPrunedEpoch+1))
+	}
+
+	if startSlot > query.EndSlot {
+		return nil, ierrors.Wrapf(httpserver.ErrInvalidParameter, "start slot %d is after the end slot %d", startSlot, query.EndSlot)
+	}
+
+	blocks, nextCursor, err := engineInstance.Retainer.QueryBlocks(query)
+	if err != nil {
+		return nil, ierrors.Wrapf(echo.ErrInternalServerError, "failed to query blocks: %s", err)
+	}
+
+	resp := &BlocksQueryResponse{
+		Blocks:   make([]*QueriedBlockResponse, 0, len(blocks)),
+		PageSize: uint32(query.PageSize),
+	}
+
+	for _, block := range blocks {
+		resp.Blocks = append(resp.Blocks, &QueriedBlockResponse{
+			BlockID:             block.BlockID,
+			IssuerAddressBech32: block.IssuerID.ToAddress().Bech32(hrp),
+			Status:              block.Status.String(),
+		})
+	}
+
+	if nextCursor != iotago.EmptyBlockID {
+		resp.Cursor = nextCursor.ToHex()
+	}
+
+	return resp, nil
+}
@@ -1,12 +1,16 @@
 package core
 
 import (
+	"encoding/hex"
+
 	"github.com/labstack/echo/v4"
 
 	"github.com/iotaledger/hive.go/ierrors"
 	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine"
 	iotago "github.com/iotaledger/iota.go/v4"
 	"github.com/iotaledger/iota.go/v4/api"
+	"github.com/iotaledger/iota.go/v4/hexutil"
 )
 
 func getCommitmentBySlot(slot iotago.SlotIndex, latestCommitment ...*model.Commitment) (*model.Commitment, error) {
@@ -106,3 +110,63 @@ func getUTXOChangesFull(commitmentID iotago.CommitmentID) (*api.UTXOChangesFullR
 		ConsumedOutputs: consumedOutputs,
 	}, nil
 }
+
+func commitmentWeight(commitmentID iotago.CommitmentID) (*CommitmentWeightResponse, error) {
+	// load the commitment to check if it matches the given commitmentID
+	if _, err := getCommitmentByID(commitmentID); err != nil {
+		return nil, err
+	}
+
+	weight, err := engine.NewCommitmentAPI(deps.Protocol.Engines.Main.Get(), commitmentID).Weight()
+	if err != nil {
+		return nil, ierrors.Wrapf(echo.ErrInternalServerError, "failed to get weight of commitment %s: %s", commitmentID, err)
+	}
+
+	hrp := deps.Protocol.APIForSlot(commitmentID.Slot()).ProtocolParameters().Bech32HRP()
+
+	attestations := make([]*SeatAttestationResponse, len(weight.Attestations))
+	for i, attestation := range weight.Attestations {
+		attestations[i] = &SeatAttestationResponse{
+			Seat:                attestation.Seat,
+			IssuerAddressBech32: attestation.IssuerID.ToAddress().Bech32(hrp),
+			BlockID:             attestation.BlockID,
+		}
+	}
+
+	return &CommitmentWeightResponse{
+		CommitmentID:           weight.CommitmentID,
+		CumulativeWeight:       weight.CumulativeWeight,
+		ParentCumulativeWeight: weight.ParentCumulativeWeight,
+		WeightDelta:            weight.WeightDelta(),
+		CommitteeSize:          weight.CommitteeSize,
+		AttestedSeats:          hex.EncodeToString(weight.AttestedSeats),
+		Attestations:           attestations,
+	}, nil
+}
+
+func commitmentRoots(commitment *model.Commitment) (*CommitmentRootsResponse, error) {
+	roots, err := engine.NewCommitmentAPI(deps.Protocol.Engines.Main.Get(), commitment.ID()).Roots()
+	if err != nil {
+		return nil, ierrors.Wrapf(echo.ErrNotFound, "failed to load roots of commitment %s: %s", commitment.ID(), err)
+	}
+
+	rootsBytes, err := deps.Protocol.APIForSlot(commitment.Slot()).Encode(roots)
+	if err != nil {
+		return nil, ierrors.Wrapf(echo.ErrInternalServerError, "failed to encode roots of commitment %s: %s", commitment.ID(), err)
+	}
+
+	return &CommitmentRootsResponse{
+		CommitmentID:           commitment.ID(),
+		RootsID:                commitment.RootsID(),
+		TangleRoot:             roots.TangleRoot,
+		StateMutationRoot:      roots.StateMutationRoot,
+		StateRoot:              roots.StateRoot,
+		AccountRoot:            roots.AccountRoot,
+		AttestationsRoot:       roots.AttestationsRoot,
+		CommitteeRoot:          roots.CommitteeRoot,
+		RewardsRoot:            roots.RewardsRoot,
+		ProtocolParametersHash: roots.ProtocolParametersHash,
+		RootsBytes:             hexutil.EncodeHex(rootsBytes),
+		CommitmentBytes:        hexutil.EncodeHex(commitment.Data()),
+	}, nil
+}
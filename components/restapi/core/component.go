@@ -19,6 +19,91 @@ import (
 	"github.com/iotaledger/iota.go/v4/api"
 )
 
+const (
+	// RouteRootBlocks is the route to get the active root blocks of the latest committed slot.
+	// GET returns the latest commitment ID and the root blocks together with the commitment IDs they commit to.
+	RouteRootBlocks = "/root-blocks"
+
+	// RouteValidatorsOverview is the route to get an overview of the validators of the current and the next epoch.
+	// GET returns the stake, fixed cost, activity, online status and latest performance factor of the validators.
+	// The validator candidates of the next epoch are only included if the "includeNext" query parameter is set.
+	RouteValidatorsOverview = "/validators-overview"
+
+	// RouteTransactionManaTrace is the route to get the mana trace of a committed transaction.
+	// GET returns the mana that the transaction allotted to accounts, the mana that was burned by allotments to
+	// accounts without block issuance credits and the resulting changes of the block issuance credits.
+	RouteTransactionManaTrace = "/transactions/:" + api.ParameterTransactionID + "/mana-trace"
+
+	// RouteTransactionsPending is the route to get the transactions that are pending in the mempool.
+	// GET returns the attached but not yet accepted transactions together with their conflicts, their attachments and
+	// their booking state.
+	RouteTransactionsPending = "/transactions/pending"
+
+	// RouteFilteredBlocksBySlot is the route to get the blocks of a slot that were dropped by the filters.
+	// GET returns the IDs and issuers of the dropped blocks together with the filter and the reason of the decision.
+	RouteFilteredBlocksBySlot = "/blocks/filtered/by-slot/:" + api.ParameterSlot
+
+	// RouteBlockCommitment is the route to get the commitment that includes a block.
+	// GET returns the ID and the slot of the commitment of the slot in which the block was accepted.
+	RouteBlockCommitment = "/blocks/:" + api.ParameterBlockID + "/commitment"
+
+	// RouteAccountsAggregatesBySlot is the route to get the aggregated statistics of the accounts ledger at a committed slot.
+	// GET returns the number of accounts and validators as well as the total validator and delegated stake.
+	RouteAccountsAggregatesBySlot = "/accounts/aggregates/by-slot/:" + api.ParameterSlot
+
+	// RouteCommitmentWeightByID is the route to get the weight accounting of a commitment by its ID.
+	// GET returns the seats that contributed attestations, the weight delta to the parent and the cumulative weight.
+	RouteCommitmentWeightByID = "/commitments/:" + api.ParameterCommitmentID + "/weight"
+
+	// RouteCommitmentWeightBySlot is the route to get the weight accounting of a commitment by its slot.
+	// GET returns the seats that contributed attestations, the weight delta to the parent and the cumulative weight.
+	RouteCommitmentWeightBySlot = "/commitments/by-slot/:" + api.ParameterSlot + "/weight"
+
+	// RouteCommitmentRootsByID is the route to get the decomposed roots of a commitment by its ID.
+	// GET returns the roots that the commitment commits to, as well as the serialized roots and commitment.
+	RouteCommitmentRootsByID = "/commitments/:" + api.ParameterCommitmentID + "/roots"
+
+	// RouteCommitmentRootsBySlot is the route to get the decomposed roots of a commitment by its slot.
+	// GET returns the roots that the commitment commits to, as well as the serialized roots and commitment.
+	RouteCommitmentRootsBySlot = "/commitments/by-slot/:" + api.ParameterSlot + "/roots"
+
+	// RouteCommitteePreview is the route to get a preview of the committee of the next epoch.
+	// GET returns the registered candidates ranked by the committee selection and whether they are expected to get a seat.
+	RouteCommitteePreview = "/committee/preview"
+
+	// RouteValidatorLatestAttestation is the route to get the newest attestation of a committee member.
+	// GET returns the block ID, the issuing time and the attested commitment of the newest attestation that the node
+	// tracks for the validator, together with the latest committed slot.
+	RouteValidatorLatestAttestation = "/validators/:" + api.ParameterBech32Address + "/latest-attestation"
+
+	// RouteConflicts is the route to get statistics about the conflicts that are tracked by the SpendDAG.
+	// GET returns the number of live conflicts and conflict sets, the number of accepted and rejected conflicts and the
+	// maximum conflict depth.
+	RouteConflicts = "/conflicts"
+
+	// RouteBlocksQuery is the route to query the retained blocks.
+	// GET returns the blocks in a slot range, optionally filtered by issuer and status (pending, accepted, orphaned or
+	// filtered), ordered by slot and block ID and paginated by the cursor of the next page.
+	RouteBlocksQuery = "/blocks/query"
+)
+
+const (
+	// QueryParameterIncludeNext is used to include the validator candidates of the next epoch.
+	QueryParameterIncludeNext = "includeNext"
+
+	// QueryParameterIssuer is used to filter for the blocks of the given issuer (bech32 account address).
+	QueryParameterIssuer = "issuer"
+
+	// QueryParameterStartSlot is used to specify the first slot of a queried slot range.
+	QueryParameterStartSlot = "startSlot"
+
+	// QueryParameterEndSlot is used to specify the last slot of a queried slot range.
+	QueryParameterEndSlot = "endSlot"
+
+	// QueryParameterStatus is used to filter for the blocks with the given statuses (can be repeated or comma separated).
+	QueryParameterStatus = "status"
+)
+
 func init() {
 	Component = &app.Component{
 		Name:      "CoreAPIV3",
@@ -108,6 +193,122 @@ func configure() error {
 		return responseByHeader(c, resp)
 	}, checkNodeSynced())
 
+	routeGroup.GET(RouteRootBlocks, func(c echo.Context) error {
+		resp, err := rootBlocks()
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	}, checkNodeSynced())
+
+	routeGroup.GET(RouteFilteredBlocksBySlot, func(c echo.Context) error {
+		resp, err := filteredBlocksBySlot(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	})
+
+	routeGroup.GET(RouteBlocksQuery, func(c echo.Context) error {
+		resp, err := queryBlocks(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	})
+
+	routeGroup.GET(RouteBlockCommitment, func(c echo.Context) error {
+		resp, err := blockCommitment(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	})
+
+	routeGroup.GET(RouteAccountsAggregatesBySlot, func(c echo.Context) error {
+		resp, err := accountsAggregatesBySlot(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	})
+
+	routeGroup.GET(RouteCommitmentWeightByID, func(c echo.Context) error {
+		commitmentID, err := httpserver.ParseCommitmentIDParam(c, api.ParameterCommitmentID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := commitmentWeight(commitmentID)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	})
+
+	routeGroup.GET(RouteCommitmentWeightBySlot, func(c echo.Context) error {
+		slot, err := httpserver.ParseSlotParam(c, api.ParameterSlot)
+		if err != nil {
+			return err
+		}
+
+		commitment, err := getCommitmentBySlot(slot)
+		if err != nil {
+			return err
+		}
+
+		resp, err := commitmentWeight(commitment.ID())
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	})
+
+	routeGroup.GET(RouteCommitmentRootsByID, func(c echo.Context) error {
+		commitmentID, err := httpserver.ParseCommitmentIDParam(c, api.ParameterCommitmentID)
+		if err != nil {
+			return err
+		}
+
+		commitment, err := getCommitmentByID(commitmentID)
+		if err != nil {
+			return err
+		}
+
+		resp, err := commitmentRoots(commitment)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	})
+
+	routeGroup.GET(RouteCommitmentRootsBySlot, func(c echo.Context) error {
+		slot, err := httpserver.ParseSlotParam(c, api.ParameterSlot)
+		if err != nil {
+			return err
+		}
+
+		commitment, err := getCommitmentBySlot(slot)
+		if err != nil {
+			return err
+		}
+
+		resp, err := commitmentRoots(commitment)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	})
+
 	routeGroup.GET(api.EndpointWithEchoParameters(api.CoreEndpointCommitmentByID), func(c echo.Context) error {
 		commitmentID, err := httpserver.ParseCommitmentIDParam(c, api.ParameterCommitmentID)
 		if err != nil {
@@ -286,6 +487,32 @@ func configure() error {
 		return responseByHeader(c, resp)
 	}, checkNodeSynced())
 
+	routeGroup.GET(RouteTransactionManaTrace, func(c echo.Context) error {
+		resp, err := transactionManaTrace(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	})
+
+	routeGroup.GET(RouteTransactionsPending, func(c echo.Context) error {
+		return httpserver.JSONResponse(c, http.StatusOK, pendingTransactions(deps.Protocol.Engines.Main.Get().Ledger.MemPool()))
+	})
+
+	routeGroup.GET(RouteConflicts, func(c echo.Context) error {
+		return httpserver.JSONResponse(c, http.StatusOK, conflictsStatistics())
+	})
+
+	routeGroup.GET(RouteValidatorsOverview, func(c echo.Context) error {
+		resp, err := validatorsOverview(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	}, checkNodeSynced())
+
 	routeGroup.GET(api.EndpointWithEchoParameters(api.CoreEndpointValidatorsAccount), func(c echo.Context) error {
 		resp, err := validatorByAccountAddress(c)
 		if err != nil {
@@ -313,6 +540,24 @@ func configure() error {
 		return responseByHeader(c, resp)
 	}, checkNodeSynced())
 
+	routeGroup.GET(RouteCommitteePreview, func(c echo.Context) error {
+		resp, err := committeePreview()
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	}, checkNodeSynced())
+
+	routeGroup.GET(RouteValidatorLatestAttestation, func(c echo.Context) error {
+		resp, err := validatorLatestAttestation(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	})
+
 	return nil
 }
 
@@ -0,0 +1,294 @@
+package core
+
+import (
+	"time"
+
+	"github.com/iotaledger/iota-core/pkg/core/account"
+	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/api"
+)
+
+type (
+	RootBlocksResponse struct {
+		// The ID of the commitment of the latest committed slot.
+		LatestCommitmentID iotago.CommitmentID `json:"latestCommitmentId"`
+		// The root blocks that are active with respect to the latest committed slot (newest first).
+		RootBlocks []*RootBlockResponse `json:"rootBlocks"`
+	}
+
+	RootBlockResponse struct {
+		// The ID of the root block.
+		BlockID iotago.BlockID `json:"blockId"`
+		// The ID of the commitment that the root block commits to.
+		CommitmentID iotago.CommitmentID `json:"commitmentId"`
+	}
+
+	BlockCommitmentResponse struct {
+		// The ID of the block.
+		BlockID iotago.BlockID `json:"blockId"`
+		// The ID of the commitment that includes the block.
+		CommitmentID iotago.CommitmentID `json:"commitmentId"`
+		// The slot of the commitment that includes the block.
+		Slot iotago.SlotIndex `json:"slot"`
+	}
+
+	ValidatorsOverviewResponse struct {
+		// The ID of the commitment of the latest committed slot that the overview is based on.
+		LatestCommitmentID iotago.CommitmentID `json:"latestCommitmentId"`
+		// The validators of the committee of the current epoch.
+		CurrentEpoch *EpochValidatorsResponse `json:"currentEpoch"`
+		// The registered validator candidates for the next epoch (only included if requested).
+		NextEpoch *EpochValidatorsResponse `json:"nextEpoch,omitempty"`
+	}
+
+	EpochValidatorsResponse struct {
+		// The epoch of the validators.
+		Epoch iotago.EpochIndex `json:"epoch"`
+		// The validators of the epoch (ordered by descending validator stake).
+		Validators []*ValidatorOverviewResponse `json:"validators"`
+	}
+
+	ValidatorOverviewResponse struct {
+		// The account address of the validator.
+		AddressBech32 string `json:"address"`
+		// The epoch until which the validator registered to stake.
+		StakingEndEpoch iotago.EpochIndex `json:"stakingEndEpoch"`
+		// The sum of the validator stake and the delegated stake.
+		PoolStake iotago.BaseToken `json:"poolStake"`
+		// The stake of the validator.
+		ValidatorStake iotago.BaseToken `json:"validatorStake"`
+		// The stake that was delegated to the validator.
+		DelegatedStake iotago.BaseToken `json:"delegatedStake"`
+		// The fixed cost that the validator receives from the total pool reward.
+		FixedCost iotago.Mana `json:"fixedCost"`
+		// Whether the validator was active recently and would be considered during the next committee selection.
+		Active bool `json:"active"`
+		// Whether the validator is a member of the current committee that is considered online.
+		Online bool `json:"online"`
+		// The performance factor of the validator in the latest committed slot.
+		LatestPerformanceFactor uint64 `json:"latestPerformanceFactor"`
+	}
+
+	PendingTransactionsResponse struct {
+		// The transactions that are pending in the mempool (ordered by their IDs).
+		Transactions []*PendingTransactionResponse `json:"transactions"`
+	}
+
+	ConflictsResponse struct {
+		// The number of conflicts that are tracked by the SpendDAG.
+		Conflicts int `json:"conflicts"`
+		// The number of conflict sets that are tracked by the SpendDAG.
+		ConflictSets int `json:"conflictSets"`
+		// The number of tracked conflicts that are neither accepted nor rejected.
+		PendingConflicts int `json:"pendingConflicts"`
+		// The number of tracked conflicts that are accepted.
+		AcceptedConflicts int `json:"acceptedConflicts"`
+		// The number of tracked conflicts that are rejected.
+		RejectedConflicts int `json:"rejectedConflicts"`
+		// The length of the longest chain of conflicts that inherit from each other.
+		MaxConflictDepth int `json:"maxConflictDepth"`
+	}
+
+	PendingTransactionResponse struct {
+		// The ID of the transaction.
+		TransactionID iotago.TransactionID `json:"transactionId"`
+		// The IDs of the conflicts that the transaction belongs to.
+		ConflictIDs []iotago.TransactionID `json:"conflictIds"`
+		// The IDs of the blocks that validly attached the transaction.
+		Attachments []iotago.BlockID `json:"attachments"`
+		// The ID of the earliest attachment that was included (omitted if no attachment was included yet).
+		EarliestIncludedAttachment *iotago.BlockID `json:"earliestIncludedAttachment,omitempty"`
+		// Whether all inputs of the transaction are known.
+		Solid bool `json:"solid"`
+		// Whether the transaction was executed.
+		Executed bool `json:"executed"`
+		// Whether the transaction was booked into the spend DAG.
+		Booked bool `json:"booked"`
+		// Whether the transaction spends inputs that are also spent by other transactions.
+		Conflicting bool `json:"conflicting"`
+	}
+
+	TransactionManaTraceResponse struct {
+		// The ID of the transaction.
+		TransactionID iotago.TransactionID `json:"transactionId"`
+		// The slot in which the transaction was committed.
+		Slot iotago.SlotIndex `json:"slot"`
+		// The total amount of mana that was added to the block issuance credits of accounts.
+		AllottedMana iotago.Mana `json:"allottedMana"`
+		// The total amount of mana that was burned by allotments to accounts without block issuance credits.
+		BurnedMana iotago.Mana `json:"burnedMana"`
+		// The allotments of the transaction (in the order of the transaction).
+		Allotments []*AllotmentTraceResponse `json:"allotments"`
+		// The resulting changes of the block issuance credits per account (in the order of the first allotment).
+		BICChanges []*BICChangeResponse `json:"bicChanges"`
+	}
+
+	AllotmentTraceResponse struct {
+		// The account address that the mana was allotted to.
+		AddressBech32 string `json:"address"`
+		// The amount of mana that was allotted.
+		Mana iotago.Mana `json:"mana"`
+		// Whether the mana was burned because the account has no block issuance credits.
+		Burned bool `json:"burned"`
+	}
+
+	BICChangeResponse struct {
+		// The account address whose block issuance credits changed.
+		AddressBech32 string `json:"address"`
+		// The change of the block issuance credits of the account.
+		Change iotago.BlockIssuanceCredits `json:"change"`
+	}
+
+	FilteredBlocksResponse struct {
+		// The slot of the filtered blocks.
+		Slot iotago.SlotIndex `json:"slot"`
+		// The blocks of the slot that were dropped by the filters (ordered by block ID).
+		Blocks []*FilteredBlockResponse `json:"blocks"`
+	}
+
+	BlocksQueryResponse struct {
+		// The blocks that match the query (ordered by slot and block ID).
+		Blocks []*QueriedBlockResponse `json:"blocks"`
+		// The maximum number of blocks per page.
+		PageSize uint32 `json:"pageSize"`
+		// The cursor of the next page (omitted if there are no more blocks).
+		Cursor string `json:"cursor,omitempty"`
+	}
+
+	QueriedBlockResponse struct {
+		// The ID of the block.
+		BlockID iotago.BlockID `json:"blockId"`
+		// The account address of the issuer of the block.
+		IssuerAddressBech32 string `json:"issuer"`
+		// The status of the block (pending, accepted, orphaned or filtered).
+		Status string `json:"status"`
+	}
+
+	FilteredBlockResponse struct {
+		// The ID of the block.
+		BlockID iotago.BlockID `json:"blockId"`
+		// The account address of the issuer of the block.
+		IssuerAddressBech32 string `json:"issuer"`
+		// The filter that dropped the block.
+		Filter string `json:"filter"`
+		// The failure reason of the block.
+		FailureReason api.BlockFailureReason `json:"failureReason"`
+		// The (truncated) error message of the filter.
+		Reason string `json:"reason"`
+	}
+
+	AccountsAggregatesResponse struct {
+		// The committed slot of the aggregates.
+		Slot iotago.SlotIndex `json:"slot"`
+		// The epoch of the slot.
+		Epoch iotago.EpochIndex `json:"epoch"`
+		// The number of accounts in the accounts ledger.
+		AccountsCount uint64 `json:"accountsCount"`
+		// The number of accounts with a validator stake.
+		ValidatorsCount uint64 `json:"validatorsCount"`
+		// The sum of the validator stake of all accounts.
+		ValidatorStake iotago.BaseToken `json:"validatorStake"`
+		// The sum of the stake that is delegated to all accounts.
+		DelegatedStake iotago.BaseToken `json:"delegatedStake"`
+	}
+
+	CommitmentWeightResponse struct {
+		// The ID of the commitment.
+		CommitmentID iotago.CommitmentID `json:"commitmentId"`
+		// The cumulative weight of the commitment.
+		CumulativeWeight uint64 `json:"cumulativeWeight"`
+		// The cumulative weight of the parent of the commitment.
+		ParentCumulativeWeight uint64 `json:"parentCumulativeWeight"`
+		// The weight that the commitment added on top of the weight of its parent.
+		WeightDelta uint64 `json:"weightDelta"`
+		// The number of seats of the committee of the slot of the commitment.
+		CommitteeSize int `json:"committeeSize"`
+		// The hex encoded bitmap of the seats that contributed an attestation (bit i of byte i/8 is set for seat i).
+		AttestedSeats string `json:"attestedSeats"`
+		// The attestations that contributed to the weight of the commitment (in the order of the attestations tree).
+		Attestations []*SeatAttestationResponse `json:"attestations"`
+	}
+
+	CommitmentRootsResponse struct {
+		// The ID of the commitment.
+		CommitmentID iotago.CommitmentID `json:"commitmentId"`
+		// The ID of the roots (the merkle root over all roots) that is contained in the commitment.
+		RootsID iotago.Identifier `json:"rootsId"`
+		// The root of the accepted blocks of the slot.
+		TangleRoot iotago.Identifier `json:"tangleRoot"`
+		// The root of the accepted transactions of the slot.
+		StateMutationRoot iotago.Identifier `json:"stateMutationRoot"`
+		// The root of the unspent outputs.
+		StateRoot iotago.Identifier `json:"stateRoot"`
+		// The root of the accounts.
+		AccountRoot iotago.Identifier `json:"accountRoot"`
+		// The root of the attestations (weights) of the slot.
+		AttestationsRoot iotago.Identifier `json:"attestationsRoot"`
+		// The root of the committee.
+		CommitteeRoot iotago.Identifier `json:"committeeRoot"`
+		// The root of the rewards.
+		RewardsRoot iotago.Identifier `json:"rewardsRoot"`
+		// The hash of the protocol parameters.
+		ProtocolParametersHash iotago.Identifier `json:"protocolParametersHash"`
+		// The hex encoded serialized roots.
+		RootsBytes string `json:"rootsBytes"`
+		// The hex encoded serialized commitment.
+		CommitmentBytes string `json:"commitmentBytes"`
+	}
+
+	SeatAttestationResponse struct {
+		// The seat of the issuer in the committee of the slot of the attested block.
+		Seat account.SeatIndex `json:"seat"`
+		// The account address of the issuer of the attestation.
+		IssuerAddressBech32 string `json:"issuer"`
+		// The ID of the block that contains the attestation.
+		BlockID iotago.BlockID `json:"blockId"`
+	}
+
+	CommitteePreviewResponse struct {
+		// The epoch whose committee is previewed.
+		Epoch iotago.EpochIndex `json:"epoch"`
+		// The committed slot whose accounts ledger state was used to rank the candidates.
+		Slot iotago.SlotIndex `json:"slot"`
+		// Whether the committee of the epoch was already selected and is therefore final.
+		Selected bool `json:"selected"`
+		// Whether the committee of the current epoch is (expected to be) reused in the epoch.
+		Reused bool `json:"reused"`
+		// The candidates ordered by their rank in the committee selection.
+		Candidates []*CommitteeCandidateResponse `json:"candidates"`
+	}
+
+	CommitteeCandidateResponse struct {
+		// The position of the candidate in the committee selection (starting at 0).
+		Rank int `json:"rank"`
+		// The account address of the candidate.
+		AddressBech32 string `json:"address"`
+		// The sum of the validator stake and the stake delegated to the candidate.
+		PoolStake iotago.BaseToken `json:"poolStake"`
+		// The stake of the candidate.
+		ValidatorStake iotago.BaseToken `json:"validatorStake"`
+		// The fixed cost that the candidate declared.
+		FixedCost iotago.Mana `json:"fixedCost"`
+		// The epoch until which the candidate declared to stake.
+		StakingEndEpoch iotago.EpochIndex `json:"stakingEndEpoch"`
+		// Whether the candidate is expected to be part of the committee.
+		Selected bool `json:"selected"`
+		// The seat of the candidate in the committee (only included if the candidate is selected).
+		Seat *account.SeatIndex `json:"seat,omitempty"`
+	}
+
+	ValidatorLatestAttestationResponse struct {
+		// The account address of the validator.
+		AddressBech32 string `json:"address"`
+		// The slot of the block that contains the newest attestation of the validator.
+		Slot iotago.SlotIndex `json:"slot"`
+		// The ID of the block that contains the newest attestation of the validator.
+		BlockID iotago.BlockID `json:"blockId"`
+		// The issuing time of the block that contains the newest attestation of the validator.
+		IssuingTime time.Time `json:"issuingTime"`
+		// The ID of the commitment that the validator attested to.
+		SlotCommitmentID iotago.CommitmentID `json:"slotCommitmentId"`
+		// The latest committed slot of the node.
+		LatestCommittedSlot iotago.SlotIndex `json:"latestCommittedSlot"`
+	}
+)
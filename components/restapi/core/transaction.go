@@ -1,11 +1,16 @@
 package core
 
 import (
+	"bytes"
+	"sort"
+
 	"github.com/labstack/echo/v4"
 
 	"github.com/iotaledger/hive.go/ierrors"
 	"github.com/iotaledger/inx-app/pkg/httpserver"
 	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/ledger"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/mempool"
 	iotago "github.com/iotaledger/iota.go/v4"
 	"github.com/iotaledger/iota.go/v4/api"
 )
@@ -78,3 +83,112 @@ func transactionMetadataFromTransactionID(c echo.Context) (*api.TransactionMetad
 
 	return metadata, nil
 }
+
+func transactionManaTrace(c echo.Context) (*TransactionManaTraceResponse, error) {
+	txID, err := httpserver.ParseTransactionIDParam(c, api.ParameterTransactionID)
+	if err != nil {
+		return nil, ierrors.Wrapf(err, "failed to parse transaction ID %s", c.Param(api.ParameterTransactionID))
+	}
+
+	// the outputs of a transaction are booked in the slot in which the transaction was committed
+	outputID := iotago.OutputIDFromTransactionIDAndIndex(txID, 0)
+	output, spent, err := deps.Protocol.Engines.Main.Get().Ledger.OutputOrSpent(outputID)
+	if err != nil {
+		return nil, ierrors.Wrapf(echo.ErrNotFound, "transaction %s is not committed: %s", txID.ToHex(), err)
+	}
+
+	if output == nil {
+		output = spent.Output()
+	}
+
+	slot := output.SlotBooked()
+	manaTrace, exists, err := deps.Protocol.Engines.Main.Get().Ledger.ManaTrace(txID, slot)
+	if err != nil {
+		return nil, ierrors.Wrapf(echo.ErrInternalServerError, "failed to get mana trace of transaction %s in slot %d: %s", txID.ToHex(), slot, err)
+	}
+	if !exists {
+		return nil, ierrors.Wrapf(echo.ErrNotFound, "mana trace of transaction %s not found in slot %d", txID.ToHex(), slot)
+	}
+
+	hrp := deps.Protocol.APIForSlot(slot).ProtocolParameters().Bech32HRP()
+
+	allotments := make([]*AllotmentTraceResponse, 0, len(manaTrace.Allotments))
+	bicChanges := make([]*BICChangeResponse, 0)
+	bicChangesByAccount := make(map[iotago.AccountID]*BICChangeResponse)
+	for _, allotment := range manaTrace.Allotments {
+		address := allotment.AccountID.ToAddress().Bech32(hrp)
+
+		allotments = append(allotments, &AllotmentTraceResponse{
+			AddressBech32: address,
+			Mana:          allotment.Mana,
+			Burned:        allotment.Burned,
+		})
+
+		if allotment.Burned {
+			continue
+		}
+
+		bicChange, exists := bicChangesByAccount[allotment.AccountID]
+		if !exists {
+			bicChange = &BICChangeResponse{AddressBech32: address}
+			bicChangesByAccount[allotment.AccountID] = bicChange
+			bicChanges = append(bicChanges, bicChange)
+		}
+
+		bicChange.Change += allotment.BICChange()
+	}
+
+	return &TransactionManaTraceResponse{
+		TransactionID: txID,
+		Slot:          slot,
+		AllottedMana:  manaTrace.AllottedMana(),
+		BurnedMana:    manaTrace.BurnedMana(),
+		Allotments:    allotments,
+		BICChanges:    bicChanges,
+	}, nil
+}
+
+func pendingTransactions(memPool mempool.MemPool[ledger.BlockVoteRank]) *PendingTransactionsResponse {
+	response := &PendingTransactionsResponse{
+		Transactions: make([]*PendingTransactionResponse, 0),
+	}
+
+	memPool.ForEachPendingTransaction(func(transaction mempool.TransactionMetadata) bool {
+		pendingTransaction := &PendingTransactionResponse{
+			TransactionID: transaction.ID(),
+			ConflictIDs:   transaction.SpenderIDs().ToSlice(),
+			Attachments:   transaction.ValidAttachments(),
+			Solid:         transaction.IsSolid(),
+			Executed:      transaction.IsExecuted(),
+			Booked:        transaction.IsBooked(),
+			Conflicting:   transaction.IsConflicting(),
+		}
+
+		if earliestIncludedAttachment := transaction.EarliestIncludedAttachment(); earliestIncludedAttachment != iotago.EmptyBlockID {
+			pendingTransaction.EarliestIncludedAttachment = &earliestIncludedAttachment
+		}
+
+		response.Transactions = append(response.Transactions, pendingTransaction)
+
+		return true
+	})
+
+	sort.Slice(response.Transactions, func(i, j int) bool {
+		return bytes.Compare(response.Transactions[i].TransactionID[:], response.Transactions[j].TransactionID[:]) < 0
+	})
+
+	return response
+}
+
+func conflictsStatistics() *ConflictsResponse {
+	statistics := deps.Protocol.Engines.Main.Get().Ledger.SpendDAG().Statistics()
+
+	return &ConflictsResponse{
+		Conflicts:         statistics.Spenders,
+		ConflictSets:      statistics.SpendSets,
+		PendingConflicts:  statistics.PendingSpenders,
+		AcceptedConflicts: statistics.AcceptedSpenders,
+		RejectedConflicts: statistics.RejectedSpenders,
+		MaxConflictDepth:  statistics.MaxDepth,
+	}
+}
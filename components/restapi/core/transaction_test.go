@@ -0,0 +1,83 @@
+package core
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/iota-core/pkg/protocol"
+	"github.com/iotaledger/iota-core/pkg/testsuite"
+	"github.com/iotaledger/iota-core/pkg/testsuite/mock"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func TestPendingTransactions(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	defer ts.Shutdown()
+
+	node1 := ts.AddValidatorNode("node1")
+	ts.AddValidatorNode("node2")
+
+	wallet := ts.AddDefaultWallet(node1)
+
+	ts.Run(true, map[string][]options.Option[protocol.Protocol]{})
+
+	tx1 := wallet.CreateBasicOutputsEquallyFromInput("tx1", 1, "Genesis:0")
+	tx2 := wallet.CreateBasicOutputsEquallyFromInput("tx2", 1, "Genesis:0")
+	wallet.CreateBasicOutputsEquallyFromInput("tx3", 1, "tx1:0")
+	tx4 := wallet.CreateBasicOutputsEquallyFromInput("tx4", 1, "tx3:0")
+
+	ts.IssueBasicBlockWithOptions("block1", wallet, tx1, mock.WithStrongParents(ts.BlockID("Genesis")))
+	ts.IssueBasicBlockWithOptions("block2", wallet, tx2, mock.WithStrongParents(ts.BlockID("Genesis")))
+	ts.IssueBasicBlockWithOptions("block4", wallet, tx4, mock.WithStrongParents(ts.BlockID("Genesis")))
+
+	ts.AssertTransactionsExist(wallet.Transactions("tx1", "tx2", "tx4"), true, node1)
+	ts.AssertTransactionsInCacheBooked(wallet.Transactions("tx1", "tx2"), true, node1)
+	ts.AssertTransactionsInCacheBooked(wallet.Transactions("tx4"), false, node1)
+
+	transactionID := func(alias string) iotago.TransactionID {
+		return lo.PanicOnErr(wallet.Transaction(alias).ID())
+	}
+
+	response := pendingTransactions(node1.Protocol.Engines.Main.Get().Ledger.MemPool())
+	require.Len(t, response.Transactions, 3)
+
+	pendingTransactionsByID := make(map[iotago.TransactionID]*PendingTransactionResponse)
+	for i, pendingTransaction := range response.Transactions {
+		if i > 0 {
+			require.Negative(t, bytes.Compare(response.Transactions[i-1].TransactionID[:], pendingTransaction.TransactionID[:]), "transactions are not ordered by their IDs")
+		}
+
+		pendingTransactionsByID[pendingTransaction.TransactionID] = pendingTransaction
+	}
+
+	// the double spends are booked into their own conflicts.
+	for _, alias := range []string{"tx1", "tx2"} {
+		pendingTransaction, exists := pendingTransactionsByID[transactionID(alias)]
+		require.True(t, exists, "transaction %s is not pending", alias)
+
+		require.Equal(t, []iotago.TransactionID{transactionID(alias)}, pendingTransaction.ConflictIDs)
+		require.True(t, pendingTransaction.Solid)
+		require.True(t, pendingTransaction.Executed)
+		require.True(t, pendingTransaction.Booked)
+		require.True(t, pendingTransaction.Conflicting)
+		require.Nil(t, pendingTransaction.EarliestIncludedAttachment)
+	}
+
+	require.Equal(t, []iotago.BlockID{ts.BlockID("block1")}, pendingTransactionsByID[transactionID("tx1")].Attachments)
+	require.Equal(t, []iotago.BlockID{ts.BlockID("block2")}, pendingTransactionsByID[transactionID("tx2")].Attachments)
+
+	// the transaction that spends an unknown input is neither solid nor booked.
+	pendingTransaction, exists := pendingTransactionsByID[transactionID("tx4")]
+	require.True(t, exists, "transaction tx4 is not pending")
+
+	require.Empty(t, pendingTransaction.ConflictIDs)
+	require.False(t, pendingTransaction.Solid)
+	require.False(t, pendingTransaction.Executed)
+	require.False(t, pendingTransaction.Booked)
+	require.False(t, pendingTransaction.Conflicting)
+	require.Nil(t, pendingTransaction.EarliestIncludedAttachment)
+}
@@ -0,0 +1,79 @@
+package indexer
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"go.uber.org/dig"
+
+	"github.com/iotaledger/hive.go/app"
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/iota-core/components/protocol"
+	"github.com/iotaledger/iota-core/components/restapi"
+	protocolpkg "github.com/iotaledger/iota-core/pkg/protocol"
+	restapipkg "github.com/iotaledger/iota-core/pkg/restapi"
+	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/api"
+)
+
+func init() {
+	Component = &app.Component{
+		Name:      "IndexerAPIV2",
+		DepsFunc:  func(cDeps dependencies) { deps = cDeps },
+		Configure: configure,
+		IsEnabled: func(c *dig.Container) bool {
+			return restapi.ParamsRestAPI.Enabled && protocol.ParamsDatabase.OutputIndex.Enabled
+		},
+	}
+}
+
+var (
+	Component *app.Component
+	deps      dependencies
+)
+
+type dependencies struct {
+	dig.In
+
+	Protocol         *protocolpkg.Protocol
+	RestRouteManager *restapipkg.RestRouteManager
+}
+
+func configure() error {
+	// check if RestAPI plugin is disabled
+	if !Component.App().IsComponentEnabled(restapi.Component.Identifier()) {
+		Component.LogPanicf("RestAPI plugin needs to be enabled to use the %s plugin", Component.Name)
+	}
+
+	routeGroup := deps.RestRouteManager.AddRoute(api.IndexerPluginName)
+
+	routeGroup.GET(api.IndexerEndpointOutputsBasic, func(c echo.Context) error {
+		resp, err := outputsByQuery(c, iotago.OutputBasic)
+		if err != nil {
+			return err
+		}
+
+		return responseJSON(c, resp)
+	})
+
+	routeGroup.GET(api.IndexerEndpointOutputsNFTs, func(c echo.Context) error {
+		resp, err := outputsByQuery(c, iotago.OutputNFT)
+		if err != nil {
+			return err
+		}
+
+		return responseJSON(c, resp)
+	})
+
+	return nil
+}
+
+// responseJSON responds with the JSON encoding of the given object (the indexer responses have no binary encoding).
+func responseJSON(c echo.Context, obj any) error {
+	j, err := deps.Protocol.CommittedAPI().JSONEncode(obj)
+	if err != nil {
+		return ierrors.Wrapf(echo.ErrInternalServerError, "failed to encode json data: %s", err)
+	}
+
+	return c.JSONBlob(http.StatusOK, j)
+}
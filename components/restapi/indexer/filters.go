@@ -0,0 +1,239 @@
+package indexer
+
+import (
+	"bytes"
+	"strconv"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/inx-app/pkg/httpserver"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/utxoledger"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// maxTagLength is the maximum length of the tag of a tag feature.
+const maxTagLength = 64
+
+// parseFilters parses the filters of the query parameters and returns the address that the outputs are looked up by in
+// the output index (either the address or the unlockableByAddress query parameter has to be given).
+func parseFilters(c echo.Context) (indexedAddress iotago.Address, filters []outputFilter, err error) {
+	filters = make([]outputFilter, 0)
+
+	for _, addressFilter := range []struct {
+		paramName string
+		addressOf func(output iotago.Output) iotago.Address
+	}{
+		{QueryParameterAddress, ownerAddress},
+		{QueryParameterExpirationReturnAddress, expirationReturnAddress},
+		{QueryParameterStorageDepositReturnAddress, storageDepositReturnAddress},
+		{QueryParameterSender, senderAddress},
+	} {
+		addressFilter := addressFilter
+
+		address, err := parseAddressQueryParam(c, addressFilter.paramName)
+		if err != nil {
+			return nil, nil, err
+		} else if address == nil {
+			continue
+		}
+
+		if addressFilter.paramName == QueryParameterAddress {
+			indexedAddress = address
+		}
+
+		filters = append(filters, func(output *utxoledger.Output, _ iotago.SlotIndex) bool {
+			actualAddress := addressFilter.addressOf(output.Output())
+
+			return actualAddress != nil && actualAddress.Equal(address)
+		})
+	}
+
+	unlockableByAddress, err := parseAddressQueryParam(c, QueryParameterUnlockableByAddress)
+	if err != nil {
+		return nil, nil, err
+	} else if unlockableByAddress != nil {
+		if indexedAddress == nil {
+			indexedAddress = unlockableByAddress
+		}
+
+		filters = append(filters, unlockableBy(unlockableByAddress))
+	}
+
+	if indexedAddress == nil {
+		return nil, nil, ierrors.Wrapf(httpserver.ErrInvalidParameter, "either query parameter %s or %s has to be given", QueryParameterAddress, QueryParameterUnlockableByAddress)
+	}
+
+	for _, presenceFilter := range []struct {
+		paramName string
+		isPresent func(output iotago.Output) bool
+	}{
+		{QueryParameterHasTimelock, func(output iotago.Output) bool { return output.UnlockConditionSet().HasTimelockCondition() }},
+		{QueryParameterHasExpiration, func(output iotago.Output) bool { return output.UnlockConditionSet().HasExpirationCondition() }},
+		{QueryParameterHasStorageDepositReturn, func(output iotago.Output) bool { return output.UnlockConditionSet().HasStorageDepositReturnCondition() }},
+		{QueryParameterHasNativeToken, func(output iotago.Output) bool { return output.FeatureSet().HasNativeTokenFeature() }},
+	} {
+		presenceFilter := presenceFilter
+
+		if c.QueryParam(presenceFilter.paramName) == "" {
+			continue
+		}
+
+		expected, err := strconv.ParseBool(c.QueryParam(presenceFilter.paramName))
+		if err != nil {
+			return nil, nil, ierrors.Wrapf(httpserver.ErrInvalidParameter, "invalid query parameter %s: %s", presenceFilter.paramName, err)
+		}
+
+		filters = append(filters, func(output *utxoledger.Output, _ iotago.SlotIndex) bool {
+			return presenceFilter.isPresent(output.Output()) == expected
+		})
+	}
+
+	for _, slotFilter := range []struct {
+		paramName string
+		slotOf    func(output *utxoledger.Output) (slot iotago.SlotIndex, exists bool)
+		before    bool
+	}{
+		{QueryParameterTimelockedBefore, timelockSlot, true},
+		{QueryParameterTimelockedAfter, timelockSlot, false},
+		{QueryParameterExpiresBefore, expirationSlot, true},
+		{QueryParameterExpiresAfter, expirationSlot, false},
+		{QueryParameterCreatedBefore, creationSlot, true},
+		{QueryParameterCreatedAfter, creationSlot, false},
+	} {
+		slotFilter := slotFilter
+
+		if c.QueryParam(slotFilter.paramName) == "" {
+			continue
+		}
+
+		referenceSlot, err := httpserver.ParseSlotQueryParam(c, slotFilter.paramName)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		filters = append(filters, func(output *utxoledger.Output, _ iotago.SlotIndex) bool {
+			slot, exists := slotFilter.slotOf(output)
+			if !exists {
+				return false
+			}
+
+			if slotFilter.before {
+				return slot < referenceSlot
+			}
+
+			return slot > referenceSlot
+		})
+	}
+
+	if c.QueryParam(QueryParameterTag) != "" {
+		tag, err := httpserver.ParseHexQueryParam(c, QueryParameterTag, maxTagLength)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		filters = append(filters, func(output *utxoledger.Output, _ iotago.SlotIndex) bool {
+			tagFeature := output.Output().FeatureSet().Tag()
+
+			return tagFeature != nil && bytes.Equal(tagFeature.Tag, tag)
+		})
+	}
+
+	return indexedAddress, filters, nil
+}
+
+// parseAddressQueryParam parses the bech32 encoded address of the given query parameter (nil if it is not set).
+func parseAddressQueryParam(c echo.Context, paramName string) (iotago.Address, error) {
+	if c.QueryParam(paramName) == "" {
+		//nolint:nilnil // no address means that the filter is not set
+		return nil, nil
+	}
+
+	return httpserver.ParseBech32AddressQueryParam(c, deps.Protocol.CommittedAPI().ProtocolParameters().Bech32HRP(), paramName)
+}
+
+// unlockableBy returns a filter that only passes the outputs that can be unlocked by the given address in a transaction
+// that uses the commitment of the ledger slot as its commitment input.
+func unlockableBy(address iotago.Address) outputFilter {
+	return func(output *utxoledger.Output, ledgerSlot iotago.SlotIndex) bool {
+		protocolParameters := deps.Protocol.APIForSlot(ledgerSlot).ProtocolParameters()
+		futureBoundedSlot := ledgerSlot + protocolParameters.MinCommittableAge()
+		pastBoundedSlot := ledgerSlot + protocolParameters.MaxCommittableAge()
+
+		unlockConditions := output.Output().UnlockConditionSet()
+		if err := unlockConditions.TimelocksExpired(futureBoundedSlot); err != nil {
+			return false
+		}
+
+		returnAddress, err := unlockConditions.CheckExpirationCondition(futureBoundedSlot, pastBoundedSlot)
+		if err != nil {
+			return false
+		} else if returnAddress != nil {
+			return returnAddress.Equal(address)
+		}
+
+		owner := ownerAddress(output.Output())
+
+		return owner != nil && owner.Equal(address)
+	}
+}
+
+// ownerAddress returns the address of the address unlock condition of the given output (nil if it has none).
+func ownerAddress(output iotago.Output) iotago.Address {
+	if addressUnlockCondition := output.UnlockConditionSet().Address(); addressUnlockCondition != nil {
+		return addressUnlockCondition.Address
+	}
+
+	return nil
+}
+
+// expirationReturnAddress returns the return address of the expiration of the given output (nil if it has none).
+func expirationReturnAddress(output iotago.Output) iotago.Address {
+	if expirationUnlockCondition := output.UnlockConditionSet().Expiration(); expirationUnlockCondition != nil {
+		return expirationUnlockCondition.ReturnAddress
+	}
+
+	return nil
+}
+
+// storageDepositReturnAddress returns the return address of the storage deposit return unlock condition of the given
+// output (nil if it has none).
+func storageDepositReturnAddress(output iotago.Output) iotago.Address {
+	if storageDepositReturnUnlockCondition := output.UnlockConditionSet().StorageDepositReturn(); storageDepositReturnUnlockCondition != nil {
+		return storageDepositReturnUnlockCondition.ReturnAddress
+	}
+
+	return nil
+}
+
+// senderAddress returns the address of the sender feature of the given output (nil if it has none).
+func senderAddress(output iotago.Output) iotago.Address {
+	if senderFeature := output.FeatureSet().SenderFeature(); senderFeature != nil {
+		return senderFeature.Address
+	}
+
+	return nil
+}
+
+// timelockSlot returns the slot until which the given output is timelocked.
+func timelockSlot(output *utxoledger.Output) (slot iotago.SlotIndex, exists bool) {
+	if timelockUnlockCondition := output.Output().UnlockConditionSet().Timelock(); timelockUnlockCondition != nil {
+		return timelockUnlockCondition.Slot, true
+	}
+
+	return 0, false
+}
+
+// expirationSlot returns the slot at which the given output expires.
+func expirationSlot(output *utxoledger.Output) (slot iotago.SlotIndex, exists bool) {
+	if expirationUnlockCondition := output.Output().UnlockConditionSet().Expiration(); expirationUnlockCondition != nil {
+		return expirationUnlockCondition.Slot, true
+	}
+
+	return 0, false
+}
+
+// creationSlot returns the slot in which the given output was created.
+func creationSlot(output *utxoledger.Output) (slot iotago.SlotIndex, exists bool) {
+	return output.SlotCreated(), true
+}
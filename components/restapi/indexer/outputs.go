@@ -0,0 +1,141 @@
+package indexer
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/inx-app/pkg/httpserver"
+	"github.com/iotaledger/iota-core/components/restapi"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/utxoledger"
+	restapipkg "github.com/iotaledger/iota-core/pkg/restapi"
+	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/api"
+)
+
+const (
+	// QueryParameterAddress is used to filter the outputs by the address of their address unlock condition.
+	QueryParameterAddress = "address"
+
+	// QueryParameterUnlockableByAddress is used to filter the outputs by the address that can unlock them at the latest
+	// committed slot (taking timelocks and expirations into account).
+	QueryParameterUnlockableByAddress = "unlockableByAddress"
+
+	// QueryParameterHasTimelock is used to filter the outputs by the presence of a timelock unlock condition.
+	QueryParameterHasTimelock = "hasTimelock"
+
+	// QueryParameterTimelockedBefore is used to only return the outputs that are timelocked before the given slot.
+	QueryParameterTimelockedBefore = "timelockedBefore"
+
+	// QueryParameterTimelockedAfter is used to only return the outputs that are timelocked after the given slot.
+	QueryParameterTimelockedAfter = "timelockedAfter"
+
+	// QueryParameterHasExpiration is used to filter the outputs by the presence of an expiration unlock condition.
+	QueryParameterHasExpiration = "hasExpiration"
+
+	// QueryParameterExpiresBefore is used to only return the outputs that expire before the given slot.
+	QueryParameterExpiresBefore = "expiresBefore"
+
+	// QueryParameterExpiresAfter is used to only return the outputs that expire after the given slot.
+	QueryParameterExpiresAfter = "expiresAfter"
+
+	// QueryParameterExpirationReturnAddress is used to filter the outputs by the return address of their expiration.
+	QueryParameterExpirationReturnAddress = "expirationReturnAddress"
+
+	// QueryParameterHasStorageDepositReturn is used to filter the outputs by the presence of a storage deposit return
+	// unlock condition.
+	QueryParameterHasStorageDepositReturn = "hasStorageDepositReturn"
+
+	// QueryParameterStorageDepositReturnAddress is used to filter the outputs by the return address of their storage
+	// deposit return unlock condition.
+	QueryParameterStorageDepositReturnAddress = "storageDepositReturnAddress"
+
+	// QueryParameterHasNativeToken is used to filter the outputs by the presence of a native token feature.
+	QueryParameterHasNativeToken = "hasNativeToken"
+
+	// QueryParameterSender is used to filter the outputs by the address of their sender feature.
+	QueryParameterSender = "sender"
+
+	// QueryParameterTag is used to filter the outputs by the hex encoded tag of their tag feature.
+	QueryParameterTag = "tag"
+
+	// QueryParameterCreatedBefore is used to only return the outputs that were created before the given slot.
+	QueryParameterCreatedBefore = "createdBefore"
+
+	// QueryParameterCreatedAfter is used to only return the outputs that were created after the given slot.
+	QueryParameterCreatedAfter = "createdAfter"
+)
+
+// outputFilter is a filter that an output has to pass to be part of the response.
+type outputFilter func(output *utxoledger.Output, ledgerSlot iotago.SlotIndex) bool
+
+// outputsByQuery returns a page of the IDs of the unspent outputs of the given type that match the filters of the query
+// parameters. The outputs are ordered by their ID and the cursor is the ID of the first output of the next page, so
+// that outputs that are created or spent between two requests do not shift the following pages.
+func outputsByQuery(c echo.Context, outputType iotago.OutputType) (*api.IndexerResponse, error) {
+	pageSize := restapi.MaxPageSize()
+	if len(c.QueryParam(restapipkg.QueryParameterPageSize)) > 0 {
+		var err error
+		if pageSize, err = httpserver.ParseUint32QueryParam(c, restapipkg.QueryParameterPageSize); err != nil {
+			return nil, ierrors.Wrapf(err, "failed to parse page size %s", c.QueryParam(restapipkg.QueryParameterPageSize))
+		}
+
+		if pageSize == 0 || pageSize > restapi.MaxPageSize() {
+			pageSize = restapi.MaxPageSize()
+		}
+	}
+
+	startOutputID := iotago.EmptyOutputID
+	if cursor := c.QueryParam(restapipkg.QueryParameterCursor); cursor != "" {
+		var err error
+		if startOutputID, err = iotago.OutputIDFromHexString(cursor); err != nil {
+			return nil, ierrors.Wrapf(httpserver.ErrInvalidParameter, "invalid query parameter %s: %s", restapipkg.QueryParameterCursor, err)
+		}
+	}
+
+	indexedAddress, filters, err := parseFilters(c)
+	if err != nil {
+		return nil, err
+	}
+
+	outputIndexer := deps.Protocol.Engines.Main.Get().Storage.OutputIndexer()
+	if outputIndexer == nil {
+		return nil, ierrors.Wrap(echo.ErrServiceUnavailable, "the output index is disabled")
+	}
+
+	outputIDs := make(iotago.OutputIDs, 0, pageSize)
+	var nextOutputID iotago.OutputID
+	var hasNextPage bool
+
+	ledgerSlot, err := outputIndexer.ForEachOutput(indexedAddress, outputType, startOutputID, func(output *utxoledger.Output, ledgerSlot iotago.SlotIndex) bool {
+		for _, filter := range filters {
+			if !filter(output, ledgerSlot) {
+				return true
+			}
+		}
+
+		if uint32(len(outputIDs)) == pageSize {
+			nextOutputID, hasNextPage = output.OutputID(), true
+
+			return false
+		}
+
+		outputIDs = append(outputIDs, output.OutputID())
+
+		return true
+	})
+	if err != nil {
+		return nil, ierrors.Wrapf(echo.ErrInternalServerError, "failed to read indexed outputs: %s", err)
+	}
+
+	response := &api.IndexerResponse{
+		CommittedSlot: ledgerSlot,
+		PageSize:      pageSize,
+		Items:         outputIDs.ToHex(),
+	}
+
+	if hasNextPage {
+		response.Cursor = nextOutputID.ToHex()
+	}
+
+	return response, nil
+}
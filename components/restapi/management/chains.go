@@ -0,0 +1,132 @@
+package management
+
+import (
+	"sort"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/inx-app/pkg/httpserver"
+	"github.com/iotaledger/iota-core/pkg/protocol"
+	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/api"
+)
+
+// ChainsResponse defines the response of a GET chains REST API call.
+type ChainsResponse struct {
+	// Chains contains the chains that are managed by the node (the main chain first, then by descending claimed weight).
+	Chains []*ChainResponse `json:"chains"`
+}
+
+// ChainResponse defines the state of a chain that is managed by the node.
+type ChainResponse struct {
+	// ForkingPointID is the ID of the first commitment of the chain.
+	ForkingPointID iotago.CommitmentID `json:"forkingPointId"`
+	// LatestCommitmentID is the ID of the latest commitment of the chain.
+	LatestCommitmentID iotago.CommitmentID `json:"latestCommitmentId"`
+	// ClaimedWeight is the cumulative weight claimed by the latest commitment of the chain.
+	ClaimedWeight uint64 `json:"claimedWeight"`
+	// AttestedWeight is the weight of the chain that was checked via attestations.
+	AttestedWeight uint64 `json:"attestedWeight"`
+	// VerifiedWeight is the weight of the chain that was verified by processing its blocks in an engine.
+	VerifiedWeight uint64 `json:"verifiedWeight"`
+	// IsMain indicates whether the chain is the main chain.
+	IsMain bool `json:"isMain"`
+	// IsHeaviestAttestedCandidate indicates whether the chain is the heaviest candidate according to its attested weight.
+	IsHeaviestAttestedCandidate bool `json:"isHeaviestAttestedCandidate"`
+	// WarpSyncMode indicates whether the chain is synced in warp sync mode.
+	WarpSyncMode bool `json:"warpSyncMode"`
+	// Engine is the name of the engine that processes the blocks of the chain (omitted if no engine is running).
+	Engine string `json:"engine,omitempty"`
+}
+
+func chains(_ echo.Context) *ChainsResponse {
+	return NewChainsResponse(deps.Protocol)
+}
+
+// NewChainsResponse creates a ChainsResponse from the chains that are currently managed by the given protocol.
+func NewChainsResponse(p *protocol.Protocol) *ChainsResponse {
+	mainChain := p.Chains.Main.Get()
+	heaviestAttestedCandidate := p.Chains.HeaviestAttestedCandidate.Get()
+
+	chainsResponse := make([]*ChainResponse, 0)
+	for _, chain := range p.Chains.ToSlice() {
+		forkingPoint := chain.ForkingPoint.Get()
+		if forkingPoint == nil {
+			continue
+		}
+
+		chainResponse := &ChainResponse{
+			ForkingPointID:              forkingPoint.ID(),
+			ClaimedWeight:               chain.ClaimedWeight.Get(),
+			AttestedWeight:              chain.AttestedWeight.Get(),
+			VerifiedWeight:              chain.VerifiedWeight.Get(),
+			IsMain:                      chain == mainChain,
+			IsHeaviestAttestedCandidate: chain == heaviestAttestedCandidate,
+			WarpSyncMode:                chain.WarpSyncMode.Get(),
+		}
+
+		if latestCommitment := chain.LatestCommitment.Get(); latestCommitment != nil {
+			chainResponse.LatestCommitmentID = latestCommitment.ID()
+		}
+
+		if chainEngine := chain.Engine.Get(); chainEngine != nil {
+			chainResponse.Engine = chainEngine.Name()
+		}
+
+		chainsResponse = append(chainsResponse, chainResponse)
+	}
+
+	sort.Slice(chainsResponse, func(i, j int) bool {
+		if chainsResponse[i].IsMain != chainsResponse[j].IsMain {
+			return chainsResponse[i].IsMain
+		}
+
+		return chainsResponse[i].ClaimedWeight > chainsResponse[j].ClaimedWeight
+	})
+
+	return &ChainsResponse{
+		Chains: chainsResponse,
+	}
+}
+
+func startChainEngine(c echo.Context) error {
+	forkingPointID, err := httpserver.ParseCommitmentIDParam(c, api.ParameterCommitmentID)
+	if err != nil {
+		return ierrors.Wrapf(err, "failed to parse forking point ID %s", c.Param(api.ParameterCommitmentID))
+	}
+
+	if err = deps.Protocol.Chains.StartCandidateEngine(forkingPointID); err != nil {
+		return wrapChainError(err, "failed to start engine")
+	}
+
+	Component.LogWarn("chain engine started manually", "forkingPointID", forkingPointID)
+
+	return nil
+}
+
+func abortChainEngine(c echo.Context) error {
+	forkingPointID, err := httpserver.ParseCommitmentIDParam(c, api.ParameterCommitmentID)
+	if err != nil {
+		return ierrors.Wrapf(err, "failed to parse forking point ID %s", c.Param(api.ParameterCommitmentID))
+	}
+
+	if err = deps.Protocol.Chains.AbortCandidateEngine(forkingPointID); err != nil {
+		return wrapChainError(err, "failed to abort engine")
+	}
+
+	Component.LogWarn("chain engine aborted manually", "forkingPointID", forkingPointID)
+
+	return nil
+}
+
+func wrapChainError(err error, message string) error {
+	switch {
+	case ierrors.Is(err, protocol.ErrorChainNotFound):
+		return ierrors.Wrapf(echo.ErrNotFound, "%s: %s", message, err)
+	case ierrors.Is(err, protocol.ErrorMainChain), ierrors.Is(err, protocol.ErrorEngineNotRunning):
+		return ierrors.Wrapf(httpserver.ErrInvalidParameter, "%s: %s", message, err)
+	default:
+		return ierrors.Wrapf(echo.ErrInternalServerError, "%s: %s", message, err)
+	}
+}
@@ -9,11 +9,75 @@ import (
 	"github.com/iotaledger/hive.go/app"
 	"github.com/iotaledger/inx-app/pkg/httpserver"
 	"github.com/iotaledger/iota-core/components/restapi"
+	snapshottercomponent "github.com/iotaledger/iota-core/components/snapshotter"
+	"github.com/iotaledger/iota-core/pkg/configreload"
+	"github.com/iotaledger/iota-core/pkg/loglevels"
+	"github.com/iotaledger/iota-core/pkg/network/p2p"
 	"github.com/iotaledger/iota-core/pkg/protocol"
 	restapipkg "github.com/iotaledger/iota-core/pkg/restapi"
+	"github.com/iotaledger/iota-core/pkg/snapshotter"
+	iotago "github.com/iotaledger/iota.go/v4"
 	"github.com/iotaledger/iota.go/v4/api"
 )
 
+const (
+	// ParameterModule is used to identify a module whose log level can be adjusted.
+	ParameterModule = "module"
+
+	// ParameterJobID is used to identify a snapshot job.
+	ParameterJobID = "jobId"
+
+	// RouteLogLevels is the route to list the log levels of all modules.
+	// GET returns the log levels of all modules.
+	RouteLogLevels = "/loglevels"
+
+	// RouteLogLevel is the route to adjust the log level of a module.
+	// PUT sets the log level of the given module.
+	RouteLogLevel = "/loglevels/:" + ParameterModule
+
+	// RoutePeersInfo is the route to get the reachability information of the node and the link quality of its neighbors.
+	// GET returns the reachability status, the NAT device types and the advertised addresses of the node, as well as the
+	// latency measurements and scores of the neighbors.
+	RoutePeersInfo = "/peers/info"
+
+	// RoutePeersIdentity is the route to get the active p2p identity of the node.
+	// GET returns the peer ID, the replaced peer ID, the activation time and the advertised addresses of the identity.
+	RoutePeersIdentity = "/peers/identity"
+
+	// RoutePeersIdentityRotate is the route to rotate the p2p identity of the node.
+	// POST switches the node to a newly generated private key and re-handshakes with the neighbors of the previous
+	// identity. Issued JWTs stay bound to the identity the node was started with until the node is restarted.
+	RoutePeersIdentityRotate = "/peers/identity/rotate"
+
+	// RouteChains is the route to list the chains that are managed by the node.
+	// GET returns the forking points, latest commitments and weights of the chains and whether an engine is running.
+	RouteChains = "/chains"
+
+	// RouteChainEngine is the route to control the engine of a candidate chain that is identified by its forking point.
+	// POST forces the start of the engine, so that the node switches to the chain once it verified that it is heavier.
+	// DELETE aborts the engine of the chain.
+	RouteChainEngine = "/chains/:" + api.ParameterCommitmentID + "/engine"
+
+	// RouteConfig is the route to list the parameters that can be changed at runtime.
+	// GET returns the names of the reloadable parameters.
+	RouteConfig = "/config"
+
+	// RouteConfigReload is the route to reload the configuration files of the node.
+	// POST applies the changed values of the reloadable parameters (the same as sending SIGHUP to the node) and returns
+	// the names of the changed parameters. No value is applied if any of the values is invalid.
+	RouteConfigReload = "/config/reload"
+
+	// RouteControlSnapshots is the route to create snapshots of the running node.
+	// POST starts a job in the background that exports the snapshot of the given finalized slot (the latest finalized
+	// slot if omitted) into the snapshot directory and returns the job.
+	// GET returns the running and the latest finished jobs.
+	RouteControlSnapshots = "/control/snapshots"
+
+	// RouteControlSnapshot is the route to get the progress of a snapshot job.
+	// GET returns the status, the exported stages and the file path of the job.
+	RouteControlSnapshot = "/control/snapshots/:" + ParameterJobID
+)
+
 func init() {
 	Component = &app.Component{
 		Name:      "ManagementAPIV1",
@@ -28,13 +92,20 @@ func init() {
 var (
 	Component *app.Component
 	deps      dependencies
+
+	snapshotJobs *snapshotter.JobManager
 )
 
 type dependencies struct {
 	dig.In
 
-	RestRouteManager *restapipkg.RestRouteManager
-	Protocol         *protocol.Protocol
+	RestRouteManager    *restapipkg.RestRouteManager
+	Protocol            *protocol.Protocol
+	LogLevels           *loglevels.Registry
+	ConfigReload        *configreload.Registry
+	ReachabilityMonitor *p2p.ReachabilityMonitor
+	P2PManager          *p2p.Manager
+	IdentityManager     *p2p.IdentityManager
 }
 
 func configure() error {
@@ -43,6 +114,17 @@ func configure() error {
 		Component.LogPanicf("RestAPI plugin needs to be enabled to use the %s plugin", Component.Name)
 	}
 
+	snapshotJobs = snapshotter.NewJobManager(
+		func() iotago.SlotIndex {
+			return deps.Protocol.Engines.Main.Get().Storage.Settings().LatestFinalizedSlot()
+		},
+		func(filePath string, targetSlot iotago.SlotIndex, progress func(stage string, completedStages int, totalStages int)) error {
+			return deps.Protocol.Engines.Main.Get().WriteSnapshotWithProgress(filePath, targetSlot, progress)
+		},
+		Component.Logger,
+		snapshotter.WithJobsDirectory(snapshottercomponent.ParamsSnapshotter.Directory),
+	)
+
 	routeGroup := deps.RestRouteManager.AddRoute(api.ManagementPluginName)
 
 	routeGroup.GET(api.EndpointWithEchoParameters(api.ManagementEndpointPeer), func(c echo.Context) error {
@@ -71,6 +153,33 @@ func configure() error {
 		return httpserver.JSONResponse(c, http.StatusOK, resp)
 	})
 
+	routeGroup.GET(RoutePeersInfo, func(c echo.Context) error {
+		resp, err := peersInfo(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	})
+
+	routeGroup.GET(RoutePeersIdentity, func(c echo.Context) error {
+		resp, err := identity(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	})
+
+	routeGroup.POST(RoutePeersIdentityRotate, func(c echo.Context) error {
+		resp, err := rotateIdentity(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	})
+
 	routeGroup.POST(api.ManagementEndpointPeers, func(c echo.Context) error {
 		resp, err := addPeer(c, Component.Logger)
 		if err != nil {
@@ -98,5 +207,78 @@ func configure() error {
 		return httpserver.JSONResponse(c, http.StatusOK, resp)
 	})
 
+	routeGroup.POST(RouteControlSnapshots, func(c echo.Context) error {
+		resp, err := startSnapshotJob(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusAccepted, resp)
+	})
+
+	routeGroup.GET(RouteControlSnapshots, func(c echo.Context) error {
+		return httpserver.JSONResponse(c, http.StatusOK, listSnapshotJobs(c))
+	})
+
+	routeGroup.GET(RouteControlSnapshot, func(c echo.Context) error {
+		resp, err := snapshotJob(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	})
+
+	routeGroup.GET(RouteLogLevels, func(c echo.Context) error {
+		resp, err := logLevels(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	})
+
+	routeGroup.PUT(RouteLogLevel, func(c echo.Context) error {
+		resp, err := setLogLevel(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	})
+
+	routeGroup.GET(RouteConfig, func(c echo.Context) error {
+		return httpserver.JSONResponse(c, http.StatusOK, configParameters(c))
+	})
+
+	routeGroup.POST(RouteConfigReload, func(c echo.Context) error {
+		resp, err := reloadConfig(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	})
+
+	routeGroup.GET(RouteChains, func(c echo.Context) error {
+		return httpserver.JSONResponse(c, http.StatusOK, chains(c))
+	})
+
+	routeGroup.POST(RouteChainEngine, func(c echo.Context) error {
+		if err := startChainEngine(c); err != nil {
+			return err
+		}
+
+		return c.NoContent(http.StatusAccepted)
+	})
+
+	routeGroup.DELETE(RouteChainEngine, func(c echo.Context) error {
+		if err := abortChainEngine(c); err != nil {
+			return err
+		}
+
+		return c.NoContent(http.StatusNoContent)
+	})
+
 	return nil
 }
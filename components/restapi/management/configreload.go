@@ -0,0 +1,48 @@
+package management
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/inx-app/pkg/httpserver"
+	"github.com/iotaledger/iota-core/pkg/configreload"
+)
+
+// ConfigParametersResponse defines the response of a GET config REST API call.
+type ConfigParametersResponse struct {
+	// Reloadable contains the names of the parameters that can be changed at runtime (formatted as "config:key").
+	Reloadable []string `json:"reloadable"`
+}
+
+// ConfigReloadResponse defines the response of a POST config reload REST API call.
+type ConfigReloadResponse struct {
+	// Changed contains the names of the parameters whose changed values were applied.
+	Changed []string `json:"changed"`
+}
+
+func configParameters(_ echo.Context) *ConfigParametersResponse {
+	return &ConfigParametersResponse{
+		Reloadable: deps.ConfigReload.Parameters(),
+	}
+}
+
+func reloadConfig(_ echo.Context) (*ConfigReloadResponse, error) {
+	changed, err := deps.ConfigReload.Reload()
+	if err != nil {
+		if ierrors.Is(err, configreload.ErrInvalidValue) {
+			return nil, ierrors.Wrapf(httpserver.ErrInvalidParameter, "failed to reload configuration: %s", err)
+		}
+
+		return nil, ierrors.Wrapf(echo.ErrInternalServerError, "failed to reload configuration: %s", err)
+	}
+
+	Component.LogInfo("configuration reloaded", "changed", changed)
+
+	if changed == nil {
+		changed = make([]string, 0)
+	}
+
+	return &ConfigReloadResponse{
+		Changed: changed,
+	}, nil
+}
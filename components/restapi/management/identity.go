@@ -0,0 +1,54 @@
+package management
+
+import (
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/iota-core/pkg/network"
+	"github.com/iotaledger/iota-core/pkg/network/p2p"
+)
+
+// IdentityResponse defines the response of a GET identity and a POST identity rotation REST API call.
+type IdentityResponse struct {
+	// PeerID is the ID of the active identity of the node.
+	PeerID string `json:"peerId"`
+	// PreviousPeerID is the ID of the identity that was replaced by the active identity (omitted if there was none).
+	PreviousPeerID string `json:"previousPeerId,omitempty"`
+	// ActivatedAt is the time when the active identity was activated.
+	ActivatedAt time.Time `json:"activatedAt"`
+	// AdvertisedAddresses are the addresses the node advertised to its peers when the identity was activated.
+	AdvertisedAddresses []string `json:"advertisedAddresses"`
+}
+
+func identity(_ echo.Context) (*IdentityResponse, error) {
+	activeIdentity, err := deps.IdentityManager.Identity()
+	if err != nil {
+		return nil, ierrors.Wrapf(echo.ErrInternalServerError, "failed to load identity: %s", err)
+	}
+
+	return identityResponse(activeIdentity), nil
+}
+
+func rotateIdentity(c echo.Context) (*IdentityResponse, error) {
+	rotatedIdentity, err := deps.IdentityManager.Rotate(c.Request().Context())
+	if err != nil {
+		if ierrors.Is(err, p2p.ErrIdentityRotationDisabled) {
+			return nil, ierrors.Wrapf(echo.ErrForbidden, "failed to rotate identity: %s (the identity private key is set in the config)", err)
+		}
+
+		return nil, ierrors.Wrapf(echo.ErrInternalServerError, "failed to rotate identity: %s", err)
+	}
+
+	return identityResponse(rotatedIdentity), nil
+}
+
+func identityResponse(identity *network.Identity) *IdentityResponse {
+	return &IdentityResponse{
+		PeerID:              identity.PeerID.String(),
+		PreviousPeerID:      identity.PreviousPeerID.String(),
+		ActivatedAt:         identity.ActivatedAt,
+		AdvertisedAddresses: multiAddressStrings(identity.AdvertisedAddresses),
+	}
+}
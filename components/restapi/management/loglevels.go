@@ -0,0 +1,75 @@
+package management
+
+import (
+	"github.com/labstack/echo/v4"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/log"
+	"github.com/iotaledger/inx-app/pkg/httpserver"
+	"github.com/iotaledger/iota-core/pkg/loglevels"
+)
+
+// LogLevelsResponse defines the response of a GET log levels REST API call.
+type LogLevelsResponse struct {
+	// Modules contains the current log level of every module that can be adjusted at runtime.
+	Modules map[string]string `json:"modules"`
+}
+
+// LogLevelRequest defines the request of a PUT log level REST API call.
+type LogLevelRequest struct {
+	// Level is the new log level of the module (trace, debug, info, warning, error).
+	Level string `json:"level"`
+}
+
+// LogLevelResponse defines the response of a PUT log level REST API call.
+type LogLevelResponse struct {
+	// Module is the name of the module.
+	Module string `json:"module"`
+	// Level is the log level of the module.
+	Level string `json:"level"`
+}
+
+func logLevels(_ echo.Context) (*LogLevelsResponse, error) {
+	modules := make(map[string]string)
+	for _, module := range deps.LogLevels.Modules() {
+		level, err := deps.LogLevels.LogLevel(module)
+		if err != nil {
+			return nil, ierrors.Wrapf(echo.ErrInternalServerError, "failed to retrieve log level of module %s: %s", module, err)
+		}
+
+		modules[module] = log.LevelName(level)
+	}
+
+	return &LogLevelsResponse{
+		Modules: modules,
+	}, nil
+}
+
+func setLogLevel(c echo.Context) (*LogLevelResponse, error) {
+	module := c.Param(ParameterModule)
+
+	request := &LogLevelRequest{}
+	if err := c.Bind(request); err != nil {
+		return nil, ierrors.Wrapf(httpserver.ErrInvalidParameter, "invalid request, error: %s", err)
+	}
+
+	level, err := log.LevelFromString(request.Level)
+	if err != nil {
+		return nil, ierrors.Wrapf(httpserver.ErrInvalidParameter, "invalid log level, error: %s", err)
+	}
+
+	if err := deps.LogLevels.SetLogLevel(module, level); err != nil {
+		if ierrors.Is(err, loglevels.ErrUnknownModule) {
+			return nil, ierrors.Wrapf(echo.ErrNotFound, "failed to set log level: %s", err)
+		}
+
+		return nil, ierrors.Wrapf(echo.ErrInternalServerError, "failed to set log level: %s", err)
+	}
+
+	Component.LogInfo("log level adjusted", "module", module, "level", log.LevelName(level))
+
+	return &LogLevelResponse{
+		Module: module,
+		Level:  log.LevelName(level),
+	}, nil
+}
@@ -0,0 +1,105 @@
+package management
+
+import (
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"github.com/multiformats/go-multiaddr"
+
+	"github.com/iotaledger/iota-core/pkg/network"
+)
+
+// PeersInfoResponse defines the response of a GET peers info REST API call.
+type PeersInfoResponse struct {
+	// PeerID is the ID of the node.
+	PeerID string `json:"peerId"`
+	// Reachability is the reachability of the node as determined by peers that dialed it back (Unknown, Public, Private).
+	Reachability string `json:"reachability"`
+	// NATDeviceTypes contains the type of the NAT device per transport protocol (Cone, Symmetric).
+	NATDeviceTypes map[string]string `json:"natDeviceTypes"`
+	// LastUpdated is the time when the reachability of the node was last updated.
+	LastUpdated time.Time `json:"lastUpdated"`
+	// ListenAddresses are the addresses the node is listening on.
+	ListenAddresses []string `json:"listenAddresses"`
+	// AdvertisedAddresses are the addresses the node advertises to its peers (including mapped and observed addresses).
+	AdvertisedAddresses []string `json:"advertisedAddresses"`
+	// PortMapping indicates whether the p2p port is mapped on the router via UPnP/NAT-PMP.
+	PortMapping bool `json:"portMapping"`
+	// ReachabilityService indicates whether the node helps other peers to determine their reachability.
+	ReachabilityService bool `json:"reachabilityService"`
+	// Neighbors contains the link quality of the connected neighbors.
+	Neighbors []*NeighborInfo `json:"neighbors"`
+}
+
+// NeighborInfo defines the link quality of a connected neighbor.
+type NeighborInfo struct {
+	// PeerID is the ID of the neighbor.
+	PeerID string `json:"peerId"`
+	// Addresses are the addresses of the neighbor.
+	Addresses []string `json:"addresses"`
+	// Transport is the transport of the connection to the neighbor (tcp, quic or other).
+	Transport string `json:"transport"`
+	// ConnectionEstablished is the time when the connection to the neighbor was established.
+	ConnectionEstablished time.Time `json:"connectionEstablished"`
+	// PacketsRead is the number of packets received from the neighbor.
+	PacketsRead uint64 `json:"packetsRead"`
+	// PacketsWritten is the number of packets sent to the neighbor.
+	PacketsWritten uint64 `json:"packetsWritten"`
+	// Latency contains the round trip time measurements of the link to the neighbor (omitted if not measured yet).
+	Latency *network.Latency `json:"latency,omitempty"`
+	// Score rates the quality of the link to the neighbor in the range [0, 1] (higher is better).
+	Score float64 `json:"score"`
+}
+
+func peersInfo(_ echo.Context) (*PeersInfoResponse, error) {
+	status := deps.ReachabilityMonitor.Status()
+
+	natDeviceTypes := make(map[string]string, len(status.NATDeviceTypes))
+	for transportProtocol, natDeviceType := range status.NATDeviceTypes {
+		natDeviceTypes[transportProtocol.String()] = natDeviceType.String()
+	}
+
+	return &PeersInfoResponse{
+		PeerID:              status.PeerID.String(),
+		Reachability:        status.Reachability.String(),
+		NATDeviceTypes:      natDeviceTypes,
+		LastUpdated:         status.LastUpdated,
+		ListenAddresses:     multiAddressStrings(status.ListenAddresses),
+		AdvertisedAddresses: multiAddressStrings(status.AdvertisedAddresses),
+		PortMapping:         status.PortMapping,
+		ReachabilityService: status.ReachabilityService,
+		Neighbors:           neighborInfos(),
+	}, nil
+}
+
+func neighborInfos() []*NeighborInfo {
+	neighbors := deps.P2PManager.AllNeighbors()
+
+	result := make([]*NeighborInfo, len(neighbors))
+	for i, neighbor := range neighbors {
+		result[i] = &NeighborInfo{
+			PeerID:                neighbor.ID.String(),
+			Addresses:             multiAddressStrings(neighbor.PeerAddresses),
+			Transport:             neighbor.Transport(),
+			ConnectionEstablished: neighbor.ConnectionEstablished(),
+			PacketsRead:           neighbor.PacketsRead(),
+			PacketsWritten:        neighbor.PacketsWritten(),
+			Score:                 neighbor.Score(),
+		}
+
+		if latency, exists := neighbor.Latency(); exists {
+			result[i].Latency = &latency
+		}
+	}
+
+	return result
+}
+
+func multiAddressStrings(multiAddresses []multiaddr.Multiaddr) []string {
+	result := make([]string, len(multiAddresses))
+	for i, multiAddress := range multiAddresses {
+		result[i] = multiAddress.String()
+	}
+
+	return result
+}
@@ -0,0 +1,118 @@
+package management
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/inx-app/pkg/httpserver"
+	"github.com/iotaledger/iota-core/pkg/snapshotter"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// SnapshotJobRequest defines the request of a POST snapshot job REST API call.
+type SnapshotJobRequest struct {
+	// Slot is the finalized slot the snapshot is created for (the latest finalized slot if omitted).
+	Slot iotago.SlotIndex `json:"slot,omitempty"`
+}
+
+// SnapshotJobResponse defines the progress of a snapshot job.
+type SnapshotJobResponse struct {
+	// JobID is the identifier of the job.
+	JobID uint64 `json:"jobId"`
+	// Slot is the finalized slot the snapshot is created for.
+	Slot iotago.SlotIndex `json:"slot"`
+	// FilePath is the path of the snapshot file.
+	FilePath string `json:"filePath"`
+	// Status is the status of the job (running, succeeded or failed).
+	Status string `json:"status"`
+	// Stage is the name of the latest stage that was exported (omitted until the first stage was exported).
+	Stage string `json:"stage,omitempty"`
+	// CompletedStages is the number of stages that were exported.
+	CompletedStages int `json:"completedStages"`
+	// TotalStages is the number of stages of the snapshot (0 until the first stage was exported).
+	TotalStages int `json:"totalStages"`
+	// StartTime is the time when the job was started.
+	StartTime time.Time `json:"startTime"`
+	// EndTime is the time when the job finished (omitted while it is running).
+	EndTime *time.Time `json:"endTime,omitempty"`
+	// Error is the error of failed jobs.
+	Error string `json:"error,omitempty"`
+}
+
+// SnapshotJobsResponse defines the response of a GET snapshot jobs REST API call.
+type SnapshotJobsResponse struct {
+	// Jobs contains the running and the latest finished jobs ordered by their ID.
+	Jobs []*SnapshotJobResponse `json:"jobs"`
+}
+
+func startSnapshotJob(c echo.Context) (*SnapshotJobResponse, error) {
+	if deps.Protocol.Engines.Main.Get().Storage.IsPruning() {
+		return nil, ierrors.Wrap(echo.ErrServiceUnavailable, "node is pruning")
+	}
+
+	request := &SnapshotJobRequest{}
+	if err := c.Bind(request); err != nil {
+		return nil, ierrors.Wrapf(httpserver.ErrInvalidParameter, "invalid request, error: %s", err)
+	}
+
+	job, err := snapshotJobs.Start(Component.Daemon().ContextStopped(), request.Slot)
+	if err != nil {
+		switch {
+		case ierrors.Is(err, snapshotter.ErrJobRunning):
+			return nil, ierrors.Wrapf(echo.ErrConflict, "failed to start snapshot job: %s", err)
+		case ierrors.Is(err, snapshotter.ErrSlotNotFinalized):
+			return nil, ierrors.Wrapf(httpserver.ErrInvalidParameter, "failed to start snapshot job: %s", err)
+		default:
+			return nil, ierrors.Wrapf(echo.ErrInternalServerError, "failed to start snapshot job: %s", err)
+		}
+	}
+
+	return snapshotJobResponse(job), nil
+}
+
+func snapshotJob(c echo.Context) (*SnapshotJobResponse, error) {
+	jobID, err := strconv.ParseUint(c.Param(ParameterJobID), 10, 64)
+	if err != nil {
+		return nil, ierrors.Wrapf(httpserver.ErrInvalidParameter, "invalid job ID: %s", c.Param(ParameterJobID))
+	}
+
+	job, err := snapshotJobs.Job(jobID)
+	if err != nil {
+		return nil, ierrors.Wrapf(echo.ErrNotFound, "failed to get snapshot job: %s", err)
+	}
+
+	return snapshotJobResponse(job), nil
+}
+
+func listSnapshotJobs(_ echo.Context) *SnapshotJobsResponse {
+	return &SnapshotJobsResponse{
+		Jobs: lo.Map(snapshotJobs.Jobs(), snapshotJobResponse),
+	}
+}
+
+func snapshotJobResponse(job *snapshotter.Job) *SnapshotJobResponse {
+	response := &SnapshotJobResponse{
+		JobID:           job.ID,
+		Slot:            job.Slot,
+		FilePath:        job.FilePath,
+		Status:          string(job.Status),
+		Stage:           job.Stage,
+		CompletedStages: job.CompletedStages,
+		TotalStages:     job.TotalStages,
+		StartTime:       job.StartTime,
+	}
+
+	if !job.EndTime.IsZero() {
+		response.EndTime = &job.EndTime
+	}
+
+	if job.Err != nil {
+		response.Error = job.Err.Error()
+	}
+
+	return response
+}
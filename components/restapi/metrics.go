@@ -0,0 +1,60 @@
+package restapi
+
+import (
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/iota-core/pkg/metrics"
+)
+
+// unmatchedRoute is the route that is used for requests that did not match any registered route.
+const unmatchedRoute = "unmatched"
+
+// routeMetricsMiddleware measures the duration and the outcome of all requests, tracks them per route in the
+// APIRouteMetrics and logs the requests that took longer than the slow request threshold.
+func routeMetricsMiddleware() echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			start := time.Now()
+			err := next(c)
+
+			route := c.Path()
+			if route == "" {
+				route = unmatchedRoute
+			}
+
+			request := &metrics.APIRequest{
+				Method:     c.Request().Method,
+				Route:      route,
+				StatusCode: responseStatusCode(c, err),
+				Duration:   time.Since(start),
+			}
+
+			deps.APIRouteMetrics.Track(request)
+
+			if threshold := SlowRequestThreshold(); threshold > 0 && request.Duration >= threshold {
+				Component.LogWarnf("slow request: %s %s (route: %s, status: %d, duration: %s)", request.Method, c.Request().RequestURI, request.Route, request.StatusCode, request.Duration)
+			}
+
+			return err
+		}
+	}
+}
+
+// responseStatusCode returns the status code of the response to a request that was handled with the given error (the
+// error is only turned into a response by the error handler of echo after all middlewares ran).
+func responseStatusCode(c echo.Context, err error) int {
+	if err == nil {
+		return c.Response().Status
+	}
+
+	var httpError *echo.HTTPError
+	if ierrors.As(err, &httpError) {
+		return httpError.Code
+	}
+
+	return http.StatusInternalServerError
+}
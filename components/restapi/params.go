@@ -1,6 +1,8 @@
 package restapi
 
 import (
+	"time"
+
 	"github.com/iotaledger/hive.go/app"
 )
 
@@ -22,6 +24,8 @@ type ParametersRestAPI struct {
 	RequestsMemoryCacheGranularity uint32 `default:"10" usage:"defines per how many slots a cache is created for big API requests"`
 	// MaxRequestedSlotAge defines the maximum age of a request that will be processed.
 	MaxRequestedSlotAge uint32 `default:"10" usage:"the maximum age of a request that will be processed"`
+	// SlowRequestThreshold defines the duration after which a request is logged as slow.
+	SlowRequestThreshold time.Duration `default:"0s" usage:"the duration after which a request is logged as slow (0 disables the logging)"`
 
 	JWTAuth struct {
 		// salt used inside the JWT tokens for the REST API. Change this to a different value to invalidate JWT tokens not matching this new value
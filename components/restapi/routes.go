@@ -16,7 +16,8 @@ type RoutesResponse struct {
 func setupRoutes() {
 
 	deps.Echo.GET(api.RouteHealth, func(c echo.Context) error {
-		if deps.Protocol.Engines.Main.Get().SyncManager.IsNodeSynced() {
+		syncManager := deps.Protocol.Engines.Main.Get().SyncManager
+		if syncManager.IsNodeSynced() && !syncManager.IsFinalizationStalled() {
 			return c.NoContent(http.StatusOK)
 		}
 
@@ -0,0 +1,101 @@
+package runtimeprofiler
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/labstack/echo/v4"
+	"go.uber.org/dig"
+
+	"github.com/iotaledger/hive.go/app"
+	"github.com/iotaledger/hive.go/runtime/timeutil"
+	"github.com/iotaledger/inx-app/pkg/httpserver"
+	"github.com/iotaledger/iota-core/components/restapi"
+	"github.com/iotaledger/iota-core/pkg/daemon"
+	"github.com/iotaledger/iota-core/pkg/profiling"
+	"github.com/iotaledger/iota-core/pkg/protocol"
+	restapipkg "github.com/iotaledger/iota-core/pkg/restapi"
+)
+
+const (
+	// RouteHistory is the route to get the recent runtime samples of the node.
+	// GET returns the retained samples (optionally only those taken after the time given by the since query parameter).
+	RouteHistory = "/history"
+
+	// QueryParameterSince is used to only return the samples taken after the given unix timestamp (in seconds).
+	QueryParameterSince = "since"
+)
+
+func init() {
+	Component = &app.Component{
+		Name:      "RuntimeProfiler",
+		DepsFunc:  func(cDeps dependencies) { deps = cDeps },
+		Params:    params,
+		Configure: configure,
+		Run:       run,
+		IsEnabled: func(_ *dig.Container) bool {
+			return restapi.ParamsRestAPI.Enabled && ParamsRuntimeProfiler.Enabled
+		},
+	}
+}
+
+var (
+	Component *app.Component
+	deps      dependencies
+
+	sampler *profiling.RuntimeSampler
+)
+
+type dependencies struct {
+	dig.In
+
+	Protocol         *protocol.Protocol
+	RestRouteManager *restapipkg.RestRouteManager
+}
+
+func configure() error {
+	// check if RestAPI plugin is disabled
+	if !Component.App().IsComponentEnabled(restapi.Component.Identifier()) {
+		Component.LogPanicf("RestAPI plugin needs to be enabled to use the %s plugin", Component.Name)
+	}
+
+	if ParamsRuntimeProfiler.Interval <= 0 || ParamsRuntimeProfiler.HistorySize <= 0 {
+		Component.LogPanicf("invalid configuration: interval (%s) and history size (%d) must be positive", ParamsRuntimeProfiler.Interval, ParamsRuntimeProfiler.HistorySize)
+	}
+
+	sampler = profiling.NewRuntimeSampler(ParamsRuntimeProfiler.HistorySize)
+
+	routeGroup := deps.RestRouteManager.AddRoute("runtime-profiler/v1")
+
+	routeGroup.GET(RouteHistory, func(c echo.Context) error {
+		var since time.Time
+		if len(c.QueryParam(QueryParameterSince)) > 0 {
+			var err error
+			if since, err = httpserver.ParseUnixTimestampQueryParam(c, QueryParameterSince); err != nil {
+				return err
+			}
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, historyResponse(sampler.History(since)))
+	})
+
+	return nil
+}
+
+func run() error {
+	if err := Component.Daemon().BackgroundWorker(Component.Name, func(ctx context.Context) {
+		Component.LogInfof("Sampling the runtime every %s (retaining %d samples) ...", ParamsRuntimeProfiler.Interval, ParamsRuntimeProfiler.HistorySize)
+
+		timeutil.NewTicker(func() {
+			sampler.Sample(deps.Protocol.Engines.Main.Get().SyncManager.LatestCommitment().Slot())
+		}, ParamsRuntimeProfiler.Interval, ctx)
+
+		<-ctx.Done()
+		Component.LogInfo("Stopping RuntimeProfiler ... done")
+	}, daemon.PriorityRuntimeProfiler); err != nil {
+		Component.LogPanicf("failed to start worker: %s", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,66 @@
+package runtimeprofiler
+
+import (
+	"time"
+
+	"github.com/iotaledger/iota-core/pkg/profiling"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+type (
+	// HistoryResponse contains the recent runtime samples of the node.
+	HistoryResponse struct {
+		// Interval is the interval in which the samples are taken (in milliseconds).
+		Interval int64 `json:"interval"`
+		// Samples are the samples ordered from oldest to newest.
+		Samples []*SampleResponse `json:"samples"`
+	}
+
+	// SampleResponse contains the state of the Go runtime at a point in time.
+	SampleResponse struct {
+		// Time is the unix timestamp at which the sample was taken (in milliseconds).
+		Time int64 `json:"time"`
+		// LatestCommittedSlot is the slot of the latest commitment at the time of the sample.
+		LatestCommittedSlot iotago.SlotIndex `json:"latestCommittedSlot"`
+		// HeapAlloc is the number of bytes of allocated heap objects.
+		HeapAlloc uint64 `json:"heapAlloc"`
+		// HeapInuse is the number of bytes in in-use heap spans.
+		HeapInuse uint64 `json:"heapInuse"`
+		// HeapObjects is the number of allocated heap objects.
+		HeapObjects uint64 `json:"heapObjects"`
+		// Sys is the total number of bytes of memory obtained from the OS.
+		Sys uint64 `json:"sys"`
+		// Goroutines is the number of goroutines that exist.
+		Goroutines int `json:"goroutines"`
+		// GCCycles is the number of GC cycles that completed since the previous sample.
+		GCCycles uint32 `json:"gcCycles"`
+		// GCPauseTotalMs is the sum of the GC pauses since the previous sample (in milliseconds).
+		GCPauseTotalMs float64 `json:"gcPauseTotalMs"`
+		// GCPauseMaxMs is the longest GC pause since the previous sample (in milliseconds).
+		GCPauseMaxMs float64 `json:"gcPauseMaxMs"`
+	}
+)
+
+func historyResponse(samples []*profiling.RuntimeSample) *HistoryResponse {
+	response := &HistoryResponse{
+		Interval: ParamsRuntimeProfiler.Interval.Milliseconds(),
+		Samples:  make([]*SampleResponse, 0, len(samples)),
+	}
+
+	for _, sample := range samples {
+		response.Samples = append(response.Samples, &SampleResponse{
+			Time:                sample.Time.UnixMilli(),
+			LatestCommittedSlot: sample.LatestCommittedSlot,
+			HeapAlloc:           sample.HeapAlloc,
+			HeapInuse:           sample.HeapInuse,
+			HeapObjects:         sample.HeapObjects,
+			Sys:                 sample.Sys,
+			Goroutines:          sample.Goroutines,
+			GCCycles:            sample.GCCycles,
+			GCPauseTotalMs:      float64(sample.GCPauseTotal) / float64(time.Millisecond),
+			GCPauseMaxMs:        float64(sample.GCPauseMax) / float64(time.Millisecond),
+		})
+	}
+
+	return response
+}
@@ -0,0 +1,25 @@
+package runtimeprofiler
+
+import (
+	"time"
+
+	"github.com/iotaledger/hive.go/app"
+)
+
+// ParametersRuntimeProfiler contains the definition of the parameters used by the RuntimeProfiler.
+type ParametersRuntimeProfiler struct {
+	// Enabled defines whether the RuntimeProfiler component is enabled.
+	Enabled bool `default:"false" usage:"whether the RuntimeProfiler component is enabled"`
+	// Interval defines the interval in which the heap, goroutine and GC statistics are sampled.
+	Interval time.Duration `default:"5s" usage:"the interval in which the heap, goroutine and GC statistics are sampled"`
+	// HistorySize defines the number of samples that are retained.
+	HistorySize int `default:"720" usage:"the number of samples that are retained"`
+}
+
+var ParamsRuntimeProfiler = &ParametersRuntimeProfiler{}
+
+var params = &app.ComponentParams{
+	Params: map[string]any{
+		"runtimeProfiler": ParamsRuntimeProfiler,
+	},
+}
@@ -0,0 +1,90 @@
+package snapshotter
+
+import (
+	"context"
+
+	"go.uber.org/dig"
+
+	"github.com/iotaledger/hive.go/app"
+	"github.com/iotaledger/hive.go/runtime/event"
+	"github.com/iotaledger/iota-core/pkg/daemon"
+	"github.com/iotaledger/iota-core/pkg/protocol"
+	"github.com/iotaledger/iota-core/pkg/snapshotter"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func init() {
+	Component = &app.Component{
+		Name:     "Snapshotter",
+		DepsFunc: func(cDeps dependencies) { deps = cDeps },
+		Params:   params,
+		Provide:  provide,
+		Run:      run,
+		IsEnabled: func(c *dig.Container) bool {
+			return ParamsSnapshotter.Enabled
+		},
+	}
+}
+
+var (
+	Component *app.Component
+	deps      dependencies
+)
+
+type dependencies struct {
+	dig.In
+
+	Protocol    *protocol.Protocol
+	Snapshotter *snapshotter.Snapshotter
+}
+
+func provide(c *dig.Container) error {
+	if ParamsSnapshotter.Interval == 0 {
+		Component.LogPanic("snapshotter.interval must be greater than 0")
+	}
+
+	if err := c.Provide(func(protocol *protocol.Protocol) *snapshotter.Snapshotter {
+		return snapshotter.New(
+			protocol,
+			func() iotago.SlotIndex {
+				return protocol.Engines.Main.Get().Storage.Settings().LatestCommitment().Slot()
+			},
+			func(filePath string, targetSlot iotago.SlotIndex) error {
+				return protocol.Engines.Main.Get().WriteSnapshot(filePath, targetSlot)
+			},
+			Component.Logger,
+			snapshotter.WithInterval(iotago.EpochIndex(ParamsSnapshotter.Interval)),
+			snapshotter.WithDirectory(ParamsSnapshotter.Directory),
+			snapshotter.WithRetainedSnapshots(ParamsSnapshotter.RetainedSnapshots),
+			snapshotter.WithUploadCommand(ParamsSnapshotter.UploadCommand),
+		)
+	}); err != nil {
+		Component.LogPanic(err.Error())
+	}
+
+	return nil
+}
+
+func run() error {
+	if err := deps.Snapshotter.Init(); err != nil {
+		Component.LogPanicf("failed to initialize snapshotter: %s", err)
+	}
+
+	if err := Component.Daemon().BackgroundWorker(Component.Name, func(ctx context.Context) {
+		Component.LogInfo("Starting Snapshotter ... done")
+
+		unhook := deps.Protocol.Events.Engine.SlotGadget.SlotFinalized.Hook(func(slot iotago.SlotIndex) {
+			deps.Snapshotter.OnSlotFinalized(ctx, slot)
+		}, event.WithWorkerPool(Component.WorkerPool)).Unhook
+
+		<-ctx.Done()
+		Component.LogInfo("Stopping Snapshotter ...")
+
+		unhook()
+		Component.LogInfo("Stopping Snapshotter ... done")
+	}, daemon.PrioritySnapshotter); err != nil {
+		Component.LogPanicf("failed to start worker: %s", err)
+	}
+
+	return nil
+}
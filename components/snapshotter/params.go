@@ -0,0 +1,27 @@
+package snapshotter
+
+import (
+	"github.com/iotaledger/hive.go/app"
+)
+
+// ParametersSnapshotter contains the definition of the parameters used by the Snapshotter.
+type ParametersSnapshotter struct {
+	// Enabled defines whether the Snapshotter component is enabled.
+	Enabled bool `default:"false" usage:"whether the Snapshotter component is enabled"`
+	// Interval defines the number of finalized epochs between two snapshots.
+	Interval uint32 `default:"10" usage:"the number of finalized epochs between two snapshots"`
+	// Directory defines the directory the snapshots are written to.
+	Directory string `default:"testnet/snapshots" usage:"the directory the snapshots are written to"`
+	// RetainedSnapshots defines the number of snapshots that are kept in the directory.
+	RetainedSnapshots int `default:"5" usage:"the number of snapshots that are kept in the directory"`
+	// UploadCommand defines the command that is executed with the path of every new snapshot as its last argument.
+	UploadCommand string `default:"" usage:"the command that is executed with the path of every new snapshot as its last argument (optional)"`
+}
+
+var ParamsSnapshotter = &ParametersSnapshotter{}
+
+var params = &app.ComponentParams{
+	Params: map[string]any{
+		"snapshotter": ParamsSnapshotter,
+	},
+}
@@ -0,0 +1,98 @@
+package txbuilder
+
+import (
+	"net/http"
+
+	"github.com/labstack/echo/v4"
+	"go.uber.org/dig"
+
+	"github.com/iotaledger/hive.go/app"
+	"github.com/iotaledger/inx-app/pkg/httpserver"
+	"github.com/iotaledger/iota-core/components/restapi"
+	"github.com/iotaledger/iota-core/pkg/protocol"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine"
+	restapipkg "github.com/iotaledger/iota-core/pkg/restapi"
+	"github.com/iotaledger/iota-core/pkg/txbuilder"
+)
+
+const (
+	// RouteSend is the route to construct a transaction that sends base tokens to an address.
+	// POST returns the unsigned transaction.
+	RouteSend = "/send"
+
+	// RouteCreateAccount is the route to construct a transaction that creates an account with a block issuer feature.
+	// POST returns the unsigned transaction.
+	RouteCreateAccount = "/create-account"
+
+	// RouteDelegate is the route to construct a transaction that delegates base tokens to a validator.
+	// POST returns the unsigned transaction.
+	RouteDelegate = "/delegate"
+)
+
+func init() {
+	Component = &app.Component{
+		Name:      "TransactionBuilder",
+		DepsFunc:  func(cDeps dependencies) { deps = cDeps },
+		Configure: configure,
+		Params:    params,
+		IsEnabled: func(c *dig.Container) bool {
+			return restapi.ParamsRestAPI.Enabled && ParamsTransactionBuilder.Enabled
+		},
+	}
+}
+
+var (
+	Component *app.Component
+	deps      dependencies
+
+	transactionBuilder *txbuilder.Builder
+)
+
+type dependencies struct {
+	dig.In
+
+	Protocol         *protocol.Protocol
+	RestRouteManager *restapipkg.RestRouteManager
+}
+
+func configure() error {
+	// check if RestAPI plugin is disabled
+	if !Component.App().IsComponentEnabled(restapi.Component.Identifier()) {
+		Component.LogPanic("RestAPI plugin needs to be enabled to use the TransactionBuilder plugin")
+	}
+
+	transactionBuilder = txbuilder.New(func() *engine.Engine {
+		return deps.Protocol.Engines.Main.Get()
+	})
+
+	routeGroup := deps.RestRouteManager.AddRoute("txbuilder/v1")
+
+	routeGroup.POST(RouteSend, func(c echo.Context) error {
+		resp, err := send(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	})
+
+	routeGroup.POST(RouteCreateAccount, func(c echo.Context) error {
+		resp, err := createAccount(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	})
+
+	routeGroup.POST(RouteDelegate, func(c echo.Context) error {
+		resp, err := delegate(c)
+		if err != nil {
+			return err
+		}
+
+		return httpserver.JSONResponse(c, http.StatusOK, resp)
+	})
+
+	return nil
+}
@@ -0,0 +1,56 @@
+package txbuilder
+
+import (
+	"encoding/json"
+
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+type (
+	SendRequest struct {
+		// The bech32 address whose unspent outputs are used as inputs.
+		SenderAddress string `json:"senderAddress"`
+		// The bech32 address that receives the base tokens.
+		ReceiverAddress string `json:"receiverAddress"`
+		// The amount of base tokens that is sent.
+		Amount iotago.BaseToken `json:"amount,string"`
+	}
+
+	CreateAccountRequest struct {
+		// The bech32 address whose unspent outputs are used as inputs and that controls the account.
+		Address string `json:"address"`
+		// The amount of base tokens that is locked in the account, the minimum deposit is used if it is omitted.
+		Amount iotago.BaseToken `json:"amount,string,omitempty"`
+		// The hex encoded Ed25519 public keys that are allowed to issue blocks for the account.
+		BlockIssuerPublicKeys []string `json:"blockIssuerPublicKeys"`
+		// The slot at which the block issuer feature expires, it does not expire if it is omitted.
+		ExpirySlot iotago.SlotIndex `json:"expirySlot,omitempty"`
+	}
+
+	DelegateRequest struct {
+		// The bech32 address whose unspent outputs are used as inputs and that controls the delegation.
+		Address string `json:"address"`
+		// The bech32 account address of the validator.
+		ValidatorAddress string `json:"validatorAddress"`
+		// The amount of base tokens that is delegated.
+		Amount iotago.BaseToken `json:"amount,string"`
+	}
+
+	UnsignedTransactionResponse struct {
+		// The unsigned transaction.
+		Transaction json.RawMessage `json:"transaction"`
+		// The consumed outputs in the order of the inputs of the transaction.
+		Inputs []*InputResponse `json:"inputs"`
+		// The bech32 address whose signature unlocks all inputs.
+		UnlockAddress string `json:"unlockAddress"`
+		// The hex encoded message that needs to be signed.
+		SigningMessage string `json:"signingMessage"`
+	}
+
+	InputResponse struct {
+		// The hex encoded ID of the consumed output.
+		OutputID string `json:"outputId"`
+		// The consumed output.
+		Output json.RawMessage `json:"output"`
+	}
+)
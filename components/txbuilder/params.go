@@ -0,0 +1,20 @@
+package txbuilder
+
+import (
+	"github.com/iotaledger/hive.go/app"
+)
+
+// ParametersTransactionBuilder contains the definition of configuration parameters used by the transaction builder.
+type ParametersTransactionBuilder struct {
+	// Enabled whether the TransactionBuilder component is enabled.
+	Enabled bool `default:"false" usage:"whether the TransactionBuilder component is enabled"`
+}
+
+// ParamsTransactionBuilder is the default configuration parameters for the TransactionBuilder component.
+var ParamsTransactionBuilder = &ParametersTransactionBuilder{}
+
+var params = &app.ComponentParams{
+	Params: map[string]any{
+		"txBuilder": ParamsTransactionBuilder,
+	},
+}
@@ -0,0 +1,165 @@
+package txbuilder
+
+import (
+	"crypto/ed25519"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/inx-app/pkg/httpserver"
+	"github.com/iotaledger/iota-core/pkg/txbuilder"
+	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/hexutil"
+)
+
+func send(c echo.Context) (*UnsignedTransactionResponse, error) {
+	request := &SendRequest{}
+	if err := c.Bind(request); err != nil {
+		return nil, ierrors.Wrapf(httpserver.ErrInvalidParameter, "invalid request, error: %s", err)
+	}
+
+	senderAddress, err := parseBech32Address(request.SenderAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	receiverAddress, err := parseBech32Address(request.ReceiverAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	unsignedTx, err := transactionBuilder.Send(&txbuilder.SendIntent{
+		SenderAddress:   senderAddress,
+		ReceiverAddress: receiverAddress,
+		Amount:          request.Amount,
+	})
+	if err != nil {
+		return nil, wrapBuilderError(err)
+	}
+
+	return unsignedTransactionResponse(unsignedTx)
+}
+
+func createAccount(c echo.Context) (*UnsignedTransactionResponse, error) {
+	request := &CreateAccountRequest{}
+	if err := c.Bind(request); err != nil {
+		return nil, ierrors.Wrapf(httpserver.ErrInvalidParameter, "invalid request, error: %s", err)
+	}
+
+	address, err := parseBech32Address(request.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	blockIssuerKeys := iotago.NewBlockIssuerKeys()
+	for _, publicKeyHex := range request.BlockIssuerPublicKeys {
+		publicKey, err := hexutil.DecodeHex(publicKeyHex)
+		if err != nil {
+			return nil, ierrors.Wrapf(httpserver.ErrInvalidParameter, "invalid block issuer public key %s, error: %s", publicKeyHex, err)
+		}
+
+		if len(publicKey) != ed25519.PublicKeySize {
+			return nil, ierrors.Wrapf(httpserver.ErrInvalidParameter, "invalid block issuer public key %s, expected %d bytes", publicKeyHex, ed25519.PublicKeySize)
+		}
+
+		blockIssuerKeys.Add(iotago.Ed25519PublicKeyHashBlockIssuerKeyFromPublicKey(publicKey))
+	}
+
+	unsignedTx, err := transactionBuilder.CreateAccount(&txbuilder.CreateAccountIntent{
+		Address:         address,
+		Amount:          request.Amount,
+		BlockIssuerKeys: blockIssuerKeys,
+		ExpirySlot:      request.ExpirySlot,
+	})
+	if err != nil {
+		return nil, wrapBuilderError(err)
+	}
+
+	return unsignedTransactionResponse(unsignedTx)
+}
+
+func delegate(c echo.Context) (*UnsignedTransactionResponse, error) {
+	request := &DelegateRequest{}
+	if err := c.Bind(request); err != nil {
+		return nil, ierrors.Wrapf(httpserver.ErrInvalidParameter, "invalid request, error: %s", err)
+	}
+
+	address, err := parseBech32Address(request.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	validatorAddress, err := parseBech32Address(request.ValidatorAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	validatorAccountAddress, isAccountAddress := validatorAddress.(*iotago.AccountAddress)
+	if !isAccountAddress {
+		return nil, ierrors.Wrapf(httpserver.ErrInvalidParameter, "validator address %s is not an account address", request.ValidatorAddress)
+	}
+
+	unsignedTx, err := transactionBuilder.Delegate(&txbuilder.DelegateIntent{
+		Address:          address,
+		ValidatorAddress: validatorAccountAddress,
+		Amount:           request.Amount,
+	})
+	if err != nil {
+		return nil, wrapBuilderError(err)
+	}
+
+	return unsignedTransactionResponse(unsignedTx)
+}
+
+func parseBech32Address(bech32Address string) (iotago.Address, error) {
+	hrp, address, err := iotago.ParseBech32(bech32Address)
+	if err != nil {
+		return nil, ierrors.Wrapf(httpserver.ErrInvalidParameter, "invalid address %s, error: %s", bech32Address, err)
+	}
+
+	if expectedHRP := deps.Protocol.CommittedAPI().ProtocolParameters().Bech32HRP(); hrp != expectedHRP {
+		return nil, ierrors.Wrapf(httpserver.ErrInvalidParameter, "invalid bech32 address, expected prefix: %s", expectedHRP)
+	}
+
+	return address, nil
+}
+
+func wrapBuilderError(err error) error {
+	if ierrors.Is(err, txbuilder.ErrInvalidIntent) || ierrors.Is(err, txbuilder.ErrInsufficientBaseTokens) || ierrors.Is(err, txbuilder.ErrInsufficientMana) {
+		return ierrors.Wrapf(httpserver.ErrInvalidParameter, "failed to build transaction, error: %s", err)
+	}
+
+	return ierrors.Wrapf(echo.ErrInternalServerError, "failed to build transaction, error: %s", err)
+}
+
+func unsignedTransactionResponse(unsignedTx *txbuilder.UnsignedTransaction) (*UnsignedTransactionResponse, error) {
+	transactionJSON, err := unsignedTx.Transaction.API.JSONEncode(unsignedTx.Transaction)
+	if err != nil {
+		return nil, ierrors.Wrapf(echo.ErrInternalServerError, "failed to encode transaction, error: %s", err)
+	}
+
+	signingMessage, err := unsignedTx.SigningMessage()
+	if err != nil {
+		return nil, ierrors.Wrapf(echo.ErrInternalServerError, "failed to compute signing message, error: %s", err)
+	}
+
+	inputs := make([]*InputResponse, 0, len(unsignedTx.Inputs))
+	for _, input := range unsignedTx.Inputs {
+		outputJSON, err := unsignedTx.Transaction.API.JSONEncode(input.Output())
+		if err != nil {
+			return nil, ierrors.Wrapf(echo.ErrInternalServerError, "failed to encode output %s, error: %s", input.OutputID().ToHex(), err)
+		}
+
+		inputs = append(inputs, &InputResponse{
+			OutputID: input.OutputID().ToHex(),
+			Output:   outputJSON,
+		})
+	}
+
+	return &UnsignedTransactionResponse{
+		Transaction:    transactionJSON,
+		Inputs:         inputs,
+		UnlockAddress:  unsignedTx.UnlockAddress.Bech32(deps.Protocol.CommittedAPI().ProtocolParameters().Bech32HRP()),
+		SigningMessage: hexutil.EncodeHex(signingMessage),
+	}, nil
+}
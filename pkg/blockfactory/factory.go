@@ -0,0 +1,161 @@
+package blockfactory
+
+import (
+	"crypto/ed25519"
+	"sync/atomic"
+	"time"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/hive.go/runtime/workerpool"
+	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/profiling"
+	"github.com/iotaledger/iota-core/pkg/protocol"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/tipmanager"
+	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/builder"
+)
+
+var (
+	// ErrNoStrongParents is returned if the tip selection did not return any strong parents.
+	ErrNoStrongParents = ierrors.New("no strong parents available")
+)
+
+// Factory keeps a pre-built skeleton of the next block ready, so that blocks can be signed and issued as soon as their
+// payload arrives instead of selecting the parents and loading the commitment on demand.
+//
+// The skeleton is refreshed in the background whenever the tips or the latest commitment of the main engine change.
+type Factory struct {
+	// protocol is the protocol instance whose main engine is used to build the skeletons.
+	protocol *protocol.Protocol
+
+	// workerPool refreshes the skeleton in the background.
+	workerPool *workerpool.WorkerPool
+
+	// skeleton is the latest pre-built skeleton.
+	skeleton atomic.Pointer[Skeleton]
+
+	// refreshPending indicates whether a refresh of the skeleton was already scheduled, so that bursts of updates only
+	// trigger a single refresh.
+	refreshPending atomic.Bool
+
+	// unhook removes the event handlers of the factory.
+	unhook func()
+
+	optsMaxParents     int
+	optsMaxSkeletonAge time.Duration
+}
+
+// New creates a new Factory that builds the skeletons from the main engine of the given protocol.
+func New(p *protocol.Protocol, opts ...options.Option[Factory]) *Factory {
+	return options.Apply(&Factory{
+		protocol:           p,
+		workerPool:         profiling.CreatePool(p.Workers, "BlockFactory", workerpool.WithWorkerCount(1)),
+		optsMaxParents:     iotago.BasicBlockMaxParents,
+		optsMaxSkeletonAge: time.Second,
+	}, opts, func(f *Factory) {
+		f.unhook = lo.Batch(
+			p.Events.Engine.TipManager.BlockAdded.Hook(func(tipmanager.TipMetadata) { f.scheduleRefresh() }).Unhook,
+			p.Events.Engine.Notarization.LatestCommitmentUpdated.Hook(func(*model.Commitment) { f.scheduleRefresh() }).Unhook,
+		)
+	})
+}
+
+// Skeleton returns the current skeleton of the next block. The skeleton is rebuilt synchronously if it is missing, was
+// built by a different engine or is older than the configured maximum age.
+func (f *Factory) Skeleton() (*Skeleton, error) {
+	engineInstance := f.protocol.Engines.Main.Get()
+	if engineInstance == nil {
+		return nil, ierrors.New("no main engine available")
+	}
+
+	if skeleton := f.skeleton.Load(); skeleton != nil && skeleton.engine == engineInstance && time.Since(skeleton.CreationTime) <= f.optsMaxSkeletonAge {
+		return skeleton, nil
+	}
+
+	return f.refresh()
+}
+
+// CreateBlock creates a basic block that contains the given payload from the current skeleton and signs it with the
+// given key of the issuer account.
+func (f *Factory) CreateBlock(payload iotago.ApplicationPayload, issuerID iotago.AccountID, privateKey ed25519.PrivateKey) (*iotago.Block, error) {
+	skeleton, err := f.Skeleton()
+	if err != nil {
+		return nil, ierrors.Wrap(err, "failed to retrieve block skeleton")
+	}
+
+	issuingTime := time.Now().UTC()
+	if issuingTime.Before(skeleton.ParentsMaxIssuingTime) {
+		issuingTime = skeleton.ParentsMaxIssuingTime
+	}
+
+	apiForTime := skeleton.engine.APIForTime(issuingTime)
+
+	commitment, err := skeleton.addressableCommitment(apiForTime, issuingTime)
+	if err != nil {
+		return nil, err
+	}
+
+	blockBuilder := builder.NewBasicBlockBuilder(apiForTime).
+		IssuingTime(issuingTime).
+		SlotCommitmentID(commitment.MustID()).
+		LatestFinalizedSlot(skeleton.LatestFinalizedSlot).
+		StrongParents(skeleton.References[iotago.StrongParentType]).
+		WeakParents(skeleton.References[iotago.WeakParentType]).
+		ShallowLikeParents(skeleton.References[iotago.ShallowLikeParentType]).
+		Payload(payload)
+
+	// the burned mana needs to be set after the payload, so that the work score of the block is correct.
+	return blockBuilder.CalculateAndSetMaxBurnedMana(commitment.ReferenceManaCost).
+		Sign(issuerID, privateKey).
+		Build()
+}
+
+// Shutdown removes the event handlers and stops the background refreshes of the factory.
+func (f *Factory) Shutdown() {
+	f.unhook()
+
+	f.workerPool.Shutdown()
+	f.workerPool.ShutdownComplete.Wait()
+}
+
+// scheduleRefresh schedules a background refresh of the skeleton unless one is already pending.
+func (f *Factory) scheduleRefresh() {
+	if !f.refreshPending.CompareAndSwap(false, true) {
+		return
+	}
+
+	f.workerPool.Submit(func() {
+		f.refreshPending.Store(false)
+
+		// the refresh is retried once the next update arrives, so errors can be ignored here.
+		_, _ = f.refresh()
+	})
+}
+
+// refresh builds a new skeleton from the main engine and stores it as the current one.
+func (f *Factory) refresh() (*Skeleton, error) {
+	skeleton, err := newSkeleton(f.protocol.Engines.Main.Get(), f.optsMaxParents)
+	if err != nil {
+		return nil, err
+	}
+
+	f.skeleton.Store(skeleton)
+
+	return skeleton, nil
+}
+
+// WithMaxParents sets the maximum number of parents per parent type of the created blocks.
+func WithMaxParents(maxParents int) options.Option[Factory] {
+	return func(f *Factory) {
+		f.optsMaxParents = maxParents
+	}
+}
+
+// WithMaxSkeletonAge sets the maximum age of a skeleton before it is rebuilt synchronously when a block is created.
+func WithMaxSkeletonAge(maxSkeletonAge time.Duration) options.Option[Factory] {
+	return func(f *Factory) {
+		f.optsMaxSkeletonAge = maxSkeletonAge
+	}
+}
@@ -0,0 +1,81 @@
+package blockfactory
+
+import (
+	"bytes"
+	"crypto/ed25519"
+
+	"github.com/libp2p/go-libp2p/core/crypto"
+
+	hiveEd25519 "github.com/iotaledger/hive.go/crypto/ed25519"
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/iota-core/pkg/protocol"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+var (
+	// ErrIssuerAccountNotFound is returned if the block issuer account does not exist in the ledger.
+	ErrIssuerAccountNotFound = ierrors.New("block issuer account not found")
+
+	// ErrNoBlockIssuerFeature is returned if the block issuer account has no block issuer feature.
+	ErrNoBlockIssuerFeature = ierrors.New("account has no block issuer feature")
+
+	// ErrIssuerAccountExpired is returned if the block issuer feature of the block issuer account is expired.
+	ErrIssuerAccountExpired = ierrors.New("block issuer account is expired")
+
+	// ErrUnknownIssuerKey is returned if the key that is used to sign the blocks is not a block issuer key of the account.
+	ErrUnknownIssuerKey = ierrors.New("key is not a block issuer key of the account")
+
+	// ErrIdentityKeyReused is returned if the key that is used to sign the blocks is the p2p identity key of the node.
+	ErrIdentityKeyReused = ierrors.New("the p2p identity key of the node must not be used to issue blocks")
+)
+
+// ValidateIssuer checks that the given account exists in the ledger of the main engine at the latest commitment, has a
+// block issuer feature that is not expired and that the public key of the given private key is one of its block issuer
+// keys (blocks that are signed with a different key are filtered by the other nodes).
+func ValidateIssuer(p *protocol.Protocol, issuerID iotago.AccountID, privateKey ed25519.PrivateKey) error {
+	engineInstance := p.Engines.Main.Get()
+	if engineInstance == nil {
+		return ierrors.New("no main engine available")
+	}
+
+	latestCommittedSlot := engineInstance.SyncManager.LatestCommitment().Slot()
+
+	accountData, exists, err := engineInstance.Ledger.Account(issuerID, latestCommittedSlot)
+	if err != nil {
+		return ierrors.Wrapf(err, "failed to load block issuer account %s", issuerID)
+	} else if !exists {
+		return ierrors.Wrapf(ErrIssuerAccountNotFound, "account %s in slot %d", issuerID, latestCommittedSlot)
+	}
+
+	if len(accountData.BlockIssuerKeys) == 0 {
+		return ierrors.Wrapf(ErrNoBlockIssuerFeature, "account %s", issuerID)
+	}
+
+	if accountData.ExpirySlot < latestCommittedSlot {
+		return ierrors.Wrapf(ErrIssuerAccountExpired, "account %s expired in slot %d", issuerID, accountData.ExpirySlot)
+	}
+
+	//nolint:forcetypeassert // we can safely assume that the public key of an ed25519 private key is an ed25519 public key
+	publicKey := hiveEd25519.PublicKey(privateKey.Public().(ed25519.PublicKey))
+	if !accountData.BlockIssuerKeys.Has(iotago.Ed25519PublicKeyBlockIssuerKeyFromPublicKey(publicKey)) {
+		return ierrors.Wrapf(ErrUnknownIssuerKey, "account %s, public key %s", issuerID, publicKey)
+	}
+
+	return nil
+}
+
+// ValidateKeySeparation checks that the given private key that is used to issue blocks is not the p2p identity key of
+// the node (the identity of the node in the network must not be linkable to the accounts that it issues blocks for).
+func ValidateKeySeparation(privateKey ed25519.PrivateKey, identityKey crypto.PubKey) error {
+	identityPublicKey, err := identityKey.Raw()
+	if err != nil {
+		return ierrors.Wrap(err, "failed to get raw p2p identity public key")
+	}
+
+	//nolint:forcetypeassert // we can safely assume that the public key of an ed25519 private key is an ed25519 public key
+	if bytes.Equal(privateKey.Public().(ed25519.PublicKey), identityPublicKey) {
+		return ErrIdentityKeyReused
+	}
+
+	return nil
+}
@@ -0,0 +1,91 @@
+package blockfactory
+
+import (
+	"time"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// Skeleton contains the pre-selected parts of a block that do not depend on its payload.
+type Skeleton struct {
+	// References contains the selected parents of the block.
+	References model.ParentReferences
+
+	// ParentsMaxIssuingTime is the latest issuing time of the parents, which is the earliest possible issuing time of
+	// the block.
+	ParentsMaxIssuingTime time.Time
+
+	// LatestCommitment is the latest commitment of the engine at the time the skeleton was built.
+	LatestCommitment *model.Commitment
+
+	// LatestFinalizedSlot is the latest finalized slot of the engine at the time the skeleton was built.
+	LatestFinalizedSlot iotago.SlotIndex
+
+	// CreationTime is the time at which the skeleton was built.
+	CreationTime time.Time
+
+	// engine is the engine that the skeleton was built from.
+	engine *engine.Engine
+}
+
+// newSkeleton builds a new skeleton from the current state of the given engine.
+func newSkeleton(engineInstance *engine.Engine, maxParents int) (*Skeleton, error) {
+	if engineInstance == nil {
+		return nil, ierrors.New("no main engine available")
+	}
+
+	references := engineInstance.TipSelection.SelectTips(maxParents)
+	if len(references[iotago.StrongParentType]) == 0 {
+		return nil, ErrNoStrongParents
+	}
+
+	var parentsMaxIssuingTime time.Time
+	for _, parentType := range []iotago.ParentsType{iotago.StrongParentType, iotago.WeakParentType, iotago.ShallowLikeParentType} {
+		for _, blockID := range references[parentType] {
+			parent, exists := engineInstance.Block(blockID)
+			if !exists {
+				return nil, ierrors.Errorf("no block found for parent %s", blockID)
+			}
+
+			if parentIssuingTime := parent.ProtocolBlock().Header.IssuingTime; parentIssuingTime.After(parentsMaxIssuingTime) {
+				parentsMaxIssuingTime = parentIssuingTime
+			}
+		}
+	}
+
+	return &Skeleton{
+		References:            references,
+		ParentsMaxIssuingTime: parentsMaxIssuingTime,
+		LatestCommitment:      engineInstance.Storage.Settings().LatestCommitment(),
+		LatestFinalizedSlot:   engineInstance.SyncManager.LatestFinalizedSlot(),
+		CreationTime:          time.Now(),
+		engine:                engineInstance,
+	}, nil
+}
+
+// addressableCommitment returns the latest commitment that a block issued at the given time can reference.
+func (s *Skeleton) addressableCommitment(apiForTime iotago.API, issuingTime time.Time) (*iotago.Commitment, error) {
+	protocolParameters := apiForTime.ProtocolParameters()
+	blockSlot := apiForTime.TimeProvider().SlotFromTime(issuingTime)
+
+	commitment := s.LatestCommitment.Commitment()
+
+	if blockSlot > commitment.Slot+protocolParameters.MaxCommittableAge() {
+		return nil, ierrors.Errorf("block slot %d is too far in the future, latest commitment is %d", blockSlot, commitment.Slot)
+	}
+
+	if blockSlot >= commitment.Slot+protocolParameters.MinCommittableAge() || blockSlot < protocolParameters.MinCommittableAge() || commitment.Slot < protocolParameters.MinCommittableAge() {
+		return commitment, nil
+	}
+
+	commitmentSlot := commitment.Slot - protocolParameters.MinCommittableAge()
+	loadedCommitment, err := s.engine.Storage.Commitments().Load(commitmentSlot)
+	if err != nil {
+		return nil, ierrors.Wrapf(err, "failed to load commitment of slot %d", commitmentSlot)
+	}
+
+	return loadedCommitment.Commitment(), nil
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/iotaledger/hive.go/runtime/event"
 	"github.com/iotaledger/hive.go/runtime/workerpool"
 	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/profiling"
 	"github.com/iotaledger/iota-core/pkg/protocol"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/filter/presolidfilter"
@@ -38,11 +39,16 @@ type BlockHandler struct {
 func New(p *protocol.Protocol) *BlockHandler {
 	return &BlockHandler{
 		events:     NewEvents(),
-		workerPool: p.Workers.CreatePool("BlockIssuer"),
+		workerPool: profiling.CreatePool(p.Workers, "BlockIssuer"),
 		protocol:   p,
 	}
 }
 
+// Events returns the events of the block handler.
+func (i *BlockHandler) Events() *Events {
+	return i.events
+}
+
 // Shutdown shuts down the block issuer.
 func (i *BlockHandler) Shutdown() {
 	i.workerPool.Shutdown()
@@ -0,0 +1,182 @@
+package configreload
+
+import (
+	"reflect"
+	"sort"
+
+	"github.com/iotaledger/hive.go/ds/shrinkingmap"
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/runtime/syncutils"
+)
+
+var (
+	// ErrInvalidValue is returned if the reloaded value of a parameter could not be decoded or failed the validation.
+	ErrInvalidValue = ierrors.New("invalid parameter value")
+
+	// ErrApplyFailed is returned if the reloaded value of a parameter could not be applied.
+	ErrApplyFailed = ierrors.New("failed to apply parameter value")
+)
+
+// Source is a loaded configuration that the values of the parameters are read from.
+type Source interface {
+	// Exists returns true if the configuration contains a value for the given key.
+	Exists(key string) bool
+
+	// Unmarshal decodes the value of the given key into the given target.
+	Unmarshal(key string, target any) error
+}
+
+// Registry keeps track of the parameters that can be changed at runtime without restarting the node.
+type Registry struct {
+	// parameters contains the registered parameters by their name.
+	parameters *shrinkingmap.ShrinkingMap[string, parameter]
+
+	// loadSources loads the configurations that the parameters are reloaded from (by config name).
+	loadSources func() (map[string]Source, error)
+
+	// reloadMutex is used to prevent concurrent reloads.
+	reloadMutex syncutils.Mutex
+}
+
+// NewRegistry creates a new Registry that reloads the parameters from the configurations returned by loadSources.
+func NewRegistry(loadSources func() (map[string]Source, error)) *Registry {
+	return &Registry{
+		parameters:  shrinkingmap.New[string, parameter](),
+		loadSources: loadSources,
+	}
+}
+
+// Register registers a parameter of the given configuration that can be reloaded at runtime. The apply function is
+// called with the new value whenever the value changed and all reloaded values passed the validation.
+func Register[T any](r *Registry, configName string, key string, currentValue T, apply func(T) error, validate ...func(T) error) {
+	r.parameters.Set(parameterName(configName, key), &typedParameter[T]{
+		configName:   configName,
+		key:          key,
+		currentValue: currentValue,
+		apply:        apply,
+		validate:     validate,
+	})
+}
+
+// Parameters returns the sorted names of all registered parameters.
+func (r *Registry) Parameters() []string {
+	names := r.parameters.Keys()
+	sort.Strings(names)
+
+	return names
+}
+
+// Reload loads the configurations and applies the changed values of the registered parameters. No value is applied if
+// any of the values is invalid.
+func (r *Registry) Reload() (changed []string, err error) {
+	sources, err := r.loadSources()
+	if err != nil {
+		return nil, ierrors.Wrap(err, "failed to load configurations")
+	}
+
+	return r.ReloadFrom(sources)
+}
+
+// ReloadFrom applies the changed values of the registered parameters from the given configurations (by config name).
+// No value is applied if any of the values is invalid.
+func (r *Registry) ReloadFrom(sources map[string]Source) (changed []string, err error) {
+	r.reloadMutex.Lock()
+	defer r.reloadMutex.Unlock()
+
+	pendingChanges := make([]func() error, 0)
+	for _, name := range r.Parameters() {
+		param, exists := r.parameters.Get(name)
+		if !exists {
+			continue
+		}
+
+		source, exists := sources[param.ConfigName()]
+		if !exists || source == nil || !source.Exists(param.Key()) {
+			continue
+		}
+
+		applyChange, hasChanged, prepareErr := param.Prepare(source)
+		if prepareErr != nil {
+			err = ierrors.Join(err, ierrors.Wrapf(prepareErr, "parameter %s", name))
+		} else if hasChanged {
+			changed = append(changed, name)
+			pendingChanges = append(pendingChanges, applyChange)
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	for i, applyChange := range pendingChanges {
+		if applyErr := applyChange(); applyErr != nil {
+			err = ierrors.Join(err, ierrors.Wrapf(applyErr, "parameter %s", changed[i]))
+		}
+	}
+
+	return changed, err
+}
+
+// parameter is the untyped interface of the registered parameters.
+type parameter interface {
+	// ConfigName returns the name of the configuration that contains the parameter.
+	ConfigName() string
+
+	// Key returns the key of the parameter in the configuration.
+	Key() string
+
+	// Prepare decodes and validates the value of the parameter and returns a function that applies it.
+	Prepare(source Source) (applyChange func() error, changed bool, err error)
+}
+
+// typedParameter is a parameter with a value of type T.
+type typedParameter[T any] struct {
+	configName   string
+	key          string
+	currentValue T
+	apply        func(T) error
+	validate     []func(T) error
+}
+
+// ConfigName returns the name of the configuration that contains the parameter.
+func (p *typedParameter[T]) ConfigName() string {
+	return p.configName
+}
+
+// Key returns the key of the parameter in the configuration.
+func (p *typedParameter[T]) Key() string {
+	return p.key
+}
+
+// Prepare decodes and validates the value of the parameter and returns a function that applies it.
+func (p *typedParameter[T]) Prepare(source Source) (applyChange func() error, changed bool, err error) {
+	var newValue T
+	if err = source.Unmarshal(p.key, &newValue); err != nil {
+		return nil, false, ierrors.Join(ErrInvalidValue, err)
+	}
+
+	if reflect.DeepEqual(newValue, p.currentValue) {
+		return nil, false, nil
+	}
+
+	for _, validate := range p.validate {
+		if err = validate(newValue); err != nil {
+			return nil, false, ierrors.Join(ErrInvalidValue, err)
+		}
+	}
+
+	return func() error {
+		if err := p.apply(newValue); err != nil {
+			return ierrors.Join(ErrApplyFailed, err)
+		}
+
+		p.currentValue = newValue
+
+		return nil
+	}, true, nil
+}
+
+// parameterName returns the name of the parameter with the given key in the given configuration.
+func parameterName(configName string, key string) string {
+	return configName + ":" + key
+}
@@ -0,0 +1,104 @@
+package configreload_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/app/configuration"
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/iota-core/pkg/configreload"
+)
+
+func TestRegistry(t *testing.T) {
+	var (
+		configFile = filepath.Join(t.TempDir(), "config.json")
+		level      = "info"
+		cooldown   = 5 * time.Minute
+		peers      = []string{"a"}
+		applied    []string
+	)
+
+	registry := configreload.NewRegistry(func() (map[string]configreload.Source, error) {
+		config := configuration.New()
+		if err := config.LoadFile(configFile); err != nil {
+			return nil, err
+		}
+
+		return map[string]configreload.Source{"app": config}, nil
+	})
+
+	configreload.Register(registry, "app", "logger.level", level, func(newLevel string) error {
+		level = newLevel
+		applied = append(applied, "logger.level")
+
+		return nil
+	}, func(newLevel string) error {
+		if newLevel == "invalid" {
+			return ierrors.New("unknown log level")
+		}
+
+		return nil
+	})
+	configreload.Register(registry, "app", "db.size.cooldownTime", cooldown, func(newCooldown time.Duration) error {
+		cooldown = newCooldown
+		applied = append(applied, "db.size.cooldownTime")
+
+		return nil
+	})
+	configreload.Register(registry, "app", "p2p.peers", peers, func(newPeers []string) error {
+		peers = newPeers
+		applied = append(applied, "p2p.peers")
+
+		return nil
+	})
+	configreload.Register(registry, "peering", "peers", peers, func([]string) error {
+		require.Fail(t, "parameters of configurations that were not loaded must not be applied")
+
+		return nil
+	})
+
+	require.Equal(t, []string{"app:db.size.cooldownTime", "app:logger.level", "app:p2p.peers", "peering:peers"}, registry.Parameters())
+
+	writeConfig := func(content string) {
+		require.NoError(t, os.WriteFile(configFile, []byte(content), 0o600))
+	}
+
+	// only the parameters that are contained in the configuration and that changed are applied
+	writeConfig(`{"logger": {"level": "debug"}, "db": {"size": {"cooldownTime": "5m"}}}`)
+	changed, err := registry.Reload()
+	require.NoError(t, err)
+	require.Equal(t, []string{"app:logger.level"}, changed)
+	require.Equal(t, "debug", level)
+	require.Equal(t, []string{"logger.level"}, applied)
+
+	// no value is applied if any of the values is invalid
+	writeConfig(`{"logger": {"level": "invalid"}, "db": {"size": {"cooldownTime": "1m"}}, "p2p": {"peers": ["a", "b"]}}`)
+	_, err = registry.Reload()
+	require.ErrorIs(t, err, configreload.ErrInvalidValue)
+	require.Equal(t, "debug", level)
+	require.Equal(t, 5*time.Minute, cooldown)
+	require.Equal(t, []string{"a"}, peers)
+
+	writeConfig(`{"logger": {"level": "debug"}, "db": {"size": {"cooldownTime": "1m"}}, "p2p": {"peers": ["a", "b"]}}`)
+	changed, err = registry.Reload()
+	require.NoError(t, err)
+	require.Equal(t, []string{"app:db.size.cooldownTime", "app:p2p.peers"}, changed)
+	require.Equal(t, time.Minute, cooldown)
+	require.Equal(t, []string{"a", "b"}, peers)
+
+	// reloading the same configuration again does not change anything
+	applied = nil
+	changed, err = registry.Reload()
+	require.NoError(t, err)
+	require.Empty(t, changed)
+	require.Empty(t, applied)
+
+	// errors of loading the configurations are returned
+	require.NoError(t, os.Remove(configFile))
+	_, err = registry.Reload()
+	require.Error(t, err)
+}
@@ -10,7 +10,12 @@ const bftThreshold = 0.67
 
 func ThresholdProvider(totalWeightProvider func() int64) func() int64 {
 	return func() int64 {
-		// TODO: should we allow threshold go to 0? or should acceptance stop if no committee member is active?
-		return lo.Max(int64(math.Ceil(float64(totalWeightProvider())*bftThreshold)), 1)
+		return Threshold(totalWeightProvider())
 	}
 }
+
+// Threshold returns the weight that is required to reach acceptance for the given total weight.
+func Threshold(totalWeight int64) int64 {
+	// TODO: should we allow threshold go to 0? or should acceptance stop if no committee member is active?
+	return lo.Max(int64(math.Ceil(float64(totalWeight)*bftThreshold)), 1)
+}
@@ -10,10 +10,18 @@ const (
 	PriorityManualPeering
 	PriorityProtocol
 	PriorityBlockIssuer
-	PriorityActivity // depends on BlockIssuer
+	PriorityActivity       // depends on BlockIssuer
+	PrioritySnapshotter    // depends on Protocol
+	PriorityRecorder       // depends on Protocol
+	PriorityFaucet         // depends on Protocol
+	PriorityReattacher     // depends on Protocol
+	PriorityEventForwarder // depends on Protocol
 	PriorityRestAPI
 	PriorityINX
+	PriorityGRPCAdmin // depends on Protocol
 	PriorityDashboardMetrics
 	PriorityDashboard
 	PriorityMetrics
+	PriorityRuntimeProfiler // depends on Protocol
+	PriorityConfigReload
 )
@@ -0,0 +1,77 @@
+package eventforwarder
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+)
+
+// Severity is the severity of an Event.
+type Severity string
+
+const (
+	// SeverityError is the severity of events that require the attention of an operator.
+	SeverityError Severity = "error"
+
+	// SeverityWarning is the severity of events that indicate a potential problem.
+	SeverityWarning Severity = "warning"
+
+	// SeverityInfo is the severity of informational events.
+	SeverityInfo Severity = "info"
+)
+
+// Event is a structured node event that is forwarded to an external sink.
+type Event struct {
+	// Time is the time the event occurred.
+	Time time.Time `json:"time"`
+
+	// Type is the type of the event.
+	Type string `json:"type"`
+
+	// Severity is the severity of the event.
+	Severity Severity `json:"severity"`
+
+	// Message is the human-readable description of the event.
+	Message string `json:"message"`
+
+	// Fields contains the structured details of the event.
+	Fields map[string]string `json:"fields,omitempty"`
+}
+
+// NewEvent creates a new Event that occurred now. The fields are given as key-value pairs.
+func NewEvent(eventType string, severity Severity, message string, fields ...any) *Event {
+	event := &Event{
+		Time:     time.Now(),
+		Type:     eventType,
+		Severity: severity,
+		Message:  message,
+	}
+
+	if len(fields) > 0 {
+		event.Fields = make(map[string]string, len(fields)/2)
+		for i := 0; i+1 < len(fields); i += 2 {
+			event.Fields[fmt.Sprint(fields[i])] = fmt.Sprint(fields[i+1])
+		}
+	}
+
+	return event
+}
+
+// String returns the message of the event followed by its fields in logfmt.
+func (e *Event) String() string {
+	var builder strings.Builder
+	builder.WriteString(e.Message)
+
+	keys := make([]string, 0, len(e.Fields))
+	for key := range e.Fields {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		fmt.Fprintf(&builder, " %s=%q", key, e.Fields[key])
+	}
+
+	return builder.String()
+}
@@ -0,0 +1,173 @@
+package eventforwarder
+
+import (
+	"context"
+	"sync/atomic"
+	"time"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/runtime/options"
+)
+
+// Forwarder queues events and delivers them in batches to a Sink. Failed deliveries are retried with an exponential
+// backoff, while new events are queued up to the size of the queue (further events are dropped).
+type Forwarder struct {
+	// sink is the sink that the events are delivered to.
+	sink Sink
+
+	// queue contains the events that were not yet added to a batch.
+	queue chan *Event
+
+	// droppedEvents is the number of events that were dropped because the queue was full.
+	droppedEvents atomic.Uint64
+
+	// optsQueueSize is the maximum number of queued events.
+	optsQueueSize int
+
+	// optsBatchSize is the maximum number of events that are delivered in a single batch.
+	optsBatchSize int
+
+	// optsFlushInterval is the interval in which incomplete batches are delivered.
+	optsFlushInterval time.Duration
+
+	// optsMinBackoff is the delay before the first retry of a failed delivery.
+	optsMinBackoff time.Duration
+
+	// optsMaxBackoff is the maximum delay between the retries of a failed delivery.
+	optsMaxBackoff time.Duration
+}
+
+// New creates a new Forwarder that delivers to the given sink.
+func New(sink Sink, opts ...options.Option[Forwarder]) *Forwarder {
+	return options.Apply(&Forwarder{
+		sink:              sink,
+		optsQueueSize:     1000,
+		optsBatchSize:     100,
+		optsFlushInterval: 5 * time.Second,
+		optsMinBackoff:    time.Second,
+		optsMaxBackoff:    time.Minute,
+	}, opts, func(f *Forwarder) {
+		f.queue = make(chan *Event, f.optsQueueSize)
+	})
+}
+
+// Forward queues the given event for delivery. It returns false if the event was dropped because the queue is full.
+func (f *Forwarder) Forward(event *Event) bool {
+	select {
+	case f.queue <- event:
+		return true
+	default:
+		f.droppedEvents.Add(1)
+
+		return false
+	}
+}
+
+// DroppedEvents returns the number of events that were dropped because the queue was full.
+func (f *Forwarder) DroppedEvents() uint64 {
+	return f.droppedEvents.Load()
+}
+
+// Run delivers the queued events until the context is canceled. The errors of failed deliveries are passed to the
+// error handler before they are retried.
+func (f *Forwarder) Run(ctx context.Context, errorHandler func(error)) {
+	ticker := time.NewTicker(f.optsFlushInterval)
+	defer ticker.Stop()
+
+	batch := make([]*Event, 0, f.optsBatchSize)
+	deliverBatch := func() {
+		if len(batch) != 0 {
+			f.deliver(ctx, batch, errorHandler)
+			batch = make([]*Event, 0, f.optsBatchSize)
+		}
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			f.flush(batch, errorHandler)
+
+			return
+		case event := <-f.queue:
+			if batch = append(batch, event); len(batch) >= f.optsBatchSize {
+				deliverBatch()
+			}
+		case <-ticker.C:
+			deliverBatch()
+		}
+	}
+}
+
+// deliver sends the batch to the sink and retries with an exponential backoff until it succeeds or the context is
+// canceled.
+func (f *Forwarder) deliver(ctx context.Context, batch []*Event, errorHandler func(error)) {
+	for backoff := f.optsMinBackoff; ; backoff = min(2*backoff, f.optsMaxBackoff) {
+		err := f.sink.Send(ctx, batch)
+		if err == nil || ctx.Err() != nil {
+			return
+		}
+
+		errorHandler(ierrors.Wrapf(err, "failed to forward %d events to %s (retrying in %s)", len(batch), f.sink.Name(), backoff))
+
+		timer := time.NewTimer(backoff)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+
+			return
+		}
+	}
+}
+
+// flush makes a single attempt to deliver the given batch and the queued events when the Forwarder is stopped.
+func (f *Forwarder) flush(batch []*Event, errorHandler func(error)) {
+	for drained := false; !drained; {
+		select {
+		case event := <-f.queue:
+			batch = append(batch, event)
+		default:
+			drained = true
+		}
+	}
+
+	if len(batch) == 0 {
+		return
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), f.optsFlushInterval)
+	defer cancel()
+
+	if err := f.sink.Send(ctx, batch); err != nil {
+		errorHandler(ierrors.Wrapf(err, "failed to forward %d events to %s on shutdown", len(batch), f.sink.Name()))
+	}
+}
+
+// WithQueueSize sets the maximum number of queued events.
+func WithQueueSize(queueSize int) options.Option[Forwarder] {
+	return func(f *Forwarder) {
+		f.optsQueueSize = queueSize
+	}
+}
+
+// WithBatchSize sets the maximum number of events that are delivered in a single batch.
+func WithBatchSize(batchSize int) options.Option[Forwarder] {
+	return func(f *Forwarder) {
+		f.optsBatchSize = batchSize
+	}
+}
+
+// WithFlushInterval sets the interval in which incomplete batches are delivered.
+func WithFlushInterval(flushInterval time.Duration) options.Option[Forwarder] {
+	return func(f *Forwarder) {
+		f.optsFlushInterval = flushInterval
+	}
+}
+
+// WithBackoff sets the delay before the first retry of a failed delivery and the maximum delay between retries.
+func WithBackoff(minBackoff time.Duration, maxBackoff time.Duration) options.Option[Forwarder] {
+	return func(f *Forwarder) {
+		f.optsMinBackoff = minBackoff
+		f.optsMaxBackoff = maxBackoff
+	}
+}
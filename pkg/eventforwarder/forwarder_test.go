@@ -0,0 +1,215 @@
+package eventforwarder
+
+import (
+	"bufio"
+	"context"
+	"encoding/json"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/ierrors"
+)
+
+// testSink is a Sink that records the delivered batches and fails the first deliveries.
+type testSink struct {
+	failures int
+	batches  [][]*Event
+	mutex    sync.Mutex
+}
+
+func (t *testSink) Name() string {
+	return "test"
+}
+
+func (t *testSink) Send(_ context.Context, events []*Event) error {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if t.failures > 0 {
+		t.failures--
+
+		return ierrors.New("sink unavailable")
+	}
+
+	t.batches = append(t.batches, events)
+
+	return nil
+}
+
+func (t *testSink) batchSizes() []int {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	sizes := make([]int, 0, len(t.batches))
+	for _, batch := range t.batches {
+		sizes = append(sizes, len(batch))
+	}
+
+	return sizes
+}
+
+func TestForwarder_Batching(t *testing.T) {
+	sink := &testSink{}
+	forwarder := New(sink, WithBatchSize(3), WithFlushInterval(time.Hour))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stopped := make(chan struct{})
+	go func() {
+		defer close(stopped)
+
+		forwarder.Run(ctx, func(err error) { require.NoError(t, err) })
+	}()
+
+	for i := 0; i < 7; i++ {
+		require.True(t, forwarder.Forward(NewEvent("test", SeverityInfo, "event", "index", i)))
+	}
+
+	require.Eventually(t, func() bool { return len(sink.batchSizes()) == 2 }, 5*time.Second, 10*time.Millisecond)
+	require.Equal(t, []int{3, 3}, sink.batchSizes())
+
+	// the remaining event is delivered when the forwarder is stopped.
+	cancel()
+	<-stopped
+	require.Equal(t, []int{3, 3, 1}, sink.batchSizes())
+}
+
+func TestForwarder_Backoff(t *testing.T) {
+	sink := &testSink{failures: 2}
+	forwarder := New(sink, WithFlushInterval(10*time.Millisecond), WithBackoff(time.Millisecond, 5*time.Millisecond))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	var errorsMutex sync.Mutex
+	var deliveryErrors []error
+	go forwarder.Run(ctx, func(err error) {
+		errorsMutex.Lock()
+		defer errorsMutex.Unlock()
+
+		deliveryErrors = append(deliveryErrors, err)
+	})
+
+	require.True(t, forwarder.Forward(NewEvent("test", SeverityError, "event")))
+
+	require.Eventually(t, func() bool { return len(sink.batchSizes()) == 1 }, 5*time.Second, 10*time.Millisecond)
+
+	errorsMutex.Lock()
+	defer errorsMutex.Unlock()
+	require.Len(t, deliveryErrors, 2)
+}
+
+func TestForwarder_DropsEventsIfQueueIsFull(t *testing.T) {
+	forwarder := New(&testSink{}, WithQueueSize(2))
+
+	require.True(t, forwarder.Forward(NewEvent("test", SeverityInfo, "event")))
+	require.True(t, forwarder.Forward(NewEvent("test", SeverityInfo, "event")))
+	require.False(t, forwarder.Forward(NewEvent("test", SeverityInfo, "event")))
+	require.EqualValues(t, 1, forwarder.DroppedEvents())
+}
+
+func TestLokiSink(t *testing.T) {
+	var request lokiPushRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	sink := NewLokiSink(server.URL, map[string]string{"job": "iota-core"}, server.Client())
+	require.NoError(t, sink.Send(context.Background(), []*Event{
+		NewEvent("chainSwitched", SeverityWarning, "switched chain"),
+		NewEvent("error", SeverityError, "engine error", "err", "failed"),
+		NewEvent("error", SeverityError, "engine error", "err", "failed again"),
+	}))
+
+	require.Len(t, request.Streams, 2)
+	require.Equal(t, map[string]string{"job": "iota-core", "severity": "warning"}, request.Streams[0].Stream)
+	require.Len(t, request.Streams[0].Values, 1)
+	require.Equal(t, map[string]string{"job": "iota-core", "severity": "error"}, request.Streams[1].Stream)
+	require.Len(t, request.Streams[1].Values, 2)
+
+	var event Event
+	require.NoError(t, json.Unmarshal([]byte(request.Streams[1].Values[1][1]), &event))
+	require.Equal(t, "failed again", event.Fields["err"])
+}
+
+func TestWebhookSink(t *testing.T) {
+	failRequests := true
+	var request webhookRequest
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if failRequests {
+			w.WriteHeader(http.StatusServiceUnavailable)
+
+			return
+		}
+
+		require.NoError(t, json.NewDecoder(r.Body).Decode(&request))
+	}))
+	defer server.Close()
+
+	sink := NewWebhookSink(server.URL, server.Client())
+	events := []*Event{NewEvent("finalizationStalled", SeverityWarning, "finalization stalled", "lag", 10)}
+
+	require.Error(t, sink.Send(context.Background(), events))
+
+	failRequests = false
+	require.NoError(t, sink.Send(context.Background(), events))
+	require.Len(t, request.Events, 1)
+	require.Equal(t, "10", request.Events[0].Fields["lag"])
+}
+
+func TestSyslogSink(t *testing.T) {
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	require.NoError(t, err)
+	defer listener.Close()
+
+	received := make(chan string, 1)
+	go func() {
+		conn, err := listener.Accept()
+		if err != nil {
+			return
+		}
+		defer conn.Close()
+
+		// the messages are framed with octet counting.
+		reader := bufio.NewReader(conn)
+		length, err := reader.ReadString(' ')
+		if err != nil {
+			return
+		}
+
+		messageLength, err := strconv.Atoi(strings.TrimSpace(length))
+		if err != nil {
+			return
+		}
+
+		message := make([]byte, messageLength)
+		if _, err := io.ReadFull(reader, message); err != nil {
+			return
+		}
+
+		received <- string(message)
+	}()
+
+	sink, err := NewSyslogSink("tcp", listener.Addr().String(), "iota-core")
+	require.NoError(t, err)
+	defer sink.Close()
+
+	require.NoError(t, sink.Send(context.Background(), []*Event{
+		NewEvent("validatorEquivocated", SeverityError, "validator equivocated", "issuerID", "0x01"),
+	}))
+
+	message := <-received
+	require.True(t, strings.HasPrefix(message, "<27>1 "), message)
+	require.Contains(t, message, " iota-core ")
+	require.Contains(t, message, ` validatorEquivocated - validator equivocated issuerID="0x01"`)
+}
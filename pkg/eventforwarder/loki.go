@@ -0,0 +1,78 @@
+package eventforwarder
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strconv"
+
+	"github.com/iotaledger/hive.go/ierrors"
+)
+
+// LokiSink pushes the events to the push API of a Loki instance.
+type LokiSink struct {
+	url    string
+	labels map[string]string
+	client *http.Client
+}
+
+// lokiPushRequest is the body of a request to the push API of Loki.
+type lokiPushRequest struct {
+	Streams []*lokiStream `json:"streams"`
+}
+
+// lokiStream contains the log lines of a set of labels.
+type lokiStream struct {
+	Stream map[string]string `json:"stream"`
+	Values [][2]string       `json:"values"`
+}
+
+// NewLokiSink creates a new LokiSink that pushes to the given URL (e.g. http://loki:3100/loki/api/v1/push) and
+// attaches the given labels to all streams.
+func NewLokiSink(url string, labels map[string]string, client *http.Client) *LokiSink {
+	return &LokiSink{
+		url:    url,
+		labels: labels,
+		client: client,
+	}
+}
+
+// Name returns the name of the sink.
+func (l *LokiSink) Name() string {
+	return "loki"
+}
+
+// Send delivers the given batch of events to the sink.
+func (l *LokiSink) Send(ctx context.Context, events []*Event) error {
+	// the severity is used as a label, so that the streams stay few while still being easy to filter.
+	streams := make(map[Severity]*lokiStream)
+	request := &lokiPushRequest{}
+	for _, event := range events {
+		stream, exists := streams[event.Severity]
+		if !exists {
+			stream = &lokiStream{Stream: l.streamLabels(event.Severity)}
+			streams[event.Severity] = stream
+			request.Streams = append(request.Streams, stream)
+		}
+
+		line, err := json.Marshal(event)
+		if err != nil {
+			return ierrors.Wrapf(err, "failed to encode event %s", event.Type)
+		}
+
+		stream.Values = append(stream.Values, [2]string{strconv.FormatInt(event.Time.UnixNano(), 10), string(line)})
+	}
+
+	return postJSON(ctx, l.client, l.url, request)
+}
+
+// streamLabels returns the labels of the stream that contains the events with the given severity.
+func (l *LokiSink) streamLabels(severity Severity) map[string]string {
+	labels := make(map[string]string, len(l.labels)+1)
+	for key, value := range l.labels {
+		labels[key] = value
+	}
+	labels["severity"] = string(severity)
+
+	return labels
+}
@@ -0,0 +1,49 @@
+package eventforwarder
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/iotaledger/hive.go/ierrors"
+)
+
+// Sink is an external system that events are forwarded to.
+type Sink interface {
+	// Name returns the name of the sink.
+	Name() string
+
+	// Send delivers the given batch of events to the sink.
+	Send(ctx context.Context, events []*Event) error
+}
+
+// postJSON sends the JSON encoding of the given body to the given URL.
+func postJSON(ctx context.Context, client *http.Client, url string, body any) error {
+	encodedBody, err := json.Marshal(body)
+	if err != nil {
+		return ierrors.Wrap(err, "failed to encode request body")
+	}
+
+	request, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(encodedBody))
+	if err != nil {
+		return ierrors.Wrap(err, "failed to create request")
+	}
+	request.Header.Set("Content-Type", "application/json")
+
+	response, err := client.Do(request)
+	if err != nil {
+		return ierrors.Wrapf(err, "failed to send request to %s", url)
+	}
+	defer response.Body.Close()
+
+	// drain the body so that the connection can be reused.
+	_, _ = io.Copy(io.Discard, response.Body)
+
+	if response.StatusCode < http.StatusOK || response.StatusCode >= http.StatusMultipleChoices {
+		return ierrors.Errorf("request to %s failed with status %s", url, response.Status)
+	}
+
+	return nil
+}
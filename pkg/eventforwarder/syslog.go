@@ -0,0 +1,120 @@
+package eventforwarder
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"os"
+	"time"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/runtime/syncutils"
+)
+
+// syslogFacilityDaemon is the syslog facility of system daemons.
+const syslogFacilityDaemon = 3
+
+// syslogSeverities maps the severities of the events to the syslog severities.
+var syslogSeverities = map[Severity]int{
+	SeverityError:   3,
+	SeverityWarning: 4,
+	SeverityInfo:    6,
+}
+
+// SyslogSink sends the events as RFC 5424 messages to a syslog server.
+type SyslogSink struct {
+	network  string
+	address  string
+	tag      string
+	hostname string
+
+	// conn is the connection to the syslog server that is (re-)established on demand.
+	conn  net.Conn
+	mutex syncutils.Mutex
+}
+
+// NewSyslogSink creates a new SyslogSink that sends to the given address over the given network ("udp" or "tcp") and
+// uses the given tag as the app name of the messages.
+func NewSyslogSink(network string, address string, tag string) (*SyslogSink, error) {
+	if network != "udp" && network != "tcp" {
+		return nil, ierrors.Errorf("unsupported syslog network %q", network)
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		hostname = "-"
+	}
+
+	return &SyslogSink{
+		network:  network,
+		address:  address,
+		tag:      tag,
+		hostname: hostname,
+	}, nil
+}
+
+// Name returns the name of the sink.
+func (s *SyslogSink) Name() string {
+	return "syslog"
+}
+
+// Send delivers the given batch of events to the sink.
+func (s *SyslogSink) Send(ctx context.Context, events []*Event) error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.conn == nil {
+		conn, err := new(net.Dialer).DialContext(ctx, s.network, s.address)
+		if err != nil {
+			return ierrors.Wrapf(err, "failed to connect to syslog server %s", s.address)
+		}
+		s.conn = conn
+	}
+
+	if deadline, hasDeadline := ctx.Deadline(); hasDeadline {
+		_ = s.conn.SetWriteDeadline(deadline)
+	} else {
+		_ = s.conn.SetWriteDeadline(time.Time{})
+	}
+
+	for _, event := range events {
+		if _, err := s.conn.Write(s.formatMessage(event)); err != nil {
+			// the connection is re-established with the next batch.
+			_ = s.conn.Close()
+			s.conn = nil
+
+			return ierrors.Wrapf(err, "failed to send event to syslog server %s", s.address)
+		}
+	}
+
+	return nil
+}
+
+// Close closes the connection to the syslog server.
+func (s *SyslogSink) Close() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if s.conn == nil {
+		return nil
+	}
+
+	defer func() { s.conn = nil }()
+
+	return s.conn.Close()
+}
+
+// formatMessage returns the RFC 5424 message of the given event (framed with octet counting for TCP, RFC 6587).
+func (s *SyslogSink) formatMessage(event *Event) []byte {
+	severity, exists := syslogSeverities[event.Severity]
+	if !exists {
+		severity = syslogSeverities[SeverityInfo]
+	}
+
+	message := fmt.Sprintf("<%d>1 %s %s %s %d %s - %s", syslogFacilityDaemon*8+severity, event.Time.UTC().Format(time.RFC3339Nano), s.hostname, s.tag, os.Getpid(), event.Type, event.String())
+	if s.network == "tcp" {
+		message = fmt.Sprintf("%d %s", len(message), message)
+	}
+
+	return []byte(message)
+}
@@ -0,0 +1,35 @@
+package eventforwarder
+
+import (
+	"context"
+	"net/http"
+)
+
+// WebhookSink posts the events as JSON to an HTTP endpoint.
+type WebhookSink struct {
+	url    string
+	client *http.Client
+}
+
+// webhookRequest is the body of the requests of the WebhookSink.
+type webhookRequest struct {
+	Events []*Event `json:"events"`
+}
+
+// NewWebhookSink creates a new WebhookSink that posts to the given URL.
+func NewWebhookSink(url string, client *http.Client) *WebhookSink {
+	return &WebhookSink{
+		url:    url,
+		client: client,
+	}
+}
+
+// Name returns the name of the sink.
+func (w *WebhookSink) Name() string {
+	return "webhook"
+}
+
+// Send delivers the given batch of events to the sink.
+func (w *WebhookSink) Send(ctx context.Context, events []*Event) error {
+	return postJSON(ctx, w.client, w.url, &webhookRequest{Events: events})
+}
@@ -0,0 +1,337 @@
+package faucet
+
+import (
+	"context"
+	"crypto/ed25519"
+	"time"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/hive.go/runtime/syncutils"
+	"github.com/iotaledger/iota-core/pkg/blockfactory"
+	"github.com/iotaledger/iota-core/pkg/blockhandler"
+	"github.com/iotaledger/iota-core/pkg/protocol"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/mempool"
+	"github.com/iotaledger/iota-core/pkg/txbuilder"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+var (
+	// ErrInvalidRequest is returned if a request is malformed.
+	ErrInvalidRequest = ierrors.New("invalid faucet request")
+	// ErrRequestPending is returned if the address of a request already has a queued request.
+	ErrRequestPending = ierrors.New("faucet request already pending")
+	// ErrAddressQuotaExceeded is returned if the address of a request exceeded its quota.
+	ErrAddressQuotaExceeded = ierrors.New("address quota exceeded")
+	// ErrIPQuotaExceeded is returned if the IP of a request exceeded its quota.
+	ErrIPQuotaExceeded = ierrors.New("IP quota exceeded")
+)
+
+// Faucet dispenses base tokens and mana allotments to the requesters of a persistent queue by issuing blocks through the
+// block issuance pipeline of the node.
+type Faucet struct {
+	// protocol is the protocol instance whose main engine is used to issue the blocks.
+	protocol *protocol.Protocol
+
+	// blockHandler attaches the issued blocks.
+	blockHandler *blockhandler.BlockHandler
+
+	// blockFactory creates the issued blocks from pre-built skeletons.
+	blockFactory *blockfactory.Factory
+
+	// txBuilder constructs the transactions that dispense the funds.
+	txBuilder *txbuilder.Builder
+
+	// privateKey is the key of the faucet address and of the block issuer account.
+	privateKey ed25519.PrivateKey
+
+	// address is the address that holds the funds of the faucet.
+	address *iotago.Ed25519Address
+
+	// signer signs the transactions of the faucet.
+	signer iotago.AddressSigner
+
+	// issuerAccountID is the account that issues the blocks of the faucet.
+	issuerAccountID iotago.AccountID
+
+	// queue contains the requests that were not dispensed yet.
+	queue *requestQueue
+
+	// addressQuota limits the requests per address.
+	addressQuota *quotaTracker
+
+	// ipQuota limits the requests per IP.
+	ipQuota *quotaTracker
+
+	// enqueueMutex makes the checks and the enqueuing of a request atomic.
+	enqueueMutex syncutils.Mutex
+
+	optsBaseTokenAmount    iotago.BaseToken
+	optsManaAmount         iotago.Mana
+	optsMaxAddressRequests int
+	optsMaxIPRequests      int
+	optsQuotaWindow        time.Duration
+	optsBatchSize          int
+	optsIssuanceInterval   time.Duration
+	optsAcceptanceTimeout  time.Duration
+}
+
+// New creates a new Faucet that stores its queue in the given store.
+func New(p *protocol.Protocol, blockHandler *blockhandler.BlockHandler, blockFactory *blockfactory.Factory, privateKey ed25519.PrivateKey, issuerAccountID iotago.AccountID, store kvstore.KVStore, opts ...options.Option[Faucet]) (*Faucet, error) {
+	address := iotago.Ed25519AddressFromPubKey(privateKey.Public().(ed25519.PublicKey))
+
+	f := options.Apply(&Faucet{
+		protocol:     p,
+		blockHandler: blockHandler,
+		blockFactory: blockFactory,
+		txBuilder: txbuilder.New(func() *engine.Engine {
+			return p.Engines.Main.Get()
+		}),
+		privateKey:             privateKey,
+		address:                address,
+		signer:                 iotago.NewInMemoryAddressSigner(iotago.NewAddressKeysForEd25519Address(address, privateKey)),
+		issuerAccountID:        issuerAccountID,
+		optsBaseTokenAmount:    1_000_000_000,
+		optsManaAmount:         0,
+		optsMaxAddressRequests: 1,
+		optsMaxIPRequests:      10,
+		optsQuotaWindow:        24 * time.Hour,
+		optsBatchSize:          50,
+		optsIssuanceInterval:   5 * time.Second,
+		optsAcceptanceTimeout:  time.Minute,
+	}, opts)
+
+	if f.optsBatchSize <= 0 || f.optsBatchSize >= iotago.MaxOutputsCount {
+		return nil, ierrors.Errorf("batch size must be between 1 and %d", iotago.MaxOutputsCount-1)
+	}
+
+	queue, err := newRequestQueue(store)
+	if err != nil {
+		return nil, err
+	}
+
+	f.queue = queue
+	f.addressQuota = newQuotaTracker(f.optsMaxAddressRequests, f.optsQuotaWindow)
+	f.ipQuota = newQuotaTracker(f.optsMaxIPRequests, f.optsQuotaWindow)
+
+	return f, nil
+}
+
+// Address returns the address that holds the funds of the faucet.
+func (f *Faucet) Address() *iotago.Ed25519Address {
+	return f.address
+}
+
+// BaseTokenAmount returns the amount of base tokens that is dispensed per request.
+func (f *Faucet) BaseTokenAmount() iotago.BaseToken {
+	return f.optsBaseTokenAmount
+}
+
+// ManaAmount returns the amount of mana that is allotted per request that contains an account.
+func (f *Faucet) ManaAmount() iotago.Mana {
+	return f.optsManaAmount
+}
+
+// QueueSize returns the number of requests that were not dispensed yet.
+func (f *Faucet) QueueSize() int {
+	return f.queue.Size()
+}
+
+// Enqueue checks the quotas of the given request and adds it to the queue.
+func (f *Faucet) Enqueue(request *Request) error {
+	if request.Address == nil {
+		return ierrors.Wrap(ErrInvalidRequest, "address is missing")
+	}
+
+	if _, isChainAddress := request.Address.(iotago.ChainAddress); isChainAddress {
+		return ierrors.Wrapf(ErrInvalidRequest, "address %s of type %s is not supported", request.Address, request.Address.Type())
+	}
+
+	f.enqueueMutex.Lock()
+	defer f.enqueueMutex.Unlock()
+
+	if request.Time.IsZero() {
+		request.Time = time.Now()
+	}
+
+	addressKey := request.Address.Key()
+	if f.queue.Contains(addressKey) {
+		return ierrors.Wrapf(ErrRequestPending, "address %s", request.Address)
+	}
+
+	if !f.addressQuota.Allowed(addressKey, request.Time) {
+		return ierrors.Wrapf(ErrAddressQuotaExceeded, "address %s", request.Address)
+	}
+
+	if !f.ipQuota.Allowed(request.IP, request.Time) {
+		return ierrors.Wrapf(ErrIPQuotaExceeded, "IP %s", request.IP)
+	}
+
+	if err := f.queue.Push(request); err != nil {
+		return ierrors.Wrapf(err, "failed to enqueue request of address %s", request.Address)
+	}
+
+	f.addressQuota.Record(addressKey, request.Time)
+	f.ipQuota.Record(request.IP, request.Time)
+
+	return nil
+}
+
+// Run dispenses the queued requests in batches until the given context is done. Batches that can not be dispensed are
+// kept in the queue and retried after the issuance interval.
+func (f *Faucet) Run(ctx context.Context, errorHandler func(error)) {
+	ticker := time.NewTicker(f.optsIssuanceInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if err := f.DispenseBatch(ctx); err != nil && !ierrors.Is(err, context.Canceled) {
+				errorHandler(err)
+			}
+		}
+	}
+}
+
+// DispenseBatch dispenses the oldest queued requests in a single transaction and removes them from the queue once the
+// transaction was accepted.
+func (f *Faucet) DispenseBatch(ctx context.Context) error {
+	sequenceNumbers, requests, err := f.queue.Peek(f.optsBatchSize)
+	if err != nil {
+		return err
+	} else if len(requests) == 0 {
+		return nil
+	}
+
+	signedTransaction, err := f.createTransaction(requests)
+	if err != nil {
+		return ierrors.Wrap(err, "failed to create faucet transaction")
+	}
+
+	transactionID, err := signedTransaction.Transaction.ID()
+	if err != nil {
+		return ierrors.Wrap(err, "failed to compute faucet transaction ID")
+	}
+
+	block, err := f.blockFactory.CreateBlock(signedTransaction, f.issuerAccountID, f.privateKey)
+	if err != nil {
+		return ierrors.Wrapf(err, "failed to create block for faucet transaction %s", transactionID)
+	}
+
+	accepted := f.protocol.Engines.Main.Get().Ledger.MemPool().AwaitTransactionState(transactionID, mempool.TransactionStateAccepted, f.optsAcceptanceTimeout)
+
+	if _, err := f.blockHandler.AttachBlock(ctx, block); err != nil {
+		return ierrors.Wrapf(err, "failed to attach block for faucet transaction %s", transactionID)
+	}
+
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case err := <-accepted:
+		if err != nil {
+			return ierrors.Wrapf(err, "faucet transaction %s was not accepted", transactionID)
+		}
+	}
+
+	return f.queue.Remove(sequenceNumbers...)
+}
+
+// createTransaction creates the signed transaction that dispenses the funds of the given requests.
+func (f *Faucet) createTransaction(requests []*Request) (*iotago.SignedTransaction, error) {
+	intent := &txbuilder.SendManyIntent{
+		SenderAddress: f.address,
+		Transfers:     make([]*txbuilder.Transfer, 0, len(requests)),
+	}
+
+	allotmentIndexes := make(map[iotago.AccountID]int)
+	for _, request := range requests {
+		intent.Transfers = append(intent.Transfers, &txbuilder.Transfer{
+			ReceiverAddress: request.Address,
+			Amount:          f.optsBaseTokenAmount,
+		})
+
+		if f.optsManaAmount == 0 || request.AccountID.Empty() {
+			continue
+		}
+
+		// every account can only be contained once in the allotments of a transaction.
+		if index, exists := allotmentIndexes[request.AccountID]; exists {
+			intent.Allotments[index].Mana += f.optsManaAmount
+
+			continue
+		}
+
+		allotmentIndexes[request.AccountID] = len(intent.Allotments)
+		intent.Allotments = append(intent.Allotments, &iotago.Allotment{
+			AccountID: request.AccountID,
+			Mana:      f.optsManaAmount,
+		})
+	}
+
+	unsignedTransaction, err := f.txBuilder.SendMany(intent)
+	if err != nil {
+		return nil, err
+	}
+
+	return unsignedTransaction.Sign(f.signer)
+}
+
+// WithBaseTokenAmount sets the amount of base tokens that is dispensed per request.
+func WithBaseTokenAmount(baseTokenAmount iotago.BaseToken) options.Option[Faucet] {
+	return func(f *Faucet) {
+		f.optsBaseTokenAmount = baseTokenAmount
+	}
+}
+
+// WithManaAmount sets the amount of mana that is allotted per request that contains an account.
+func WithManaAmount(manaAmount iotago.Mana) options.Option[Faucet] {
+	return func(f *Faucet) {
+		f.optsManaAmount = manaAmount
+	}
+}
+
+// WithMaxAddressRequests sets the maximum number of requests per address within the quota window (0 disables the quota).
+func WithMaxAddressRequests(maxAddressRequests int) options.Option[Faucet] {
+	return func(f *Faucet) {
+		f.optsMaxAddressRequests = maxAddressRequests
+	}
+}
+
+// WithMaxIPRequests sets the maximum number of requests per IP within the quota window (0 disables the quota).
+func WithMaxIPRequests(maxIPRequests int) options.Option[Faucet] {
+	return func(f *Faucet) {
+		f.optsMaxIPRequests = maxIPRequests
+	}
+}
+
+// WithQuotaWindow sets the duration of the sliding window of the quotas.
+func WithQuotaWindow(quotaWindow time.Duration) options.Option[Faucet] {
+	return func(f *Faucet) {
+		f.optsQuotaWindow = quotaWindow
+	}
+}
+
+// WithBatchSize sets the maximum number of requests that are dispensed in a single transaction.
+func WithBatchSize(batchSize int) options.Option[Faucet] {
+	return func(f *Faucet) {
+		f.optsBatchSize = batchSize
+	}
+}
+
+// WithIssuanceInterval sets the interval in which batches are dispensed.
+func WithIssuanceInterval(issuanceInterval time.Duration) options.Option[Faucet] {
+	return func(f *Faucet) {
+		f.optsIssuanceInterval = issuanceInterval
+	}
+}
+
+// WithAcceptanceTimeout sets the duration after which a batch that was not accepted is retried.
+func WithAcceptanceTimeout(acceptanceTimeout time.Duration) options.Option[Faucet] {
+	return func(f *Faucet) {
+		f.optsAcceptanceTimeout = acceptanceTimeout
+	}
+}
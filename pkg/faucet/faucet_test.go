@@ -0,0 +1,117 @@
+package faucet
+
+import (
+	"crypto/ed25519"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/iotaledger/hive.go/runtime/options"
+	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/tpkg"
+)
+
+func newTestFaucet(t *testing.T, store kvstore.KVStore, opts ...options.Option[Faucet]) *Faucet {
+	_, privateKey, err := ed25519.GenerateKey(nil)
+	require.NoError(t, err)
+
+	f, err := New(nil, nil, nil, privateKey, tpkg.RandAccountID(), store, opts...)
+	require.NoError(t, err)
+
+	return f
+}
+
+func TestFaucet_QueuePersistence(t *testing.T) {
+	store := mapdb.NewMapDB()
+
+	requests := []*Request{
+		{Address: tpkg.RandEd25519Address(), IP: "10.0.0.1"},
+		{Address: tpkg.RandEd25519Address(), AccountID: tpkg.RandAccountID(), IP: "10.0.0.2"},
+		{Address: tpkg.RandEd25519Address(), IP: "10.0.0.3"},
+	}
+
+	f := newTestFaucet(t, store)
+	for _, request := range requests {
+		require.NoError(t, f.Enqueue(request))
+	}
+	require.Equal(t, 3, f.QueueSize())
+
+	// a new faucet on the same store restores the queue in the original order.
+	restoredFaucet := newTestFaucet(t, store, WithBatchSize(2))
+	require.Equal(t, 3, restoredFaucet.QueueSize())
+
+	sequenceNumbers, batch, err := restoredFaucet.queue.Peek(restoredFaucet.optsBatchSize)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{0, 1}, sequenceNumbers)
+	require.Len(t, batch, 2)
+
+	for i, request := range batch {
+		require.True(t, requests[i].Address.Equal(request.Address))
+		require.Equal(t, requests[i].AccountID, request.AccountID)
+		require.Equal(t, requests[i].IP, request.IP)
+		require.True(t, requests[i].Time.Equal(request.Time))
+	}
+
+	// pending addresses are known after the restore.
+	require.ErrorIs(t, restoredFaucet.Enqueue(&Request{Address: requests[0].Address, IP: "10.0.0.4"}), ErrRequestPending)
+
+	require.NoError(t, restoredFaucet.queue.Remove(sequenceNumbers...))
+	require.Equal(t, 1, restoredFaucet.QueueSize())
+
+	// new requests continue the sequence of the restored queue.
+	require.NoError(t, restoredFaucet.Enqueue(&Request{Address: tpkg.RandEd25519Address(), IP: "10.0.0.5"}))
+
+	sequenceNumbers, _, err = restoredFaucet.queue.Peek(restoredFaucet.optsBatchSize)
+	require.NoError(t, err)
+	require.Equal(t, []uint64{2, 3}, sequenceNumbers)
+}
+
+func TestFaucet_Quotas(t *testing.T) {
+	f := newTestFaucet(t, mapdb.NewMapDB(), WithMaxAddressRequests(1), WithMaxIPRequests(2), WithQuotaWindow(time.Hour))
+
+	now := time.Now()
+	address := tpkg.RandEd25519Address()
+
+	require.NoError(t, f.Enqueue(&Request{Address: address, IP: "10.0.0.1", Time: now}))
+
+	sequenceNumbers, _, err := f.queue.Peek(1)
+	require.NoError(t, err)
+	require.NoError(t, f.queue.Remove(sequenceNumbers...))
+
+	// the address quota is still exceeded after the request was dispensed.
+	require.ErrorIs(t, f.Enqueue(&Request{Address: address, IP: "10.0.0.2", Time: now.Add(time.Minute)}), ErrAddressQuotaExceeded)
+
+	require.NoError(t, f.Enqueue(&Request{Address: tpkg.RandEd25519Address(), IP: "10.0.0.1", Time: now.Add(time.Minute)}))
+	require.ErrorIs(t, f.Enqueue(&Request{Address: tpkg.RandEd25519Address(), IP: "10.0.0.1", Time: now.Add(2 * time.Minute)}), ErrIPQuotaExceeded)
+
+	// the quotas are reset once the window has passed.
+	require.NoError(t, f.Enqueue(&Request{Address: address, IP: "10.0.0.1", Time: now.Add(time.Hour + time.Minute)}))
+
+	// chain addresses can not be funded.
+	require.ErrorIs(t, f.Enqueue(&Request{Address: tpkg.RandAccountAddress(), IP: "10.0.0.3"}), ErrInvalidRequest)
+	require.ErrorIs(t, f.Enqueue(&Request{IP: "10.0.0.3"}), ErrInvalidRequest)
+}
+
+func TestRequest_Bytes(t *testing.T) {
+	request := &Request{
+		Address:   tpkg.RandEd25519Address(),
+		AccountID: tpkg.RandAccountID(),
+		IP:        "2001:db8::1",
+		Time:      time.Now(),
+	}
+
+	requestBytes, err := request.Bytes()
+	require.NoError(t, err)
+
+	parsedRequest, consumedBytes, err := RequestFromBytes(requestBytes)
+	require.NoError(t, err)
+	require.Equal(t, len(requestBytes), consumedBytes)
+	require.Equal(t, iotago.AddressEd25519, parsedRequest.Address.Type())
+	require.True(t, request.Address.Equal(parsedRequest.Address))
+	require.Equal(t, request.AccountID, parsedRequest.AccountID)
+	require.Equal(t, request.IP, parsedRequest.IP)
+	require.True(t, request.Time.Equal(parsedRequest.Time))
+}
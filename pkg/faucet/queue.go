@@ -0,0 +1,120 @@
+package faucet
+
+import (
+	"github.com/iotaledger/hive.go/ds/shrinkingmap"
+	"github.com/iotaledger/hive.go/ds/types"
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/runtime/syncutils"
+)
+
+// requestQueue is a persistent FIFO queue of Requests.
+type requestQueue struct {
+	// store contains the queued requests keyed by their sequence number.
+	store *kvstore.TypedStore[uint64, *Request]
+
+	// nextSequenceNumber is the sequence number of the next enqueued request.
+	nextSequenceNumber uint64
+
+	// queuedAddresses contains the keys of the addresses that have a queued request.
+	queuedAddresses *shrinkingmap.ShrinkingMap[string, types.Empty]
+
+	mutex syncutils.RWMutex
+}
+
+// newRequestQueue creates a new requestQueue that restores the queued requests of the given store.
+func newRequestQueue(store kvstore.KVStore) (*requestQueue, error) {
+	q := &requestQueue{
+		store: kvstore.NewTypedStore[uint64, *Request](store,
+			sequenceNumberBytes,
+			sequenceNumberFromBytes,
+			(*Request).Bytes,
+			RequestFromBytes,
+		),
+		queuedAddresses: shrinkingmap.New[string, types.Empty](),
+	}
+
+	if err := q.store.Iterate(kvstore.EmptyPrefix, func(sequenceNumber uint64, request *Request) bool {
+		q.nextSequenceNumber = sequenceNumber + 1
+		q.queuedAddresses.Set(request.Address.Key(), types.Void)
+
+		return true
+	}); err != nil {
+		return nil, ierrors.Wrap(err, "failed to restore queued requests")
+	}
+
+	return q, nil
+}
+
+// Push appends the given request to the queue.
+func (q *requestQueue) Push(request *Request) error {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	if err := q.store.Set(q.nextSequenceNumber, request); err != nil {
+		return ierrors.Wrap(err, "failed to store request")
+	}
+
+	q.nextSequenceNumber++
+	q.queuedAddresses.Set(request.Address.Key(), types.Void)
+
+	return nil
+}
+
+// Peek returns up to maxCount of the oldest requests together with their sequence numbers.
+func (q *requestQueue) Peek(maxCount int) (sequenceNumbers []uint64, requests []*Request, err error) {
+	q.mutex.RLock()
+	defer q.mutex.RUnlock()
+
+	if err = q.store.Iterate(kvstore.EmptyPrefix, func(sequenceNumber uint64, request *Request) bool {
+		sequenceNumbers = append(sequenceNumbers, sequenceNumber)
+		requests = append(requests, request)
+
+		return len(requests) < maxCount
+	}); err != nil {
+		return nil, nil, ierrors.Wrap(err, "failed to read queued requests")
+	}
+
+	return sequenceNumbers, requests, nil
+}
+
+// Remove removes the requests with the given sequence numbers from the queue.
+func (q *requestQueue) Remove(sequenceNumbers ...uint64) error {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	for _, sequenceNumber := range sequenceNumbers {
+		request, err := q.store.Get(sequenceNumber)
+		if err != nil {
+			if ierrors.Is(err, kvstore.ErrKeyNotFound) {
+				continue
+			}
+
+			return ierrors.Wrapf(err, "failed to load request %d", sequenceNumber)
+		}
+
+		if err := q.store.Delete(sequenceNumber); err != nil {
+			return ierrors.Wrapf(err, "failed to delete request %d", sequenceNumber)
+		}
+
+		q.queuedAddresses.Delete(request.Address.Key())
+	}
+
+	return nil
+}
+
+// Contains returns true if the queue contains a request for the given address key.
+func (q *requestQueue) Contains(addressKey string) bool {
+	q.mutex.RLock()
+	defer q.mutex.RUnlock()
+
+	return q.queuedAddresses.Has(addressKey)
+}
+
+// Size returns the number of queued requests.
+func (q *requestQueue) Size() int {
+	q.mutex.RLock()
+	defer q.mutex.RUnlock()
+
+	return q.queuedAddresses.Size()
+}
@@ -0,0 +1,78 @@
+package faucet
+
+import (
+	"time"
+
+	"github.com/iotaledger/hive.go/ds/shrinkingmap"
+	"github.com/iotaledger/hive.go/runtime/syncutils"
+)
+
+// quotaTracker limits the number of requests per key within a sliding time window.
+type quotaTracker struct {
+	// maxRequests is the maximum number of requests per key within the window (0 disables the quota).
+	maxRequests int
+
+	// window is the duration of the sliding window.
+	window time.Duration
+
+	// requestTimes contains the times of the requests of every key within the window.
+	requestTimes *shrinkingmap.ShrinkingMap[string, []time.Time]
+
+	mutex syncutils.Mutex
+}
+
+// newQuotaTracker creates a new quotaTracker.
+func newQuotaTracker(maxRequests int, window time.Duration) *quotaTracker {
+	return &quotaTracker{
+		maxRequests:  maxRequests,
+		window:       window,
+		requestTimes: shrinkingmap.New[string, []time.Time](),
+	}
+}
+
+// Allowed returns true if another request of the given key is allowed at the given time.
+func (q *quotaTracker) Allowed(key string, now time.Time) bool {
+	if q.maxRequests == 0 {
+		return true
+	}
+
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	return len(q.prune(key, now)) < q.maxRequests
+}
+
+// Record records a request of the given key at the given time.
+func (q *quotaTracker) Record(key string, now time.Time) {
+	if q.maxRequests == 0 {
+		return
+	}
+
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	q.requestTimes.Set(key, append(q.prune(key, now), now))
+}
+
+// prune removes the request times of the given key that are outside the window and returns the remaining ones.
+func (q *quotaTracker) prune(key string, now time.Time) []time.Time {
+	requestTimes, exists := q.requestTimes.Get(key)
+	if !exists {
+		return nil
+	}
+
+	windowStart := now.Add(-q.window)
+	for len(requestTimes) > 0 && !requestTimes[0].After(windowStart) {
+		requestTimes = requestTimes[1:]
+	}
+
+	if len(requestTimes) == 0 {
+		q.requestTimes.Delete(key)
+
+		return nil
+	}
+
+	q.requestTimes.Set(key, requestTimes)
+
+	return requestTimes
+}
@@ -0,0 +1,101 @@
+package faucet
+
+import (
+	"encoding/binary"
+	"io"
+	"time"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/serializer/v2"
+	"github.com/iotaledger/hive.go/serializer/v2/stream"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// Request is a request for funds that is queued by the Faucet.
+type Request struct {
+	// Address is the address that receives the base tokens.
+	Address iotago.Address
+
+	// AccountID is the account that receives the mana allotment (empty if no mana is requested).
+	AccountID iotago.AccountID
+
+	// IP is the IP address of the requester.
+	IP string
+
+	// Time is the time at which the request was enqueued.
+	Time time.Time
+}
+
+// RequestFromReader reads a Request from the given reader.
+func RequestFromReader(reader io.ReadSeeker) (*Request, error) {
+	r := new(Request)
+
+	var err error
+	if r.Address, err = stream.ReadObjectFromReader(reader, iotago.AddressFromReader); err != nil {
+		return nil, ierrors.Wrap(err, "failed to read Address")
+	}
+	if r.AccountID, err = stream.ReadObject(reader, iotago.AccountIDLength, iotago.AccountIDFromBytes); err != nil {
+		return nil, ierrors.Wrap(err, "failed to read AccountID")
+	}
+
+	ipBytes, err := stream.ReadBytesWithSize(reader, serializer.SeriLengthPrefixTypeAsByte)
+	if err != nil {
+		return nil, ierrors.Wrap(err, "failed to read IP")
+	}
+	r.IP = string(ipBytes)
+
+	unixNano, err := stream.Read[int64](reader)
+	if err != nil {
+		return nil, ierrors.Wrap(err, "failed to read Time")
+	}
+	r.Time = time.Unix(0, unixNano)
+
+	return r, nil
+}
+
+// RequestFromBytes parses a Request from the given bytes.
+func RequestFromBytes(bytes []byte) (*Request, int, error) {
+	byteReader := stream.NewByteReader(bytes)
+
+	r, err := RequestFromReader(byteReader)
+	if err != nil {
+		return nil, 0, ierrors.Wrap(err, "failed to parse Request")
+	}
+
+	return r, byteReader.BytesRead(), nil
+}
+
+// Bytes returns the serialized form of the Request.
+func (r *Request) Bytes() ([]byte, error) {
+	byteBuffer := stream.NewByteBuffer()
+
+	if err := stream.WriteBytes(byteBuffer, r.Address.ID()); err != nil {
+		return nil, ierrors.Wrap(err, "failed to write Address")
+	}
+	if err := stream.WriteBytes(byteBuffer, r.AccountID[:]); err != nil {
+		return nil, ierrors.Wrap(err, "failed to write AccountID")
+	}
+	if err := stream.WriteBytesWithSize(byteBuffer, []byte(r.IP), serializer.SeriLengthPrefixTypeAsByte); err != nil {
+		return nil, ierrors.Wrap(err, "failed to write IP")
+	}
+	if err := stream.Write(byteBuffer, r.Time.UnixNano()); err != nil {
+		return nil, ierrors.Wrap(err, "failed to write Time")
+	}
+
+	return byteBuffer.Bytes()
+}
+
+// sequenceNumberBytes returns the big endian representation of the given sequence number, so that the queued requests
+// are iterated in the order in which they were enqueued.
+func sequenceNumberBytes(sequenceNumber uint64) ([]byte, error) {
+	return binary.BigEndian.AppendUint64(nil, sequenceNumber), nil
+}
+
+// sequenceNumberFromBytes parses a sequence number from the given bytes.
+func sequenceNumberFromBytes(bytes []byte) (uint64, int, error) {
+	if len(bytes) < serializer.UInt64ByteSize {
+		return 0, 0, ierrors.Errorf("not enough bytes to parse sequence number: %d", len(bytes))
+	}
+
+	return binary.BigEndian.Uint64(bytes), serializer.UInt64ByteSize, nil
+}
@@ -0,0 +1,128 @@
+package loglevels
+
+import (
+	"sort"
+	"strings"
+
+	"github.com/iotaledger/hive.go/ds"
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/log"
+	"github.com/iotaledger/hive.go/runtime/syncutils"
+)
+
+// ErrUnknownModule is returned if the log level of a module is requested that was not registered.
+var ErrUnknownModule = ierrors.New("unknown module")
+
+// Registry keeps track of the loggers of the modules whose log level can be adjusted at runtime.
+type Registry struct {
+	modules map[string]*moduleLoggers
+	mutex   syncutils.RWMutex
+}
+
+// moduleLoggers contains the loggers that are registered for a module.
+type moduleLoggers struct {
+	// loggers contains the currently registered loggers of the module (e.g. one per engine instance).
+	loggers ds.Set[log.Logger]
+
+	// level contains the log level that was set at runtime (nil if it was never set).
+	level *log.Level
+}
+
+// NewRegistry creates a new Registry.
+func NewRegistry() *Registry {
+	return &Registry{
+		modules: make(map[string]*moduleLoggers),
+	}
+}
+
+// Register registers a logger of the given module (module names are case-insensitive) and returns a function that
+// removes it again. A module can have multiple loggers, and loggers that are registered after the log level of their
+// module was set at runtime adopt that log level.
+func (r *Registry) Register(module string, logger log.Logger) (unregister func()) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	moduleName := strings.ToLower(module)
+	entry, exists := r.modules[moduleName]
+	if !exists {
+		entry = &moduleLoggers{loggers: ds.NewSet[log.Logger]()}
+		r.modules[moduleName] = entry
+	}
+
+	if entry.level != nil {
+		logger.SetLogLevel(*entry.level)
+	}
+
+	entry.loggers.Add(logger)
+
+	return func() {
+		r.mutex.Lock()
+		defer r.mutex.Unlock()
+
+		entry.loggers.Delete(logger)
+	}
+}
+
+// RegisterModule registers the logger of the given module instance if the instance has its own logger (i.e. it
+// implements log.Logger) and returns a function that removes it again.
+func (r *Registry) RegisterModule(module string, instance any) (unregister func()) {
+	logger, hasLogger := instance.(log.Logger)
+	if !hasLogger {
+		return func() {}
+	}
+
+	return r.Register(module, logger)
+}
+
+// Modules returns the sorted names of all registered modules.
+func (r *Registry) Modules() []string {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	modules := make([]string, 0, len(r.modules))
+	for module := range r.modules {
+		modules = append(modules, module)
+	}
+	sort.Strings(modules)
+
+	return modules
+}
+
+// LogLevel returns the current log level of the given module.
+func (r *Registry) LogLevel(module string) (log.Level, error) {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	entry, exists := r.modules[strings.ToLower(module)]
+	if !exists {
+		return 0, ierrors.Wrapf(ErrUnknownModule, "module %s", module)
+	}
+
+	if entry.level != nil {
+		return *entry.level, nil
+	}
+
+	if logger, exists := entry.loggers.Any(); exists {
+		return logger.LogLevel(), nil
+	}
+
+	return log.LevelInfo, nil
+}
+
+// SetLogLevel sets the log level of all loggers of the given module (the level is inherited by their child loggers).
+func (r *Registry) SetLogLevel(module string, level log.Level) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	entry, exists := r.modules[strings.ToLower(module)]
+	if !exists {
+		return ierrors.Wrapf(ErrUnknownModule, "module %s", module)
+	}
+
+	entry.level = &level
+	entry.loggers.Range(func(logger log.Logger) {
+		logger.SetLogLevel(level)
+	})
+
+	return nil
+}
@@ -0,0 +1,77 @@
+package loglevels_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/log"
+	"github.com/iotaledger/iota-core/pkg/loglevels"
+)
+
+func TestRegistry(t *testing.T) {
+	rootLogger := log.NewLogger()
+	bookerLogger := rootLogger.NewChildLogger("Booker")
+	schedulerLogger := rootLogger.NewChildLogger("Scheduler")
+
+	registry := loglevels.NewRegistry()
+	registry.Register("Scheduler", schedulerLogger)
+	registry.Register("Booker", bookerLogger)
+
+	require.Equal(t, []string{"booker", "scheduler"}, registry.Modules())
+
+	require.NoError(t, registry.SetLogLevel("booker", log.LevelDebug))
+	require.Equal(t, log.LevelDebug, bookerLogger.LogLevel())
+	require.Equal(t, log.LevelInfo, schedulerLogger.LogLevel())
+
+	level, err := registry.LogLevel("BOOKER")
+	require.NoError(t, err)
+	require.Equal(t, log.LevelDebug, level)
+
+	require.True(t, ierrors.Is(registry.SetLogLevel("chainmanager", log.LevelTrace), loglevels.ErrUnknownModule))
+
+	_, err = registry.LogLevel("chainmanager")
+	require.True(t, ierrors.Is(err, loglevels.ErrUnknownModule))
+}
+
+func TestRegistry_MultipleLoggers(t *testing.T) {
+	rootLogger := log.NewLogger()
+	componentLogger := rootLogger.NewChildLogger("Booker")
+
+	registry := loglevels.NewRegistry()
+	registry.Register("Booker", componentLogger)
+
+	// the module logger of the first engine instance follows the log level of the module.
+	firstEngineLogger := rootLogger.NewChildLogger("Engine1").NewChildLogger("Booker")
+	unregisterFirstEngine := registry.RegisterModule("Booker", &moduleWithLogger{Logger: firstEngineLogger})
+
+	require.NoError(t, registry.SetLogLevel("Booker", log.LevelTrace))
+	require.Equal(t, log.LevelTrace, componentLogger.LogLevel())
+	require.Equal(t, log.LevelTrace, firstEngineLogger.LogLevel())
+
+	// loggers that are registered later adopt the log level that was set at runtime.
+	unregisterFirstEngine()
+	secondEngineLogger := rootLogger.NewChildLogger("Engine2").NewChildLogger("Booker")
+	registry.RegisterModule("Booker", &moduleWithLogger{Logger: secondEngineLogger})
+	require.Equal(t, log.LevelTrace, secondEngineLogger.LogLevel())
+
+	// unregistered loggers are no longer adjusted.
+	require.NoError(t, registry.SetLogLevel("Booker", log.LevelWarning))
+	require.Equal(t, log.LevelWarning, componentLogger.LogLevel())
+	require.Equal(t, log.LevelWarning, secondEngineLogger.LogLevel())
+	require.Equal(t, log.LevelTrace, firstEngineLogger.LogLevel())
+
+	level, err := registry.LogLevel("Booker")
+	require.NoError(t, err)
+	require.Equal(t, log.LevelWarning, level)
+
+	// module instances without their own logger are ignored.
+	registry.RegisterModule("Scheduler", struct{}{})
+	require.Equal(t, []string{"booker"}, registry.Modules())
+}
+
+// moduleWithLogger is a module instance that has its own logger.
+type moduleWithLogger struct {
+	log.Logger
+}
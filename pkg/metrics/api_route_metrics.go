@@ -0,0 +1,155 @@
+package metrics
+
+import (
+	"net/http"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/iotaledger/hive.go/runtime/event"
+)
+
+// APIRequest contains the details of a request that was handled by the REST API.
+type APIRequest struct {
+	// Method is the HTTP method of the request.
+	Method string
+	// Route is the route pattern that handled the request (not the requested path to keep the number of routes low).
+	Route string
+	// StatusCode is the HTTP status code of the response.
+	StatusCode int
+	// Duration is the time it took to handle the request.
+	Duration time.Duration
+}
+
+// IsClientError returns true if the request failed because of an error of the client.
+func (a *APIRequest) IsClientError() bool {
+	return a.StatusCode >= http.StatusBadRequest && a.StatusCode < http.StatusInternalServerError
+}
+
+// IsServerError returns true if the request failed because of an error of the server.
+func (a *APIRequest) IsServerError() bool {
+	return a.StatusCode >= http.StatusInternalServerError
+}
+
+// APIRouteStatistics contains the aggregated statistics of the requests of a single route.
+type APIRouteStatistics struct {
+	// Method is the HTTP method of the route.
+	Method string
+	// Route is the route pattern.
+	Route string
+	// Requests is the number of handled requests.
+	Requests uint64
+	// ClientErrors is the number of requests that failed because of an error of the client.
+	ClientErrors uint64
+	// ServerErrors is the number of requests that failed because of an error of the server.
+	ServerErrors uint64
+	// TotalDuration is the accumulated duration of all requests.
+	TotalDuration time.Duration
+	// MaxDuration is the duration of the slowest request.
+	MaxDuration time.Duration
+}
+
+// AverageDuration returns the average duration of the requests of the route.
+func (a *APIRouteStatistics) AverageDuration() time.Duration {
+	if a.Requests == 0 {
+		return 0
+	}
+
+	return a.TotalDuration / time.Duration(a.Requests)
+}
+
+// ErrorRate returns the share of requests that failed (because of the client or the server).
+func (a *APIRouteStatistics) ErrorRate() float64 {
+	if a.Requests == 0 {
+		return 0
+	}
+
+	return float64(a.ClientErrors+a.ServerErrors) / float64(a.Requests)
+}
+
+// APIRouteMetricsEvents contains the events of the APIRouteMetrics.
+type APIRouteMetricsEvents struct {
+	// RequestTracked is triggered when a request of the REST API was tracked.
+	RequestTracked *event.Event1[*APIRequest]
+}
+
+// APIRouteMetrics keeps track of the requests of the REST API to measure the number of requests, the latencies and
+// the error rates per route.
+type APIRouteMetrics struct {
+	// Events contains the events of the APIRouteMetrics.
+	Events *APIRouteMetricsEvents
+
+	// statisticsByRoute contains the aggregated statistics per method and route.
+	statisticsByRoute map[apiRouteKey]*APIRouteStatistics
+
+	mutex sync.RWMutex
+}
+
+// NewAPIRouteMetrics creates a new APIRouteMetrics instance.
+func NewAPIRouteMetrics() *APIRouteMetrics {
+	return &APIRouteMetrics{
+		Events: &APIRouteMetricsEvents{
+			RequestTracked: event.New1[*APIRequest](),
+		},
+		statisticsByRoute: make(map[apiRouteKey]*APIRouteStatistics),
+	}
+}
+
+// Track adds the given request to the statistics of its route.
+func (a *APIRouteMetrics) Track(request *APIRequest) {
+	func() {
+		a.mutex.Lock()
+		defer a.mutex.Unlock()
+
+		key := apiRouteKey{method: request.Method, route: request.Route}
+
+		statistics, exists := a.statisticsByRoute[key]
+		if !exists {
+			statistics = &APIRouteStatistics{Method: request.Method, Route: request.Route}
+			a.statisticsByRoute[key] = statistics
+		}
+
+		statistics.Requests++
+		statistics.TotalDuration += request.Duration
+
+		if request.Duration > statistics.MaxDuration {
+			statistics.MaxDuration = request.Duration
+		}
+
+		if request.IsClientError() {
+			statistics.ClientErrors++
+		} else if request.IsServerError() {
+			statistics.ServerErrors++
+		}
+	}()
+
+	a.Events.RequestTracked.Trigger(request)
+}
+
+// Routes returns a copy of the statistics of all routes that received requests ordered by their routes and methods.
+func (a *APIRouteMetrics) Routes() []*APIRouteStatistics {
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+
+	routes := make([]*APIRouteStatistics, 0, len(a.statisticsByRoute))
+	for _, statistics := range a.statisticsByRoute {
+		statisticsCopy := *statistics
+		routes = append(routes, &statisticsCopy)
+	}
+
+	sort.Slice(routes, func(i, j int) bool {
+		if routes[i].Route != routes[j].Route {
+			return routes[i].Route < routes[j].Route
+		}
+
+		return routes[i].Method < routes[j].Method
+	})
+
+	return routes
+}
+
+// apiRouteKey is the key that is used to aggregate the requests per method and route.
+type apiRouteKey struct {
+	method string
+	route  string
+}
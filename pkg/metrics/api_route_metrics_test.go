@@ -0,0 +1,62 @@
+package metrics_test
+
+import (
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/iota-core/pkg/metrics"
+)
+
+func TestAPIRouteMetrics(t *testing.T) {
+	apiRouteMetrics := metrics.NewAPIRouteMetrics()
+
+	var trackedRequests int
+	apiRouteMetrics.Events.RequestTracked.Hook(func(_ *metrics.APIRequest) {
+		trackedRequests++
+	})
+
+	require.Empty(t, apiRouteMetrics.Routes())
+
+	apiRouteMetrics.Track(&metrics.APIRequest{Method: http.MethodGet, Route: "/api/core/v3/info", StatusCode: http.StatusOK, Duration: 2 * time.Millisecond})
+	apiRouteMetrics.Track(&metrics.APIRequest{Method: http.MethodGet, Route: "/api/core/v3/blocks/:blockID", StatusCode: http.StatusOK, Duration: 4 * time.Millisecond})
+	apiRouteMetrics.Track(&metrics.APIRequest{Method: http.MethodGet, Route: "/api/core/v3/blocks/:blockID", StatusCode: http.StatusNotFound, Duration: 1 * time.Millisecond})
+	apiRouteMetrics.Track(&metrics.APIRequest{Method: http.MethodGet, Route: "/api/core/v3/blocks/:blockID", StatusCode: http.StatusInternalServerError, Duration: 10 * time.Millisecond})
+	apiRouteMetrics.Track(&metrics.APIRequest{Method: http.MethodPost, Route: "/api/core/v3/blocks", StatusCode: http.StatusBadRequest, Duration: 3 * time.Millisecond})
+
+	require.Equal(t, 5, trackedRequests)
+
+	routes := apiRouteMetrics.Routes()
+	require.Len(t, routes, 3)
+
+	require.Equal(t, &metrics.APIRouteStatistics{
+		Method:        http.MethodPost,
+		Route:         "/api/core/v3/blocks",
+		Requests:      1,
+		ClientErrors:  1,
+		TotalDuration: 3 * time.Millisecond,
+		MaxDuration:   3 * time.Millisecond,
+	}, routes[0])
+
+	require.Equal(t, &metrics.APIRouteStatistics{
+		Method:        http.MethodGet,
+		Route:         "/api/core/v3/blocks/:blockID",
+		Requests:      3,
+		ClientErrors:  1,
+		ServerErrors:  1,
+		TotalDuration: 15 * time.Millisecond,
+		MaxDuration:   10 * time.Millisecond,
+	}, routes[1])
+	require.Equal(t, 5*time.Millisecond, routes[1].AverageDuration())
+	require.InDelta(t, 2.0/3.0, routes[1].ErrorRate(), 0.001)
+
+	require.Equal(t, "/api/core/v3/info", routes[2].Route)
+	require.Zero(t, routes[2].ErrorRate())
+
+	// the returned statistics are copies that are not modified by later requests.
+	apiRouteMetrics.Track(&metrics.APIRequest{Method: http.MethodGet, Route: "/api/core/v3/info", StatusCode: http.StatusOK, Duration: time.Millisecond})
+	require.EqualValues(t, 1, routes[2].Requests)
+	require.EqualValues(t, 2, apiRouteMetrics.Routes()[2].Requests)
+}
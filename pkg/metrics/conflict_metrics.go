@@ -0,0 +1,119 @@
+package metrics
+
+import (
+	"sync"
+	"time"
+
+	"github.com/iotaledger/hive.go/ds/shrinkingmap"
+	"github.com/iotaledger/hive.go/runtime/event"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// ConflictMetricsEvents contains the events of the ConflictMetrics.
+type ConflictMetricsEvents struct {
+	// ConflictAccepted is triggered with the time to resolution when a tracked conflict was accepted.
+	ConflictAccepted *event.Event1[time.Duration]
+	// ConflictRejected is triggered with the time to resolution when a tracked conflict was rejected.
+	ConflictRejected *event.Event1[time.Duration]
+	// ConflictEvicted is triggered when a tracked conflict was evicted before it was resolved.
+	ConflictEvicted *event.Event
+}
+
+// ConflictMetrics keeps track of the conflicts of the SpendDAG to measure how many of them are open and how long it
+// takes to resolve them.
+type ConflictMetrics struct {
+	// Events contains the events of the ConflictMetrics.
+	Events *ConflictMetricsEvents
+
+	// openConflicts contains the creation times of the conflicts that were not resolved or evicted yet.
+	openConflicts *shrinkingmap.ShrinkingMap[iotago.TransactionID, time.Time]
+
+	// acceptedCount contains the number of conflicts that were accepted.
+	acceptedCount uint64
+
+	// rejectedCount contains the number of conflicts that were rejected.
+	rejectedCount uint64
+
+	mutex sync.RWMutex
+}
+
+// NewConflictMetrics creates a new ConflictMetrics instance.
+func NewConflictMetrics() *ConflictMetrics {
+	return &ConflictMetrics{
+		Events: &ConflictMetricsEvents{
+			ConflictAccepted: event.New1[time.Duration](),
+			ConflictRejected: event.New1[time.Duration](),
+			ConflictEvicted:  event.New(),
+		},
+		openConflicts: shrinkingmap.New[iotago.TransactionID, time.Time](),
+	}
+}
+
+// TrackCreated starts tracking the given conflict that was created at the given time.
+func (c *ConflictMetrics) TrackCreated(conflictID iotago.TransactionID, createdAt time.Time) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	c.openConflicts.GetOrCreate(conflictID, func() time.Time { return createdAt })
+}
+
+// TrackAccepted measures the time to resolution of the given conflict that was accepted at the given time.
+func (c *ConflictMetrics) TrackAccepted(conflictID iotago.TransactionID, acceptedAt time.Time) {
+	if timeToResolution, resolved := c.resolve(conflictID, acceptedAt, &c.acceptedCount); resolved {
+		c.Events.ConflictAccepted.Trigger(timeToResolution)
+	}
+}
+
+// TrackRejected measures the time to resolution of the given conflict that was rejected at the given time.
+func (c *ConflictMetrics) TrackRejected(conflictID iotago.TransactionID, rejectedAt time.Time) {
+	if timeToResolution, resolved := c.resolve(conflictID, rejectedAt, &c.rejectedCount); resolved {
+		c.Events.ConflictRejected.Trigger(timeToResolution)
+	}
+}
+
+// TrackEvicted stops tracking the given conflict because it was evicted from the SpendDAG.
+func (c *ConflictMetrics) TrackEvicted(conflictID iotago.TransactionID) {
+	if evicted := func() bool {
+		c.mutex.Lock()
+		defer c.mutex.Unlock()
+
+		return c.openConflicts.Delete(conflictID)
+	}(); evicted {
+		c.Events.ConflictEvicted.Trigger()
+	}
+}
+
+// OpenConflicts returns the number of conflicts that were neither resolved nor evicted yet.
+func (c *ConflictMetrics) OpenConflicts() int {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	return c.openConflicts.Size()
+}
+
+// RejectionRate returns the share of the resolved conflicts that were rejected.
+func (c *ConflictMetrics) RejectionRate() float64 {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	if resolvedCount := c.acceptedCount + c.rejectedCount; resolvedCount != 0 {
+		return float64(c.rejectedCount) / float64(resolvedCount)
+	}
+
+	return 0
+}
+
+// resolve stops tracking the given conflict, increases the given counter and returns the time to resolution.
+func (c *ConflictMetrics) resolve(conflictID iotago.TransactionID, resolvedAt time.Time, counter *uint64) (timeToResolution time.Duration, resolved bool) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	createdAt, exists := c.openConflicts.DeleteAndReturn(conflictID)
+	if !exists {
+		return 0, false
+	}
+
+	*counter++
+
+	return resolvedAt.Sub(createdAt), true
+}
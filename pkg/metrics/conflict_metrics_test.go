@@ -0,0 +1,53 @@
+package metrics_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/iota-core/pkg/metrics"
+	"github.com/iotaledger/iota.go/v4/tpkg"
+)
+
+func TestConflictMetrics(t *testing.T) {
+	conflictMetrics := metrics.NewConflictMetrics()
+
+	var acceptedTimes, rejectedTimes []time.Duration
+	var evictedCount int
+	conflictMetrics.Events.ConflictAccepted.Hook(func(timeToResolution time.Duration) {
+		acceptedTimes = append(acceptedTimes, timeToResolution)
+	})
+	conflictMetrics.Events.ConflictRejected.Hook(func(timeToResolution time.Duration) {
+		rejectedTimes = append(rejectedTimes, timeToResolution)
+	})
+	conflictMetrics.Events.ConflictEvicted.Hook(func() {
+		evictedCount++
+	})
+
+	createdAt := time.Now()
+	tx1, tx2, tx3, tx4 := tpkg.RandTransactionID(), tpkg.RandTransactionID(), tpkg.RandTransactionID(), tpkg.RandTransactionID()
+
+	conflictMetrics.TrackCreated(tx1, createdAt)
+	conflictMetrics.TrackCreated(tx2, createdAt)
+	conflictMetrics.TrackCreated(tx3, createdAt)
+	conflictMetrics.TrackCreated(tx4, createdAt)
+	require.Equal(t, 4, conflictMetrics.OpenConflicts())
+	require.Zero(t, conflictMetrics.RejectionRate())
+
+	conflictMetrics.TrackAccepted(tx1, createdAt.Add(2*time.Second))
+	conflictMetrics.TrackRejected(tx2, createdAt.Add(3*time.Second))
+	conflictMetrics.TrackRejected(tx3, createdAt.Add(4*time.Second))
+	require.Equal(t, 1, conflictMetrics.OpenConflicts())
+
+	// resolved conflicts are neither resolved nor evicted a second time.
+	conflictMetrics.TrackRejected(tx1, createdAt.Add(5*time.Second))
+	conflictMetrics.TrackEvicted(tx2)
+	conflictMetrics.TrackEvicted(tx4)
+
+	require.Equal(t, []time.Duration{2 * time.Second}, acceptedTimes)
+	require.Equal(t, []time.Duration{3 * time.Second, 4 * time.Second}, rejectedTimes)
+	require.Equal(t, 1, evictedCount)
+	require.Zero(t, conflictMetrics.OpenConflicts())
+	require.InDelta(t, 2.0/3.0, conflictMetrics.RejectionRate(), 0.001)
+}
@@ -0,0 +1,234 @@
+package metrics
+
+import (
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/iotaledger/hive.go/ds/shrinkingmap"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/hive.go/runtime/event"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// LatencyHistogramBuckets contains the upper bounds (in seconds) of the buckets that are used to aggregate the
+// transaction latencies.
+var LatencyHistogramBuckets = []float64{0.5, 1, 2, 3, 5, 7.5, 10, 15, 20, 30, 45, 60, 120}
+
+// LatencyHistogram is a histogram of latencies.
+type LatencyHistogram struct {
+	// Buckets contains the upper bounds (in seconds) of the buckets.
+	Buckets []float64 `json:"buckets"`
+	// Counts contains the number of observations that fall into the buckets (the last element counts the observations
+	// that exceed the highest bucket).
+	Counts []uint64 `json:"counts"`
+	// Count contains the total number of observations.
+	Count uint64 `json:"count"`
+	// Sum contains the sum of all observed latencies in seconds.
+	Sum float64 `json:"sum"`
+}
+
+// NewLatencyHistogram creates a new empty LatencyHistogram with the default buckets.
+func NewLatencyHistogram() *LatencyHistogram {
+	return &LatencyHistogram{
+		Buckets: LatencyHistogramBuckets,
+		Counts:  make([]uint64, len(LatencyHistogramBuckets)+1),
+	}
+}
+
+// Observe adds the given latency to the histogram.
+func (l *LatencyHistogram) Observe(latency time.Duration) {
+	seconds := latency.Seconds()
+
+	l.Counts[sort.SearchFloat64s(l.Buckets, seconds)]++
+	l.Count++
+	l.Sum += seconds
+}
+
+// clone returns a copy of the histogram.
+func (l *LatencyHistogram) clone() *LatencyHistogram {
+	return &LatencyHistogram{
+		Buckets: l.Buckets,
+		Counts:  append([]uint64(nil), l.Counts...),
+		Count:   l.Count,
+		Sum:     l.Sum,
+	}
+}
+
+// SlotTransactionLatencies contains the aggregated latencies of the transactions that were committed in a slot.
+type SlotTransactionLatencies struct {
+	// Slot contains the slot in which the transactions were committed.
+	Slot iotago.SlotIndex `json:"slot"`
+	// Acceptance contains the latencies from the attachment to the acceptance of the transactions.
+	Acceptance *LatencyHistogram `json:"acceptance"`
+	// Commitment contains the latencies from the attachment to the commitment of the transactions.
+	Commitment *LatencyHistogram `json:"commitment"`
+}
+
+// TransactionLatenciesEvents contains the events of the TransactionLatencies.
+type TransactionLatenciesEvents struct {
+	// AcceptanceLatencyMeasured is triggered when the acceptance latency of a transaction was measured.
+	AcceptanceLatencyMeasured *event.Event1[time.Duration]
+	// CommitmentLatencyMeasured is triggered when the commitment latency of a transaction was measured.
+	CommitmentLatencyMeasured *event.Event1[time.Duration]
+}
+
+// TransactionLatencies measures the time it takes for transactions to get accepted and committed after they were
+// attached and aggregates the measured latencies per slot.
+type TransactionLatencies struct {
+	// Events contains the events of the TransactionLatencies.
+	Events *TransactionLatenciesEvents
+
+	// pendingTransactions contains the transactions that were attached but not committed yet.
+	pendingTransactions *shrinkingmap.ShrinkingMap[iotago.TransactionID, *pendingTransaction]
+
+	// slotLatencies contains the aggregated latencies per slot.
+	slotLatencies *shrinkingmap.ShrinkingMap[iotago.SlotIndex, *SlotTransactionLatencies]
+
+	// retentionWindow contains the number of slots for which the aggregated latencies are kept after a slot got evicted.
+	retentionWindow iotago.SlotIndex
+
+	mutex sync.RWMutex
+}
+
+// pendingTransaction contains the timestamps of a transaction that was attached but not committed yet.
+type pendingTransaction struct {
+	attachedSlot iotago.SlotIndex
+	attachedAt   time.Time
+	acceptedAt   time.Time
+}
+
+// NewTransactionLatencies creates a new TransactionLatencies instance that keeps the aggregated latencies of the given
+// number of slots.
+func NewTransactionLatencies(retentionWindow iotago.SlotIndex) *TransactionLatencies {
+	return &TransactionLatencies{
+		Events: &TransactionLatenciesEvents{
+			AcceptanceLatencyMeasured: event.New1[time.Duration](),
+			CommitmentLatencyMeasured: event.New1[time.Duration](),
+		},
+		pendingTransactions: shrinkingmap.New[iotago.TransactionID, *pendingTransaction](),
+		slotLatencies:       shrinkingmap.New[iotago.SlotIndex, *SlotTransactionLatencies](),
+		retentionWindow:     retentionWindow,
+	}
+}
+
+// TrackAttached starts tracking the latencies of the given transaction that was attached in the given slot.
+func (t *TransactionLatencies) TrackAttached(transactionID iotago.TransactionID, slot iotago.SlotIndex, attachedAt time.Time) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.pendingTransactions.GetOrCreate(transactionID, func() *pendingTransaction {
+		return &pendingTransaction{
+			attachedSlot: slot,
+			attachedAt:   attachedAt,
+		}
+	})
+}
+
+// TrackAccepted measures the acceptance latency of the given transaction.
+func (t *TransactionLatencies) TrackAccepted(transactionID iotago.TransactionID, acceptedAt time.Time) {
+	latency, measured := func() (time.Duration, bool) {
+		t.mutex.Lock()
+		defer t.mutex.Unlock()
+
+		transaction, exists := t.pendingTransactions.Get(transactionID)
+		if !exists || !transaction.acceptedAt.IsZero() {
+			return 0, false
+		}
+
+		transaction.acceptedAt = acceptedAt
+
+		return acceptedAt.Sub(transaction.attachedAt), true
+	}()
+
+	if measured {
+		t.Events.AcceptanceLatencyMeasured.Trigger(latency)
+	}
+}
+
+// TrackCommitted measures the commitment latency of the given transaction and adds its latencies to the histograms of
+// the given slot.
+func (t *TransactionLatencies) TrackCommitted(transactionID iotago.TransactionID, slot iotago.SlotIndex, committedAt time.Time) {
+	latency, measured := func() (time.Duration, bool) {
+		t.mutex.Lock()
+		defer t.mutex.Unlock()
+
+		transaction, exists := t.pendingTransactions.DeleteAndReturn(transactionID)
+		if !exists {
+			return 0, false
+		}
+
+		slotLatencies := lo.Return1(t.slotLatencies.GetOrCreate(slot, func() *SlotTransactionLatencies {
+			return &SlotTransactionLatencies{
+				Slot:       slot,
+				Acceptance: NewLatencyHistogram(),
+				Commitment: NewLatencyHistogram(),
+			}
+		}))
+
+		if !transaction.acceptedAt.IsZero() {
+			slotLatencies.Acceptance.Observe(transaction.acceptedAt.Sub(transaction.attachedAt))
+		}
+
+		commitmentLatency := committedAt.Sub(transaction.attachedAt)
+		slotLatencies.Commitment.Observe(commitmentLatency)
+
+		return commitmentLatency, true
+	}()
+
+	if measured {
+		t.Events.CommitmentLatencyMeasured.Trigger(latency)
+	}
+}
+
+// StopTracking stops tracking the latencies of the given transaction (i.e. because it became invalid).
+func (t *TransactionLatencies) StopTracking(transactionID iotago.TransactionID) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.pendingTransactions.Delete(transactionID)
+}
+
+// SlotLatencies returns a copy of the aggregated latencies of the transactions that were committed in the given slot.
+func (t *TransactionLatencies) SlotLatencies(slot iotago.SlotIndex) (latencies *SlotTransactionLatencies, exists bool) {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	slotLatencies, exists := t.slotLatencies.Get(slot)
+	if !exists {
+		return nil, false
+	}
+
+	return &SlotTransactionLatencies{
+		Slot:       slotLatencies.Slot,
+		Acceptance: slotLatencies.Acceptance.clone(),
+		Commitment: slotLatencies.Commitment.clone(),
+	}, true
+}
+
+// Evict drops the pending transactions that were attached in or before the given slot and the aggregated latencies
+// that fell out of the retention window.
+func (t *TransactionLatencies) Evict(slot iotago.SlotIndex) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.pendingTransactions.ForEach(func(transactionID iotago.TransactionID, transaction *pendingTransaction) bool {
+		if transaction.attachedSlot <= slot {
+			t.pendingTransactions.Delete(transactionID)
+		}
+
+		return true
+	})
+
+	if slot < t.retentionWindow {
+		return
+	}
+
+	t.slotLatencies.ForEach(func(latencySlot iotago.SlotIndex, _ *SlotTransactionLatencies) bool {
+		if latencySlot <= slot-t.retentionWindow {
+			t.slotLatencies.Delete(latencySlot)
+		}
+
+		return true
+	})
+}
@@ -0,0 +1,60 @@
+package metrics_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/iota-core/pkg/metrics"
+	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/tpkg"
+)
+
+func TestTransactionLatencies(t *testing.T) {
+	latencies := metrics.NewTransactionLatencies(2)
+
+	var acceptanceLatencies, commitmentLatencies []time.Duration
+	latencies.Events.AcceptanceLatencyMeasured.Hook(func(latency time.Duration) {
+		acceptanceLatencies = append(acceptanceLatencies, latency)
+	})
+	latencies.Events.CommitmentLatencyMeasured.Hook(func(latency time.Duration) {
+		commitmentLatencies = append(commitmentLatencies, latency)
+	})
+
+	attachedAt := time.Now()
+	tx1, tx2, tx3 := tpkg.RandTransactionID(), tpkg.RandTransactionID(), tpkg.RandTransactionID()
+
+	latencies.TrackAttached(tx1, 1, attachedAt)
+	latencies.TrackAttached(tx2, 1, attachedAt)
+	latencies.TrackAttached(tx3, 2, attachedAt)
+
+	latencies.TrackAccepted(tx1, attachedAt.Add(800*time.Millisecond))
+	latencies.TrackAccepted(tx1, attachedAt.Add(5*time.Second))
+	latencies.TrackCommitted(tx1, 3, attachedAt.Add(12*time.Second))
+	latencies.TrackCommitted(tx2, 3, attachedAt.Add(200*time.Second))
+
+	require.Equal(t, []time.Duration{800 * time.Millisecond}, acceptanceLatencies)
+	require.Equal(t, []time.Duration{12 * time.Second, 200 * time.Second}, commitmentLatencies)
+
+	slotLatencies, exists := latencies.SlotLatencies(3)
+	require.True(t, exists)
+	require.Equal(t, iotago.SlotIndex(3), slotLatencies.Slot)
+	require.EqualValues(t, 1, slotLatencies.Acceptance.Count)
+	require.EqualValues(t, 1, slotLatencies.Acceptance.Counts[1])
+	require.EqualValues(t, 2, slotLatencies.Commitment.Count)
+	require.EqualValues(t, 1, slotLatencies.Commitment.Counts[7])
+	require.EqualValues(t, 1, slotLatencies.Commitment.Counts[len(metrics.LatencyHistogramBuckets)])
+	require.InDelta(t, 212, slotLatencies.Commitment.Sum, 0.001)
+
+	// evicting slot 2 drops the pending transaction that was attached in that slot.
+	latencies.Evict(2)
+	latencies.TrackCommitted(tx3, 4, attachedAt.Add(time.Second))
+	_, exists = latencies.SlotLatencies(4)
+	require.False(t, exists)
+
+	// evicting slot 5 drops the aggregated latencies of slot 3 (which fell out of the retention window).
+	latencies.Evict(5)
+	_, exists = latencies.SlotLatencies(3)
+	require.False(t, exists)
+}
@@ -0,0 +1,95 @@
+package model
+
+import (
+	"io"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/serializer/v2/stream"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// AccountsAggregates contains the aggregated statistics of the accounts ledger at a committed slot.
+type AccountsAggregates struct {
+	// AccountsCount is the number of accounts in the accounts ledger.
+	AccountsCount uint64
+
+	// ValidatorsCount is the number of accounts with a non-zero validator stake.
+	ValidatorsCount uint64
+
+	// ValidatorStake is the sum of the validator stake of all accounts.
+	ValidatorStake iotago.BaseToken
+
+	// DelegationStake is the sum of the stake that is delegated to all accounts.
+	DelegationStake iotago.BaseToken
+}
+
+// NewAccountsAggregates creates a new empty AccountsAggregates.
+func NewAccountsAggregates() *AccountsAggregates {
+	return new(AccountsAggregates)
+}
+
+// Clone returns a copy of the AccountsAggregates.
+func (a *AccountsAggregates) Clone() *AccountsAggregates {
+	return &AccountsAggregates{
+		AccountsCount:   a.AccountsCount,
+		ValidatorsCount: a.ValidatorsCount,
+		ValidatorStake:  a.ValidatorStake,
+		DelegationStake: a.DelegationStake,
+	}
+}
+
+func AccountsAggregatesFromBytes(bytes []byte) (*AccountsAggregates, int, error) {
+	byteReader := stream.NewByteReader(bytes)
+
+	a, err := AccountsAggregatesFromReader(byteReader)
+	if err != nil {
+		return nil, 0, ierrors.Wrap(err, "failed to parse AccountsAggregates")
+	}
+
+	return a, byteReader.BytesRead(), nil
+}
+
+func AccountsAggregatesFromReader(reader io.ReadSeeker) (*AccountsAggregates, error) {
+	var err error
+	a := NewAccountsAggregates()
+
+	if a.AccountsCount, err = stream.Read[uint64](reader); err != nil {
+		return nil, ierrors.Wrap(err, "failed to read accounts count")
+	}
+
+	if a.ValidatorsCount, err = stream.Read[uint64](reader); err != nil {
+		return nil, ierrors.Wrap(err, "failed to read validators count")
+	}
+
+	if a.ValidatorStake, err = stream.Read[iotago.BaseToken](reader); err != nil {
+		return nil, ierrors.Wrap(err, "failed to read validator stake")
+	}
+
+	if a.DelegationStake, err = stream.Read[iotago.BaseToken](reader); err != nil {
+		return nil, ierrors.Wrap(err, "failed to read delegation stake")
+	}
+
+	return a, nil
+}
+
+func (a *AccountsAggregates) Bytes() ([]byte, error) {
+	byteBuffer := stream.NewByteBuffer()
+
+	if err := stream.Write(byteBuffer, a.AccountsCount); err != nil {
+		return nil, ierrors.Wrap(err, "failed to write accounts count")
+	}
+
+	if err := stream.Write(byteBuffer, a.ValidatorsCount); err != nil {
+		return nil, ierrors.Wrap(err, "failed to write validators count")
+	}
+
+	if err := stream.Write(byteBuffer, a.ValidatorStake); err != nil {
+		return nil, ierrors.Wrap(err, "failed to write validator stake")
+	}
+
+	if err := stream.Write(byteBuffer, a.DelegationStake); err != nil {
+		return nil, ierrors.Wrap(err, "failed to write delegation stake")
+	}
+
+	return byteBuffer.Bytes()
+}
@@ -48,6 +48,16 @@ func BlockFromIDAndBytes(blockID iotago.BlockID, data []byte, api iotago.API, op
 	return newBlock(blockID, block, data)
 }
 
+// BlockFromIDAndStorageBytes decodes a block that was encoded with StorageBytes.
+func BlockFromIDAndStorageBytes(blockID iotago.BlockID, storedBytes []byte, api iotago.API, opts ...serix.Option) (*Block, error) {
+	data, err := blockStorageFormat.decode(storedBytes)
+	if err != nil {
+		return nil, err
+	}
+
+	return BlockFromIDAndBytes(blockID, data, api, opts...)
+}
+
 func BlockFromBytes(data []byte, apiProvider iotago.APIProvider) (*Block, error) {
 	iotaBlock, _, err := iotago.BlockFromBytes(apiProvider)(data)
 	if err != nil {
@@ -89,6 +99,11 @@ func (blk *Block) Bytes() ([]byte, error) {
 	return blk.data, nil
 }
 
+// StorageBytes returns the bytes of the block prefixed with the version of its storage format.
+func (blk *Block) StorageBytes() []byte {
+	return blockStorageFormat.encode(blk.data)
+}
+
 func (blk *Block) ProtocolBlock() *iotago.Block {
 	return blk.block
 }
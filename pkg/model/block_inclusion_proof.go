@@ -0,0 +1,206 @@
+package model
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"io"
+
+	"github.com/pokt-network/smt"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/hive.go/serializer/v2"
+	"github.com/iotaledger/hive.go/serializer/v2/stream"
+	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/merklehasher"
+)
+
+var (
+	// ErrBlockNotIncluded is returned when a block is not part of the accepted blocks of a slot.
+	ErrBlockNotIncluded = ierrors.New("block is not included in the accepted blocks of the slot")
+
+	// ErrInvalidBlockInclusionProof is returned when a BlockInclusionProof does not match the given commitment.
+	ErrInvalidBlockInclusionProof = ierrors.New("invalid block inclusion proof")
+)
+
+var (
+	// smtLeafPrefix is the prefix of the preimage of a leaf in the sparse merkle tree of the accepted blocks.
+	smtLeafPrefix = []byte{0}
+
+	// smtInnerPrefix is the prefix of the preimage of an inner node in the sparse merkle tree of the accepted blocks.
+	smtInnerPrefix = []byte{1}
+)
+
+// BlockInclusionProof proves that a block is part of the accepted blocks of a slot commitment. It consists of the
+// sparse merkle proof of the block ID in the tangle root and the merkle proof of the tangle root in the roots of the
+// commitment, which allows light clients to verify the inclusion of a block by only trusting the commitment.
+type BlockInclusionProof struct {
+	// BlockID is the ID of the block whose inclusion is proven.
+	BlockID iotago.BlockID
+
+	// TangleRoot is the root of the sparse merkle tree of the accepted blocks of the slot.
+	TangleRoot iotago.Identifier
+
+	// TangleRootProof proves that the TangleRoot is part of the roots of the commitment.
+	TangleRootProof *merklehasher.Proof[iotago.Identifier]
+
+	// SideNodes are the hashes of the siblings on the path from the leaf of the block to the TangleRoot (bottom up).
+	SideNodes []iotago.Identifier
+}
+
+// NewBlockInclusionProof creates a BlockInclusionProof for the given block from the accepted blocks and the roots of
+// its slot.
+func NewBlockInclusionProof(blockID iotago.BlockID, acceptedBlockIDs iotago.BlockIDs, roots *iotago.Roots) (*BlockInclusionProof, error) {
+	// rebuild the sparse merkle tree the same way as the ads.Set that is used to calculate the tangle root.
+	tree := smt.NewSparseMerkleTree(smt.NewSimpleMap(), sha256.New(), smt.WithValueHasher(nil))
+
+	var blockIncluded bool
+	for _, acceptedBlockID := range acceptedBlockIDs {
+		if err := tree.Update(lo.PanicOnErr(acceptedBlockID.Bytes()), []byte{}); err != nil {
+			return nil, ierrors.Wrapf(err, "failed to add block %s to tree", acceptedBlockID)
+		}
+
+		blockIncluded = blockIncluded || acceptedBlockID == blockID
+	}
+
+	if !blockIncluded {
+		return nil, ierrors.Wrapf(ErrBlockNotIncluded, "block %s", blockID)
+	}
+
+	if tangleRoot := iotago.Identifier(tree.Root()); tangleRoot != roots.TangleRoot {
+		return nil, ierrors.Errorf("rebuilt tangle root %s does not match the committed tangle root %s", tangleRoot, roots.TangleRoot)
+	}
+
+	smtProof, err := tree.Prove(lo.PanicOnErr(blockID.Bytes()))
+	if err != nil {
+		return nil, ierrors.Wrapf(err, "failed to create proof for block %s", blockID)
+	}
+
+	sideNodes := make([]iotago.Identifier, len(smtProof.SideNodes))
+	for i, sideNode := range smtProof.SideNodes {
+		sideNodes[i] = iotago.Identifier(sideNode)
+	}
+
+	return &BlockInclusionProof{
+		BlockID:         blockID,
+		TangleRoot:      roots.TangleRoot,
+		TangleRootProof: roots.TangleProof(),
+		SideNodes:       sideNodes,
+	}, nil
+}
+
+// BlockInclusionProofFromBytes parses a BlockInclusionProof from the given bytes.
+func BlockInclusionProofFromBytes(bytes []byte) (*BlockInclusionProof, int, error) {
+	byteReader := stream.NewByteReader(bytes)
+
+	p, err := BlockInclusionProofFromReader(byteReader)
+	if err != nil {
+		return nil, 0, ierrors.Wrap(err, "failed to parse BlockInclusionProof")
+	}
+
+	return p, byteReader.BytesRead(), nil
+}
+
+// BlockInclusionProofFromReader reads a BlockInclusionProof from the given reader.
+func BlockInclusionProofFromReader(reader io.ReadSeeker) (p *BlockInclusionProof, err error) {
+	p = new(BlockInclusionProof)
+
+	if p.BlockID, err = stream.Read[iotago.BlockID](reader); err != nil {
+		return nil, ierrors.Wrap(err, "failed to read BlockID")
+	}
+
+	if p.TangleRoot, err = stream.Read[iotago.Identifier](reader); err != nil {
+		return nil, ierrors.Wrap(err, "failed to read TangleRoot")
+	}
+
+	if p.TangleRootProof, err = stream.ReadObjectWithSize(reader, serializer.SeriLengthPrefixTypeAsUint32, merklehasher.ProofFromBytes[iotago.Identifier]); err != nil {
+		return nil, ierrors.Wrap(err, "failed to read TangleRootProof")
+	}
+
+	if err = stream.ReadCollection(reader, serializer.SeriLengthPrefixTypeAsUint16, func(i int) error {
+		sideNode, err := stream.Read[iotago.Identifier](reader)
+		if err != nil {
+			return ierrors.Wrapf(err, "failed to read side node %d", i)
+		}
+
+		p.SideNodes = append(p.SideNodes, sideNode)
+
+		return nil
+	}); err != nil {
+		return nil, ierrors.Wrap(err, "failed to read SideNodes")
+	}
+
+	return p, nil
+}
+
+// Bytes returns the serialized form of the BlockInclusionProof.
+func (p *BlockInclusionProof) Bytes() ([]byte, error) {
+	byteBuffer := stream.NewByteBuffer()
+
+	if err := stream.Write(byteBuffer, p.BlockID); err != nil {
+		return nil, ierrors.Wrap(err, "failed to write BlockID")
+	}
+
+	if err := stream.Write(byteBuffer, p.TangleRoot); err != nil {
+		return nil, ierrors.Wrap(err, "failed to write TangleRoot")
+	}
+
+	if err := stream.WriteObjectWithSize(byteBuffer, p.TangleRootProof, serializer.SeriLengthPrefixTypeAsUint32, (*merklehasher.Proof[iotago.Identifier]).Bytes); err != nil {
+		return nil, ierrors.Wrap(err, "failed to write TangleRootProof")
+	}
+
+	if err := stream.WriteCollection(byteBuffer, serializer.SeriLengthPrefixTypeAsUint16, func() (int, error) {
+		for _, sideNode := range p.SideNodes {
+			if err := stream.Write(byteBuffer, sideNode); err != nil {
+				return 0, ierrors.Wrapf(err, "failed to write side node %s", sideNode)
+			}
+		}
+
+		return len(p.SideNodes), nil
+	}); err != nil {
+		return nil, ierrors.Wrap(err, "failed to write SideNodes")
+	}
+
+	return byteBuffer.Bytes()
+}
+
+// Verify checks that the proof shows the inclusion of the block in the accepted blocks of the given commitment.
+func (p *BlockInclusionProof) Verify(commitment *iotago.Commitment) error {
+	if p.BlockID.Slot() != commitment.Slot {
+		return ierrors.Wrapf(ErrInvalidBlockInclusionProof, "block %s is not from the slot of the commitment %d", p.BlockID, commitment.Slot)
+	}
+
+	if p.TangleRootProof == nil || !iotago.VerifyProof(p.TangleRootProof, p.TangleRoot, commitment.RootsID) {
+		return ierrors.Wrapf(ErrInvalidBlockInclusionProof, "tangle root %s is not part of the roots %s", p.TangleRoot, commitment.RootsID)
+	}
+
+	if tangleRoot := p.computeTangleRoot(); !bytes.Equal(tangleRoot, p.TangleRoot[:]) {
+		return ierrors.Wrapf(ErrInvalidBlockInclusionProof, "block %s is not part of the tangle root %s", p.BlockID, p.TangleRoot)
+	}
+
+	return nil
+}
+
+// computeTangleRoot computes the root of the sparse merkle tree from the leaf of the block and the side nodes.
+func (p *BlockInclusionProof) computeTangleRoot() []byte {
+	if len(p.SideNodes) > sha256.Size*8 {
+		return nil
+	}
+
+	path := sha256.Sum256(lo.PanicOnErr(p.BlockID.Bytes()))
+
+	// the leaves of an ads.Set store an empty value, so the leaf hash only depends on the path.
+	currentHash := sha256.Sum256(append(append([]byte{}, smtLeafPrefix...), path[:]...))
+	for i, sideNode := range p.SideNodes {
+		preimage := append([]byte{}, smtInnerPrefix...)
+		if smt.GetPathBit(path[:], len(p.SideNodes)-1-i) == 0 {
+			preimage = append(append(preimage, currentHash[:]...), sideNode[:]...)
+		} else {
+			preimage = append(append(preimage, sideNode[:]...), currentHash[:]...)
+		}
+
+		currentHash = sha256.Sum256(preimage)
+	}
+
+	return currentHash[:]
+}
@@ -84,6 +84,23 @@ func CommitmentFromBytes(apiProvider iotago.APIProvider) func([]byte) (*Commitme
 	}
 }
 
+// CommitmentFromStorageBytes returns a function that decodes a commitment that was encoded with StorageBytes.
+func CommitmentFromStorageBytes(apiProvider iotago.APIProvider) func([]byte) (*Commitment, int, error) {
+	return func(storedBytes []byte) (*Commitment, int, error) {
+		data, err := commitmentStorageFormat.decode(storedBytes)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		commitment, bytesRead, err := CommitmentFromBytes(apiProvider)(data)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		return commitment, len(storedBytes) - len(data) + bytesRead, nil
+	}
+}
+
 func (c *Commitment) ID() iotago.CommitmentID {
 	return c.commitmentID
 }
@@ -116,6 +133,11 @@ func (c *Commitment) Bytes() ([]byte, error) {
 	return c.data, nil
 }
 
+// StorageBytes returns the bytes of the commitment prefixed with the version of its storage format.
+func (c *Commitment) StorageBytes() ([]byte, error) {
+	return commitmentStorageFormat.encode(c.data), nil
+}
+
 func (c *Commitment) Commitment() *iotago.Commitment {
 	return c.commitment
 }
@@ -0,0 +1,84 @@
+package model
+
+import (
+	"github.com/iotaledger/iota-core/pkg/core/account"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// CommitmentWeight contains the accounting of the attestations that contributed to the cumulative weight of a
+// commitment.
+type CommitmentWeight struct {
+	// CommitmentID is the ID of the commitment whose weight is described.
+	CommitmentID iotago.CommitmentID
+
+	// CumulativeWeight is the cumulative weight of the commitment.
+	CumulativeWeight uint64
+
+	// ParentCumulativeWeight is the cumulative weight of the parent of the commitment.
+	ParentCumulativeWeight uint64
+
+	// CommitteeSize is the number of seats of the committee of the slot of the commitment.
+	CommitteeSize int
+
+	// AttestedSeats contains the seats of the committee that contributed an attestation to the commitment.
+	AttestedSeats SeatBitmap
+
+	// Attestations contains the attestations that contributed to the weight of the commitment.
+	Attestations []*SeatAttestation
+}
+
+// WeightDelta returns the weight that was added by the commitment on top of the weight of its parent.
+func (c *CommitmentWeight) WeightDelta() uint64 {
+	if c.CumulativeWeight < c.ParentCumulativeWeight {
+		return 0
+	}
+
+	return c.CumulativeWeight - c.ParentCumulativeWeight
+}
+
+// SeatAttestation is an attestation that was issued by a seat of the committee.
+type SeatAttestation struct {
+	// Seat is the seat of the issuer in the committee of the slot of the attested block.
+	Seat account.SeatIndex
+
+	// IssuerID is the account of the issuer of the attestation.
+	IssuerID iotago.AccountID
+
+	// BlockID is the ID of the block that contains the attestation.
+	BlockID iotago.BlockID
+}
+
+// SeatBitmap is a bitmap that contains a bit for each seat of a committee.
+type SeatBitmap []byte
+
+// Set sets the bit of the given seat and grows the bitmap if necessary.
+func (s *SeatBitmap) Set(seat account.SeatIndex) {
+	if byteIndex := int(seat) / 8; byteIndex >= len(*s) {
+		*s = append(*s, make([]byte, byteIndex-len(*s)+1)...)
+	}
+
+	(*s)[seat/8] |= 1 << (seat % 8)
+}
+
+// Has returns true if the bit of the given seat is set.
+func (s SeatBitmap) Has(seat account.SeatIndex) bool {
+	if int(seat)/8 >= len(s) {
+		return false
+	}
+
+	return s[seat/8]&(1<<(seat%8)) != 0
+}
+
+// Seats returns the seats whose bits are set in ascending order.
+func (s SeatBitmap) Seats() []account.SeatIndex {
+	seats := make([]account.SeatIndex, 0)
+	for byteIndex, b := range s {
+		for bit := 0; bit < 8; bit++ {
+			if b&(1<<bit) != 0 {
+				seats = append(seats, account.SeatIndex(byteIndex*8+bit))
+			}
+		}
+	}
+
+	return seats
+}
@@ -0,0 +1,51 @@
+package model
+
+import (
+	"github.com/iotaledger/iota-core/pkg/core/account"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// CommitteePreview contains the committee that is expected to be selected for an epoch.
+type CommitteePreview struct {
+	// Epoch is the epoch whose committee is previewed.
+	Epoch iotago.EpochIndex
+
+	// Slot is the committed slot whose accounts ledger state was used to rank the candidates.
+	Slot iotago.SlotIndex
+
+	// Selected is true if the committee of the epoch was already selected and is therefore final.
+	Selected bool
+
+	// Reused is true if the committee of the current epoch is (expected to be) reused in the epoch.
+	Reused bool
+
+	// Candidates contains the candidates ordered by their rank in the committee selection.
+	Candidates []*CommitteeCandidate
+}
+
+// CommitteeCandidate is a validator candidate ranked in a CommitteePreview.
+type CommitteeCandidate struct {
+	// Rank is the position of the candidate in the committee selection (starting at 0).
+	Rank int
+
+	// AccountID is the account of the candidate.
+	AccountID iotago.AccountID
+
+	// PoolStake is the sum of the validator stake and the stake delegated to the candidate.
+	PoolStake iotago.BaseToken
+
+	// ValidatorStake is the stake of the candidate.
+	ValidatorStake iotago.BaseToken
+
+	// FixedCost is the fixed cost that the candidate declared.
+	FixedCost iotago.Mana
+
+	// StakeEndEpoch is the epoch until which the candidate declared to stake.
+	StakeEndEpoch iotago.EpochIndex
+
+	// Selected is true if the candidate is part of the committee.
+	Selected bool
+
+	// Seat is the seat of the candidate in the committee (only valid if Selected is true).
+	Seat account.SeatIndex
+}
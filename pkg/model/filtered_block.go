@@ -0,0 +1,126 @@
+package model
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/serializer/v2"
+	"github.com/iotaledger/hive.go/serializer/v2/stream"
+	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/api"
+)
+
+// MaxFilteredBlockReasonLength is the maximum length of the persisted reason of a filter decision.
+const MaxFilteredBlockReasonLength = 512
+
+// BlockFilter is the filter that dropped a block.
+type BlockFilter byte
+
+const (
+	// BlockFilterPreSolid is the filter that checks blocks before they are attached to the BlockDAG.
+	BlockFilterPreSolid BlockFilter = iota
+
+	// BlockFilterPostSolid is the filter that checks blocks after they became solid.
+	BlockFilterPostSolid
+)
+
+// String returns a human-readable representation of the BlockFilter.
+func (b BlockFilter) String() string {
+	switch b {
+	case BlockFilterPreSolid:
+		return "preSolid"
+	case BlockFilterPostSolid:
+		return "postSolid"
+	default:
+		return fmt.Sprintf("unknown(%d)", byte(b))
+	}
+}
+
+// FilteredBlock is the persisted record of the decision of a filter to drop a block.
+type FilteredBlock struct {
+	// IssuerID is the ID of the account that issued the block.
+	IssuerID iotago.AccountID
+
+	// Filter is the filter that dropped the block.
+	Filter BlockFilter
+
+	// FailureReason is the failure reason of the block that is also reported by the block metadata.
+	FailureReason api.BlockFailureReason
+
+	// Reason is the (truncated) error message of the filter.
+	Reason string
+}
+
+// NewFilteredBlock creates a new FilteredBlock and truncates the given reason to MaxFilteredBlockReasonLength.
+func NewFilteredBlock(issuerID iotago.AccountID, filter BlockFilter, failureReason api.BlockFailureReason, reason error) *FilteredBlock {
+	reasonString := reason.Error()
+	if len(reasonString) > MaxFilteredBlockReasonLength {
+		reasonString = reasonString[:MaxFilteredBlockReasonLength]
+	}
+
+	return &FilteredBlock{
+		IssuerID:      issuerID,
+		Filter:        filter,
+		FailureReason: failureReason,
+		Reason:        reasonString,
+	}
+}
+
+func FilteredBlockFromBytes(bytes []byte) (*FilteredBlock, int, error) {
+	byteReader := stream.NewByteReader(bytes)
+
+	f, err := FilteredBlockFromReader(byteReader)
+	if err != nil {
+		return nil, 0, ierrors.Wrap(err, "failed to parse FilteredBlock")
+	}
+
+	return f, byteReader.BytesRead(), nil
+}
+
+func FilteredBlockFromReader(reader io.ReadSeeker) (*FilteredBlock, error) {
+	var err error
+	f := new(FilteredBlock)
+
+	if f.IssuerID, err = stream.Read[iotago.AccountID](reader); err != nil {
+		return nil, ierrors.Wrap(err, "failed to read issuer ID")
+	}
+
+	if f.Filter, err = stream.Read[BlockFilter](reader); err != nil {
+		return nil, ierrors.Wrap(err, "failed to read filter")
+	}
+
+	if f.FailureReason, err = stream.Read[api.BlockFailureReason](reader); err != nil {
+		return nil, ierrors.Wrap(err, "failed to read failure reason")
+	}
+
+	reasonBytes, err := stream.ReadBytesWithSize(reader, serializer.SeriLengthPrefixTypeAsUint16)
+	if err != nil {
+		return nil, ierrors.Wrap(err, "failed to read reason")
+	}
+	f.Reason = string(reasonBytes)
+
+	return f, nil
+}
+
+func (f *FilteredBlock) Bytes() ([]byte, error) {
+	byteBuffer := stream.NewByteBuffer()
+
+	if err := stream.Write(byteBuffer, f.IssuerID); err != nil {
+		return nil, ierrors.Wrap(err, "failed to write issuer ID")
+	}
+
+	if err := stream.Write(byteBuffer, f.Filter); err != nil {
+		return nil, ierrors.Wrap(err, "failed to write filter")
+	}
+
+	if err := stream.Write(byteBuffer, f.FailureReason); err != nil {
+		return nil, ierrors.Wrap(err, "failed to write failure reason")
+	}
+
+	if err := stream.WriteBytesWithSize(byteBuffer, []byte(f.Reason), serializer.SeriLengthPrefixTypeAsUint16); err != nil {
+		return nil, ierrors.Wrap(err, "failed to write reason")
+	}
+
+	return byteBuffer.Bytes()
+}
@@ -0,0 +1,130 @@
+package model
+
+import (
+	"io"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/serializer/v2"
+	"github.com/iotaledger/hive.go/serializer/v2/stream"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// ManaTrace is the persisted record of how the mana that a committed transaction allotted was distributed to the
+// block issuance credits of the accounts.
+type ManaTrace struct {
+	// Allotments contains the allotments of the transaction in the order of the transaction.
+	Allotments []*AllotmentTrace
+}
+
+// AllotmentTrace is the persisted record of a single allotment of a committed transaction.
+type AllotmentTrace struct {
+	// AccountID is the ID of the account that the mana was allotted to.
+	AccountID iotago.AccountID
+
+	// Mana is the amount of mana that was allotted.
+	Mana iotago.Mana
+
+	// Burned indicates whether the mana was burned because the account has no block issuance credits.
+	Burned bool
+}
+
+// BICChange returns the change of the block issuance credits of the account that was caused by the allotment.
+func (a *AllotmentTrace) BICChange() iotago.BlockIssuanceCredits {
+	if a.Burned {
+		return 0
+	}
+
+	return iotago.BlockIssuanceCredits(a.Mana)
+}
+
+// AllottedMana returns the total amount of mana that was added to the block issuance credits of accounts.
+func (m *ManaTrace) AllottedMana() (allottedMana iotago.Mana) {
+	for _, allotment := range m.Allotments {
+		if !allotment.Burned {
+			allottedMana += allotment.Mana
+		}
+	}
+
+	return allottedMana
+}
+
+// BurnedMana returns the total amount of mana that was burned by allotments to accounts without block issuance credits.
+func (m *ManaTrace) BurnedMana() (burnedMana iotago.Mana) {
+	for _, allotment := range m.Allotments {
+		if allotment.Burned {
+			burnedMana += allotment.Mana
+		}
+	}
+
+	return burnedMana
+}
+
+func ManaTraceFromBytes(bytes []byte) (*ManaTrace, int, error) {
+	byteReader := stream.NewByteReader(bytes)
+
+	m, err := ManaTraceFromReader(byteReader)
+	if err != nil {
+		return nil, 0, ierrors.Wrap(err, "failed to parse ManaTrace")
+	}
+
+	return m, byteReader.BytesRead(), nil
+}
+
+func ManaTraceFromReader(reader io.ReadSeeker) (*ManaTrace, error) {
+	m := new(ManaTrace)
+
+	if err := stream.ReadCollection(reader, serializer.SeriLengthPrefixTypeAsUint16, func(i int) error {
+		accountID, err := stream.Read[iotago.AccountID](reader)
+		if err != nil {
+			return ierrors.Wrapf(err, "failed to read account ID of allotment %d", i)
+		}
+
+		mana, err := stream.Read[iotago.Mana](reader)
+		if err != nil {
+			return ierrors.Wrapf(err, "failed to read mana of allotment %d", i)
+		}
+
+		burned, err := stream.Read[bool](reader)
+		if err != nil {
+			return ierrors.Wrapf(err, "failed to read burned flag of allotment %d", i)
+		}
+
+		m.Allotments = append(m.Allotments, &AllotmentTrace{
+			AccountID: accountID,
+			Mana:      mana,
+			Burned:    burned,
+		})
+
+		return nil
+	}); err != nil {
+		return nil, ierrors.Wrap(err, "failed to read Allotments")
+	}
+
+	return m, nil
+}
+
+func (m *ManaTrace) Bytes() ([]byte, error) {
+	byteBuffer := stream.NewByteBuffer()
+
+	if err := stream.WriteCollection(byteBuffer, serializer.SeriLengthPrefixTypeAsUint16, func() (int, error) {
+		for _, allotment := range m.Allotments {
+			if err := stream.Write(byteBuffer, allotment.AccountID); err != nil {
+				return 0, ierrors.Wrapf(err, "failed to write account ID of allotment to %s", allotment.AccountID)
+			}
+
+			if err := stream.Write(byteBuffer, allotment.Mana); err != nil {
+				return 0, ierrors.Wrapf(err, "failed to write mana of allotment to %s", allotment.AccountID)
+			}
+
+			if err := stream.Write(byteBuffer, allotment.Burned); err != nil {
+				return 0, ierrors.Wrapf(err, "failed to write burned flag of allotment to %s", allotment.AccountID)
+			}
+		}
+
+		return len(m.Allotments), nil
+	}); err != nil {
+		return nil, ierrors.Wrap(err, "failed to write Allotments")
+	}
+
+	return byteBuffer.Bytes()
+}
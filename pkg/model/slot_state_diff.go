@@ -0,0 +1,115 @@
+package model
+
+import (
+	"github.com/iotaledger/hive.go/ads"
+	"github.com/iotaledger/hive.go/ds"
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// ErrInvalidSlotStateDiff is returned when a SlotStateDiff does not match the commitment of its slot.
+var ErrInvalidSlotStateDiff = ierrors.New("invalid slot state diff")
+
+// SlotStateDiff contains the changes that the committed transactions of a slot applied to the UTXO ledger.
+type SlotStateDiff struct {
+	// CreatedOutputIDs contains the IDs of the outputs that were created in the slot.
+	CreatedOutputIDs iotago.OutputIDs `serix:",lenPrefix=uint32"`
+
+	// ConsumedOutputIDs contains the IDs of the outputs that were consumed in the slot.
+	ConsumedOutputIDs iotago.OutputIDs `serix:",lenPrefix=uint32"`
+
+	// Roots contains the roots of the commitment of the slot.
+	Roots *iotago.Roots `serix:""`
+}
+
+// Verify checks that the SlotStateDiff belongs to the given commitment, that the given accepted transactions of the
+// slot match its state mutation root and that the created outputs are exactly the outputs of these transactions.
+//
+// The consumed outputs can only be checked against the state root once the transactions are applied (see
+// MatchesStateRoot).
+func (s *SlotStateDiff) Verify(commitment *Commitment, acceptedTransactionIDs iotago.TransactionIDs) error {
+	if s.Roots == nil {
+		return ierrors.Wrap(ErrInvalidSlotStateDiff, "roots are missing")
+	}
+
+	if rootsID := s.Roots.ID(); rootsID != commitment.RootsID() {
+		return ierrors.Wrapf(ErrInvalidSlotStateDiff, "roots %s do not match the roots ID %s of commitment %s", rootsID, commitment.RootsID(), commitment.ID())
+	}
+
+	mutations := ads.NewSet[iotago.Identifier](mapdb.NewMapDB(), iotago.Identifier.Bytes, iotago.IdentifierFromBytes, iotago.TransactionID.Bytes, iotago.TransactionIDFromBytes)
+	for _, transactionID := range acceptedTransactionIDs {
+		_ = mutations.Add(transactionID) // a mapdb can never return an error
+	}
+
+	if mutationRoot := mutations.Root(); mutationRoot != s.Roots.StateMutationRoot {
+		return ierrors.Wrapf(ErrInvalidSlotStateDiff, "accepted transactions with root %s do not match the state mutation root %s", mutationRoot, s.Roots.StateMutationRoot)
+	}
+
+	acceptedTransactions := ds.NewSet(acceptedTransactionIDs...)
+	transactionsWithoutOutputs := ds.NewSet(acceptedTransactionIDs...)
+	createdOutputIDs := ds.NewSet[iotago.OutputID]()
+	for _, outputID := range s.CreatedOutputIDs {
+		if !createdOutputIDs.Add(outputID) {
+			return ierrors.Wrapf(ErrInvalidSlotStateDiff, "output %s is created more than once", outputID)
+		}
+
+		if !acceptedTransactions.Has(outputID.TransactionID()) {
+			return ierrors.Wrapf(ErrInvalidSlotStateDiff, "output %s is not created by an accepted transaction", outputID)
+		}
+
+		transactionsWithoutOutputs.Delete(outputID.TransactionID())
+	}
+
+	if !transactionsWithoutOutputs.IsEmpty() {
+		return ierrors.Wrapf(ErrInvalidSlotStateDiff, "accepted transactions %s do not create any outputs", transactionsWithoutOutputs)
+	}
+
+	consumedOutputIDs := ds.NewSet[iotago.OutputID]()
+	for _, outputID := range s.ConsumedOutputIDs {
+		if !consumedOutputIDs.Add(outputID) {
+			return ierrors.Wrapf(ErrInvalidSlotStateDiff, "output %s is consumed more than once", outputID)
+		}
+	}
+
+	return nil
+}
+
+// Matches checks that the SlotStateDiff contains exactly the given created and consumed outputs.
+func (s *SlotStateDiff) Matches(createdOutputIDs iotago.OutputIDs, consumedOutputIDs iotago.OutputIDs) error {
+	if err := matchOutputIDs(s.CreatedOutputIDs, createdOutputIDs); err != nil {
+		return ierrors.Wrapf(err, "created outputs do not match")
+	}
+
+	if err := matchOutputIDs(s.ConsumedOutputIDs, consumedOutputIDs); err != nil {
+		return ierrors.Wrapf(err, "consumed outputs do not match")
+	}
+
+	return nil
+}
+
+// MatchesStateRoot checks that the given state root of the ledger after applying the changes of the slot matches the
+// state root of the SlotStateDiff.
+func (s *SlotStateDiff) MatchesStateRoot(stateRoot iotago.Identifier) error {
+	if stateRoot != s.Roots.StateRoot {
+		return ierrors.Wrapf(ErrInvalidSlotStateDiff, "state root %s does not match the expected state root %s", stateRoot, s.Roots.StateRoot)
+	}
+
+	return nil
+}
+
+// matchOutputIDs checks that the given lists contain the same output IDs (ignoring their order).
+func matchOutputIDs(expected iotago.OutputIDs, actual iotago.OutputIDs) error {
+	if len(expected) != len(actual) {
+		return ierrors.Wrapf(ErrInvalidSlotStateDiff, "expected %d outputs, got %d", len(expected), len(actual))
+	}
+
+	expectedOutputIDs := ds.NewSet(expected...)
+	for _, outputID := range actual {
+		if !expectedOutputIDs.Has(outputID) {
+			return ierrors.Wrapf(ErrInvalidSlotStateDiff, "unexpected output %s", outputID)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,157 @@
+package model
+
+import (
+	"io"
+	"time"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/serializer/v2"
+	"github.com/iotaledger/hive.go/serializer/v2/stream"
+	"github.com/iotaledger/iota-core/pkg/core/account"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// Spender is the persisted state of a pending spender of the SpendDAG that is used to restore the SpendDAG after a
+// restart of the node.
+type Spender struct {
+	// SpentResources contains the IDs of the resources that are spent by the spender.
+	SpentResources []iotago.Identifier
+
+	// Parents contains the IDs of the parent spenders.
+	Parents []iotago.TransactionID
+
+	// Votes contains the latest supporting votes of the seats that contributed to the weight of the spender.
+	Votes []*SpenderVote
+}
+
+// SpenderVote is the persisted latest supporting vote of a seat for a spender.
+type SpenderVote struct {
+	// Seat is the seat of the voter.
+	Seat account.SeatIndex
+
+	// BlockID is the ID of the block that cast the vote.
+	BlockID iotago.BlockID
+
+	// IssuingTime is the issuing time of the block that cast the vote.
+	IssuingTime time.Time
+}
+
+func SpenderFromBytes(bytes []byte) (*Spender, int, error) {
+	byteReader := stream.NewByteReader(bytes)
+
+	s, err := SpenderFromReader(byteReader)
+	if err != nil {
+		return nil, 0, ierrors.Wrap(err, "failed to parse Spender")
+	}
+
+	return s, byteReader.BytesRead(), nil
+}
+
+func SpenderFromReader(reader io.ReadSeeker) (*Spender, error) {
+	s := new(Spender)
+
+	if err := stream.ReadCollection(reader, serializer.SeriLengthPrefixTypeAsUint32, func(i int) error {
+		resourceID, err := stream.Read[iotago.Identifier](reader)
+		if err != nil {
+			return ierrors.Wrapf(err, "failed to read spent resource %d", i)
+		}
+
+		s.SpentResources = append(s.SpentResources, resourceID)
+
+		return nil
+	}); err != nil {
+		return nil, ierrors.Wrap(err, "failed to read SpentResources")
+	}
+
+	if err := stream.ReadCollection(reader, serializer.SeriLengthPrefixTypeAsUint32, func(i int) error {
+		parentID, err := stream.Read[iotago.TransactionID](reader)
+		if err != nil {
+			return ierrors.Wrapf(err, "failed to read parent %d", i)
+		}
+
+		s.Parents = append(s.Parents, parentID)
+
+		return nil
+	}); err != nil {
+		return nil, ierrors.Wrap(err, "failed to read Parents")
+	}
+
+	if err := stream.ReadCollection(reader, serializer.SeriLengthPrefixTypeAsUint32, func(i int) error {
+		seat, err := stream.Read[account.SeatIndex](reader)
+		if err != nil {
+			return ierrors.Wrapf(err, "failed to read seat of vote %d", i)
+		}
+
+		blockID, err := stream.Read[iotago.BlockID](reader)
+		if err != nil {
+			return ierrors.Wrapf(err, "failed to read block ID of vote %d", i)
+		}
+
+		issuingTime, err := stream.Read[int64](reader)
+		if err != nil {
+			return ierrors.Wrapf(err, "failed to read issuing time of vote %d", i)
+		}
+
+		s.Votes = append(s.Votes, &SpenderVote{
+			Seat:        seat,
+			BlockID:     blockID,
+			IssuingTime: time.Unix(0, issuingTime),
+		})
+
+		return nil
+	}); err != nil {
+		return nil, ierrors.Wrap(err, "failed to read Votes")
+	}
+
+	return s, nil
+}
+
+func (s *Spender) Bytes() ([]byte, error) {
+	byteBuffer := stream.NewByteBuffer()
+
+	if err := stream.WriteCollection(byteBuffer, serializer.SeriLengthPrefixTypeAsUint32, func() (int, error) {
+		for _, resourceID := range s.SpentResources {
+			if err := stream.Write(byteBuffer, resourceID); err != nil {
+				return 0, ierrors.Wrapf(err, "failed to write spent resource %s", resourceID)
+			}
+		}
+
+		return len(s.SpentResources), nil
+	}); err != nil {
+		return nil, ierrors.Wrap(err, "failed to write SpentResources")
+	}
+
+	if err := stream.WriteCollection(byteBuffer, serializer.SeriLengthPrefixTypeAsUint32, func() (int, error) {
+		for _, parentID := range s.Parents {
+			if err := stream.Write(byteBuffer, parentID); err != nil {
+				return 0, ierrors.Wrapf(err, "failed to write parent %s", parentID)
+			}
+		}
+
+		return len(s.Parents), nil
+	}); err != nil {
+		return nil, ierrors.Wrap(err, "failed to write Parents")
+	}
+
+	if err := stream.WriteCollection(byteBuffer, serializer.SeriLengthPrefixTypeAsUint32, func() (int, error) {
+		for _, vote := range s.Votes {
+			if err := stream.Write(byteBuffer, vote.Seat); err != nil {
+				return 0, ierrors.Wrapf(err, "failed to write seat of vote %d", vote.Seat)
+			}
+
+			if err := stream.Write(byteBuffer, vote.BlockID); err != nil {
+				return 0, ierrors.Wrapf(err, "failed to write block ID of vote %d", vote.Seat)
+			}
+
+			if err := stream.Write(byteBuffer, vote.IssuingTime.UnixNano()); err != nil {
+				return 0, ierrors.Wrapf(err, "failed to write issuing time of vote %d", vote.Seat)
+			}
+		}
+
+		return len(s.Votes), nil
+	}); err != nil {
+		return nil, ierrors.Wrap(err, "failed to write Votes")
+	}
+
+	return byteBuffer.Bytes()
+}
@@ -0,0 +1,88 @@
+package model
+
+import (
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/serializer/v2/byteutils"
+)
+
+const (
+	// InitialStorageVersion is the storage format version of the objects that were stored before the storage formats
+	// were versioned.
+	InitialStorageVersion byte = 1
+
+	// CommitmentStorageVersion is the current version of the format that commitments are stored in.
+	CommitmentStorageVersion = InitialStorageVersion
+
+	// BlockStorageVersion is the current version of the format that blocks are stored in.
+	BlockStorageVersion = InitialStorageVersion
+)
+
+// ErrUnsupportedStorageVersion is returned if stored bytes use a storage format version that can not be decoded.
+var ErrUnsupportedStorageVersion = ierrors.New("unsupported storage version")
+
+// StorageMigration upgrades the bytes of an object from the storage format version it is registered for to the next
+// version.
+type StorageMigration func(data []byte) ([]byte, error)
+
+// storageFormat describes the versioned format that an object is stored in.
+type storageFormat struct {
+	// name is the name of the stored object type.
+	name string
+
+	// version is the current version of the format.
+	version byte
+
+	// migrations contains the hooks that upgrade the bytes of a version to the next version.
+	migrations map[byte]StorageMigration
+}
+
+// commitmentStorageFormat is the format that commitments are stored in.
+var commitmentStorageFormat = &storageFormat{
+	name:       "commitment",
+	version:    CommitmentStorageVersion,
+	migrations: map[byte]StorageMigration{},
+}
+
+// blockStorageFormat is the format that blocks are stored in.
+var blockStorageFormat = &storageFormat{
+	name:       "block",
+	version:    BlockStorageVersion,
+	migrations: map[byte]StorageMigration{},
+}
+
+// encode prefixes the given bytes with the current version of the format.
+func (s *storageFormat) encode(data []byte) []byte {
+	return byteutils.ConcatBytes([]byte{s.version}, data)
+}
+
+// decode strips the version from the stored bytes and upgrades them to the current version of the format.
+func (s *storageFormat) decode(storedBytes []byte) ([]byte, error) {
+	if len(storedBytes) == 0 {
+		return nil, ierrors.Errorf("failed to read %s storage version: no bytes", s.name)
+	}
+
+	version, data := storedBytes[0], storedBytes[1:]
+	if version > s.version || version < InitialStorageVersion {
+		return nil, ierrors.Wrapf(ErrUnsupportedStorageVersion, "%s storage version %d (current %d)", s.name, version, s.version)
+	}
+
+	for ; version < s.version; version++ {
+		migration, exists := s.migrations[version]
+		if !exists {
+			return nil, ierrors.Wrapf(ErrUnsupportedStorageVersion, "no migration for %s storage version %d", s.name, version)
+		}
+
+		var err error
+		if data, err = migration(data); err != nil {
+			return nil, ierrors.Wrapf(err, "failed to migrate %s from storage version %d", s.name, version)
+		}
+	}
+
+	return data, nil
+}
+
+// StorageBytesFromUnversioned converts the bytes of an object that was stored before the storage formats were versioned
+// to the initial version of its storage format.
+func StorageBytesFromUnversioned(data []byte) ([]byte, error) {
+	return byteutils.ConcatBytes([]byte{InitialStorageVersion}, data), nil
+}
@@ -19,17 +19,18 @@ import (
 )
 
 type Manager struct {
-	networkID        string
-	p2pManager       *p2p.Manager
-	logger           log.Logger
-	host             host.Host
-	peerDB           *network.DB
-	startOnce        sync.Once
-	isStarted        atomic.Bool
-	stopOnce         sync.Once
-	ctx              context.Context
-	stopFunc         context.CancelFunc
-	routingDiscovery *routing.RoutingDiscovery
+	networkID  string
+	p2pManager *p2p.Manager
+	logger     log.Logger
+	host       host.Host
+	peerDB     *network.DB
+	startOnce  sync.Once
+	isStarted  atomic.Bool
+	stopOnce   sync.Once
+	isStopped  bool
+	parentCtx  context.Context
+	stopFunc   context.CancelFunc
+	mutex      sync.Mutex
 }
 
 // NewManager creates a new autopeering manager.
@@ -45,42 +46,15 @@ func NewManager(networkID string, p2pManager *p2p.Manager, host host.Host, peerD
 
 // Start starts the autopeering manager.
 func (m *Manager) Start(ctx context.Context) (err error) {
-	//nolint:contextcheck
 	m.startOnce.Do(func() {
-		m.ctx, m.stopFunc = context.WithCancel(ctx)
-		kademliaDHT, innerErr := dht.New(m.ctx, m.host, dht.Mode(dht.ModeServer))
-		if innerErr != nil {
-			err = innerErr
-			return
-		}
+		m.mutex.Lock()
+		defer m.mutex.Unlock()
 
-		// Bootstrap the DHT. In the default configuration, this spawns a Background worker that will keep the
-		// node connected to the bootstrap peers and will disconnect from peers that are not useful.
-		if innerErr = kademliaDHT.Bootstrap(m.ctx); innerErr != nil {
-			err = innerErr
+		m.parentCtx = ctx
+		if err = m.start(); err != nil {
 			return
 		}
 
-		for _, seedPeer := range m.peerDB.SeedPeers() {
-			addrInfo := seedPeer.ToAddrInfo()
-			if innerErr := m.host.Connect(ctx, *addrInfo); innerErr != nil {
-				m.logger.LogInfof("Failed to connect to bootstrap node, peer: %s, error: %s", seedPeer, innerErr)
-				continue
-			}
-
-			if _, innerErr := kademliaDHT.RoutingTable().TryAddPeer(addrInfo.ID, true, true); innerErr != nil {
-				m.logger.LogWarnf("Failed to add bootstrap node to routing table, error: %s", innerErr)
-				continue
-			}
-
-			m.logger.LogDebugf("Connected to bootstrap node, peer: %s", seedPeer)
-		}
-
-		m.routingDiscovery = routing.NewRoutingDiscovery(kademliaDHT)
-		util.Advertise(m.ctx, m.routingDiscovery, m.networkID, discovery.TTL(5*time.Minute))
-
-		go m.discoveryLoop()
-
 		m.isStarted.Store(true)
 	})
 
@@ -93,48 +67,109 @@ func (m *Manager) Stop() error {
 		return ierrors.New("can't stop the manager: it hasn't been started yet")
 	}
 	m.stopOnce.Do(func() {
+		m.mutex.Lock()
+		defer m.mutex.Unlock()
+
 		m.stopFunc()
+		m.isStopped = true
 	})
 
 	return nil
 }
 
-func (m *Manager) discoveryLoop() {
+// SwitchHost restarts the DHT based discovery on the given host (e.g. after the identity of the node was rotated).
+func (m *Manager) SwitchHost(newHost host.Host) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	m.host = newHost
+
+	if !m.isStarted.Load() || m.isStopped {
+		return nil
+	}
+
+	m.stopFunc()
+
+	return m.start()
+}
+
+// start bootstraps the DHT on the current host and starts the discovery loop. It must be called while holding the mutex.
+//
+//nolint:contextcheck // the DHT is bound to the context of the manager
+func (m *Manager) start() error {
+	ctx, stopFunc := context.WithCancel(m.parentCtx)
+	m.stopFunc = stopFunc
+
+	kademliaDHT, err := dht.New(ctx, m.host, dht.Mode(dht.ModeServer))
+	if err != nil {
+		return err
+	}
+
+	// Bootstrap the DHT. In the default configuration, this spawns a Background worker that will keep the
+	// node connected to the bootstrap peers and will disconnect from peers that are not useful.
+	if err = kademliaDHT.Bootstrap(ctx); err != nil {
+		return err
+	}
+
+	for _, seedPeer := range m.peerDB.SeedPeers() {
+		addrInfo := seedPeer.ToAddrInfo()
+		if innerErr := m.host.Connect(ctx, *addrInfo); innerErr != nil {
+			m.logger.LogInfof("Failed to connect to bootstrap node, peer: %s, error: %s", seedPeer, innerErr)
+			continue
+		}
+
+		if _, innerErr := kademliaDHT.RoutingTable().TryAddPeer(addrInfo.ID, true, true); innerErr != nil {
+			m.logger.LogWarnf("Failed to add bootstrap node to routing table, error: %s", innerErr)
+			continue
+		}
+
+		m.logger.LogDebugf("Connected to bootstrap node, peer: %s", seedPeer)
+	}
+
+	routingDiscovery := routing.NewRoutingDiscovery(kademliaDHT)
+	util.Advertise(ctx, routingDiscovery, m.networkID, discovery.TTL(5*time.Minute))
+
+	go m.discoveryLoop(ctx, routingDiscovery, m.host)
+
+	return nil
+}
+
+func (m *Manager) discoveryLoop(ctx context.Context, routingDiscovery *routing.RoutingDiscovery, localHost host.Host) {
 	ticker := time.NewTicker(1 * time.Minute)
 	defer ticker.Stop()
 
-	m.discoverAndDialPeers()
+	m.discoverAndDialPeers(ctx, routingDiscovery, localHost)
 
 	for {
 		select {
 		case <-ticker.C:
-			m.discoverAndDialPeers()
-		case <-m.ctx.Done():
+			m.discoverAndDialPeers(ctx, routingDiscovery, localHost)
+		case <-ctx.Done():
 			return
 		}
 	}
 }
 
-func (m *Manager) discoverAndDialPeers() {
-	tctx, cancel := context.WithTimeout(m.ctx, 10*time.Second)
+func (m *Manager) discoverAndDialPeers(ctx context.Context, routingDiscovery *routing.RoutingDiscovery, localHost host.Host) {
+	tctx, cancel := context.WithTimeout(ctx, 10*time.Second)
 	defer cancel()
 
 	m.logger.LogDebugf("Discovering peers for network ID %s", m.networkID)
-	peerChan, err := m.routingDiscovery.FindPeers(tctx, m.networkID)
+	peerChan, err := routingDiscovery.FindPeers(tctx, m.networkID)
 	if err != nil {
 		m.logger.LogWarnf("Failed to find peers: %s", err)
 	}
 
 	for peerAddrInfo := range peerChan {
 		// Do not self-dial.
-		if peerAddrInfo.ID == m.host.ID() {
+		if peerAddrInfo.ID == localHost.ID() {
 			continue
 		}
 
 		m.logger.LogDebugf("Found peer: %s", peerAddrInfo)
 
 		peer := network.NewPeerFromAddrInfo(&peerAddrInfo)
-		if err := m.p2pManager.DialPeer(m.ctx, peer); err != nil {
+		if err := m.p2pManager.DialPeer(ctx, peer); err != nil {
 			if ierrors.Is(err, p2p.ErrDuplicateNeighbor) {
 				m.logger.LogDebugf("Already connected to peer %s", peer)
 				continue
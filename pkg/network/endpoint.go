@@ -7,6 +7,7 @@ import (
 
 type Endpoint interface {
 	LocalPeerID() peer.ID
+	AllNeighborsIDs() []peer.ID
 	RegisterProtocol(factory func() proto.Message, handler func(peer.ID, proto.Message) error)
 	UnregisterProtocol()
 	Send(packet proto.Message, to ...peer.ID)
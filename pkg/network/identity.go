@@ -0,0 +1,115 @@
+package network
+
+import (
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/serializer/v2"
+	"github.com/iotaledger/hive.go/serializer/v2/stream"
+)
+
+// Identity contains the metadata of the active p2p identity of the node.
+type Identity struct {
+	// PeerID is the ID of the active identity.
+	PeerID peer.ID
+	// PreviousPeerID is the ID of the identity that was replaced by the active identity (empty if there was none).
+	PreviousPeerID peer.ID
+	// ActivatedAt is the time when the active identity was activated.
+	ActivatedAt time.Time
+	// AdvertisedAddresses are the addresses that were advertised to the peers when the metadata was stored.
+	AdvertisedAddresses []multiaddr.Multiaddr
+}
+
+// Bytes returns the serialized form of the Identity.
+func (i *Identity) Bytes() ([]byte, error) {
+	byteBuffer := stream.NewByteBuffer()
+
+	for _, id := range []peer.ID{i.PeerID, i.PreviousPeerID} {
+		if err := stream.WriteObjectWithSize(byteBuffer, id, serializer.SeriLengthPrefixTypeAsUint16, func(id peer.ID) ([]byte, error) {
+			return []byte(id), nil
+		}); err != nil {
+			return nil, ierrors.Wrap(err, "failed to write peer ID")
+		}
+	}
+
+	if err := stream.Write(byteBuffer, i.ActivatedAt.UnixNano()); err != nil {
+		return nil, ierrors.Wrap(err, "failed to write activation time")
+	}
+
+	if err := stream.WriteCollection(byteBuffer, serializer.SeriLengthPrefixTypeAsByte, func() (elementsCount int, err error) {
+		for _, addr := range i.AdvertisedAddresses {
+			if err = stream.WriteObjectWithSize(byteBuffer, addr, serializer.SeriLengthPrefixTypeAsUint16, func(m multiaddr.Multiaddr) ([]byte, error) {
+				return m.Bytes(), nil
+			}); err != nil {
+				return 0, ierrors.Wrap(err, "failed to write advertised address")
+			}
+		}
+
+		return len(i.AdvertisedAddresses), nil
+	}); err != nil {
+		return nil, ierrors.Wrap(err, "failed to write advertised addresses")
+	}
+
+	return byteBuffer.Bytes()
+}
+
+// identityFromBytes parses an Identity from a byte slice.
+func identityFromBytes(bytes []byte) (*Identity, error) {
+	i := &Identity{
+		AdvertisedAddresses: make([]multiaddr.Multiaddr, 0),
+	}
+
+	byteReader := stream.NewByteReader(bytes)
+
+	for _, target := range []*peer.ID{&i.PeerID, &i.PreviousPeerID} {
+		id, err := stream.ReadObjectWithSize(byteReader, serializer.SeriLengthPrefixTypeAsUint16, func(bytes []byte) (peer.ID, int, error) {
+			// the previous peer ID is empty if the identity was never rotated.
+			if len(bytes) == 0 {
+				return "", 0, nil
+			}
+
+			id, err := peer.IDFromBytes(bytes)
+			if err != nil {
+				return "", 0, ierrors.Wrap(err, "failed to parse peerID")
+			}
+
+			return id, len(bytes), nil
+		})
+		if err != nil {
+			return nil, ierrors.Wrap(err, "failed to read peer ID")
+		}
+
+		*target = id
+	}
+
+	activatedAt, err := stream.Read[int64](byteReader)
+	if err != nil {
+		return nil, ierrors.Wrap(err, "failed to read activation time")
+	}
+	i.ActivatedAt = time.Unix(0, activatedAt)
+
+	if err = stream.ReadCollection(byteReader, serializer.SeriLengthPrefixTypeAsByte, func(int) error {
+		addr, err := stream.ReadObjectWithSize(byteReader, serializer.SeriLengthPrefixTypeAsUint16, func(bytes []byte) (multiaddr.Multiaddr, int, error) {
+			m, err := multiaddr.NewMultiaddrBytes(bytes)
+			if err != nil {
+				return nil, 0, ierrors.Wrap(err, "failed to parse advertised address")
+			}
+
+			return m, len(bytes), nil
+		})
+		if err != nil {
+			return ierrors.Wrap(err, "failed to read advertised address")
+		}
+
+		i.AdvertisedAddresses = append(i.AdvertisedAddresses, addr)
+
+		return nil
+	}); err != nil {
+		return nil, ierrors.Wrap(err, "failed to read advertised addresses")
+	}
+
+	return i, nil
+}
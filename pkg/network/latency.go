@@ -0,0 +1,79 @@
+package network
+
+import (
+	"time"
+)
+
+const (
+	// latencyRTTGain is the gain of the exponentially weighted moving average of the round trip time (see RFC 6298).
+	latencyRTTGain = 8
+
+	// latencyJitterGain is the gain of the exponentially weighted moving average of the jitter (see RFC 3550).
+	latencyJitterGain = 16
+)
+
+// Latency contains the round trip time measurements of the link to a peer.
+type Latency struct {
+	// RTT is the smoothed round trip time.
+	RTT time.Duration `json:"rtt"`
+
+	// LastRTT is the round trip time of the latest measurement.
+	LastRTT time.Duration `json:"lastRtt"`
+
+	// Jitter is the smoothed variation of the round trip time between consecutive measurements.
+	Jitter time.Duration `json:"jitter"`
+
+	// Samples is the number of successful measurements.
+	Samples uint64 `json:"samples"`
+
+	// Lost is the number of measurements that were not answered in time.
+	Lost uint64 `json:"lost"`
+
+	// ConsecutiveLost is the number of measurements that were not answered in time since the last successful one.
+	ConsecutiveLost uint64 `json:"consecutiveLost"`
+
+	// LastUpdated is the time of the latest successful measurement.
+	LastUpdated time.Time `json:"lastUpdated"`
+}
+
+// WithSample returns a copy of the Latency that includes the given round trip time measurement.
+func (l Latency) WithSample(rtt time.Duration, measuredAt time.Time) Latency {
+	if l.Samples == 0 {
+		l.RTT = rtt
+	} else {
+		l.RTT += (rtt - l.RTT) / latencyRTTGain
+		l.Jitter += (absDuration(rtt-l.LastRTT) - l.Jitter) / latencyJitterGain
+	}
+
+	l.LastRTT = rtt
+	l.Samples++
+	l.ConsecutiveLost = 0
+	l.LastUpdated = measuredAt
+
+	return l
+}
+
+// WithLoss returns a copy of the Latency that includes a measurement that was not answered in time.
+func (l Latency) WithLoss() Latency {
+	l.Lost++
+	l.ConsecutiveLost++
+
+	return l
+}
+
+// LossRate returns the share of measurements that were not answered in time.
+func (l Latency) LossRate() float64 {
+	if total := l.Samples + l.Lost; total != 0 {
+		return float64(l.Lost) / float64(total)
+	}
+
+	return 0
+}
+
+func absDuration(d time.Duration) time.Duration {
+	if d < 0 {
+		return -d
+	}
+
+	return d
+}
@@ -0,0 +1,131 @@
+package p2p
+
+import (
+	"sort"
+
+	"github.com/multiformats/go-multiaddr"
+)
+
+const (
+	// TransportTCP is the name of the TCP transport.
+	TransportTCP = "tcp"
+	// TransportQUIC is the name of the QUIC transport.
+	TransportQUIC = "quic"
+	// TransportOther is the name of all transports that are neither TCP nor QUIC.
+	TransportOther = "other"
+)
+
+// AdvertisedAddresses merges the addresses of the host with the configured external addresses into the list of
+// addresses that is advertised to the peers. Duplicates are removed and the addresses are ordered by network (IPv4
+// before IPv6 before DNS) and transport (TCP before QUIC), so that all peers see the same addresses in the same order
+// regardless of the order in which the listeners or the NAT mappings of the host came up.
+func AdvertisedAddresses(hostAddresses []multiaddr.Multiaddr, externalAddresses []multiaddr.Multiaddr) []multiaddr.Multiaddr {
+	seen := make(map[string]struct{}, len(hostAddresses)+len(externalAddresses))
+	result := make([]multiaddr.Multiaddr, 0, len(hostAddresses)+len(externalAddresses))
+
+	for _, addresses := range [][]multiaddr.Multiaddr{hostAddresses, externalAddresses} {
+		for _, address := range addresses {
+			if _, exists := seen[string(address.Bytes())]; exists {
+				continue
+			}
+
+			seen[string(address.Bytes())] = struct{}{}
+			result = append(result, address)
+		}
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		networkI, transportI := addressRank(result[i])
+		networkJ, transportJ := addressRank(result[j])
+
+		if networkI != networkJ {
+			return networkI < networkJ
+		}
+
+		if transportI != transportJ {
+			return transportI < transportJ
+		}
+
+		return result[i].String() < result[j].String()
+	})
+
+	return result
+}
+
+// UsesQUIC returns true if any of the given addresses uses the QUIC transport.
+func UsesQUIC(addresses []multiaddr.Multiaddr) bool {
+	for _, address := range addresses {
+		if TransportName(address) == TransportQUIC {
+			return true
+		}
+	}
+
+	return false
+}
+
+// QUICAddresses derives the QUIC addresses that listen on the same IPs and ports as the given TCP addresses.
+// Addresses that do not use TCP are ignored.
+func QUICAddresses(tcpAddresses []multiaddr.Multiaddr) []multiaddr.Multiaddr {
+	quicAddresses := make([]multiaddr.Multiaddr, 0, len(tcpAddresses))
+	for _, tcpAddress := range tcpAddresses {
+		port, err := tcpAddress.ValueForProtocol(multiaddr.P_TCP)
+		if err != nil {
+			continue
+		}
+
+		ipAddress, _ := multiaddr.SplitFirst(tcpAddress)
+		if ipAddress == nil {
+			continue
+		}
+
+		quicAddress, err := multiaddr.NewMultiaddr(ipAddress.String() + "/udp/" + port + "/quic-v1")
+		if err != nil {
+			continue
+		}
+
+		quicAddresses = append(quicAddresses, quicAddress)
+	}
+
+	return quicAddresses
+}
+
+// TransportName returns the name of the transport that is used by the given address.
+func TransportName(address multiaddr.Multiaddr) string {
+	if address == nil {
+		return TransportOther
+	}
+
+	if _, err := address.ValueForProtocol(multiaddr.P_QUIC_V1); err == nil {
+		return TransportQUIC
+	}
+
+	if _, err := address.ValueForProtocol(multiaddr.P_TCP); err == nil {
+		return TransportTCP
+	}
+
+	return TransportOther
+}
+
+// addressRank returns the rank of the network and the transport of the given address (lower ranks are advertised first).
+func addressRank(address multiaddr.Multiaddr) (networkRank int, transportRank int) {
+	networkRank, transportRank = 3, 2
+
+	multiaddr.ForEach(address, func(component multiaddr.Component) bool {
+		switch component.Protocol().Code {
+		case multiaddr.P_IP4:
+			networkRank = 0
+		case multiaddr.P_IP6:
+			networkRank = 1
+		case multiaddr.P_DNS, multiaddr.P_DNS4, multiaddr.P_DNS6:
+			networkRank = 2
+		case multiaddr.P_TCP:
+			transportRank = 0
+		case multiaddr.P_QUIC_V1:
+			transportRank = 1
+		}
+
+		return true
+	})
+
+	return networkRank, transportRank
+}
@@ -0,0 +1,78 @@
+package p2p
+
+import (
+	"testing"
+
+	"github.com/multiformats/go-multiaddr"
+	"github.com/stretchr/testify/require"
+)
+
+func TestAdvertisedAddresses(t *testing.T) {
+	hostAddresses := []multiaddr.Multiaddr{
+		multiaddr.StringCast("/ip6/::1/udp/14666/quic-v1"),
+		multiaddr.StringCast("/ip4/127.0.0.1/udp/14666/quic-v1"),
+		multiaddr.StringCast("/ip6/::1/tcp/14666"),
+		multiaddr.StringCast("/ip4/127.0.0.1/tcp/14666"),
+	}
+
+	externalAddresses := []multiaddr.Multiaddr{
+		multiaddr.StringCast("/dns/node.example.com/tcp/14666"),
+		multiaddr.StringCast("/ip4/127.0.0.1/tcp/14666"),
+		multiaddr.StringCast("/ip4/1.2.3.4/tcp/14666"),
+	}
+
+	expected := []string{
+		"/ip4/1.2.3.4/tcp/14666",
+		"/ip4/127.0.0.1/tcp/14666",
+		"/ip4/127.0.0.1/udp/14666/quic-v1",
+		"/ip6/::1/tcp/14666",
+		"/ip6/::1/udp/14666/quic-v1",
+		"/dns/node.example.com/tcp/14666",
+	}
+
+	require.Equal(t, expected, addressStrings(AdvertisedAddresses(hostAddresses, externalAddresses)))
+
+	// the order of the listeners must not change the advertised addresses.
+	reversedHostAddresses := make([]multiaddr.Multiaddr, len(hostAddresses))
+	for i, address := range hostAddresses {
+		reversedHostAddresses[len(hostAddresses)-1-i] = address
+	}
+	require.Equal(t, expected, addressStrings(AdvertisedAddresses(reversedHostAddresses, externalAddresses)))
+}
+
+func TestUsesQUIC(t *testing.T) {
+	require.False(t, UsesQUIC([]multiaddr.Multiaddr{multiaddr.StringCast("/ip4/0.0.0.0/tcp/14666")}))
+	require.True(t, UsesQUIC([]multiaddr.Multiaddr{
+		multiaddr.StringCast("/ip4/0.0.0.0/tcp/14666"),
+		multiaddr.StringCast("/ip4/0.0.0.0/udp/14666/quic-v1"),
+	}))
+}
+
+func addressStrings(addresses []multiaddr.Multiaddr) []string {
+	result := make([]string, len(addresses))
+	for i, address := range addresses {
+		result[i] = address.String()
+	}
+
+	return result
+}
+
+func TestQUICAddresses(t *testing.T) {
+	tcpAddresses := []multiaddr.Multiaddr{
+		multiaddr.StringCast("/ip4/0.0.0.0/tcp/14666"),
+		multiaddr.StringCast("/ip6/::/tcp/14667"),
+		multiaddr.StringCast("/ip4/0.0.0.0/udp/14666/quic-v1"),
+	}
+
+	require.Equal(t, []string{
+		"/ip4/0.0.0.0/udp/14666/quic-v1",
+		"/ip6/::/udp/14667/quic-v1",
+	}, addressStrings(QUICAddresses(tcpAddresses)))
+}
+
+func TestTransportName(t *testing.T) {
+	require.Equal(t, TransportTCP, TransportName(multiaddr.StringCast("/ip4/127.0.0.1/tcp/14666")))
+	require.Equal(t, TransportQUIC, TransportName(multiaddr.StringCast("/ip6/::1/udp/14666/quic-v1")))
+	require.Equal(t, TransportOther, TransportName(multiaddr.StringCast("/ip4/127.0.0.1/udp/14666")))
+	require.Equal(t, TransportOther, TransportName(nil))
+}
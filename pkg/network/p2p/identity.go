@@ -0,0 +1,195 @@
+package p2p
+
+import (
+	"context"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/host"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/log"
+	"github.com/iotaledger/hive.go/runtime/event"
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/hive.go/runtime/syncutils"
+	"github.com/iotaledger/iota-core/pkg/network"
+)
+
+// ErrIdentityRotationDisabled is returned when the identity of the node can not be rotated.
+var ErrIdentityRotationDisabled = ierrors.New("identity rotation is disabled")
+
+// HostFactory creates a lib-p2p host that uses the given private key as its identity.
+type HostFactory func(privateKey crypto.PrivKey) (host.Host, error)
+
+// IdentityEvents contains the events of the IdentityManager.
+type IdentityEvents struct {
+	// HostSwitched is triggered after the host of the node was replaced (with the host of the new identity or, if the
+	// rotation failed, with a new host of the previous identity).
+	HostSwitched *event.Event1[host.Host]
+
+	// IdentityRotated is triggered after the node switched to a new identity.
+	IdentityRotated *event.Event1[*network.Identity]
+}
+
+// NewIdentityEvents returns a new instance of IdentityEvents.
+func NewIdentityEvents() *IdentityEvents {
+	return &IdentityEvents{
+		HostSwitched:    event.New1[host.Host](),
+		IdentityRotated: event.New1[*network.Identity](),
+	}
+}
+
+// IdentityManager keeps track of the identity of the node, persists its metadata in the peer database and allows to
+// rotate it at runtime.
+type IdentityManager struct {
+	Events *IdentityEvents
+
+	p2pManager      *Manager
+	peerDB          *network.DB
+	privateKey      crypto.PrivKey
+	hostFactory     HostFactory
+	storePrivateKey func(crypto.PrivKey) error
+	logger          log.Logger
+	mutex           syncutils.Mutex
+
+	optsRotationEnabled bool
+}
+
+// NewIdentityManager creates a new IdentityManager for the given active private key. The host factory is used to
+// create the host of a rotated identity and the store function persists its private key.
+func NewIdentityManager(p2pManager *Manager, peerDB *network.DB, privateKey crypto.PrivKey, hostFactory HostFactory, storePrivateKey func(crypto.PrivKey) error, logger log.Logger, opts ...options.Option[IdentityManager]) *IdentityManager {
+	return options.Apply(&IdentityManager{
+		Events:              NewIdentityEvents(),
+		p2pManager:          p2pManager,
+		peerDB:              peerDB,
+		privateKey:          privateKey,
+		hostFactory:         hostFactory,
+		storePrivateKey:     storePrivateKey,
+		logger:              logger,
+		optsRotationEnabled: true,
+	}, opts)
+}
+
+// Identity returns the metadata of the active identity.
+func (i *IdentityManager) Identity() (*network.Identity, error) {
+	return i.peerDB.Identity()
+}
+
+// StoreActiveIdentity persists the metadata of the identity of the active host. The activation time and the previous
+// identity are kept if the identity did not change since the metadata was stored the last time.
+func (i *IdentityManager) StoreActiveIdentity() (*network.Identity, error) {
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	activeHost := i.p2pManager.P2PHost()
+
+	identity := &network.Identity{
+		PeerID:              activeHost.ID(),
+		ActivatedAt:         time.Now(),
+		AdvertisedAddresses: activeHost.Addrs(),
+	}
+
+	storedIdentity, err := i.peerDB.Identity()
+	switch {
+	case err == nil && storedIdentity.PeerID == identity.PeerID:
+		identity.PreviousPeerID = storedIdentity.PreviousPeerID
+		identity.ActivatedAt = storedIdentity.ActivatedAt
+	case err == nil:
+		// the identity was replaced while the node was offline.
+		identity.PreviousPeerID = storedIdentity.PeerID
+	case !ierrors.Is(err, kvstore.ErrKeyNotFound):
+		return nil, ierrors.Wrap(err, "failed to load stored identity")
+	}
+
+	if err := i.peerDB.StoreIdentity(identity); err != nil {
+		return nil, ierrors.Wrap(err, "failed to store identity")
+	}
+
+	return identity, nil
+}
+
+// Rotate switches the node to a newly generated identity. The neighbors of the previous identity are dropped and
+// dialed again with the new identity, so that they re-handshake with the new peer ID. If the host of the new identity
+// can not be created, the node falls back to the previous identity.
+func (i *IdentityManager) Rotate(ctx context.Context) (*network.Identity, error) {
+	if !i.optsRotationEnabled {
+		return nil, ErrIdentityRotationDisabled
+	}
+
+	i.mutex.Lock()
+	defer i.mutex.Unlock()
+
+	privateKey, _, err := crypto.GenerateEd25519Key(nil)
+	if err != nil {
+		return nil, ierrors.Wrap(err, "failed to generate private key")
+	}
+
+	previousPeerID := i.p2pManager.LocalPeerID()
+
+	var newHostErr error
+	droppedPeers, err := i.p2pManager.SwitchHost(func() (host.Host, error) {
+		newHost, err := i.hostFactory(privateKey)
+		if err == nil {
+			return newHost, nil
+		}
+
+		newHostErr = err
+
+		return i.hostFactory(i.privateKey)
+	})
+	if err != nil {
+		return nil, ierrors.Wrap(err, "failed to restore the previous identity after the rotation failed")
+	}
+
+	defer i.rehandshake(ctx, droppedPeers)
+
+	i.Events.HostSwitched.Trigger(i.p2pManager.P2PHost())
+
+	if newHostErr != nil {
+		return nil, ierrors.Wrap(newHostErr, "failed to create host for the new identity, the previous identity was restored")
+	}
+
+	i.privateKey = privateKey
+	newHost := i.p2pManager.P2PHost()
+
+	identity := &network.Identity{
+		PeerID:              newHost.ID(),
+		PreviousPeerID:      previousPeerID,
+		ActivatedAt:         time.Now(),
+		AdvertisedAddresses: newHost.Addrs(),
+	}
+
+	// the new identity is active from now on, so the events are triggered even if it can not be persisted.
+	defer i.Events.IdentityRotated.Trigger(identity)
+
+	if err := i.storePrivateKey(privateKey); err != nil {
+		return identity, ierrors.Wrap(err, "failed to store the private key of the new identity, the previous identity will be used after a restart")
+	}
+
+	if err := i.peerDB.StoreIdentity(identity); err != nil {
+		return identity, ierrors.Wrap(err, "failed to store the new identity")
+	}
+
+	return identity, nil
+}
+
+// rehandshake dials the given peers with the active identity.
+func (i *IdentityManager) rehandshake(ctx context.Context, peers []*network.Peer) {
+	for _, peer := range peers {
+		if len(peer.PeerAddresses) == 0 {
+			continue
+		}
+
+		if err := i.p2pManager.DialPeer(ctx, peer); err != nil && !ierrors.Is(err, ErrDuplicateNeighbor) {
+			i.logger.LogWarnf("failed to re-handshake with peer %s after identity rotation: %s", peer.ID, err)
+		}
+	}
+}
+
+// WithRotationEnabled sets whether the identity of the node can be rotated.
+func WithRotationEnabled(enabled bool) options.Option[IdentityManager] {
+	return func(i *IdentityManager) {
+		i.optsRotationEnabled = enabled
+	}
+}
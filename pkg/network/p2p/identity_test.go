@@ -0,0 +1,77 @@
+package p2p
+
+import (
+	"context"
+	"testing"
+
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/libp2p/go-libp2p/core/host"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/iota-core/pkg/network"
+)
+
+func TestIdentityManagerRotate(t *testing.T) {
+	hostFactory := func(privateKey crypto.PrivKey) (host.Host, error) {
+		return libp2p.New(libp2p.Identity(privateKey), libp2p.ListenAddrStrings("/ip4/127.0.0.1/tcp/0"))
+	}
+
+	privateKey, _, err := crypto.GenerateEd25519Key(nil)
+	require.NoError(t, err)
+	initialHost := lo.PanicOnErr(hostFactory(privateKey))
+
+	peerDB := network.NewDB(mapdb.NewMapDB())
+	manager := NewManager(initialHost, peerDB, testLogger)
+	defer manager.Shutdown()
+
+	var storedPrivateKey crypto.PrivKey
+	identityManager := NewIdentityManager(manager, peerDB, privateKey, hostFactory, func(privateKey crypto.PrivKey) error {
+		storedPrivateKey = privateKey
+
+		return nil
+	}, testLogger)
+
+	initialIdentity, err := identityManager.StoreActiveIdentity()
+	require.NoError(t, err)
+	require.Equal(t, initialHost.ID(), initialIdentity.PeerID)
+	require.Empty(t, initialIdentity.PreviousPeerID)
+
+	var switchedHost host.Host
+	identityManager.Events.HostSwitched.Hook(func(newHost host.Host) {
+		switchedHost = newHost
+	})
+
+	rotatedIdentity, err := identityManager.Rotate(context.Background())
+	require.NoError(t, err)
+	require.NotEqual(t, initialHost.ID(), rotatedIdentity.PeerID)
+	require.Equal(t, initialHost.ID(), rotatedIdentity.PreviousPeerID)
+	require.Equal(t, rotatedIdentity.PeerID, manager.LocalPeerID())
+	require.Equal(t, manager.P2PHost(), switchedHost)
+	require.Equal(t, rotatedIdentity.PeerID, lo.PanicOnErr(peer.IDFromPrivateKey(storedPrivateKey)))
+
+	storedIdentity, err := identityManager.Identity()
+	require.NoError(t, err)
+	require.Equal(t, rotatedIdentity.PeerID, storedIdentity.PeerID)
+	require.Equal(t, rotatedIdentity.PreviousPeerID, storedIdentity.PreviousPeerID)
+	require.Equal(t, rotatedIdentity.ActivatedAt.UnixNano(), storedIdentity.ActivatedAt.UnixNano())
+	require.Equal(t, rotatedIdentity.AdvertisedAddresses, storedIdentity.AdvertisedAddresses)
+
+	// storing the active identity again (e.g. after a restart) keeps the metadata of the rotation.
+	restoredIdentity, err := identityManager.StoreActiveIdentity()
+	require.NoError(t, err)
+	require.Equal(t, rotatedIdentity.PreviousPeerID, restoredIdentity.PreviousPeerID)
+	require.Equal(t, rotatedIdentity.ActivatedAt.UnixNano(), restoredIdentity.ActivatedAt.UnixNano())
+
+	require.NoError(t, manager.P2PHost().Close())
+}
+
+func TestIdentityManagerRotationDisabled(t *testing.T) {
+	identityManager := NewIdentityManager(nil, nil, nil, nil, nil, testLogger, WithRotationEnabled(false))
+
+	_, err := identityManager.Rotate(context.Background())
+	require.ErrorIs(t, err, ErrIdentityRotationDisabled)
+}
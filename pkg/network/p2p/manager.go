@@ -22,6 +22,9 @@ const (
 	protocolID               = "iota-core/1.0.0"
 	defaultConnectionTimeout = 5 * time.Second // timeout after which the connection must be established.
 	ioTimeout                = 4 * time.Second
+
+	// neighborProtectionTag is the tag that protects the connections to neighbors in the connection manager.
+	neighborProtectionTag = "neighbor"
 )
 
 var (
@@ -68,8 +71,9 @@ func WithNoDefaultTimeout() ConnectPeerOption {
 type Manager struct {
 	Events *NeighborEvents
 
-	libp2pHost host.Host
-	peerDB     *network.DB
+	libp2pHost      host.Host
+	libp2pHostMutex syncutils.RWMutex
+	peerDB          *network.DB
 
 	logger log.Logger
 
@@ -106,7 +110,7 @@ func (m *Manager) RegisterProtocol(factory func() proto.Message, handler func(pe
 		PacketHandler: handler,
 	}
 
-	m.libp2pHost.SetStreamHandler(protocol.ID(protocolID), m.handleStream)
+	m.P2PHost().SetStreamHandler(protocol.ID(protocolID), m.handleStream)
 }
 
 // UnregisterProtocol unregisters the handler for the protocol.
@@ -114,7 +118,7 @@ func (m *Manager) UnregisterProtocol() {
 	m.protocolHandlerMutex.Lock()
 	defer m.protocolHandlerMutex.Unlock()
 
-	m.libp2pHost.RemoveStreamHandler(protocol.ID(protocolID))
+	m.P2PHost().RemoveStreamHandler(protocol.ID(protocolID))
 	m.protocolHandler = nil
 }
 
@@ -134,7 +138,7 @@ func (m *Manager) DialPeer(ctx context.Context, peer *network.Peer, opts ...Conn
 	conf := buildConnectPeerConfig(opts)
 
 	// Adds the peer's multiaddresses to the peerstore, so that they can be used for dialing.
-	m.libp2pHost.Peerstore().AddAddrs(peer.ID, peer.PeerAddresses, peerstore.ConnectedAddrTTL)
+	m.P2PHost().Peerstore().AddAddrs(peer.ID, peer.PeerAddresses, peerstore.ConnectedAddrTTL)
 	cancelCtx := ctx
 	if conf.useDefaultTimeout {
 		var cancel context.CancelFunc
@@ -187,14 +191,58 @@ func (m *Manager) Shutdown() {
 
 // LocalPeerID returns the local peer ID.
 func (m *Manager) LocalPeerID() peer.ID {
-	return m.libp2pHost.ID()
+	return m.P2PHost().ID()
 }
 
 // P2PHost returns the lib-p2p host.
 func (m *Manager) P2PHost() host.Host {
+	m.libp2pHostMutex.RLock()
+	defer m.libp2pHostMutex.RUnlock()
+
 	return m.libp2pHost
 }
 
+// SwitchHost replaces the lib-p2p host of the manager with the host that is created by the given function: the
+// neighbors are dropped and the previous host is closed before the new host is created (so that it can bind to the
+// same addresses), and the protocol handler is moved to the new host. It returns the peers of the dropped neighbors,
+// so that the caller can re-handshake with them using the new host.
+func (m *Manager) SwitchHost(newHostFunc func() (host.Host, error)) (droppedPeers []*network.Peer, err error) {
+	// the neighbors are closed before locking the protocol handler, as their read loops need it to handle packets.
+	neighbors := m.AllNeighbors()
+	droppedPeers = make([]*network.Peer, 0, len(neighbors))
+	for _, nbr := range neighbors {
+		droppedPeers = append(droppedPeers, network.NewPeerFromAddrInfo(nbr.ToAddrInfo()))
+		nbr.Close()
+	}
+
+	m.protocolHandlerMutex.Lock()
+	defer m.protocolHandlerMutex.Unlock()
+
+	previousHost := m.P2PHost()
+	if m.protocolHandler != nil {
+		previousHost.RemoveStreamHandler(protocol.ID(protocolID))
+	}
+
+	if err = previousHost.Close(); err != nil {
+		m.logger.LogWarnf("failed to close previous libp2p host: %s", err)
+	}
+
+	newHost, err := newHostFunc()
+	if err != nil {
+		return droppedPeers, ierrors.Wrap(err, "failed to create new libp2p host")
+	}
+
+	m.libp2pHostMutex.Lock()
+	m.libp2pHost = newHost
+	m.libp2pHostMutex.Unlock()
+
+	if m.protocolHandler != nil {
+		newHost.SetStreamHandler(protocol.ID(protocolID), m.handleStream)
+	}
+
+	return droppedPeers, nil
+}
+
 // DropNeighbor disconnects the neighbor with the given ID and the group.
 func (m *Manager) DropNeighbor(id peer.ID) error {
 	nbr, err := m.neighbor(id)
@@ -320,7 +368,7 @@ func (m *Manager) neighbor(id peer.ID) (*Neighbor, error) {
 }
 
 func (m *Manager) addNeighbor(peer *network.Peer, ps *PacketsStream) error {
-	if peer.ID == m.libp2pHost.ID() {
+	if peer.ID == m.LocalPeerID() {
 		return ierrors.WithStack(ErrLoopbackNeighbor)
 	}
 	m.shutdownMutex.RLock()
@@ -345,6 +393,7 @@ func (m *Manager) addNeighbor(peer *network.Peer, ps *PacketsStream) error {
 			nbr.logger.LogDebugf("Can't handle packet, error: %s", err)
 		}
 	}, func(nbr *Neighbor) {
+		m.P2PHost().ConnManager().Unprotect(nbr.ID, neighborProtectionTag)
 		m.deleteNeighbor(nbr)
 		m.Events.NeighborRemoved.Trigger(nbr)
 	})
@@ -355,6 +404,10 @@ func (m *Manager) addNeighbor(peer *network.Peer, ps *PacketsStream) error {
 
 		return ierrors.WithStack(err)
 	}
+	// the connection manager must not trim the connections to neighbors when the watermarks are exceeded (e.g. because
+	// a peer is connected via TCP and QUIC at the same time).
+	m.P2PHost().ConnManager().Protect(peer.ID, neighborProtectionTag)
+
 	nbr.readLoop()
 	nbr.writeLoop()
 	nbr.logger.LogInfo("Connection established to %s")
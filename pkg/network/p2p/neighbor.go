@@ -2,7 +2,9 @@ package p2p
 
 import (
 	"context"
+	"math"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/libp2p/go-libp2p/core/protocol"
@@ -15,6 +17,15 @@ import (
 
 const (
 	NeighborsSendQueueSize = 20_000
+
+	// scoreMaturityPeriod is the connection age after which a neighbor receives the full score for the age.
+	scoreMaturityPeriod = 10 * time.Minute
+
+	// scoreReferenceLatency is the latency at which a neighbor receives half of the score for the latency.
+	scoreReferenceLatency = 100 * time.Millisecond
+
+	// scoreAgeWeight is the weight of the connection age in the score (the rest is the weight of the latency).
+	scoreAgeWeight = 0.25
 )
 
 type queuedPacket struct {
@@ -44,7 +55,13 @@ type Neighbor struct {
 
 	stream *PacketsStream
 
-	sendQueue chan *queuedPacket
+	sendQueue *sendQueue
+
+	// latency contains the latest latency measurements of the link to the neighbor.
+	latency atomic.Pointer[network.Latency]
+
+	// failedVerifications contains the number of times the data sent by the neighbor failed the proof verification.
+	failedVerifications atomic.Int64
 }
 
 // NewNeighbor creates a new neighbor from the provided peer and connection.
@@ -59,7 +76,7 @@ func NewNeighbor(parentLogger log.Logger, p *network.Peer, stream *PacketsStream
 		loopCtx:            ctx,
 		loopCtxCancel:      cancel,
 		stream:             stream,
-		sendQueue:          make(chan *queuedPacket, NeighborsSendQueueSize),
+		sendQueue:          newSendQueue(NeighborsSendQueueSize),
 	}
 
 	n.logger.LogInfo("created", "ID", n.ID)
@@ -67,11 +84,15 @@ func NewNeighbor(parentLogger log.Logger, p *network.Peer, stream *PacketsStream
 	return n
 }
 
+// Enqueue adds the packet to the send queue of its priority class (see network.PrioritizedPacket).
 func (n *Neighbor) Enqueue(packet proto.Message, protocolID protocol.ID) {
-	select {
-	case n.sendQueue <- &queuedPacket{protocolID: protocolID, packet: packet}:
-	default:
-		n.logger.LogWarn("Dropped packet due to SendQueue being full")
+	priority := network.PacketPriorityGossip
+	if prioritizedPacket, isPrioritized := packet.(network.PrioritizedPacket); isPrioritized {
+		priority = prioritizedPacket.Priority()
+	}
+
+	if !n.sendQueue.Enqueue(&queuedPacket{protocolID: protocolID, packet: packet}, priority) {
+		n.logger.LogWarn("Dropped packet due to SendQueue being full", "priority", priority)
 	}
 }
 
@@ -90,6 +111,52 @@ func (n *Neighbor) ConnectionEstablished() time.Time {
 	return n.stream.Stat().Opened
 }
 
+// Transport returns the name of the transport of the connection to the neighbor (tcp, quic or other).
+func (n *Neighbor) Transport() string {
+	return TransportName(n.stream.Conn().RemoteMultiaddr())
+}
+
+// SetLatency updates the latency measurements of the link to the neighbor.
+func (n *Neighbor) SetLatency(latency network.Latency) {
+	n.latency.Store(&latency)
+}
+
+// Latency returns the latency measurements of the link to the neighbor (if any were made yet).
+func (n *Neighbor) Latency() (latency network.Latency, exists bool) {
+	if latencyPtr := n.latency.Load(); latencyPtr != nil {
+		return *latencyPtr, true
+	}
+
+	return network.Latency{}, false
+}
+
+// SetFailedVerifications updates the number of times the data sent by the neighbor failed the proof verification.
+func (n *Neighbor) SetFailedVerifications(failedVerifications int) {
+	n.failedVerifications.Store(int64(failedVerifications))
+}
+
+// FailedVerifications returns the number of times the data sent by the neighbor failed the proof verification.
+func (n *Neighbor) FailedVerifications() int {
+	return int(n.failedVerifications.Load())
+}
+
+// Score rates the quality of the link to the neighbor in the range [0, 1] (higher is better). It combines the age of
+// the connection with the round trip time, the jitter and the loss rate of the latency measurements, as the number of
+// exchanged packets alone does not reflect the link quality. Neighbors that sent data that failed the proof
+// verification are penalized.
+func (n *Neighbor) Score() float64 {
+	ageScore := math.Min(float64(time.Since(n.ConnectionEstablished()))/float64(scoreMaturityPeriod), 1)
+
+	// neighbors that were not measured yet are neither rewarded nor penalized.
+	latencyScore := 0.5
+	if latency, exists := n.Latency(); exists && latency.Samples != 0 {
+		effectiveLatency := latency.RTT + 2*latency.Jitter
+		latencyScore = float64(scoreReferenceLatency) / float64(scoreReferenceLatency+effectiveLatency) * (1 - latency.LossRate())
+	}
+
+	return (scoreAgeWeight*ageScore + (1-scoreAgeWeight)*latencyScore) / float64(1+n.FailedVerifications())
+}
+
 func (n *Neighbor) readLoop() {
 	n.wg.Add(1)
 	go func(stream *PacketsStream) {
@@ -126,27 +193,27 @@ func (n *Neighbor) writeLoop() {
 	go func() {
 		defer n.wg.Done()
 		for {
-			select {
-			case <-n.loopCtx.Done():
+			sendPacket, ok := n.sendQueue.Dequeue(n.loopCtx)
+			if !ok {
 				n.logger.LogInfo("Exit writeLoop due to canceled context")
 				return
-			case sendPacket := <-n.sendQueue:
-				if n.stream == nil {
-					n.logger.LogWarnf("send error, no stream for protocol, peerID: %s, protocol: %s", n.ID, sendPacket.protocolID)
-					if disconnectErr := n.disconnect(); disconnectErr != nil {
-						n.logger.LogWarnf("Failed to disconnect, error: %s", disconnectErr)
-					}
-
-					return
+			}
+
+			if n.stream == nil {
+				n.logger.LogWarnf("send error, no stream for protocol, peerID: %s, protocol: %s", n.ID, sendPacket.protocolID)
+				if disconnectErr := n.disconnect(); disconnectErr != nil {
+					n.logger.LogWarnf("Failed to disconnect, error: %s", disconnectErr)
 				}
-				if err := n.stream.WritePacket(sendPacket.packet); err != nil {
-					n.logger.LogWarnf("send error, peerID: %s, error: %s", n.ID, err)
-					if disconnectErr := n.disconnect(); disconnectErr != nil {
-						n.logger.LogWarnf("Failed to disconnect, error: %s", disconnectErr)
-					}
 
-					return
+				return
+			}
+			if err := n.stream.WritePacket(sendPacket.packet); err != nil {
+				n.logger.LogWarnf("send error, peerID: %s, error: %s", n.ID, err)
+				if disconnectErr := n.disconnect(); disconnectErr != nil {
+					n.logger.LogWarnf("Failed to disconnect, error: %s", disconnectErr)
 				}
+
+				return
 			}
 		}
 	}()
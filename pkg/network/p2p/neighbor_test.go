@@ -75,6 +75,65 @@ func TestNeighborWrite(t *testing.T) {
 	assert.Eventually(t, func() bool { return atomic.LoadUint32(&countB) == 1 }, time.Second, 10*time.Millisecond)
 }
 
+func TestSendQueueWeightedDequeue(t *testing.T) {
+	queue := newSendQueue(10)
+
+	enqueuedPackets := make(map[*queuedPacket]network.PacketPriority)
+	for _, priority := range []network.PacketPriority{network.PacketPriorityBulk, network.PacketPriorityGossip, network.PacketPriorityConsensus} {
+		for i := 0; i < 6; i++ {
+			packet := &queuedPacket{packet: testPacket1}
+			enqueuedPackets[packet] = priority
+
+			require.True(t, queue.Enqueue(packet, priority))
+		}
+	}
+
+	dequeuedPriorities := make([]network.PacketPriority, 0, len(enqueuedPackets))
+	for i := 0; i < len(enqueuedPackets); i++ {
+		packet, ok := queue.Dequeue(context.Background())
+		require.True(t, ok)
+
+		dequeuedPriorities = append(dequeuedPriorities, enqueuedPackets[packet])
+	}
+
+	consensus, gossip, bulk := network.PacketPriorityConsensus, network.PacketPriorityGossip, network.PacketPriorityBulk
+	require.Equal(t, []network.PacketPriority{
+		consensus, consensus, consensus, consensus, gossip, gossip, bulk,
+		consensus, consensus, gossip, gossip, bulk,
+		gossip, gossip, bulk,
+		bulk, bulk, bulk,
+	}, dequeuedPriorities)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	_, ok := queue.Dequeue(ctx)
+	require.False(t, ok)
+}
+
+func TestSendQueueFull(t *testing.T) {
+	queue := newSendQueue(1)
+
+	require.True(t, queue.Enqueue(&queuedPacket{packet: testPacket1}, network.PacketPriorityBulk))
+	require.False(t, queue.Enqueue(&queuedPacket{packet: testPacket1}, network.PacketPriorityBulk))
+	require.True(t, queue.Enqueue(&queuedPacket{packet: testPacket1}, network.PacketPriorityConsensus))
+}
+
+func TestNeighborScoreFailedVerifications(t *testing.T) {
+	a, _, teardown := newStreamsPipe(t)
+	defer teardown()
+
+	n := newTestNeighbor("A", a)
+	unpenalizedScore := n.Score()
+
+	n.SetFailedVerifications(1)
+	require.Equal(t, 1, n.FailedVerifications())
+	require.InDelta(t, unpenalizedScore/2, n.Score(), 0.01)
+
+	n.SetFailedVerifications(3)
+	require.InDelta(t, unpenalizedScore/4, n.Score(), 0.01)
+}
+
 func newTestNeighbor(name string, stream p2pnetwork.Stream, packetReceivedFunc ...PacketReceivedFunc) *Neighbor {
 	var packetReceived PacketReceivedFunc
 	if len(packetReceivedFunc) > 0 {
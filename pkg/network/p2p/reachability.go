@@ -0,0 +1,184 @@
+package p2p
+
+import (
+	"context"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/event"
+	"github.com/libp2p/go-libp2p/core/host"
+	p2pnetwork "github.com/libp2p/go-libp2p/core/network"
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/multiformats/go-multiaddr"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/log"
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/hive.go/runtime/syncutils"
+)
+
+// ReachabilityStatus contains the result of the reachability self-test of the node.
+type ReachabilityStatus struct {
+	// PeerID is the ID of the node.
+	PeerID peer.ID
+	// Reachability is the reachability of the node as determined by peers that tried to dial it back.
+	Reachability p2pnetwork.Reachability
+	// NATDeviceTypes contains the type of the NAT device per transport protocol (only meaningful if the node is not
+	// publicly reachable).
+	NATDeviceTypes map[p2pnetwork.NATTransportProtocol]p2pnetwork.NATDeviceType
+	// LastUpdated is the time when the reachability of the node was last updated.
+	LastUpdated time.Time
+	// ListenAddresses are the addresses the node is listening on.
+	ListenAddresses []multiaddr.Multiaddr
+	// AdvertisedAddresses are the addresses the node advertises to its peers (including mapped and observed addresses).
+	AdvertisedAddresses []multiaddr.Multiaddr
+	// PortMapping indicates whether the p2p port is mapped on the router via UPnP/NAT-PMP.
+	PortMapping bool
+	// ReachabilityService indicates whether the node helps other peers to determine their reachability.
+	ReachabilityService bool
+}
+
+// ReachabilityMonitor keeps track of the reachability of the node that is determined by asking peers to dial back
+// (AutoNAT) and of the addresses that were mapped on the router (UPnP/NAT-PMP).
+type ReachabilityMonitor struct {
+	libp2pHost host.Host
+	logger     log.Logger
+
+	reachability   p2pnetwork.Reachability
+	natDeviceTypes map[p2pnetwork.NATTransportProtocol]p2pnetwork.NATDeviceType
+	lastUpdated    time.Time
+	hostSwitched   chan struct{}
+	mutex          syncutils.RWMutex
+
+	optsPortMapping         bool
+	optsReachabilityService bool
+}
+
+// NewReachabilityMonitor creates a new ReachabilityMonitor for the given host.
+func NewReachabilityMonitor(libp2pHost host.Host, logger log.Logger, opts ...options.Option[ReachabilityMonitor]) *ReachabilityMonitor {
+	return options.Apply(&ReachabilityMonitor{
+		libp2pHost:     libp2pHost,
+		logger:         logger,
+		reachability:   p2pnetwork.ReachabilityUnknown,
+		natDeviceTypes: make(map[p2pnetwork.NATTransportProtocol]p2pnetwork.NATDeviceType),
+		hostSwitched:   make(chan struct{}, 1),
+	}, opts)
+}
+
+// Run tracks the reachability events of the host until the given context is done.
+func (r *ReachabilityMonitor) Run(ctx context.Context) error {
+	for {
+		if hostSwitched, err := r.trackHost(ctx); err != nil || !hostSwitched {
+			return err
+		}
+	}
+}
+
+// SwitchHost makes the monitor track the given host (e.g. after the identity of the node was rotated). The
+// reachability of the previous host is discarded, as the new host needs to be dialed back by the peers again.
+func (r *ReachabilityMonitor) SwitchHost(newHost host.Host) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.libp2pHost = newHost
+	r.reachability = p2pnetwork.ReachabilityUnknown
+	r.natDeviceTypes = make(map[p2pnetwork.NATTransportProtocol]p2pnetwork.NATDeviceType)
+	r.lastUpdated = time.Now()
+
+	select {
+	case r.hostSwitched <- struct{}{}:
+	default:
+	}
+}
+
+// trackHost tracks the reachability events of the current host until the given context is done or the host is switched.
+func (r *ReachabilityMonitor) trackHost(ctx context.Context) (hostSwitched bool, err error) {
+	r.mutex.RLock()
+	libp2pHost := r.libp2pHost
+	r.mutex.RUnlock()
+
+	subscription, err := libp2pHost.EventBus().Subscribe([]any{
+		new(event.EvtLocalReachabilityChanged),
+		new(event.EvtNATDeviceTypeChanged),
+	})
+	if err != nil {
+		return false, ierrors.Wrap(err, "failed to subscribe to reachability events")
+	}
+	defer subscription.Close()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return false, nil
+		case <-r.hostSwitched:
+			return true, nil
+		case evt, ok := <-subscription.Out():
+			if !ok {
+				// the subscription is closed together with the host, so we wait for the new host to be set.
+				select {
+				case <-ctx.Done():
+					return false, nil
+				case <-r.hostSwitched:
+					return true, nil
+				}
+			}
+
+			r.processEvent(evt)
+		}
+	}
+}
+
+// Status returns the current ReachabilityStatus of the node.
+func (r *ReachabilityMonitor) Status() *ReachabilityStatus {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	natDeviceTypes := make(map[p2pnetwork.NATTransportProtocol]p2pnetwork.NATDeviceType, len(r.natDeviceTypes))
+	for transportProtocol, natDeviceType := range r.natDeviceTypes {
+		natDeviceTypes[transportProtocol] = natDeviceType
+	}
+
+	return &ReachabilityStatus{
+		PeerID:              r.libp2pHost.ID(),
+		Reachability:        r.reachability,
+		NATDeviceTypes:      natDeviceTypes,
+		LastUpdated:         r.lastUpdated,
+		ListenAddresses:     r.libp2pHost.Network().ListenAddresses(),
+		AdvertisedAddresses: r.libp2pHost.Addrs(),
+		PortMapping:         r.optsPortMapping,
+		ReachabilityService: r.optsReachabilityService,
+	}
+}
+
+func (r *ReachabilityMonitor) processEvent(evt any) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	switch typedEvent := evt.(type) {
+	case event.EvtLocalReachabilityChanged:
+		r.reachability = typedEvent.Reachability
+
+		r.logger.LogInfo("reachability changed", "reachability", typedEvent.Reachability)
+	case event.EvtNATDeviceTypeChanged:
+		r.natDeviceTypes[typedEvent.TransportProtocol] = typedEvent.NatDeviceType
+
+		r.logger.LogInfo("NAT device type changed", "transportProtocol", typedEvent.TransportProtocol, "natDeviceType", typedEvent.NatDeviceType)
+	default:
+		return
+	}
+
+	r.lastUpdated = time.Now()
+}
+
+// WithPortMapping sets whether the host of the node maps the p2p port on the router via UPnP/NAT-PMP.
+func WithPortMapping(enabled bool) options.Option[ReachabilityMonitor] {
+	return func(r *ReachabilityMonitor) {
+		r.optsPortMapping = enabled
+	}
+}
+
+// WithReachabilityService sets whether the host of the node helps other peers to determine their reachability.
+func WithReachabilityService(enabled bool) options.Option[ReachabilityMonitor] {
+	return func(r *ReachabilityMonitor) {
+		r.optsReachabilityService = enabled
+	}
+}
@@ -0,0 +1,86 @@
+package p2p
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p"
+	"github.com/libp2p/go-libp2p/core/event"
+	"github.com/libp2p/go-libp2p/core/host"
+	p2pnetwork "github.com/libp2p/go-libp2p/core/network"
+	"github.com/stretchr/testify/require"
+)
+
+func newTestHost(t *testing.T) host.Host {
+	testHost, err := libp2p.New(libp2p.ListenAddrStrings("/ip4/127.0.0.1/tcp/0"))
+	require.NoError(t, err)
+	t.Cleanup(func() { _ = testHost.Close() })
+
+	return testHost
+}
+
+func emitReachability(t *testing.T, testHost host.Host, reachability p2pnetwork.Reachability) {
+	emitter, err := testHost.EventBus().Emitter(new(event.EvtLocalReachabilityChanged))
+	require.NoError(t, err)
+	defer emitter.Close()
+
+	require.NoError(t, emitter.Emit(event.EvtLocalReachabilityChanged{Reachability: reachability}))
+}
+
+func TestReachabilityMonitor(t *testing.T) {
+	initialHost := newTestHost(t)
+
+	monitor := NewReachabilityMonitor(initialHost, testLogger, WithPortMapping(true))
+
+	status := monitor.Status()
+	require.Equal(t, initialHost.ID(), status.PeerID)
+	require.Equal(t, p2pnetwork.ReachabilityUnknown, status.Reachability)
+	require.Empty(t, status.NATDeviceTypes)
+	require.NotEmpty(t, status.ListenAddresses)
+	require.True(t, status.PortMapping)
+	require.False(t, status.ReachabilityService)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	runStopped := make(chan error, 1)
+	go func() { runStopped <- monitor.Run(ctx) }()
+
+	// the subscription of the monitor is set up asynchronously, so we emit until the event was processed.
+	require.Eventually(t, func() bool {
+		emitReachability(t, initialHost, p2pnetwork.ReachabilityPrivate)
+
+		return monitor.Status().Reachability == p2pnetwork.ReachabilityPrivate
+	}, 5*time.Second, 10*time.Millisecond)
+
+	monitor.processEvent(event.EvtNATDeviceTypeChanged{
+		TransportProtocol: p2pnetwork.NATTransportTCP,
+		NatDeviceType:     p2pnetwork.NATDeviceTypeSymmetric,
+	})
+	require.Equal(t, map[p2pnetwork.NATTransportProtocol]p2pnetwork.NATDeviceType{
+		p2pnetwork.NATTransportTCP: p2pnetwork.NATDeviceTypeSymmetric,
+	}, monitor.Status().NATDeviceTypes)
+
+	// switching the host discards the reachability of the previous host and tracks the events of the new host.
+	switchedHost := newTestHost(t)
+	monitor.SwitchHost(switchedHost)
+
+	status = monitor.Status()
+	require.Equal(t, switchedHost.ID(), status.PeerID)
+	require.Equal(t, p2pnetwork.ReachabilityUnknown, status.Reachability)
+	require.Empty(t, status.NATDeviceTypes)
+
+	require.Eventually(t, func() bool {
+		emitReachability(t, switchedHost, p2pnetwork.ReachabilityPublic)
+
+		return monitor.Status().Reachability == p2pnetwork.ReachabilityPublic
+	}, 5*time.Second, 10*time.Millisecond)
+
+	cancel()
+
+	select {
+	case err := <-runStopped:
+		require.NoError(t, err)
+	case <-time.After(5 * time.Second):
+		require.FailNow(t, "monitor did not stop after the context was canceled")
+	}
+}
@@ -0,0 +1,104 @@
+package p2p
+
+import (
+	"context"
+
+	"github.com/iotaledger/hive.go/ds/types"
+	"github.com/iotaledger/iota-core/pkg/network"
+)
+
+// packetPriorityWeights defines how many packets of each priority class are sent per round of the weighted dequeuing,
+// so that bulk data can not starve the more important packets (and vice versa).
+var packetPriorityWeights = [network.PacketPriorityCount]int{
+	network.PacketPriorityConsensus: 4,
+	network.PacketPriorityGossip:    2,
+	network.PacketPriorityBulk:      1,
+}
+
+// sendQueue is the send queue of a neighbor that keeps a separate FIFO queue per priority class and dequeues them in
+// a weighted round-robin fashion.
+type sendQueue struct {
+	// queues contains the FIFO queues of the priority classes.
+	queues [network.PacketPriorityCount]chan *queuedPacket
+
+	// packetEnqueued is used to wake up a waiting Dequeue call when a new packet was enqueued.
+	packetEnqueued chan types.Empty
+
+	// credits contains the number of packets that can still be dequeued per priority class in the current round.
+	credits [network.PacketPriorityCount]int
+}
+
+// newSendQueue creates a new sendQueue that holds up to size packets per priority class.
+func newSendQueue(size int) *sendQueue {
+	s := &sendQueue{
+		packetEnqueued: make(chan types.Empty, 1),
+		credits:        packetPriorityWeights,
+	}
+
+	for priority := range s.queues {
+		s.queues[priority] = make(chan *queuedPacket, size)
+	}
+
+	return s
+}
+
+// Enqueue adds the packet to the queue of the given priority class and returns false if the queue is full.
+func (s *sendQueue) Enqueue(packet *queuedPacket, priority network.PacketPriority) bool {
+	if priority >= network.PacketPriorityCount {
+		priority = network.PacketPriorityGossip
+	}
+
+	select {
+	case s.queues[priority] <- packet:
+	default:
+		return false
+	}
+
+	select {
+	case s.packetEnqueued <- types.Void:
+	default:
+	}
+
+	return true
+}
+
+// Dequeue blocks until the next packet is available (or the context is canceled) and returns it.
+//
+// Dequeue must only be called from a single goroutine.
+func (s *sendQueue) Dequeue(ctx context.Context) (packet *queuedPacket, ok bool) {
+	for {
+		if packet, ok = s.next(); ok {
+			return packet, true
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, false
+		case <-s.packetEnqueued:
+		}
+	}
+}
+
+// next returns the next packet according to the remaining credits of the priority classes without blocking.
+func (s *sendQueue) next() (*queuedPacket, bool) {
+	// the second pass refills the credits if all queues with remaining credits are empty
+	for pass := 0; pass < 2; pass++ {
+		for priority := range s.queues {
+			if s.credits[priority] == 0 {
+				continue
+			}
+
+			select {
+			case packet := <-s.queues[priority]:
+				s.credits[priority]--
+
+				return packet, true
+			default:
+			}
+		}
+
+		s.credits = packetPriorityWeights
+	}
+
+	return nil, false
+}
@@ -0,0 +1,39 @@
+package network
+
+// PacketPriority is the priority class of a packet that determines how it is scheduled in the send queue of a neighbor.
+type PacketPriority uint8
+
+const (
+	// PacketPriorityConsensus is the priority of packets that are critical for the consensus (e.g. commitments and
+	// attestations).
+	PacketPriorityConsensus PacketPriority = iota
+
+	// PacketPriorityGossip is the priority of packets that are gossiped (e.g. blocks and block requests).
+	PacketPriorityGossip
+
+	// PacketPriorityBulk is the priority of packets that transfer bulk data (e.g. warp sync).
+	PacketPriorityBulk
+
+	// PacketPriorityCount is the number of priority classes.
+	PacketPriorityCount
+)
+
+// String returns a human-readable representation of the PacketPriority.
+func (p PacketPriority) String() string {
+	switch p {
+	case PacketPriorityConsensus:
+		return "consensus"
+	case PacketPriorityGossip:
+		return "gossip"
+	case PacketPriorityBulk:
+		return "bulk"
+	default:
+		return "unknown"
+	}
+}
+
+// PrioritizedPacket is implemented by packets that define their own priority class (packets that do not implement it
+// are sent with PacketPriorityGossip).
+type PrioritizedPacket interface {
+	Priority() PacketPriority
+}
@@ -33,6 +33,8 @@ const (
 	dbNodePrefix = "n:" // Identifier to prefix node entries with
 
 	dbNodeUpdated = "updated"
+
+	dbIdentity = "identity" // Key of the metadata of the active identity of the node
 )
 
 // NewDB creates a new peer database.
@@ -76,6 +78,30 @@ func (db *DB) Peer(id peer.ID) (*Peer, error) {
 	return peerFromBytes(data)
 }
 
+// StoreIdentity stores the metadata of the active identity of the node.
+func (db *DB) StoreIdentity(identity *Identity) error {
+	data, err := identity.Bytes()
+	if err != nil {
+		return err
+	}
+
+	if err := db.store.Set([]byte(dbIdentity), data); err != nil {
+		return err
+	}
+
+	return db.store.Flush()
+}
+
+// Identity retrieves the metadata of the active identity of the node.
+func (db *DB) Identity() (*Identity, error) {
+	data, err := db.store.Get([]byte(dbIdentity))
+	if err != nil {
+		return nil, err
+	}
+
+	return identityFromBytes(data)
+}
+
 // SeedPeers retrieves random nodes to be used as potential bootstrap peers.
 func (db *DB) SeedPeers() []*Peer {
 	return randomSubset(db.getPeers(), seedCount)
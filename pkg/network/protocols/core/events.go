@@ -5,6 +5,7 @@ import (
 
 	"github.com/iotaledger/hive.go/runtime/event"
 	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/network"
 	iotago "github.com/iotaledger/iota.go/v4"
 	"github.com/iotaledger/iota.go/v4/merklehasher"
 )
@@ -16,8 +17,11 @@ type Events struct {
 	SlotCommitmentRequestReceived *event.Event2[iotago.CommitmentID, peer.ID]
 	AttestationsReceived          *event.Event4[*model.Commitment, []*iotago.Attestation, *merklehasher.Proof[iotago.Identifier], peer.ID]
 	AttestationsRequestReceived   *event.Event2[iotago.CommitmentID, peer.ID]
-	WarpSyncRequestReceived       *event.Event2[iotago.CommitmentID, peer.ID]
-	WarpSyncResponseReceived      *event.Event6[iotago.CommitmentID, map[iotago.CommitmentID]iotago.BlockIDs, *merklehasher.Proof[iotago.Identifier], iotago.TransactionIDs, *merklehasher.Proof[iotago.Identifier], peer.ID]
+	WarpSyncRequestReceived       *event.Event3[iotago.CommitmentID, bool, peer.ID]
+	WarpSyncResponseReceived      *event.Event7[iotago.CommitmentID, map[iotago.CommitmentID]iotago.BlockIDs, *merklehasher.Proof[iotago.Identifier], iotago.TransactionIDs, *merklehasher.Proof[iotago.Identifier], *model.SlotStateDiff, peer.ID]
+	PeerLatencyUpdated            *event.Event2[peer.ID, network.Latency]
+	RequestDropped                *event.Event2[RequestType, peer.ID]
+	OutgoingRequestDropped        *event.Event1[RequestType]
 	Error                         *event.Event2[error, peer.ID]
 
 	event.Group[Events, *Events]
@@ -32,8 +36,11 @@ var NewEvents = event.CreateGroupConstructor(func() (newEvents *Events) {
 		SlotCommitmentRequestReceived: event.New2[iotago.CommitmentID, peer.ID](),
 		AttestationsReceived:          event.New4[*model.Commitment, []*iotago.Attestation, *merklehasher.Proof[iotago.Identifier], peer.ID](),
 		AttestationsRequestReceived:   event.New2[iotago.CommitmentID, peer.ID](),
-		WarpSyncRequestReceived:       event.New2[iotago.CommitmentID, peer.ID](),
-		WarpSyncResponseReceived:      event.New6[iotago.CommitmentID, map[iotago.CommitmentID]iotago.BlockIDs, *merklehasher.Proof[iotago.Identifier], iotago.TransactionIDs, *merklehasher.Proof[iotago.Identifier], peer.ID](),
+		WarpSyncRequestReceived:       event.New3[iotago.CommitmentID, bool, peer.ID](),
+		WarpSyncResponseReceived:      event.New7[iotago.CommitmentID, map[iotago.CommitmentID]iotago.BlockIDs, *merklehasher.Proof[iotago.Identifier], iotago.TransactionIDs, *merklehasher.Proof[iotago.Identifier], *model.SlotStateDiff, peer.ID](),
+		PeerLatencyUpdated:            event.New2[peer.ID, network.Latency](),
+		RequestDropped:                event.New2[RequestType, peer.ID](),
+		OutgoingRequestDropped:        event.New1[RequestType](),
 		Error:                         event.New2[error, peer.ID](),
 	}
 })
@@ -0,0 +1,169 @@
+package core
+
+import (
+	"context"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/iotaledger/hive.go/ds"
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/iota-core/pkg/network"
+	nwmodels "github.com/iotaledger/iota-core/pkg/network/protocols/core/models"
+)
+
+// maxConsecutiveLostPongs is the number of consecutive unanswered pings after which the latency of a peer is forgotten.
+const maxConsecutiveLostPongs = 3
+
+// pendingPing is a ping that was sent to the neighbors and that is waiting for their pongs.
+type pendingPing struct {
+	// sentTime is the time the ping was sent.
+	sentTime time.Time
+
+	// respondedPeers contains the peers that already answered the ping.
+	respondedPeers ds.Set[peer.ID]
+}
+
+// Ping sends a ping to the given peers (or all neighbors if no peer is given) to measure the round trip time of the
+// links to them.
+func (p *Protocol) Ping(to ...peer.ID) {
+	p.latencyMutex.Lock()
+	p.lastPingNonce++
+	nonce := p.lastPingNonce
+	p.pendingPings[nonce] = &pendingPing{
+		sentTime:       time.Now(),
+		respondedPeers: ds.NewSet[peer.ID](),
+	}
+	p.latencyMutex.Unlock()
+
+	p.network.Send(&nwmodels.Packet{Body: &nwmodels.Packet_Ping{Ping: &nwmodels.Ping{
+		Nonce: nonce,
+	}}}, to...)
+}
+
+// PeerLatency returns the latency of the link to the given peer.
+func (p *Protocol) PeerLatency(id peer.ID) (latency network.Latency, exists bool) {
+	p.latencyMutex.RLock()
+	defer p.latencyMutex.RUnlock()
+
+	latency, exists = p.peerLatencies[id]
+
+	return latency, exists
+}
+
+// PeerLatencies returns the latencies of the links to all peers that answered a ping.
+func (p *Protocol) PeerLatencies() map[peer.ID]network.Latency {
+	p.latencyMutex.RLock()
+	defer p.latencyMutex.RUnlock()
+
+	latencies := make(map[peer.ID]network.Latency, len(p.peerLatencies))
+	for id, latency := range p.peerLatencies {
+		latencies[id] = latency
+	}
+
+	return latencies
+}
+
+// OnPeerLatencyUpdated registers a callback that is triggered when the latency of the link to a peer was updated.
+func (p *Protocol) OnPeerLatencyUpdated(callback func(id peer.ID, latency network.Latency)) (unsubscribe func()) {
+	return p.Events.PeerLatencyUpdated.Hook(callback).Unhook
+}
+
+// startPingLoop starts to periodically ping all neighbors if a ping interval was configured.
+func (p *Protocol) startPingLoop() {
+	if p.optsPingInterval <= 0 {
+		return
+	}
+
+	ctx, cancel := context.WithCancel(context.Background())
+	p.shutdown.OnTrigger(cancel)
+
+	go func() {
+		ticker := time.NewTicker(p.optsPingInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				p.expirePendingPings()
+				p.Ping()
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// onPing answers a ping of a neighbor. It is answered immediately to not distort the measurement of the neighbor.
+func (p *Protocol) onPing(nonce uint64, id peer.ID) {
+	p.network.Send(&nwmodels.Packet{Body: &nwmodels.Packet_Pong{Pong: &nwmodels.Pong{
+		Nonce: nonce,
+	}}}, id)
+}
+
+// onPong updates the latency of a neighbor with the round trip time of one of our pings.
+func (p *Protocol) onPong(nonce uint64, id peer.ID) {
+	receivedTime := time.Now()
+
+	p.latencyMutex.Lock()
+	ping, exists := p.pendingPings[nonce]
+	if !exists || !ping.respondedPeers.Add(id) {
+		p.latencyMutex.Unlock()
+
+		return
+	}
+
+	latency := p.peerLatencies[id].WithSample(receivedTime.Sub(ping.sentTime), receivedTime)
+	p.peerLatencies[id] = latency
+	p.latencyMutex.Unlock()
+
+	p.workerPool.Submit(func() { p.Events.PeerLatencyUpdated.Trigger(id, latency) })
+}
+
+// expirePendingPings removes the pings that were not answered within the ping timeout and accounts them as lost for
+// the peers that did not respond.
+func (p *Protocol) expirePendingPings() {
+	updatedLatencies := make(map[peer.ID]network.Latency)
+
+	p.latencyMutex.Lock()
+	for nonce, ping := range p.pendingPings {
+		if time.Since(ping.sentTime) < p.optsPingTimeout {
+			continue
+		}
+
+		delete(p.pendingPings, nonce)
+
+		for id, latency := range p.peerLatencies {
+			if ping.respondedPeers.Has(id) {
+				continue
+			}
+
+			if latency = latency.WithLoss(); latency.ConsecutiveLost >= maxConsecutiveLostPongs {
+				delete(p.peerLatencies, id)
+			} else {
+				p.peerLatencies[id] = latency
+			}
+
+			updatedLatencies[id] = latency
+		}
+	}
+	p.latencyMutex.Unlock()
+
+	for id, latency := range updatedLatencies {
+		p.Events.PeerLatencyUpdated.Trigger(id, latency)
+	}
+}
+
+// WithPingInterval sets the interval in which the neighbors are pinged to measure the latency (0 = disabled).
+func WithPingInterval(interval time.Duration) options.Option[Protocol] {
+	return func(p *Protocol) {
+		p.optsPingInterval = interval
+	}
+}
+
+// WithPingTimeout sets the duration after which an unanswered ping is considered to be lost.
+func WithPingTimeout(timeout time.Duration) options.Option[Protocol] {
+	return func(p *Protocol) {
+		p.optsPingTimeout = timeout
+	}
+}
@@ -0,0 +1,149 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/protobuf/proto"
+
+	"github.com/iotaledger/hive.go/runtime/workerpool"
+	"github.com/iotaledger/iota-core/pkg/network"
+	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/tpkg"
+)
+
+// loopbackEndpoint is a network.Endpoint that delivers the packets to the connected endpoints in the same process.
+type loopbackEndpoint struct {
+	id        peer.ID
+	neighbors map[peer.ID]*loopbackEndpoint
+	handler   func(peer.ID, proto.Message) error
+
+	// dropPackets defines whether sent packets are silently dropped.
+	dropPackets bool
+}
+
+func newLoopbackEndpoint(id peer.ID) *loopbackEndpoint {
+	return &loopbackEndpoint{
+		id:        id,
+		neighbors: make(map[peer.ID]*loopbackEndpoint),
+	}
+}
+
+func (e *loopbackEndpoint) connect(other *loopbackEndpoint) {
+	e.neighbors[other.id] = other
+	other.neighbors[e.id] = e
+}
+
+func (e *loopbackEndpoint) LocalPeerID() peer.ID {
+	return e.id
+}
+
+func (e *loopbackEndpoint) AllNeighborsIDs() []peer.ID {
+	neighborIDs := make([]peer.ID, 0, len(e.neighbors))
+	for id := range e.neighbors {
+		neighborIDs = append(neighborIDs, id)
+	}
+
+	return neighborIDs
+}
+
+func (e *loopbackEndpoint) RegisterProtocol(_ func() proto.Message, handler func(peer.ID, proto.Message) error) {
+	e.handler = handler
+}
+
+func (e *loopbackEndpoint) UnregisterProtocol() {
+	e.handler = nil
+}
+
+func (e *loopbackEndpoint) Send(packet proto.Message, to ...peer.ID) {
+	if e.dropPackets {
+		return
+	}
+
+	if len(to) == 0 {
+		for id := range e.neighbors {
+			to = append(to, id)
+		}
+	}
+
+	for _, id := range to {
+		if neighbor, exists := e.neighbors[id]; exists && neighbor.handler != nil {
+			_ = neighbor.handler(e.id, packet)
+		}
+	}
+}
+
+func (e *loopbackEndpoint) Shutdown() {}
+
+func newTestProtocol(t *testing.T, endpoint *loopbackEndpoint) *Protocol {
+	p := NewProtocol(endpoint, workerpool.New(t.Name()+string(endpoint.id)).Start(), iotago.SingleVersionProvider(tpkg.ZeroCostTestAPI), WithPingTimeout(0))
+	t.Cleanup(p.Shutdown)
+
+	return p
+}
+
+func TestProtocol_Ping(t *testing.T) {
+	endpointA, endpointB, endpointC := newLoopbackEndpoint("A"), newLoopbackEndpoint("B"), newLoopbackEndpoint("C")
+	endpointA.connect(endpointB)
+	endpointA.connect(endpointC)
+
+	protocolA := newTestProtocol(t, endpointA)
+	newTestProtocol(t, endpointB)
+	newTestProtocol(t, endpointC)
+
+	updatedLatencies := make(chan peer.ID, 10)
+	protocolA.OnPeerLatencyUpdated(func(id peer.ID, _ network.Latency) { updatedLatencies <- id })
+
+	protocolA.Ping()
+	require.ElementsMatch(t, []peer.ID{"B", "C"}, []peer.ID{<-updatedLatencies, <-updatedLatencies})
+
+	for _, id := range []peer.ID{"B", "C"} {
+		latency, exists := protocolA.PeerLatency(id)
+		require.True(t, exists)
+		require.EqualValues(t, 1, latency.Samples)
+		require.Equal(t, latency.LastRTT, latency.RTT)
+	}
+	require.Len(t, protocolA.PeerLatencies(), 2)
+
+	// C stops answering, so its pings are accounted as lost until it is forgotten.
+	endpointC.dropPackets = true
+	for i := 1; i <= maxConsecutiveLostPongs; i++ {
+		protocolA.Ping()
+		require.Equal(t, peer.ID("B"), <-updatedLatencies)
+
+		protocolA.expirePendingPings()
+		require.Equal(t, peer.ID("C"), <-updatedLatencies)
+
+		latencyB, exists := protocolA.PeerLatency("B")
+		require.True(t, exists)
+		require.EqualValues(t, 1+i, latencyB.Samples)
+		require.Zero(t, latencyB.Lost)
+
+		latencyC, exists := protocolA.PeerLatency("C")
+		require.Equal(t, i < maxConsecutiveLostPongs, exists)
+		if exists {
+			require.EqualValues(t, i, latencyC.ConsecutiveLost)
+			require.InDelta(t, float64(i)/float64(1+i), latencyC.LossRate(), 0.0001)
+		}
+	}
+	require.Len(t, protocolA.PeerLatencies(), 1)
+}
+
+func TestLatency_WithSample(t *testing.T) {
+	now := time.Now()
+
+	latency := network.Latency{}.WithSample(100*time.Millisecond, now)
+	require.Equal(t, 100*time.Millisecond, latency.RTT)
+	require.Zero(t, latency.Jitter)
+
+	latency = latency.WithLoss().WithSample(180*time.Millisecond, now.Add(time.Second))
+	require.Equal(t, 110*time.Millisecond, latency.RTT)
+	require.Equal(t, 5*time.Millisecond, latency.Jitter)
+	require.Equal(t, 180*time.Millisecond, latency.LastRTT)
+	require.EqualValues(t, 2, latency.Samples)
+	require.EqualValues(t, 1, latency.Lost)
+	require.Zero(t, latency.ConsecutiveLost)
+	require.Equal(t, now.Add(time.Second), latency.LastUpdated)
+}
@@ -0,0 +1,17 @@
+package models
+
+import (
+	"github.com/iotaledger/iota-core/pkg/network"
+)
+
+// Priority returns the priority class of the packet that is used to schedule it in the send queues of the neighbors.
+func (m *Packet) Priority() network.PacketPriority {
+	switch m.GetBody().(type) {
+	case *Packet_SlotCommitment, *Packet_SlotCommitmentRequest, *Packet_Attestations, *Packet_AttestationsRequest, *Packet_Ping, *Packet_Pong:
+		return network.PacketPriorityConsensus
+	case *Packet_WarpSyncRequest, *Packet_WarpSyncResponse:
+		return network.PacketPriorityBulk
+	default:
+		return network.PacketPriorityGossip
+	}
+}
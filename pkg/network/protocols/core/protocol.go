@@ -1,13 +1,14 @@
 package core
 
 import (
+	"time"
+
 	"github.com/libp2p/go-libp2p/core/peer"
 	"google.golang.org/protobuf/proto"
 
 	"github.com/iotaledger/hive.go/ds/bytesfilter"
 	"github.com/iotaledger/hive.go/ds/reactive"
 	"github.com/iotaledger/hive.go/ds/shrinkingmap"
-	"github.com/iotaledger/hive.go/ds/types"
 	"github.com/iotaledger/hive.go/ierrors"
 	"github.com/iotaledger/hive.go/lo"
 	"github.com/iotaledger/hive.go/runtime/options"
@@ -31,27 +32,81 @@ type Protocol struct {
 	workerPool                *workerpool.WorkerPool
 	duplicateBlockBytesFilter *bytesfilter.BytesFilter[iotago.Identifier]
 
-	requestedBlockHashes      *shrinkingmap.ShrinkingMap[iotago.Identifier, types.Empty]
+	// requestedBlockHashes contains the identifiers of the requested blocks and the time they were requested.
+	requestedBlockHashes      *shrinkingmap.ShrinkingMap[iotago.Identifier, time.Time]
 	requestedBlockHashesMutex syncutils.Mutex
 
+	// incomingRequests limits the number of requests of the neighbors that are processed concurrently.
+	incomingRequests *requestBudget
+
+	// lastPingNonce is the nonce of the latest ping that was sent to the neighbors.
+	lastPingNonce uint64
+
+	// pendingPings contains the pings that are waiting for the pongs of the neighbors.
+	pendingPings map[uint64]*pendingPing
+
+	// peerLatencies contains the latencies of the links to the neighbors that answered a ping.
+	peerLatencies map[peer.ID]network.Latency
+
+	// latencyMutex is used to synchronize access to the ping and latency state.
+	latencyMutex syncutils.RWMutex
+
+	// optsPingInterval is the interval in which the neighbors are pinged (0 = disabled).
+	optsPingInterval time.Duration
+
+	// optsPingTimeout is the duration after which an unanswered ping is considered to be lost.
+	optsPingTimeout time.Duration
+
+	// optsMaxIncomingRequestsPerPeer is the maximum number of requests of a single neighbor that are processed
+	// concurrently (0 = unlimited).
+	optsMaxIncomingRequestsPerPeer int
+
+	// optsMaxIncomingRequests is the maximum number of requests of all neighbors that are processed concurrently
+	// (0 = unlimited).
+	optsMaxIncomingRequests int
+
+	// optsMaxOutgoingBlockRequests is the maximum number of unanswered block requests (0 = unlimited).
+	optsMaxOutgoingBlockRequests int
+
+	// optsBlockRequestTimeout is the duration after which an unanswered block request no longer counts towards the
+	// maximum number of unanswered block requests.
+	optsBlockRequestTimeout time.Duration
+
 	shutdown reactive.Event
 }
 
-func NewProtocol(network network.Endpoint, workerPool *workerpool.WorkerPool, apiProvider iotago.APIProvider, opts ...options.Option[Protocol]) (protocol *Protocol) {
+func NewProtocol(networkEndpoint network.Endpoint, workerPool *workerpool.WorkerPool, apiProvider iotago.APIProvider, opts ...options.Option[Protocol]) (protocol *Protocol) {
 	return options.Apply(&Protocol{
 		Events: NewEvents(),
 
-		network:                   network,
+		network:                   networkEndpoint,
 		workerPool:                workerPool,
 		apiProvider:               apiProvider,
 		duplicateBlockBytesFilter: bytesfilter.New(iotago.IdentifierFromData, 10000),
-		requestedBlockHashes:      shrinkingmap.New[iotago.Identifier, types.Empty](shrinkingmap.WithShrinkingThresholdCount(1000)),
+		requestedBlockHashes:      shrinkingmap.New[iotago.Identifier, time.Time](shrinkingmap.WithShrinkingThresholdCount(1000)),
+		pendingPings:              make(map[uint64]*pendingPing),
+		peerLatencies:             make(map[peer.ID]network.Latency),
 		shutdown:                  reactive.NewEvent(),
+
+		optsPingTimeout:                30 * time.Second,
+		optsMaxIncomingRequestsPerPeer: 100,
+		optsMaxIncomingRequests:        1000,
+		optsMaxOutgoingBlockRequests:   10000,
+		optsBlockRequestTimeout:        time.Minute,
 	}, opts, func(p *Protocol) {
-		network.RegisterProtocol(newPacket, p.handlePacket)
+		p.incomingRequests = newRequestBudget(p.optsMaxIncomingRequestsPerPeer, p.optsMaxIncomingRequests)
+
+		networkEndpoint.RegisterProtocol(newPacket, p.handlePacket)
+
+		p.startPingLoop()
 	})
 }
 
+// Neighbors returns the IDs of the neighbors that are currently connected.
+func (p *Protocol) Neighbors() []peer.ID {
+	return p.network.AllNeighborsIDs()
+}
+
 func (p *Protocol) SendBlock(block *model.Block, to ...peer.ID) {
 	p.network.Send(&nwmodels.Packet{Body: &nwmodels.Packet_Block{Block: &nwmodels.Block{
 		Bytes: block.Data(),
@@ -59,9 +114,11 @@ func (p *Protocol) SendBlock(block *model.Block, to ...peer.ID) {
 }
 
 func (p *Protocol) RequestBlock(id iotago.BlockID, to ...peer.ID) {
-	p.requestedBlockHashesMutex.Lock()
-	p.requestedBlockHashes.Set(id.Identifier(), types.Void)
-	p.requestedBlockHashesMutex.Unlock()
+	if !p.trackBlockRequest(id.Identifier()) {
+		p.Events.OutgoingRequestDropped.Trigger(RequestTypeBlock)
+
+		return
+	}
 
 	p.network.Send(&nwmodels.Packet{Body: &nwmodels.Packet_BlockRequest{BlockRequest: &nwmodels.BlockRequest{
 		BlockId: id[:],
@@ -134,11 +191,11 @@ func (p *Protocol) OnAttestationsRequestReceived(callback func(commitmentID iota
 	return p.Events.AttestationsRequestReceived.Hook(callback).Unhook
 }
 
-func (p *Protocol) OnWarpSyncResponseReceived(callback func(commitmentID iotago.CommitmentID, blockIDs map[iotago.CommitmentID]iotago.BlockIDs, proof *merklehasher.Proof[iotago.Identifier], transactionIDs iotago.TransactionIDs, mutationProof *merklehasher.Proof[iotago.Identifier], src peer.ID)) (unsubscribe func()) {
+func (p *Protocol) OnWarpSyncResponseReceived(callback func(commitmentID iotago.CommitmentID, blockIDs map[iotago.CommitmentID]iotago.BlockIDs, proof *merklehasher.Proof[iotago.Identifier], transactionIDs iotago.TransactionIDs, mutationProof *merklehasher.Proof[iotago.Identifier], stateDiff *model.SlotStateDiff, src peer.ID)) (unsubscribe func()) {
 	return p.Events.WarpSyncResponseReceived.Hook(callback).Unhook
 }
 
-func (p *Protocol) OnWarpSyncRequestReceived(callback func(commitmentID iotago.CommitmentID, src peer.ID)) (unsubscribe func()) {
+func (p *Protocol) OnWarpSyncRequestReceived(callback func(commitmentID iotago.CommitmentID, includeStateDiff bool, src peer.ID)) (unsubscribe func()) {
 	return p.Events.WarpSyncRequestReceived.Hook(callback).Unhook
 }
 
@@ -164,23 +221,27 @@ func (p *Protocol) handlePacket(nbr peer.ID, packet proto.Message) (err error) {
 	case *nwmodels.Packet_Block:
 		p.workerPool.Submit(func() { p.onBlock(packetBody.Block.GetBytes(), nbr) })
 	case *nwmodels.Packet_BlockRequest:
-		p.workerPool.Submit(func() { p.onBlockRequest(packetBody.BlockRequest.GetBlockId(), nbr) })
+		p.submitRequest(RequestTypeBlock, nbr, func() { p.onBlockRequest(packetBody.BlockRequest.GetBlockId(), nbr) })
 	case *nwmodels.Packet_SlotCommitment:
 		p.workerPool.Submit(func() { p.onSlotCommitment(packetBody.SlotCommitment.GetBytes(), nbr) })
 	case *nwmodels.Packet_SlotCommitmentRequest:
-		p.workerPool.Submit(func() { p.onSlotCommitmentRequest(packetBody.SlotCommitmentRequest.GetCommitmentId(), nbr) })
+		p.submitRequest(RequestTypeCommitment, nbr, func() { p.onSlotCommitmentRequest(packetBody.SlotCommitmentRequest.GetCommitmentId(), nbr) })
 	case *nwmodels.Packet_Attestations:
 		p.workerPool.Submit(func() {
 			p.onAttestations(packetBody.Attestations.GetCommitment(), packetBody.Attestations.GetAttestations(), packetBody.Attestations.GetMerkleProof(), nbr)
 		})
 	case *nwmodels.Packet_AttestationsRequest:
-		p.workerPool.Submit(func() {
+		p.submitRequest(RequestTypeAttestations, nbr, func() {
 			p.onAttestationsRequest(packetBody.AttestationsRequest.GetCommitmentId(), nbr)
 		})
 	case *nwmodels.Packet_WarpSyncRequest:
-		p.handleWarpSyncRequest(packetBody.WarpSyncRequest.GetCommitmentId(), nbr)
+		p.handleWarpSyncRequest(packetBody.WarpSyncRequest.GetCommitmentId(), packetBody.WarpSyncRequest.GetIncludeStateDiff(), nbr)
 	case *nwmodels.Packet_WarpSyncResponse:
 		p.handleWarpSyncResponse(packetBody.WarpSyncResponse.GetCommitmentId(), packetBody.WarpSyncResponse.GetPayload(), nbr)
+	case *nwmodels.Packet_Ping:
+		p.onPing(packetBody.Ping.GetNonce(), nbr)
+	case *nwmodels.Packet_Pong:
+		p.onPong(packetBody.Pong.GetNonce(), nbr)
 	default:
 		return ierrors.Errorf("unsupported packet; packet=%+v, packetBody=%T-%+v", packet, packetBody, packetBody)
 	}
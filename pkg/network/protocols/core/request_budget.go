@@ -0,0 +1,170 @@
+package core
+
+import (
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/hive.go/runtime/syncutils"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// RequestType is the type of request that is subject to the request budget.
+type RequestType string
+
+const (
+	// RequestTypeBlock is the type of block requests.
+	RequestTypeBlock RequestType = "block"
+
+	// RequestTypeCommitment is the type of slot commitment requests.
+	RequestTypeCommitment RequestType = "commitment"
+
+	// RequestTypeAttestations is the type of attestations requests.
+	RequestTypeAttestations RequestType = "attestations"
+)
+
+// requestBudget limits the number of requests of the neighbors that are processed concurrently.
+type requestBudget struct {
+	// maxPerPeer is the maximum number of outstanding requests of a single peer (0 = unlimited).
+	maxPerPeer int
+
+	// maxTotal is the maximum number of outstanding requests of all peers (0 = unlimited).
+	maxTotal int
+
+	// outstandingPerPeer contains the number of outstanding requests of each peer.
+	outstandingPerPeer map[peer.ID]int
+
+	// outstandingTotal is the number of outstanding requests of all peers.
+	outstandingTotal int
+
+	// mutex is used to synchronize access to the counters.
+	mutex syncutils.Mutex
+}
+
+// newRequestBudget creates a new requestBudget with the given limits.
+func newRequestBudget(maxPerPeer int, maxTotal int) *requestBudget {
+	return &requestBudget{
+		maxPerPeer:         maxPerPeer,
+		maxTotal:           maxTotal,
+		outstandingPerPeer: make(map[peer.ID]int),
+	}
+}
+
+// acquire reserves a slot for a request of the given peer and returns false if the budget is exhausted.
+func (r *requestBudget) acquire(id peer.ID) bool {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.maxTotal > 0 && r.outstandingTotal >= r.maxTotal {
+		return false
+	}
+
+	if r.maxPerPeer > 0 && r.outstandingPerPeer[id] >= r.maxPerPeer {
+		return false
+	}
+
+	r.outstandingPerPeer[id]++
+	r.outstandingTotal++
+
+	return true
+}
+
+// release frees the slot of a request of the given peer that was processed.
+func (r *requestBudget) release(id peer.ID) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.outstandingPerPeer[id]--; r.outstandingPerPeer[id] <= 0 {
+		delete(r.outstandingPerPeer, id)
+	}
+
+	r.outstandingTotal--
+}
+
+// OnRequestDropped registers a callback that is triggered when a request of a neighbor was dropped because the request
+// budget was exhausted.
+func (p *Protocol) OnRequestDropped(callback func(requestType RequestType, src peer.ID)) (unsubscribe func()) {
+	return p.Events.RequestDropped.Hook(callback).Unhook
+}
+
+// OnOutgoingRequestDropped registers a callback that is triggered when one of our own requests was not sent because
+// too many of them are still unanswered.
+func (p *Protocol) OnOutgoingRequestDropped(callback func(requestType RequestType)) (unsubscribe func()) {
+	return p.Events.OutgoingRequestDropped.Hook(callback).Unhook
+}
+
+// submitRequest processes the request of a neighbor in the worker pool if the request budget allows it and drops it
+// otherwise.
+func (p *Protocol) submitRequest(requestType RequestType, id peer.ID, handler func()) {
+	if !p.incomingRequests.acquire(id) {
+		p.Events.RequestDropped.Trigger(requestType, id)
+
+		return
+	}
+
+	p.workerPool.Submit(func() {
+		defer p.incomingRequests.release(id)
+
+		handler()
+	})
+}
+
+// trackBlockRequest remembers that the block with the given identifier was requested and returns false if too many
+// block requests are still unanswered.
+func (p *Protocol) trackBlockRequest(blockIdentifier iotago.Identifier) bool {
+	p.requestedBlockHashesMutex.Lock()
+	defer p.requestedBlockHashesMutex.Unlock()
+
+	if p.optsMaxOutgoingBlockRequests > 0 && !p.requestedBlockHashes.Has(blockIdentifier) && p.requestedBlockHashes.Size() >= p.optsMaxOutgoingBlockRequests {
+		p.expireBlockRequests()
+
+		if p.requestedBlockHashes.Size() >= p.optsMaxOutgoingBlockRequests {
+			return false
+		}
+	}
+
+	p.requestedBlockHashes.Set(blockIdentifier, time.Now())
+
+	return true
+}
+
+// expireBlockRequests forgets the block requests that were not answered within the block request timeout.
+func (p *Protocol) expireBlockRequests() {
+	for _, blockIdentifier := range p.requestedBlockHashes.Keys() {
+		if requestTime, exists := p.requestedBlockHashes.Get(blockIdentifier); exists && time.Since(requestTime) >= p.optsBlockRequestTimeout {
+			p.requestedBlockHashes.Delete(blockIdentifier)
+		}
+	}
+}
+
+// WithMaxIncomingRequestsPerPeer sets the maximum number of requests of a single neighbor that are processed
+// concurrently (0 = unlimited).
+func WithMaxIncomingRequestsPerPeer(maxRequests int) options.Option[Protocol] {
+	return func(p *Protocol) {
+		p.optsMaxIncomingRequestsPerPeer = maxRequests
+	}
+}
+
+// WithMaxIncomingRequests sets the maximum number of requests of all neighbors that are processed concurrently
+// (0 = unlimited).
+func WithMaxIncomingRequests(maxRequests int) options.Option[Protocol] {
+	return func(p *Protocol) {
+		p.optsMaxIncomingRequests = maxRequests
+	}
+}
+
+// WithMaxOutgoingBlockRequests sets the maximum number of unanswered block requests (0 = unlimited).
+func WithMaxOutgoingBlockRequests(maxRequests int) options.Option[Protocol] {
+	return func(p *Protocol) {
+		p.optsMaxOutgoingBlockRequests = maxRequests
+	}
+}
+
+// WithBlockRequestTimeout sets the duration after which an unanswered block request no longer counts towards the
+// maximum number of unanswered block requests.
+func WithBlockRequestTimeout(timeout time.Duration) options.Option[Protocol] {
+	return func(p *Protocol) {
+		p.optsBlockRequestTimeout = timeout
+	}
+}
@@ -0,0 +1,72 @@
+package core
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/runtime/workerpool"
+	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/tpkg"
+)
+
+func TestRequestBudget(t *testing.T) {
+	budget := newRequestBudget(2, 3)
+
+	require.True(t, budget.acquire("A"))
+	require.True(t, budget.acquire("A"))
+	require.False(t, budget.acquire("A"))
+
+	require.True(t, budget.acquire("B"))
+	require.False(t, budget.acquire("C"))
+
+	budget.release("A")
+	require.True(t, budget.acquire("C"))
+	require.False(t, budget.acquire("B"))
+
+	budget.release("A")
+	budget.release("B")
+	budget.release("C")
+	require.Zero(t, budget.outstandingTotal)
+	require.Empty(t, budget.outstandingPerPeer)
+
+	unlimitedBudget := newRequestBudget(0, 0)
+	for i := 0; i < 100; i++ {
+		require.True(t, unlimitedBudget.acquire("A"))
+	}
+}
+
+func TestProtocol_RequestBlockBudget(t *testing.T) {
+	endpoint := newLoopbackEndpoint("A")
+	endpoint.dropPackets = true
+
+	p := NewProtocol(endpoint, workerpool.New(t.Name()).Start(), iotago.SingleVersionProvider(tpkg.ZeroCostTestAPI), WithMaxOutgoingBlockRequests(2), WithBlockRequestTimeout(time.Hour))
+	t.Cleanup(p.Shutdown)
+
+	droppedRequests := 0
+	p.OnOutgoingRequestDropped(func(requestType RequestType) {
+		require.Equal(t, RequestTypeBlock, requestType)
+
+		droppedRequests++
+	})
+
+	blockIDs := []iotago.BlockID{tpkg.RandBlockID(), tpkg.RandBlockID(), tpkg.RandBlockID()}
+
+	p.RequestBlock(blockIDs[0])
+	p.RequestBlock(blockIDs[1])
+	p.RequestBlock(blockIDs[0])
+	require.Zero(t, droppedRequests)
+
+	p.RequestBlock(blockIDs[2])
+	require.Equal(t, 1, droppedRequests)
+	require.False(t, p.requestedBlockHashes.Has(blockIDs[2].Identifier()))
+
+	// the unanswered requests expire and make room for new requests
+	p.optsBlockRequestTimeout = 0
+
+	p.RequestBlock(blockIDs[2])
+	require.Equal(t, 1, droppedRequests)
+	require.True(t, p.requestedBlockHashes.Has(blockIDs[2].Identifier()))
+	require.Equal(t, 1, p.requestedBlockHashes.Size())
+}
@@ -6,6 +6,7 @@ import (
 	"github.com/iotaledger/hive.go/ierrors"
 	"github.com/iotaledger/hive.go/lo"
 	"github.com/iotaledger/hive.go/serializer/v2/serix"
+	"github.com/iotaledger/iota-core/pkg/model"
 	nwmodels "github.com/iotaledger/iota-core/pkg/network/protocols/core/models"
 	iotago "github.com/iotaledger/iota.go/v4"
 	"github.com/iotaledger/iota.go/v4/merklehasher"
@@ -16,17 +17,19 @@ type WarpSyncPayload struct {
 	TangleMerkleProof          *merklehasher.Proof[iotago.Identifier]  `serix:""`
 	TransactionIDs             iotago.TransactionIDs                   `serix:""`
 	MutationsMerkleProof       *merklehasher.Proof[iotago.Identifier]  `serix:""`
+	StateDiff                  *model.SlotStateDiff                    `serix:",optional"`
 }
 
-func (p *Protocol) SendWarpSyncRequest(id iotago.CommitmentID, to ...peer.ID) {
+func (p *Protocol) SendWarpSyncRequest(id iotago.CommitmentID, includeStateDiff bool, to ...peer.ID) {
 	p.network.Send(&nwmodels.Packet{Body: &nwmodels.Packet_WarpSyncRequest{
 		WarpSyncRequest: &nwmodels.WarpSyncRequest{
-			CommitmentId: lo.PanicOnErr(id.Bytes()),
+			CommitmentId:     lo.PanicOnErr(id.Bytes()),
+			IncludeStateDiff: includeStateDiff,
 		},
 	}}, to...)
 }
 
-func (p *Protocol) SendWarpSyncResponse(id iotago.CommitmentID, blockIDsBySlotCommitmentID map[iotago.CommitmentID]iotago.BlockIDs, tangleMerkleProof *merklehasher.Proof[iotago.Identifier], transactionIDs iotago.TransactionIDs, mutationsMerkleProof *merklehasher.Proof[iotago.Identifier], to ...peer.ID) {
+func (p *Protocol) SendWarpSyncResponse(id iotago.CommitmentID, blockIDsBySlotCommitmentID map[iotago.CommitmentID]iotago.BlockIDs, tangleMerkleProof *merklehasher.Proof[iotago.Identifier], transactionIDs iotago.TransactionIDs, mutationsMerkleProof *merklehasher.Proof[iotago.Identifier], stateDiff *model.SlotStateDiff, to ...peer.ID) {
 	serializer := p.apiProvider.APIForSlot(id.Slot())
 
 	payload := &WarpSyncPayload{
@@ -34,6 +37,7 @@ func (p *Protocol) SendWarpSyncResponse(id iotago.CommitmentID, blockIDsBySlotCo
 		TangleMerkleProof:          tangleMerkleProof,
 		TransactionIDs:             transactionIDs,
 		MutationsMerkleProof:       mutationsMerkleProof,
+		StateDiff:                  stateDiff,
 	}
 
 	p.network.Send(&nwmodels.Packet{Body: &nwmodels.Packet_WarpSyncResponse{
@@ -44,7 +48,7 @@ func (p *Protocol) SendWarpSyncResponse(id iotago.CommitmentID, blockIDsBySlotCo
 	}}, to...)
 }
 
-func (p *Protocol) handleWarpSyncRequest(commitmentIDBytes []byte, id peer.ID) {
+func (p *Protocol) handleWarpSyncRequest(commitmentIDBytes []byte, includeStateDiff bool, id peer.ID) {
 	p.workerPool.Submit(func() {
 		commitmentID, _, err := iotago.CommitmentIDFromBytes(commitmentIDBytes)
 		if err != nil {
@@ -53,7 +57,7 @@ func (p *Protocol) handleWarpSyncRequest(commitmentIDBytes []byte, id peer.ID) {
 			return
 		}
 
-		p.Events.WarpSyncRequestReceived.Trigger(commitmentID, id)
+		p.Events.WarpSyncRequestReceived.Trigger(commitmentID, includeStateDiff, id)
 	})
 }
 
@@ -73,6 +77,6 @@ func (p *Protocol) handleWarpSyncResponse(commitmentIDBytes []byte, payloadBytes
 			return
 		}
 
-		p.Events.WarpSyncResponseReceived.Trigger(commitmentID, payload.BlockIDsBySlotCommitmentID, payload.TangleMerkleProof, payload.TransactionIDs, payload.MutationsMerkleProof, id)
+		p.Events.WarpSyncResponseReceived.Trigger(commitmentID, payload.BlockIDsBySlotCommitmentID, payload.TangleMerkleProof, payload.TransactionIDs, payload.MutationsMerkleProof, payload.StateDiff, id)
 	})
 }
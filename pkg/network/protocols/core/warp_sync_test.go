@@ -0,0 +1,64 @@
+package core
+
+import (
+	"testing"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/iota-core/pkg/model"
+	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/merklehasher"
+	"github.com/iotaledger/iota.go/v4/tpkg"
+)
+
+func TestProtocol_WarpSyncStateDiff(t *testing.T) {
+	endpointA, endpointB := newLoopbackEndpoint("A"), newLoopbackEndpoint("B")
+	endpointA.connect(endpointB)
+
+	protocolA := newTestProtocol(t, endpointA)
+	protocolB := newTestProtocol(t, endpointB)
+
+	type receivedRequest struct {
+		commitmentID     iotago.CommitmentID
+		includeStateDiff bool
+	}
+
+	receivedRequests := make(chan receivedRequest, 2)
+	protocolB.OnWarpSyncRequestReceived(func(commitmentID iotago.CommitmentID, includeStateDiff bool, src peer.ID) {
+		require.Equal(t, peer.ID("A"), src)
+
+		receivedRequests <- receivedRequest{commitmentID, includeStateDiff}
+	})
+
+	receivedStateDiffs := make(chan *model.SlotStateDiff, 2)
+	protocolA.OnWarpSyncResponseReceived(func(_ iotago.CommitmentID, _ map[iotago.CommitmentID]iotago.BlockIDs, _ *merklehasher.Proof[iotago.Identifier], _ iotago.TransactionIDs, _ *merklehasher.Proof[iotago.Identifier], stateDiff *model.SlotStateDiff, src peer.ID) {
+		require.Equal(t, peer.ID("B"), src)
+
+		receivedStateDiffs <- stateDiff
+	})
+
+	commitmentID := tpkg.RandCommitmentID()
+
+	protocolA.SendWarpSyncRequest(commitmentID, true)
+	require.Equal(t, receivedRequest{commitmentID, true}, <-receivedRequests)
+
+	protocolA.SendWarpSyncRequest(commitmentID, false)
+	require.Equal(t, receivedRequest{commitmentID, false}, <-receivedRequests)
+
+	roots := iotago.NewRoots(tpkg.RandIdentifier(), tpkg.RandIdentifier(), tpkg.RandIdentifier(), tpkg.RandIdentifier(), tpkg.RandIdentifier(), tpkg.RandIdentifier(), tpkg.RandIdentifier(), tpkg.RandIdentifier())
+	stateDiff := &model.SlotStateDiff{
+		CreatedOutputIDs:  tpkg.RandOutputIDs(3),
+		ConsumedOutputIDs: tpkg.RandOutputIDs(2),
+		Roots:             roots,
+	}
+
+	protocolB.SendWarpSyncResponse(commitmentID, map[iotago.CommitmentID]iotago.BlockIDs{}, roots.TangleProof(), iotago.TransactionIDs{}, roots.MutationProof(), stateDiff, "A")
+	receivedStateDiff := <-receivedStateDiffs
+	require.Equal(t, stateDiff.CreatedOutputIDs, receivedStateDiff.CreatedOutputIDs)
+	require.Equal(t, stateDiff.ConsumedOutputIDs, receivedStateDiff.ConsumedOutputIDs)
+	require.Equal(t, roots.ID(), receivedStateDiff.Roots.ID())
+
+	protocolB.SendWarpSyncResponse(commitmentID, map[iotago.CommitmentID]iotago.BlockIDs{}, roots.TangleProof(), iotago.TransactionIDs{}, roots.MutationProof(), nil, "A")
+	require.Nil(t, <-receivedStateDiffs)
+}
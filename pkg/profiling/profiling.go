@@ -0,0 +1,161 @@
+package profiling
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"path"
+	"runtime/pprof"
+	"slices"
+	"time"
+
+	"github.com/google/pprof/profile"
+
+	"github.com/iotaledger/hive.go/ds/shrinkingmap"
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/hive.go/runtime/workerpool"
+)
+
+const (
+	// LabelEngine is the pprof label that contains the alias of the engine that a worker pool belongs to.
+	LabelEngine = "engine"
+
+	// LabelPool is the pprof label that contains the name of the worker pool (prefixed with the names of its groups).
+	LabelPool = "pool"
+)
+
+// ErrCPUProfilingActive is returned if a CPU profile is requested while another CPU profile is being recorded.
+var ErrCPUProfilingActive = ierrors.New("cpu profiling is already active")
+
+// groupLabels contains the labels that are inherited by the worker pools of a group.
+type groupLabels struct {
+	// root is the group that the labels were assigned to.
+	root *workerpool.Group
+
+	// path contains the names of the groups between the root and the group.
+	path string
+
+	// labels contains the key-value pairs of the labels of the root.
+	labels []string
+}
+
+// labeledGroups contains the labels of the groups that were labeled or created through this package.
+var labeledGroups = shrinkingmap.New[*workerpool.Group, *groupLabels]()
+
+// LabelGroup assigns the given key-value pairs as pprof labels to the worker pools of the group and its subgroups that
+// are created through this package.
+func LabelGroup(group *workerpool.Group, labels ...string) *workerpool.Group {
+	if len(labels)%2 != 0 {
+		panic("profiling: uneven number of label arguments")
+	}
+
+	labeledGroups.Set(group, &groupLabels{root: group, labels: labels})
+
+	return group
+}
+
+// ReleaseGroup removes the labels of the given group and of all its subgroups.
+func ReleaseGroup(group *workerpool.Group) {
+	labeledGroups.ForEach(func(labeledGroup *workerpool.Group, labels *groupLabels) bool {
+		if labels.root == group {
+			labeledGroups.Delete(labeledGroup)
+		}
+
+		return true
+	})
+}
+
+// CreateGroup creates a subgroup that inherits the labels of its parent.
+func CreateGroup(parent *workerpool.Group, name string) *workerpool.Group {
+	group := parent.CreateGroup(name)
+
+	if parentLabels, exists := labeledGroups.Get(parent); exists {
+		labeledGroups.Set(group, &groupLabels{
+			root:   parentLabels.root,
+			path:   path.Join(parentLabels.path, name),
+			labels: parentLabels.labels,
+		})
+	}
+
+	return group
+}
+
+// CreatePool creates a WorkerPool in the given group whose goroutines are tagged with the labels of the group and the
+// name of the pool.
+func CreatePool(group *workerpool.Group, name string, opts ...options.Option[workerpool.WorkerPool]) (pool *workerpool.WorkerPool) {
+	labels := []string{LabelPool, name}
+	if groupLabels, exists := labeledGroups.Get(group); exists {
+		labels = append([]string{LabelPool, path.Join(groupLabels.path, name)}, groupLabels.labels...)
+	}
+
+	// the workers are started when the pool is created and inherit the labels of the goroutine that creates them, so we
+	// create the pool in a separate goroutine to not modify the labels of the calling goroutine.
+	created := make(chan struct{})
+	go func() {
+		defer close(created)
+
+		pprof.SetGoroutineLabels(pprof.WithLabels(context.Background(), pprof.Labels(labels...)))
+
+		pool = group.CreatePool(name, opts...)
+	}()
+	<-created
+
+	return pool
+}
+
+// WriteGoroutineProfile writes the goroutine profile of the goroutines that carry the given labels to the writer.
+func WriteGoroutineProfile(writer io.Writer, labels map[string]string) error {
+	var buffer bytes.Buffer
+	if err := pprof.Lookup("goroutine").WriteTo(&buffer, 0); err != nil {
+		return ierrors.Wrap(err, "failed to write goroutine profile")
+	}
+
+	return writeFilteredProfile(&buffer, writer, labels)
+}
+
+// WriteCPUProfile records a CPU profile for the given duration (or until the context is canceled) and writes the
+// samples that carry the given labels to the writer.
+func WriteCPUProfile(ctx context.Context, writer io.Writer, duration time.Duration, labels map[string]string) error {
+	var buffer bytes.Buffer
+	if err := pprof.StartCPUProfile(&buffer); err != nil {
+		return ierrors.Join(ErrCPUProfilingActive, err)
+	}
+
+	timer := time.NewTimer(duration)
+	select {
+	case <-timer.C:
+	case <-ctx.Done():
+		timer.Stop()
+	}
+
+	pprof.StopCPUProfile()
+
+	return writeFilteredProfile(&buffer, writer, labels)
+}
+
+// writeFilteredProfile writes the samples of the given profile that carry all the given labels to the writer.
+func writeFilteredProfile(reader io.Reader, writer io.Writer, labels map[string]string) error {
+	if len(labels) == 0 {
+		_, err := io.Copy(writer, reader)
+
+		return err
+	}
+
+	parsedProfile, err := profile.Parse(reader)
+	if err != nil {
+		return ierrors.Wrap(err, "failed to parse profile")
+	}
+
+	parsedProfile.FilterSamplesByTag(func(sample *profile.Sample) bool {
+		for key, value := range labels {
+			if !slices.Contains(sample.Label[key], value) {
+				return false
+			}
+		}
+
+		return true
+	}, nil)
+
+	return parsedProfile.Write(writer)
+}
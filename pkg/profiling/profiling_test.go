@@ -0,0 +1,54 @@
+package profiling
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/google/pprof/profile"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/runtime/workerpool"
+)
+
+func TestCreatePool(t *testing.T) {
+	workers := workerpool.NewGroup("Test")
+	defer workers.Shutdown()
+
+	engineGroup := LabelGroup(workers.CreateGroup("engine1"), LabelEngine, "engine1")
+
+	blocked := make(chan struct{})
+	defer close(blocked)
+
+	for _, pool := range []*workerpool.WorkerPool{
+		CreatePool(CreateGroup(engineGroup, "BlockDAG"), "Attach", workerpool.WithWorkerCount(1)),
+		CreatePool(workers, "Unlabeled", workerpool.WithWorkerCount(1)),
+	} {
+		pool.Submit(func() { <-blocked })
+	}
+
+	goroutineCount := func(labels map[string]string) int {
+		var buffer bytes.Buffer
+		require.NoError(t, WriteGoroutineProfile(&buffer, labels))
+
+		parsedProfile, err := profile.Parse(&buffer)
+		require.NoError(t, err)
+
+		var count int64
+		for _, sample := range parsedProfile.Sample {
+			count += sample.Value[0]
+		}
+
+		return int(count)
+	}
+
+	// every pool consists of a dispatcher and one worker.
+	require.Equal(t, 2, goroutineCount(map[string]string{LabelEngine: "engine1", LabelPool: "BlockDAG/Attach"}))
+	require.Equal(t, 2, goroutineCount(map[string]string{LabelEngine: "engine1"}))
+	require.Equal(t, 2, goroutineCount(map[string]string{LabelPool: "Unlabeled"}))
+	require.Equal(t, 0, goroutineCount(map[string]string{LabelEngine: "engine2"}))
+
+	ReleaseGroup(engineGroup)
+	_, exists := labeledGroups.Get(engineGroup)
+	require.False(t, exists)
+	require.Equal(t, 0, labeledGroups.Size())
+}
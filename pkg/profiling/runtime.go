@@ -0,0 +1,130 @@
+package profiling
+
+import (
+	"runtime"
+	"time"
+
+	"github.com/iotaledger/hive.go/runtime/syncutils"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// RuntimeSample contains the state of the Go runtime at a point in time.
+type RuntimeSample struct {
+	// Time is the time at which the sample was taken.
+	Time time.Time
+
+	// LatestCommittedSlot is the slot of the latest commitment at the time of the sample.
+	LatestCommittedSlot iotago.SlotIndex
+
+	// HeapAlloc is the number of bytes of allocated heap objects.
+	HeapAlloc uint64
+
+	// HeapInuse is the number of bytes in in-use heap spans.
+	HeapInuse uint64
+
+	// HeapObjects is the number of allocated heap objects.
+	HeapObjects uint64
+
+	// Sys is the total number of bytes of memory obtained from the OS.
+	Sys uint64
+
+	// Goroutines is the number of goroutines that exist.
+	Goroutines int
+
+	// GCCycles is the number of GC cycles that completed since the previous sample.
+	GCCycles uint32
+
+	// GCPauseTotal is the sum of the stop-the-world pauses of the GC cycles since the previous sample.
+	GCPauseTotal time.Duration
+
+	// GCPauseMax is the longest stop-the-world pause of the GC cycles since the previous sample.
+	GCPauseMax time.Duration
+}
+
+// RuntimeSampler samples the state of the Go runtime into a ring buffer that retains the most recent samples.
+type RuntimeSampler struct {
+	// samples is the ring buffer that contains the samples.
+	samples []*RuntimeSample
+
+	// next is the position in the ring buffer that the next sample is written to.
+	next int
+
+	// size is the number of samples in the ring buffer.
+	size int
+
+	// lastNumGC is the number of completed GC cycles at the time of the previous sample.
+	lastNumGC uint32
+
+	// mutex is used to synchronize access to the ring buffer.
+	mutex syncutils.RWMutex
+}
+
+// NewRuntimeSampler creates a new RuntimeSampler that retains the given number of samples.
+func NewRuntimeSampler(historySize int) *RuntimeSampler {
+	if historySize <= 0 {
+		panic("profiling: history size must be positive")
+	}
+
+	var memStats runtime.MemStats
+	runtime.ReadMemStats(&memStats)
+
+	return &RuntimeSampler{
+		samples:   make([]*RuntimeSample, historySize),
+		lastNumGC: memStats.NumGC,
+	}
+}
+
+// Sample takes a sample of the Go runtime, adds it to the history (replacing the oldest sample if the history is full)
+// and returns it.
+func (r *RuntimeSampler) Sample(latestCommittedSlot iotago.SlotIndex) *RuntimeSample {
+	var memStats runtime.MemStats
+	runtime.ReadMemStats(&memStats)
+
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	sample := &RuntimeSample{
+		Time:                time.Now(),
+		LatestCommittedSlot: latestCommittedSlot,
+		HeapAlloc:           memStats.HeapAlloc,
+		HeapInuse:           memStats.HeapInuse,
+		HeapObjects:         memStats.HeapObjects,
+		Sys:                 memStats.Sys,
+		Goroutines:          runtime.NumGoroutine(),
+		GCCycles:            memStats.NumGC - r.lastNumGC,
+	}
+
+	// PauseNs is a circular buffer that only contains the pauses of the most recent cycles.
+	for numGC := memStats.NumGC; numGC > r.lastNumGC && memStats.NumGC-numGC < uint32(len(memStats.PauseNs)); numGC-- {
+		pause := time.Duration(memStats.PauseNs[(numGC+uint32(len(memStats.PauseNs))-1)%uint32(len(memStats.PauseNs))])
+
+		sample.GCPauseTotal += pause
+		if pause > sample.GCPauseMax {
+			sample.GCPauseMax = pause
+		}
+	}
+
+	r.lastNumGC = memStats.NumGC
+	r.samples[r.next] = sample
+	r.next = (r.next + 1) % len(r.samples)
+	if r.size < len(r.samples) {
+		r.size++
+	}
+
+	return sample
+}
+
+// History returns the retained samples that were taken after the given time, ordered from oldest to newest.
+func (r *RuntimeSampler) History(since time.Time) []*RuntimeSample {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	history := make([]*RuntimeSample, 0, r.size)
+	for i := 0; i < r.size; i++ {
+		if sample := r.samples[(r.next-r.size+i+len(r.samples))%len(r.samples)]; sample.Time.After(since) {
+			history = append(history, sample)
+		}
+	}
+
+	return history
+}
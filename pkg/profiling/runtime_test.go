@@ -0,0 +1,38 @@
+package profiling
+
+import (
+	"runtime"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func TestRuntimeSampler(t *testing.T) {
+	sampler := NewRuntimeSampler(3)
+	require.Empty(t, sampler.History(time.Time{}))
+
+	runtime.GC()
+	sample := sampler.Sample(1)
+	require.GreaterOrEqual(t, sample.GCCycles, uint32(1))
+	require.Positive(t, sample.GCPauseMax)
+	require.GreaterOrEqual(t, sample.GCPauseTotal, sample.GCPauseMax)
+	require.Positive(t, sample.HeapAlloc)
+	require.Positive(t, sample.Goroutines)
+
+	for slot := iotago.SlotIndex(2); slot <= 5; slot++ {
+		sampler.Sample(slot)
+	}
+
+	// the history only retains the most recent samples.
+	history := sampler.History(time.Time{})
+	require.Len(t, history, 3)
+	for i, slot := range []iotago.SlotIndex{3, 4, 5} {
+		require.Equal(t, slot, history[i].LatestCommittedSlot)
+	}
+
+	require.Equal(t, history[1:], sampler.History(history[0].Time))
+	require.Empty(t, sampler.History(history[2].Time))
+}
@@ -10,6 +10,7 @@ import (
 	"github.com/iotaledger/hive.go/log"
 	"github.com/iotaledger/hive.go/runtime/workerpool"
 	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/profiling"
 	iotago "github.com/iotaledger/iota.go/v4"
 	"github.com/iotaledger/iota.go/v4/merklehasher"
 )
@@ -37,7 +38,7 @@ func newAttestations(protocol *Protocol) *Attestations {
 	a := &Attestations{
 		Logger:              lo.Return1(protocol.Logger.NewChildLogger("Attestations")),
 		protocol:            protocol,
-		workerPool:          protocol.Workers.CreatePool("Attestations"),
+		workerPool:          profiling.CreatePool(protocol.Workers, "Attestations"),
 		requester:           eventticker.New[iotago.SlotIndex, iotago.CommitmentID](protocol.Options.AttestationRequesterOptions...),
 		commitmentVerifiers: shrinkingmap.New[iotago.CommitmentID, *CommitmentVerifier](),
 	}
@@ -142,7 +143,14 @@ func (a *Attestations) setupCommitmentVerifier(chain *Chain) (shutdown func()) {
 func (a *Attestations) sendRequest(commitmentID iotago.CommitmentID) {
 	a.workerPool.Submit(func() {
 		if commitment, err := a.protocol.Commitments.Get(commitmentID, false); err == nil {
-			a.protocol.Network.RequestAttestations(commitmentID)
+			targets, send := a.protocol.PeerPenalties.requestTargets(commitment.Chain.Get())
+			if !send {
+				a.LogDebug("no eligible peers to request attestations from", "commitment", commitment.LogName())
+
+				return
+			}
+
+			a.protocol.Network.RequestAttestations(commitmentID, targets...)
 
 			a.LogDebug("request", "commitment", commitment.LogName())
 		} else {
@@ -175,6 +183,12 @@ func (a *Attestations) processResponse(commitment *model.Commitment, attestation
 				return currentWeight
 			}
 
+			if a.protocol.PeerPenalties.IsIgnored(chain, from) {
+				a.LogTrace("ignoring attestations of penalized peer", "chain", chain.LogName(), "fromPeer", from)
+
+				return currentWeight
+			}
+
 			commitmentVerifier, exists := a.commitmentVerifiers.Get(chain.ForkingPoint.Get().ID())
 			if !exists || commitmentVerifier == nil {
 				a.LogDebug("failed to retrieve commitment verifier", "commitment", publishedCommitment.LogName())
@@ -184,7 +198,9 @@ func (a *Attestations) processResponse(commitment *model.Commitment, attestation
 
 			_, actualWeight, err := commitmentVerifier.verifyCommitment(publishedCommitment, attestations, merkleProof)
 			if err != nil {
-				a.LogError("failed to verify commitment", "commitment", publishedCommitment.LogName(), "error", err)
+				a.LogError("failed to verify commitment", "commitment", publishedCommitment.LogName(), "fromPeer", from, "error", err)
+
+				a.protocol.PeerPenalties.RecordFailedVerification(chain, from, "attestations")
 
 				return currentWeight
 			}
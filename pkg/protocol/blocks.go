@@ -10,6 +10,7 @@ import (
 	"github.com/iotaledger/hive.go/runtime/workerpool"
 	"github.com/iotaledger/iota-core/pkg/core/buffer"
 	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/profiling"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
 	iotago "github.com/iotaledger/iota.go/v4"
@@ -36,7 +37,7 @@ func newBlocks(protocol *Protocol) *Blocks {
 	b := &Blocks{
 		Logger:              lo.Return1(protocol.Logger.NewChildLogger("Blocks")),
 		protocol:            protocol,
-		workerPool:          protocol.Workers.CreatePool("Blocks"),
+		workerPool:          profiling.CreatePool(protocol.Workers, "Blocks"),
 		droppedBlocksBuffer: buffer.NewUnsolidCommitmentBuffer[*types.Tuple[*model.Block, peer.ID]](20, 100),
 	}
 
@@ -66,6 +66,10 @@ type Chain struct {
 	// Engine contains the engine instance that is used to process blocks for this chain.
 	Engine reactive.Variable[*engine.Engine]
 
+	// BlockBuffer contains the buffer that the blocks of this chain are dispatched through while it is not the main
+	// chain (it is nil if the buffering is disabled or no engine was spawned for this chain).
+	BlockBuffer reactive.Variable[*ChainBlockBuffer]
+
 	// IsEvicted contains a flag that indicates whether this chain was evicted.
 	IsEvicted reactive.Event
 
@@ -75,6 +79,9 @@ type Chain struct {
 	// commitments contains the commitments that make up this chain.
 	commitments *shrinkingmap.ShrinkingMap[iotago.SlotIndex, *Commitment]
 
+	// verificationRate counts the commitments of this chain that were verified recently.
+	verificationRate *rateCounter
+
 	// Logger embeds a logger that can be used to log messages emitted by this chain.
 	log.Logger
 }
@@ -97,15 +104,18 @@ func newChain(chains *Chains) *Chain {
 		RequestAttestations:      reactive.NewVariable[bool](),
 		StartEngine:              reactive.NewVariable[bool](),
 		Engine:                   reactive.NewVariable[*engine.Engine](),
+		BlockBuffer:              reactive.NewVariable[*ChainBlockBuffer](),
 		IsEvicted:                reactive.NewEvent(),
 
-		chains:      chains,
-		commitments: shrinkingmap.New[iotago.SlotIndex, *Commitment](),
+		chains:           chains,
+		commitments:      shrinkingmap.New[iotago.SlotIndex, *Commitment](),
+		verificationRate: newRateCounter(verificationRateWindow),
 	}
 
 	shutdown := lo.Batch(
 		c.initLogger(),
 		c.initDerivedProperties(),
+		c.initVerificationRate(),
 	)
 
 	c.IsEvicted.OnTrigger(shutdown)
@@ -215,6 +225,7 @@ func (c *Chain) initDerivedProperties() (shutdown func()) {
 		c.ForkingPoint.WithValue(c.deriveParentChain),
 		c.ParentChain.WithNonEmptyValue(lo.Bind(c, (*Chain).deriveChildChains)),
 		c.Engine.WithNonEmptyValue(c.deriveOutOfSyncThreshold),
+		c.WithInitializedEngine(c.deriveBlockBuffer),
 	)
 }
 
@@ -305,6 +316,25 @@ func (c *Chain) deriveOutOfSyncThreshold(engineInstance *engine.Engine) func() {
 	}, c.chains.LatestSeenSlot))
 }
 
+// deriveBlockBuffer defines how a chain determines its BlockBuffer (by creating a new buffer for every initialized
+// engine if the buffering is enabled in the protocol options).
+func (c *Chain) deriveBlockBuffer(engineInstance *engine.Engine) (shutdown func()) {
+	if c.chains.protocol.Options.ChainBlockBufferSize <= 0 {
+		return nil
+	}
+
+	blockBuffer := newChainBlockBuffer(c, engineInstance, c.chains.protocol.Options)
+	c.BlockBuffer.Set(blockBuffer)
+
+	return func() {
+		c.BlockBuffer.Compute(func(currentBlockBuffer *ChainBlockBuffer) *ChainBlockBuffer {
+			return lo.Cond(currentBlockBuffer == blockBuffer, nil, currentBlockBuffer)
+		})
+
+		blockBuffer.Shutdown()
+	}
+}
+
 // addCommitment adds the given commitment to this chain.
 func (c *Chain) addCommitment(newCommitment *Commitment) (shutdown func()) {
 	c.commitments.Set(newCommitment.Slot(), newCommitment)
@@ -348,6 +378,12 @@ func (c *Chain) dispatchBlockToSpawnedEngine(block *model.Block, src peer.ID) (d
 		}
 	}
 
+	// dispatch the block through the block buffer if we are not the main chain (to limit the memory usage of engines
+	// that are catching up)
+	if blockBuffer := c.BlockBuffer.Get(); blockBuffer != nil && c.chains.Main.Get() != c {
+		return blockBuffer.Add(block, src)
+	}
+
 	// dispatch the block to the spawned engine if all previous checks passed
 	engineInstance.ProcessBlockFromPeer(block, src)
 
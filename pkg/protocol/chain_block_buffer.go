@@ -0,0 +1,295 @@
+package protocol
+
+import (
+	"sync"
+	"sync/atomic"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/iotaledger/hive.go/ds/types"
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/hive.go/runtime/workerpool"
+	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/profiling"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// ChainBlockBuffer is a bounded buffer for the blocks that are dispatched to the engine of a non-main chain. It only
+// hands the blocks to the engine while the worker pools of the engine have less than a configured amount of pending
+// tasks, so that the memory usage stays flat during long catch-ups even if the verification of the blocks is slow.
+//
+// Blocks that exceed the in-memory capacity of the buffer are either spilled to a temporary bucket of the prunable
+// storage of the engine or dropped (if spilling to disk is disabled).
+type ChainBlockBuffer struct {
+	// chain contains a reference to the Chain that this buffer belongs to.
+	chain *Chain
+
+	// engine contains the engine instance that the buffered blocks are dispatched to.
+	engine *engine.Engine
+
+	// engineWorkerPools contains the worker pools of the engine whose pending tasks limit the dispatching.
+	engineWorkerPools []*workerpool.WorkerPool
+
+	// workerPool contains the worker pool that dispatches the buffered blocks to the engine.
+	workerPool *workerpool.WorkerPool
+
+	// bufferedBlocks contains the blocks that are kept in memory.
+	bufferedBlocks []*types.Tuple[*model.Block, peer.ID]
+
+	// spilledBlocks contains the IDs (and sources) of the blocks that were spilled to disk.
+	spilledBlocks []*types.Tuple[iotago.BlockID, peer.ID]
+
+	// size contains the total number of buffered and spilled blocks.
+	size atomic.Int64
+
+	// drainScheduled indicates whether the draining of the buffer was already scheduled, so that bursts of updates
+	// only trigger a single drain.
+	drainScheduled atomic.Bool
+
+	// isShutdown indicates whether the buffer was shut down, so that blocks that finish spilling afterward are dropped.
+	isShutdown bool
+
+	// mutex is used to synchronize access to the buffered and spilled blocks (the storage is accessed outside of it, so
+	// that adding blocks never has to wait for disk I/O of other blocks).
+	mutex sync.Mutex
+
+	// unsubscribe removes the subscriptions to the pending tasks of the engine.
+	unsubscribe func()
+
+	// maxSize contains the maximum number of blocks that are kept in memory.
+	maxSize int
+
+	// maxPendingTasks contains the amount of pending tasks of the engine at which the dispatching is paused.
+	maxPendingTasks int
+
+	// spillToDisk indicates whether blocks that exceed the in-memory capacity are spilled to disk.
+	spillToDisk bool
+}
+
+// newChainBlockBuffer creates a new block buffer that dispatches the blocks of the given chain to the given engine.
+func newChainBlockBuffer(chain *Chain, engineInstance *engine.Engine, options *Options) *ChainBlockBuffer {
+	b := &ChainBlockBuffer{
+		chain:             chain,
+		engine:            engineInstance,
+		engineWorkerPools: lo.Values(engineInstance.Workers.Pools()),
+		maxSize:           options.ChainBlockBufferSize,
+		maxPendingTasks:   options.ChainBlockBufferMaxPendingTasks,
+		spillToDisk:       options.ChainBlockBufferSpillToDisk,
+	}
+
+	// the worker pool is created after collecting the worker pools of the engine so that its own tasks do not count
+	// towards the pending tasks of the engine.
+	b.workerPool = profiling.CreatePool(engineInstance.Workers, "ChainBlockBuffer", workerpool.WithWorkerCount(1))
+
+	b.unsubscribe = lo.Batch(lo.Map(b.engineWorkerPools, func(workerPool *workerpool.WorkerPool) func() {
+		return workerPool.PendingTasksCounter.Subscribe(func(oldValue int, newValue int) {
+			if newValue < oldValue && b.size.Load() > 0 {
+				b.scheduleDrain()
+			}
+		})
+	})...)
+
+	return b
+}
+
+// Add adds the given block to the buffer and returns true if it was buffered or false if it was dropped.
+func (b *ChainBlockBuffer) Add(block *model.Block, src peer.ID) (added bool) {
+	if added = b.add(block, src); added {
+		b.scheduleDrain()
+	}
+
+	return added
+}
+
+// Size returns the total number of buffered and spilled blocks.
+func (b *ChainBlockBuffer) Size() int {
+	return int(b.size.Load())
+}
+
+// SpilledSize returns the number of blocks that were spilled to disk.
+func (b *ChainBlockBuffer) SpilledSize() int {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	return len(b.spilledBlocks)
+}
+
+// Shutdown removes the subscriptions of the buffer and drops all blocks that were not dispatched yet.
+func (b *ChainBlockBuffer) Shutdown() {
+	b.unsubscribe()
+
+	b.workerPool.Shutdown()
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	b.isShutdown = true
+	b.bufferedBlocks = nil
+	b.spilledBlocks = nil
+	b.size.Store(0)
+}
+
+// add adds the given block to the in-memory buffer or spills it to disk if the buffer is full.
+func (b *ChainBlockBuffer) add(block *model.Block, src peer.ID) bool {
+	if added, spill := b.addToMemory(block, src); !spill {
+		return added
+	}
+
+	// the block is only made available to pop after it was written, so the storage is accessed without holding the lock.
+	if err := b.spill(block); err != nil {
+		b.chain.LogError("failed to spill block to disk", "blockID", block.ID(), "err", err)
+
+		return false
+	}
+
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if b.isShutdown {
+		return false
+	}
+
+	b.spilledBlocks = append(b.spilledBlocks, types.NewTuple(block.ID(), src))
+	b.size.Add(1)
+
+	return true
+}
+
+// addToMemory adds the given block to the in-memory buffer if it has capacity left and otherwise returns whether the
+// block needs to be spilled to disk.
+func (b *ChainBlockBuffer) addToMemory(block *model.Block, src peer.ID) (added bool, spill bool) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if b.isShutdown {
+		return false, false
+	}
+
+	if len(b.bufferedBlocks) < b.maxSize {
+		b.bufferedBlocks = append(b.bufferedBlocks, types.NewTuple(block, src))
+		b.size.Add(1)
+
+		return true, false
+	}
+
+	if !b.spillToDisk {
+		b.chain.LogDebug("dropped block exceeding the capacity of the block buffer", "blockID", block.ID())
+
+		return false, false
+	}
+
+	return false, true
+}
+
+// scheduleDrain schedules the draining of the buffer unless it is already scheduled.
+func (b *ChainBlockBuffer) scheduleDrain() {
+	if !b.drainScheduled.CompareAndSwap(false, true) {
+		return
+	}
+
+	b.workerPool.Submit(func() {
+		b.drainScheduled.Store(false)
+
+		b.drain()
+	})
+}
+
+// drain dispatches the buffered blocks to the engine until the buffer is empty or the engine is busy.
+func (b *ChainBlockBuffer) drain() {
+	for b.pendingEngineTasks() < b.maxPendingTasks {
+		block, src, exists := b.pop()
+		if !exists {
+			return
+		}
+
+		if block != nil {
+			b.engine.ProcessBlockFromPeer(block, src)
+		}
+	}
+}
+
+// pop removes the oldest block from the buffer, preferring the blocks that are kept in memory.
+func (b *ChainBlockBuffer) pop() (block *model.Block, src peer.ID, exists bool) {
+	block, spilledBlockID, src, exists := b.popEntry()
+	if !exists || block != nil {
+		return block, src, exists
+	}
+
+	// the entry was already removed from the buffer, so the storage is accessed without holding the lock.
+	loadedBlock, err := b.unspill(spilledBlockID)
+	if err != nil {
+		// the block can still be requested again by the engine if it turns out to be missing
+		b.chain.LogError("failed to load spilled block from disk", "blockID", spilledBlockID, "err", err)
+	}
+
+	return loadedBlock, src, true
+}
+
+// popEntry removes the oldest entry from the buffer and returns either the block (if it was kept in memory) or the ID
+// of the block that was spilled to disk.
+func (b *ChainBlockBuffer) popEntry() (block *model.Block, spilledBlockID iotago.BlockID, src peer.ID, exists bool) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if len(b.bufferedBlocks) != 0 {
+		bufferedBlock := b.bufferedBlocks[0]
+		b.bufferedBlocks[0] = nil
+		b.bufferedBlocks = b.bufferedBlocks[1:]
+		b.size.Add(-1)
+
+		return bufferedBlock.A, iotago.EmptyBlockID, bufferedBlock.B, true
+	}
+
+	if len(b.spilledBlocks) != 0 {
+		spilledBlock := b.spilledBlocks[0]
+		b.spilledBlocks[0] = nil
+		b.spilledBlocks = b.spilledBlocks[1:]
+		b.size.Add(-1)
+
+		return nil, spilledBlock.A, spilledBlock.B, true
+	}
+
+	return nil, iotago.EmptyBlockID, "", false
+}
+
+// spill writes the given block to the temporary bucket of the engine.
+func (b *ChainBlockBuffer) spill(block *model.Block) error {
+	bufferedBlocks, err := b.engine.Storage.BufferedBlocks(block.ID().Slot())
+	if err != nil {
+		return ierrors.Wrapf(err, "failed to access buffered blocks of slot %d", block.ID().Slot())
+	}
+
+	return bufferedBlocks.Store(block)
+}
+
+// unspill loads the block with the given ID from the temporary bucket of the engine and removes it from there.
+func (b *ChainBlockBuffer) unspill(blockID iotago.BlockID) (*model.Block, error) {
+	bufferedBlocks, err := b.engine.Storage.BufferedBlocks(blockID.Slot())
+	if err != nil {
+		return nil, ierrors.Wrapf(err, "failed to access buffered blocks of slot %d", blockID.Slot())
+	}
+
+	block, err := bufferedBlocks.Load(blockID)
+	if err != nil {
+		return nil, ierrors.Wrapf(err, "failed to load buffered block %s", blockID)
+	} else if block == nil {
+		return nil, ierrors.Errorf("buffered block %s not found", blockID)
+	}
+
+	if err = bufferedBlocks.Delete(blockID); err != nil {
+		return nil, ierrors.Wrapf(err, "failed to delete buffered block %s", blockID)
+	}
+
+	return block, nil
+}
+
+// pendingEngineTasks returns the number of tasks that are pending in the worker pools of the engine.
+func (b *ChainBlockBuffer) pendingEngineTasks() (pendingTasks int) {
+	for _, workerPool := range b.engineWorkerPools {
+		pendingTasks += workerPool.PendingTasksCounter.Get()
+	}
+
+	return pendingTasks
+}
@@ -0,0 +1,150 @@
+package protocol
+
+import (
+	"sync"
+	"time"
+
+	"github.com/iotaledger/hive.go/lo"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// verificationRateWindow is the time window over which the verification rate of a chain is measured.
+const verificationRateWindow = time.Minute
+
+// CommitmentsPendingVerification returns the number of commitments of this chain that are known but not verified yet
+// (by attestations or by processing the blocks in an engine, depending on how the chain is verified).
+func (c *Chain) CommitmentsPendingVerification() iotago.SlotIndex {
+	latestCommitment := c.LatestCommitment.Get()
+	if latestCommitment == nil {
+		return 0
+	}
+
+	// commitments before the forking point are verified as part of the parent chain
+	var verifiedSlot iotago.SlotIndex
+	if forkingPoint := c.ForkingPoint.Get(); forkingPoint != nil && forkingPoint.Slot() > 0 {
+		verifiedSlot = forkingPoint.Slot() - 1
+	}
+
+	if latestVerifiedCommitment := c.latestVerifiedCommitment(); latestVerifiedCommitment != nil {
+		verifiedSlot = latestVerifiedCommitment.Slot()
+	}
+
+	if latestCommitment.Slot() <= verifiedSlot {
+		return 0
+	}
+
+	return latestCommitment.Slot() - verifiedSlot
+}
+
+// BlocksPendingBooking returns the number of blocks of this chain that were received but not processed by its engine
+// yet (including the blocks that are still held back by the block buffer).
+func (c *Chain) BlocksPendingBooking() (pendingBlocks int) {
+	if blockBuffer := c.BlockBuffer.Get(); blockBuffer != nil {
+		return blockBuffer.Size() + blockBuffer.pendingEngineTasks()
+	}
+
+	if engineInstance := c.Engine.Get(); engineInstance != nil {
+		for _, workerPool := range engineInstance.Workers.Pools() {
+			pendingBlocks += workerPool.PendingTasksCounter.Get()
+		}
+	}
+
+	return pendingBlocks
+}
+
+// VerificationRate returns the number of commitments of this chain that were verified per second over the last
+// minute.
+func (c *Chain) VerificationRate() float64 {
+	return c.verificationRate.Rate(time.Now())
+}
+
+// initVerificationRate initializes the tracking of the verification rate of this chain.
+func (c *Chain) initVerificationRate() (shutdown func()) {
+	trackVerifiedCommitments := func(verifiedByEngine bool) func(*Commitment, *Commitment) {
+		return func(previousCommitment *Commitment, newCommitment *Commitment) {
+			if newCommitment == nil || c.StartEngine.Get() != verifiedByEngine {
+				return
+			}
+
+			verifiedCommitments := 1
+			if previousCommitment != nil && newCommitment.Slot() > previousCommitment.Slot() {
+				verifiedCommitments = int(newCommitment.Slot() - previousCommitment.Slot())
+			}
+
+			c.verificationRate.Record(time.Now(), verifiedCommitments)
+		}
+	}
+
+	return lo.Batch(
+		c.LatestAttestedCommitment.OnUpdate(trackVerifiedCommitments(false)),
+		c.LatestProducedCommitment.OnUpdate(trackVerifiedCommitments(true)),
+	)
+}
+
+// latestVerifiedCommitment returns the latest commitment of this chain that was verified.
+func (c *Chain) latestVerifiedCommitment() *Commitment {
+	if c.StartEngine.Get() {
+		return c.LatestProducedCommitment.Get()
+	}
+
+	return c.LatestAttestedCommitment.Get()
+}
+
+// rateCounter counts events over a sliding time window.
+type rateCounter struct {
+	// window contains the duration of the sliding time window.
+	window time.Duration
+
+	// events contains the times and amounts of the events that happened within the window.
+	events []rateCounterEvent
+
+	// mutex is used to synchronize access to the events.
+	mutex sync.Mutex
+}
+
+// rateCounterEvent is an event that was recorded by the rateCounter.
+type rateCounterEvent struct {
+	time   time.Time
+	amount int
+}
+
+// newRateCounter creates a new rateCounter with the given window.
+func newRateCounter(window time.Duration) *rateCounter {
+	return &rateCounter{
+		window: window,
+	}
+}
+
+// Record records the given amount of events at the given time.
+func (r *rateCounter) Record(now time.Time, amount int) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.events = append(r.events, rateCounterEvent{time: now, amount: amount})
+	r.evict(now)
+}
+
+// Rate returns the number of events per second within the window that ends at the given time.
+func (r *rateCounter) Rate(now time.Time) float64 {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.evict(now)
+
+	var total int
+	for _, event := range r.events {
+		total += event.amount
+	}
+
+	return float64(total) / r.window.Seconds()
+}
+
+// evict removes the events that are outside the window that ends at the given time.
+func (r *rateCounter) evict(now time.Time) {
+	var evicted int
+	for evicted < len(r.events) && now.Sub(r.events[evicted].time) > r.window {
+		evicted++
+	}
+
+	r.events = r.events[evicted:]
+}
@@ -0,0 +1,26 @@
+package protocol
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateCounter(t *testing.T) {
+	rateCounter := newRateCounter(10 * time.Second)
+	startTime := time.Now()
+
+	require.Zero(t, rateCounter.Rate(startTime))
+
+	rateCounter.Record(startTime, 10)
+	rateCounter.Record(startTime.Add(5*time.Second), 20)
+	require.Equal(t, 3.0, rateCounter.Rate(startTime.Add(5*time.Second)))
+
+	// the first event falls out of the window.
+	require.Equal(t, 2.0, rateCounter.Rate(startTime.Add(11*time.Second)))
+
+	// all events fall out of the window.
+	require.Zero(t, rateCounter.Rate(startTime.Add(16*time.Second)))
+	require.Empty(t, rateCounter.events)
+}
@@ -2,10 +2,12 @@ package protocol
 
 import (
 	"cmp"
+	"sync"
 
 	"github.com/libp2p/go-libp2p/core/peer"
 
 	"github.com/iotaledger/hive.go/ds/reactive"
+	"github.com/iotaledger/hive.go/ierrors"
 	"github.com/iotaledger/hive.go/lo"
 	"github.com/iotaledger/hive.go/log"
 	"github.com/iotaledger/iota-core/pkg/model"
@@ -31,6 +33,14 @@ type Chains struct {
 	// HeaviestVerifiedCandidate contains the candidate chain with the heaviest verified weight, meaning the chain has been instantiated into an engine and the commitments have been produced by the engine itself.
 	HeaviestVerifiedCandidate reactive.Variable[*Chain]
 
+	// WarmStandby contains the candidate chain whose engine is kept fully synced ahead of the chain switching threshold
+	// (only used if warm standby is enabled in the protocol options).
+	WarmStandby reactive.Variable[*Chain]
+
+	// WarmStandbyLag contains the number of slots that the engine of the WarmStandby chain lags behind the latest
+	// attested commitment of that chain.
+	WarmStandbyLag reactive.Variable[iotago.SlotIndex]
+
 	// LatestSeenSlot contains the slot of the latest commitment of any received block.
 	LatestSeenSlot reactive.Variable[iotago.SlotIndex]
 
@@ -49,6 +59,8 @@ func newChains(protocol *Protocol) *Chains {
 		HeaviestClaimedCandidate:  reactive.NewVariable[*Chain](),
 		HeaviestAttestedCandidate: reactive.NewVariable[*Chain](),
 		HeaviestVerifiedCandidate: reactive.NewVariable[*Chain](),
+		WarmStandby:               reactive.NewVariable[*Chain](),
+		WarmStandbyLag:            reactive.NewVariable[iotago.SlotIndex](),
 		LatestSeenSlot:            reactive.NewVariable[iotago.SlotIndex](increasing[iotago.SlotIndex]),
 		protocol:                  protocol,
 	}
@@ -77,6 +89,70 @@ func (c *Chains) WithInitializedEngines(callback func(chain *Chain, engine *engi
 	})
 }
 
+// ChainByForkingPoint returns the managed chain that forked at the commitment with the given ID.
+func (c *Chains) ChainByForkingPoint(forkingPointID iotago.CommitmentID) (chain *Chain, exists bool) {
+	for _, chain = range c.ToSlice() {
+		if forkingPoint := chain.ForkingPoint.Get(); forkingPoint != nil && forkingPoint.ID() == forkingPointID {
+			return chain, true
+		}
+	}
+
+	return nil, false
+}
+
+// StartCandidateEngine forces the start of an engine for the candidate chain that forked at the commitment with the
+// given ID (i.e. to recover from a misbehaving chain switching). The protocol switches to the chain once the engine
+// verified that it is heavier than the main chain.
+func (c *Chains) StartCandidateEngine(forkingPointID iotago.CommitmentID) error {
+	candidate, err := c.candidateChain(forkingPointID)
+	if err != nil {
+		return err
+	}
+
+	candidate.StartEngine.Set(true)
+
+	return nil
+}
+
+// AbortCandidateEngine stops and shuts down the engine of the candidate chain that forked at the commitment with the
+// given ID.
+func (c *Chains) AbortCandidateEngine(forkingPointID iotago.CommitmentID) error {
+	candidate, err := c.candidateChain(forkingPointID)
+	if err != nil {
+		return err
+	}
+
+	candidateEngine := candidate.Engine.Get()
+	if candidateEngine == nil {
+		return ierrors.Wrapf(ErrorEngineNotRunning, "chain with forking point %s", forkingPointID)
+	}
+
+	// shut down the engine once it was detached from the chain.
+	candidate.Engine.OnUpdateOnce(func(_ *engine.Engine, _ *engine.Engine) {
+		candidateEngine.Shutdown.Trigger()
+	}, func(_ *engine.Engine, newEngine *engine.Engine) bool {
+		return newEngine == nil
+	})
+
+	candidate.StartEngine.Set(false)
+
+	return nil
+}
+
+// candidateChain returns the candidate chain that forked at the commitment with the given ID.
+func (c *Chains) candidateChain(forkingPointID iotago.CommitmentID) (*Chain, error) {
+	chain, exists := c.ChainByForkingPoint(forkingPointID)
+	if !exists {
+		return nil, ierrors.Wrapf(ErrorChainNotFound, "chain with forking point %s", forkingPointID)
+	}
+
+	if chain == c.Main.Get() {
+		return nil, ierrors.Wrapf(ErrorMainChain, "chain with forking point %s", forkingPointID)
+	}
+
+	return chain, nil
+}
+
 // initLogger initializes the logger for this component.
 func (c *Chains) initLogger(logger log.Logger) (shutdown func()) {
 	c.Logger = logger
@@ -86,6 +162,7 @@ func (c *Chains) initLogger(logger log.Logger) (shutdown func()) {
 		c.HeaviestClaimedCandidate.LogUpdates(c, log.LevelTrace, "HeaviestClaimedCandidate", (*Chain).LogName),
 		c.HeaviestAttestedCandidate.LogUpdates(c, log.LevelTrace, "HeaviestAttestedCandidate", (*Chain).LogName),
 		c.HeaviestVerifiedCandidate.LogUpdates(c, log.LevelTrace, "HeaviestVerifiedCandidate", (*Chain).LogName),
+		c.WarmStandby.LogUpdates(c, log.LevelDebug, "WarmStandby", (*Chain).LogName),
 
 		logger.UnsubscribeFromParentLogger,
 	)
@@ -102,9 +179,13 @@ func (c *Chains) initChainSwitching() (shutdown func()) {
 	forkingPointBelowChainSwitchingThreshold := func(chain *Chain) func(_ *Commitment, latestCommitment *Commitment) bool {
 		return func(_ *Commitment, latestCommitment *Commitment) bool {
 			forkingPoint := chain.ForkingPoint.Get()
+			if forkingPoint == nil || latestCommitment == nil {
+				return false
+			}
+
 			chainSwitchingThreshold := iotago.SlotIndex(c.protocol.APIForSlot(latestCommitment.Slot()).ProtocolParameters().ChainSwitchingThreshold())
 
-			return forkingPoint != nil && latestCommitment != nil && (latestCommitment.ID().Slot()-forkingPoint.ID().Slot()) > chainSwitchingThreshold
+			return (latestCommitment.ID().Slot() - forkingPoint.ID().Slot()) > chainSwitchingThreshold
 		}
 	}
 
@@ -114,9 +195,13 @@ func (c *Chains) initChainSwitching() (shutdown func()) {
 		}),
 
 		c.HeaviestAttestedCandidate.WithNonEmptyValue(func(heaviestAttestedCandidate *Chain) (shutdown func()) {
-			return heaviestAttestedCandidate.LatestAttestedCommitment.OnUpdateOnce(func(_ *Commitment, _ *Commitment) {
-				heaviestAttestedCandidate.StartEngine.Set(true)
-			}, forkingPointBelowChainSwitchingThreshold(heaviestAttestedCandidate))
+			return lo.Batch(
+				c.initWarmStandby(heaviestAttestedCandidate, forkingPointBelowChainSwitchingThreshold(heaviestAttestedCandidate)),
+
+				heaviestAttestedCandidate.LatestAttestedCommitment.OnUpdateOnce(func(_ *Commitment, _ *Commitment) {
+					heaviestAttestedCandidate.StartEngine.Set(true)
+				}, forkingPointBelowChainSwitchingThreshold(heaviestAttestedCandidate)),
+			)
 		}),
 
 		c.HeaviestVerifiedCandidate.WithNonEmptyValue(func(heaviestVerifiedCandidate *Chain) (shutdown func()) {
@@ -135,6 +220,71 @@ func (c *Chains) initChainSwitching() (shutdown func()) {
 	)
 }
 
+// initWarmStandby starts the engine of the given candidate chain before it reaches the chain switching threshold (if
+// warm standby is enabled), so that the switch can happen instantly instead of waiting for the chain to be verified.
+//
+// To limit the resource usage, only the heaviest attested candidate is kept in standby (the engine of a previous
+// candidate is released once it gets replaced) and no standby engine is started while the main chain is in warp sync
+// mode.
+func (c *Chains) initWarmStandby(candidate *Chain, switchingThresholdReached func(*Commitment, *Commitment) bool) (shutdown func()) {
+	if !c.protocol.Options.WarmStandby {
+		return func() {}
+	}
+
+	return c.Main.WithNonEmptyValue(func(mainChain *Chain) (shutdown func()) {
+		return mainChain.WarpSyncMode.WithValue(func(mainChainWarpSyncing bool) (shutdown func()) {
+			if mainChainWarpSyncing || mainChain == candidate {
+				return nil
+			}
+
+			return c.keepWarmStandby(candidate, switchingThresholdReached)
+		})
+	})
+}
+
+// keepWarmStandby starts the engine of the given candidate chain and returns a function that releases it again (the
+// engine is also released if the candidate chain gets evicted).
+func (c *Chains) keepWarmStandby(candidate *Chain, switchingThresholdReached func(*Commitment, *Commitment) bool) (release func()) {
+	c.WarmStandby.Set(candidate)
+	candidate.StartEngine.Set(true)
+
+	unsubscribeLag := c.WarmStandbyLag.DeriveValueFrom(reactive.NewDerivedVariable2(func(_ iotago.SlotIndex, latestAttestedCommitment *Commitment, latestProducedCommitment *Commitment) iotago.SlotIndex {
+		if latestAttestedCommitment == nil {
+			return 0
+		}
+
+		if latestProducedCommitment == nil {
+			return latestAttestedCommitment.Slot() - candidate.LastCommonSlot()
+		}
+
+		if latestProducedCommitment.Slot() >= latestAttestedCommitment.Slot() {
+			return 0
+		}
+
+		return latestAttestedCommitment.Slot() - latestProducedCommitment.Slot()
+	}, candidate.LatestAttestedCommitment, candidate.LatestProducedCommitment))
+
+	var releaseOnce sync.Once
+	release = func() {
+		releaseOnce.Do(func() {
+			unsubscribeLag()
+
+			c.WarmStandby.Compute(func(currentStandby *Chain) *Chain {
+				return lo.Cond(currentStandby == candidate, nil, currentStandby)
+			})
+			c.WarmStandbyLag.Set(0)
+
+			// release the standby engine unless the chain became the main chain or the engine would have been started
+			// anyway.
+			if c.Main.Get() != candidate && !switchingThresholdReached(nil, candidate.LatestAttestedCommitment.Get()) {
+				candidate.StartEngine.Set(false)
+			}
+		})
+	}
+
+	return lo.Batch(candidate.IsEvicted.OnTrigger(release), release)
+}
+
 // initHeaviestCandidateTracking initializes the tracking of the heaviest candidates according to the given parameters.
 func (c *Chains) initHeaviestCandidateTracking(candidateVar reactive.Variable[*Chain], weightVar func(*Chain) reactive.Variable[uint64], newCandidate *Chain) (unsubscribe func()) {
 	return weightVar(newCandidate).OnUpdate(func(_ uint64, newWeight uint64) {
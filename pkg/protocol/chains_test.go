@@ -0,0 +1,53 @@
+package protocol
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/ds/reactive"
+	"github.com/iotaledger/hive.go/log"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func TestChains_KeepWarmStandby(t *testing.T) {
+	chains := &Chains{
+		Set:            reactive.NewSet[*Chain](),
+		Main:           reactive.NewVariable[*Chain](),
+		WarmStandby:    reactive.NewVariable[*Chain](),
+		WarmStandbyLag: reactive.NewVariable[iotago.SlotIndex](),
+		LatestSeenSlot: reactive.NewVariable[iotago.SlotIndex](increasing[iotago.SlotIndex]),
+		Logger:         log.NewLogger(),
+	}
+
+	switchingThresholdNotReached := func(*Commitment, *Commitment) bool { return false }
+
+	// releasing the standby stops the engine of the candidate.
+	firstCandidate := newChain(chains)
+	release := chains.keepWarmStandby(firstCandidate, switchingThresholdNotReached)
+	require.Equal(t, firstCandidate, chains.WarmStandby.Get())
+	require.True(t, firstCandidate.StartEngine.Get())
+
+	release()
+	require.Nil(t, chains.WarmStandby.Get())
+	require.False(t, firstCandidate.StartEngine.Get())
+
+	// the standby is released when the candidate gets evicted.
+	secondCandidate := newChain(chains)
+	release = chains.keepWarmStandby(secondCandidate, switchingThresholdNotReached)
+	chains.WarmStandbyLag.Set(5)
+
+	secondCandidate.IsEvicted.Trigger()
+	require.Nil(t, chains.WarmStandby.Get())
+	require.Zero(t, chains.WarmStandbyLag.Get())
+	require.False(t, secondCandidate.StartEngine.Get())
+
+	// the standby is only released once.
+	thirdCandidate := newChain(chains)
+	chains.keepWarmStandby(thirdCandidate, switchingThresholdNotReached)
+	secondCandidate.StartEngine.Set(true)
+
+	release()
+	require.Equal(t, thirdCandidate, chains.WarmStandby.Get())
+	require.True(t, secondCandidate.StartEngine.Get())
+}
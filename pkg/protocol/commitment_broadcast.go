@@ -0,0 +1,185 @@
+package protocol
+
+import (
+	"context"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/iotaledger/hive.go/ds"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/hive.go/log"
+	"github.com/iotaledger/hive.go/runtime/syncutils"
+	"github.com/iotaledger/hive.go/runtime/workerpool"
+	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/profiling"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// CommitmentBroadcast is a subcomponent of the protocol that is responsible for gossiping the commitments of the main
+// engine to the neighbors. It runs decoupled from the notarization of the engine, so slow neighbors do not affect the
+// commitment cadence, and it retries to send the latest commitment to the neighbors that did not acknowledge it yet.
+//
+// A neighbor acknowledges a commitment by sending or requesting it, or by sending a block that commits to a slot that
+// is at least as recent as the commitment.
+type CommitmentBroadcast struct {
+	// protocol contains a reference to the Protocol instance that this component belongs to.
+	protocol *Protocol
+
+	// workerPool contains the worker pool that is used to broadcast the commitments asynchronously.
+	workerPool *workerpool.WorkerPool
+
+	// pending contains the latest commitment that was not acknowledged by all neighbors yet.
+	pending *pendingCommitmentBroadcast
+
+	// mutex is used to synchronize access to the pending broadcast.
+	mutex syncutils.Mutex
+
+	// shutdown is used to stop the retry loop.
+	shutdown context.CancelFunc
+
+	// Logger embeds a logger that can be used to log messages emitted by this component.
+	log.Logger
+}
+
+// pendingCommitmentBroadcast is a commitment that is waiting for the acknowledgements of the neighbors.
+type pendingCommitmentBroadcast struct {
+	// commitment is the commitment that is broadcast.
+	commitment *model.Commitment
+
+	// acknowledged contains the neighbors that acknowledged the commitment.
+	acknowledged ds.Set[peer.ID]
+
+	// retries is the number of times the commitment was sent again to the neighbors that did not acknowledge it.
+	retries int
+}
+
+// newCommitmentBroadcast creates a new commitment broadcast protocol instance for the given protocol.
+func newCommitmentBroadcast(protocol *Protocol) *CommitmentBroadcast {
+	ctx, cancel := context.WithCancel(context.Background())
+
+	c := &CommitmentBroadcast{
+		Logger:     lo.Return1(protocol.Logger.NewChildLogger("CommitmentBroadcast")),
+		protocol:   protocol,
+		workerPool: profiling.CreatePool(protocol.Workers, "CommitmentBroadcast", workerpool.WithWorkerCount(1)),
+		shutdown:   cancel,
+	}
+
+	protocol.Constructed.OnTrigger(func() {
+		protocol.Engines.Main.WithNonEmptyValue(func(mainEngine *engine.Engine) (shutdown func()) {
+			return mainEngine.Events.Notarization.LatestCommitmentUpdated.Hook(c.Broadcast).Unhook
+		})
+
+		c.startRetryLoop(ctx)
+	})
+
+	return c
+}
+
+// Broadcast schedules the given commitment to be sent to all neighbors. It replaces the commitment that is currently
+// waiting for acknowledgements and returns immediately.
+func (c *CommitmentBroadcast) Broadcast(commitment *model.Commitment) {
+	c.workerPool.Submit(func() {
+		if !c.protocol.Engines.Main.Get().SyncManager.IsBootstrapped() {
+			return
+		}
+
+		c.mutex.Lock()
+		c.pending = &pendingCommitmentBroadcast{
+			commitment:   commitment,
+			acknowledged: ds.NewSet[peer.ID](),
+		}
+		c.mutex.Unlock()
+
+		c.protocol.Network.SendSlotCommitment(commitment)
+
+		c.LogTrace("broadcast", "commitment", commitment.ID())
+	})
+}
+
+// Acknowledge marks the commitment that is waiting for acknowledgements as received by the given neighbor if the
+// neighbor knows about a commitment of the same or a later slot.
+func (c *CommitmentBroadcast) Acknowledge(commitmentID iotago.CommitmentID, from peer.ID) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if c.pending != nil && (commitmentID == c.pending.commitment.ID() || commitmentID.Slot() > c.pending.commitment.Slot()) {
+		c.pending.acknowledged.Add(from)
+	}
+}
+
+// Shutdown shuts down the commitment broadcast protocol and waits for all pending broadcasts to be finished.
+func (c *CommitmentBroadcast) Shutdown() {
+	c.shutdown()
+
+	c.workerPool.Shutdown().ShutdownComplete.Wait()
+}
+
+// initAcknowledgements initializes the tracking of the acknowledgements of the neighbors and returns a function that
+// shuts it down.
+func (c *CommitmentBroadcast) initAcknowledgements() (shutdown func()) {
+	return lo.Batch(
+		c.protocol.Network.OnBlockReceived(func(block *model.Block, from peer.ID) {
+			c.Acknowledge(block.ProtocolBlock().Header.SlotCommitmentID, from)
+		}),
+		c.protocol.Network.OnCommitmentReceived(func(commitment *model.Commitment, from peer.ID) {
+			c.Acknowledge(commitment.ID(), from)
+		}),
+		c.protocol.Network.OnCommitmentRequestReceived(c.Acknowledge),
+	)
+}
+
+// startRetryLoop starts to periodically send the pending commitment to the neighbors that did not acknowledge it yet
+// if a retry interval was configured.
+func (c *CommitmentBroadcast) startRetryLoop(ctx context.Context) {
+	if c.protocol.Options.CommitmentBroadcastRetryInterval <= 0 {
+		return
+	}
+
+	go func() {
+		ticker := time.NewTicker(c.protocol.Options.CommitmentBroadcastRetryInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ticker.C:
+				c.workerPool.Submit(c.retry)
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+}
+
+// retry sends the pending commitment to the neighbors that did not acknowledge it yet.
+func (c *CommitmentBroadcast) retry() {
+	commitment, unacknowledgedNeighbors := func() (*model.Commitment, []peer.ID) {
+		c.mutex.Lock()
+		defer c.mutex.Unlock()
+
+		if c.pending == nil {
+			return nil, nil
+		}
+
+		unacknowledgedNeighbors := lo.Filter(c.protocol.Network.Neighbors(), func(neighbor peer.ID) bool {
+			return !c.pending.acknowledged.Has(neighbor)
+		})
+
+		if len(unacknowledgedNeighbors) == 0 || c.pending.retries >= c.protocol.Options.CommitmentBroadcastMaxRetries {
+			c.pending = nil
+
+			return nil, nil
+		}
+
+		c.pending.retries++
+
+		return c.pending.commitment, unacknowledgedNeighbors
+	}()
+
+	if commitment != nil {
+		c.protocol.Network.SendSlotCommitment(commitment, unacknowledgedNeighbors...)
+
+		c.LogTrace("retried broadcast", "commitment", commitment.ID(), "neighbors", unacknowledgedNeighbors)
+	}
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/iotaledger/hive.go/runtime/workerpool"
 	"github.com/iotaledger/iota-core/pkg/core/promise"
 	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/profiling"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine"
 	iotago "github.com/iotaledger/iota.go/v4"
 )
@@ -49,7 +50,7 @@ func newCommitments(protocol *Protocol) *Commitments {
 		Root:           reactive.NewVariable[*Commitment](),
 		protocol:       protocol,
 		cachedRequests: shrinkingmap.New[iotago.CommitmentID, *promise.Promise[*Commitment]](),
-		workerPool:     protocol.Workers.CreatePool("Commitments"),
+		workerPool:     profiling.CreatePool(protocol.Workers, "Commitments"),
 		requester:      eventticker.New[iotago.SlotIndex, iotago.CommitmentID](protocol.Options.CommitmentRequesterOptions...),
 	}
 
@@ -334,6 +335,13 @@ func (c *Commitments) processResponse(commitment *model.Commitment, from peer.ID
 			return
 		}
 
+		// ignore commitments that extend a chain that the peer was penalized for.
+		if parent, err := c.Get(commitment.PreviousCommitmentID(), false); err == nil && c.protocol.PeerPenalties.IsIgnored(parent.Chain.Get(), from) {
+			c.LogTrace("ignoring commitment of penalized peer", "commitment", commitment.ID(), "fromPeer", from)
+
+			return
+		}
+
 		if publishedCommitment, published, err := c.protocol.Commitments.publishCommitment(commitment); err != nil {
 			c.LogError("failed to process commitment", "fromPeer", from, "err", err)
 		} else if published {
@@ -0,0 +1,101 @@
+package accountsledger
+
+import (
+	"github.com/iotaledger/hive.go/core/safemath"
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/accounts"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// Aggregates returns the aggregated statistics of the accounts ledger at the given committed slot.
+func (m *Manager) Aggregates(slot iotago.SlotIndex) (*model.AccountsAggregates, error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	if slot > m.latestCommittedSlot {
+		return nil, ierrors.Errorf("can't retrieve accounts aggregates, slot %d is not committed yet, latest committed slot: %d", slot, m.latestCommittedSlot)
+	}
+
+	return m.aggregatesAt(slot)
+}
+
+// aggregatesAt loads the aggregates of the given slot. The aggregates of the latest committed slot are computed from the
+// accounts tree if they were not stored yet (e.g. because the ledger was loaded from a snapshot).
+func (m *Manager) aggregatesAt(slot iotago.SlotIndex) (*model.AccountsAggregates, error) {
+	// the store of a pruned slot is treated like a store that does not contain the aggregates.
+	if store, err := m.aggregatesStore(slot); err == nil {
+		aggregates, exists, err := store.Load(slot)
+		if err != nil {
+			return nil, ierrors.Wrapf(err, "failed to load accounts aggregates of slot %d", slot)
+		} else if exists {
+			return aggregates, nil
+		}
+	}
+
+	if slot != m.latestCommittedSlot {
+		return nil, ierrors.Wrapf(kvstore.ErrKeyNotFound, "accounts aggregates of slot %d are not available", slot)
+	}
+
+	aggregates := model.NewAccountsAggregates()
+	if err := m.accountsTree.Stream(func(_ iotago.AccountID, accountData *accounts.AccountData) error {
+		return updateAggregates(aggregates, nil, accountData)
+	}); err != nil {
+		return nil, ierrors.Wrap(err, "failed to compute accounts aggregates from the accounts tree")
+	}
+
+	return aggregates, nil
+}
+
+// storeAggregates stores the aggregates of the given slot.
+func (m *Manager) storeAggregates(slot iotago.SlotIndex, aggregates *model.AccountsAggregates) error {
+	store, err := m.aggregatesStore(slot)
+	if err != nil {
+		return ierrors.Wrapf(err, "failed to get accounts aggregates store of slot %d", slot)
+	}
+
+	if err := store.Store(slot, aggregates); err != nil {
+		return ierrors.Wrapf(err, "failed to store accounts aggregates of slot %d", slot)
+	}
+
+	return nil
+}
+
+// updateAggregates replaces the contribution of the previous state of an account with the contribution of its new
+// state. The previous state is nil for created accounts and the new state is nil for destroyed accounts.
+func updateAggregates(aggregates *model.AccountsAggregates, previousState *accounts.AccountData, newState *accounts.AccountData) (err error) {
+	if previousState != nil {
+		aggregates.AccountsCount--
+
+		if previousState.ValidatorStake > 0 {
+			aggregates.ValidatorsCount--
+		}
+
+		if aggregates.ValidatorStake, err = safemath.SafeSub(aggregates.ValidatorStake, previousState.ValidatorStake); err != nil {
+			return ierrors.Wrapf(err, "validator stake of account %s exceeds the aggregated validator stake", previousState.ID)
+		}
+
+		if aggregates.DelegationStake, err = safemath.SafeSub(aggregates.DelegationStake, previousState.DelegationStake); err != nil {
+			return ierrors.Wrapf(err, "delegation stake of account %s exceeds the aggregated delegation stake", previousState.ID)
+		}
+	}
+
+	if newState != nil {
+		aggregates.AccountsCount++
+
+		if newState.ValidatorStake > 0 {
+			aggregates.ValidatorsCount++
+		}
+
+		if aggregates.ValidatorStake, err = safemath.SafeAdd(aggregates.ValidatorStake, newState.ValidatorStake); err != nil {
+			return ierrors.Wrapf(err, "aggregated validator stake overflow when adding account %s", newState.ID)
+		}
+
+		if aggregates.DelegationStake, err = safemath.SafeAdd(aggregates.DelegationStake, newState.DelegationStake); err != nil {
+			return ierrors.Wrapf(err, "aggregated delegation stake overflow when adding account %s", newState.ID)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,112 @@
+package accountsledger
+
+import (
+	"sync/atomic"
+
+	"github.com/zyedidia/generic/cache"
+
+	"github.com/iotaledger/hive.go/runtime/syncutils"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/accounts"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/ledger"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// DefaultAccountsCacheSize is the default number of (accountID, slot) entries that are kept in the accounts cache.
+const DefaultAccountsCacheSize = 10000
+
+// accountsCacheKey identifies the state of an account at a given slot.
+type accountsCacheKey struct {
+	accountID iotago.AccountID
+	slot      iotago.SlotIndex
+}
+
+// accountsCacheEntry is the cached result of an account lookup (accounts that do not exist are cached as well).
+type accountsCacheEntry struct {
+	accountData *accounts.AccountData
+	exists      bool
+}
+
+// accountsCache is an LRU cache for the state of accounts at committed slots.
+type accountsCache struct {
+	cache  *cache.Cache[accountsCacheKey, *accountsCacheEntry]
+	hits   atomic.Uint64
+	misses atomic.Uint64
+	mutex  syncutils.Mutex
+}
+
+func newAccountsCache(size int) *accountsCache {
+	return &accountsCache{
+		cache: cache.New[accountsCacheKey, *accountsCacheEntry](size),
+	}
+}
+
+// Get returns a copy of the cached state of the account at the given slot.
+func (a *accountsCache) Get(accountID iotago.AccountID, slot iotago.SlotIndex) (accountData *accounts.AccountData, exists bool, cached bool) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	entry, cached := a.cache.Get(accountsCacheKey{accountID, slot})
+	if !cached {
+		a.misses.Add(1)
+
+		return nil, false, false
+	}
+
+	a.hits.Add(1)
+
+	if !entry.exists {
+		return nil, false, true
+	}
+
+	return entry.accountData.Clone(), true, true
+}
+
+// Put stores a copy of the state of the account at the given slot.
+func (a *accountsCache) Put(accountID iotago.AccountID, slot iotago.SlotIndex, accountData *accounts.AccountData, exists bool) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	entry := &accountsCacheEntry{exists: exists}
+	if exists {
+		entry.accountData = accountData.Clone()
+	}
+
+	a.cache.Put(accountsCacheKey{accountID, slot}, entry)
+}
+
+// Invalidate removes all entries of the given accounts and all entries of slots before the given slot.
+func (a *accountsCache) Invalidate(accountIDs map[iotago.AccountID]struct{}, lowerBoundSlot iotago.SlotIndex) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	var staleKeys []accountsCacheKey
+	a.cache.Each(func(key accountsCacheKey, _ *accountsCacheEntry) {
+		if _, isInvalidated := accountIDs[key.accountID]; isInvalidated || key.slot < lowerBoundSlot {
+			staleKeys = append(staleKeys, key)
+		}
+	})
+
+	for _, key := range staleKeys {
+		a.cache.Remove(key)
+	}
+}
+
+// Clear removes all entries from the cache.
+func (a *accountsCache) Clear() {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	a.cache = cache.New[accountsCacheKey, *accountsCacheEntry](a.cache.Capacity())
+}
+
+// Metrics returns the statistics of the cache.
+func (a *accountsCache) Metrics() *ledger.AccountsCacheMetrics {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	return &ledger.AccountsCacheMetrics{
+		Hits:   a.hits.Load(),
+		Misses: a.misses.Load(),
+		Size:   a.cache.Size(),
+	}
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/iotaledger/iota-core/pkg/model"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/accounts"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/ledger"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/utxoledger"
 	"github.com/iotaledger/iota-core/pkg/storage/prunable/slotstore"
 	iotago "github.com/iotaledger/iota.go/v4"
@@ -41,9 +42,20 @@ type Manager struct {
 	// slot diffs for the Account between [LatestCommittedSlot - MCA, LatestCommittedSlot].
 	slotDiff func(iotago.SlotIndex) (*slotstore.AccountDiffs, error)
 
+	// aggregatesStore returns the store of the aggregated statistics of the accounts ledger at a slot.
+	aggregatesStore func(iotago.SlotIndex) (*slotstore.Store[iotago.SlotIndex, *model.AccountsAggregates], error)
+
 	// block is a function that returns a block from the cache or from the database.
 	block func(id iotago.BlockID) (*blocks.Block, bool)
 
+	// accountsCache caches the state of recently requested accounts at committed slots within the max committable age.
+	accountsCache *accountsCache
+
+	// accountOutputIndex maps the accounts to the IDs of their outputs at the latest committed slot.
+	accountOutputIndex *accountOutputIndex
+
+	optsAccountsCacheSize int
+
 	mutex syncutils.RWMutex
 
 	module.Module
@@ -53,9 +65,11 @@ func New(
 	apiProvider iotago.APIProvider,
 	blockFunc func(id iotago.BlockID) (*blocks.Block, bool),
 	slotDiffFunc func(iotago.SlotIndex) (*slotstore.AccountDiffs, error),
+	aggregatesFunc func(iotago.SlotIndex) (*slotstore.Store[iotago.SlotIndex, *model.AccountsAggregates], error),
 	accountsStore kvstore.KVStore,
+	opts ...options.Option[Manager],
 ) *Manager {
-	return &Manager{
+	return options.Apply(&Manager{
 		apiProvider:                   apiProvider,
 		blockBurns:                    shrinkingmap.New[iotago.SlotIndex, ds.Set[iotago.BlockID]](),
 		latestSupportedVersionSignals: memstorage.NewIndexedStorage[iotago.SlotIndex, iotago.AccountID, *model.SignaledBlock](),
@@ -67,9 +81,16 @@ func New(
 			(*accounts.AccountData).Bytes,
 			accounts.AccountDataFromBytes,
 		),
-		block:    blockFunc,
-		slotDiff: slotDiffFunc,
-	}
+		block:                 blockFunc,
+		slotDiff:              slotDiffFunc,
+		aggregatesStore:       aggregatesFunc,
+		accountOutputIndex:    newAccountOutputIndex(),
+		optsAccountsCacheSize: DefaultAccountsCacheSize,
+	}, opts, func(m *Manager) {
+		if m.optsAccountsCacheSize > 0 {
+			m.accountsCache = newAccountsCache(m.optsAccountsCacheSize)
+		}
+	})
 }
 
 func (m *Manager) Shutdown() {
@@ -168,11 +189,20 @@ func (m *Manager) ApplyDiff(
 		}
 	}
 
+	aggregates, err := m.aggregatesAt(m.latestCommittedSlot)
+	if err != nil {
+		return ierrors.Wrap(err, "could not load accounts aggregates of the previous slot")
+	}
+
 	// committing the tree will modify the accountDiffs to take into account the decayed credits
-	if err := m.commitAccountTree(slot, accountDiffs, destroyedAccounts); err != nil {
+	if err := m.commitAccountTree(slot, accountDiffs, destroyedAccounts, true, aggregates); err != nil {
 		return ierrors.Wrap(err, "could not commit account tree")
 	}
 
+	if err := m.storeAggregates(slot, aggregates); err != nil {
+		return ierrors.Wrap(err, "could not store accounts aggregates")
+	}
+
 	for accountID, accountDiff := range accountDiffs {
 		s, err := m.slotDiff(slot)
 		if err != nil {
@@ -188,6 +218,8 @@ func (m *Manager) ApplyDiff(
 	// set the index where the tree is now at
 	m.latestCommittedSlot = slot
 
+	m.invalidateAccountsCache(accountDiffs, destroyedAccounts)
+
 	m.evict(slot - m.apiProvider.APIForSlot(slot).ProtocolParameters().MaxCommittableAge() - 1)
 
 	return nil
@@ -213,6 +245,12 @@ func (m *Manager) account(accountID iotago.AccountID, targetSlot iotago.SlotInde
 		return nil, false, ierrors.Errorf("can't retrieve account, slot %d is not committed yet, latest committed slot: %d", targetSlot, m.latestCommittedSlot)
 	}
 
+	if m.accountsCache != nil {
+		if cachedAccount, cachedExists, cached := m.accountsCache.Get(accountID, targetSlot); cached {
+			return cachedAccount, cachedExists, nil
+		}
+	}
+
 	// read initial account data at the latest committed slot
 	loadedAccount, exists, err := m.accountsTree.Get(accountID)
 	if err != nil {
@@ -223,19 +261,36 @@ func (m *Manager) account(accountID iotago.AccountID, targetSlot iotago.SlotInde
 		loadedAccount = accounts.NewAccountData(accountID, accounts.WithCredits(accounts.NewBlockIssuanceCredits(0, targetSlot)))
 	}
 
-	wasDestroyed, err := m.rollbackAccountTo(loadedAccount, targetSlot)
+	wasDestroyed, _, err := m.rollbackAccountTo(loadedAccount, targetSlot)
 	if err != nil {
 		return nil, false, err
 	}
 
 	// account not present in the accountsTree, and it was not marked as destroyed in slots between targetSlot and latestCommittedSlot
 	if !exists && !wasDestroyed {
+		if m.accountsCache != nil {
+			m.accountsCache.Put(accountID, targetSlot, nil, false)
+		}
+
 		return nil, false, nil
 	}
 
+	if m.accountsCache != nil {
+		m.accountsCache.Put(accountID, targetSlot, loadedAccount, true)
+	}
+
 	return loadedAccount, true, nil
 }
 
+// CacheMetrics returns the hit and miss statistics of the accounts cache (nil if the cache is disabled).
+func (m *Manager) CacheMetrics() *ledger.AccountsCacheMetrics {
+	if m.accountsCache == nil {
+		return nil
+	}
+
+	return m.accountsCache.Metrics()
+}
+
 // PastAccounts loads the past accounts' data at a specific slot index.
 func (m *Manager) PastAccounts(accountIDs iotago.AccountIDs, targetSlot iotago.SlotIndex) (pastAccounts map[iotago.AccountID]*accounts.AccountData, err error) {
 	m.mutex.RLock()
@@ -253,7 +308,7 @@ func (m *Manager) PastAccounts(accountIDs iotago.AccountIDs, targetSlot iotago.S
 		if !exists {
 			loadedAccount = accounts.NewAccountData(accountID, accounts.WithCredits(accounts.NewBlockIssuanceCredits(0, targetSlot)))
 		}
-		wasDestroyed, err := m.rollbackAccountTo(loadedAccount, targetSlot)
+		wasDestroyed, _, err := m.rollbackAccountTo(loadedAccount, targetSlot)
 		if err != nil {
 			continue
 		}
@@ -270,42 +325,58 @@ func (m *Manager) PastAccounts(accountIDs iotago.AccountIDs, targetSlot iotago.S
 }
 
 func (m *Manager) Rollback(targetSlot iotago.SlotIndex) error {
-	for slot := m.latestCommittedSlot; slot > targetSlot; slot-- {
-		slotDiff := lo.PanicOnErr(m.slotDiff(slot))
-		var internalErr error
-
-		if err := slotDiff.Stream(func(accountID iotago.AccountID, accountDiff *model.AccountDiff, destroyed bool) bool {
-			accountData, exists, err := m.accountsTree.Get(accountID)
-			if err != nil {
-				internalErr = ierrors.Wrapf(err, "unable to retrieve account %s to rollback in slot %d", accountID, slot)
+	if m.accountsCache != nil {
+		m.accountsCache.Clear()
+	}
+	m.accountOutputIndex.Clear()
 
-				return false
-			}
+	// collect the accounts that changed after the target slot first, as every account must only be rolled back once.
+	changedAccounts := ds.NewSet[iotago.AccountID]()
+	for slot := m.latestCommittedSlot; slot > targetSlot; slot-- {
+		if err := lo.PanicOnErr(m.slotDiff(slot)).Stream(func(accountID iotago.AccountID, _ *model.AccountDiff, _ bool) bool {
+			changedAccounts.Add(accountID)
 
-			if !exists {
-				accountData = accounts.NewAccountData(accountID)
-			}
+			return true
+		}); err != nil {
+			return ierrors.Wrapf(err, "error in streaming account diffs for slot %s", slot)
+		}
+	}
 
-			if _, err := m.rollbackAccountTo(accountData, targetSlot); err != nil {
-				internalErr = ierrors.Wrapf(err, "unable to rollback account %s to target slot %d", accountID, targetSlot)
+	if err := changedAccounts.ForEach(func(accountID iotago.AccountID) error {
+		accountData, exists, err := m.accountsTree.Get(accountID)
+		if err != nil {
+			return ierrors.Wrapf(err, "unable to retrieve account %s to rollback to slot %d", accountID, targetSlot)
+		}
 
-				return false
-			}
+		if !exists {
+			accountData = accounts.NewAccountData(accountID)
+		}
 
-			if err := m.accountsTree.Set(accountID, accountData); err != nil {
-				internalErr = ierrors.Wrapf(err, "failed to save rolled back account %s to target slot %d", accountID, targetSlot)
+		_, wasCreated, err := m.rollbackAccountTo(accountData, targetSlot)
+		if err != nil {
+			return ierrors.Wrapf(err, "unable to rollback account %s to target slot %d", accountID, targetSlot)
+		}
 
-				return false
+		// accounts that were created after the target slot did not exist at the target slot.
+		if wasCreated {
+			if _, err := m.accountsTree.Delete(accountID); err != nil {
+				return ierrors.Wrapf(err, "failed to delete account %s that was created after target slot %d", accountID, targetSlot)
 			}
 
-			return true
-		}); err != nil {
-			return ierrors.Wrapf(err, "error in streaming account diffs for slot %s", slot)
+			return nil
 		}
 
-		if internalErr != nil {
-			return ierrors.Wrapf(internalErr, "error in rolling back account for slot %s", slot)
+		if err := m.accountsTree.Set(accountID, accountData); err != nil {
+			return ierrors.Wrapf(err, "failed to save rolled back account %s to target slot %d", accountID, targetSlot)
 		}
+
+		return nil
+	}); err != nil {
+		return ierrors.Wrapf(err, "error in rolling back accounts to slot %d", targetSlot)
+	}
+
+	if err := m.accountsTree.Commit(); err != nil {
+		return ierrors.Wrap(err, "unable to commit account tree")
 	}
 
 	return nil
@@ -350,6 +421,11 @@ func (m *Manager) AddAccount(output *utxoledger.Output, blockIssuanceCredits iot
 		return ierrors.Wrapf(err, "can't add account (%s), could not commit accounts tree", accountOutput.AccountID)
 	}
 
+	if m.accountsCache != nil {
+		m.accountsCache.Invalidate(map[iotago.AccountID]struct{}{accountOutput.AccountID: {}}, 0)
+	}
+	m.accountOutputIndex.Set(accountOutput.AccountID, output.OutputID())
+
 	return nil
 }
 
@@ -362,17 +438,17 @@ func (m *Manager) Reset() {
 	m.latestSupportedVersionSignals.Clear()
 }
 
-func (m *Manager) rollbackAccountTo(accountData *accounts.AccountData, targetSlot iotago.SlotIndex) (wasDestroyed bool, err error) {
+func (m *Manager) rollbackAccountTo(accountData *accounts.AccountData, targetSlot iotago.SlotIndex) (wasDestroyed bool, wasCreated bool, err error) {
 	// to reach targetSlot, we need to rollback diffs from the current latestCommittedSlot down to targetSlot + 1
 	for diffSlot := m.latestCommittedSlot; diffSlot > targetSlot; diffSlot-- {
 		diffStore, err := m.slotDiff(diffSlot)
 		if err != nil {
-			return false, ierrors.Errorf("can't retrieve account, could not find diff store for slot (%d)", diffSlot)
+			return false, false, ierrors.Errorf("can't retrieve account, could not find diff store for slot (%d)", diffSlot)
 		}
 
 		found, err := diffStore.Has(accountData.ID)
 		if err != nil {
-			return false, ierrors.Wrapf(err, "can't retrieve account, could not check if diff store for slot (%d) has account (%s)", diffSlot, accountData.ID)
+			return false, false, ierrors.Wrapf(err, "can't retrieve account, could not check if diff store for slot (%d) has account (%s)", diffSlot, accountData.ID)
 		}
 
 		// no changes for this account in this slot
@@ -382,7 +458,7 @@ func (m *Manager) rollbackAccountTo(accountData *accounts.AccountData, targetSlo
 
 		diffChange, destroyed, err := diffStore.Load(accountData.ID)
 		if err != nil {
-			return false, ierrors.Wrapf(err, "can't retrieve account, could not load diff for account (%s) in slot (%d)", accountData.ID, diffSlot)
+			return false, false, ierrors.Wrapf(err, "can't retrieve account, could not load diff for account (%s) in slot (%d)", accountData.ID, diffSlot)
 		}
 
 		// update the account data with the diff
@@ -400,25 +476,25 @@ func (m *Manager) rollbackAccountTo(accountData *accounts.AccountData, targetSlo
 
 		validatorStake, err := safemath.SafeSub(int64(accountData.ValidatorStake), diffChange.ValidatorStakeChange)
 		if err != nil {
-			return false, ierrors.Wrapf(err, "can't retrieve account, validator stake underflow for account (%s) in slot (%d): %d - %d", accountData.ID, diffSlot, accountData.ValidatorStake, diffChange.ValidatorStakeChange)
+			return false, false, ierrors.Wrapf(err, "can't retrieve account, validator stake underflow for account (%s) in slot (%d): %d - %d", accountData.ID, diffSlot, accountData.ValidatorStake, diffChange.ValidatorStakeChange)
 		}
 		accountData.ValidatorStake = iotago.BaseToken(validatorStake)
 
 		delegationStake, err := safemath.SafeSub(int64(accountData.DelegationStake), diffChange.DelegationStakeChange)
 		if err != nil {
-			return false, ierrors.Wrapf(err, "can't retrieve account, delegation stake underflow for account (%s) in slot (%d): %d - %d", accountData.ID, diffSlot, accountData.DelegationStake, diffChange.DelegationStakeChange)
+			return false, false, ierrors.Wrapf(err, "can't retrieve account, delegation stake underflow for account (%s) in slot (%d): %d - %d", accountData.ID, diffSlot, accountData.DelegationStake, diffChange.DelegationStakeChange)
 		}
 		accountData.DelegationStake = iotago.BaseToken(delegationStake)
 
 		stakeEpochEnd, err := safemath.SafeSub(int64(accountData.StakeEndEpoch), diffChange.StakeEndEpochChange)
 		if err != nil {
-			return false, ierrors.Wrapf(err, "can't retrieve account, stake end epoch underflow for account (%s) in slot (%d): %d - %d", accountData.ID, diffSlot, accountData.StakeEndEpoch, diffChange.StakeEndEpochChange)
+			return false, false, ierrors.Wrapf(err, "can't retrieve account, stake end epoch underflow for account (%s) in slot (%d): %d - %d", accountData.ID, diffSlot, accountData.StakeEndEpoch, diffChange.StakeEndEpochChange)
 		}
 		accountData.StakeEndEpoch = iotago.EpochIndex(stakeEpochEnd)
 
 		fixedCost, err := safemath.SafeSub(int64(accountData.FixedCost), diffChange.FixedCostChange)
 		if err != nil {
-			return false, ierrors.Wrapf(err, "can't retrieve account, fixed cost underflow for account (%s) in slot (%d): %d - %d", accountData.ID, diffSlot, accountData.FixedCost, diffChange.FixedCostChange)
+			return false, false, ierrors.Wrapf(err, "can't retrieve account, fixed cost underflow for account (%s) in slot (%d): %d - %d", accountData.ID, diffSlot, accountData.FixedCost, diffChange.FixedCostChange)
 		}
 		accountData.FixedCost = iotago.Mana(fixedCost)
 		if diffChange.PrevLatestSupportedVersionAndHash != diffChange.NewLatestSupportedVersionAndHash {
@@ -427,9 +503,12 @@ func (m *Manager) rollbackAccountTo(accountData *accounts.AccountData, targetSlo
 
 		// collected to see if an account was destroyed between slotIndex and b.latestCommittedSlot index.
 		wasDestroyed = wasDestroyed || destroyed
+
+		// collected to see if an account was created between slotIndex and b.latestCommittedSlot index.
+		wasCreated = wasCreated || (diffChange.PreviousOutputID == iotago.EmptyOutputID && diffChange.NewOutputID != iotago.EmptyOutputID)
 	}
 
-	return wasDestroyed, nil
+	return wasDestroyed, wasCreated, nil
 }
 
 func (m *Manager) preserveDestroyedAccountData(accountID iotago.AccountID) (accountDiff *model.AccountDiff, err error) {
@@ -502,30 +581,44 @@ func (m *Manager) computeBlockBurnsForSlot(slot iotago.SlotIndex, rmc iotago.Man
 	return burns, nil
 }
 
-func (m *Manager) commitAccountTree(slot iotago.SlotIndex, accountDiffChanges map[iotago.AccountID]*model.AccountDiff, destroyedAccounts ds.Set[iotago.AccountID]) error {
+// commitAccountTree applies the given diffs to the account tree. If decayCredits is set, the credits of existing accounts
+// are decayed to the given slot and the decay is added to the BICChange of the diffs. Diffs that were already stored
+// by the ledger contain the decay, so they need to be applied without decaying again. The given aggregates are updated
+// with the changes of the accounts.
+func (m *Manager) commitAccountTree(slot iotago.SlotIndex, accountDiffChanges map[iotago.AccountID]*model.AccountDiff, destroyedAccounts ds.Set[iotago.AccountID], decayCredits bool, aggregates *model.AccountsAggregates) error {
 	// update the account tree to latestCommitted slot
 	for accountID, diffChange := range accountDiffChanges {
+		accountData, exists, err := m.accountsTree.Get(accountID)
+		if err != nil {
+			return ierrors.Wrapf(err, "can't retrieve account, could not load account (%s) from accounts tree", accountID)
+		}
+
 		// remove a destroyed account, no need to update with diffs
 		if destroyedAccounts.Has(accountID) {
 			if _, err := m.accountsTree.Delete(accountID); err != nil {
 				return ierrors.Wrapf(err, "could not delete account (%s) from accounts tree", accountID)
 			}
+			m.accountOutputIndex.Delete(accountID)
 
-			continue
-		}
+			if exists {
+				if err := updateAggregates(aggregates, accountData, nil); err != nil {
+					return ierrors.Wrapf(err, "could not update accounts aggregates with destroyed account (%s)", accountID)
+				}
+			}
 
-		accountData, exists, err := m.accountsTree.Get(accountID)
-		if err != nil {
-			return ierrors.Wrapf(err, "can't retrieve account, could not load account (%s) from accounts tree", accountID)
+			continue
 		}
 
-		if !exists {
+		var previousState *accounts.AccountData
+		if exists {
+			previousState = accountData.Clone()
+		} else {
 			accountData = accounts.NewAccountData(accountID)
 		}
 
 		if diffChange.BICChange != 0 || !exists {
 			// decay the credits to the current slot if the account exists
-			if exists {
+			if exists && decayCredits {
 				decayedPreviousCredits, err := m.apiProvider.APIForSlot(slot).ManaDecayProvider().DecayManaBySlots(iotago.Mana(accountData.Credits.Value), accountData.Credits.UpdateSlot, slot)
 				if err != nil {
 					return ierrors.Wrapf(err, "can't retrieve account, could not decay credits for account (%s) in slot (%d)", accountData.ID, slot)
@@ -583,6 +676,11 @@ func (m *Manager) commitAccountTree(slot iotago.SlotIndex, accountDiffChanges ma
 		if err := m.accountsTree.Set(accountID, accountData); err != nil {
 			return ierrors.Wrapf(err, "could not set account (%s) in accounts tree", accountID)
 		}
+		m.accountOutputIndex.Set(accountID, accountData.OutputID)
+
+		if err := updateAggregates(aggregates, previousState, accountData); err != nil {
+			return ierrors.Wrapf(err, "could not update accounts aggregates with account (%s)", accountID)
+		}
 	}
 
 	if err := m.accountsTree.Commit(); err != nil {
@@ -592,6 +690,29 @@ func (m *Manager) commitAccountTree(slot iotago.SlotIndex, accountDiffChanges ma
 	return nil
 }
 
+// invalidateAccountsCache removes the cached state of the accounts that were changed in the committed slot and of the
+// slots that fell out of the max committable age.
+func (m *Manager) invalidateAccountsCache(accountDiffs map[iotago.AccountID]*model.AccountDiff, destroyedAccounts ds.Set[iotago.AccountID]) {
+	if m.accountsCache == nil {
+		return
+	}
+
+	changedAccounts := make(map[iotago.AccountID]struct{}, len(accountDiffs))
+	for accountID := range accountDiffs {
+		changedAccounts[accountID] = struct{}{}
+	}
+	destroyedAccounts.Range(func(accountID iotago.AccountID) {
+		changedAccounts[accountID] = struct{}{}
+	})
+
+	var lowerBoundSlot iotago.SlotIndex
+	if maxCommittableAge := m.apiProvider.APIForSlot(m.latestCommittedSlot).ProtocolParameters().MaxCommittableAge(); m.latestCommittedSlot > maxCommittableAge {
+		lowerBoundSlot = m.latestCommittedSlot - maxCommittableAge
+	}
+
+	m.accountsCache.Invalidate(changedAccounts, lowerBoundSlot)
+}
+
 func (m *Manager) evict(slot iotago.SlotIndex) {
 	m.blockBurns.Delete(slot)
 	m.latestSupportedVersionSignals.Evict(slot)
@@ -649,3 +770,11 @@ func (m *Manager) updateSlotDiffWithVersionSignals(slot iotago.SlotIndex, accoun
 
 	return nil
 }
+
+// WithAccountsCacheSize sets the number of (accountID, slot) entries that are kept in the accounts cache (0 disables
+// the cache).
+func WithAccountsCacheSize(size int) options.Option[Manager] {
+	return func(m *Manager) {
+		m.optsAccountsCacheSize = size
+	}
+}
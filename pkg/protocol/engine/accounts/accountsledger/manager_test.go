@@ -1,7 +1,14 @@
 package accountsledger_test
 
 import (
+	"fmt"
 	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/runtime/debug"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/ledger"
+	iotago "github.com/iotaledger/iota.go/v4"
 )
 
 func TestManager_Scenario1(t *testing.T) {
@@ -235,3 +242,166 @@ func TestManager_Scenario5(t *testing.T) {
 		},
 	})
 }
+
+func TestManager_AccountsCache(t *testing.T) {
+	ts := NewTestSuite(t)
+
+	ts.ApplySlotActions(1, 5, map[string]*AccountActions{
+		"A": {
+			TotalAllotments: 10,
+			NumBlocks:       1,
+			AddedKeys:       []string{"A.P1"},
+
+			NewOutputID: "A1",
+		},
+	})
+
+	accountState := map[string]*AccountState{
+		"A": {
+			BICUpdatedTime:  1,
+			BICAmount:       5,
+			BlockIssuerKeys: []string{"A.P1"},
+			OutputID:        "A1",
+		},
+	}
+
+	// the first lookup misses the cache, the second lookup is served from the cache
+	ts.AssertAccountLedgerUntil(1, accountState)
+	require.Equal(t, &ledger.AccountsCacheMetrics{Hits: 0, Misses: 1, Size: 1}, ts.Instance.CacheMetrics())
+
+	ts.AssertAccountLedgerUntil(1, accountState)
+	require.Equal(t, &ledger.AccountsCacheMetrics{Hits: 1, Misses: 1, Size: 1}, ts.Instance.CacheMetrics())
+
+	// modifying the returned account data does not modify the cached entry
+	accountData, exists, err := ts.Instance.Account(ts.AccountID("A", false), 1)
+	require.NoError(t, err)
+	require.True(t, exists)
+	accountData.Credits.Value = 100
+
+	ts.AssertAccountLedgerUntil(1, accountState)
+	require.Equal(t, &ledger.AccountsCacheMetrics{Hits: 3, Misses: 1, Size: 1}, ts.Instance.CacheMetrics())
+
+	// applying a diff that changes the account invalidates its cached entries
+	ts.ApplySlotActions(2, 15, map[string]*AccountActions{
+		"A": {
+			TotalAllotments: 30,
+			NumBlocks:       1,
+			AddedKeys:       []string{"A.P2"},
+
+			NewOutputID: "A2",
+		}},
+	)
+	require.Equal(t, &ledger.AccountsCacheMetrics{Hits: 3, Misses: 1, Size: 0}, ts.Instance.CacheMetrics())
+
+	ts.AssertAccountLedgerUntil(2, map[string]*AccountState{
+		"A": {
+			BICAmount:       20,
+			BlockIssuerKeys: []string{"A.P1", "A.P2"},
+			OutputID:        "A2",
+			BICUpdatedTime:  2,
+		},
+	})
+	require.Equal(t, &ledger.AccountsCacheMetrics{Hits: 3, Misses: 3, Size: 2}, ts.Instance.CacheMetrics())
+}
+
+func TestManager_AccountOutputID(t *testing.T) {
+	debug.SetEnabled(true)
+	defer debug.SetEnabled(false)
+
+	ts := NewTestSuite(t)
+
+	requireAccountOutputID := func(alias string, slot iotago.SlotIndex, expectedOutputAlias string) {
+		outputID, exists, err := ts.Instance.AccountOutputID(ts.AccountID(alias, false), slot)
+		require.NoError(t, err)
+		require.Equal(t, expectedOutputAlias != "", exists)
+
+		if exists {
+			require.Equal(t, ts.OutputID(expectedOutputAlias, false), outputID)
+		}
+	}
+
+	ts.ApplySlotActions(1, 5, map[string]*AccountActions{
+		"A": {
+			TotalAllotments: 10,
+			NumBlocks:       1,
+			AddedKeys:       []string{"A.P1"},
+
+			NewOutputID: "A1",
+		},
+	})
+
+	// the first lookup fills the index from the accounts tree, the second lookup is served from the index
+	requireAccountOutputID("A", 1, "A1")
+	requireAccountOutputID("A", 1, "A1")
+
+	ts.ApplySlotActions(2, 15, map[string]*AccountActions{
+		"A": {
+			TotalAllotments: 30,
+			NumBlocks:       1,
+
+			NewOutputID: "A2",
+		},
+	})
+
+	requireAccountOutputID("A", 2, "A2")
+	requireAccountOutputID("A", 1, "A1")
+
+	ts.ApplySlotActions(3, 5, map[string]*AccountActions{
+		"A": {
+			TotalAllotments: 5,
+			NumBlocks:       1,
+			Destroyed:       true,
+		},
+	})
+
+	requireAccountOutputID("A", 3, "")
+	requireAccountOutputID("A", 2, "A2")
+}
+
+func TestManager_RollbackSymmetry(t *testing.T) {
+	slotActions := []map[string]*AccountActions{
+		1: {
+			"A": {TotalAllotments: 10, NumBlocks: 1, AddedKeys: []string{"A.P1"}, ValidatorStakeChange: 50, NewOutputID: "A1"},
+			"B": {TotalAllotments: 20, NumBlocks: 2, AddedKeys: []string{"B.P1"}, NewOutputID: "B1"},
+		},
+		2: {
+			"A": {TotalAllotments: 5, NumBlocks: 1, AddedKeys: []string{"A.P2"}, RemovedKeys: []string{"A.P1"}, ValidatorStakeChange: -20, NewOutputID: "A2"},
+			"B": {DelegationStakeChange: 30},
+			"C": {TotalAllotments: 15, NumBlocks: 1, AddedKeys: []string{"C.P1"}, NewOutputID: "C1"},
+		},
+		3: {
+			"A": {TotalAllotments: 3, NumBlocks: 2},
+			"B": {Destroyed: true},
+			"D": {TotalAllotments: 7, AddedKeys: []string{"D.P1"}, NewOutputID: "D1"},
+		},
+		4: {
+			"C": {DelegationStakeChange: 10, TotalAllotments: 1, NumBlocks: 1},
+			"D": {AddedKeys: []string{"D.P2"}, RemovedKeys: []string{"D.P1"}, ValidatorStakeChange: 40, NewOutputID: "D2"},
+		},
+	}
+	rmcPerSlot := []iotago.Mana{1: 5, 2: 3, 3: 7, 4: 2}
+	latestSlot := iotago.SlotIndex(len(slotActions) - 1)
+
+	for _, targetSlot := range []iotago.SlotIndex{latestSlot, 3, 2, 1, 0} {
+		t.Run(fmt.Sprintf("rollback to slot %d", targetSlot), func(t *testing.T) {
+			ts := NewTestSuite(t)
+
+			rootPerSlot := map[iotago.SlotIndex]iotago.Identifier{0: ts.Instance.AccountsTreeRoot()}
+			for slot := iotago.SlotIndex(1); slot <= latestSlot; slot++ {
+				ts.ApplySlotActions(slot, rmcPerSlot[slot], slotActions[slot])
+
+				rootPerSlot[slot] = ts.Instance.AccountsTreeRoot()
+			}
+
+			// the accounts that were created, changed or destroyed after the target slot are restored.
+			ts.RollbackTo(targetSlot)
+			require.Equal(t, rootPerSlot[targetSlot], ts.Instance.AccountsTreeRoot())
+
+			// re-applying the rolled back slots results in the same accounts.
+			for slot := targetSlot + 1; slot <= latestSlot; slot++ {
+				ts.ReapplySlot(slot, rmcPerSlot[slot])
+				require.Equal(t, rootPerSlot[slot], ts.Instance.AccountsTreeRoot(), "re-apply of slot %d", slot)
+			}
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package accountsledger
+
+import (
+	"github.com/iotaledger/hive.go/ds/shrinkingmap"
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/runtime/debug"
+	"github.com/iotaledger/hive.go/runtime/syncutils"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// accountOutputIndex maps the accounts to the IDs of their outputs at the latest committed slot. It is filled lazily
+// from the accounts tree and kept up to date when the accounts tree is modified.
+type accountOutputIndex struct {
+	outputIDs *shrinkingmap.ShrinkingMap[iotago.AccountID, iotago.OutputID]
+	mutex     syncutils.RWMutex
+}
+
+func newAccountOutputIndex() *accountOutputIndex {
+	return &accountOutputIndex{
+		outputIDs: shrinkingmap.New[iotago.AccountID, iotago.OutputID](),
+	}
+}
+
+// Get returns the indexed output ID of the given account.
+func (a *accountOutputIndex) Get(accountID iotago.AccountID) (outputID iotago.OutputID, exists bool) {
+	a.mutex.RLock()
+	defer a.mutex.RUnlock()
+
+	return a.outputIDs.Get(accountID)
+}
+
+// Set indexes the output ID of the given account.
+func (a *accountOutputIndex) Set(accountID iotago.AccountID, outputID iotago.OutputID) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	a.outputIDs.Set(accountID, outputID)
+}
+
+// Delete removes the given account from the index.
+func (a *accountOutputIndex) Delete(accountID iotago.AccountID) {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	a.outputIDs.Delete(accountID)
+}
+
+// Clear removes all accounts from the index.
+func (a *accountOutputIndex) Clear() {
+	a.mutex.Lock()
+	defer a.mutex.Unlock()
+
+	a.outputIDs.Clear()
+}
+
+// AccountOutputID returns the ID of the output of the account at the given slot. Lookups at the latest committed slot
+// are served from the account output index without loading and rolling back the account data.
+func (m *Manager) AccountOutputID(accountID iotago.AccountID, targetSlot iotago.SlotIndex) (outputID iotago.OutputID, exists bool, err error) {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	if targetSlot != m.latestCommittedSlot {
+		accountData, exists, err := m.account(accountID, targetSlot)
+		if err != nil || !exists {
+			return iotago.EmptyOutputID, false, err
+		}
+
+		return accountData.OutputID, true, nil
+	}
+
+	if outputID, exists = m.accountOutputIndex.Get(accountID); exists {
+		if debug.GetEnabled() {
+			if err = m.checkAccountOutputID(accountID, outputID); err != nil {
+				return iotago.EmptyOutputID, false, err
+			}
+		}
+
+		return outputID, true, nil
+	}
+
+	accountData, exists, err := m.accountsTree.Get(accountID)
+	if err != nil {
+		return iotago.EmptyOutputID, false, ierrors.Wrapf(err, "can't retrieve account output, could not load account (%s) from accounts tree", accountID)
+	}
+
+	if !exists {
+		return iotago.EmptyOutputID, false, nil
+	}
+
+	m.accountOutputIndex.Set(accountID, accountData.OutputID)
+
+	return accountData.OutputID, true, nil
+}
+
+// checkAccountOutputID verifies that the indexed output ID of the account matches the accounts tree.
+func (m *Manager) checkAccountOutputID(accountID iotago.AccountID, indexedOutputID iotago.OutputID) error {
+	accountData, exists, err := m.accountsTree.Get(accountID)
+	if err != nil {
+		return ierrors.Wrapf(err, "can't verify account output, could not load account (%s) from accounts tree", accountID)
+	}
+
+	if !exists {
+		return ierrors.Errorf("account output index is inconsistent: account %s is indexed with output %s, but does not exist in the accounts tree", accountID, indexedOutputID)
+	}
+
+	if accountData.OutputID != indexedOutputID {
+		return ierrors.Errorf("account output index is inconsistent: account %s is indexed with output %s, but the accounts tree contains output %s", accountID, indexedOutputID, accountData.OutputID)
+	}
+
+	return nil
+}
@@ -3,12 +3,14 @@ package accountsledger
 import (
 	"io"
 
+	"github.com/iotaledger/hive.go/ds"
 	"github.com/iotaledger/hive.go/ierrors"
 	"github.com/iotaledger/hive.go/lo"
 	"github.com/iotaledger/hive.go/serializer/v2"
 	"github.com/iotaledger/hive.go/serializer/v2/stream"
 	"github.com/iotaledger/iota-core/pkg/model"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/accounts"
+	"github.com/iotaledger/iota-core/pkg/storage/prunable/slotstore"
 	iotago "github.com/iotaledger/iota.go/v4"
 )
 
@@ -36,6 +38,11 @@ func (m *Manager) Import(reader io.ReadSeeker) error {
 		return ierrors.Wrap(err, "unable to import slot diffs")
 	}
 
+	if m.accountsCache != nil {
+		m.accountsCache.Clear()
+	}
+	m.accountOutputIndex.Clear()
+
 	return nil
 }
 
@@ -73,10 +80,17 @@ func (m *Manager) exportAccountTree(writer io.WriteSeeker, targetIndex iotago.Sl
 	var accountCount int
 
 	if err := m.accountsTree.Stream(func(accountID iotago.AccountID, accountData *accounts.AccountData) error {
-		if _, err := m.rollbackAccountTo(accountData, targetIndex); err != nil {
+		_, wasCreated, err := m.rollbackAccountTo(accountData, targetIndex)
+		if err != nil {
 			return ierrors.Wrapf(err, "unable to rollback account %s", accountID)
 		}
 
+		// the account did not exist at the target slot yet, so it is not part of the exported accounts tree (and the
+		// aggregates that are computed from it).
+		if wasCreated {
+			return nil
+		}
+
 		if err := stream.WriteObject(writer, accountData, (*accounts.AccountData).Bytes); err != nil {
 			return ierrors.Wrapf(err, "unable to write account %s", accountID)
 		}
@@ -102,10 +116,7 @@ func (m *Manager) recreateDestroyedAccounts(writer io.WriteSeeker, targetSlot io
 		// it should be impossible that `m.slotDiff(slot)` returns an error, because it is impossible to export a pruned slot
 		err := lo.PanicOnErr(m.slotDiff(slot)).StreamDestroyed(func(accountID iotago.AccountID) bool {
 			// actual data will be filled in by rollbackAccountTo
-			accountData := accounts.NewAccountData(accountID)
-
-			destroyedAccounts[accountID] = accountData
-			recreatedAccountsCount++
+			destroyedAccounts[accountID] = accounts.NewAccountData(accountID)
 
 			return true
 		})
@@ -115,15 +126,20 @@ func (m *Manager) recreateDestroyedAccounts(writer io.WriteSeeker, targetSlot io
 	}
 
 	for accountID, accountData := range destroyedAccounts {
-		if wasDestroyed, err := m.rollbackAccountTo(accountData, targetSlot); err != nil {
+		if wasDestroyed, wasCreated, err := m.rollbackAccountTo(accountData, targetSlot); err != nil {
 			return 0, ierrors.Wrapf(err, "unable to rollback account %s to target slot %d", accountID, targetSlot)
 		} else if !wasDestroyed {
 			return 0, ierrors.Errorf("account %s was not destroyed", accountID)
+		} else if wasCreated {
+			// the account was created and destroyed after the target slot, so it did not exist at the target slot.
+			continue
 		}
 
 		if err := stream.WriteObject(writer, accountData, (*accounts.AccountData).Bytes); err != nil {
 			return 0, ierrors.Wrapf(err, "unable to write account %s", accountID)
 		}
+
+		recreatedAccountsCount++
 	}
 
 	return recreatedAccountsCount, nil
@@ -137,44 +153,70 @@ func (m *Manager) readSlotDiffs(reader io.ReadSeeker) error {
 			return ierrors.Wrapf(err, "unable to read slot index at index %d", i)
 		}
 
-		// Read all the slot diffs within each slot.
-		if err := stream.ReadCollection(reader, serializer.SeriLengthPrefixTypeAsUint64, func(j int) error {
-			diffStore, err := m.slotDiff(slot)
-			if err != nil {
-				return ierrors.Wrapf(err, "unable to get account diff storage for slot %d", slot)
-			}
+		accountDiffs, destroyedAccounts, err := readSlotDiff(reader)
+		if err != nil {
+			return ierrors.Wrapf(err, "unable to read accounts in diff count at index %d", i)
+		}
 
-			accountID, err := stream.Read[iotago.AccountID](reader)
-			if err != nil {
-				return ierrors.Wrapf(err, "unable to read accountID for index %d", j)
-			}
+		if err := m.storeSlotDiff(slot, accountDiffs, destroyedAccounts); err != nil {
+			return ierrors.Wrapf(err, "unable to store slot diff for slot %d", slot)
+		}
 
-			destroyed, err := stream.Read[bool](reader)
-			if err != nil {
-				return ierrors.Wrapf(err, "unable to read destroyed flag for accountID %s", accountID)
-			}
+		return nil
+	}); err != nil {
+		return ierrors.Wrap(err, "failed to read slot diffs")
+	}
 
-			var accountDiff *model.AccountDiff
-			if !destroyed {
-				if accountDiff, err = stream.ReadObjectFromReader(reader, model.AccountDiffFromReader); err != nil {
-					return ierrors.Wrapf(err, "unable to read account diff for accountID %s", accountID)
-				}
-			} else {
-				accountDiff = model.NewAccountDiff()
-			}
+	return nil
+}
 
-			if err := diffStore.Store(accountID, accountDiff, destroyed); err != nil {
-				return ierrors.Wrapf(err, "unable to store slot diff for accountID %s", accountID)
-			}
+// readSlotDiff reads the account diffs of a single slot.
+func readSlotDiff(reader io.ReadSeeker) (map[iotago.AccountID]*model.AccountDiff, ds.Set[iotago.AccountID], error) {
+	accountDiffs := make(map[iotago.AccountID]*model.AccountDiff)
+	destroyedAccounts := ds.NewSet[iotago.AccountID]()
 
-			return nil
-		}); err != nil {
-			return ierrors.Wrapf(err, "unable to read accounts in diff count at index %d", i)
+	if err := stream.ReadCollection(reader, serializer.SeriLengthPrefixTypeAsUint64, func(j int) error {
+		accountID, err := stream.Read[iotago.AccountID](reader)
+		if err != nil {
+			return ierrors.Wrapf(err, "unable to read accountID for index %d", j)
+		}
+
+		destroyed, err := stream.Read[bool](reader)
+		if err != nil {
+			return ierrors.Wrapf(err, "unable to read destroyed flag for accountID %s", accountID)
 		}
 
+		var accountDiff *model.AccountDiff
+		if !destroyed {
+			if accountDiff, err = stream.ReadObjectFromReader(reader, model.AccountDiffFromReader); err != nil {
+				return ierrors.Wrapf(err, "unable to read account diff for accountID %s", accountID)
+			}
+		} else {
+			accountDiff = model.NewAccountDiff()
+			destroyedAccounts.Add(accountID)
+		}
+
+		accountDiffs[accountID] = accountDiff
+
 		return nil
 	}); err != nil {
-		return ierrors.Wrap(err, "failed to read slot diffs")
+		return nil, nil, err
+	}
+
+	return accountDiffs, destroyedAccounts, nil
+}
+
+// storeSlotDiff stores the given account diffs in the diff storage of the slot.
+func (m *Manager) storeSlotDiff(slot iotago.SlotIndex, accountDiffs map[iotago.AccountID]*model.AccountDiff, destroyedAccounts ds.Set[iotago.AccountID]) error {
+	diffStore, err := m.slotDiff(slot)
+	if err != nil {
+		return ierrors.Wrapf(err, "unable to get account diff storage for slot %d", slot)
+	}
+
+	for accountID, accountDiff := range accountDiffs {
+		if err := diffStore.Store(accountID, accountDiff, destroyedAccounts.Has(accountID)); err != nil {
+			return ierrors.Wrapf(err, "unable to store slot diff for accountID %s", accountID)
+		}
 	}
 
 	return nil
@@ -191,8 +233,6 @@ func (m *Manager) writeSlotDiffs(writer io.WriteSeeker, targetSlot iotago.SlotIn
 	}
 
 	for ; slot <= targetSlot; slot++ {
-		var accountsInDiffCount int
-
 		if err := stream.Write(writer, slot); err != nil {
 			return 0, ierrors.Wrapf(err, "unable to write slot %d", slot)
 		}
@@ -203,46 +243,55 @@ func (m *Manager) writeSlotDiffs(writer io.WriteSeeker, targetSlot iotago.SlotIn
 			continue
 		}
 
-		if err = stream.WriteCollection(writer, serializer.SeriLengthPrefixTypeAsUint64, func() (int, error) {
-			var innerErr error
-
-			if err = slotDiffs.Stream(func(accountID iotago.AccountID, accountDiff *model.AccountDiff, destroyed bool) bool {
+		if err = writeSlotDiff(writer, slot, slotDiffs); err != nil {
+			return 0, err
+		}
 
-				if err = stream.Write(writer, accountID); err != nil {
-					innerErr = ierrors.Wrapf(err, "unable to write accountID for account %s", accountID)
-					return false
-				}
+		slotDiffsCount++
+	}
 
-				if err = stream.Write(writer, destroyed); err != nil {
-					innerErr = ierrors.Wrapf(err, "unable to write destroyed flag for account %s", accountID)
-					return false
-				}
+	return slotDiffsCount, nil
+}
 
-				if !destroyed {
-					if err = stream.WriteObject(writer, accountDiff, (*model.AccountDiff).Bytes); err != nil {
-						innerErr = ierrors.Wrapf(err, "unable to write account diff for account %s", accountID)
-						return false
-					}
-				}
+// writeSlotDiff writes the account diffs of a single slot.
+func writeSlotDiff(writer io.WriteSeeker, slot iotago.SlotIndex, slotDiffs *slotstore.AccountDiffs) error {
+	if err := stream.WriteCollection(writer, serializer.SeriLengthPrefixTypeAsUint64, func() (int, error) {
+		var accountsInDiffCount int
+		var innerErr error
 
-				accountsInDiffCount++
+		if err := slotDiffs.Stream(func(accountID iotago.AccountID, accountDiff *model.AccountDiff, destroyed bool) bool {
+			if innerErr = stream.Write(writer, accountID); innerErr != nil {
+				innerErr = ierrors.Wrapf(innerErr, "unable to write accountID for account %s", accountID)
+				return false
+			}
 
-				return true
-			}); err != nil {
-				return 0, ierrors.Wrapf(err, "unable to stream slot diff for index %d", slot)
+			if innerErr = stream.Write(writer, destroyed); innerErr != nil {
+				innerErr = ierrors.Wrapf(innerErr, "unable to write destroyed flag for account %s", accountID)
+				return false
 			}
 
-			if innerErr != nil {
-				return 0, ierrors.Wrapf(innerErr, "unable to stream slot diff for index %d", slot)
+			if !destroyed {
+				if innerErr = stream.WriteObject(writer, accountDiff, (*model.AccountDiff).Bytes); innerErr != nil {
+					innerErr = ierrors.Wrapf(innerErr, "unable to write account diff for account %s", accountID)
+					return false
+				}
 			}
 
-			return accountsInDiffCount, nil
+			accountsInDiffCount++
+
+			return true
 		}); err != nil {
-			return 0, ierrors.Wrapf(err, "unable to write slot diff %d", slot)
+			return 0, ierrors.Wrapf(err, "unable to stream slot diff for index %d", slot)
 		}
 
-		slotDiffsCount++
+		if innerErr != nil {
+			return 0, ierrors.Wrapf(innerErr, "unable to stream slot diff for index %d", slot)
+		}
+
+		return accountsInDiffCount, nil
+	}); err != nil {
+		return ierrors.Wrapf(err, "unable to write slot diff %d", slot)
 	}
 
-	return slotDiffsCount, nil
+	return nil
 }
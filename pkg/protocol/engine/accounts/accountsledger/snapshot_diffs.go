@@ -0,0 +1,131 @@
+package accountsledger
+
+import (
+	"io"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/serializer/v2"
+	"github.com/iotaledger/hive.go/serializer/v2/stream"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// ExportDiffs writes the account diffs of the slots in (startSlot, endSlot] to the writer. Contrary to Export, the
+// accounts tree is not written, which allows a node whose accounts ledger is at startSlot to catch up to endSlot by
+// importing only the changes with ImportDiffs.
+func (m *Manager) ExportDiffs(writer io.WriteSeeker, startSlot iotago.SlotIndex, endSlot iotago.SlotIndex) error {
+	m.mutex.RLock()
+	defer m.mutex.RUnlock()
+
+	if startSlot >= endSlot {
+		return ierrors.Errorf("start slot %d must be smaller than end slot %d", startSlot, endSlot)
+	}
+
+	if endSlot > m.latestCommittedSlot {
+		return ierrors.Errorf("cannot export diffs until slot %d, latest committed slot is %d", endSlot, m.latestCommittedSlot)
+	}
+
+	if err := stream.Write(writer, startSlot); err != nil {
+		return ierrors.Wrap(err, "unable to write start slot")
+	}
+
+	if err := stream.Write(writer, endSlot); err != nil {
+		return ierrors.Wrap(err, "unable to write end slot")
+	}
+
+	if err := stream.WriteCollection(writer, serializer.SeriLengthPrefixTypeAsUint64, func() (int, error) {
+		for slot := startSlot + 1; slot <= endSlot; slot++ {
+			slotDiffs, err := m.slotDiff(slot)
+			if err != nil {
+				// contrary to a full snapshot, the diffs of every slot are required to patch the accounts tree.
+				return 0, ierrors.Wrapf(err, "unable to get account diff storage for slot %d", slot)
+			}
+
+			if err := stream.Write(writer, slot); err != nil {
+				return 0, ierrors.Wrapf(err, "unable to write slot %d", slot)
+			}
+
+			if err := writeSlotDiff(writer, slot, slotDiffs); err != nil {
+				return 0, err
+			}
+		}
+
+		return int(endSlot - startSlot), nil
+	}); err != nil {
+		return ierrors.Wrapf(err, "unable to export slot diffs from slot %d to slot %d", startSlot, endSlot)
+	}
+
+	return nil
+}
+
+// ImportDiffs reads the account diffs written by ExportDiffs and applies them on top of the accounts tree, which must be
+// at the start slot of the export. Afterward the accounts ledger is at the end slot of the export, and the caller is
+// expected to verify the AccountsTreeRoot against the accounts root of the corresponding commitment.
+func (m *Manager) ImportDiffs(reader io.ReadSeeker) error {
+	m.mutex.Lock()
+	defer m.mutex.Unlock()
+
+	startSlot, err := stream.Read[iotago.SlotIndex](reader)
+	if err != nil {
+		return ierrors.Wrap(err, "unable to read start slot")
+	}
+
+	endSlot, err := stream.Read[iotago.SlotIndex](reader)
+	if err != nil {
+		return ierrors.Wrap(err, "unable to read end slot")
+	}
+
+	if startSlot != m.latestCommittedSlot {
+		return ierrors.Errorf("cannot import diffs starting at slot %d, latest committed slot is %d", startSlot, m.latestCommittedSlot)
+	}
+
+	// the cache can't be invalidated selectively, as it might hold states of slots that are not covered by the diffs.
+	if m.accountsCache != nil {
+		defer m.accountsCache.Clear()
+	}
+
+	if err := stream.ReadCollection(reader, serializer.SeriLengthPrefixTypeAsUint64, func(i int) error {
+		slot, err := stream.Read[iotago.SlotIndex](reader)
+		if err != nil {
+			return ierrors.Wrapf(err, "unable to read slot index at index %d", i)
+		}
+
+		if slot != m.latestCommittedSlot+1 || slot > endSlot {
+			return ierrors.Errorf("unexpected slot %d in diffs, latest committed slot is %d", slot, m.latestCommittedSlot)
+		}
+
+		accountDiffs, destroyedAccounts, err := readSlotDiff(reader)
+		if err != nil {
+			return ierrors.Wrapf(err, "unable to read account diffs of slot %d", slot)
+		}
+
+		aggregates, err := m.aggregatesAt(m.latestCommittedSlot)
+		if err != nil {
+			return ierrors.Wrapf(err, "unable to load accounts aggregates of slot %d", m.latestCommittedSlot)
+		}
+
+		// the exported diffs already contain the decay of the credits, so they are applied as they are.
+		if err := m.commitAccountTree(slot, accountDiffs, destroyedAccounts, false, aggregates); err != nil {
+			return ierrors.Wrapf(err, "unable to apply account diffs of slot %d", slot)
+		}
+
+		if err := m.storeAggregates(slot, aggregates); err != nil {
+			return ierrors.Wrapf(err, "unable to store accounts aggregates of slot %d", slot)
+		}
+
+		if err := m.storeSlotDiff(slot, accountDiffs, destroyedAccounts); err != nil {
+			return ierrors.Wrapf(err, "unable to store slot diff for slot %d", slot)
+		}
+
+		m.latestCommittedSlot = slot
+
+		return nil
+	}); err != nil {
+		return ierrors.Wrapf(err, "failed to import slot diffs from slot %d to slot %d", startSlot, endSlot)
+	}
+
+	if m.latestCommittedSlot != endSlot {
+		return ierrors.Errorf("incomplete diffs, imported until slot %d instead of slot %d", m.latestCommittedSlot, endSlot)
+	}
+
+	return nil
+}
@@ -187,6 +187,7 @@ func TestManager_Import_Export(t *testing.T) {
 		err = ts.Instance.Import(writer.Reader())
 		require.NoError(t, err)
 		ts.Instance.SetLatestCommittedSlot(3)
+		ts.aggregatesAvailableFrom = 3
 
 		ts.AssertAccountLedgerUntilWithoutNewState(3)
 	}
@@ -202,7 +203,163 @@ func TestManager_Import_Export(t *testing.T) {
 		err = ts.Instance.Import(writer.Reader())
 		require.NoError(t, err)
 		ts.Instance.SetLatestCommittedSlot(2)
+		ts.aggregatesAvailableFrom = 2
 
 		ts.AssertAccountLedgerUntilWithoutNewState(2)
 	}
 }
+
+func TestManager_ImportExportDiffs(t *testing.T) {
+	ts := NewTestSuite(t)
+
+	ts.ApplySlotActions(1, 5, map[string]*AccountActions{
+		"A": {
+			TotalAllotments: 10,
+			NumBlocks:       1,
+			AddedKeys:       []string{"A.P1"},
+
+			NewOutputID: "A1",
+		},
+		"B": {
+			TotalAllotments: 20,
+			NumBlocks:       2,
+			AddedKeys:       []string{"B.P1", "B.P2"},
+
+			NewOutputID: "B1",
+		},
+	})
+
+	ts.AssertAccountLedgerUntil(1, map[string]*AccountState{
+		"A": {
+			BICUpdatedTime:  1,
+			BICAmount:       5,
+			BlockIssuerKeys: []string{"A.P1"},
+			OutputID:        "A1",
+		},
+		"B": {
+			BICUpdatedTime:  1,
+			BICAmount:       10,
+			BlockIssuerKeys: []string{"B.P1", "B.P2"},
+			OutputID:        "B1",
+		},
+	})
+
+	// Export the full account ledger at slot 1 into a new manager that falls behind.
+	writer := stream.NewByteBuffer()
+	require.NoError(t, ts.Instance.Export(writer, iotago.SlotIndex(1)))
+
+	laggingInstance := ts.initAccountLedger()
+	require.NoError(t, laggingInstance.Import(writer.Reader()))
+	laggingInstance.SetLatestCommittedSlot(1)
+
+	ts.ApplySlotActions(2, 1, map[string]*AccountActions{
+		"A": {
+			NumBlocks:   5,
+			RemovedKeys: []string{"A.P1"},
+
+			NewOutputID: "A2",
+		},
+		"B": {
+			TotalAllotments: 5,
+			NumBlocks:       2,
+			RemovedKeys:     []string{"B.P1"},
+
+			NewOutputID: "B2",
+		},
+		"C": { // create a staking account
+			AddedKeys: []string{"C.P1"},
+
+			ValidatorStakeChange:  20,
+			DelegationStakeChange: 20,
+			FixedCostChange:       10,
+			StakeEndEpochChange:   10,
+
+			NewOutputID: "C1",
+		},
+	})
+
+	ts.AssertAccountLedgerUntil(2, map[string]*AccountState{
+		"A": {
+			BICUpdatedTime:  2,
+			BICAmount:       0,
+			BlockIssuerKeys: []string{},
+			OutputID:        "A2",
+		},
+		"B": {
+			BICUpdatedTime:  2,
+			BICAmount:       13,
+			BlockIssuerKeys: []string{"B.P2"},
+			OutputID:        "B2",
+		},
+		"C": {
+			BICUpdatedTime:  2,
+			BICAmount:       0,
+			BlockIssuerKeys: []string{"C.P1"},
+			OutputID:        "C1",
+			ValidatorStake:  20,
+			DelegationStake: 20,
+			FixedCost:       10,
+			StakeEndEpoch:   10,
+		},
+	})
+
+	ts.ApplySlotActions(3, 5, map[string]*AccountActions{
+		"A": {
+			Destroyed: true,
+		},
+		"B": {
+			TotalAllotments: 10,
+			NumBlocks:       1,
+			AddedKeys:       []string{"B.P3"},
+
+			NewOutputID: "B3",
+		},
+		"C": {
+			DelegationStakeChange: -5,
+		},
+	})
+
+	ts.AssertAccountLedgerUntil(3, map[string]*AccountState{
+		"A": {
+			Destroyed: true,
+
+			BICUpdatedTime: 3,
+		},
+		"B": {
+			BICUpdatedTime:  3,
+			BICAmount:       18,
+			BlockIssuerKeys: []string{"B.P2", "B.P3"},
+			OutputID:        "B3",
+		},
+		"C": {
+			BICUpdatedTime:  2,
+			BICAmount:       0,
+			BlockIssuerKeys: []string{"C.P1"},
+			OutputID:        "C1",
+			ValidatorStake:  20,
+			DelegationStake: 15,
+			FixedCost:       10,
+			StakeEndEpoch:   10,
+		},
+	})
+
+	// Diffs can only be imported on top of the start slot of the export.
+	{
+		diffsWriter := stream.NewByteBuffer()
+		require.NoError(t, ts.Instance.ExportDiffs(diffsWriter, 2, 3))
+		require.Error(t, laggingInstance.ImportDiffs(diffsWriter.Reader()))
+	}
+
+	require.Error(t, ts.Instance.ExportDiffs(stream.NewByteBuffer(), 1, 4))
+
+	// Patch the lagging manager with the diffs only.
+	diffsWriter := stream.NewByteBuffer()
+	require.NoError(t, ts.Instance.ExportDiffs(diffsWriter, 1, 3))
+	require.NoError(t, laggingInstance.ImportDiffs(diffsWriter.Reader()))
+
+	require.Equal(t, ts.Instance.AccountsTreeRoot(), laggingInstance.AccountsTreeRoot())
+
+	ts.Instance = laggingInstance
+	ts.aggregatesAvailableFrom = 2
+	ts.AssertAccountLedgerUntilWithoutNewState(3)
+}
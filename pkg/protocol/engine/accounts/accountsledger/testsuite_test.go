@@ -35,6 +35,10 @@ type TestSuite struct {
 	latestFieldsPerAccount *shrinkingmap.ShrinkingMap[iotago.AccountID, *latestAccountFields]
 	blocks                 *memstorage.IndexedStorage[iotago.SlotIndex, iotago.BlockID, *blocks.Block]
 	Instance               *accountsledger.Manager
+
+	// aggregatesAvailableFrom is the first slot for which the accounts aggregates of the Instance are asserted (instances
+	// that were imported from a snapshot only have the aggregates from the snapshot slot onwards).
+	aggregatesAvailableFrom iotago.SlotIndex
 }
 
 func NewTestSuite(test *testing.T) *TestSuite {
@@ -79,7 +83,21 @@ func (t *TestSuite) initAccountLedger() *accountsledger.Manager {
 		return storage.Get(id)
 	}
 
-	manager := accountsledger.New(t.apiProvider, blockFunc, slotDiffFunc, mapdb.NewMapDB())
+	aggregatesStores := make(map[iotago.SlotIndex]kvstore.KVStore)
+	aggregatesFunc := func(slot iotago.SlotIndex) (*slotstore.Store[iotago.SlotIndex, *model.AccountsAggregates], error) {
+		if _, exists := aggregatesStores[slot]; !exists {
+			aggregatesStores[slot] = mapdb.NewMapDB()
+		}
+
+		return slotstore.NewStore(slot, aggregatesStores[slot],
+			iotago.SlotIndex.Bytes,
+			iotago.SlotIndexFromBytes,
+			(*model.AccountsAggregates).Bytes,
+			model.AccountsAggregatesFromBytes,
+		), nil
+	}
+
+	manager := accountsledger.New(t.apiProvider, blockFunc, slotDiffFunc, aggregatesFunc, mapdb.NewMapDB())
 
 	return manager
 }
@@ -186,6 +204,34 @@ func (t *TestSuite) ApplySlotActions(slot iotago.SlotIndex, rmc iotago.Mana, act
 	require.NoError(t.T, err)
 }
 
+// RollbackTo rolls back the account ledger to the given slot.
+func (t *TestSuite) RollbackTo(slot iotago.SlotIndex) {
+	require.NoError(t.T, t.Instance.Rollback(slot))
+
+	t.Instance.SetLatestCommittedSlot(slot)
+}
+
+// ReapplySlot applies the diff of the given slot, that was applied before with ApplySlotActions, again.
+func (t *TestSuite) ReapplySlot(slot iotago.SlotIndex, rmc iotago.Mana) {
+	slotDetails, exists := t.slotData.Get(slot)
+	require.True(t.T, exists, "slot data for slot %d should exist", slot)
+
+	if slotBlocks := t.blocks.Get(slot); slotBlocks != nil {
+		slotBlocks.ForEach(func(_ iotago.BlockID, block *blocks.Block) bool {
+			t.Instance.TrackBlock(block)
+
+			return true
+		})
+	}
+
+	diffs := make(map[iotago.AccountID]*model.AccountDiff)
+	for accountID, diff := range slotDetails.SlotDiff {
+		diffs[accountID] = diff.Clone()
+	}
+
+	require.NoError(t.T, t.Instance.ApplyDiff(slot, rmc, diffs, slotDetails.DestroyedAccounts.Clone()))
+}
+
 func (t *TestSuite) createBlockWithRMC(accountID iotago.AccountID, slot iotago.SlotIndex, rmc iotago.Mana) *blocks.Block {
 	innerBlock := tpkg.RandBasicBlockWithIssuerAndRMC(tpkg.ZeroCostTestAPI, accountID, rmc)
 	innerBlock.Header.IssuingTime = tpkg.ZeroCostTestAPI.TimeProvider().SlotStartTime(slot)
@@ -206,7 +252,33 @@ func (t *TestSuite) AssertAccountLedgerUntilWithoutNewState(slot iotago.SlotInde
 			t.assertAccountState(i, accountID, expectedState)
 			t.assertDiff(i, accountID, expectedState)
 		}
+
+		t.assertAggregates(i, storedAccountsState)
+	}
+}
+
+func (t *TestSuite) assertAggregates(slot iotago.SlotIndex, expectedAccountsState map[iotago.AccountID]*AccountState) {
+	if slot < t.aggregatesAvailableFrom {
+		return
+	}
+
+	expectedAggregates := model.NewAccountsAggregates()
+	for _, expectedState := range expectedAccountsState {
+		if expectedState.Destroyed {
+			continue
+		}
+
+		expectedAggregates.AccountsCount++
+		if expectedState.ValidatorStake > 0 {
+			expectedAggregates.ValidatorsCount++
+		}
+		expectedAggregates.ValidatorStake += expectedState.ValidatorStake
+		expectedAggregates.DelegationStake += expectedState.DelegationStake
 	}
+
+	actualAggregates, err := t.Instance.Aggregates(slot)
+	require.NoError(t.T, err)
+	require.Equal(t.T, expectedAggregates, actualAggregates, "slot: %d: unexpected accounts aggregates", slot)
 }
 
 func (t *TestSuite) AssertAccountLedgerUntil(slot iotago.SlotIndex, accountsState map[string]*AccountState) {
@@ -45,8 +45,6 @@ func (m *Manager) GetManaOnAccount(accountID iotago.AccountID, slot iotago.SlotI
 	m.mutex.Lock()
 	defer m.mutex.Unlock()
 
-	apiForSlot := m.apiProvider.APIForSlot(slot)
-
 	mana, exists := m.manaVectorCache.Get(accountID)
 	// if entry does not exist or required slot is earlier than the one stored in the cache,
 	// then need to calculate it from scratch
@@ -69,8 +67,6 @@ func (m *Manager) GetManaOnAccount(accountID iotago.AccountID, slot iotago.SlotI
 		}
 	}
 
-	manaDecayProvider := apiForSlot.ManaDecayProvider()
-
 	// If the requested slot is the same as the update time the cached mana, then return how it is.
 	// Otherwise, need to calculate decay and potential mana generation before returning.
 	if slot == mana.UpdateTime() {
@@ -79,13 +75,13 @@ func (m *Manager) GetManaOnAccount(accountID iotago.AccountID, slot iotago.SlotI
 
 	// Apply decay to stored, potential and BIC mana that was calculated when adding the entry to cache
 	// so that it's correct for the requested slot.
-	manaWithDecay, err := manaDecayProvider.DecayManaBySlots(mana.Value(), mana.UpdateTime(), slot)
+	manaWithDecay, err := m.decayManaBySlots(mana.Value(), mana.UpdateTime(), slot)
 	if err != nil {
 		return 0, err
 	}
 
 	// Calculate newly generated potential mana since last update time.
-	manaPotential, err := manaDecayProvider.GenerateManaAndDecayBySlots(mana.ExcessBaseTokens(), mana.UpdateTime(), slot)
+	manaPotential, err := m.generateManaAndDecayBySlots(mana.ExcessBaseTokens(), mana.UpdateTime(), slot)
 	if err != nil {
 		return 0, err
 	}
@@ -105,7 +101,6 @@ func (m *Manager) getMana(accountID iotago.AccountID, output *utxoledger.Output,
 		return nil, ierrors.Wrap(err, "failed to retrieve BIC")
 	}
 
-	manaDecayProvider := m.apiProvider.APIForSlot(slot).ManaDecayProvider()
 	minDeposit := lo.PanicOnErr(m.apiProvider.APIForSlot(slot).StorageScoreStructure().MinDeposit(output.Output()))
 	excessBaseTokens, err := safemath.SafeSub(output.BaseTokenAmount(), minDeposit)
 	if err != nil {
@@ -124,12 +119,12 @@ func (m *Manager) getMana(accountID iotago.AccountID, output *utxoledger.Output,
 	var manaUpdateTime iotago.SlotIndex
 	var totalMana iotago.Mana
 	if bicUpdateTime > output.SlotCreated() {
-		manaPotential, err := manaDecayProvider.GenerateManaAndDecayBySlots(excessBaseTokens, output.SlotCreated(), bicUpdateTime)
+		manaPotential, err := m.generateManaAndDecayBySlots(excessBaseTokens, output.SlotCreated(), bicUpdateTime)
 		if err != nil {
 			return nil, ierrors.Wrapf(err, "failed to calculate mana generation with decay (excessBaseTokens: %d; outputSlotCreated: %d; targetSlot: %d)", excessBaseTokens, output.SlotCreated(), bicUpdateTime)
 		}
 
-		manaStored, err := manaDecayProvider.DecayManaBySlots(output.StoredMana(), output.SlotCreated(), bicUpdateTime)
+		manaStored, err := m.decayManaBySlots(output.StoredMana(), output.SlotCreated(), bicUpdateTime)
 		if err != nil {
 			return nil, ierrors.Wrapf(err, "failed to calculate mana with decay (storedMana: %d; outputSlotCreated: %d; targetSlot: %d)", output.StoredMana(), output.SlotCreated(), bicUpdateTime)
 		}
@@ -147,7 +142,7 @@ func (m *Manager) getMana(accountID iotago.AccountID, output *utxoledger.Output,
 		manaUpdateTime = bicUpdateTime
 	} else if output.SlotCreated() > bicUpdateTime {
 		// Decay BIC to match the Output creation time.
-		bicWithDecay, err := manaDecayProvider.DecayManaBySlots(bic, bicUpdateTime, output.SlotCreated())
+		bicWithDecay, err := m.decayManaBySlots(bic, bicUpdateTime, output.SlotCreated())
 		if err != nil {
 			return nil, err
 		}
@@ -210,6 +205,80 @@ func (m *Manager) ApplyDiff(slot iotago.SlotIndex, destroyedAccounts ds.Set[iota
 	return nil
 }
 
+// decayManaBySlots applies the decay between the creation and target slots to the given mana. The decay of every epoch
+// is calculated with the decay parameters of the protocol version that was active in that epoch.
+func (m *Manager) decayManaBySlots(mana iotago.Mana, creationSlot iotago.SlotIndex, targetSlot iotago.SlotIndex) (iotago.Mana, error) {
+	for _, period := range m.decayPeriods(creationSlot, targetSlot) {
+		var err error
+		if mana, err = period.decayProvider.DecayManaBySlots(mana, period.startSlot, period.endSlot); err != nil {
+			return 0, ierrors.Wrapf(err, "failed to decay mana between slots %d and %d", period.startSlot, period.endSlot)
+		}
+	}
+
+	return mana, nil
+}
+
+// generateManaAndDecayBySlots generates mana from the given base token amount between the creation and target slots
+// and returns the decayed result. The generation and decay of every epoch is calculated with the parameters of the
+// protocol version that was active in that epoch.
+func (m *Manager) generateManaAndDecayBySlots(amount iotago.BaseToken, creationSlot iotago.SlotIndex, targetSlot iotago.SlotIndex) (iotago.Mana, error) {
+	var generatedMana iotago.Mana
+	for _, period := range m.decayPeriods(creationSlot, targetSlot) {
+		decayedMana, err := period.decayProvider.DecayManaBySlots(generatedMana, period.startSlot, period.endSlot)
+		if err != nil {
+			return 0, ierrors.Wrapf(err, "failed to decay generated mana between slots %d and %d", period.startSlot, period.endSlot)
+		}
+
+		manaPotential, err := period.decayProvider.GenerateManaAndDecayBySlots(amount, period.startSlot, period.endSlot)
+		if err != nil {
+			return 0, ierrors.Wrapf(err, "failed to generate mana between slots %d and %d", period.startSlot, period.endSlot)
+		}
+
+		if generatedMana, err = safemath.SafeAdd(decayedMana, manaPotential); err != nil {
+			return 0, ierrors.Wrapf(err, "overflow when adding generated mana between slots %d and %d", period.startSlot, period.endSlot)
+		}
+	}
+
+	return generatedMana, nil
+}
+
+// decayPeriods splits the given slot range at the start of the epochs that activated a new protocol version and
+// returns the resulting periods together with the decay provider of the protocol version that was active in them.
+//
+// The decay that is applied when entering the epoch of an upgrade is still calculated with the parameters of the
+// previous protocol version, as it accounts for the time that the mana was held before the upgrade.
+func (m *Manager) decayPeriods(startSlot iotago.SlotIndex, endSlot iotago.SlotIndex) []*decayPeriod {
+	currentAPI := m.apiProvider.APIForSlot(startSlot)
+
+	// the protocol versions only ever increase, so there is no upgrade in between if both ends share the same version
+	if startSlot >= endSlot || m.apiProvider.APIForSlot(endSlot).Version() == currentAPI.Version() {
+		return []*decayPeriod{{decayProvider: currentAPI.ManaDecayProvider(), startSlot: startSlot, endSlot: endSlot}}
+	}
+
+	periods := make([]*decayPeriod, 0)
+	periodStartSlot := startSlot
+	timeProvider := currentAPI.TimeProvider()
+	for epoch := timeProvider.EpochFromSlot(startSlot) + 1; epoch <= timeProvider.EpochFromSlot(endSlot); epoch++ {
+		if epochAPI := m.apiProvider.APIForEpoch(epoch); epochAPI.Version() != currentAPI.Version() {
+			epochStartSlot := timeProvider.EpochStart(epoch)
+
+			periods = append(periods, &decayPeriod{decayProvider: currentAPI.ManaDecayProvider(), startSlot: periodStartSlot, endSlot: epochStartSlot})
+
+			currentAPI = epochAPI
+			periodStartSlot = epochStartSlot
+		}
+	}
+
+	return append(periods, &decayPeriod{decayProvider: currentAPI.ManaDecayProvider(), startSlot: periodStartSlot, endSlot: endSlot})
+}
+
+// decayPeriod is a slot range in which the same decay parameters are active.
+type decayPeriod struct {
+	decayProvider *iotago.ManaDecayProvider
+	startSlot     iotago.SlotIndex
+	endSlot       iotago.SlotIndex
+}
+
 func (m *Manager) getBIC(accountID iotago.AccountID, slot iotago.SlotIndex) (bic iotago.Mana, updateTime iotago.SlotIndex, err error) {
 	accountBIC, exists, err := m.accountRetrieveFunc(accountID, slot)
 	if err != nil {
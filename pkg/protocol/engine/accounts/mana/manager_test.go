@@ -6,6 +6,7 @@ import (
 	"github.com/stretchr/testify/require"
 
 	"github.com/iotaledger/hive.go/core/safemath"
+	"github.com/iotaledger/hive.go/ds"
 	"github.com/iotaledger/hive.go/lo"
 	"github.com/iotaledger/iota-core/pkg/model"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/accounts"
@@ -202,3 +203,114 @@ func TestManager_GetManaOnAccountOverflow(t *testing.T) {
 	}
 
 }
+
+func TestManager_ManaAcrossProtocolUpgrade(t *testing.T) {
+	const upgradeEpoch = iotago.EpochIndex(2)
+
+	apiProvider := iotago.NewEpochBasedProvider(
+		iotago.WithAPIForMissingVersionCallback(func(params iotago.ProtocolParameters) (iotago.API, error) {
+			return iotago.V3API(params), nil
+		}),
+	)
+	apiProvider.AddProtocolParametersAtEpoch(iotago.NewV3SnapshotProtocolParameters(
+		iotago.WithVersion(3),
+		iotago.WithTimeProviderOptions(0, 0, 10, 13),
+	), 0)
+	apiProvider.AddProtocolParametersAtEpoch(iotago.NewV3SnapshotProtocolParameters(
+		iotago.WithVersion(4),
+		iotago.WithTimeProviderOptions(0, 0, 10, 13),
+		iotago.WithSupplyOptions(1813620509061365, 63, 2, 17, 32, 21, 50),
+	), upgradeEpoch)
+
+	oldDecayProvider := apiProvider.APIForEpoch(0).ManaDecayProvider()
+	newDecayProvider := apiProvider.APIForEpoch(upgradeEpoch).ManaDecayProvider()
+	upgradeSlot := apiProvider.LatestAPI().TimeProvider().EpochStart(upgradeEpoch)
+	targetSlot := apiProvider.LatestAPI().TimeProvider().EpochStart(upgradeEpoch+1) + 5
+
+	accountID := tpkg.RandAccountID()
+	bic := iotago.Mana(500_000)
+	createAccountOutput := func(slotCreated iotago.SlotIndex, storedMana iotago.Mana) *utxoledger.Output {
+		return utxoledger.CreateOutput(
+			apiProvider,
+			iotago.OutputIDFromTransactionIDAndIndex(iotago.NewTransactionID(slotCreated, tpkg.Rand32ByteArray()), 0),
+			tpkg.RandBlockID(),
+			slotCreated,
+			&iotago.AccountOutput{
+				Amount:    1_000_000_000,
+				Mana:      storedMana,
+				AccountID: accountID,
+			},
+			lo.PanicOnErr(iotago.NewOutputIDProof(tpkg.ZeroCostTestAPI, tpkg.Rand32ByteArray(), slotCreated, iotago.TxEssenceOutputs{tpkg.RandBasicOutput(iotago.AddressEd25519)}, 0)),
+		)
+	}
+
+	accountOutput := createAccountOutput(1, 1_000_000)
+	excessBaseTokens := accountOutput.BaseTokenAmount() - lo.PanicOnErr(apiProvider.APIForSlot(1).StorageScoreStructure().MinDeposit(accountOutput.Output()))
+
+	manager := NewManager(apiProvider, func(iotago.AccountID, iotago.SlotIndex) (*utxoledger.Output, error) {
+		return accountOutput, nil
+	}, func(id iotago.AccountID, _ iotago.SlotIndex) (*accounts.AccountData, bool, error) {
+		return &accounts.AccountData{
+			ID:         id,
+			Credits:    &accounts.BlockIssuanceCredits{Value: iotago.BlockIssuanceCredits(bic)},
+			ExpirySlot: iotago.MaxSlotIndex,
+		}, true, nil
+	})
+
+	// decayAcrossUpgrade decays the given mana from the given slot to the target slot with the decay parameters of
+	// the protocol versions before and after the upgrade.
+	decayAcrossUpgrade := func(mana iotago.Mana, slot iotago.SlotIndex) iotago.Mana {
+		return lo.PanicOnErr(newDecayProvider.DecayManaBySlots(lo.PanicOnErr(oldDecayProvider.DecayManaBySlots(mana, slot, upgradeSlot)), upgradeSlot, targetSlot))
+	}
+
+	// The cache entry is created in the epoch before the upgrade.
+	{
+		mana, err := manager.GetManaOnAccount(accountID, 1)
+		require.NoError(t, err)
+		require.EqualValues(t, bic+accountOutput.StoredMana(), mana)
+	}
+
+	// Projecting the mana to a slot before the upgrade only uses the old parameters.
+	{
+		slot := upgradeSlot - 1
+
+		mana, err := manager.GetManaOnAccount(accountID, slot)
+		require.NoError(t, err)
+
+		decayedMana := lo.PanicOnErr(oldDecayProvider.DecayManaBySlots(bic+accountOutput.StoredMana(), 1, slot))
+		generatedMana := lo.PanicOnErr(oldDecayProvider.GenerateManaAndDecayBySlots(excessBaseTokens, 1, slot))
+		require.EqualValues(t, decayedMana+generatedMana, mana)
+	}
+
+	// Projecting the mana across the upgrade uses the old parameters until the upgrade and the new ones afterward.
+	{
+		mana, err := manager.GetManaOnAccount(accountID, targetSlot)
+		require.NoError(t, err)
+
+		decayedMana := decayAcrossUpgrade(bic+accountOutput.StoredMana(), 1)
+		generatedMana := decayAcrossUpgrade(lo.PanicOnErr(oldDecayProvider.GenerateManaAndDecayBySlots(excessBaseTokens, 1, upgradeSlot)), upgradeSlot) +
+			lo.PanicOnErr(newDecayProvider.GenerateManaAndDecayBySlots(excessBaseTokens, upgradeSlot, targetSlot))
+		require.EqualValues(t, decayedMana+generatedMana, mana)
+
+		// Make sure that the result differs from applying the new parameters to the whole range.
+		decayedManaNewParameters := lo.PanicOnErr(newDecayProvider.DecayManaBySlots(bic+accountOutput.StoredMana(), 1, targetSlot))
+		generatedManaNewParameters := lo.PanicOnErr(newDecayProvider.GenerateManaAndDecayBySlots(excessBaseTokens, 1, targetSlot))
+		require.NotEqualValues(t, decayedManaNewParameters+generatedManaNewParameters, mana)
+	}
+
+	// Applying a diff after the upgrade decays the BIC across the upgrade to the creation slot of the new output.
+	{
+		newAccountOutput := createAccountOutput(targetSlot, 2_000_000)
+
+		require.NoError(t, manager.ApplyDiff(targetSlot, ds.NewSet[iotago.AccountID](), map[iotago.AccountID]*utxoledger.Output{
+			accountID: newAccountOutput,
+		}, map[iotago.AccountID]*model.AccountDiff{
+			accountID: model.NewAccountDiff(),
+		}))
+
+		cachedMana, exists := manager.manaVectorCache.Get(accountID)
+		require.True(t, exists)
+		require.EqualValues(t, targetSlot, cachedMana.UpdateTime())
+		require.EqualValues(t, decayAcrossUpgrade(bic, 0)+newAccountOutput.StoredMana(), cachedMana.Value())
+	}
+}
@@ -18,6 +18,11 @@ type Attestations interface {
 	// If attestationCommitmentOffset=3 and commitment is 10, then the returned attestations are blocks from 7 to 10 that commit to at least 7.
 	GetMap(index iotago.SlotIndex) (attestations ads.Map[iotago.Identifier, iotago.AccountID, *iotago.Attestation], err error)
 	AddAttestationFromValidationBlock(block *blocks.Block) error
+
+	// LatestAttestation returns the newest tracked attestation of the given committee member and the slot of the block
+	// it was issued in.
+	LatestAttestation(accountID iotago.AccountID) (attestation *iotago.Attestation, slot iotago.SlotIndex, exists bool, err error)
+
 	Commit(index iotago.SlotIndex) (newCW uint64, attestationsRoot iotago.Identifier, err error)
 
 	Import(reader io.ReadSeeker) (err error)
@@ -0,0 +1,46 @@
+package attestation
+
+import (
+	"github.com/iotaledger/hive.go/runtime/event"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+type Events struct {
+	// ValidatorEquivocated is triggered when a committee member issued two different validation blocks with the same
+	// issuing time.
+	ValidatorEquivocated *event.Event1[*Equivocation]
+
+	event.Group[Events, *Events]
+}
+
+// NewEvents contains the constructor of the Events object (it is generated by a generic factory).
+var NewEvents = event.CreateGroupConstructor(func() (newEvents *Events) {
+	return &Events{
+		ValidatorEquivocated: event.New1[*Equivocation](),
+	}
+})
+
+// Equivocation contains the conflicting attestations of a committee member.
+type Equivocation struct {
+	// IssuerID is the account of the committee member that issued the conflicting attestations.
+	IssuerID iotago.AccountID
+
+	// Attestation is the attestation that was tracked first.
+	Attestation *iotago.Attestation
+
+	// ConflictingAttestation is the attestation that conflicts with the tracked attestation.
+	ConflictingAttestation *iotago.Attestation
+}
+
+// BlockIDs returns the IDs of the blocks of the conflicting attestations.
+func (e *Equivocation) BlockIDs() (blockID iotago.BlockID, conflictingBlockID iotago.BlockID, err error) {
+	if blockID, err = e.Attestation.BlockID(); err != nil {
+		return iotago.EmptyBlockID, iotago.EmptyBlockID, err
+	}
+
+	if conflictingBlockID, err = e.ConflictingAttestation.BlockID(); err != nil {
+		return iotago.EmptyBlockID, iotago.EmptyBlockID, err
+	}
+
+	return blockID, conflictingBlockID, nil
+}
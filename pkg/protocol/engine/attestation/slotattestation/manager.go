@@ -3,6 +3,7 @@ package slotattestation
 import (
 	"github.com/iotaledger/hive.go/ads"
 	"github.com/iotaledger/hive.go/core/memstorage"
+	"github.com/iotaledger/hive.go/ds/shrinkingmap"
 	"github.com/iotaledger/hive.go/ierrors"
 	"github.com/iotaledger/hive.go/kvstore"
 	"github.com/iotaledger/hive.go/runtime/module"
@@ -62,6 +63,8 @@ type Manager struct {
 
 	apiProvider iotago.APIProvider
 
+	events *attestation.Events
+
 	module.Module
 }
 
@@ -69,13 +72,17 @@ func NewProvider() module.Provider[*engine.Engine, attestation.Attestations] {
 	return module.Provide(func(e *engine.Engine) attestation.Attestations {
 		latestCommitment := e.Storage.Settings().LatestCommitment()
 
-		return NewManager(
+		m := NewManager(
 			latestCommitment.Slot(),
 			latestCommitment.CumulativeWeight(),
 			e.Storage.Attestations,
 			e.SybilProtection.SeatManager().CommitteeInSlot,
 			e,
 		)
+
+		e.Events.Attestation.LinkTo(m.events)
+
+		return m
 	})
 }
 
@@ -94,6 +101,7 @@ func NewManager(
 		futureAttestations:   memstorage.NewIndexedStorage[iotago.SlotIndex, iotago.AccountID, *iotago.Attestation](),
 		pendingAttestations:  memstorage.NewIndexedStorage[iotago.SlotIndex, iotago.AccountID, *iotago.Attestation](),
 		apiProvider:          apiProvider,
+		events:               attestation.NewEvents(),
 	}
 	m.TriggerConstructed()
 
@@ -171,11 +179,21 @@ func (m *Manager) AddAttestationFromValidationBlock(block *blocks.Block) error {
 	newAttestation := iotago.NewAttestation(m.apiProvider.APIForSlot(block.ID().Slot()), block.ProtocolBlock())
 
 	// We keep only the latest attestation for each committee member.
+	var equivocation *attestation.Equivocation
 	m.futureAttestations.Get(block.ID().Slot(), true).Compute(block.ProtocolBlock().Header.IssuerID, func(currentValue *iotago.Attestation, exists bool) *iotago.Attestation {
 		if !exists {
 			return newAttestation
 		}
 
+		// An honest committee member never issues two different blocks with the same issuing time.
+		if isEquivocation(currentValue, newAttestation) {
+			equivocation = &attestation.Equivocation{
+				IssuerID:               block.ProtocolBlock().Header.IssuerID,
+				Attestation:            currentValue,
+				ConflictingAttestation: newAttestation,
+			}
+		}
+
 		// Replace the attestation only if the new one is greater.
 		if newAttestation.Compare(currentValue) == 1 {
 			return newAttestation
@@ -184,9 +202,84 @@ func (m *Manager) AddAttestationFromValidationBlock(block *blocks.Block) error {
 		return currentValue
 	})
 
+	if equivocation != nil {
+		m.events.ValidatorEquivocated.Trigger(equivocation)
+	}
+
 	return nil
 }
 
+// Events returns the events of the component.
+func (m *Manager) Events() *attestation.Events {
+	return m.events
+}
+
+// LatestAttestation returns the newest attestation of the given committee member that is tracked by the component
+// (future, pending or committed at the last committed slot) and the slot of the block it was issued in.
+func (m *Manager) LatestAttestation(accountID iotago.AccountID) (latestAttestation *iotago.Attestation, slot iotago.SlotIndex, exists bool, err error) {
+	m.commitmentMutex.RLock()
+	defer m.commitmentMutex.RUnlock()
+
+	updateLatestAttestation := func(candidate *iotago.Attestation) {
+		if latestAttestation == nil || candidate.Compare(latestAttestation) == 1 {
+			latestAttestation = candidate
+		}
+	}
+
+	for _, trackedAttestations := range []*memstorage.IndexedStorage[iotago.SlotIndex, iotago.AccountID, *iotago.Attestation]{m.futureAttestations, m.pendingAttestations} {
+		trackedAttestations.ForEach(func(_ iotago.SlotIndex, storage *shrinkingmap.ShrinkingMap[iotago.AccountID, *iotago.Attestation]) {
+			if trackedAttestation, tracked := storage.Get(accountID); tracked {
+				updateLatestAttestation(trackedAttestation)
+			}
+		})
+	}
+
+	if _, isValid := m.computeAttestationCommitmentOffset(m.lastCommittedSlot); isValid {
+		committedAttestations, err := m.attestationsForSlot(m.lastCommittedSlot)
+		if err != nil {
+			return nil, 0, false, ierrors.Wrapf(err, "failed to get attestations of slot %d", m.lastCommittedSlot)
+		}
+
+		committedAttestation, committed, err := committedAttestations.Get(accountID)
+		if err != nil {
+			return nil, 0, false, ierrors.Wrapf(err, "failed to get attestation of %s in slot %d", accountID, m.lastCommittedSlot)
+		} else if committed {
+			updateLatestAttestation(committedAttestation)
+		}
+	}
+
+	if latestAttestation == nil {
+		return nil, 0, false, nil
+	}
+
+	blockID, err := latestAttestation.BlockID()
+	if err != nil {
+		return nil, 0, false, ierrors.Wrapf(err, "failed to get block ID of attestation of %s", accountID)
+	}
+
+	return latestAttestation, blockID.Slot(), true, nil
+}
+
+// isEquivocation returns true if the given attestations of the same committee member belong to different blocks with
+// the same issuing time.
+func isEquivocation(attestation *iotago.Attestation, otherAttestation *iotago.Attestation) bool {
+	if !attestation.Header.IssuingTime.Equal(otherAttestation.Header.IssuingTime) {
+		return false
+	}
+
+	blockID, err := attestation.BlockID()
+	if err != nil {
+		return false
+	}
+
+	otherBlockID, err := otherAttestation.BlockID()
+	if err != nil {
+		return false
+	}
+
+	return blockID != otherBlockID
+}
+
 func (m *Manager) applyToPendingAttestations(attestation *iotago.Attestation, cutoffSlot iotago.SlotIndex) {
 	if attestation.Header.SlotCommitmentID.Slot() < cutoffSlot {
 		return
@@ -2,6 +2,10 @@ package slotattestation_test
 
 import (
 	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/attestation"
 )
 
 func TestManager(t *testing.T) {
@@ -77,3 +81,64 @@ func TestManager(t *testing.T) {
 		})
 	}
 }
+
+func TestManager_ValidatorEquivocated(t *testing.T) {
+	tf := NewTestFramework(t)
+
+	var equivocations []*attestation.Equivocation
+	tf.Instance.Events().ValidatorEquivocated.Hook(func(equivocation *attestation.Equivocation) {
+		equivocations = append(equivocations, equivocation)
+	})
+
+	tf.AddFutureAttestation("A", "A.2-0", 2, 0)
+	tf.AddFutureAttestation("A", "A.2.2-0", 2, 0)
+
+	// Adding the same block again is not an equivocation.
+	tf.AddEquivocatingFutureAttestation("A", "A.2.2-0*", "A.2.2-0", 0)
+	require.Empty(t, equivocations)
+
+	tf.AddEquivocatingFutureAttestation("A", "A.2.2-1", "A.2.2-0", 1)
+	require.Len(t, equivocations, 1)
+	require.Equal(t, tf.issuer("A").accountID, equivocations[0].IssuerID)
+	require.Equal(t, tf.attestation("A.2.2-0"), equivocations[0].Attestation)
+	require.Equal(t, tf.attestation("A.2.2-1"), equivocations[0].ConflictingAttestation)
+}
+
+func TestManager_LatestAttestation(t *testing.T) {
+	tf := NewTestFramework(t)
+
+	tf.AssertCommit(0, 0, map[string]string{}, true)
+	tf.AssertLatestAttestation("A", "", 0)
+
+	// future attestations are tracked
+	tf.AddFutureAttestation("A", "A.1-0", 1, 0)
+	tf.AddFutureAttestation("B", "B.1-0", 1, 0)
+	tf.AssertLatestAttestation("A", "A.1-0", 1)
+
+	tf.AddFutureAttestation("A", "A.2-0", 2, 0)
+	tf.AssertLatestAttestation("A", "A.2-0", 2)
+	tf.AssertLatestAttestation("B", "B.1-0", 1)
+
+	// pending attestations are tracked
+	tf.AssertCommit(1, 0, map[string]string{}, true)
+	tf.AssertLatestAttestation("A", "A.2-0", 2)
+	tf.AssertLatestAttestation("B", "B.1-0", 1)
+
+	// committed attestations are tracked
+	tf.AssertCommit(2, 2, map[string]string{
+		"A": "A.2-0",
+		"B": "B.1-0",
+	})
+	tf.AssertLatestAttestation("A", "A.2-0", 2)
+	tf.AssertLatestAttestation("B", "B.1-0", 1)
+
+	tf.AddFutureAttestation("B", "B.3-1", 3, 1)
+	tf.AssertLatestAttestation("B", "B.3-1", 3)
+
+	// attestations that fell out of the attestation window are no longer tracked
+	tf.AssertCommit(3, 3, map[string]string{
+		"B": "B.3-1",
+	})
+	tf.AssertLatestAttestation("A", "", 0)
+	tf.AssertLatestAttestation("B", "B.3-1", 3)
+}
@@ -107,9 +107,23 @@ func (t *TestFramework) AddFutureAttestation(issuerAlias string, attestationAlia
 	t.mutex.Lock()
 	defer t.mutex.Unlock()
 
-	issuer := t.issuer(issuerAlias)
 	issuingTime := t.testAPI.TimeProvider().SlotStartTime(blockSlot).Add(time.Duration(t.uniqueCounter.Add(1))).UTC()
 
+	t.addAttestation(issuerAlias, attestationAlias, issuingTime, attestedSlot)
+}
+
+// AddEquivocatingFutureAttestation adds an attestation that has the same issuing time as the given attestation of the
+// issuer but attests to a different slot.
+func (t *TestFramework) AddEquivocatingFutureAttestation(issuerAlias string, attestationAlias string, equivocatedAttestationAlias string, attestedSlot iotago.SlotIndex) {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	t.addAttestation(issuerAlias, attestationAlias, t.attestation(equivocatedAttestationAlias).Header.IssuingTime, attestedSlot)
+}
+
+func (t *TestFramework) addAttestation(issuerAlias string, attestationAlias string, issuingTime time.Time, attestedSlot iotago.SlotIndex) {
+	issuer := t.issuer(issuerAlias)
+
 	block, err := builder.NewValidationBlockBuilder(t.testAPI).
 		IssuingTime(issuingTime).
 		SlotCommitmentID(iotago.NewCommitment(t.testAPI.Version(), attestedSlot, iotago.CommitmentID{}, iotago.Identifier{}, 0, 0).MustID()).
@@ -185,3 +199,23 @@ func (t *TestFramework) AssertCommit(slot iotago.SlotIndex, expectedCW uint64, e
 
 	require.Equal(t.test, iotago.Identifier(expectedTree.Root()), root)
 }
+
+// AssertLatestAttestation asserts that the given attestation is the newest tracked attestation of the issuer (no
+// attestation is expected to be tracked if the attestation alias is empty).
+func (t *TestFramework) AssertLatestAttestation(issuerAlias string, expectedAttestationAlias string, expectedSlot iotago.SlotIndex) {
+	t.mutex.RLock()
+	defer t.mutex.RUnlock()
+
+	latestAttestation, slot, exists, err := t.Instance.LatestAttestation(t.issuer(issuerAlias).accountID)
+	require.NoError(t.test, err)
+
+	if expectedAttestationAlias == "" {
+		require.Falsef(t.test, exists, "expected no attestation of %s to be tracked", issuerAlias)
+
+		return
+	}
+
+	require.Truef(t.test, exists, "expected attestation %s of %s to be tracked", expectedAttestationAlias, issuerAlias)
+	require.Equal(t.test, t.attestation(expectedAttestationAlias), latestAttestation)
+	require.Equal(t.test, expectedSlot, slot)
+}
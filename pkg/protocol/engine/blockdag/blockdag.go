@@ -16,6 +16,9 @@ type BlockDAG interface {
 	// without requesting it.
 	GetOrRequestBlock(blockID iotago.BlockID) (block *blocks.Block, requested bool)
 
+	// PendingTasks returns the number of blocks that are waiting to be attached, solidified and booked.
+	PendingTasks() int
+
 	// Reset resets the component to a clean state as if it was created at the last commitment.
 	Reset()
 
@@ -10,6 +10,7 @@ import (
 	"github.com/iotaledger/hive.go/runtime/workerpool"
 	"github.com/iotaledger/iota-core/pkg/core/buffer"
 	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/profiling"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/blockdag"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
@@ -41,10 +42,10 @@ type BlockDAG struct {
 
 func NewProvider(opts ...options.Option[BlockDAG]) module.Provider[*engine.Engine, blockdag.BlockDAG] {
 	return module.Provide(func(e *engine.Engine) blockdag.BlockDAG {
-		b := New(e.Workers.CreateGroup("BlockDAG"), int(e.Storage.Settings().APIProvider().CommittedAPI().ProtocolParameters().MaxCommittableAge())*2, e.EvictionState, e.BlockCache, e.ErrorHandler("blockdag"), opts...)
+		b := New(profiling.CreateGroup(e.Workers, "BlockDAG"), int(e.Storage.Settings().APIProvider().CommittedAPI().ProtocolParameters().MaxCommittableAge())*2, e.EvictionState, e.BlockCache, e.ErrorHandler("blockdag"), opts...)
 
 		e.Constructed.OnTrigger(func() {
-			wp := b.workers.CreatePool("BlockDAG.Attach", workerpool.WithWorkerCount(2))
+			wp := profiling.CreatePool(b.workers, "BlockDAG.Attach", workerpool.WithWorkerCount(2))
 
 			e.Events.PreSolidFilter.BlockPreAllowed.Hook(func(block *model.Block) {
 				if _, _, err := b.Attach(block); err != nil {
@@ -149,6 +150,16 @@ func (b *BlockDAG) GetOrRequestBlock(blockID iotago.BlockID) (block *blocks.Bloc
 	})
 }
 
+// PendingTasks returns the number of blocks that are waiting to be attached, solidified and booked (the booker runs in
+// the worker pools of the BlockDAG).
+func (b *BlockDAG) PendingTasks() (pendingTasks int) {
+	for _, workerPool := range b.workers.Pools() {
+		pendingTasks += workerPool.PendingTasksCounter.Get()
+	}
+
+	return pendingTasks
+}
+
 // Reset resets the component to a clean state as if it was created at the last commitment.
 func (b *BlockDAG) Reset() {
 	b.uncommittedSlotBlocks.Reset()
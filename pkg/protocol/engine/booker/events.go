@@ -4,13 +4,16 @@ import (
 	"github.com/iotaledger/hive.go/runtime/event"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/mempool"
+	iotago "github.com/iotaledger/iota.go/v4"
 )
 
 type Events struct {
-	BlockBooked         *event.Event1[*blocks.Block]
-	BlockInvalid        *event.Event2[*blocks.Block, error]
-	TransactionAccepted *event.Event1[mempool.TransactionMetadata]
-	TransactionInvalid  *event.Event2[mempool.TransactionMetadata, error]
+	BlockBooked          *event.Event1[*blocks.Block]
+	BlockInvalid         *event.Event2[*blocks.Block, error]
+	TransactionAttached  *event.Event1[mempool.TransactionMetadata]
+	TransactionAccepted  *event.Event1[mempool.TransactionMetadata]
+	TransactionCommitted *event.Event2[mempool.TransactionMetadata, iotago.SlotIndex]
+	TransactionInvalid   *event.Event2[mempool.TransactionMetadata, error]
 
 	event.Group[Events, *Events]
 }
@@ -18,9 +21,11 @@ type Events struct {
 // NewEvents contains the constructor of the Events object (it is generated by a generic factory).
 var NewEvents = event.CreateGroupConstructor(func() (newEvents *Events) {
 	return &Events{
-		BlockBooked:         event.New1[*blocks.Block](),
-		BlockInvalid:        event.New2[*blocks.Block, error](),
-		TransactionAccepted: event.New1[mempool.TransactionMetadata](),
-		TransactionInvalid:  event.New2[mempool.TransactionMetadata, error](),
+		BlockBooked:          event.New1[*blocks.Block](),
+		BlockInvalid:         event.New2[*blocks.Block, error](),
+		TransactionAttached:  event.New1[mempool.TransactionMetadata](),
+		TransactionAccepted:  event.New1[mempool.TransactionMetadata](),
+		TransactionCommitted: event.New2[mempool.TransactionMetadata, iotago.SlotIndex](),
+		TransactionInvalid:   event.New2[mempool.TransactionMetadata, error](),
 	}
 })
@@ -6,6 +6,7 @@ import (
 	"github.com/iotaledger/hive.go/ds"
 	"github.com/iotaledger/hive.go/ierrors"
 	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/hive.go/log"
 	"github.com/iotaledger/hive.go/runtime/module"
 	"github.com/iotaledger/hive.go/runtime/options"
 	"github.com/iotaledger/iota-core/pkg/model"
@@ -34,21 +35,32 @@ type Booker struct {
 	errorHandler func(error)
 	apiProvider  iotago.APIProvider
 
+	log.Logger
+
 	module.Module
 }
 
 func NewProvider(opts ...options.Option[Booker]) module.Provider[*engine.Engine, booker.Booker] {
 	return module.Provide(func(e *engine.Engine) booker.Booker {
-		b := New(e, e.BlockCache, e.ErrorHandler("booker"), opts...)
+		logger := e.NewChildLogger("Booker")
+
+		b := New(logger, e, e.BlockCache, e.ErrorHandler("booker"), opts...)
+		b.HookStopped(logger.UnsubscribeFromParentLogger)
+
 		e.Constructed.OnTrigger(func() {
 			b.ledger = e.Ledger
 			b.ledger.HookConstructed(func() {
 				b.spendDAG = b.ledger.SpendDAG()
 				b.loadBlockFromStorage = e.Block
 				b.ledger.MemPool().OnTransactionAttached(func(transaction mempool.TransactionMetadata) {
+					b.events.TransactionAttached.Trigger(transaction)
+
 					transaction.OnAccepted(func() {
 						b.events.TransactionAccepted.Trigger(transaction)
 					})
+					transaction.OnCommittedSlotUpdated(func(slot iotago.SlotIndex) {
+						b.events.TransactionCommitted.Trigger(transaction, slot)
+					})
 					transaction.OnInvalid(func(err error) {
 						b.events.TransactionInvalid.Trigger(transaction, err)
 					})
@@ -72,8 +84,9 @@ func NewProvider(opts ...options.Option[Booker]) module.Provider[*engine.Engine,
 	})
 }
 
-func New(apiProvider iotago.APIProvider, blockCache *blocks.Blocks, errorHandler func(error), opts ...options.Option[Booker]) *Booker {
+func New(logger log.Logger, apiProvider iotago.APIProvider, blockCache *blocks.Blocks, errorHandler func(error), opts ...options.Option[Booker]) *Booker {
 	return options.Apply(&Booker{
+		Logger:      logger,
 		events:      booker.NewEvents(),
 		apiProvider: apiProvider,
 
@@ -141,6 +154,8 @@ func (b *Booker) setupBlock(block *blocks.Block) {
 			if unbookedParentsCount.Add(-1) == 0 {
 				if err := b.book(block); err != nil {
 					if block.SetInvalid() {
+						b.LogDebug("failed to book block", "block", block.ID(), "err", err)
+
 						b.events.BlockInvalid.Trigger(block, ierrors.Wrap(err, "failed to book block"))
 					}
 				}
@@ -182,6 +197,7 @@ func (b *Booker) book(block *blocks.Block) error {
 
 	block.SetSpenderIDs(spendersToInherit)
 	block.SetBooked()
+	b.LogTrace("block booked", "block", block.ID(), "spenders", spendersToInherit)
 	b.events.BlockBooked.Trigger(block)
 
 	return nil
@@ -10,6 +10,7 @@ import (
 	"github.com/iotaledger/hive.go/runtime/workerpool"
 
 	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/iota-core/pkg/profiling"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/clock"
@@ -38,7 +39,7 @@ func NewProvider(opts ...options.Option[Clock]) module.Provider[*engine.Engine,
 		return options.Apply(&Clock{
 			acceptedTime:  NewRelativeTime(),
 			confirmedTime: NewRelativeTime(),
-			workerPool:    e.Workers.CreatePool("Clock", workerpool.WithWorkerCount(1), workerpool.WithCancelPendingTasksOnShutdown(true), workerpool.WithPanicOnSubmitAfterShutdown(true)),
+			workerPool:    profiling.CreatePool(e.Workers, "Clock", workerpool.WithWorkerCount(1), workerpool.WithCancelPendingTasksOnShutdown(true), workerpool.WithPanicOnSubmitAfterShutdown(true)),
 		}, opts, func(c *Clock) {
 			e.Constructed.OnTrigger(func() {
 				latestCommitmentIndex := e.Storage.Settings().LatestCommitment().Slot()
@@ -3,11 +3,18 @@ package engine
 import (
 	"github.com/iotaledger/hive.go/ads"
 	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/iotaledger/hive.go/lo"
 	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/utxoledger"
 	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/api"
 	"github.com/iotaledger/iota.go/v4/merklehasher"
 )
 
+// ErrAcceptedBlocksMismatch is returned when the stored accepted blocks of a slot do not match its commitment.
+var ErrAcceptedBlocksMismatch = ierrors.New("accepted blocks do not match the commitment")
+
 // CommitmentAPI is a wrapper for the Engine that provides access to the data of a committed slot.
 type CommitmentAPI struct {
 	// engine is the Engine that is used to access the data.
@@ -64,6 +71,61 @@ func (c *CommitmentAPI) Attestations() (commitment *model.Commitment, attestatio
 	return commitment, attestations, roots.AttestationsProof(), nil
 }
 
+// Weight returns the accounting of the attestations that contributed to the cumulative weight of the commitment.
+func (c *CommitmentAPI) Weight() (*model.CommitmentWeight, error) {
+	commitment, attestations, _, err := c.Attestations()
+	if err != nil {
+		return nil, ierrors.Wrap(err, "failed to load attestations")
+	}
+
+	weight := &model.CommitmentWeight{
+		CommitmentID:     c.CommitmentID,
+		CumulativeWeight: commitment.CumulativeWeight(),
+		AttestedSeats:    make(model.SeatBitmap, 0),
+		Attestations:     make([]*model.SeatAttestation, 0, len(attestations)),
+	}
+
+	if commitment.Slot() > c.engine.CommittedAPI().ProtocolParameters().GenesisSlot() {
+		parent, err := c.engine.Storage.Commitments().Load(commitment.Slot() - 1)
+		if err != nil {
+			return nil, ierrors.Wrapf(err, "failed to load parent commitment for slot %d", commitment.Slot()-1)
+		}
+
+		weight.ParentCumulativeWeight = parent.CumulativeWeight()
+	}
+
+	if committee, exists := c.engine.SybilProtection.SeatManager().CommitteeInSlot(commitment.Slot()); exists {
+		weight.CommitteeSize = committee.SeatCount()
+	}
+
+	for _, attestation := range attestations {
+		blockID, err := attestation.BlockID()
+		if err != nil {
+			return nil, ierrors.Wrap(err, "failed to compute block id of attestation")
+		}
+
+		// attestations are weighted by the committee of the slot of the attested block.
+		committee, exists := c.engine.SybilProtection.SeatManager().CommitteeInSlot(blockID.Slot())
+		if !exists {
+			return nil, ierrors.Errorf("committee for slot %d does not exist", blockID.Slot())
+		}
+
+		seat, exists := committee.GetSeat(attestation.Header.IssuerID)
+		if !exists {
+			return nil, ierrors.Errorf("issuer %s of attestation %s is not part of the committee of slot %d", attestation.Header.IssuerID, blockID, blockID.Slot())
+		}
+
+		weight.AttestedSeats.Set(seat)
+		weight.Attestations = append(weight.Attestations, &model.SeatAttestation{
+			Seat:     seat,
+			IssuerID: attestation.Header.IssuerID,
+			BlockID:  blockID,
+		})
+	}
+
+	return weight, nil
+}
+
 // Mutations returns all accepted block IDs, the tangle proof, all accepted transaction IDs and the ledger state
 // mutation proof of the slot.
 func (c *CommitmentAPI) Mutations() (acceptedBlocksBySlotCommitment map[iotago.CommitmentID]iotago.BlockIDs, acceptedBlocksProof *merklehasher.Proof[iotago.Identifier], acceptedTransactionIDs iotago.TransactionIDs, acceptedTransactionsProof *merklehasher.Proof[iotago.Identifier], err error) {
@@ -84,6 +146,25 @@ func (c *CommitmentAPI) Mutations() (acceptedBlocksBySlotCommitment map[iotago.C
 	return acceptedBlocksBySlotCommitment, roots.TangleProof(), acceptedTransactionIDs, roots.MutationProof(), nil
 }
 
+// StateDiff returns the outputs that were created and consumed in the slot together with the roots of the slot.
+func (c *CommitmentAPI) StateDiff() (stateDiff *model.SlotStateDiff, err error) {
+	roots, err := c.Roots()
+	if err != nil {
+		return nil, ierrors.Wrap(err, "failed to get roots")
+	}
+
+	slotDiff, err := c.engine.Ledger.SlotDiffs(c.CommitmentID.Slot())
+	if err != nil {
+		return nil, ierrors.Wrapf(err, "failed to get slot diff of slot %d", c.CommitmentID.Slot())
+	}
+
+	return &model.SlotStateDiff{
+		CreatedOutputIDs:  lo.Map(slotDiff.Outputs, (*utxoledger.Output).OutputID),
+		ConsumedOutputIDs: lo.Map(slotDiff.Spents, (*utxoledger.Spent).OutputID),
+		Roots:             roots,
+	}, nil
+}
+
 // Roots returns the roots of the slot.
 func (c *CommitmentAPI) Roots() (committedRoots *iotago.Roots, err error) {
 	if c.engine.Storage.Settings().LatestCommitment().Slot() < c.CommitmentID.Slot() {
@@ -127,6 +208,133 @@ func (c *CommitmentAPI) BlocksIDsBySlotCommitmentID() (map[iotago.CommitmentID]i
 	return blockIDsBySlotCommitmentID, nil
 }
 
+// AcceptedBlockIDs returns the IDs of all blocks that were accepted in the slot (the leaves of its tangle root).
+func (c *CommitmentAPI) AcceptedBlockIDs() (iotago.BlockIDs, error) {
+	blockIDsBySlotCommitmentID, err := c.BlocksIDsBySlotCommitmentID()
+	if err != nil {
+		return nil, err
+	}
+
+	blockIDs := make(iotago.BlockIDs, 0)
+	for _, slotCommitmentBlockIDs := range blockIDsBySlotCommitmentID {
+		blockIDs = append(blockIDs, slotCommitmentBlockIDs...)
+	}
+
+	return blockIDs, nil
+}
+
+// StreamAcceptedBlockIDs passes the IDs of all blocks that were accepted in the slot to the consumer without collecting
+// them first. The streaming is aborted if the consumer returns an error.
+func (c *CommitmentAPI) StreamAcceptedBlockIDs(consumer func(blockID iotago.BlockID) error) error {
+	if c.engine.Storage.Settings().LatestCommitment().Slot() < c.CommitmentID.Slot() {
+		return ierrors.Errorf("slot %d is not committed yet", c.CommitmentID)
+	}
+
+	store, err := c.engine.Storage.Blocks(c.CommitmentID.Slot())
+	if err != nil {
+		return ierrors.Errorf("failed to get block store of slot index %d", c.CommitmentID.Slot())
+	}
+
+	if err := store.StreamKeys(consumer); err != nil {
+		return ierrors.Wrapf(err, "failed to iterate over blocks of slot %d", c.CommitmentID.Slot())
+	}
+
+	return nil
+}
+
+// VerifiedAcceptedBlockIDs returns the IDs of all blocks that were accepted in the slot after verifying that they
+// produce the tangle root of the commitment, so that they represent exactly the committed view of the slot.
+func (c *CommitmentAPI) VerifiedAcceptedBlockIDs() (iotago.BlockIDs, error) {
+	commitment, err := c.Commitment()
+	if err != nil {
+		return nil, ierrors.Wrap(err, "failed to load commitment")
+	}
+
+	roots, err := c.Roots()
+	if err != nil {
+		return nil, ierrors.Wrap(err, "failed to get roots")
+	}
+
+	if roots.ID() != commitment.RootsID() {
+		return nil, ierrors.Wrapf(ErrAcceptedBlocksMismatch, "roots %s of slot %d do not match the roots ID %s of commitment %s", roots.ID(), c.CommitmentID.Slot(), commitment.RootsID(), c.CommitmentID)
+	}
+
+	acceptedBlockIDs, err := c.AcceptedBlockIDs()
+	if err != nil {
+		return nil, ierrors.Wrap(err, "failed to get accepted block ids")
+	}
+
+	// rebuild the tree the same way as the notarization does when it commits the accepted blocks of a slot.
+	acceptedBlocks := ads.NewSet[iotago.Identifier](
+		mapdb.NewMapDB(),
+		iotago.Identifier.Bytes,
+		iotago.IdentifierFromBytes,
+		iotago.BlockID.Bytes,
+		iotago.BlockIDFromBytes,
+	)
+	for _, blockID := range acceptedBlockIDs {
+		if err := acceptedBlocks.Add(blockID); err != nil {
+			return nil, ierrors.Wrapf(err, "failed to add block %s to the accepted blocks tree", blockID)
+		}
+	}
+
+	if err := acceptedBlocks.Commit(); err != nil {
+		return nil, ierrors.Wrap(err, "failed to commit the accepted blocks tree")
+	}
+
+	if tangleRoot := acceptedBlocks.Root(); tangleRoot != roots.TangleRoot {
+		return nil, ierrors.Wrapf(ErrAcceptedBlocksMismatch, "rebuilt tangle root %s of slot %d does not match the committed tangle root %s", tangleRoot, c.CommitmentID.Slot(), roots.TangleRoot)
+	}
+
+	return acceptedBlockIDs, nil
+}
+
+// BlockInclusionProof returns the proof that the given block is part of the accepted blocks of the slot.
+func (c *CommitmentAPI) BlockInclusionProof(blockID iotago.BlockID) (*model.BlockInclusionProof, error) {
+	if blockID.Slot() != c.CommitmentID.Slot() {
+		return nil, ierrors.Errorf("block %s is not from slot %d", blockID, c.CommitmentID.Slot())
+	}
+
+	roots, err := c.Roots()
+	if err != nil {
+		return nil, ierrors.Wrap(err, "failed to get roots")
+	}
+
+	acceptedBlockIDs, err := c.AcceptedBlockIDs()
+	if err != nil {
+		return nil, ierrors.Wrap(err, "failed to get accepted block ids")
+	}
+
+	return model.NewBlockInclusionProof(blockID, acceptedBlockIDs, roots)
+}
+
+// ConfirmedBlockIDs returns the IDs of the accepted blocks of the slot that were also confirmed.
+func (c *CommitmentAPI) ConfirmedBlockIDs() (iotago.BlockIDs, error) {
+	acceptedBlockIDs, err := c.AcceptedBlockIDs()
+	if err != nil {
+		return nil, err
+	}
+
+	confirmedBlockIDs := make(iotago.BlockIDs, 0, len(acceptedBlockIDs))
+	for _, blockID := range acceptedBlockIDs {
+		blockMetadata, err := c.engine.Retainer.BlockMetadata(blockID)
+		if err != nil {
+			return nil, ierrors.Wrapf(err, "failed to load metadata of block %s", blockID)
+		}
+
+		if blockMetadata.BlockState == api.BlockStateConfirmed || blockMetadata.BlockState == api.BlockStateFinalized {
+			confirmedBlockIDs = append(confirmedBlockIDs, blockID)
+		}
+	}
+
+	return confirmedBlockIDs, nil
+}
+
+// IsFinalized returns true if the slot of the commitment is finalized.
+func (c *CommitmentAPI) IsFinalized() bool {
+	return c.CommitmentID.Slot() <= c.engine.Storage.Settings().LatestFinalizedSlot()
+}
+
 func (c *CommitmentAPI) TransactionIDs() (iotago.TransactionIDs, error) {
 	if c.engine.Storage.Settings().LatestCommitment().Slot() < c.CommitmentID.Slot() {
 		return nil, ierrors.Errorf("slot %d is not committed yet", c.CommitmentID)
@@ -0,0 +1,58 @@
+package drr
+
+import (
+	"github.com/iotaledger/hive.go/runtime/syncutils"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// BurstAllowance grants newly created accounts an initial deficit, so that they can get blocks scheduled before they
+// generated enough mana to be served by the DRR scheduler.
+//
+// Every account receives the allowance only once (when it is created) and the number of allowances that are granted per
+// slot is limited, so that the additional work that is scheduled through allowances stays bounded no matter how many
+// accounts are created (creating accounts to collect allowances is bounded by the cost of the account creation).
+type BurstAllowance struct {
+	// work is the work that every newly created account is allowed to get scheduled (0 = disabled).
+	work iotago.WorkScore
+
+	// maxGrantsPerSlot is the maximum number of allowances that are granted per slot (0 = unlimited).
+	maxGrantsPerSlot int
+
+	// slot is the slot that the grants are currently counted for.
+	slot iotago.SlotIndex
+
+	// grantsInSlot is the number of allowances that were granted in the current slot.
+	grantsInSlot int
+
+	mutex syncutils.Mutex
+}
+
+// NewBurstAllowance creates a new BurstAllowance.
+func NewBurstAllowance(work iotago.WorkScore, maxGrantsPerSlot int) *BurstAllowance {
+	return &BurstAllowance{
+		work:             work,
+		maxGrantsPerSlot: maxGrantsPerSlot,
+	}
+}
+
+// Grant returns the work that an account that was created in the given slot is allowed to get scheduled (if any).
+func (b *BurstAllowance) Grant(slot iotago.SlotIndex) (work iotago.WorkScore, granted bool) {
+	b.mutex.Lock()
+	defer b.mutex.Unlock()
+
+	if b.work == 0 {
+		return 0, false
+	}
+
+	if slot != b.slot {
+		b.slot = slot
+		b.grantsInSlot = 0
+	}
+
+	if b.maxGrantsPerSlot != 0 && b.grantsInSlot >= b.maxGrantsPerSlot {
+		return 0, false
+	}
+	b.grantsInSlot++
+
+	return b.work, true
+}
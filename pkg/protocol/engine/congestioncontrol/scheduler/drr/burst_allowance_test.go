@@ -0,0 +1,36 @@
+package drr
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/lo"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func TestBurstAllowance(t *testing.T) {
+	burstAllowance := NewBurstAllowance(100, 2)
+
+	requireGranted := func(slot iotago.SlotIndex, expectedGranted bool) {
+		work, granted := burstAllowance.Grant(slot)
+		require.Equal(t, expectedGranted, granted)
+		require.Equal(t, lo.Cond[iotago.WorkScore](expectedGranted, 100, 0), work)
+	}
+
+	// the number of allowances is limited per slot.
+	requireGranted(1, true)
+	requireGranted(1, true)
+	requireGranted(1, false)
+
+	// the limit is reset in the next slot.
+	requireGranted(2, true)
+	requireGranted(2, true)
+	requireGranted(2, false)
+}
+
+func TestBurstAllowance_Disabled(t *testing.T) {
+	work, granted := NewBurstAllowance(0, 0).Grant(1)
+	require.False(t, granted)
+	require.Zero(t, work)
+}
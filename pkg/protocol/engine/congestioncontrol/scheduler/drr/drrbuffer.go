@@ -275,6 +275,44 @@ func (b *BufferQueue) PopFront() *blocks.Block {
 	return block
 }
 
+// RemoveOlderThan removes all blocks (submitted and ready) that were issued before the given deadline from the queues
+// of all issuers and returns them.
+func (b *BufferQueue) RemoveOlderThan(deadline time.Time) (removedBlocks []*blocks.Block) {
+	b.activeIssuers.ForEach(func(_ iotago.AccountID, element *ring.Ring) bool {
+		issuerQueue, isIQ := element.Value.(*IssuerQueue)
+		if !isIQ {
+			panic("buffer contains elements that are not issuer queues")
+		}
+
+		issuerRemovedBlocks := issuerQueue.RemoveOlderThan(deadline)
+		b.size.Sub(int64(len(issuerRemovedBlocks)))
+		removedBlocks = append(removedBlocks, issuerRemovedBlocks...)
+
+		return true
+	})
+
+	return removedBlocks
+}
+
+// OldestIssuingTime returns the issuing time of the oldest block in the buffer.
+func (b *BufferQueue) OldestIssuingTime() (oldestIssuingTime time.Time, exists bool) {
+	b.activeIssuers.ForEach(func(_ iotago.AccountID, element *ring.Ring) bool {
+		issuerQueue, isIQ := element.Value.(*IssuerQueue)
+		if !isIQ {
+			panic("buffer contains elements that are not issuer queues")
+		}
+
+		if issuerOldestIssuingTime, issuerExists := issuerQueue.OldestIssuingTime(); issuerExists && (!exists || issuerOldestIssuingTime.Before(oldestIssuingTime)) {
+			oldestIssuingTime = issuerOldestIssuingTime
+			exists = true
+		}
+
+		return true
+	})
+
+	return oldestIssuingTime, exists
+}
+
 // IssuerIDs returns the issuerIDs of all issuers.
 func (b *BufferQueue) IssuerIDs() []iotago.AccountID {
 	var issuerIDs []iotago.AccountID
@@ -0,0 +1,72 @@
+package drr
+
+import (
+	"math"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
+	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/tpkg"
+)
+
+func newTestBlock(t *testing.T, issuerID iotago.AccountID, issuingTime time.Time) *blocks.Block {
+	block := tpkg.RandBasicBlockWithIssuerAndRMC(tpkg.ZeroCostTestAPI, issuerID, 0)
+	block.Header.IssuingTime = issuingTime
+
+	modelBlock, err := model.BlockFromBlock(block)
+	require.NoError(t, err)
+
+	return blocks.NewBlock(modelBlock)
+}
+
+func TestBufferQueue_RemoveOlderThan(t *testing.T) {
+	buffer := NewBufferQueue()
+	quantumFunc := func(iotago.AccountID) Deficit { return 1 }
+
+	now := time.Now()
+	deadline := now.Add(-time.Minute)
+
+	issuer1 := tpkg.RandAccountID()
+	issuer2 := tpkg.RandAccountID()
+
+	staleSubmitted := newTestBlock(t, issuer1, now.Add(-2*time.Minute))
+	staleReady := newTestBlock(t, issuer1, now.Add(-3*time.Minute))
+	freshReady := newTestBlock(t, issuer1, now)
+	freshSubmitted := newTestBlock(t, issuer2, now.Add(-time.Second))
+
+	for _, block := range []*blocks.Block{staleSubmitted, staleReady, freshReady, freshSubmitted} {
+		_, submitted := buffer.Submit(block, buffer.GetOrCreateIssuerQueue(block.ProtocolBlock().Header.IssuerID), quantumFunc, math.MaxInt)
+		require.True(t, submitted)
+	}
+	require.True(t, buffer.Ready(staleReady))
+	require.True(t, buffer.Ready(freshReady))
+
+	require.Equal(t, 4, buffer.Size())
+	require.Equal(t, 2, buffer.ReadyBlocksCount())
+
+	oldestIssuingTime, exists := buffer.OldestIssuingTime()
+	require.True(t, exists)
+	require.Equal(t, staleReady.IssuingTime(), oldestIssuingTime)
+
+	require.ElementsMatch(t, []*blocks.Block{staleSubmitted, staleReady}, buffer.RemoveOlderThan(deadline))
+
+	require.Equal(t, 2, buffer.Size())
+	require.Equal(t, 1, buffer.ReadyBlocksCount())
+	require.Equal(t, 1, buffer.IssuerQueue(issuer1).Size())
+	require.Equal(t, freshReady.WorkScore(), buffer.IssuerQueue(issuer1).Work())
+	require.Equal(t, freshReady, buffer.IssuerQueue(issuer1).Front())
+
+	oldestIssuingTime, exists = buffer.OldestIssuingTime()
+	require.True(t, exists)
+	require.Equal(t, freshSubmitted.IssuingTime(), oldestIssuingTime)
+
+	// evicted blocks can not be marked as ready anymore.
+	require.False(t, buffer.Ready(staleSubmitted))
+
+	require.Empty(t, buffer.RemoveOlderThan(deadline))
+	require.Equal(t, 2, buffer.Size())
+}
@@ -3,6 +3,7 @@ package drr
 import (
 	"container/heap"
 	"fmt"
+	"time"
 
 	"go.uber.org/atomic"
 
@@ -171,6 +172,60 @@ func (q *IssuerQueue) RemoveTail() *blocks.Block {
 	return blk
 }
 
+// RemoveOlderThan removes all blocks (submitted and ready) that were issued before the given deadline and returns them.
+func (q *IssuerQueue) RemoveOlderThan(deadline time.Time) (removedBlocks []*blocks.Block) {
+	q.submitted.ForEach(func(_ iotago.BlockID, block *blocks.Block) bool {
+		if block.IssuingTime().Before(deadline) {
+			removedBlocks = append(removedBlocks, block)
+		}
+
+		return true
+	})
+
+	for _, block := range removedBlocks {
+		q.Unsubmit(block)
+	}
+
+	retainedInbox := make(generalheap.Heap[timed.HeapKey, *blocks.Block], 0, q.inbox.Len())
+	for _, heapElement := range q.inbox {
+		if block := heapElement.Value; block.IssuingTime().Before(deadline) {
+			removedBlocks = append(removedBlocks, block)
+			q.size.Dec()
+			q.work.Sub(int64(block.WorkScore()))
+
+			continue
+		}
+
+		retainedInbox = append(retainedInbox, heapElement)
+	}
+
+	if len(retainedInbox) != q.inbox.Len() {
+		q.inbox = retainedInbox
+		heap.Init(&q.inbox)
+	}
+
+	return removedBlocks
+}
+
+// OldestIssuingTime returns the issuing time of the oldest block in the queue (submitted or ready).
+func (q *IssuerQueue) OldestIssuingTime() (oldestIssuingTime time.Time, exists bool) {
+	q.submitted.ForEach(func(_ iotago.BlockID, block *blocks.Block) bool {
+		if !exists || block.IssuingTime().Before(oldestIssuingTime) {
+			oldestIssuingTime = block.IssuingTime()
+			exists = true
+		}
+
+		return true
+	})
+
+	if front := q.Front(); front != nil && (!exists || front.IssuingTime().Before(oldestIssuingTime)) {
+		oldestIssuingTime = front.IssuingTime()
+		exists = true
+	}
+
+	return oldestIssuingTime, exists
+}
+
 func (q *IssuerQueue) tail() int {
 	h := q.inbox
 	if h.Len() <= 0 {
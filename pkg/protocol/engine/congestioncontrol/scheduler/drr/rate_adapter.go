@@ -0,0 +1,90 @@
+package drr
+
+import (
+	"time"
+
+	"github.com/iotaledger/hive.go/runtime/syncutils"
+)
+
+const (
+	// rateDecreaseFactor is the factor the rate factor is multiplied with when the downstream backlog is too high.
+	rateDecreaseFactor = 0.5
+
+	// rateRecoveryStep is the amount the rate factor recovers by per adaptation interval once the backlog is low again.
+	rateRecoveryStep = 0.1
+)
+
+// RateAdapter lowers the local dequeue rate of the scheduler while the downstream stages of the node are backed up.
+//
+// To avoid oscillation, the rate is only lowered once the backlog reaches the high watermark and it only recovers once
+// the backlog drops to the low watermark again (the rate factor stays unchanged in between).
+type RateAdapter struct {
+	// backlog returns the number of pending tasks of the downstream stages.
+	backlog func() int
+
+	// highWatermark is the backlog at which the rate is lowered (0 = disabled).
+	highWatermark int
+
+	// lowWatermark is the backlog at which the rate recovers.
+	lowWatermark int
+
+	// minRateFactor is the lower bound of the rate factor.
+	minRateFactor float64
+
+	// interval is the minimum duration between two adaptations.
+	interval time.Duration
+
+	// rateFactor is the factor that the scheduler rate is multiplied with.
+	rateFactor float64
+
+	// lastAdaptation is the time of the last adaptation.
+	lastAdaptation time.Time
+
+	mutex syncutils.RWMutex
+}
+
+// NewRateAdapter creates a new RateAdapter.
+func NewRateAdapter(backlog func() int, highWatermark int, lowWatermark int, minRateFactor float64, interval time.Duration) *RateAdapter {
+	return &RateAdapter{
+		backlog:       backlog,
+		highWatermark: highWatermark,
+		lowWatermark:  lowWatermark,
+		minRateFactor: minRateFactor,
+		interval:      interval,
+		rateFactor:    1,
+	}
+}
+
+// RateFactor returns the factor that the scheduler rate is currently multiplied with.
+func (r *RateAdapter) RateFactor() float64 {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	return r.rateFactor
+}
+
+// Adapt updates the rate factor based on the current downstream backlog and returns it.
+func (r *RateAdapter) Adapt(now time.Time) float64 {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if r.highWatermark == 0 || r.backlog == nil {
+		return r.rateFactor
+	}
+
+	elapsedIntervals := float64(now.Sub(r.lastAdaptation)) / float64(r.interval)
+	if elapsedIntervals < 1 {
+		return r.rateFactor
+	}
+	r.lastAdaptation = now
+
+	switch backlog := r.backlog(); {
+	case backlog >= r.highWatermark:
+		r.rateFactor = max(r.minRateFactor, r.rateFactor*rateDecreaseFactor)
+	case backlog <= r.lowWatermark:
+		// recover for every interval that passed, so that an idle scheduler does not lag behind.
+		r.rateFactor = min(1, r.rateFactor+rateRecoveryStep*elapsedIntervals)
+	}
+
+	return r.rateFactor
+}
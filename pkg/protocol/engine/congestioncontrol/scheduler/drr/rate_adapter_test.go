@@ -0,0 +1,41 @@
+package drr
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestRateAdapter(t *testing.T) {
+	backlog := 0
+	rateAdapter := NewRateAdapter(func() int { return backlog }, 100, 20, 0.25, time.Second)
+
+	now := time.Now()
+	require.Equal(t, 1.0, rateAdapter.Adapt(now))
+
+	// the rate is lowered multiplicatively while the backlog is above the high watermark, but not below the minimum.
+	backlog = 150
+	require.Equal(t, 0.5, rateAdapter.Adapt(now.Add(time.Second)))
+	require.Equal(t, 0.5, rateAdapter.Adapt(now.Add(1500*time.Millisecond)), "adaptations are rate limited")
+	require.Equal(t, 0.25, rateAdapter.Adapt(now.Add(2*time.Second)))
+	require.Equal(t, 0.25, rateAdapter.Adapt(now.Add(3*time.Second)))
+
+	// the rate is kept while the backlog is between the watermarks.
+	backlog = 50
+	require.Equal(t, 0.25, rateAdapter.Adapt(now.Add(4*time.Second)))
+
+	// the rate recovers additively once the backlog dropped to the low watermark.
+	backlog = 20
+	require.InDelta(t, 0.35, rateAdapter.Adapt(now.Add(5*time.Second)), 1e-9)
+	require.InDelta(t, 0.55, rateAdapter.Adapt(now.Add(7*time.Second)), 1e-9)
+	require.Equal(t, 1.0, rateAdapter.Adapt(now.Add(time.Minute)))
+	require.Equal(t, 1.0, rateAdapter.RateFactor())
+}
+
+func TestRateAdapter_Disabled(t *testing.T) {
+	rateAdapter := NewRateAdapter(func() int { return 1000 }, 0, 0, 0.25, time.Second)
+
+	require.Equal(t, 1.0, rateAdapter.Adapt(time.Now()))
+	require.Equal(t, 1.0, rateAdapter.Adapt(time.Now().Add(time.Minute)))
+}
@@ -9,6 +9,7 @@ import (
 	"github.com/iotaledger/hive.go/ds/shrinkingmap"
 	"github.com/iotaledger/hive.go/ierrors"
 	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/hive.go/log"
 	"github.com/iotaledger/hive.go/runtime/module"
 	"github.com/iotaledger/hive.go/runtime/options"
 	"github.com/iotaledger/hive.go/runtime/syncutils"
@@ -20,6 +21,9 @@ import (
 	iotago "github.com/iotaledger/iota.go/v4"
 )
 
+// ErrBlockExceededMaxLatency is returned when a block is evicted from the buffer because it exceeded the max latency.
+var ErrBlockExceededMaxLatency = ierrors.New("block exceeded max latency in scheduler buffer")
+
 type Deficit int64
 
 type SubSlotIndex int
@@ -48,12 +52,53 @@ type Scheduler struct {
 
 	errorHandler func(error)
 
+	// lastEvictionTime is the time when the basic buffer was last checked for blocks exceeding the max latency.
+	lastEvictionTime time.Time
+
+	// optsMaxBlockLatency is the max duration a basic block can wait in the buffer (measured from its issuing time)
+	// before it is evicted. A value of 0 disables the eviction.
+	optsMaxBlockLatency time.Duration
+
+	// downstreamBacklog returns the number of pending tasks of the stages that process the blocks after booking.
+	downstreamBacklog func() int
+
+	// rateAdapter lowers the local scheduler rate while the downstream stages are backed up.
+	rateAdapter *RateAdapter
+
+	// optsRateAdaptationHighWatermark is the downstream backlog at which the scheduler rate is lowered (0 = disabled).
+	optsRateAdaptationHighWatermark int
+
+	// optsRateAdaptationLowWatermark is the downstream backlog at which the scheduler rate recovers.
+	optsRateAdaptationLowWatermark int
+
+	// optsMinRateFactor is the lower bound of the factor that the scheduler rate is multiplied with.
+	optsMinRateFactor float64
+
+	// optsRateAdaptationInterval is the minimum duration between two adaptations of the scheduler rate.
+	optsRateAdaptationInterval time.Duration
+
+	// burstAllowance grants newly created accounts an initial deficit.
+	burstAllowance *BurstAllowance
+
+	// optsNewAccountBurstWork is the work that newly created accounts are allowed to get scheduled before they
+	// generated mana (0 = disabled).
+	optsNewAccountBurstWork iotago.WorkScore
+
+	// optsMaxNewAccountBurstsPerSlot is the maximum number of newly created accounts that receive the burst allowance
+	// per slot (0 = unlimited).
+	optsMaxNewAccountBurstsPerSlot int
+
+	log.Logger
+
 	module.Module
 }
 
 func NewProvider(opts ...options.Option[Scheduler]) module.Provider[*engine.Engine, scheduler.Scheduler] {
 	return module.Provide(func(e *engine.Engine) scheduler.Scheduler {
-		s := New(e, opts...)
+		logger := e.NewChildLogger("Scheduler")
+
+		s := New(logger, e, opts...)
+		s.HookStopped(logger.UnsubscribeFromParentLogger)
 		s.errorHandler = e.ErrorHandler("scheduler")
 		s.basicBuffer = NewBufferQueue()
 
@@ -62,6 +107,9 @@ func NewProvider(opts ...options.Option[Scheduler]) module.Provider[*engine.Engi
 				return e.Storage.Settings().LatestCommitment().Slot()
 			}
 			s.blockCache = e.BlockCache
+			s.downstreamBacklog = func() int {
+				return e.BlockDAG.PendingTasks() + e.Notarization.PendingTasks()
+			}
 			e.Events.Scheduler.LinkTo(s.events)
 			e.SybilProtection.HookInitialized(func() {
 				s.seatManager = e.SybilProtection.SeatManager()
@@ -113,6 +161,7 @@ func NewProvider(opts ...options.Option[Scheduler]) module.Provider[*engine.Engi
 				defer s.bufferMutex.Unlock()
 
 				s.createIssuer(accountID)
+				s.grantBurstAllowance(accountID)
 			})
 			e.Events.Ledger.AccountDestroyed.Hook(func(accountID iotago.AccountID) {
 				s.bufferMutex.Lock()
@@ -128,14 +177,27 @@ func NewProvider(opts ...options.Option[Scheduler]) module.Provider[*engine.Engi
 	})
 }
 
-func New(apiProvider iotago.APIProvider, opts ...options.Option[Scheduler]) *Scheduler {
+func New(logger log.Logger, apiProvider iotago.APIProvider, opts ...options.Option[Scheduler]) *Scheduler {
 	return options.Apply(
 		&Scheduler{
+			Logger:          logger,
 			events:          scheduler.NewEvents(),
 			deficits:        shrinkingmap.New[iotago.AccountID, Deficit](),
 			apiProvider:     apiProvider,
 			validatorBuffer: NewValidatorBuffer(),
-		}, opts,
+
+			optsMinRateFactor:          0.25,
+			optsRateAdaptationInterval: time.Second,
+		}, opts, func(s *Scheduler) {
+			s.rateAdapter = NewRateAdapter(func() int {
+				if s.downstreamBacklog == nil {
+					return 0
+				}
+
+				return s.downstreamBacklog()
+			}, s.optsRateAdaptationHighWatermark, s.optsRateAdaptationLowWatermark, s.optsMinRateFactor, s.optsRateAdaptationInterval)
+			s.burstAllowance = NewBurstAllowance(s.optsNewAccountBurstWork, s.optsMaxNewAccountBurstsPerSlot)
+		},
 	)
 }
 
@@ -194,6 +256,24 @@ func (s *Scheduler) BasicBufferSize() int {
 	return s.basicBuffer.Size()
 }
 
+// BasicBufferOldestBlockAge returns the age of the oldest block in the basic buffer of the Scheduler.
+func (s *Scheduler) BasicBufferOldestBlockAge() time.Duration {
+	s.bufferMutex.RLock()
+	defer s.bufferMutex.RUnlock()
+
+	oldestIssuingTime, exists := s.basicBuffer.OldestIssuingTime()
+	if !exists {
+		return 0
+	}
+
+	return time.Since(oldestIssuingTime)
+}
+
+// RateFactor returns the factor that the scheduler rate is currently lowered by due to a downstream backlog.
+func (s *Scheduler) RateFactor() float64 {
+	return s.rateAdapter.RateFactor()
+}
+
 func (s *Scheduler) ValidatorBufferSize() int {
 	return s.validatorBuffer.Size()
 }
@@ -269,6 +349,9 @@ func (s *Scheduler) enqueueBasicBlock(block *blocks.Block) {
 	issuerID := block.ProtocolBlock().Header.IssuerID
 	issuerQueue := s.getOrCreateIssuer(issuerID)
 
+	// free up space occupied by stale blocks before falling back to dropping blocks of the longest queue.
+	s.evictBlocksExceedingMaxLatency(s.basicBuffer.Size() >= s.MaxBufferSize())
+
 	droppedBlocks, submitted := s.basicBuffer.Submit(
 		block,
 		issuerQueue,
@@ -290,6 +373,7 @@ func (s *Scheduler) enqueueBasicBlock(block *blocks.Block) {
 	}
 	for _, b := range droppedBlocks {
 		b.SetDropped()
+		s.LogDebug("basic block dropped from buffer", "block", b.ID(), "issuer", b.ProtocolBlock().Header.IssuerID)
 		s.events.BlockDropped.Trigger(b, ierrors.New("basic block dropped from buffer"))
 	}
 	if block.SetEnqueued() {
@@ -312,6 +396,7 @@ func (s *Scheduler) enqueueValidationBlock(block *blocks.Block) {
 	}
 	if droppedBlock != nil {
 		droppedBlock.SetDropped()
+		s.LogDebug("validation block dropped from buffer", "block", droppedBlock.ID(), "issuer", droppedBlock.ProtocolBlock().Header.IssuerID)
 		s.events.BlockDropped.Trigger(droppedBlock, ierrors.New("validation block dropped from buffer"))
 	}
 
@@ -333,12 +418,12 @@ loop:
 		// when a block is pushed by the buffer
 		case blockToSchedule = <-s.basicBuffer.blockChan:
 			currentAPI := s.apiProvider.CommittedAPI()
-			rate := currentAPI.ProtocolParameters().CongestionControlParameters().SchedulerRate
-			if waitTime := s.basicBuffer.waitTime(float64(rate), blockToSchedule); waitTime > 0 {
+			rate := float64(currentAPI.ProtocolParameters().CongestionControlParameters().SchedulerRate) * s.rateAdapter.Adapt(time.Now())
+			if waitTime := s.basicBuffer.waitTime(rate, blockToSchedule); waitTime > 0 {
 				timer := time.NewTimer(waitTime)
 				<-timer.C
 			}
-			s.basicBuffer.updateTokenBucket(float64(rate), float64(currentAPI.MaxBlockWork()))
+			s.basicBuffer.updateTokenBucket(rate, float64(currentAPI.MaxBlockWork()))
 
 			s.scheduleBasicBlock(blockToSchedule)
 		}
@@ -380,6 +465,7 @@ func (s *Scheduler) scheduleBasicBlock(block *blocks.Block) {
 		s.updateChildrenWithLocking(block)
 		s.selectBlockToScheduleWithLocking()
 
+		s.LogTrace("basic block scheduled", "block", block.ID())
 		s.events.BlockScheduled.Trigger(block)
 	}
 }
@@ -393,6 +479,7 @@ func (s *Scheduler) scheduleValidationBlock(block *blocks.Block, validatorQueue
 		s.updateChildrenWithLocking(block)
 		s.selectBlockToScheduleWithLocking()
 
+		s.LogTrace("validation block scheduled", "block", block.ID())
 		s.events.BlockScheduled.Trigger(block)
 	}
 }
@@ -429,6 +516,8 @@ func (s *Scheduler) selectValidationBlockWithoutLocking(validatorQueue *Validato
 func (s *Scheduler) selectBasicBlockWithoutLocking() {
 	slot := s.latestCommittedSlot()
 
+	s.evictBlocksExceedingMaxLatency(false)
+
 	// already a block selected to be scheduled.
 	if len(s.basicBuffer.blockChan) > 0 {
 		return
@@ -571,6 +660,36 @@ func (s *Scheduler) selectIssuer(start *IssuerQueue, slot iotago.SlotIndex) (Def
 	return rounds, schedulingIssuer
 }
 
+// evictBlocksExceedingMaxLatency removes all blocks from the basic buffer that have been issued longer than the max
+// latency ago, as they would be orphaned anyway. The check is rate-limited unless force is set.
+func (s *Scheduler) evictBlocksExceedingMaxLatency(force bool) {
+	if s.optsMaxBlockLatency == 0 {
+		return
+	}
+
+	now := time.Now()
+	if !force && now.Sub(s.lastEvictionTime) < s.optsMaxBlockLatency/10 {
+		return
+	}
+	s.lastEvictionTime = now
+
+	for _, block := range s.basicBuffer.RemoveOlderThan(now.Add(-s.optsMaxBlockLatency)) {
+		// accepted blocks are not useless, so they are skipped instead of being dropped.
+		if block.IsAccepted() {
+			if block.SetSkipped() {
+				s.updateChildrenWithoutLocking(block)
+				s.events.BlockSkipped.Trigger(block)
+			}
+
+			continue
+		}
+
+		block.SetDropped()
+		s.events.BlockDropped.Trigger(block, ErrBlockExceededMaxLatency)
+		s.events.BlockEvicted.Trigger(block)
+	}
+}
+
 func (s *Scheduler) removeIssuer(issuerID iotago.AccountID, err error) {
 	q := s.basicBuffer.IssuerQueue(issuerID)
 	q.submitted.ForEach(func(id iotago.BlockID, block *blocks.Block) bool {
@@ -604,6 +723,21 @@ func (s *Scheduler) createIssuer(accountID iotago.AccountID) *IssuerQueue {
 	return issuerQueue
 }
 
+// grantBurstAllowance sets the deficit of a newly created account to the burst allowance (if one is granted).
+func (s *Scheduler) grantBurstAllowance(accountID iotago.AccountID) {
+	work, granted := s.burstAllowance.Grant(s.latestCommittedSlot())
+	if !granted {
+		return
+	}
+
+	deficit, err := safemath.SafeMul(s.deficitFromWork(1), Deficit(work))
+	if err != nil || deficit >= s.maxDeficit() {
+		deficit = s.maxDeficit() - 1
+	}
+
+	s.deficits.Set(accountID, deficit)
+}
+
 func (s *Scheduler) updateDeficit(accountID iotago.AccountID, delta Deficit) (Deficit, error) {
 	var updateErr error
 	updatedDeficit := s.deficits.Compute(accountID, func(currentValue Deficit, exists bool) Deficit {
@@ -741,3 +875,43 @@ func (s *Scheduler) addValidator(accountID iotago.AccountID) *ValidatorQueue {
 func (s *Scheduler) shutdownValidatorQueue(validatorQueue *ValidatorQueue) {
 	close(validatorQueue.shutdownSignal)
 }
+
+// WithMaxBlockLatency sets the max duration a basic block can wait in the buffer before it is evicted.
+func WithMaxBlockLatency(maxBlockLatency time.Duration) options.Option[Scheduler] {
+	return func(s *Scheduler) {
+		s.optsMaxBlockLatency = maxBlockLatency
+	}
+}
+
+// WithRateAdaptation sets the downstream backlog at which the scheduler rate is lowered (highWatermark, 0 = disabled)
+// and the backlog at which it recovers again (lowWatermark).
+func WithRateAdaptation(highWatermark int, lowWatermark int) options.Option[Scheduler] {
+	return func(s *Scheduler) {
+		s.optsRateAdaptationHighWatermark = highWatermark
+		s.optsRateAdaptationLowWatermark = lowWatermark
+	}
+}
+
+// WithMinRateFactor sets the lower bound of the factor that the scheduler rate is multiplied with.
+func WithMinRateFactor(minRateFactor float64) options.Option[Scheduler] {
+	return func(s *Scheduler) {
+		s.optsMinRateFactor = minRateFactor
+	}
+}
+
+// WithRateAdaptationInterval sets the minimum duration between two adaptations of the scheduler rate.
+func WithRateAdaptationInterval(rateAdaptationInterval time.Duration) options.Option[Scheduler] {
+	return func(s *Scheduler) {
+		s.optsRateAdaptationInterval = rateAdaptationInterval
+	}
+}
+
+// WithNewAccountBurst sets the work that newly created accounts are allowed to get scheduled before they generated mana
+// (work, 0 = disabled) and the maximum number of accounts that receive this allowance per slot (maxAccountsPerSlot,
+// 0 = unlimited).
+func WithNewAccountBurst(work iotago.WorkScore, maxAccountsPerSlot int) options.Option[Scheduler] {
+	return func(s *Scheduler) {
+		s.optsNewAccountBurstWork = work
+		s.optsMaxNewAccountBurstsPerSlot = maxAccountsPerSlot
+	}
+}
@@ -15,6 +15,9 @@ type Events struct {
 	BlockSkipped *event.Event1[*blocks.Block]
 	// BlockDropped is triggered when a block in the buffer is dropped. Dropped blocks are not passed to tip manager and not gossiped.
 	BlockDropped *event.Event2[*blocks.Block, error]
+	// BlockEvicted is triggered when a block is evicted from the buffer because it exceeded the max latency.
+	// Evicted blocks are also dropped, i.e., BlockDropped is triggered for them as well.
+	BlockEvicted *event.Event1[*blocks.Block]
 
 	event.Group[Events, *Events]
 }
@@ -26,5 +29,6 @@ var NewEvents = event.CreateGroupConstructor(func() (newEvents *Events) {
 		BlockScheduled: event.New1[*blocks.Block](),
 		BlockSkipped:   event.New1[*blocks.Block](),
 		BlockDropped:   event.New2[*blocks.Block, error](),
+		BlockEvicted:   event.New1[*blocks.Block](),
 	}
 })
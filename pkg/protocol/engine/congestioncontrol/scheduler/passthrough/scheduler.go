@@ -1,6 +1,8 @@
 package passthrough
 
 import (
+	"time"
+
 	"github.com/iotaledger/hive.go/runtime/module"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
@@ -48,6 +50,14 @@ func (s *Scheduler) BasicBufferSize() int {
 	return 0
 }
 
+func (s *Scheduler) BasicBufferOldestBlockAge() time.Duration {
+	return 0
+}
+
+func (s *Scheduler) RateFactor() float64 {
+	return 1
+}
+
 func (s *Scheduler) ValidatorBufferSize() int {
 	return 0
 }
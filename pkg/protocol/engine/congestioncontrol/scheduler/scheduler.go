@@ -1,6 +1,8 @@
 package scheduler
 
 import (
+	"time"
+
 	"github.com/iotaledger/hive.go/runtime/module"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
 	iotago "github.com/iotaledger/iota.go/v4"
@@ -13,6 +15,10 @@ type Scheduler interface {
 	IsBlockIssuerReady(iotago.AccountID, ...*blocks.Block) bool
 	// BasicBufferSize returns the current buffer size of the Scheduler as block count.
 	BasicBufferSize() int
+	// BasicBufferOldestBlockAge returns the age of the oldest block in the basic buffer of the Scheduler.
+	BasicBufferOldestBlockAge() time.Duration
+	// RateFactor returns the factor that the scheduler rate is currently lowered by due to a local downstream backlog.
+	RateFactor() float64
 	// ValidatorBufferSize returns the current buffer size of the Scheduler as block count.
 	ValidatorBufferSize() int
 	// ReadyBlocksCount returns the number of ready blocks.
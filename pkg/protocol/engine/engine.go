@@ -1,6 +1,7 @@
 package engine
 
 import (
+	"fmt"
 	"io"
 	"os"
 	"path/filepath"
@@ -19,6 +20,7 @@ import (
 	"github.com/iotaledger/hive.go/runtime/syncutils"
 	"github.com/iotaledger/hive.go/runtime/workerpool"
 	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/profiling"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/attestation"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/blockdag"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
@@ -84,6 +86,7 @@ type Engine struct {
 	mutex   syncutils.RWMutex
 
 	optsSnapshotPath     string
+	optsForkingSlot      iotago.SlotIndex
 	optsEntryPointsDepth int
 	optsSnapshotDepth    int
 	optsBlockRequester   []options.Option[eventticker.EventTicker[iotago.SlotIndex, iotago.BlockID]]
@@ -134,6 +137,8 @@ func New(
 
 			e.errorHandler = func(err error) {
 				e.LogTrace("engine error", "err", err)
+
+				e.Events.Error.Trigger(err)
 			}
 
 			// Import the settings from the snapshot file if needed.
@@ -227,6 +232,11 @@ func New(
 				e.Reset()
 			}
 
+			// Make sure that all components resolve the same protocol parameters for every epoch.
+			if err := e.Storage.Settings().CheckProtocolParameters(); err != nil {
+				panic(ierrors.Wrap(err, "inconsistent protocol parameters"))
+			}
+
 			e.Initialized.Trigger()
 
 			e.LogDebug("initialized", "settings", e.Storage.Settings().String())
@@ -301,6 +311,19 @@ func (e *Engine) Block(id iotago.BlockID) (*model.Block, bool) {
 	return modelBlock, modelBlock != nil
 }
 
+// ActiveRootBlocks returns the commitment of the latest committed slot together with the root blocks that are active
+// with respect to it and the commitment IDs they commit to. Any of the root blocks is a valid parent for a new block.
+func (e *Engine) ActiveRootBlocks() (*model.Commitment, map[iotago.BlockID]iotago.CommitmentID, error) {
+	lastCommittedSlot, activeRootBlocks := e.EvictionState.ActiveRootBlocks()
+
+	commitment, err := e.Storage.Commitments().Load(lastCommittedSlot)
+	if err != nil {
+		return nil, nil, ierrors.Wrapf(err, "failed to load commitment of slot %d", lastCommittedSlot)
+	}
+
+	return commitment, activeRootBlocks, nil
+}
+
 func (e *Engine) CommittedAPI() iotago.API {
 	return e.Storage.Settings().APIProvider().CommittedAPI()
 }
@@ -338,16 +361,78 @@ func (e *Engine) CommitmentAPI(commitmentID iotago.CommitmentID) (*CommitmentAPI
 	return NewCommitmentAPI(e, commitmentID), nil
 }
 
+// BlockCommitment returns the commitment that includes the given block (the commitment of the slot in which the block
+// was accepted). It returns an error wrapping model.ErrBlockNotIncluded if the block is not part of a commitment (yet).
+func (e *Engine) BlockCommitment(blockID iotago.BlockID) (*model.Commitment, error) {
+	if e.Storage.Settings().LatestCommitment().Slot() < blockID.Slot() {
+		return nil, ierrors.Wrapf(model.ErrBlockNotIncluded, "slot %d is not committed yet", blockID.Slot())
+	}
+
+	blockCommitments, err := e.Storage.BlockCommitments(blockID.Slot())
+	if err != nil {
+		return nil, ierrors.Wrapf(err, "failed to get block commitments storage for slot %d", blockID.Slot())
+	}
+
+	commitmentID, exists, err := blockCommitments.Load(blockID)
+	if err != nil {
+		return nil, ierrors.Wrapf(err, "failed to load commitment of block %s", blockID)
+	} else if !exists {
+		return nil, ierrors.Wrapf(model.ErrBlockNotIncluded, "block %s was not accepted in slot %d", blockID, blockID.Slot())
+	}
+
+	return NewCommitmentAPI(e, commitmentID).Commitment()
+}
+
+// StreamAcceptedBlocks passes the commitments of the committed slots in the range [startSlot, endSlot] (capped at the
+// latest commitment) together with the IDs of the blocks that were accepted in these slots to the consumer. If verify is
+// set, the accepted blocks of every slot are verified against the tangle root of its commitment before they are passed
+// to the consumer. The streaming is aborted if the consumer returns an error.
+func (e *Engine) StreamAcceptedBlocks(startSlot iotago.SlotIndex, endSlot iotago.SlotIndex, verify bool, consumer func(commitment *model.Commitment, acceptedBlockIDs iotago.BlockIDs) error) error {
+	if latestCommittedSlot := e.Storage.Settings().LatestCommitment().Slot(); endSlot > latestCommittedSlot {
+		endSlot = latestCommittedSlot
+	}
+
+	for slot := startSlot; slot <= endSlot; slot++ {
+		commitment, err := e.Storage.Commitments().Load(slot)
+		if err != nil {
+			return ierrors.Wrapf(err, "failed to load commitment for slot %d", slot)
+		}
+
+		commitmentAPI := NewCommitmentAPI(e, commitment.ID())
+
+		acceptedBlockIDs, err := lo.Cond(verify, commitmentAPI.VerifiedAcceptedBlockIDs, commitmentAPI.AcceptedBlockIDs)()
+		if err != nil {
+			return ierrors.Wrapf(err, "failed to get accepted blocks of slot %d", slot)
+		}
+
+		if err = consumer(commitment, acceptedBlockIDs); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (e *Engine) WriteSnapshot(filePath string, targetSlot ...iotago.SlotIndex) (err error) {
 	if len(targetSlot) == 0 {
 		targetSlot = append(targetSlot, e.Storage.Settings().LatestCommitment().Slot())
-	} else if lastPrunedEpoch, hasPruned := e.Storage.LastPrunedEpoch(); hasPruned && e.APIForSlot(targetSlot[0]).TimeProvider().EpochFromSlot(targetSlot[0]) <= lastPrunedEpoch {
-		return ierrors.Errorf("impossible to create a snapshot for slot %d because it is pruned (last pruned slot %d)", targetSlot[0], lo.Return1(e.Storage.LastPrunedEpoch()))
+	}
+
+	return e.WriteSnapshotWithProgress(filePath, targetSlot[0], nil)
+}
+
+// WriteSnapshotWithProgress writes the snapshot of the given slot to the given file and reports every exported stage to
+// the optional progress callback.
+func (e *Engine) WriteSnapshotWithProgress(filePath string, targetSlot iotago.SlotIndex, progress func(stage string, completedStages int, totalStages int)) (err error) {
+	if lastPrunedEpoch, hasPruned := e.Storage.LastPrunedEpoch(); hasPruned && e.APIForSlot(targetSlot).TimeProvider().EpochFromSlot(targetSlot) <= lastPrunedEpoch {
+		return ierrors.Errorf("impossible to create a snapshot for slot %d because it is pruned (last pruned slot %d)", targetSlot, lo.Return1(e.Storage.LastPrunedEpoch()))
 	}
 
 	if fileHandle, err := os.Create(filePath); err != nil {
 		return ierrors.Wrap(err, "failed to create snapshot file")
-	} else if err = e.Export(fileHandle, targetSlot[0]); err != nil {
+	} else if err = e.Export(fileHandle, targetSlot, progress); err != nil {
+		_ = fileHandle.Close()
+
 		return ierrors.Wrap(err, "failed to write snapshot")
 	} else if err = fileHandle.Close(); err != nil {
 		return ierrors.Wrap(err, "failed to close snapshot file")
@@ -382,27 +467,40 @@ func (e *Engine) ImportContents(reader io.ReadSeeker) (err error) {
 	return
 }
 
-func (e *Engine) Export(writer io.WriteSeeker, targetSlot iotago.SlotIndex) (err error) {
+// Export writes the snapshot of the given slot to the given writer. The optional progress callback is called after
+// every exported stage.
+func (e *Engine) Export(writer io.WriteSeeker, targetSlot iotago.SlotIndex, progress ...func(stage string, completedStages int, totalStages int)) (err error) {
 	targetCommitment, err := e.Storage.Commitments().Load(targetSlot)
 	if err != nil {
 		return ierrors.Wrapf(err, "failed to load target commitment at slot %d", targetSlot)
 	}
 
-	if err = e.Storage.Settings().Export(writer, targetCommitment.Commitment()); err != nil {
-		return ierrors.Wrap(err, "failed to export settings")
-	} else if err = e.Storage.Commitments().Export(writer, targetSlot); err != nil {
-		return ierrors.Wrap(err, "failed to export commitments")
-	} else if err = e.Ledger.Export(writer, targetSlot); err != nil {
-		return ierrors.Wrap(err, "failed to export ledger")
-	} else if err := e.SybilProtection.Export(writer, targetSlot); err != nil {
-		return ierrors.Wrap(err, "failed to export sybil protection")
-	} else if err = e.EvictionState.Export(writer, e.Storage.Settings().LatestFinalizedSlot(), targetSlot); err != nil {
+	stages := []struct {
+		name       string
+		exportFunc func() error
+	}{
+		{"settings", func() error { return e.Storage.Settings().Export(writer, targetCommitment.Commitment()) }},
+		{"commitments", func() error { return e.Storage.Commitments().Export(writer, targetSlot) }},
+		{"ledger", func() error { return e.Ledger.Export(writer, targetSlot) }},
+		{"sybil protection", func() error { return e.SybilProtection.Export(writer, targetSlot) }},
 		// The rootcommitment is determined from the rootblocks. Therefore, we need to export starting from the last finalized slot.
-		return ierrors.Wrap(err, "failed to export eviction state")
-	} else if err = e.Attestations.Export(writer, targetSlot); err != nil {
-		return ierrors.Wrap(err, "failed to export attestation state")
-	} else if err = e.UpgradeOrchestrator.Export(writer, targetSlot); err != nil {
-		return ierrors.Wrap(err, "failed to export upgrade orchestrator")
+		{"eviction state", func() error {
+			return e.EvictionState.Export(writer, e.Storage.Settings().LatestFinalizedSlot(), targetSlot)
+		}},
+		{"attestation state", func() error { return e.Attestations.Export(writer, targetSlot) }},
+		{"upgrade orchestrator", func() error { return e.UpgradeOrchestrator.Export(writer, targetSlot) }},
+	}
+
+	for i, stage := range stages {
+		if err = stage.exportFunc(); err != nil {
+			return ierrors.Wrapf(err, "failed to export %s", stage.name)
+		}
+
+		for _, progressCallback := range progress {
+			if progressCallback != nil {
+				progressCallback(stage.name, i+1, len(stages))
+			}
+		}
 	}
 
 	return
@@ -417,6 +515,21 @@ func (e *Engine) Name() string {
 	return filepath.Base(e.Storage.Directory())
 }
 
+// Alias returns the short alias of the engine that is used to distinguish its log output and metrics from the ones
+// of other engine instances.
+func (e *Engine) Alias() string {
+	if name := e.Name(); len(name) > engineAliasLength {
+		return name[:engineAliasLength]
+	}
+
+	return e.Name()
+}
+
+// ForkingSlot returns the slot at which the engine was forked from the main engine (0 if it was not forked).
+func (e *Engine) ForkingSlot() iotago.SlotIndex {
+	return e.optsForkingSlot
+}
+
 func (e *Engine) ChainID() iotago.CommitmentID {
 	e.mutex.RLock()
 	defer e.mutex.RUnlock()
@@ -432,7 +545,7 @@ func (e *Engine) SetChainID(chainID iotago.CommitmentID) {
 }
 
 func (e *Engine) acceptanceHandler() {
-	wp := e.Workers.CreatePool("BlockAccepted", workerpool.WithWorkerCount(1))
+	wp := profiling.CreatePool(e.Workers, "BlockAccepted", workerpool.WithWorkerCount(1))
 
 	e.Events.BlockGadget.BlockAccepted.Hook(func(block *blocks.Block) {
 		e.Ledger.TrackBlock(block)
@@ -445,7 +558,7 @@ func (e *Engine) acceptanceHandler() {
 }
 
 func (e *Engine) setupBlockStorage() {
-	wp := e.Workers.CreatePool("BlockStorage", workerpool.WithWorkerCount(1)) // Using just 1 worker to avoid contention
+	wp := profiling.CreatePool(e.Workers, "BlockStorage", workerpool.WithWorkerCount(1)) // Using just 1 worker to avoid contention
 
 	e.Events.BlockGadget.BlockAccepted.Hook(func(block *blocks.Block) {
 		store, err := e.Storage.Blocks(block.ID().Slot())
@@ -463,7 +576,7 @@ func (e *Engine) setupBlockStorage() {
 func (e *Engine) setupEvictionState() {
 	e.Events.EvictionState.LinkTo(e.EvictionState.Events)
 
-	wp := e.Workers.CreatePool("EvictionState", workerpool.WithWorkerCount(1)) // Using just 1 worker to avoid contention
+	wp := profiling.CreatePool(e.Workers, "EvictionState", workerpool.WithWorkerCount(1)) // Using just 1 worker to avoid contention
 
 	e.Events.BlockGadget.BlockAccepted.Hook(func(block *blocks.Block) {
 		e.EvictionState.AddRootBlock(block.ID(), block.SlotCommitmentID())
@@ -490,7 +603,7 @@ func (e *Engine) setupBlockRequester() {
 	})
 	e.Events.BlockDAG.MissingBlockAttached.Hook(func(block *blocks.Block) {
 		e.BlockRequester.StopTicker(block.ID())
-	}, event.WithWorkerPool(e.Workers.CreatePool("BlockRequester", workerpool.WithWorkerCount(1)))) // Using just 1 worker to avoid contention
+	}, event.WithWorkerPool(profiling.CreatePool(e.Workers, "BlockRequester", workerpool.WithWorkerCount(1)))) // Using just 1 worker to avoid contention
 }
 
 func (e *Engine) setupPruning() {
@@ -498,7 +611,7 @@ func (e *Engine) setupPruning() {
 		if err := e.Storage.TryPrune(); err != nil {
 			e.errorHandler(ierrors.Wrapf(err, "failed to prune storage at slot %d", slot))
 		}
-	}, event.WithWorkerPool(e.Workers.CreatePool("PruneEngine", workerpool.WithWorkerCount(1))))
+	}, event.WithWorkerPool(profiling.CreatePool(e.Workers, "PruneEngine", workerpool.WithWorkerCount(1))))
 }
 
 func (e *Engine) ErrorHandler(componentName string) func(error) {
@@ -561,9 +674,11 @@ func (e *Engine) initLatestCommitment() {
 }
 
 func (e *Engine) initReactiveModule(parentLogger log.Logger) (reactiveModule *module.ReactiveModule) {
-	logger := parentLogger.NewChildLogger("Engine", true)
+	logger := parentLogger.NewChildLogger(e.logName(), false)
 	reactiveModule = module.NewReactiveModule(logger)
 
+	reactiveModule.LogDebug("created", "alias", e.Alias(), "forkingSlot", e.optsForkingSlot)
+
 	e.RootCommitment.LogUpdates(reactiveModule, log.LevelTrace, "RootCommitment")
 	e.LatestCommitment.LogUpdates(reactiveModule, log.LevelTrace, "LatestCommitment")
 
@@ -592,6 +707,7 @@ func (e *Engine) initReactiveModule(parentLogger log.Logger) (reactiveModule *mo
 		e.PreSolidFilter.Shutdown()
 		e.Retainer.Shutdown()
 		e.Workers.Shutdown()
+		profiling.ReleaseGroup(e.Workers)
 		e.Storage.Shutdown()
 
 		reactiveModule.LogDebug("stopped")
@@ -602,6 +718,19 @@ func (e *Engine) initReactiveModule(parentLogger log.Logger) (reactiveModule *mo
 	return reactiveModule
 }
 
+// logName returns the name of the engine logger, which contains the alias of the engine and, for forked engines, the
+// slot at which they were forked so that the output of candidate engines can be told apart from the main engine.
+func (e *Engine) logName() string {
+	if e.optsForkingSlot == 0 {
+		return fmt.Sprintf("Engine[%s]", e.Alias())
+	}
+
+	return fmt.Sprintf("Engine[%s@%d]", e.Alias(), e.optsForkingSlot)
+}
+
+// engineAliasLength is the number of characters of the engine name that are used as its alias.
+const engineAliasLength = 8
+
 // endregion ///////////////////////////////////////////////////////////////////////////////////////////////////////////
 
 // region Options //////////////////////////////////////////////////////////////////////////////////////////////////////
@@ -612,6 +741,13 @@ func WithSnapshotPath(snapshotPath string) options.Option[Engine] {
 	}
 }
 
+// WithForkingSlot sets the slot at which the engine was forked from the main engine.
+func WithForkingSlot(forkingSlot iotago.SlotIndex) options.Option[Engine] {
+	return func(e *Engine) {
+		e.optsForkingSlot = forkingSlot
+	}
+}
+
 func WithEntryPointsDepth(entryPointsDepth int) options.Option[Engine] {
 	return func(engine *Engine) {
 		engine.optsEntryPointsDepth = entryPointsDepth
@@ -3,6 +3,7 @@ package engine
 import (
 	"github.com/iotaledger/hive.go/core/eventticker"
 	"github.com/iotaledger/hive.go/runtime/event"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/attestation"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/blockdag"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/booker"
@@ -28,8 +29,10 @@ type Events struct {
 	BlockProcessed         *event.Event1[iotago.BlockID]
 	AcceptedBlockProcessed *event.Event1[*blocks.Block]
 	StoragePruned          *event.Event1[iotago.EpochIndex]
+	Error                  *event.Event1[error]
 
 	EvictionState   *eviction.Events
+	Attestation     *attestation.Events
 	PreSolidFilter  *presolidfilter.Events
 	PostSolidFilter *postsolidfilter.Events
 	BlockRequester  *eventticker.Events[iotago.SlotIndex, iotago.BlockID]
@@ -56,7 +59,9 @@ var NewEvents = event.CreateGroupConstructor(func() (newEvents *Events) {
 		BlockProcessed:         event.New1[iotago.BlockID](),
 		AcceptedBlockProcessed: event.New1[*blocks.Block](),
 		StoragePruned:          event.New1[iotago.EpochIndex](),
+		Error:                  event.New1[error](),
 		EvictionState:          eviction.NewEvents(),
+		Attestation:            attestation.NewEvents(),
 		PreSolidFilter:         presolidfilter.NewEvents(),
 		PostSolidFilter:        postsolidfilter.NewEvents(),
 		BlockRequester:         eventticker.NewEvents[iotago.SlotIndex, iotago.BlockID](),
@@ -76,24 +76,16 @@ func (s *State) AllActiveRootBlocks() map[iotago.BlockID]iotago.CommitmentID {
 	s.evictionMutex.RLock()
 	defer s.evictionMutex.RUnlock()
 
-	activeRootBlocks := make(map[iotago.BlockID]iotago.CommitmentID)
-	startSlot, endSlot := s.activeIndexRange(s.lastCommittedSlot)
-	for slot := startSlot; slot <= endSlot; slot++ {
-		// We assume the cache is always populated for the latest slots.
-		storage, err := s.rootBlockStorageFunc(slot)
-		// Slot too old, it was pruned.
-		if err != nil {
-			continue
-		}
-
-		_ = storage.Stream(func(id iotago.BlockID, commitmentID iotago.CommitmentID) error {
-			activeRootBlocks[id] = commitmentID
+	return s.activeRootBlocks(s.lastCommittedSlot)
+}
 
-			return nil
-		})
-	}
+// ActiveRootBlocks returns the latest committed slot together with the root blocks that are active with respect to it
+// and the commitment IDs they commit to.
+func (s *State) ActiveRootBlocks() (lastCommittedSlot iotago.SlotIndex, activeRootBlocks map[iotago.BlockID]iotago.CommitmentID) {
+	s.evictionMutex.RLock()
+	defer s.evictionMutex.RUnlock()
 
-	return activeRootBlocks
+	return s.lastCommittedSlot, s.activeRootBlocks(s.lastCommittedSlot)
 }
 
 func (s *State) LatestActiveRootBlock() (iotago.BlockID, iotago.CommitmentID) {
@@ -303,6 +295,28 @@ func (s *State) Rollback(lowerTarget iotago.SlotIndex, targetSlot iotago.SlotInd
 
 func (s *State) Reset() { /* nothing to reset but comply with interface */ }
 
+// activeRootBlocks returns the root blocks that are active with respect to the given slot (without locking).
+func (s *State) activeRootBlocks(targetSlot iotago.SlotIndex) map[iotago.BlockID]iotago.CommitmentID {
+	activeRootBlocks := make(map[iotago.BlockID]iotago.CommitmentID)
+	startSlot, endSlot := s.activeIndexRange(targetSlot)
+	for slot := startSlot; slot <= endSlot; slot++ {
+		// We assume the cache is always populated for the latest slots.
+		storage, err := s.rootBlockStorageFunc(slot)
+		// Slot too old, it was pruned.
+		if err != nil {
+			continue
+		}
+
+		_ = storage.Stream(func(id iotago.BlockID, commitmentID iotago.CommitmentID) error {
+			activeRootBlocks[id] = commitmentID
+
+			return nil
+		})
+	}
+
+	return activeRootBlocks
+}
+
 func (s *State) activeIndexRange(targetSlot iotago.SlotIndex) (startSlot iotago.SlotIndex, endSlot iotago.SlotIndex) {
 	protocolParams := s.settings.APIProvider().APIForSlot(targetSlot).ProtocolParameters()
 	genesisSlot := protocolParams.GenesisSlot()
@@ -83,6 +83,10 @@ func (t *TestFramework) RequireActiveRootBlocks(expected ...string) {
 	expectedRootBlocks := t.RootBlocks(expected...)
 	gotActiveRootBlocks := t.Instance.AllActiveRootBlocks()
 	require.Equalf(t.Testing, expectedRootBlocks, gotActiveRootBlocks, "active root blocks do not match, expected: %v, got: %v", expectedRootBlocks, gotActiveRootBlocks)
+
+	lastCommittedSlot, gotActiveRootBlocks := t.Instance.ActiveRootBlocks()
+	require.Equalf(t.Testing, expectedRootBlocks, gotActiveRootBlocks, "active root blocks of the last committed slot do not match, expected: %v, got: %v", expectedRootBlocks, gotActiveRootBlocks)
+	require.Equal(t.Testing, t.Instance.LastEvictedSlot(), lastCommittedSlot)
 }
 
 func (t *TestFramework) RequireLastEvictedSlot(expectedSlot iotago.SlotIndex) {
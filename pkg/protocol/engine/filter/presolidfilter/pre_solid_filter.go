@@ -3,10 +3,18 @@ package presolidfilter
 import (
 	"github.com/libp2p/go-libp2p/core/peer"
 
+	"github.com/iotaledger/hive.go/ierrors"
 	"github.com/iotaledger/hive.go/runtime/module"
 	"github.com/iotaledger/iota-core/pkg/model"
 )
 
+var (
+	ErrBlockTimeTooFarAheadInFuture = ierrors.New("a block cannot be too far ahead in the future")
+	ErrValidatorNotInCommittee      = ierrors.New("validation block issuer is not in the committee")
+	ErrInvalidBlockVersion          = ierrors.New("block has invalid protocol version")
+	ErrIssuerReputationTooLow       = ierrors.New("block issuer recently issued too many invalid blocks")
+)
+
 type PreSolidFilter interface {
 	// ProcessReceivedBlock processes block from the given source.
 	ProcessReceivedBlock(block *model.Block, source peer.ID)
@@ -0,0 +1,95 @@
+package presolidblockfilter
+
+import (
+	"math"
+
+	"github.com/iotaledger/hive.go/ds/shrinkingmap"
+	"github.com/iotaledger/hive.go/runtime/syncutils"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// minIssuerReputationScore is the score below which the reputation of an issuer is forgotten on eviction.
+const minIssuerReputationScore = 0.01
+
+// issuerReputation tracks the number of invalid blocks per issuer. Every invalid block increases the score of its
+// issuer by one and the score halves every halfLife slots, so that issuers recover once they stop issuing invalid
+// blocks.
+type issuerReputation struct {
+	// scores contains the scores of the issuers that recently issued invalid blocks.
+	scores *shrinkingmap.ShrinkingMap[iotago.AccountID, *issuerScore]
+
+	// halfLife contains the number of slots after which a score is halved.
+	halfLife iotago.SlotIndex
+
+	mutex syncutils.RWMutex
+}
+
+// issuerScore contains the score of an issuer at a given slot.
+type issuerScore struct {
+	value float64
+	slot  iotago.SlotIndex
+}
+
+// newIssuerReputation creates a new issuerReputation with the given half-life.
+func newIssuerReputation(halfLife iotago.SlotIndex) *issuerReputation {
+	return &issuerReputation{
+		scores:   shrinkingmap.New[iotago.AccountID, *issuerScore](),
+		halfLife: max(halfLife, 1),
+	}
+}
+
+// RecordInvalidBlock increases the score of the given issuer for an invalid block of the given slot.
+func (r *issuerReputation) RecordInvalidBlock(issuerID iotago.AccountID, slot iotago.SlotIndex) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	score, created := r.scores.GetOrCreate(issuerID, func() *issuerScore {
+		return &issuerScore{value: 1, slot: slot}
+	})
+	if created {
+		return
+	}
+
+	if slot > score.slot {
+		score.value = r.decayedValue(score, slot)
+		score.slot = slot
+	}
+
+	score.value++
+}
+
+// Score returns the score of the given issuer at the given slot.
+func (r *issuerReputation) Score(issuerID iotago.AccountID, slot iotago.SlotIndex) float64 {
+	r.mutex.RLock()
+	defer r.mutex.RUnlock()
+
+	score, exists := r.scores.Get(issuerID)
+	if !exists {
+		return 0
+	}
+
+	return r.decayedValue(score, slot)
+}
+
+// Evict forgets the issuers whose score decayed below minIssuerReputationScore at the given slot.
+func (r *issuerReputation) Evict(slot iotago.SlotIndex) {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	r.scores.ForEach(func(issuerID iotago.AccountID, score *issuerScore) bool {
+		if r.decayedValue(score, slot) < minIssuerReputationScore {
+			r.scores.Delete(issuerID)
+		}
+
+		return true
+	})
+}
+
+// decayedValue returns the value of the given score decayed to the given slot.
+func (r *issuerReputation) decayedValue(score *issuerScore, slot iotago.SlotIndex) float64 {
+	if slot <= score.slot {
+		return score.value
+	}
+
+	return score.value * math.Pow(0.5, float64(slot-score.slot)/float64(r.halfLife))
+}
@@ -11,16 +11,11 @@ import (
 	"github.com/iotaledger/iota-core/pkg/core/account"
 	"github.com/iotaledger/iota-core/pkg/model"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/filter/presolidfilter"
 	iotago "github.com/iotaledger/iota.go/v4"
 )
 
-var (
-	ErrBlockTimeTooFarAheadInFuture = ierrors.New("a block cannot be too far ahead in the future")
-	ErrValidatorNotInCommittee      = ierrors.New("validation block issuer is not in the committee")
-	ErrInvalidBlockVersion          = ierrors.New("block has invalid protocol version")
-)
-
 // PreSolidBlockFilter filters blocks.
 type PreSolidBlockFilter struct {
 	events *presolidfilter.Events
@@ -29,6 +24,17 @@ type PreSolidBlockFilter struct {
 
 	optsMaxAllowedWallClockDrift time.Duration
 
+	// optsIssuerReputationThreshold contains the score of invalid blocks above which the blocks of an issuer that is not
+	// part of the committee are dropped (0 = disabled).
+	optsIssuerReputationThreshold float64
+
+	// optsIssuerReputationHalfLife contains the number of slots after which the score of invalid blocks of an issuer is
+	// halved.
+	optsIssuerReputationHalfLife iotago.SlotIndex
+
+	// issuerReputation tracks the invalid blocks of the issuers.
+	issuerReputation *issuerReputation
+
 	committeeFunc func(iotago.SlotIndex) (*account.SeatedAccounts, bool)
 
 	module.Module
@@ -44,6 +50,16 @@ func NewProvider(opts ...options.Option[PreSolidBlockFilter]) module.Provider[*e
 			e.SybilProtection.HookInitialized(func() {
 				f.committeeFunc = e.SybilProtection.SeatManager().CommitteeInSlot
 			})
+
+			if f.optsIssuerReputationThreshold > 0 {
+				// only blocks that passed the signature check are taken into account, as the issuer of blocks that
+				// were filtered before can't be trusted.
+				e.Events.Booker.BlockInvalid.Hook(func(block *blocks.Block, _ error) {
+					f.issuerReputation.RecordInvalidBlock(block.ProtocolBlock().Header.IssuerID, block.ID().Slot())
+				})
+				e.Events.EvictionState.SlotEvicted.Hook(f.issuerReputation.Evict)
+			}
+
 			f.TriggerInitialized()
 		})
 
@@ -56,9 +72,13 @@ var _ presolidfilter.PreSolidFilter = new(PreSolidBlockFilter)
 // New creates a new PreSolidBlockFilter.
 func New(apiProvider iotago.APIProvider, opts ...options.Option[PreSolidBlockFilter]) *PreSolidBlockFilter {
 	return options.Apply(&PreSolidBlockFilter{
-		events:      presolidfilter.NewEvents(),
-		apiProvider: apiProvider,
+		events:                       presolidfilter.NewEvents(),
+		apiProvider:                  apiProvider,
+		optsIssuerReputationHalfLife: 10,
 	}, opts,
+		func(f *PreSolidBlockFilter) {
+			f.issuerReputation = newIssuerReputation(f.optsIssuerReputationHalfLife)
+		},
 		(*PreSolidBlockFilter).TriggerConstructed,
 		(*PreSolidBlockFilter).TriggerInitialized,
 	)
@@ -71,7 +91,7 @@ func (f *PreSolidBlockFilter) ProcessReceivedBlock(block *model.Block, source pe
 	if apiForSlot.Version() != block.ProtocolBlock().Header.ProtocolVersion {
 		f.events.BlockPreFiltered.Trigger(&presolidfilter.BlockPreFilteredEvent{
 			Block:  block,
-			Reason: ierrors.Wrapf(ErrInvalidBlockVersion, "invalid protocol version %d (expected %d) for epoch %d", block.ProtocolBlock().Header.ProtocolVersion, apiForSlot.Version(), apiForSlot.TimeProvider().EpochFromSlot(block.ID().Slot())),
+			Reason: ierrors.Wrapf(presolidfilter.ErrInvalidBlockVersion, "invalid protocol version %d (expected %d) for epoch %d", block.ProtocolBlock().Header.ProtocolVersion, apiForSlot.Version(), apiForSlot.TimeProvider().EpochFromSlot(block.ID().Slot())),
 			Source: source,
 		})
 
@@ -83,7 +103,17 @@ func (f *PreSolidBlockFilter) ProcessReceivedBlock(block *model.Block, source pe
 	if timeDelta < -f.optsMaxAllowedWallClockDrift {
 		f.events.BlockPreFiltered.Trigger(&presolidfilter.BlockPreFilteredEvent{
 			Block:  block,
-			Reason: ierrors.Wrapf(ErrBlockTimeTooFarAheadInFuture, "issuing time ahead %s vs %s allowed", -timeDelta, f.optsMaxAllowedWallClockDrift),
+			Reason: ierrors.Wrapf(presolidfilter.ErrBlockTimeTooFarAheadInFuture, "issuing time ahead %s vs %s allowed", -timeDelta, f.optsMaxAllowedWallClockDrift),
+			Source: source,
+		})
+
+		return
+	}
+
+	if f.issuerReputationTooLow(block) {
+		f.events.BlockPreFiltered.Trigger(&presolidfilter.BlockPreFilteredEvent{
+			Block:  block,
+			Reason: ierrors.Wrapf(presolidfilter.ErrIssuerReputationTooLow, "block issuer %s exceeded the invalid block threshold %.2f", block.ProtocolBlock().Header.IssuerID, f.optsIssuerReputationThreshold),
 			Source: source,
 		})
 
@@ -96,7 +126,7 @@ func (f *PreSolidBlockFilter) ProcessReceivedBlock(block *model.Block, source pe
 		if !exists {
 			f.events.BlockPreFiltered.Trigger(&presolidfilter.BlockPreFilteredEvent{
 				Block:  block,
-				Reason: ierrors.Wrapf(ErrValidatorNotInCommittee, "no committee for slot %d", blockSlot),
+				Reason: ierrors.Wrapf(presolidfilter.ErrValidatorNotInCommittee, "no committee for slot %d", blockSlot),
 				Source: source,
 			})
 
@@ -106,7 +136,7 @@ func (f *PreSolidBlockFilter) ProcessReceivedBlock(block *model.Block, source pe
 		if !committee.HasAccount(block.ProtocolBlock().Header.IssuerID) {
 			f.events.BlockPreFiltered.Trigger(&presolidfilter.BlockPreFilteredEvent{
 				Block:  block,
-				Reason: ierrors.Wrapf(ErrValidatorNotInCommittee, "validation block issuer %s is not part of the committee for slot %d", block.ProtocolBlock().Header.IssuerID, blockSlot),
+				Reason: ierrors.Wrapf(presolidfilter.ErrValidatorNotInCommittee, "validation block issuer %s is not part of the committee for slot %d", block.ProtocolBlock().Header.IssuerID, blockSlot),
 				Source: source,
 			})
 
@@ -117,6 +147,27 @@ func (f *PreSolidBlockFilter) ProcessReceivedBlock(block *model.Block, source pe
 	f.events.BlockPreAllowed.Trigger(block)
 }
 
+// issuerReputationTooLow returns true if the issuer of the given block exceeded the invalid block threshold and is not
+// part of the committee.
+func (f *PreSolidBlockFilter) issuerReputationTooLow(block *model.Block) bool {
+	if f.optsIssuerReputationThreshold == 0 {
+		return false
+	}
+
+	issuerID := block.ProtocolBlock().Header.IssuerID
+	if f.issuerReputation.Score(issuerID, block.ID().Slot()) <= f.optsIssuerReputationThreshold {
+		return false
+	}
+
+	if f.committeeFunc != nil {
+		if committee, exists := f.committeeFunc(block.ID().Slot()); exists && committee.HasAccount(issuerID) {
+			return false
+		}
+	}
+
+	return true
+}
+
 // Reset resets the component to a clean state as if it was created at the last commitment.
 func (f *PreSolidBlockFilter) Reset() { /* nothing to reset but comply with interface */ }
 
@@ -130,3 +181,19 @@ func WithMaxAllowedWallClockDrift(d time.Duration) options.Option[PreSolidBlockF
 		filter.optsMaxAllowedWallClockDrift = d
 	}
 }
+
+// WithIssuerReputationThreshold specifies the score of invalid blocks above which the blocks of an issuer are dropped
+// unless it is part of the committee (defaults to 0 which disables the reputation tracking).
+func WithIssuerReputationThreshold(threshold float64) options.Option[PreSolidBlockFilter] {
+	return func(filter *PreSolidBlockFilter) {
+		filter.optsIssuerReputationThreshold = threshold
+	}
+}
+
+// WithIssuerReputationHalfLife specifies the number of slots after which the score of invalid blocks of an issuer is
+// halved (defaults to 10 slots).
+func WithIssuerReputationHalfLife(halfLife iotago.SlotIndex) options.Option[PreSolidBlockFilter] {
+	return func(filter *PreSolidBlockFilter) {
+		filter.optsIssuerReputationHalfLife = halfLife
+	}
+}
@@ -79,6 +79,18 @@ func (t *TestFramework) IssueValidationBlockAtTime(alias string, issuingTime tim
 	return t.processBlock(alias, block)
 }
 
+func (t *TestFramework) IssueSignedBlockAtTime(alias string, issuingTime time.Time, issuerAccountID iotago.AccountID) error {
+	slot := t.apiProvider.CommittedAPI().TimeProvider().SlotFromTime(issuingTime)
+	block, err := builder.NewBasicBlockBuilder(t.apiProvider.APIForSlot(slot)).
+		StrongParents(iotago.BlockIDs{tpkg.RandBlockID()}).
+		Sign(issuerAccountID, tpkg.RandEd25519PrivateKey()).
+		IssuingTime(issuingTime).
+		Build()
+	require.NoError(t.Test, err)
+
+	return t.processBlock(alias, block)
+}
+
 func (t *TestFramework) IssueBlockAtSlotWithVersion(alias string, slot iotago.SlotIndex, version iotago.Version, apiProvider iotago.APIProvider) error {
 	apiForVersion, err := apiProvider.APIForVersion(version)
 	require.NoError(t.Test, err)
@@ -124,7 +136,7 @@ func TestFilter_WithMaxAllowedWallClockDrift(t *testing.T) {
 
 	tf.Filter.events.BlockPreFiltered.Hook(func(event *presolidfilter.BlockPreFilteredEvent) {
 		require.Equal(t, "tooFarAheadFuture", event.Block.ID().Alias())
-		require.True(t, ierrors.Is(event.Reason, ErrBlockTimeTooFarAheadInFuture))
+		require.True(t, ierrors.Is(event.Reason, presolidfilter.ErrBlockTimeTooFarAheadInFuture))
 	})
 
 	require.NoError(t, tf.IssueUnsignedBlockAtTime("past", time.Now().Add(-allowedDrift)))
@@ -164,7 +176,7 @@ func TestFilter_ProtocolVersion(t *testing.T) {
 		block := event.Block
 		require.False(t, valid.Has(block.ID().Alias()))
 		require.True(t, invalid.Has(block.ID().Alias()))
-		require.True(t, ierrors.Is(event.Reason, ErrInvalidBlockVersion))
+		require.True(t, ierrors.Is(event.Reason, presolidfilter.ErrInvalidBlockVersion))
 	})
 
 	invalid.Add("A")
@@ -219,9 +231,74 @@ func TestFilter_ValidationBlocks(t *testing.T) {
 	tf.Filter.events.BlockPreFiltered.Hook(func(event *presolidfilter.BlockPreFilteredEvent) {
 		require.NotEqual(t, "validator", event.Block.ID().Alias())
 		require.Equal(t, "nonValidator", event.Block.ID().Alias())
-		require.True(t, ierrors.Is(event.Reason, ErrValidatorNotInCommittee))
+		require.True(t, ierrors.Is(event.Reason, presolidfilter.ErrValidatorNotInCommittee))
 	})
 
 	require.NoError(t, tf.IssueValidationBlockAtTime("validator", time.Now(), validatorAccountID))
 	require.NoError(t, tf.IssueValidationBlockAtTime("nonValidator", time.Now(), nonValidatorAccountID))
 }
+
+func TestFilter_IssuerReputation(t *testing.T) {
+	testAPI := tpkg.ZeroCostTestAPI
+	timeProvider := testAPI.TimeProvider()
+
+	tf := NewTestFramework(t,
+		iotago.SingleVersionProvider(testAPI),
+		// Set this to some value far in the future so that we can arbitrarily manipulate block times.
+		WithMaxAllowedWallClockDrift(time.Hour),
+		WithIssuerReputationThreshold(2),
+		WithIssuerReputationHalfLife(10),
+	)
+
+	validatorAccountID := tpkg.RandAccountID()
+	misbehavingAccountID := tpkg.RandAccountID()
+	honestAccountID := tpkg.RandAccountID()
+
+	tf.Filter.committeeFunc = mockedCommitteeFunc(validatorAccountID)
+
+	valid := ds.NewSet[string]()
+	invalid := ds.NewSet[string]()
+
+	tf.Filter.events.BlockPreAllowed.Hook(func(block *model.Block) {
+		require.True(t, valid.Has(block.ID().Alias()))
+	})
+
+	tf.Filter.events.BlockPreFiltered.Hook(func(event *presolidfilter.BlockPreFilteredEvent) {
+		require.True(t, invalid.Has(event.Block.ID().Alias()))
+		require.True(t, ierrors.Is(event.Reason, presolidfilter.ErrIssuerReputationTooLow))
+	})
+
+	now := time.Now()
+	slot := timeProvider.SlotFromTime(now)
+
+	// two invalid blocks do not exceed the threshold.
+	tf.Filter.issuerReputation.RecordInvalidBlock(misbehavingAccountID, slot)
+	tf.Filter.issuerReputation.RecordInvalidBlock(misbehavingAccountID, slot)
+
+	valid.Add("A")
+	require.NoError(t, tf.IssueSignedBlockAtTime("A", now, misbehavingAccountID))
+
+	tf.Filter.issuerReputation.RecordInvalidBlock(misbehavingAccountID, slot)
+	tf.Filter.issuerReputation.RecordInvalidBlock(validatorAccountID, slot)
+	tf.Filter.issuerReputation.RecordInvalidBlock(validatorAccountID, slot)
+	tf.Filter.issuerReputation.RecordInvalidBlock(validatorAccountID, slot)
+
+	invalid.Add("B")
+	require.NoError(t, tf.IssueSignedBlockAtTime("B", now, misbehavingAccountID))
+
+	// committee members are never dropped and other issuers are not affected.
+	valid.Add("C")
+	require.NoError(t, tf.IssueSignedBlockAtTime("C", now, validatorAccountID))
+	valid.Add("D")
+	require.NoError(t, tf.IssueSignedBlockAtTime("D", now, honestAccountID))
+
+	// the score halves after 10 slots (3 -> 1.5), so that the issuer recovers.
+	valid.Add("E")
+	require.NoError(t, tf.IssueSignedBlockAtTime("E", timeProvider.SlotStartTime(slot+10), misbehavingAccountID))
+
+	// the issuer is forgotten once its score decayed.
+	tf.Filter.issuerReputation.Evict(slot + 10)
+	require.True(t, tf.Filter.issuerReputation.scores.Has(misbehavingAccountID))
+	tf.Filter.issuerReputation.Evict(slot + 100)
+	require.False(t, tf.Filter.issuerReputation.scores.Has(misbehavingAccountID))
+}
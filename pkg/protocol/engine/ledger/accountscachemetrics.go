@@ -0,0 +1,11 @@
+package ledger
+
+// AccountsCacheMetrics contains the statistics of the accounts cache.
+type AccountsCacheMetrics struct {
+	// Hits is the number of account lookups that were served from the cache.
+	Hits uint64
+	// Misses is the number of account lookups that had to be computed from the accounts tree and the slot diffs.
+	Misses uint64
+	// Size is the number of entries that are currently held in the cache.
+	Size int
+}
@@ -28,3 +28,13 @@ func (v BlockVoteRank) Compare(other BlockVoteRank) int {
 
 	return bytes.Compare(v.blockID[:], other.blockID[:])
 }
+
+// BlockID returns the ID of the block that cast the vote.
+func (v BlockVoteRank) BlockID() iotago.BlockID {
+	return v.blockID
+}
+
+// Time returns the issuing time of the block that cast the vote.
+func (v BlockVoteRank) Time() time.Time {
+	return v.time
+}
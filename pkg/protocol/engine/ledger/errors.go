@@ -0,0 +1,12 @@
+package ledger
+
+import "github.com/iotaledger/hive.go/ierrors"
+
+var (
+	// ErrBlockIssuerKeysCountInvalid is returned when the block issuer keys of an account exceed the protocol limits.
+	ErrBlockIssuerKeysCountInvalid = ierrors.New("invalid number of block issuer keys")
+
+	// ErrBlockIssuerKeysDiffInvalid is returned when the added and removed block issuer keys of an account diff are not
+	// consistent with the block issuer keys of the account.
+	ErrBlockIssuerKeysDiffInvalid = ierrors.New("invalid block issuer keys diff")
+)
@@ -9,13 +9,21 @@ type Events struct {
 	AccountCreated   *event.Event1[iotago.AccountID]
 	AccountDestroyed *event.Event1[iotago.AccountID]
 
+	// BlockIssuerKeysCountInvalid is triggered when the block issuer keys of an account exceed the protocol limits.
+	BlockIssuerKeysCountInvalid *event.Event2[iotago.AccountID, error]
+
+	// BlockIssuerKeysDiffInvalid is triggered when the block issuer keys diff of an account is inconsistent.
+	BlockIssuerKeysDiffInvalid *event.Event2[iotago.AccountID, error]
+
 	event.Group[Events, *Events]
 }
 
 // NewEvents contains the constructor of the Events object (it is generated by a generic factory).
 var NewEvents = event.CreateGroupConstructor(func() (newEvents *Events) {
 	return &Events{
-		AccountCreated:   event.New1[iotago.AccountID](),
-		AccountDestroyed: event.New1[iotago.AccountID](),
+		AccountCreated:              event.New1[iotago.AccountID](),
+		AccountDestroyed:            event.New1[iotago.AccountID](),
+		BlockIssuerKeysCountInvalid: event.New2[iotago.AccountID, error](),
+		BlockIssuerKeysDiffInvalid:  event.New2[iotago.AccountID, error](),
 	}
 })
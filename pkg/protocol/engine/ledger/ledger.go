@@ -5,6 +5,7 @@ import (
 
 	"github.com/iotaledger/hive.go/runtime/event"
 	"github.com/iotaledger/hive.go/runtime/module"
+	"github.com/iotaledger/iota-core/pkg/model"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/accounts"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/accounts/mana"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
@@ -22,8 +23,11 @@ type Ledger interface {
 	TransactionMetadataByAttachment(blockID iotago.BlockID) (transactionMetadata mempool.TransactionMetadata, exists bool)
 
 	Account(accountID iotago.AccountID, targetSlot iotago.SlotIndex) (accountData *accounts.AccountData, exists bool, err error)
+	AccountOutputID(accountID iotago.AccountID, targetSlot iotago.SlotIndex) (outputID iotago.OutputID, exists bool, err error)
 	PastAccounts(accountIDs iotago.AccountIDs, targetSlot iotago.SlotIndex) (pastAccountsData map[iotago.AccountID]*accounts.AccountData, err error)
 	AddAccount(account *utxoledger.Output, credits iotago.BlockIssuanceCredits) error
+	AccountsCacheMetrics() *AccountsCacheMetrics
+	AccountsAggregates(slot iotago.SlotIndex) (*model.AccountsAggregates, error)
 
 	Output(id iotago.OutputID) (*utxoledger.Output, error)
 	OutputOrSpent(id iotago.OutputID) (output *utxoledger.Output, spent *utxoledger.Spent, err error)
@@ -33,10 +37,12 @@ type Ledger interface {
 	SpendDAG() spenddag.SpendDAG[iotago.TransactionID, mempool.StateID, BlockVoteRank]
 	MemPool() mempool.MemPool[BlockVoteRank]
 	SlotDiffs(slot iotago.SlotIndex) (*utxoledger.SlotDiff, error)
+	ManaTrace(transactionID iotago.TransactionID, slot iotago.SlotIndex) (manaTrace *model.ManaTrace, exists bool, err error)
 
 	ManaManager() *mana.Manager
 	RMCManager() *rmc.Manager
 
+	SetWarpSyncStateDiff(slot iotago.SlotIndex, stateDiff *model.SlotStateDiff, transactionIDs iotago.TransactionIDs, onInvalid func(err error))
 	CommitSlot(slot iotago.SlotIndex) (stateRoot, mutationRoot, accountRoot iotago.Identifier, created utxoledger.Outputs, consumed utxoledger.Spents, err error)
 
 	Import(reader io.ReadSeeker) error
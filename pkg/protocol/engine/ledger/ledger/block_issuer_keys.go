@@ -0,0 +1,101 @@
+package ledger
+
+import (
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/ledger"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// validateBlockIssuerKeys checks that the given block issuer keys of a created account output are unique and that
+// their number is within the protocol limits.
+func validateBlockIssuerKeys(blockIssuerKeys iotago.BlockIssuerKeys) error {
+	if err := validateBlockIssuerKeysCount(len(blockIssuerKeys)); err != nil {
+		return err
+	}
+
+	uniqueKeys := iotago.NewBlockIssuerKeys()
+	for i, blockIssuerKey := range blockIssuerKeys {
+		if uniqueKeys.Has(blockIssuerKey) {
+			return ierrors.Wrapf(ledger.ErrBlockIssuerKeysDiffInvalid, "duplicate block issuer key at index %d", i)
+		}
+
+		uniqueKeys.Add(blockIssuerKey)
+	}
+
+	return nil
+}
+
+// validateCreatedBlockIssuerKeys checks the block issuer keys of all outputs that are created by a transaction, so that
+// a transaction that creates invalid block issuer keys is rejected before it can be accepted.
+func validateCreatedBlockIssuerKeys(createdOutputs []iotago.Output) error {
+	for index, createdOutput := range createdOutputs {
+		if blockIssuerFeature := createdOutput.FeatureSet().BlockIssuer(); blockIssuerFeature != nil {
+			if err := validateBlockIssuerKeys(blockIssuerFeature.BlockIssuerKeys); err != nil {
+				return ierrors.Wrapf(err, "invalid block issuer keys of output %d", index)
+			}
+		}
+	}
+
+	return nil
+}
+
+// blockIssuerKeysDiff returns the block issuer keys that need to be added to and removed from the old keys of an
+// account to end up with the new keys.
+func blockIssuerKeysDiff(oldKeys iotago.BlockIssuerKeys, newKeys iotago.BlockIssuerKeys) (addedKeys iotago.BlockIssuerKeys, removedKeys iotago.BlockIssuerKeys) {
+	newKeysSet := iotago.NewBlockIssuerKeys()
+	for _, newKey := range newKeys {
+		newKeysSet.Add(newKey)
+	}
+
+	// Add public keys that are not in the old set
+	addedKeys = iotago.NewBlockIssuerKeys()
+	for _, newKey := range newKeysSet {
+		if !oldKeys.Has(newKey) {
+			addedKeys.Add(newKey)
+		}
+	}
+
+	// Remove the keys that are not in the new set
+	removedKeys = iotago.NewBlockIssuerKeys()
+	for _, oldKey := range oldKeys {
+		if !newKeysSet.Has(oldKey) {
+			removedKeys.Add(oldKey)
+		}
+	}
+
+	return addedKeys, removedKeys
+}
+
+// validateBlockIssuerKeysDiff checks that the added and removed block issuer keys of an account diff can be applied to
+// the current block issuer keys of the account and that the resulting number of keys is within the protocol limits.
+//
+// A key that is removed and added again in the same slot does not change the key set of the account, so it must
+// neither show up as added nor as removed key of the diff.
+func validateBlockIssuerKeysDiff(currentKeys iotago.BlockIssuerKeys, addedKeys iotago.BlockIssuerKeys, removedKeys iotago.BlockIssuerKeys) error {
+	for i, addedKey := range addedKeys {
+		if currentKeys.Has(addedKey) {
+			return ierrors.Wrapf(ledger.ErrBlockIssuerKeysDiffInvalid, "added block issuer key at index %d already exists", i)
+		}
+
+		if removedKeys.Has(addedKey) {
+			return ierrors.Wrapf(ledger.ErrBlockIssuerKeysDiffInvalid, "added block issuer key at index %d is also removed", i)
+		}
+	}
+
+	for i, removedKey := range removedKeys {
+		if !currentKeys.Has(removedKey) {
+			return ierrors.Wrapf(ledger.ErrBlockIssuerKeysDiffInvalid, "removed block issuer key at index %d does not exist", i)
+		}
+	}
+
+	return validateBlockIssuerKeysCount(len(currentKeys) + len(addedKeys) - len(removedKeys))
+}
+
+// validateBlockIssuerKeysCount checks that the given number of block issuer keys is within the protocol limits.
+func validateBlockIssuerKeysCount(count int) error {
+	if count < iotago.MinBlockIssuerKeysCount || count > iotago.MaxBlockIssuerKeysCount {
+		return ierrors.Wrapf(ledger.ErrBlockIssuerKeysCountInvalid, "%d keys should be in [%d, %d]", count, iotago.MinBlockIssuerKeysCount, iotago.MaxBlockIssuerKeysCount)
+	}
+
+	return nil
+}
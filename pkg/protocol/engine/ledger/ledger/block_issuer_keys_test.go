@@ -0,0 +1,191 @@
+package ledger
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/ledger"
+	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/tpkg"
+)
+
+func TestValidateBlockIssuerKeys(t *testing.T) {
+	key := tpkg.RandBlockIssuerKey()
+
+	require.NoError(t, validateBlockIssuerKeys(tpkg.RandBlockIssuerKeys(iotago.MinBlockIssuerKeysCount)))
+	require.NoError(t, validateBlockIssuerKeys(tpkg.RandBlockIssuerKeys(iotago.MaxBlockIssuerKeysCount)))
+	require.ErrorIs(t, validateBlockIssuerKeys(iotago.NewBlockIssuerKeys()), ledger.ErrBlockIssuerKeysCountInvalid)
+	require.ErrorIs(t, validateBlockIssuerKeys(tpkg.RandBlockIssuerKeys(iotago.MaxBlockIssuerKeysCount+1)), ledger.ErrBlockIssuerKeysCountInvalid)
+	require.ErrorIs(t, validateBlockIssuerKeys(iotago.BlockIssuerKeys{key, key}), ledger.ErrBlockIssuerKeysDiffInvalid)
+}
+
+func TestBlockIssuerKeysTransition(t *testing.T) {
+	keyA, keyB, keyC := tpkg.RandBlockIssuerKey(), tpkg.RandBlockIssuerKey(), tpkg.RandBlockIssuerKey()
+
+	for _, test := range []struct {
+		name            string
+		oldKeys         iotago.BlockIssuerKeys
+		newKeys         iotago.BlockIssuerKeys
+		expectedAdded   iotago.BlockIssuerKeys
+		expectedRemoved iotago.BlockIssuerKeys
+		expectedErr     error
+	}{
+		{
+			name:    "unchanged",
+			oldKeys: iotago.NewBlockIssuerKeys(keyA, keyB),
+			newKeys: iotago.NewBlockIssuerKeys(keyA, keyB),
+		},
+		{
+			// the key was removed and added again by different transactions of the same slot.
+			name:    "removed and added again",
+			oldKeys: iotago.NewBlockIssuerKeys(keyA, keyB),
+			newKeys: iotago.NewBlockIssuerKeys(keyB, keyA),
+		},
+		{
+			name:          "added",
+			oldKeys:       iotago.NewBlockIssuerKeys(keyA),
+			newKeys:       iotago.NewBlockIssuerKeys(keyA, keyB),
+			expectedAdded: iotago.NewBlockIssuerKeys(keyB),
+		},
+		{
+			name:            "removed",
+			oldKeys:         iotago.NewBlockIssuerKeys(keyA, keyB),
+			newKeys:         iotago.NewBlockIssuerKeys(keyA),
+			expectedRemoved: iotago.NewBlockIssuerKeys(keyB),
+		},
+		{
+			name:            "replaced",
+			oldKeys:         iotago.NewBlockIssuerKeys(keyA, keyB),
+			newKeys:         iotago.NewBlockIssuerKeys(keyB, keyC),
+			expectedAdded:   iotago.NewBlockIssuerKeys(keyC),
+			expectedRemoved: iotago.NewBlockIssuerKeys(keyA),
+		},
+		{
+			name:            "all removed",
+			oldKeys:         iotago.NewBlockIssuerKeys(keyA, keyB),
+			newKeys:         iotago.NewBlockIssuerKeys(),
+			expectedRemoved: iotago.NewBlockIssuerKeys(keyA, keyB),
+			expectedErr:     ledger.ErrBlockIssuerKeysCountInvalid,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			addedKeys, removedKeys := blockIssuerKeysDiff(test.oldKeys, test.newKeys)
+			require.True(t, iotago.NewBlockIssuerKeys(test.expectedAdded...).Equal(addedKeys))
+			require.True(t, iotago.NewBlockIssuerKeys(test.expectedRemoved...).Equal(removedKeys))
+
+			err := validateBlockIssuerKeysDiff(test.oldKeys, addedKeys, removedKeys)
+			if test.expectedErr == nil {
+				require.NoError(t, err)
+
+				return
+			}
+
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
+
+func TestValidateBlockIssuerKeysDiff(t *testing.T) {
+	keyA, keyB, keyC := tpkg.RandBlockIssuerKey(), tpkg.RandBlockIssuerKey(), tpkg.RandBlockIssuerKey()
+	maxKeys := tpkg.RandBlockIssuerKeys(iotago.MaxBlockIssuerKeysCount)
+
+	for _, test := range []struct {
+		name        string
+		currentKeys iotago.BlockIssuerKeys
+		addedKeys   iotago.BlockIssuerKeys
+		removedKeys iotago.BlockIssuerKeys
+		expectedErr error
+	}{
+		{
+			name:        "add and remove different keys",
+			currentKeys: iotago.NewBlockIssuerKeys(keyA),
+			addedKeys:   iotago.NewBlockIssuerKeys(keyB),
+			removedKeys: iotago.NewBlockIssuerKeys(keyA),
+		},
+		{
+			name:        "add and remove the same key",
+			currentKeys: iotago.NewBlockIssuerKeys(keyA),
+			addedKeys:   iotago.NewBlockIssuerKeys(keyB),
+			removedKeys: iotago.NewBlockIssuerKeys(keyB),
+			expectedErr: ledger.ErrBlockIssuerKeysDiffInvalid,
+		},
+		{
+			name:        "add existing key",
+			currentKeys: iotago.NewBlockIssuerKeys(keyA),
+			addedKeys:   iotago.NewBlockIssuerKeys(keyA),
+			expectedErr: ledger.ErrBlockIssuerKeysDiffInvalid,
+		},
+		{
+			name:        "remove missing key",
+			currentKeys: iotago.NewBlockIssuerKeys(keyA, keyB),
+			removedKeys: iotago.NewBlockIssuerKeys(keyC),
+			expectedErr: ledger.ErrBlockIssuerKeysDiffInvalid,
+		},
+		{
+			name:        "replace key at limit",
+			currentKeys: maxKeys,
+			addedKeys:   iotago.NewBlockIssuerKeys(keyA),
+			removedKeys: iotago.NewBlockIssuerKeys(maxKeys[0]),
+		},
+		{
+			name:        "add key at limit",
+			currentKeys: maxKeys,
+			addedKeys:   iotago.NewBlockIssuerKeys(keyA),
+			expectedErr: ledger.ErrBlockIssuerKeysCountInvalid,
+		},
+		{
+			name:        "remove last key",
+			currentKeys: iotago.NewBlockIssuerKeys(keyA),
+			removedKeys: iotago.NewBlockIssuerKeys(keyA),
+			expectedErr: ledger.ErrBlockIssuerKeysCountInvalid,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateBlockIssuerKeysDiff(test.currentKeys, test.addedKeys, test.removedKeys)
+			if test.expectedErr == nil {
+				require.NoError(t, err)
+
+				return
+			}
+
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
+
+func TestValidateCreatedBlockIssuerKeys(t *testing.T) {
+	accountOutput := func(blockIssuerKeys iotago.BlockIssuerKeys) *iotago.AccountOutput {
+		return &iotago.AccountOutput{Features: iotago.AccountOutputFeatures{&iotago.BlockIssuerFeature{BlockIssuerKeys: blockIssuerKeys, ExpirySlot: iotago.MaxSlotIndex}}}
+	}
+
+	require.NoError(t, validateCreatedBlockIssuerKeys([]iotago.Output{&iotago.BasicOutput{}, &iotago.AccountOutput{}, accountOutput(tpkg.RandBlockIssuerKeys(iotago.MaxBlockIssuerKeysCount))}))
+	require.ErrorIs(t, validateCreatedBlockIssuerKeys([]iotago.Output{accountOutput(tpkg.RandBlockIssuerKeys(1)), accountOutput(tpkg.RandBlockIssuerKeys(iotago.MaxBlockIssuerKeysCount + 1))}), ledger.ErrBlockIssuerKeysCountInvalid)
+
+	key := tpkg.RandBlockIssuerKey()
+	require.ErrorIs(t, validateCreatedBlockIssuerKeys([]iotago.Output{accountOutput(iotago.BlockIssuerKeys{key, key})}), ledger.ErrBlockIssuerKeysDiffInvalid)
+}
+
+func TestLedger_BlockIssuerKeysInvalid(t *testing.T) {
+	l := &Ledger{events: ledger.NewEvents()}
+	accountID := tpkg.RandAccountID()
+
+	var countInvalidErr, diffInvalidErr error
+	l.events.BlockIssuerKeysCountInvalid.Hook(func(invalidAccountID iotago.AccountID, err error) {
+		require.Equal(t, accountID, invalidAccountID)
+		countInvalidErr = err
+	})
+	l.events.BlockIssuerKeysDiffInvalid.Hook(func(invalidAccountID iotago.AccountID, err error) {
+		require.Equal(t, accountID, invalidAccountID)
+		diffInvalidErr = err
+	})
+
+	l.blockIssuerKeysInvalid(accountID, validateBlockIssuerKeysCount(0))
+	require.ErrorIs(t, countInvalidErr, ledger.ErrBlockIssuerKeysCountInvalid)
+	require.NoError(t, diffInvalidErr)
+
+	countInvalidErr = nil
+	l.blockIssuerKeysInvalid(accountID, validateBlockIssuerKeysDiff(iotago.NewBlockIssuerKeys(), nil, tpkg.RandBlockIssuerKeys(1)))
+	require.ErrorIs(t, diffInvalidErr, ledger.ErrBlockIssuerKeysDiffInvalid)
+	require.NoError(t, countInvalidErr)
+}
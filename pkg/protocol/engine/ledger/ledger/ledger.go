@@ -9,9 +9,11 @@ import (
 	"github.com/iotaledger/hive.go/kvstore"
 	"github.com/iotaledger/hive.go/runtime/event"
 	"github.com/iotaledger/hive.go/runtime/module"
+	"github.com/iotaledger/hive.go/runtime/options"
 	"github.com/iotaledger/iota-core/pkg/core/promise"
 	"github.com/iotaledger/iota-core/pkg/core/vote"
 	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/profiling"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/accounts"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/accounts/accountsledger"
@@ -45,10 +47,38 @@ type Ledger struct {
 	retainTransactionFailure func(iotago.BlockID, error)
 	errorHandler             func(error)
 
+	// spendersFunc returns the storage that the pending spenders of the SpendDAG are persisted to.
+	spendersFunc func(iotago.SlotIndex) (*slotstore.Store[iotago.TransactionID, *model.Spender], error)
+
+	// manaTracesFunc returns the storage that the mana traces of the committed transactions are persisted to.
+	manaTracesFunc func(iotago.SlotIndex) (*slotstore.Store[iotago.TransactionID, *model.ManaTrace], error)
+
+	// restoredSpenders contains the spenders that were restored from the storage and that need to be evicted once
+	// restoredSpendersEvictionSlot was evicted (unless they were attached again in the meantime).
+	restoredSpenders ds.Set[iotago.TransactionID]
+
+	// restoredSpendersEvictionSlot is the slot after whose eviction the restored spenders are evicted.
+	restoredSpendersEvictionSlot iotago.SlotIndex
+
+	// warpSyncStateDiffs contains the verified state diffs of the slots that are warp synced.
+	warpSyncStateDiffs *warpSyncStateDiffs
+
+	// optsSpendDAGPersistence defines whether the pending spenders of the SpendDAG are persisted with every commitment
+	// and restored on startup.
+	optsSpendDAGPersistence bool
+
+	// optsMemPoolWriteAheadLog defines whether the attached transactions of the MemPool are recorded in a write-ahead
+	// log and replayed on startup.
+	optsMemPoolWriteAheadLog bool
+
+	// optsMergeToMaster defines whether accepted spenders are removed from the spenders that blocks and transactions
+	// inherit (merge to master).
+	optsMergeToMaster bool
+
 	module.Module
 }
 
-func NewProvider() module.Provider[*engine.Engine, ledger.Ledger] {
+func NewProvider(opts ...options.Option[Ledger]) module.Provider[*engine.Engine, ledger.Ledger] {
 	return module.Provide(func(e *engine.Engine) ledger.Ledger {
 		l := New(
 			e.Storage.Ledger(),
@@ -56,21 +86,57 @@ func NewProvider() module.Provider[*engine.Engine, ledger.Ledger] {
 			e.Storage.Commitments().Load,
 			e.BlockCache.Block,
 			e.Storage.AccountDiffs,
+			e.Storage.AccountsAggregates,
+			e.Storage.Spenders,
+			e.Storage.ManaTraces,
 			e,
 			e.SybilProtection,
 			e.ErrorHandler("ledger"),
+			opts...,
 		)
 
 		e.Constructed.OnTrigger(func() {
 			e.Events.Ledger.LinkTo(l.events)
-			l.spendDAG = spenddagv1.New[iotago.TransactionID, mempool.StateID, ledger.BlockVoteRank](l.sybilProtection.SeatManager().OnlineCommittee().Size)
+			l.spendDAG = l.newSpendDAG()
 			e.Events.SpendDAG.LinkTo(l.spendDAG.Events())
 
 			l.setRetainTransactionFailureFunc(e.Retainer.RetainTransactionFailure)
 
-			l.memPool = mempoolv1.New(NewVM(l), l.resolveState, e.Storage.Mutations, e.Workers.CreateGroup("MemPool"), l.spendDAG, l.apiProvider, l.errorHandler)
+			memPoolOpts := make([]options.Option[mempoolv1.MemPool[ledger.BlockVoteRank]], 0)
+			if l.optsMemPoolWriteAheadLog {
+				memPoolOpts = append(memPoolOpts, mempoolv1.WithWriteAheadLog[ledger.BlockVoteRank](
+					e.Storage.MemPoolWriteAheadLog,
+					func() iotago.SlotIndex { return e.Storage.Settings().LatestCommitment().Slot() },
+					l.signedTransactionBytes,
+					l.signedTransactionFromBytes,
+				))
+			}
+
+			memPool := mempoolv1.New(NewVM(l), l.resolveState, e.Storage.Mutations, profiling.CreateGroup(e.Workers, "MemPool"), l.spendDAG, l.apiProvider, l.errorHandler, memPoolOpts...)
+			l.memPool = memPool
 			e.EvictionState.Events.SlotEvicted.Hook(l.memPool.Evict)
 
+			if l.optsMemPoolWriteAheadLog {
+				// the transactions can only be replayed once the state of the ledger was restored
+				e.Initialized.OnTrigger(func() {
+					if err := memPool.ReplayWriteAheadLog(e.Storage.Settings().LatestCommitment().Slot()); err != nil {
+						l.errorHandler(ierrors.Wrap(err, "failed to replay MemPool write-ahead log"))
+					}
+				})
+			}
+
+			if l.optsSpendDAGPersistence {
+				e.EvictionState.Events.SlotEvicted.Hook(l.evictRestoredSpenders)
+
+				// the spenders can only be restored once the committee is known, as their votes could otherwise
+				// immediately reach the acceptance threshold of an empty online committee
+				l.sybilProtection.HookInitialized(func() {
+					if err := l.restoreSpendDAG(e.Storage.Settings().LatestCommitment().Slot()); err != nil {
+						l.errorHandler(ierrors.Wrap(err, "failed to restore SpendDAG"))
+					}
+				})
+			}
+
 			l.manaManager = mana.NewManager(l.apiProvider, l.resolveAccountOutput, l.accountsLedger.Account)
 			latestCommittedSlot := e.Storage.Settings().LatestCommitment().Slot()
 			l.accountsLedger.SetLatestCommittedSlot(latestCommittedSlot)
@@ -97,21 +163,39 @@ func New(
 	commitmentLoader func(iotago.SlotIndex) (*model.Commitment, error),
 	blocksFunc func(id iotago.BlockID) (*blocks.Block, bool),
 	slotDiffFunc func(iotago.SlotIndex) (*slotstore.AccountDiffs, error),
+	accountsAggregatesFunc func(iotago.SlotIndex) (*slotstore.Store[iotago.SlotIndex, *model.AccountsAggregates], error),
+	spendersFunc func(iotago.SlotIndex) (*slotstore.Store[iotago.TransactionID, *model.Spender], error),
+	manaTracesFunc func(iotago.SlotIndex) (*slotstore.Store[iotago.TransactionID, *model.ManaTrace], error),
 	apiProvider iotago.APIProvider,
 	sybilProtection sybilprotection.SybilProtection,
 	errorHandler func(error),
+	opts ...options.Option[Ledger],
 ) *Ledger {
-	return &Ledger{
-		events:           ledger.NewEvents(),
-		apiProvider:      apiProvider,
-		accountsLedger:   accountsledger.New(apiProvider, blocksFunc, slotDiffFunc, accountsStore),
-		rmcManager:       rmc.NewManager(apiProvider, commitmentLoader),
-		utxoLedger:       utxoLedger,
-		commitmentLoader: commitmentLoader,
-		sybilProtection:  sybilProtection,
-		errorHandler:     errorHandler,
-		spendDAG:         spenddagv1.New[iotago.TransactionID, mempool.StateID, ledger.BlockVoteRank](sybilProtection.SeatManager().OnlineCommittee().Size),
-	}
+	return options.Apply(&Ledger{
+		events:             ledger.NewEvents(),
+		apiProvider:        apiProvider,
+		accountsLedger:     accountsledger.New(apiProvider, blocksFunc, slotDiffFunc, accountsAggregatesFunc, accountsStore),
+		rmcManager:         rmc.NewManager(apiProvider, commitmentLoader),
+		utxoLedger:         utxoLedger,
+		commitmentLoader:   commitmentLoader,
+		sybilProtection:    sybilProtection,
+		errorHandler:       errorHandler,
+		spendersFunc:       spendersFunc,
+		manaTracesFunc:     manaTracesFunc,
+		restoredSpenders:   ds.NewSet[iotago.TransactionID](),
+		warpSyncStateDiffs: newWarpSyncStateDiffs(),
+		optsMergeToMaster:  true,
+	}, opts, func(l *Ledger) {
+		l.spendDAG = l.newSpendDAG()
+	})
+}
+
+// newSpendDAG creates a new SpendDAG that uses the online committee of the sybil protection.
+func (l *Ledger) newSpendDAG() *spenddagv1.SpendDAG[iotago.TransactionID, mempool.StateID, ledger.BlockVoteRank] {
+	return spenddagv1.New[iotago.TransactionID, mempool.StateID, ledger.BlockVoteRank](
+		l.sybilProtection.SeatManager().OnlineCommittee().Size,
+		spenddagv1.WithMergeToMaster[iotago.TransactionID, mempool.StateID, ledger.BlockVoteRank](l.optsMergeToMaster),
+	)
 }
 
 func (l *Ledger) setRetainTransactionFailureFunc(retainTransactionFailure func(iotago.BlockID, error)) {
@@ -156,7 +240,7 @@ func (l *Ledger) CommitSlot(slot iotago.SlotIndex) (stateRoot iotago.Identifier,
 	// collect outputs and allotments from the "uncompacted" stateDiff
 	// outputs need to be processed in the "uncompacted" version of the state diff, as we need to be able to store
 	// and retrieve intermediate outputs to show to the user
-	spenders, outputs, accountDiffs, err := l.processStateDiffTransactions(stateDiff)
+	spenders, outputs, accountDiffs, manaTraces, err := l.processStateDiffTransactions(stateDiff)
 	if err != nil {
 		return iotago.Identifier{}, iotago.Identifier{}, iotago.Identifier{}, nil, nil, ierrors.Errorf("failed to process state diff transactions in slot %d: %w", slot, err)
 	}
@@ -178,6 +262,9 @@ func (l *Ledger) CommitSlot(slot iotago.SlotIndex) (stateRoot iotago.Identifier,
 		return iotago.Identifier{}, iotago.Identifier{}, iotago.Identifier{}, nil, nil, ierrors.Errorf("failed to apply diff to UTXO ledger for slot %d: %w", slot, err)
 	}
 
+	// Verify the changes against the state diff that was received while warp syncing the slot (if any)
+	l.checkWarpSyncStateDiff(slot, outputs, spenders)
+
 	// Update the Accounts ledger
 	// first, get the RMC corresponding to this slot
 	protocolParams := l.apiProvider.APIForSlot(slot).ProtocolParameters()
@@ -198,12 +285,24 @@ func (l *Ledger) CommitSlot(slot iotago.SlotIndex) (stateRoot iotago.Identifier,
 		return iotago.Identifier{}, iotago.Identifier{}, iotago.Identifier{}, nil, nil, ierrors.Errorf("failed to apply diff to mana manager for slot %d: %w", slot, err)
 	}
 
+	if err = l.storeManaTraces(slot, manaTraces); err != nil {
+		return iotago.Identifier{}, iotago.Identifier{}, iotago.Identifier{}, nil, nil, ierrors.Errorf("failed to store mana traces for slot %d: %w", slot, err)
+	}
+
 	// Mark each transaction as committed so the mempool can evict it
 	stateDiff.ExecutedTransactions().ForEach(func(_ iotago.TransactionID, tx mempool.TransactionMetadata) bool {
 		tx.Commit()
 		return true
 	})
 
+	// the persisted SpendDAG is only an optional aid to recover from a crash, so failing to write it must not prevent
+	// the slot from being committed.
+	if l.optsSpendDAGPersistence {
+		if err = l.persistSpendDAG(slot); err != nil {
+			l.errorHandler(ierrors.Wrapf(err, "failed to persist SpendDAG for slot %d", slot))
+		}
+	}
+
 	return l.utxoLedger.StateTreeRoot(), stateDiff.Mutations().Root(), l.accountsLedger.AccountsTreeRoot(), outputs, spenders, nil
 }
 
@@ -231,6 +330,12 @@ func (l *Ledger) Account(accountID iotago.AccountID, targetIndex iotago.SlotInde
 	return l.accountsLedger.Account(accountID, targetIndex)
 }
 
+// AccountOutputID returns the ID of the output of the account at the given slot without resolving the full account data
+// if the slot is the latest committed slot.
+func (l *Ledger) AccountOutputID(accountID iotago.AccountID, targetSlot iotago.SlotIndex) (outputID iotago.OutputID, exists bool, err error) {
+	return l.accountsLedger.AccountOutputID(accountID, targetSlot)
+}
+
 func (l *Ledger) PastAccounts(accountIDs iotago.AccountIDs, targetIndex iotago.SlotIndex) (accountDataMap map[iotago.AccountID]*accounts.AccountData, err error) {
 	return l.accountsLedger.PastAccounts(accountIDs, targetIndex)
 }
@@ -339,6 +444,16 @@ func (l *Ledger) Export(writer io.WriteSeeker, targetIndex iotago.SlotIndex) err
 	return nil
 }
 
+// AccountsCacheMetrics returns the hit and miss statistics of the accounts cache (nil if the cache is disabled).
+func (l *Ledger) AccountsCacheMetrics() *ledger.AccountsCacheMetrics {
+	return l.accountsLedger.CacheMetrics()
+}
+
+// AccountsAggregates returns the aggregated statistics of the accounts ledger at the given committed slot.
+func (l *Ledger) AccountsAggregates(slot iotago.SlotIndex) (*model.AccountsAggregates, error) {
+	return l.accountsLedger.Aggregates(slot)
+}
+
 func (l *Ledger) ManaManager() *mana.Manager {
 	return l.manaManager
 }
@@ -351,6 +466,7 @@ func (l *Ledger) RMCManager() *rmc.Manager {
 func (l *Ledger) Reset() {
 	l.memPool.Reset()
 	l.accountsLedger.Reset()
+	l.warpSyncStateDiffs.Clear()
 }
 
 func (l *Ledger) Shutdown() {
@@ -403,27 +519,13 @@ func (l *Ledger) prepareAccountDiffs(accountDiffs map[iotago.AccountID]*model.Ac
 		accountDiff.NewOutputID = createdOutput.OutputID()
 		accountDiff.NewExpirySlot = createdOutput.Output().FeatureSet().BlockIssuer().ExpirySlot
 
-		oldPubKeysSet := accountData.BlockIssuerKeys
-		newPubKeysSet := iotago.NewBlockIssuerKeys()
-		for _, blockIssuerKey := range createdOutput.Output().FeatureSet().BlockIssuer().BlockIssuerKeys {
-			k := blockIssuerKey
-			newPubKeysSet.Add(k)
+		if err := validateBlockIssuerKeys(createdOutput.Output().FeatureSet().BlockIssuer().BlockIssuerKeys); err != nil {
+			l.blockIssuerKeysInvalid(consumedAccountID, ierrors.Wrapf(err, "invalid block issuer keys of transitioned account %s", consumedAccountID))
 		}
 
-		// Add public keys that are not in the old set
-		accountDiff.BlockIssuerKeysAdded = iotago.NewBlockIssuerKeys()
-		for _, newKey := range newPubKeysSet {
-			if !oldPubKeysSet.Has(newKey) {
-				accountDiff.BlockIssuerKeysAdded.Add(newKey)
-			}
-		}
-
-		// Remove the keys that are not in the new set
-		accountDiff.BlockIssuerKeysRemoved = iotago.NewBlockIssuerKeys()
-		for _, oldKey := range oldPubKeysSet {
-			if !newPubKeysSet.Has(oldKey) {
-				accountDiff.BlockIssuerKeysRemoved.Add(oldKey)
-			}
+		accountDiff.BlockIssuerKeysAdded, accountDiff.BlockIssuerKeysRemoved = blockIssuerKeysDiff(accountData.BlockIssuerKeys, createdOutput.Output().FeatureSet().BlockIssuer().BlockIssuerKeys)
+		if err := validateBlockIssuerKeysDiff(accountData.BlockIssuerKeys, accountDiff.BlockIssuerKeysAdded, accountDiff.BlockIssuerKeysRemoved); err != nil {
+			l.blockIssuerKeysInvalid(consumedAccountID, ierrors.Wrapf(err, "invalid block issuer keys diff of transitioned account %s", consumedAccountID))
 		}
 
 		if stakingFeature := createdOutput.Output().FeatureSet().Staking(); stakingFeature != nil {
@@ -458,6 +560,10 @@ func (l *Ledger) prepareAccountDiffs(accountDiffs map[iotago.AccountID]*model.Ac
 		switch createdOutput.Output().Type() {
 		// for account outputs, get block issuer keys from the block issuer feature, and check for staking info.
 		case iotago.OutputAccount:
+			if err := validateBlockIssuerKeys(createdOutput.Output().FeatureSet().BlockIssuer().BlockIssuerKeys); err != nil {
+				l.blockIssuerKeysInvalid(createdAccountID, ierrors.Wrapf(err, "invalid block issuer keys of created account %s", createdAccountID))
+			}
+
 			accountDiff.BlockIssuerKeysAdded = createdOutput.Output().FeatureSet().BlockIssuer().BlockIssuerKeys
 			accountDiff.NewExpirySlot = createdOutput.Output().FeatureSet().BlockIssuer().ExpirySlot
 			if stakingFeature := createdOutput.Output().FeatureSet().Staking(); stakingFeature != nil {
@@ -475,6 +581,19 @@ func (l *Ledger) prepareAccountDiffs(accountDiffs map[iotago.AccountID]*model.Ac
 	}
 }
 
+// blockIssuerKeysInvalid triggers the event that corresponds to the given block issuer keys error of the given account.
+//
+// The transactions of the slot were already accepted and the VM rejects transactions that create invalid block issuer
+// keys, so an error at this point means that the accounts ledger is inconsistent with the UTXO ledger, which we report
+// without preventing the slot from being committed.
+func (l *Ledger) blockIssuerKeysInvalid(accountID iotago.AccountID, err error) {
+	if ierrors.Is(err, ledger.ErrBlockIssuerKeysCountInvalid) {
+		l.events.BlockIssuerKeysCountInvalid.Trigger(accountID, err)
+	} else {
+		l.events.BlockIssuerKeysDiffInvalid.Trigger(accountID, err)
+	}
+}
+
 func (l *Ledger) processCreatedAndConsumedAccountOutputs(stateDiff mempool.StateDiff, accountDiffs map[iotago.AccountID]*model.AccountDiff) (createdAccounts map[iotago.AccountID]*utxoledger.Output, consumedAccounts map[iotago.AccountID]*utxoledger.Output, destroyedAccounts ds.Set[iotago.AccountID], err error) {
 	createdAccounts = make(map[iotago.AccountID]*utxoledger.Output)
 	consumedAccounts = make(map[iotago.AccountID]*utxoledger.Output)
@@ -594,8 +713,9 @@ func (l *Ledger) processCreatedAndConsumedAccountOutputs(stateDiff mempool.State
 	return createdAccounts, consumedAccounts, destroyedAccounts, nil
 }
 
-func (l *Ledger) processStateDiffTransactions(stateDiff mempool.StateDiff) (spents utxoledger.Spents, outputs utxoledger.Outputs, accountDiffs map[iotago.AccountID]*model.AccountDiff, err error) {
+func (l *Ledger) processStateDiffTransactions(stateDiff mempool.StateDiff) (spents utxoledger.Spents, outputs utxoledger.Outputs, accountDiffs map[iotago.AccountID]*model.AccountDiff, manaTraces map[iotago.TransactionID]*model.ManaTrace, err error) {
 	accountDiffs = make(map[iotago.AccountID]*model.AccountDiff)
+	manaTraces = make(map[iotago.TransactionID]*model.ManaTrace)
 
 	stateDiff.ExecutedTransactions().ForEach(func(txID iotago.TransactionID, txWithMeta mempool.TransactionMetadata) bool {
 		tx, ok := txWithMeta.Transaction().(*iotago.Transaction)
@@ -640,8 +760,16 @@ func (l *Ledger) processStateDiffTransactions(stateDiff mempool.StateDiff) (spen
 			}
 		}
 
+		if err = validateStakingTransitions(tx, txWithMeta); err != nil {
+			err = ierrors.Wrapf(err, "failed to validate staking transitions of %s", txID)
+			return false
+		}
+
 		// process allotments
 		{
+			manaTrace := &model.ManaTrace{Allotments: make([]*model.AllotmentTrace, 0, len(tx.Allotments))}
+			manaTraces[txID] = manaTrace
+
 			for _, allotment := range tx.Allotments {
 				// in case it didn't exist, allotments won't change the outputID of the Account,
 				// so the diff defaults to empty new and previous outputIDs
@@ -651,6 +779,12 @@ func (l *Ledger) processStateDiffTransactions(stateDiff mempool.StateDiff) (spen
 				if accountErr != nil {
 					panic(ierrors.Errorf("error loading account %s in slot %d: %w", allotment.AccountID, stateDiff.Slot()-1, accountErr))
 				}
+				manaTrace.Allotments = append(manaTrace.Allotments, &model.AllotmentTrace{
+					AccountID: allotment.AccountID,
+					Mana:      allotment.Mana,
+					Burned:    !exists,
+				})
+
 				// if the account does not exist in our AccountsLedger it means it doesn't have a BIC feature, so
 				// we burn this allotment.
 				if !exists {
@@ -673,11 +807,46 @@ func (l *Ledger) processStateDiffTransactions(stateDiff mempool.StateDiff) (spen
 		return true
 	})
 
-	return spents, outputs, accountDiffs, nil
+	// the executed transactions are iterated in an arbitrary order, so we sort the collected changes to make sure that
+	// all nodes apply them (and derive the stored slot diffs and roots) in the same order
+	outputs.Sort()
+	spents.Sort()
+
+	return spents, outputs, accountDiffs, manaTraces, nil
+}
+
+// storeManaTraces persists the mana traces of the transactions that were committed in the given slot.
+func (l *Ledger) storeManaTraces(slot iotago.SlotIndex, manaTraces map[iotago.TransactionID]*model.ManaTrace) error {
+	if len(manaTraces) == 0 {
+		return nil
+	}
+
+	manaTracesStore, err := l.manaTracesFunc(slot)
+	if err != nil {
+		return ierrors.Wrapf(err, "failed to get mana traces store of slot %d", slot)
+	}
+
+	for transactionID, manaTrace := range manaTraces {
+		if err = manaTracesStore.Store(transactionID, manaTrace); err != nil {
+			return ierrors.Wrapf(err, "failed to store mana trace of transaction %s", transactionID)
+		}
+	}
+
+	return nil
+}
+
+// ManaTrace returns the mana trace of the given transaction that was committed in the given slot.
+func (l *Ledger) ManaTrace(transactionID iotago.TransactionID, slot iotago.SlotIndex) (manaTrace *model.ManaTrace, exists bool, err error) {
+	manaTracesStore, err := l.manaTracesFunc(slot)
+	if err != nil {
+		return nil, false, ierrors.Wrapf(err, "failed to get mana traces store of slot %d", slot)
+	}
+
+	return manaTracesStore.Load(transactionID)
 }
 
 func (l *Ledger) resolveAccountOutput(accountID iotago.AccountID, slot iotago.SlotIndex) (*utxoledger.Output, error) {
-	accountMetadata, exists, err := l.accountsLedger.Account(accountID, slot)
+	accountOutputID, exists, err := l.accountsLedger.AccountOutputID(accountID, slot)
 	if err != nil {
 		return nil, ierrors.Errorf("could not get account information for account %s in slot %d: %w", accountID, slot, err)
 	}
@@ -688,17 +857,17 @@ func (l *Ledger) resolveAccountOutput(accountID iotago.AccountID, slot iotago.Sl
 	l.utxoLedger.ReadLockLedger()
 	defer l.utxoLedger.ReadUnlockLedger()
 
-	isUnspent, err := l.utxoLedger.IsOutputIDUnspentWithoutLocking(accountMetadata.OutputID)
+	isUnspent, err := l.utxoLedger.IsOutputIDUnspentWithoutLocking(accountOutputID)
 	if err != nil {
-		return nil, ierrors.Errorf("error while checking account output %s is unspent: %w", accountMetadata.OutputID, err)
+		return nil, ierrors.Errorf("error while checking account output %s is unspent: %w", accountOutputID, err)
 	}
 	if !isUnspent {
-		return nil, ierrors.Errorf("unspent account output %s not found: %w", accountMetadata.OutputID, mempool.ErrStateNotFound)
+		return nil, ierrors.Errorf("unspent account output %s not found: %w", accountOutputID, mempool.ErrStateNotFound)
 	}
 
-	accountOutput, err := l.utxoLedger.ReadOutputByOutputIDWithoutLocking(accountMetadata.OutputID)
+	accountOutput, err := l.utxoLedger.ReadOutputByOutputIDWithoutLocking(accountOutputID)
 	if err != nil {
-		return nil, ierrors.Errorf("error while retrieving account output %s: %w", accountMetadata.OutputID, err)
+		return nil, ierrors.Errorf("error while retrieving account output %s: %w", accountOutputID, err)
 	}
 
 	return accountOutput, nil
@@ -789,6 +958,27 @@ func (l *Ledger) loadCommitment(inputCommitmentID iotago.CommitmentID) (*iotago.
 	return c.Commitment(), nil
 }
 
+// signedTransactionBytes serializes the given signed transaction for the write-ahead log of the MemPool.
+func (l *Ledger) signedTransactionBytes(signedTransaction mempool.SignedTransaction) ([]byte, error) {
+	iotaSignedTransaction, isSignedTransaction := signedTransaction.(*iotago.SignedTransaction)
+	if !isSignedTransaction {
+		return nil, ierrors.Errorf("unsupported signed transaction type %T", signedTransaction)
+	}
+
+	return iotaSignedTransaction.API.Encode(iotaSignedTransaction)
+}
+
+// signedTransactionFromBytes deserializes a signed transaction from the write-ahead log of the MemPool with the API of
+// the slot of the block that attached it.
+func (l *Ledger) signedTransactionFromBytes(blockID iotago.BlockID, bytes []byte) (mempool.SignedTransaction, mempool.Transaction, error) {
+	signedTransaction := new(iotago.SignedTransaction)
+	if _, err := l.apiProvider.APIForSlot(blockID.Slot()).Decode(bytes, signedTransaction); err != nil {
+		return nil, nil, ierrors.Wrap(err, "failed to decode signed transaction")
+	}
+
+	return signedTransaction, signedTransaction.Transaction, nil
+}
+
 func getAccountDiff(accountDiffs map[iotago.AccountID]*model.AccountDiff, accountID iotago.AccountID) *model.AccountDiff {
 	accountDiff, exists := accountDiffs[accountID]
 	if !exists {
@@ -799,3 +989,27 @@ func getAccountDiff(accountDiffs map[iotago.AccountID]*model.AccountDiff, accoun
 
 	return accountDiff
 }
+
+// WithSpendDAGPersistence defines whether the pending spenders of the SpendDAG are persisted with every commitment and
+// restored on startup.
+func WithSpendDAGPersistence(spendDAGPersistence bool) options.Option[Ledger] {
+	return func(l *Ledger) {
+		l.optsSpendDAGPersistence = spendDAGPersistence
+	}
+}
+
+// WithMemPoolWriteAheadLog defines whether the attached transactions of the MemPool are recorded in a write-ahead log
+// and replayed on startup, so that in-flight payloads are not lost when the node restarts.
+func WithMemPoolWriteAheadLog(memPoolWriteAheadLog bool) options.Option[Ledger] {
+	return func(l *Ledger) {
+		l.optsMemPoolWriteAheadLog = memPoolWriteAheadLog
+	}
+}
+
+// WithMergeToMaster defines whether accepted spenders are removed from the spenders that blocks and transactions inherit
+// (merge to master).
+func WithMergeToMaster(mergeToMaster bool) options.Option[Ledger] {
+	return func(l *Ledger) {
+		l.optsMergeToMaster = mergeToMaster
+	}
+}
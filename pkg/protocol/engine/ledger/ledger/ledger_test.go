@@ -0,0 +1,174 @@
+package ledger
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/ds/shrinkingmap"
+	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/ledger"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/mempool"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/utxoledger"
+	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/tpkg"
+)
+
+// testStateDiff is a mempool.StateDiff that contains the compacted state changes of a slot.
+type testStateDiff struct {
+	mempool.StateDiff
+
+	createdStates   *shrinkingmap.ShrinkingMap[mempool.StateID, mempool.StateMetadata]
+	destroyedStates *shrinkingmap.ShrinkingMap[mempool.StateID, mempool.StateMetadata]
+}
+
+func newTestStateDiff(createdOutputs []*utxoledger.Output, destroyedOutputs []*utxoledger.Output) *testStateDiff {
+	toStates := func(outputs []*utxoledger.Output) *shrinkingmap.ShrinkingMap[mempool.StateID, mempool.StateMetadata] {
+		states := shrinkingmap.New[mempool.StateID, mempool.StateMetadata]()
+		for _, output := range outputs {
+			states.Set(output.StateID(), &testStateMetadata{state: output})
+		}
+
+		return states
+	}
+
+	return &testStateDiff{
+		createdStates:   toStates(createdOutputs),
+		destroyedStates: toStates(destroyedOutputs),
+	}
+}
+
+func (t *testStateDiff) CreatedStates() *shrinkingmap.ShrinkingMap[mempool.StateID, mempool.StateMetadata] {
+	return t.createdStates
+}
+
+func (t *testStateDiff) DestroyedStates() *shrinkingmap.ShrinkingMap[mempool.StateID, mempool.StateMetadata] {
+	return t.destroyedStates
+}
+
+// testStateMetadata is a mempool.StateMetadata that only exposes its state.
+type testStateMetadata struct {
+	mempool.StateMetadata
+
+	state mempool.State
+}
+
+func (t *testStateMetadata) State() mempool.State {
+	return t.state
+}
+
+func TestLedger_ProcessCreatedAndConsumedAccountOutputs(t *testing.T) {
+	apiProvider := iotago.SingleVersionProvider(tpkg.ZeroCostTestAPI)
+	unbondingPeriod := tpkg.ZeroCostTestAPI.ProtocolParameters().StakingUnbondingPeriod()
+
+	epoch := iotago.EpochIndex(10)
+	slot := tpkg.ZeroCostTestAPI.TimeProvider().EpochStart(epoch)
+
+	newOutput := func(txID iotago.TransactionID, output iotago.Output) *utxoledger.Output {
+		return utxoledger.NewOutput(apiProvider, iotago.OutputIDFromTransactionIDAndIndex(txID, 0), tpkg.RandBlockID(), slot, output, nil, nil, nil)
+	}
+
+	accountOutput := func(accountID iotago.AccountID, stakingFeature *iotago.StakingFeature) *iotago.AccountOutput {
+		features := iotago.AccountOutputFeatures{&iotago.BlockIssuerFeature{ExpirySlot: iotago.MaxSlotIndex}}
+		if stakingFeature != nil {
+			features = append(features, stakingFeature)
+		}
+
+		return &iotago.AccountOutput{AccountID: accountID, Features: features}
+	}
+
+	delegationOutput := func(validatorID iotago.AccountID, delegatedAmount iotago.BaseToken) *iotago.DelegationOutput {
+		validatorAddress := iotago.AccountAddress(validatorID)
+
+		return &iotago.DelegationOutput{DelegatedAmount: delegatedAmount, ValidatorAddress: &validatorAddress, StartEpoch: epoch + 1}
+	}
+
+	// the account was created in a previous slot, so the consumed output does not contain the account ID yet.
+	createdAccount := newOutput(tpkg.RandTransactionID(), accountOutput(iotago.EmptyAccountID, nil))
+	accountID := iotago.AccountIDFromOutputID(createdAccount.OutputID())
+
+	stakingFeature := &iotago.StakingFeature{StakedAmount: 100, FixedCost: 10, StartEpoch: epoch, EndEpoch: epoch + unbondingPeriod}
+
+	for _, test := range []struct {
+		name                          string
+		createdOutputs                []*utxoledger.Output
+		destroyedOutputs              []*utxoledger.Output
+		expectedCreatedAccount        bool
+		expectedConsumedAccount       bool
+		expectedDestroyedAccount      bool
+		expectedDelegationStakeChange int64
+	}{
+		{
+			// the outputs of the intermediate transitions are compacted away, so only the net transition is processed.
+			name:                    "account transitioned twice",
+			createdOutputs:          []*utxoledger.Output{newOutput(tpkg.RandTransactionID(), accountOutput(accountID, stakingFeature))},
+			destroyedOutputs:        []*utxoledger.Output{createdAccount},
+			expectedCreatedAccount:  true,
+			expectedConsumedAccount: true,
+		},
+		{
+			name:                    "staking feature added and removed",
+			createdOutputs:          []*utxoledger.Output{newOutput(tpkg.RandTransactionID(), accountOutput(accountID, nil))},
+			destroyedOutputs:        []*utxoledger.Output{createdAccount},
+			expectedCreatedAccount:  true,
+			expectedConsumedAccount: true,
+		},
+		{
+			name:                    "bonded staking feature removed",
+			createdOutputs:          []*utxoledger.Output{newOutput(tpkg.RandTransactionID(), accountOutput(accountID, nil))},
+			destroyedOutputs:        []*utxoledger.Output{newOutput(tpkg.RandTransactionID(), accountOutput(accountID, stakingFeature))},
+			expectedCreatedAccount:  true,
+			expectedConsumedAccount: true,
+		},
+		{
+			name:                     "account destroyed",
+			destroyedOutputs:         []*utxoledger.Output{createdAccount},
+			expectedConsumedAccount:  true,
+			expectedDestroyedAccount: true,
+		},
+		{
+			name:                          "delegation created",
+			createdOutputs:                []*utxoledger.Output{newOutput(tpkg.RandTransactionID(), delegationOutput(accountID, 50))},
+			expectedDelegationStakeChange: 50,
+		},
+		{
+			name:                          "delegation destroyed",
+			destroyedOutputs:              []*utxoledger.Output{newOutput(tpkg.RandTransactionID(), delegationOutput(accountID, 50))},
+			expectedDelegationStakeChange: -50,
+		},
+		{
+			// a delegation output that is created and destroyed in the same slot is compacted away, and a delegation
+			// that is destroyed while another one is created cancels out.
+			name:                          "delegation created and destroyed",
+			createdOutputs:                []*utxoledger.Output{newOutput(tpkg.RandTransactionID(), delegationOutput(accountID, 50))},
+			destroyedOutputs:              []*utxoledger.Output{newOutput(tpkg.RandTransactionID(), delegationOutput(accountID, 50))},
+			expectedDelegationStakeChange: 0,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			l := &Ledger{apiProvider: apiProvider, events: ledger.NewEvents()}
+
+			accountDiffs := make(map[iotago.AccountID]*model.AccountDiff)
+			createdAccounts, consumedAccounts, destroyedAccounts, err := l.processCreatedAndConsumedAccountOutputs(newTestStateDiff(test.createdOutputs, test.destroyedOutputs), accountDiffs)
+			require.NoError(t, err)
+
+			createdOutput, created := createdAccounts[accountID]
+			require.Equal(t, test.expectedCreatedAccount, created)
+
+			consumedOutput, consumed := consumedAccounts[accountID]
+			require.Equal(t, test.expectedConsumedAccount, consumed)
+			require.Equal(t, test.expectedDestroyedAccount, destroyedAccounts.Has(accountID))
+
+			if created && consumed {
+				require.Equal(t, test.destroyedOutputs[0].OutputID(), consumedOutput.OutputID())
+				require.Equal(t, test.createdOutputs[0].OutputID(), createdOutput.OutputID())
+			}
+
+			if accountDiff, exists := accountDiffs[accountID]; exists {
+				require.Equal(t, test.expectedDelegationStakeChange, accountDiff.DelegationStakeChange)
+			} else {
+				require.Zero(t, test.expectedDelegationStakeChange)
+			}
+		})
+	}
+}
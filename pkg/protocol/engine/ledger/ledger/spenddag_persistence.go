@@ -0,0 +1,110 @@
+package ledger
+
+import (
+	"github.com/iotaledger/hive.go/ds"
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/iota-core/pkg/core/vote"
+	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/ledger"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// persistSpendDAG stores the topology and the supporting votes of all pending spenders in the storage of the given slot.
+func (l *Ledger) persistSpendDAG(slot iotago.SlotIndex) error {
+	spenders, err := l.spendersFunc(slot)
+	if err != nil {
+		return ierrors.Wrapf(err, "failed to get spenders storage for slot %d", slot)
+	}
+
+	return l.spendDAG.PendingSpenders().ForEach(func(spenderID iotago.TransactionID) error {
+		spentResources, exists := l.spendDAG.SpendSets(spenderID)
+		if !exists {
+			// the spender was evicted in the meantime
+			return nil
+		}
+
+		parents, exists := l.spendDAG.SpenderParents(spenderID)
+		if !exists {
+			return nil
+		}
+
+		if err := spenders.Store(spenderID, &model.Spender{
+			SpentResources: spentResources.ToSlice(),
+			Parents:        parents.ToSlice(),
+			Votes: lo.Map(l.spendDAG.SpenderSupportingVotes(spenderID), func(supportingVote *vote.Vote[ledger.BlockVoteRank]) *model.SpenderVote {
+				return &model.SpenderVote{
+					Seat:        supportingVote.Voter,
+					BlockID:     supportingVote.Rank.BlockID(),
+					IssuingTime: supportingVote.Rank.Time(),
+				}
+			}),
+		}); err != nil {
+			return ierrors.Wrapf(err, "failed to store spender %s", spenderID)
+		}
+
+		return nil
+	})
+}
+
+// restoreSpendDAG restores the spenders that were persisted with the commitment of the given slot.
+func (l *Ledger) restoreSpendDAG(slot iotago.SlotIndex) error {
+	spenders, err := l.spendersFunc(slot)
+	if err != nil {
+		return ierrors.Wrapf(err, "failed to get spenders storage for slot %d", slot)
+	}
+
+	restoredSpenders := make(map[iotago.TransactionID]*model.Spender)
+	if err := spenders.Stream(func(spenderID iotago.TransactionID, spender *model.Spender) error {
+		restoredSpenders[spenderID] = spender
+
+		return nil
+	}); err != nil {
+		return ierrors.Wrapf(err, "failed to stream spenders of slot %d", slot)
+	}
+
+	// the spenders need to exist before their relations and votes can be restored
+	for spenderID, spender := range restoredSpenders {
+		l.spendDAG.CreateSpender(spenderID)
+
+		if err := l.spendDAG.UpdateSpentResources(spenderID, ds.NewSet(spender.SpentResources...)); err != nil {
+			return ierrors.Wrapf(err, "failed to restore spent resources of spender %s", spenderID)
+		}
+	}
+
+	for spenderID, spender := range restoredSpenders {
+		if err := l.spendDAG.UpdateSpenderParents(spenderID, ds.NewSet(spender.Parents...), ds.NewSet[iotago.TransactionID]()); err != nil {
+			return ierrors.Wrapf(err, "failed to restore parents of spender %s", spenderID)
+		}
+	}
+
+	for spenderID, spender := range restoredSpenders {
+		for _, spenderVote := range spender.Votes {
+			if err := l.spendDAG.CastVotes(vote.NewVote(spenderVote.Seat, ledger.NewBlockVoteRank(spenderVote.BlockID, spenderVote.IssuingTime)), ds.NewSet(spenderID)); err != nil {
+				return ierrors.Wrapf(err, "failed to restore vote of seat %d for spender %s", spenderVote.Seat, spenderID)
+			}
+		}
+
+		l.restoredSpenders.Add(spenderID)
+	}
+
+	l.restoredSpendersEvictionSlot = slot + l.apiProvider.APIForSlot(slot).ProtocolParameters().MaxCommittableAge()
+
+	return nil
+}
+
+// evictRestoredSpenders evicts the restored spenders whose transactions were not attached again once they would have
+// been evicted by the MemPool.
+func (l *Ledger) evictRestoredSpenders(slot iotago.SlotIndex) {
+	if slot < l.restoredSpendersEvictionSlot || l.restoredSpenders.IsEmpty() {
+		return
+	}
+
+	l.restoredSpenders.Range(func(spenderID iotago.TransactionID) {
+		if _, exists := l.memPool.TransactionMetadata(spenderID); !exists {
+			l.spendDAG.EvictSpender(spenderID)
+		}
+	})
+
+	l.restoredSpenders.Clear()
+}
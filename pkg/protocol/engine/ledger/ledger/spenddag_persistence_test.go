@@ -0,0 +1,78 @@
+package ledger
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/ds"
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/iotaledger/iota-core/pkg/core/account"
+	"github.com/iotaledger/iota-core/pkg/core/vote"
+	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/ledger"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/mempool"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/mempool/spenddag/spenddagv1"
+	"github.com/iotaledger/iota-core/pkg/storage/prunable/slotstore"
+	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/tpkg"
+)
+
+func TestLedger_SpendDAGPersistence(t *testing.T) {
+	spenders := slotstore.NewStore(1, mapdb.NewMapDB(),
+		iotago.TransactionID.Bytes,
+		iotago.TransactionIDFromBytes,
+		(*model.Spender).Bytes,
+		model.SpenderFromBytes,
+	)
+
+	newLedger := func() *Ledger {
+		return &Ledger{
+			apiProvider: iotago.SingleVersionProvider(tpkg.ZeroCostTestAPI),
+			spendDAG: spenddagv1.New[iotago.TransactionID, mempool.StateID, ledger.BlockVoteRank](func() int {
+				return 3
+			}),
+			spendersFunc: func(iotago.SlotIndex) (*slotstore.Store[iotago.TransactionID, *model.Spender], error) {
+				return spenders, nil
+			},
+			restoredSpenders: ds.NewSet[iotago.TransactionID](),
+		}
+	}
+
+	tx1, tx2, tx3 := tpkg.RandTransactionID(), tpkg.RandTransactionID(), tpkg.RandTransactionID()
+	resource1, resource2 := tpkg.RandIdentifier(), tpkg.RandIdentifier()
+
+	persistedLedger := newLedger()
+	for spenderID, resourceID := range map[iotago.TransactionID]iotago.Identifier{tx1: resource1, tx2: resource1, tx3: resource2} {
+		persistedLedger.spendDAG.CreateSpender(spenderID)
+		require.NoError(t, persistedLedger.spendDAG.UpdateSpentResources(spenderID, ds.NewSet(resourceID)))
+	}
+	require.NoError(t, persistedLedger.spendDAG.UpdateSpenderParents(tx3, ds.NewSet(tx1), ds.NewSet[iotago.TransactionID]()))
+
+	issuingTime := time.Unix(1000, 0)
+	require.NoError(t, persistedLedger.spendDAG.CastVotes(vote.NewVote(account.SeatIndex(0), ledger.NewBlockVoteRank(tpkg.RandBlockID(), issuingTime)), ds.NewSet(tx3)))
+	require.NoError(t, persistedLedger.spendDAG.CastVotes(vote.NewVote(account.SeatIndex(1), ledger.NewBlockVoteRank(tpkg.RandBlockID(), issuingTime)), ds.NewSet(tx2)))
+
+	require.NoError(t, persistedLedger.persistSpendDAG(1))
+
+	restoredLedger := newLedger()
+	require.NoError(t, restoredLedger.restoreSpendDAG(1))
+
+	for _, spenderID := range []iotago.TransactionID{tx1, tx2, tx3} {
+		expectedSpendSets, _ := persistedLedger.spendDAG.SpendSets(spenderID)
+		spendSets, exists := restoredLedger.spendDAG.SpendSets(spenderID)
+		require.True(t, exists)
+		require.True(t, expectedSpendSets.Equals(spendSets))
+
+		expectedParents, _ := persistedLedger.spendDAG.SpenderParents(spenderID)
+		parents, _ := restoredLedger.spendDAG.SpenderParents(spenderID)
+		require.True(t, expectedParents.Equals(parents))
+
+		require.True(t, persistedLedger.spendDAG.SpenderVoters(spenderID).Equals(restoredLedger.spendDAG.SpenderVoters(spenderID)))
+		require.Equal(t, persistedLedger.spendDAG.SpenderWeight(spenderID), restoredLedger.spendDAG.SpenderWeight(spenderID))
+		require.True(t, restoredLedger.restoredSpenders.Has(spenderID))
+	}
+
+	require.True(t, restoredLedger.spendDAG.PendingSpenders().Equals(ds.NewSet(tx1, tx2, tx3)))
+}
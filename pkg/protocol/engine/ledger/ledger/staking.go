@@ -0,0 +1,159 @@
+package ledger
+
+import (
+	"github.com/iotaledger/hive.go/ds"
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/mempool"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/utxoledger"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// validateStakingTransitions checks the staking feature transitions of all accounts that are consumed or created by the
+// given transaction.
+//
+// The account diffs are derived from the compacted state diff of the slot, so we verify every step of the transitions
+// again before we apply them to the accounts ledger. The bounds are derived from the commitment input of the
+// transaction exactly like the VM derives them, so a transaction that was accepted by the VM is never rejected here.
+func validateStakingTransitions(tx *iotago.Transaction, txWithMeta mempool.TransactionMetadata) error {
+	consumedAccounts := make(map[iotago.AccountID]*iotago.AccountOutput)
+	consumedAccountIDs := make(map[iotago.OutputID]iotago.AccountID)
+	txWithMeta.Inputs().Range(func(stateMetadata mempool.StateMetadata) {
+		if consumedOutput, isOutput := stateMetadata.State().(*utxoledger.Output); isOutput {
+			if consumedAccount, isAccount := consumedOutput.Output().(*iotago.AccountOutput); isAccount {
+				accountID := consumedAccount.AccountID
+				if accountID.Empty() {
+					accountID = iotago.AccountIDFromOutputID(consumedOutput.OutputID())
+				}
+
+				consumedAccounts[accountID] = consumedAccount
+				consumedAccountIDs[consumedOutput.OutputID()] = accountID
+			}
+		}
+	})
+
+	claimingAccounts := ds.NewSet[iotago.AccountID]()
+	rewardInputs, err := tx.RewardInputs()
+	if err != nil {
+		return ierrors.Wrap(err, "failed to retrieve reward inputs")
+	}
+	for _, rewardInput := range rewardInputs {
+		if int(rewardInput.Index) >= len(tx.TransactionEssence.Inputs) {
+			continue
+		}
+
+		if utxoInput, isUTXOInput := tx.TransactionEssence.Inputs[rewardInput.Index].(*iotago.UTXOInput); isUTXOInput {
+			if accountID, isAccount := consumedAccountIDs[utxoInput.OutputID()]; isAccount {
+				claimingAccounts.Add(accountID)
+			}
+		}
+	}
+
+	return txWithMeta.Outputs().ForEach(func(stateMetadata mempool.StateMetadata) error {
+		createdOutput, isOutput := stateMetadata.State().(*utxoledger.Output)
+		if !isOutput {
+			return nil
+		}
+
+		createdAccount, isAccount := createdOutput.Output().(*iotago.AccountOutput)
+		if !isAccount {
+			return nil
+		}
+
+		accountID := createdAccount.AccountID
+		if accountID.Empty() {
+			accountID = iotago.AccountIDFromOutputID(createdOutput.OutputID())
+		}
+
+		var consumedAccount iotago.Output
+		if consumedAccountOutput, exists := consumedAccounts[accountID]; exists {
+			consumedAccount = consumedAccountOutput
+		}
+
+		if err := validateStakingTransition(tx.API, tx.CommitmentInput(), claimingAccounts.Has(accountID), consumedAccount, createdAccount); err != nil {
+			return ierrors.Wrapf(err, "invalid staking transition of account %s", accountID)
+		}
+
+		return nil
+	})
+}
+
+// validateStakingTransition checks that the staking feature of an account was transitioned according to the rules of
+// the VM from the consumed output (which is nil if the account was created) to the created output by a transaction
+// with the given commitment input (which is nil if the transaction has none) that does or does not claim the rewards of
+// the account.
+func validateStakingTransition(apiForTransaction iotago.API, commitmentInput *iotago.CommitmentInput, isClaiming bool, consumedOutput iotago.Output, createdOutput iotago.Output) error {
+	var currentStakingFeature *iotago.StakingFeature
+	if consumedOutput != nil {
+		currentStakingFeature = consumedOutput.FeatureSet().Staking()
+	}
+	nextStakingFeature := createdOutput.FeatureSet().Staking()
+
+	if currentStakingFeature == nil && nextStakingFeature == nil {
+		return nil
+	}
+
+	if commitmentInput == nil {
+		return ierrors.Join(iotago.ErrInvalidStakingTransition, iotago.ErrInvalidStakingCommitmentInput)
+	}
+
+	protocolParameters := apiForTransaction.ProtocolParameters()
+	pastBoundedEpoch := apiForTransaction.TimeProvider().EpochFromSlot(commitmentInput.CommitmentID.Slot() + protocolParameters.MaxCommittableAge())
+	futureBoundedEpoch := apiForTransaction.TimeProvider().EpochFromSlot(commitmentInput.CommitmentID.Slot() + protocolParameters.MinCommittableAge())
+
+	switch {
+	case currentStakingFeature == nil:
+		return validateAddedStakingFeature(apiForTransaction, pastBoundedEpoch, createdOutput, nextStakingFeature)
+	case futureBoundedEpoch <= currentStakingFeature.EndEpoch:
+		if nextStakingFeature == nil {
+			return ierrors.Join(iotago.ErrInvalidStakingTransition, iotago.ErrInvalidStakingBondedRemoval)
+		}
+
+		if isClaiming {
+			return ierrors.Join(iotago.ErrInvalidStakingTransition, iotago.ErrInvalidStakingRewardClaim)
+		}
+
+		if createdOutput.FeatureSet().BlockIssuer() == nil {
+			return ierrors.Join(iotago.ErrInvalidStakingTransition, iotago.ErrInvalidStakingBlockIssuerRequired)
+		}
+
+		if currentStakingFeature.StakedAmount != nextStakingFeature.StakedAmount ||
+			currentStakingFeature.FixedCost != nextStakingFeature.FixedCost ||
+			currentStakingFeature.StartEpoch != nextStakingFeature.StartEpoch {
+			return ierrors.Join(iotago.ErrInvalidStakingTransition, iotago.ErrInvalidStakingBondedModified)
+		}
+
+		if earliestUnbondingEpoch := pastBoundedEpoch + protocolParameters.StakingUnbondingPeriod(); currentStakingFeature.EndEpoch != nextStakingFeature.EndEpoch && nextStakingFeature.EndEpoch < earliestUnbondingEpoch {
+			return ierrors.Wrapf(ierrors.Join(iotago.ErrInvalidStakingTransition, iotago.ErrInvalidStakingEndEpochTooEarly), "end epoch %d should be >= %d", nextStakingFeature.EndEpoch, earliestUnbondingEpoch)
+		}
+	case nextStakingFeature == nil:
+		// the expired staking feature can only be removed if its rewards are claimed
+		if !isClaiming {
+			return ierrors.Join(iotago.ErrInvalidStakingTransition, iotago.ErrInvalidStakingRewardInputRequired)
+		}
+	case isClaiming:
+		// the rewards of the expired staking feature are claimed, so it needs to be reset like a newly added feature
+		return validateAddedStakingFeature(apiForTransaction, pastBoundedEpoch, createdOutput, nextStakingFeature)
+	case !currentStakingFeature.Equal(nextStakingFeature):
+		return ierrors.Join(iotago.ErrInvalidStakingTransition, iotago.ErrInvalidStakingRewardInputRequired)
+	}
+
+	return nil
+}
+
+// validateAddedStakingFeature checks that a staking feature that was added to an account (or reset after its rewards
+// were claimed) starts at the past bounded epoch and lasts at least for the unbonding period.
+func validateAddedStakingFeature(apiForTransaction iotago.API, pastBoundedEpoch iotago.EpochIndex, createdOutput iotago.Output, stakingFeature *iotago.StakingFeature) error {
+	if stakingFeature.StartEpoch != pastBoundedEpoch {
+		return ierrors.Wrapf(ierrors.Join(iotago.ErrInvalidStakingTransition, iotago.ErrInvalidStakingStartEpoch), "start epoch %d should be %d", stakingFeature.StartEpoch, pastBoundedEpoch)
+	}
+
+	if unbondingEpoch := pastBoundedEpoch + apiForTransaction.ProtocolParameters().StakingUnbondingPeriod(); stakingFeature.EndEpoch < unbondingEpoch {
+		return ierrors.Wrapf(ierrors.Join(iotago.ErrInvalidStakingTransition, iotago.ErrInvalidStakingEndEpochTooEarly), "end epoch %d should be >= %d", stakingFeature.EndEpoch, unbondingEpoch)
+	}
+
+	if createdOutput.FeatureSet().BlockIssuer() == nil {
+		return ierrors.Join(iotago.ErrInvalidStakingTransition, iotago.ErrInvalidStakingBlockIssuerRequired)
+	}
+
+	return nil
+}
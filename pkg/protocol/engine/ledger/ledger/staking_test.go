@@ -0,0 +1,216 @@
+package ledger
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/tpkg"
+)
+
+func TestValidateStakingTransition(t *testing.T) {
+	apiForTransaction := tpkg.ZeroCostTestAPI
+	unbondingPeriod := apiForTransaction.ProtocolParameters().StakingUnbondingPeriod()
+	timeProvider := apiForTransaction.TimeProvider()
+
+	commitmentInput := func(slot iotago.SlotIndex) *iotago.CommitmentInput {
+		return &iotago.CommitmentInput{CommitmentID: iotago.NewCommitmentID(slot, iotago.Identifier{})}
+	}
+
+	// the past bounded slot of the commitment input is the first slot of the epoch, while its future bounded slot is
+	// still in the previous epoch.
+	epoch := iotago.EpochIndex(10)
+	commitmentSlot := timeProvider.EpochStart(epoch) - apiForTransaction.ProtocolParameters().MaxCommittableAge()
+	require.Equal(t, epoch-1, timeProvider.EpochFromSlot(commitmentSlot+apiForTransaction.ProtocolParameters().MinCommittableAge()))
+
+	accountOutput := func(stakingFeature *iotago.StakingFeature) *iotago.AccountOutput {
+		features := iotago.AccountOutputFeatures{&iotago.BlockIssuerFeature{ExpirySlot: iotago.MaxSlotIndex}}
+		if stakingFeature != nil {
+			features = append(features, stakingFeature)
+		}
+
+		return &iotago.AccountOutput{Features: features}
+	}
+
+	bondedFeature := &iotago.StakingFeature{StakedAmount: 100, FixedCost: 10, StartEpoch: epoch - 2, EndEpoch: epoch + unbondingPeriod}
+	bondedUntilFutureBoundedEpochFeature := &iotago.StakingFeature{StakedAmount: 100, FixedCost: 10, StartEpoch: 0, EndEpoch: epoch - 1}
+	expiredFeature := &iotago.StakingFeature{StakedAmount: 100, FixedCost: 10, StartEpoch: 0, EndEpoch: epoch - 2}
+
+	for _, test := range []struct {
+		name            string
+		commitmentInput *iotago.CommitmentInput
+		isClaiming      bool
+		consumedOutput  iotago.Output
+		createdOutput   iotago.Output
+		expectedErr     error
+	}{
+		{
+			name:          "create without staking feature and commitment input",
+			createdOutput: accountOutput(nil),
+		},
+		{
+			name:            "create with staking feature",
+			commitmentInput: commitmentInput(commitmentSlot),
+			createdOutput:   accountOutput(&iotago.StakingFeature{StakedAmount: 100, StartEpoch: epoch, EndEpoch: epoch + unbondingPeriod}),
+		},
+		{
+			name:          "create with staking feature without commitment input",
+			createdOutput: accountOutput(&iotago.StakingFeature{StakedAmount: 100, StartEpoch: epoch, EndEpoch: epoch + unbondingPeriod}),
+			expectedErr:   iotago.ErrInvalidStakingCommitmentInput,
+		},
+		{
+			name:            "create with start epoch of the past bounded epoch of an older commitment",
+			commitmentInput: commitmentInput(commitmentSlot - 1),
+			createdOutput:   accountOutput(&iotago.StakingFeature{StakedAmount: 100, StartEpoch: epoch - 1, EndEpoch: epoch - 1 + unbondingPeriod}),
+		},
+		{
+			name:            "create with start epoch before the past bounded epoch",
+			commitmentInput: commitmentInput(commitmentSlot),
+			createdOutput:   accountOutput(&iotago.StakingFeature{StakedAmount: 100, StartEpoch: epoch - 1, EndEpoch: epoch + unbondingPeriod}),
+			expectedErr:     iotago.ErrInvalidStakingStartEpoch,
+		},
+		{
+			name:            "create with start epoch after the past bounded epoch",
+			commitmentInput: commitmentInput(commitmentSlot),
+			createdOutput:   accountOutput(&iotago.StakingFeature{StakedAmount: 100, StartEpoch: epoch + 1, EndEpoch: epoch + 1 + unbondingPeriod}),
+			expectedErr:     iotago.ErrInvalidStakingStartEpoch,
+		},
+		{
+			name:            "create with end epoch before unbonding period",
+			commitmentInput: commitmentInput(commitmentSlot),
+			createdOutput:   accountOutput(&iotago.StakingFeature{StakedAmount: 100, StartEpoch: epoch, EndEpoch: epoch + unbondingPeriod - 1}),
+			expectedErr:     iotago.ErrInvalidStakingEndEpochTooEarly,
+		},
+		{
+			name:            "create without block issuer feature",
+			commitmentInput: commitmentInput(commitmentSlot),
+			createdOutput:   &iotago.AccountOutput{Features: iotago.AccountOutputFeatures{&iotago.StakingFeature{StakedAmount: 100, StartEpoch: epoch, EndEpoch: epoch + unbondingPeriod}}},
+			expectedErr:     iotago.ErrInvalidStakingBlockIssuerRequired,
+		},
+		{
+			name:            "bonded unchanged",
+			commitmentInput: commitmentInput(commitmentSlot),
+			consumedOutput:  accountOutput(bondedFeature),
+			createdOutput:   accountOutput(bondedFeature),
+		},
+		{
+			name:           "bonded without commitment input",
+			consumedOutput: accountOutput(bondedFeature),
+			createdOutput:  accountOutput(bondedFeature),
+			expectedErr:    iotago.ErrInvalidStakingCommitmentInput,
+		},
+		{
+			name:            "bonded end epoch extended",
+			commitmentInput: commitmentInput(commitmentSlot),
+			consumedOutput:  accountOutput(bondedFeature),
+			createdOutput:   accountOutput(&iotago.StakingFeature{StakedAmount: 100, FixedCost: 10, StartEpoch: epoch - 2, EndEpoch: epoch + 2*unbondingPeriod}),
+		},
+		{
+			name:            "bonded end epoch reduced below unbonding period",
+			commitmentInput: commitmentInput(commitmentSlot),
+			consumedOutput:  accountOutput(bondedFeature),
+			createdOutput:   accountOutput(&iotago.StakingFeature{StakedAmount: 100, FixedCost: 10, StartEpoch: epoch - 2, EndEpoch: epoch - 1}),
+			expectedErr:     iotago.ErrInvalidStakingEndEpochTooEarly,
+		},
+		{
+			name:            "bonded staked amount changed",
+			commitmentInput: commitmentInput(commitmentSlot),
+			consumedOutput:  accountOutput(bondedFeature),
+			createdOutput:   accountOutput(&iotago.StakingFeature{StakedAmount: 200, FixedCost: 10, StartEpoch: epoch - 2, EndEpoch: epoch + unbondingPeriod}),
+			expectedErr:     iotago.ErrInvalidStakingBondedModified,
+		},
+		{
+			name:            "bonded fixed cost changed",
+			commitmentInput: commitmentInput(commitmentSlot),
+			consumedOutput:  accountOutput(bondedFeature),
+			createdOutput:   accountOutput(&iotago.StakingFeature{StakedAmount: 100, FixedCost: 5, StartEpoch: epoch - 2, EndEpoch: epoch + unbondingPeriod}),
+			expectedErr:     iotago.ErrInvalidStakingBondedModified,
+		},
+		{
+			name:            "bonded removed",
+			commitmentInput: commitmentInput(commitmentSlot),
+			isClaiming:      true,
+			consumedOutput:  accountOutput(bondedFeature),
+			createdOutput:   accountOutput(nil),
+			expectedErr:     iotago.ErrInvalidStakingBondedRemoval,
+		},
+		{
+			name:            "bonded rewards claimed",
+			commitmentInput: commitmentInput(commitmentSlot),
+			isClaiming:      true,
+			consumedOutput:  accountOutput(bondedFeature),
+			createdOutput:   accountOutput(bondedFeature),
+			expectedErr:     iotago.ErrInvalidStakingRewardClaim,
+		},
+		{
+			name:            "bonded until the future bounded epoch removed",
+			commitmentInput: commitmentInput(commitmentSlot),
+			isClaiming:      true,
+			consumedOutput:  accountOutput(bondedUntilFutureBoundedEpochFeature),
+			createdOutput:   accountOutput(nil),
+			expectedErr:     iotago.ErrInvalidStakingBondedRemoval,
+		},
+		{
+			name:            "expired after the future bounded epoch removed",
+			commitmentInput: commitmentInput(commitmentSlot + apiForTransaction.ProtocolParameters().MaxCommittableAge()),
+			isClaiming:      true,
+			consumedOutput:  accountOutput(bondedUntilFutureBoundedEpochFeature),
+			createdOutput:   accountOutput(nil),
+		},
+		{
+			name:            "expired removed",
+			commitmentInput: commitmentInput(commitmentSlot),
+			isClaiming:      true,
+			consumedOutput:  accountOutput(expiredFeature),
+			createdOutput:   accountOutput(nil),
+		},
+		{
+			name:            "expired removed without claiming",
+			commitmentInput: commitmentInput(commitmentSlot),
+			consumedOutput:  accountOutput(expiredFeature),
+			createdOutput:   accountOutput(nil),
+			expectedErr:     iotago.ErrInvalidStakingRewardInputRequired,
+		},
+		{
+			name:            "expired unchanged",
+			commitmentInput: commitmentInput(commitmentSlot),
+			consumedOutput:  accountOutput(expiredFeature),
+			createdOutput:   accountOutput(expiredFeature),
+		},
+		{
+			name:            "expired reset",
+			commitmentInput: commitmentInput(commitmentSlot),
+			isClaiming:      true,
+			consumedOutput:  accountOutput(expiredFeature),
+			createdOutput:   accountOutput(&iotago.StakingFeature{StakedAmount: 200, StartEpoch: epoch, EndEpoch: epoch + unbondingPeriod}),
+		},
+		{
+			name:            "expired reset without claiming",
+			commitmentInput: commitmentInput(commitmentSlot),
+			consumedOutput:  accountOutput(expiredFeature),
+			createdOutput:   accountOutput(&iotago.StakingFeature{StakedAmount: 200, StartEpoch: epoch, EndEpoch: epoch + unbondingPeriod}),
+			expectedErr:     iotago.ErrInvalidStakingRewardInputRequired,
+		},
+		{
+			name:            "expired reset with end epoch before unbonding period",
+			commitmentInput: commitmentInput(commitmentSlot),
+			isClaiming:      true,
+			consumedOutput:  accountOutput(expiredFeature),
+			createdOutput:   accountOutput(&iotago.StakingFeature{StakedAmount: 200, StartEpoch: epoch, EndEpoch: epoch}),
+			expectedErr:     iotago.ErrInvalidStakingEndEpochTooEarly,
+		},
+	} {
+		t.Run(test.name, func(t *testing.T) {
+			err := validateStakingTransition(apiForTransaction, test.commitmentInput, test.isClaiming, test.consumedOutput, test.createdOutput)
+			if test.expectedErr == nil {
+				require.NoError(t, err)
+
+				return
+			}
+
+			require.ErrorIs(t, err, iotago.ErrInvalidStakingTransition)
+			require.ErrorIs(t, err, test.expectedErr)
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 
 	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/lo"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/mempool"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/utxoledger"
 	iotago "github.com/iotaledger/iota.go/v4"
@@ -61,6 +62,13 @@ func (v *VM) ValidateSignatures(signedTransaction mempool.SignedTransaction, res
 		}
 	}
 
+	// transactions of warp synced slots are known to be accepted (their IDs are verified against the state mutation
+	// root), so we skip their validation. If they do not consume the inputs that the state diff of their slot expects
+	// them to consume, the state diff is discarded and the transactions are validated and executed as usual.
+	if transactionID, idErr := signedStardustTransaction.Transaction.ID(); idErr == nil && v.ledger.warpSyncStateDiffs.IsPreVerified(transactionID, lo.Keys(utxoInputSet)) {
+		return context.WithValue(context.Background(), ExecutionContextKeyPreVerified, true), nil
+	}
+
 	if (len(rewardInputs) > 0 || len(bicInputs) > 0) && commitmentInput == nil {
 		return nil, iotago.ErrCommitmentInputMissing
 	}
@@ -165,17 +173,7 @@ func (v *VM) Execute(executionContext context.Context, transaction mempool.Trans
 		return nil, err
 	}
 
-	unlockedIdentities, ok := executionContext.Value(ExecutionContextKeyUnlockedIdentities).(iotagovm.UnlockedIdentities)
-	if !ok {
-		return nil, ierrors.Errorf("unlockedIdentities not found in execution context")
-	}
-
-	resolvedInputs, ok := executionContext.Value(ExecutionContextKeyResolvedInputs).(iotagovm.ResolvedInputs)
-	if !ok {
-		return nil, ierrors.Errorf("resolvedInputs not found in execution context")
-	}
-
-	createdOutputs, err := nova.NewVirtualMachine().Execute(stardustTransaction, resolvedInputs, unlockedIdentities)
+	createdOutputs, err := v.execute(executionContext, stardustTransaction)
 	if err != nil {
 		return nil, err
 	}
@@ -199,6 +197,37 @@ func (v *VM) Execute(executionContext context.Context, transaction mempool.Trans
 	return outputs, nil
 }
 
+// execute returns the outputs that are created by the given transaction. Pre-verified transactions are not executed by
+// the virtual machine, and their outputs are taken from the transaction as is.
+//
+// Executed transactions are additionally rejected if they create block issuer keys that exceed the protocol limits,
+// since the accounts ledger can not refuse to apply the keys of an accepted transaction when the slot is committed.
+func (v *VM) execute(executionContext context.Context, transaction *iotago.Transaction) (createdOutputs []iotago.Output, err error) {
+	if preVerified, ok := executionContext.Value(ExecutionContextKeyPreVerified).(bool); ok && preVerified {
+		return lo.Map(transaction.Outputs, func(output iotago.TxEssenceOutput) iotago.Output { return output }), nil
+	}
+
+	unlockedIdentities, ok := executionContext.Value(ExecutionContextKeyUnlockedIdentities).(iotagovm.UnlockedIdentities)
+	if !ok {
+		return nil, ierrors.Errorf("unlockedIdentities not found in execution context")
+	}
+
+	resolvedInputs, ok := executionContext.Value(ExecutionContextKeyResolvedInputs).(iotagovm.ResolvedInputs)
+	if !ok {
+		return nil, ierrors.Errorf("resolvedInputs not found in execution context")
+	}
+
+	if createdOutputs, err = nova.NewVirtualMachine().Execute(transaction, resolvedInputs, unlockedIdentities); err != nil {
+		return nil, err
+	}
+
+	if err = validateCreatedBlockIssuerKeys(createdOutputs); err != nil {
+		return nil, err
+	}
+
+	return createdOutputs, nil
+}
+
 // ExecutionContextKey is the type of the keys used in the execution context.
 type ExecutionContextKey uint8
 
@@ -208,4 +237,8 @@ const (
 
 	// ExecutionContextKeyResolvedInputs is the key for the resolved inputs in the execution context.
 	ExecutionContextKeyResolvedInputs
+
+	// ExecutionContextKeyPreVerified is the key for the flag that marks transactions of warp synced slots whose
+	// validation and execution is skipped.
+	ExecutionContextKeyPreVerified
 )
@@ -0,0 +1,170 @@
+package ledger
+
+import (
+	"github.com/iotaledger/hive.go/ds"
+	"github.com/iotaledger/hive.go/ds/shrinkingmap"
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/hive.go/runtime/syncutils"
+	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/utxoledger"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// warpSyncStateDiffs contains the verified state diffs of the slots that are warp synced. The transactions of these
+// slots are known to be accepted (their IDs are verified against the state mutation root of the commitment), so they
+// are applied without re-executing them and the resulting changes are checked against the state diff when the slot is
+// committed.
+type warpSyncStateDiffs struct {
+	// stateDiffs contains the expected state diffs by slot.
+	stateDiffs *shrinkingmap.ShrinkingMap[iotago.SlotIndex, *warpSyncStateDiff]
+
+	// transactions contains the slots of the transactions that are known to be accepted.
+	transactions *shrinkingmap.ShrinkingMap[iotago.TransactionID, iotago.SlotIndex]
+
+	// consumedOutputs contains the outputs that are consumed according to the state diffs.
+	consumedOutputs ds.Set[iotago.OutputID]
+
+	// mutex is used to synchronize access to the state diffs.
+	mutex syncutils.RWMutex
+}
+
+// warpSyncStateDiff is a state diff of a warp synced slot together with the handler that is called if it turns out to
+// be invalid.
+type warpSyncStateDiff struct {
+	*model.SlotStateDiff
+
+	// onInvalid is called if the state diff does not match the actual changes of the slot.
+	onInvalid func(err error)
+}
+
+// newWarpSyncStateDiffs creates a new empty warpSyncStateDiffs instance.
+func newWarpSyncStateDiffs() *warpSyncStateDiffs {
+	return &warpSyncStateDiffs{
+		stateDiffs:      shrinkingmap.New[iotago.SlotIndex, *warpSyncStateDiff](),
+		transactions:    shrinkingmap.New[iotago.TransactionID, iotago.SlotIndex](),
+		consumedOutputs: ds.NewSet[iotago.OutputID](),
+	}
+}
+
+// Set registers the verified state diff and the accepted transactions of the given slot. The onInvalid handler is
+// called if the state diff turns out to not match the actual changes of the slot.
+func (w *warpSyncStateDiffs) Set(slot iotago.SlotIndex, stateDiff *model.SlotStateDiff, transactionIDs iotago.TransactionIDs, onInvalid func(err error)) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	w.evict(slot)
+
+	w.stateDiffs.Set(slot, &warpSyncStateDiff{SlotStateDiff: stateDiff, onInvalid: onInvalid})
+	for _, transactionID := range transactionIDs {
+		w.transactions.Set(transactionID, slot)
+	}
+	for _, outputID := range stateDiff.ConsumedOutputIDs {
+		w.consumedOutputs.Add(outputID)
+	}
+}
+
+// IsPreVerified returns true if the given transaction is known to be accepted and consumes the given inputs according
+// to the state diff of its slot. If the inputs differ from the state diff, the state diff is discarded as invalid, so
+// that the transactions of the slot are executed again.
+func (w *warpSyncStateDiffs) IsPreVerified(transactionID iotago.TransactionID, inputIDs iotago.OutputIDs) bool {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	slot, exists := w.transactions.Get(transactionID)
+	if !exists {
+		return false
+	}
+
+	for _, inputID := range inputIDs {
+		if !w.consumedOutputs.Has(inputID) {
+			w.invalidate(slot, ierrors.Wrapf(model.ErrInvalidSlotStateDiff, "transaction %s consumes output %s that is not consumed according to the state diff", transactionID, inputID))
+
+			return false
+		}
+	}
+
+	return true
+}
+
+// Check verifies that the given created and consumed outputs and the resulting state root match the state diff of the
+// given slot (if it exists) and removes the state diff afterward. A mismatch is reported to the onInvalid handler of
+// the state diff.
+func (w *warpSyncStateDiffs) Check(slot iotago.SlotIndex, created utxoledger.Outputs, consumed utxoledger.Spents, stateRoot iotago.Identifier) {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	stateDiff, exists := w.stateDiffs.Get(slot)
+	if !exists {
+		return
+	}
+
+	if err := stateDiff.Matches(lo.Map(created, (*utxoledger.Output).OutputID), lo.Map(consumed, (*utxoledger.Spent).OutputID)); err != nil {
+		w.invalidate(slot, err)
+
+		return
+	}
+
+	if err := stateDiff.MatchesStateRoot(stateRoot); err != nil {
+		w.invalidate(slot, err)
+
+		return
+	}
+
+	w.evict(slot)
+}
+
+// Clear removes all state diffs.
+func (w *warpSyncStateDiffs) Clear() {
+	w.mutex.Lock()
+	defer w.mutex.Unlock()
+
+	w.stateDiffs.Clear()
+	w.transactions.Clear()
+	w.consumedOutputs.Clear()
+}
+
+// invalidate removes the state diff of the given slot and reports the given error to its onInvalid handler (without
+// locking).
+func (w *warpSyncStateDiffs) invalidate(slot iotago.SlotIndex, err error) {
+	if stateDiff := w.evict(slot); stateDiff != nil && stateDiff.onInvalid != nil {
+		stateDiff.onInvalid(ierrors.Wrapf(err, "state diff of slot %d is invalid", slot))
+	}
+}
+
+// evict removes the state diff of the given slot and returns it (without locking).
+func (w *warpSyncStateDiffs) evict(slot iotago.SlotIndex) *warpSyncStateDiff {
+	stateDiff, exists := w.stateDiffs.DeleteAndReturn(slot)
+	if !exists {
+		return nil
+	}
+
+	for _, outputID := range stateDiff.ConsumedOutputIDs {
+		w.consumedOutputs.Delete(outputID)
+	}
+
+	for _, transactionID := range w.transactions.Keys() {
+		if transactionSlot, exists := w.transactions.Get(transactionID); exists && transactionSlot == slot {
+			w.transactions.Delete(transactionID)
+		}
+	}
+
+	return stateDiff
+}
+
+// SetWarpSyncStateDiff registers the verified state diff of a slot that is warp synced, so that its accepted
+// transactions are applied without re-executing them. The onInvalid handler is called if the state diff does not
+// match the actual changes of the slot, in which case the remaining transactions of the slot are executed again.
+func (l *Ledger) SetWarpSyncStateDiff(slot iotago.SlotIndex, stateDiff *model.SlotStateDiff, transactionIDs iotago.TransactionIDs, onInvalid func(err error)) {
+	l.warpSyncStateDiffs.Set(slot, stateDiff, transactionIDs, onInvalid)
+}
+
+// checkWarpSyncStateDiff verifies that the outputs created and consumed in the given slot and the resulting state root
+// match its warp sync state diff.
+//
+// A mismatch does not fail the commitment of the slot: the changes of the pre-verified transactions are fully
+// determined by their IDs, which are verified against the state mutation root, so only the peer that sent the state
+// diff is at fault.
+func (l *Ledger) checkWarpSyncStateDiff(slot iotago.SlotIndex, created utxoledger.Outputs, consumed utxoledger.Spents) {
+	l.warpSyncStateDiffs.Check(slot, created, consumed, l.utxoLedger.StateTreeRoot())
+}
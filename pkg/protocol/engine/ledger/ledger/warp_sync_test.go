@@ -0,0 +1,114 @@
+package ledger
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/ads"
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/utxoledger"
+	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/tpkg"
+)
+
+func TestWarpSyncStateDiffs(t *testing.T) {
+	transactionA, transactionB := tpkg.RandTransactionID(), tpkg.RandTransactionID()
+	inputA, inputB := tpkg.RandOutputID(0), tpkg.RandOutputID(0)
+
+	mutations := ads.NewSet[iotago.Identifier](mapdb.NewMapDB(), iotago.Identifier.Bytes, iotago.IdentifierFromBytes, iotago.TransactionID.Bytes, iotago.TransactionIDFromBytes)
+	require.NoError(t, mutations.Add(transactionA))
+	require.NoError(t, mutations.Add(transactionB))
+
+	stateRoot := tpkg.RandIdentifier()
+	roots := iotago.NewRoots(tpkg.RandIdentifier(), mutations.Root(), tpkg.RandIdentifier(), stateRoot, tpkg.RandIdentifier(), tpkg.RandIdentifier(), tpkg.RandIdentifier(), tpkg.RandIdentifier())
+	commitment := lo.PanicOnErr(model.CommitmentFromCommitment(iotago.NewCommitment(0, 5, tpkg.RandCommitmentID(), roots.ID(), 0, 0), tpkg.ZeroCostTestAPI))
+
+	stateDiff := &model.SlotStateDiff{
+		CreatedOutputIDs: iotago.OutputIDs{
+			iotago.OutputIDFromTransactionIDAndIndex(transactionA, 0),
+			iotago.OutputIDFromTransactionIDAndIndex(transactionB, 0),
+		},
+		ConsumedOutputIDs: iotago.OutputIDs{inputA, inputB},
+		Roots:             roots,
+	}
+
+	// the state diff needs to match the commitment and the accepted transactions
+	require.NoError(t, stateDiff.Verify(commitment, iotago.TransactionIDs{transactionA, transactionB}))
+	require.ErrorIs(t, stateDiff.Verify(commitment, iotago.TransactionIDs{transactionA}), model.ErrInvalidSlotStateDiff)
+	require.ErrorIs(t, stateDiff.Verify(commitment, iotago.TransactionIDs{transactionA, transactionB, tpkg.RandTransactionID()}), model.ErrInvalidSlotStateDiff)
+	require.ErrorIs(t, (&model.SlotStateDiff{Roots: iotago.NewRoots(tpkg.RandIdentifier(), tpkg.RandIdentifier(), tpkg.RandIdentifier(), tpkg.RandIdentifier(), tpkg.RandIdentifier(), tpkg.RandIdentifier(), tpkg.RandIdentifier(), tpkg.RandIdentifier())}).Verify(commitment, iotago.TransactionIDs{}), model.ErrInvalidSlotStateDiff)
+
+	// the created outputs need to belong to the accepted transactions, which need to match the state mutation root
+	require.ErrorIs(t, (&model.SlotStateDiff{CreatedOutputIDs: stateDiff.CreatedOutputIDs[:1], Roots: roots}).Verify(commitment, iotago.TransactionIDs{transactionA, transactionB}), model.ErrInvalidSlotStateDiff)
+	otherTransaction := tpkg.RandTransactionID()
+	require.ErrorIs(t, (&model.SlotStateDiff{CreatedOutputIDs: iotago.OutputIDs{iotago.OutputIDFromTransactionIDAndIndex(otherTransaction, 0)}, Roots: roots}).Verify(commitment, iotago.TransactionIDs{otherTransaction}), model.ErrInvalidSlotStateDiff)
+
+	var invalidErrors []error
+	onInvalid := func(err error) {
+		invalidErrors = append(invalidErrors, err)
+	}
+
+	stateDiffs := newWarpSyncStateDiffs()
+	stateDiffs.Set(5, stateDiff, iotago.TransactionIDs{transactionA, transactionB}, onInvalid)
+
+	// only known transactions that consume the expected inputs are pre-verified
+	require.True(t, stateDiffs.IsPreVerified(transactionA, iotago.OutputIDs{inputA}))
+	require.True(t, stateDiffs.IsPreVerified(transactionB, iotago.OutputIDs{inputA, inputB}))
+	require.False(t, stateDiffs.IsPreVerified(tpkg.RandTransactionID(), iotago.OutputIDs{inputA}))
+	require.Empty(t, invalidErrors)
+
+	// a known transaction that consumes unexpected inputs invalidates the state diff, so that the transactions of the
+	// slot are executed again
+	require.False(t, stateDiffs.IsPreVerified(transactionA, iotago.OutputIDs{inputA, tpkg.RandOutputID(0)}))
+	require.Len(t, invalidErrors, 1)
+	require.ErrorIs(t, invalidErrors[0], model.ErrInvalidSlotStateDiff)
+	require.False(t, stateDiffs.IsPreVerified(transactionB, iotago.OutputIDs{inputB}))
+
+	created := utxoledger.Outputs{newTestOutput(stateDiff.CreatedOutputIDs[1]), newTestOutput(stateDiff.CreatedOutputIDs[0])}
+	consumed := utxoledger.Spents{
+		utxoledger.NewSpent(newTestOutput(inputA), transactionA, 5),
+		utxoledger.NewSpent(newTestOutput(inputB), transactionB, 5),
+	}
+
+	// slots without a state diff are not checked
+	invalidErrors = nil
+	stateDiffs.Check(4, created, nil, stateRoot)
+	stateDiffs.Check(5, created, nil, stateRoot)
+	require.Empty(t, invalidErrors)
+
+	// a check that fails reports the error and removes the state diff
+	stateDiffs.Set(5, stateDiff, iotago.TransactionIDs{transactionA, transactionB}, onInvalid)
+	stateDiffs.Check(5, created, consumed[:1], stateRoot)
+	require.Len(t, invalidErrors, 1)
+	require.ErrorIs(t, invalidErrors[0], model.ErrInvalidSlotStateDiff)
+	require.False(t, stateDiffs.IsPreVerified(transactionA, iotago.OutputIDs{inputA}))
+
+	// the check also verifies the state root of the ledger after the changes were applied
+	stateDiffs.Set(5, stateDiff, iotago.TransactionIDs{transactionA, transactionB}, onInvalid)
+	stateDiffs.Check(5, created, consumed, tpkg.RandIdentifier())
+	require.Len(t, invalidErrors, 2)
+	require.ErrorIs(t, invalidErrors[1], model.ErrInvalidSlotStateDiff)
+
+	stateDiffs.Set(5, stateDiff, iotago.TransactionIDs{transactionA, transactionB}, onInvalid)
+	stateDiffs.Check(5, created, consumed, stateRoot)
+	require.Len(t, invalidErrors, 2)
+	require.False(t, stateDiffs.IsPreVerified(transactionA, iotago.OutputIDs{inputA}))
+
+	stateDiffs.Set(5, stateDiff, iotago.TransactionIDs{transactionA, transactionB}, onInvalid)
+	stateDiffs.Clear()
+	require.False(t, stateDiffs.IsPreVerified(transactionA, iotago.OutputIDs{inputA}))
+}
+
+func newTestOutput(outputID iotago.OutputID) *utxoledger.Output {
+	return utxoledger.CreateOutput(
+		iotago.SingleVersionProvider(tpkg.ZeroCostTestAPI),
+		outputID,
+		tpkg.RandBlockID(),
+		5,
+		tpkg.RandBasicOutput(iotago.AddressEd25519),
+		lo.PanicOnErr(iotago.NewOutputIDProof(tpkg.ZeroCostTestAPI, tpkg.Rand32ByteArray(), 5, iotago.TxEssenceOutputs{tpkg.RandBasicOutput(iotago.AddressEd25519)}, 0)),
+	)
+}
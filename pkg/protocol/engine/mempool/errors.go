@@ -3,3 +3,17 @@ package mempool
 import "github.com/iotaledger/hive.go/ierrors"
 
 var ErrStateNotFound = ierrors.New("state not found")
+
+var (
+	// ErrTransactionStateTimeout is returned when a transaction did not reach an awaited state within the timeout.
+	ErrTransactionStateTimeout = ierrors.New("timeout while awaiting transaction state")
+
+	// ErrTransactionStateUnreachable is returned when a transaction can no longer reach an awaited state.
+	ErrTransactionStateUnreachable = ierrors.New("transaction state is unreachable")
+
+	// ErrTransactionNotFound is returned when a transaction is not known to the MemPool.
+	ErrTransactionNotFound = ierrors.New("transaction not found")
+
+	// ErrTransactionNotReattachable is returned when a transaction can not be attached to a new block anymore.
+	ErrTransactionNotReattachable = ierrors.New("transaction can not be reattached")
+)
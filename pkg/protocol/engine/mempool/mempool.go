@@ -1,6 +1,8 @@
 package mempool
 
 import (
+	"time"
+
 	"github.com/iotaledger/hive.go/runtime/event"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/mempool/spenddag"
 	iotago "github.com/iotaledger/iota.go/v4"
@@ -9,22 +11,51 @@ import (
 type MemPool[VoteRank spenddag.VoteRankType[VoteRank]] interface {
 	AttachSignedTransaction(signedTransaction SignedTransaction, transaction Transaction, blockID iotago.BlockID) (signedTransactionMetadata SignedTransactionMetadata, err error)
 
+	// ReattachTransaction hands the stored payload of a known transaction to the issue function, which is expected to
+	// issue it in a new block, and registers the returned block as an additional attachment of the transaction.
+	ReattachTransaction(id iotago.TransactionID, issueFunc func(signedTransaction SignedTransaction) (iotago.BlockID, error)) (signedTransactionMetadata SignedTransactionMetadata, err error)
+
 	OnSignedTransactionAttached(callback func(signedTransactionMetadata SignedTransactionMetadata), opts ...event.Option)
 
 	OnTransactionAttached(callback func(metadata TransactionMetadata), opts ...event.Option)
 
+	// OnStateDiffChanged registers a callback that is triggered for each incremental change of the StateDiff of a
+	// pending (uncommitted) slot, so that a live view of the pending state can be maintained without recomputing the
+	// StateDiff on each query.
+	OnStateDiffChanged(callback func(change *StateDiffChange), opts ...event.Option) (unsubscribe func())
+
 	MarkAttachmentIncluded(blockID iotago.BlockID) bool
 
 	StateMetadata(reference StateReference) (state StateMetadata, err error)
 
 	TransactionMetadata(id iotago.TransactionID) (transaction TransactionMetadata, exists bool)
 
+	// AwaitTransactionState returns a channel that receives nil once the transaction reaches the given state, or an
+	// error if the state becomes unreachable or is not reached within the timeout. Transactions that are not known yet
+	// are awaited to be attached.
+	AwaitTransactionState(id iotago.TransactionID, state TransactionState, timeout time.Duration) <-chan error
+
 	VM() VM
 
 	InjectRequestedState(state State)
 
 	TransactionMetadataByAttachment(blockID iotago.BlockID) (transaction TransactionMetadata, exists bool)
 
+	// ConflictGroup returns the pending transactions that belong to the conflicts of the given transaction, to the
+	// conflicts that compete with them or to their future cone (including the transaction itself if it is pending).
+	ConflictGroup(id iotago.TransactionID) (transactions []TransactionMetadata, exists bool)
+
+	// ForEachPendingTransaction calls the consumer for each transaction that is pending at the time of the call until
+	// the consumer returns false. The transactions are collected before the consumer is called, so the consumer can
+	// safely call back into the MemPool, and transactions that get evicted in the meantime are still consumed.
+	ForEachPendingTransaction(consumer func(transaction TransactionMetadata) bool)
+
+	// QuarantinedTransaction returns the retained information about the given transaction if it turned invalid.
+	QuarantinedTransaction(id iotago.TransactionID) (transaction *QuarantinedTransaction, exists bool)
+
+	// QuarantinedTransactions returns the retained transactions that turned invalid (ordered from oldest to newest).
+	QuarantinedTransactions() []*QuarantinedTransaction
+
 	StateDiff(slot iotago.SlotIndex) (StateDiff, error)
 
 	Evict(slot iotago.SlotIndex)
@@ -0,0 +1,26 @@
+package mempool
+
+import (
+	"time"
+
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// QuarantinedTransaction is a transaction that turned invalid and that is retained for inspection after it was evicted
+// from the MemPool.
+type QuarantinedTransaction struct {
+	// ID is the identifier of the transaction.
+	ID iotago.TransactionID
+
+	// Transaction is the transaction that turned invalid.
+	Transaction Transaction
+
+	// Reason is the error that made the transaction invalid.
+	Reason error
+
+	// FirstAttachment is the ID of the block that attached the transaction first.
+	FirstAttachment iotago.BlockID
+
+	// QuarantinedAt is the time at which the transaction turned invalid.
+	QuarantinedAt time.Time
+}
@@ -31,7 +31,10 @@ type SpendDAG[SpenderID, ResourceID IDType, VoteRank VoteRankType[VoteRank]] int
 	SpenderWeight(spenderID SpenderID) int64
 	SpenderChildren(spenderID SpenderID) (spenderIDs ds.Set[SpenderID], exists bool)
 	SpenderVoters(spenderID SpenderID) (voters ds.Set[account.SeatIndex])
+	SpenderSupportingVotes(spenderID SpenderID) (votes []*vote.Vote[VoteRank])
+	PendingSpenders() (spenderIDs ds.Set[SpenderID])
 	LikedInstead(spenderIDs ds.Set[SpenderID]) ds.Set[SpenderID]
+	Statistics() *Statistics
 }
 
 type ReadLockedSpendDAG[SpenderID, ResourceID IDType, VoteRank VoteRankType[VoteRank]] interface {
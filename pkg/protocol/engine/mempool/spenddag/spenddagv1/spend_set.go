@@ -10,6 +10,11 @@ import (
 
 // SpendSet represents a set of Spenders of a Resource.
 // If there's more than 1 spender in a SpendSet, they are conflicting with each other over the shared Resource.
+//
+// The number of members is deliberately not capped: which Spenders a node knows about depends on the order in which it
+// receives them, so excluding (or rejecting) Spenders beyond a limit would make the outcome of the vote depend on the
+// local arrival order instead of the votes of the committee. Double spend spam is bounded by the Mana that every block
+// has to burn instead.
 type SpendSet[SpenderID, ResourceID spenddag.IDType, VoteRank spenddag.VoteRankType[VoteRank]] struct {
 	// ID is the ID of the Resource being spent.
 	ID ResourceID
@@ -60,6 +65,14 @@ func (c *SpendSet[SpenderID, ResourceID, VoteRank]) Remove(removedSpender *Spend
 	return removed
 }
 
+// Size returns the number of members of the SpendSet.
+func (c *SpendSet[SpenderID, ResourceID, VoteRank]) Size() int {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	return c.spenders.Size()
+}
+
 func (c *SpendSet[SpenderID, ResourceID, VoteRank]) ForEach(callback func(parent *Spender[SpenderID, ResourceID, VoteRank]) error) error {
 	c.mutex.RLock()
 	defer c.mutex.RUnlock()
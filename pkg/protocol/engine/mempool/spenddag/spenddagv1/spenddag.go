@@ -6,6 +6,7 @@ import (
 	"github.com/iotaledger/hive.go/ds/walker"
 	"github.com/iotaledger/hive.go/ierrors"
 	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/hive.go/runtime/options"
 	"github.com/iotaledger/hive.go/runtime/syncutils"
 	"github.com/iotaledger/iota-core/pkg/core/acceptance"
 	"github.com/iotaledger/iota-core/pkg/core/account"
@@ -40,11 +41,15 @@ type SpendDAG[SpenderID, ResourceID spenddag.IDType, VoteRank spenddag.VoteRankT
 
 	// votingMutex is used to synchronize voting for different identities.
 	votingMutex *syncutils.DAGMutex[account.SeatIndex]
+
+	// optsMergeToMaster defines whether accepted spenders are removed from the spenders that are inherited (merge to
+	// master).
+	optsMergeToMaster bool
 }
 
 // New creates a new spenddag.
-func New[SpenderID, ResourceID spenddag.IDType, VoteRank spenddag.VoteRankType[VoteRank]](seatCount func() int) *SpendDAG[SpenderID, ResourceID, VoteRank] {
-	return &SpendDAG[SpenderID, ResourceID, VoteRank]{
+func New[SpenderID, ResourceID spenddag.IDType, VoteRank spenddag.VoteRankType[VoteRank]](seatCount func() int, opts ...options.Option[SpendDAG[SpenderID, ResourceID, VoteRank]]) *SpendDAG[SpenderID, ResourceID, VoteRank] {
+	return options.Apply(&SpendDAG[SpenderID, ResourceID, VoteRank]{
 		events: spenddag.NewEvents[SpenderID, ResourceID](),
 
 		seatCount:     seatCount,
@@ -53,7 +58,9 @@ func New[SpenderID, ResourceID spenddag.IDType, VoteRank spenddag.VoteRankType[V
 		spendSetsByID: shrinkingmap.New[ResourceID, *SpendSet[SpenderID, ResourceID, VoteRank]](),
 		pendingTasks:  syncutils.NewCounter(),
 		votingMutex:   syncutils.NewDAGMutex[account.SeatIndex](),
-	}
+
+		optsMergeToMaster: true,
+	}, opts)
 }
 
 var _ spenddag.SpendDAG[iotago.TransactionID, iotago.OutputID, vote.MockedRank] = &SpendDAG[iotago.TransactionID, iotago.OutputID, vote.MockedRank]{}
@@ -248,6 +255,70 @@ func (c *SpendDAG[SpenderID, ResourceID, VoteRank]) SpenderVoters(spenderID Spen
 	return ds.NewSet[account.SeatIndex]()
 }
 
+// SpenderSupportingVotes returns the latest votes of the seats that support the given Spender.
+func (c *SpendDAG[SpenderID, ResourceID, VoteRank]) SpenderSupportingVotes(spenderID SpenderID) (votes []*vote.Vote[VoteRank]) {
+	if spender, exists := c.spendersByID.Get(spenderID); exists {
+		spender.LatestVotes.ForEach(func(_ account.SeatIndex, latestVote *vote.Vote[VoteRank]) bool {
+			if latestVote.IsLiked() {
+				votes = append(votes, latestVote)
+			}
+
+			return true
+		})
+	}
+
+	return votes
+}
+
+// PendingSpenders returns the SpenderIDs of all Spenders that are neither accepted nor rejected.
+func (c *SpendDAG[SpenderID, ResourceID, VoteRank]) PendingSpenders() (spenderIDs ds.Set[SpenderID]) {
+	spenderIDs = ds.NewSet[SpenderID]()
+	c.spendersByID.ForEach(func(spenderID SpenderID, spender *Spender[SpenderID, ResourceID, VoteRank]) bool {
+		if spender.IsPending() {
+			spenderIDs.Add(spenderID)
+		}
+
+		return true
+	})
+
+	return spenderIDs
+}
+
+// Statistics returns aggregated statistics about the Spenders and SpendSets that are currently tracked by the SpendDAG.
+func (c *SpendDAG[SpenderID, ResourceID, VoteRank]) Statistics() *spenddag.Statistics {
+	c.mutex.RLock()
+	defer c.mutex.RUnlock()
+
+	statistics := &spenddag.Statistics{
+		Spenders:  c.spendersByID.Size(),
+		SpendSets: c.spendSetsByID.Size(),
+	}
+
+	depths := make(map[SpenderID]int)
+	c.spendersByID.ForEach(func(_ SpenderID, spender *Spender[SpenderID, ResourceID, VoteRank]) bool {
+		switch {
+		case spender.IsAccepted():
+			statistics.AcceptedSpenders++
+		case spender.IsRejected():
+			statistics.RejectedSpenders++
+		default:
+			statistics.PendingSpenders++
+		}
+
+		statistics.MaxDepth = max(statistics.MaxDepth, spenderDepth(spender, depths))
+
+		return true
+	})
+
+	c.spendSetsByID.ForEach(func(_ ResourceID, spendSet *SpendSet[SpenderID, ResourceID, VoteRank]) bool {
+		statistics.MaxSpendSetSize = max(statistics.MaxSpendSetSize, spendSet.Size())
+
+		return true
+	})
+
+	return statistics
+}
+
 func (c *SpendDAG[SpenderID, ResourceID, VoteRank]) SpendSets(spenderID SpenderID) (spendSets ds.Set[ResourceID], exists bool) {
 	spender, exists := c.spendersByID.Get(spenderID)
 	if !exists {
@@ -375,11 +446,11 @@ func (c *SpendDAG[SpenderID, ResourceID, VoteRank]) SetAccepted(spenderID Spende
 }
 
 // UnacceptedSpends takes a set of SpenderIDs and removes all the accepted Spends (leaving only the
-// pending or rejected ones behind).
+// pending or rejected ones behind). If merge to master is disabled, the accepted Spends are kept until they are evicted.
 func (c *SpendDAG[SpenderID, ResourceID, VoteRank]) UnacceptedSpenders(spenderIDs ds.Set[SpenderID]) ds.Set[SpenderID] {
 	pendingSpenderIDs := ds.NewSet[SpenderID]()
 	spenderIDs.Range(func(currentSpenderID SpenderID) {
-		if spender, exists := c.spendersByID.Get(currentSpenderID); exists && !spender.IsAccepted() {
+		if spender, exists := c.spendersByID.Get(currentSpenderID); exists && (!c.optsMergeToMaster || !spender.IsAccepted()) {
 			pendingSpenderIDs.Add(currentSpenderID)
 		}
 	})
@@ -509,3 +580,28 @@ func (c *SpendDAG[SpenderID, ResourceID, VoteRank]) spendSetFactory(resourceID R
 		return spendSet
 	}
 }
+
+// WithMergeToMaster defines whether accepted spenders are removed from the spenders that are inherited (merge to master).
+// Disabling it keeps accepted spenders in the inherited spenders until they are evicted.
+func WithMergeToMaster[SpenderID, ResourceID spenddag.IDType, VoteRank spenddag.VoteRankType[VoteRank]](mergeToMaster bool) options.Option[SpendDAG[SpenderID, ResourceID, VoteRank]] {
+	return func(c *SpendDAG[SpenderID, ResourceID, VoteRank]) {
+		c.optsMergeToMaster = mergeToMaster
+	}
+}
+
+// spenderDepth returns the length of the longest chain of parents of the given Spender (including itself) and memoizes
+// the results in the given map.
+func spenderDepth[SpenderID, ResourceID spenddag.IDType, VoteRank spenddag.VoteRankType[VoteRank]](spender *Spender[SpenderID, ResourceID, VoteRank], depths map[SpenderID]int) int {
+	if depth, exists := depths[spender.ID]; exists {
+		return depth
+	}
+
+	var maxParentDepth int
+	spender.Parents.Range(func(parent *Spender[SpenderID, ResourceID, VoteRank]) {
+		maxParentDepth = max(maxParentDepth, spenderDepth(parent, depths))
+	})
+
+	depths[spender.ID] = maxParentDepth + 1
+
+	return maxParentDepth + 1
+}
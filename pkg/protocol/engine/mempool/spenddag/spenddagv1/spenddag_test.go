@@ -10,6 +10,7 @@ import (
 	"github.com/iotaledger/hive.go/runtime/memanalyzer"
 	"github.com/iotaledger/iota-core/pkg/core/account"
 	"github.com/iotaledger/iota-core/pkg/core/vote"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/mempool/spenddag"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/mempool/spenddag/tests"
 	iotago "github.com/iotaledger/iota.go/v4"
 )
@@ -36,6 +37,81 @@ func newTestFramework(t *testing.T) *tests.Framework {
 	)
 }
 
+func TestSpendDAG_Statistics(t *testing.T) {
+	accountsTestFramework := tests.NewAccountsTestFramework(t, account.NewAccounts())
+	tf := tests.NewFramework(t, New[iotago.TransactionID, iotago.OutputID, vote.MockedRank](accountsTestFramework.Committee.SeatCount), accountsTestFramework, transactionID, outputID)
+
+	tf.Accounts.CreateID("nodeID1")
+	tf.Accounts.CreateID("nodeID2")
+	tf.Accounts.CreateID("nodeID3")
+
+	require.Equal(t, &spenddag.Statistics{}, tf.Instance.Statistics())
+
+	require.NoError(t, tf.CreateOrUpdateSpender("spender1", []string{"resource1"}))
+	require.NoError(t, tf.CreateOrUpdateSpender("spender2", []string{"resource1"}))
+	require.NoError(t, tf.CreateOrUpdateSpender("spender3", []string{"resource2"}))
+	require.NoError(t, tf.UpdateSpenderParents("spender3", []string{"spender1"}, []string{}))
+	require.NoError(t, tf.CreateOrUpdateSpender("spender4", []string{"resource2"}))
+	require.NoError(t, tf.CreateOrUpdateSpender("spender5", []string{"resource3"}))
+	require.NoError(t, tf.UpdateSpenderParents("spender5", []string{"spender3"}, []string{}))
+
+	require.Equal(t, &spenddag.Statistics{
+		Spenders:        5,
+		SpendSets:       3,
+		PendingSpenders: 5,
+		MaxDepth:        3,
+		MaxSpendSetSize: 2,
+	}, tf.Instance.Statistics())
+
+	require.NoError(t, tf.CastVotes("nodeID1", 1, "spender2"))
+	require.NoError(t, tf.CastVotes("nodeID2", 1, "spender2"))
+	require.NoError(t, tf.CastVotes("nodeID3", 1, "spender2"))
+	tf.Assert.Accepted("spender2")
+	tf.Assert.Rejected("spender1", "spender3", "spender5")
+
+	require.Equal(t, &spenddag.Statistics{
+		Spenders:         5,
+		SpendSets:        3,
+		PendingSpenders:  1,
+		AcceptedSpenders: 1,
+		RejectedSpenders: 3,
+		MaxDepth:         3,
+		MaxSpendSetSize:  2,
+	}, tf.Instance.Statistics())
+}
+
+func TestSpendDAG_LargeSpendSet(t *testing.T) {
+	tf := newTestFramework(t)
+
+	tf.Accounts.CreateID("nodeID1")
+	tf.Accounts.CreateID("nodeID2")
+	tf.Accounts.CreateID("nodeID3")
+
+	spenderAliases := make([]string, 100)
+	for i := range spenderAliases {
+		spenderAliases[i] = fmt.Sprintf("spender%d", i)
+
+		require.NoError(t, tf.CreateOrUpdateSpender(spenderAliases[i], []string{"resource1"}))
+	}
+
+	// every spender is tracked individually, no matter how many spenders joined the SpendSet before it.
+	tf.Assert.SpendSetMembers("resource1", spenderAliases...)
+	tf.Assert.Pending(spenderAliases...)
+	require.Equal(t, 100, tf.Instance.Statistics().MaxSpendSetSize)
+
+	lastSpender := spenderAliases[len(spenderAliases)-1]
+	conflictingSpenders, exists := tf.Instance.ConflictingSpenders(tf.SpenderID(lastSpender))
+	require.True(t, exists)
+	require.Equal(t, len(spenderAliases)-1, conflictingSpenders.Size())
+
+	// the spender that arrived last can still be accepted by the votes of the committee.
+	require.NoError(t, tf.CastVotes("nodeID1", 1, lastSpender))
+	require.NoError(t, tf.CastVotes("nodeID2", 1, lastSpender))
+	require.NoError(t, tf.CastVotes("nodeID3", 1, lastSpender))
+	tf.Assert.Accepted(lastSpender)
+	tf.Assert.Rejected(spenderAliases[:len(spenderAliases)-1]...)
+}
+
 // transactionID creates a (made up) TransactionID from the given alias.
 func transactionID(alias string) iotago.TransactionID {
 	result := iotago.TransactionIDRepresentingData(TestTransactionCreationSlot, []byte(alias))
@@ -49,6 +125,33 @@ func outputID(alias string) iotago.OutputID {
 	return iotago.OutputIDFromTransactionIDAndIndex(iotago.TransactionIDRepresentingData(TestTransactionCreationSlot, []byte(alias)), 1)
 }
 
+func TestSpendDAG_MergeToMaster(t *testing.T) {
+	for _, mergeToMaster := range []bool{true, false} {
+		t.Run(fmt.Sprintf("mergeToMaster=%t", mergeToMaster), func(t *testing.T) {
+			accountsTestFramework := tests.NewAccountsTestFramework(t, account.NewAccounts())
+			spendDAG := New[iotago.TransactionID, iotago.OutputID, vote.MockedRank](accountsTestFramework.Committee.SeatCount, WithMergeToMaster[iotago.TransactionID, iotago.OutputID, vote.MockedRank](mergeToMaster))
+			tf := tests.NewFramework(t, spendDAG, accountsTestFramework, transactionID, outputID)
+
+			require.NoError(t, tf.CreateOrUpdateSpender("spender1", []string{"resource1"}))
+			require.NoError(t, tf.CreateOrUpdateSpender("spender2", []string{"resource1"}))
+			require.NoError(t, tf.CreateOrUpdateSpender("spender3", []string{"resource2"}))
+
+			spendDAG.SetAccepted(tf.SpenderID("spender1"))
+			tf.Assert.Accepted("spender1")
+
+			if mergeToMaster {
+				require.True(t, tf.SpenderIDs("spender2", "spender3").Equals(spendDAG.UnacceptedSpenders(tf.SpenderIDs("spender1", "spender2", "spender3"))))
+			} else {
+				require.True(t, tf.SpenderIDs("spender1", "spender2", "spender3").Equals(spendDAG.UnacceptedSpenders(tf.SpenderIDs("spender1", "spender2", "spender3"))))
+			}
+
+			// evicted spenders are never inherited.
+			tf.EvictSpender("spender3")
+			require.False(t, spendDAG.UnacceptedSpenders(tf.SpenderIDs("spender3")).Has(tf.SpenderID("spender3")))
+		})
+	}
+}
+
 func TestMemoryRelease(t *testing.T) {
 	//t.Skip("skip memory test as for some reason it's failing")
 	tf := newTestFramework(t)
@@ -0,0 +1,27 @@
+package spenddag
+
+// Statistics contains aggregated statistics about the Spenders and SpendSets that are currently tracked by a SpendDAG.
+type Statistics struct {
+	// Spenders contains the number of Spenders that are tracked by the SpendDAG.
+	Spenders int
+
+	// SpendSets contains the number of SpendSets that are tracked by the SpendDAG.
+	SpendSets int
+
+	// PendingSpenders contains the number of tracked Spenders that are neither accepted nor rejected.
+	PendingSpenders int
+
+	// AcceptedSpenders contains the number of tracked Spenders that are accepted.
+	AcceptedSpenders int
+
+	// RejectedSpenders contains the number of tracked Spenders that are rejected.
+	RejectedSpenders int
+
+	// MaxDepth contains the length of the longest chain of Spenders that inherit from each other (a Spender without
+	// parents has a depth of 1).
+	MaxDepth int
+
+	// MaxSpendSetSize contains the number of Spenders of the largest SpendSet (i.e. the highest number of Spenders that
+	// conflict over the same resource).
+	MaxSpendSetSize int
+}
@@ -0,0 +1,64 @@
+package mempool
+
+import (
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// StateDiffChangeType is the type of StateDiffChange.
+type StateDiffChangeType uint8
+
+const (
+	// StateDiffTransactionAdded is emitted when a transaction was added to the executed transactions of a StateDiff.
+	StateDiffTransactionAdded StateDiffChangeType = iota + 1
+
+	// StateDiffTransactionRemoved is emitted when a transaction was removed from the executed transactions of a
+	// StateDiff (i.e. because it was rolled back or moved to a different slot).
+	StateDiffTransactionRemoved
+
+	// StateDiffReset is emitted when a StateDiff was discarded because the MemPool was reset.
+	StateDiffReset
+
+	// StateDiffEvicted is emitted when a StateDiff was evicted because its slot was committed.
+	StateDiffEvicted
+)
+
+// String returns a human-readable representation of the StateDiffChangeType.
+func (s StateDiffChangeType) String() string {
+	switch s {
+	case StateDiffTransactionAdded:
+		return "transaction added"
+	case StateDiffTransactionRemoved:
+		return "transaction removed"
+	case StateDiffReset:
+		return "reset"
+	case StateDiffEvicted:
+		return "evicted"
+	default:
+		return "unknown"
+	}
+}
+
+// StateDiffChange is an incremental change of the StateDiff of a pending (uncommitted) slot. Applying all changes of a
+// slot in the order they were emitted results in the same compacted state changes that are returned by the StateDiff.
+type StateDiffChange struct {
+	// Type is the type of the change.
+	Type StateDiffChangeType
+
+	// Slot is the slot of the StateDiff that was changed.
+	Slot iotago.SlotIndex
+
+	// Transaction is the transaction that was added or removed (nil for resets and evictions).
+	Transaction TransactionMetadata
+
+	// CreatedStatesAdded contains the states that were added to the created states of the StateDiff.
+	CreatedStatesAdded []StateMetadata
+
+	// CreatedStatesRemoved contains the states that were removed from the created states of the StateDiff.
+	CreatedStatesRemoved []StateMetadata
+
+	// DestroyedStatesAdded contains the states that were added to the destroyed states of the StateDiff.
+	DestroyedStatesAdded []StateMetadata
+
+	// DestroyedStatesRemoved contains the states that were removed from the destroyed states of the StateDiff.
+	DestroyedStatesRemoved []StateMetadata
+}
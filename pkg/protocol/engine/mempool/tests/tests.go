@@ -2,9 +2,12 @@ package mempooltests
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/iotaledger/hive.go/ds"
+	"github.com/iotaledger/hive.go/ierrors"
 	"github.com/iotaledger/hive.go/lo"
 	"github.com/iotaledger/hive.go/runtime/debug"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/mempool"
@@ -25,6 +28,15 @@ func TestAll(t *testing.T, frameworkProvider func(*testing.T) *TestFramework) {
 		"TestSetTransactionOrphanage":              TestSetTransactionOrphanage,
 		"TestInvalidTransaction":                   TestInvalidTransaction,
 		"TestStoreAttachmentInEvictedSlot":         TestStoreAttachmentInEvictedSlot,
+		"TestAwaitTransactionState":                TestAwaitTransactionState,
+		"TestAwaitTransactionStateCommitted":       TestAwaitTransactionStateCommitted,
+		"TestConflictGroup":                        TestConflictGroup,
+		"TestReattachTransaction":                  TestReattachTransaction,
+		"TestReattachResolvedTransaction":          TestReattachResolvedTransaction,
+		"TestForEachPendingTransaction":            TestForEachPendingTransaction,
+		"TestStateDiffChanges":                     TestStateDiffChanges,
+		"TestStateDiffChangesRolledBack":           TestStateDiffChangesRolledBack,
+		"TestStateDiffChangesOrphaned":             TestStateDiffChangesOrphaned,
 	} {
 		t.Run(testName, func(t *testing.T) { testCase(t, frameworkProvider(t)) })
 	}
@@ -297,6 +309,12 @@ func TestInvalidTransaction(t *testing.T, tf *TestFramework) {
 	require.NoError(t, tf.AttachTransaction("tx1-signed", "tx1", "block2", 1))
 
 	tf.RequireInvalid("tx1")
+
+	quarantinedTransaction, exists := tf.Instance.QuarantinedTransaction(tf.TransactionID("tx1"))
+	require.True(t, exists)
+	require.Error(t, quarantinedTransaction.Reason)
+	require.Equal(t, tf.BlockID("block2"), quarantinedTransaction.FirstAttachment)
+	require.Equal(t, []*mempool.QuarantinedTransaction{quarantinedTransaction}, tf.Instance.QuarantinedTransactions())
 }
 
 func TestStoreAttachmentInEvictedSlot(t *testing.T, tf *TestFramework) {
@@ -310,3 +328,491 @@ func TestStoreAttachmentInEvictedSlot(t *testing.T, tf *TestFramework) {
 
 	require.False(t, lo.Return2(tf.TransactionMetadata("tx1")))
 }
+
+func TestAwaitTransactionState(t *testing.T, tf *TestFramework) {
+	debug.SetEnabled(true)
+	defer debug.SetEnabled(false)
+
+	requireResolved := func(result <-chan error, expectedErr error) {
+		select {
+		case err := <-result:
+			if expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, expectedErr)
+			}
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "transaction state was not resolved in time")
+		}
+	}
+
+	tf.CreateSignedTransaction("tx1", []string{"genesis"}, 1)
+	tf.CreateSignedTransaction("tx2", []string{"tx1:0"}, 1)
+	tf.CreateSignedTransaction("tx3", []string{"genesis"}, 1, true)
+
+	// the transactions are not known yet, so the awaiters wait for them to be attached.
+	tx1Orphaned := tf.Instance.AwaitTransactionState(tf.TransactionID("tx1"), mempool.TransactionStateOrphaned, time.Minute)
+	tx1Accepted := tf.Instance.AwaitTransactionState(tf.TransactionID("tx1"), mempool.TransactionStateAccepted, time.Minute)
+	tx2Accepted := tf.Instance.AwaitTransactionState(tf.TransactionID("tx2"), mempool.TransactionStateAccepted, 10*time.Millisecond)
+
+	require.NoError(t, tf.AttachTransaction("tx1-signed", "tx1", "block1", 1))
+	require.NoError(t, tf.AttachTransaction("tx2-signed", "tx2", "block2", 2))
+	require.NoError(t, tf.AttachTransaction("tx3-signed", "tx3", "block3", 2))
+	tf.RequireBooked("tx1", "tx2")
+	tf.RequireInvalid("tx3")
+
+	requireResolved(tx2Accepted, mempool.ErrTransactionStateTimeout)
+	requireResolved(tf.Instance.AwaitTransactionState(tf.TransactionID("tx3"), mempool.TransactionStateAccepted, time.Minute), mempool.ErrTransactionStateUnreachable)
+
+	tf.Instance.Evict(1)
+
+	requireResolved(tx1Orphaned, nil)
+	requireResolved(tx1Accepted, mempool.ErrTransactionStateUnreachable)
+
+	// the state is resolved immediately if the transaction already reached it.
+	requireResolved(tf.Instance.AwaitTransactionState(tf.TransactionID("tx1"), mempool.TransactionStateOrphaned, time.Minute), nil)
+}
+
+func TestAwaitTransactionStateCommitted(t *testing.T, tf *TestFramework) {
+	debug.SetEnabled(true)
+	defer debug.SetEnabled(false)
+
+	requireResolved := func(result <-chan error, expectedErr error) {
+		select {
+		case err := <-result:
+			if expectedErr == nil {
+				require.NoError(t, err)
+			} else {
+				require.ErrorIs(t, err, expectedErr)
+			}
+		case <-time.After(5 * time.Second):
+			require.FailNow(t, "transaction state was not resolved in time")
+		}
+	}
+
+	tf.CreateSignedTransaction("tx1", []string{"genesis"}, 1)
+
+	// transactions that are never attached time out.
+	requireResolved(tf.Instance.AwaitTransactionState(tf.TransactionID("tx1"), mempool.TransactionStateAccepted, 10*time.Millisecond), mempool.ErrTransactionStateTimeout)
+
+	require.NoError(t, tf.AttachTransaction("tx1-signed", "tx1", "block1", 1))
+	tf.RequireBooked("tx1")
+
+	tx1Accepted := tf.Instance.AwaitTransactionState(tf.TransactionID("tx1"), mempool.TransactionStateAccepted, time.Minute)
+	tx1Committed := tf.Instance.AwaitTransactionState(tf.TransactionID("tx1"), mempool.TransactionStateCommitted, time.Minute)
+	tx1Orphaned := tf.Instance.AwaitTransactionState(tf.TransactionID("tx1"), mempool.TransactionStateOrphaned, time.Minute)
+
+	require.True(t, tf.MarkAttachmentIncluded("block1"))
+	tf.SpendDAG.SetAccepted(tf.TransactionID("tx1"))
+	tf.RequireAccepted(map[string]bool{"tx1": true})
+
+	requireResolved(tx1Accepted, nil)
+
+	tf.CommitSlot(1)
+
+	requireResolved(tx1Committed, nil)
+	requireResolved(tx1Orphaned, mempool.ErrTransactionStateUnreachable)
+
+	tf.Instance.Evict(1)
+}
+
+func TestConflictGroup(t *testing.T, tf *TestFramework) {
+	debug.SetEnabled(true)
+	defer debug.SetEnabled(false)
+
+	requireConflictGroup := func(transactionAlias string, expectedAliases ...string) {
+		transactions, exists := tf.Instance.ConflictGroup(tf.TransactionID(transactionAlias))
+		require.True(t, exists)
+
+		require.ElementsMatch(t, lo.Map(expectedAliases, tf.TransactionID), lo.Map(transactions, mempool.TransactionMetadata.ID))
+	}
+
+	tf.CreateSignedTransaction("tx1", []string{"genesis"}, 2)
+	tf.CreateSignedTransaction("tx2", []string{"tx1:0"}, 1)
+	tf.CreateSignedTransaction("tx2*", []string{"tx1:0"}, 1)
+	tf.CreateSignedTransaction("tx3", []string{"tx2:0"}, 1)
+	tf.CreateSignedTransaction("tx4", []string{"tx1:1"}, 1)
+
+	require.NoError(t, tf.AttachTransaction("tx1-signed", "tx1", "block1", 1))
+	require.NoError(t, tf.AttachTransaction("tx2-signed", "tx2", "block2", 2))
+	require.NoError(t, tf.AttachTransaction("tx2*-signed", "tx2*", "block2*", 2))
+	require.NoError(t, tf.AttachTransaction("tx3-signed", "tx3", "block3", 3))
+	require.NoError(t, tf.AttachTransaction("tx4-signed", "tx4", "block4", 3))
+	tf.RequireBooked("tx1", "tx2", "tx2*", "tx3", "tx4")
+
+	// the conflict group contains the competing conflicts and their future cone.
+	requireConflictGroup("tx2", "tx2", "tx2*", "tx3")
+	requireConflictGroup("tx2*", "tx2", "tx2*", "tx3")
+	requireConflictGroup("tx3", "tx2", "tx2*", "tx3")
+
+	// non-conflicting transactions only belong to their own group.
+	requireConflictGroup("tx1", "tx1")
+	requireConflictGroup("tx4", "tx4")
+
+	// the transactions of the group expose their attachments.
+	transactions, _ := tf.Instance.ConflictGroup(tf.TransactionID("tx2*"))
+	for _, transaction := range transactions {
+		if transaction.ID() == tf.TransactionID("tx2*") {
+			require.Equal(t, []iotago.BlockID{tf.BlockID("block2*")}, transaction.ValidAttachments())
+		}
+	}
+
+	_, exists := tf.Instance.ConflictGroup(iotago.TransactionIDRepresentingData(0, []byte("unknown")))
+	require.False(t, exists)
+
+	// accepted and rejected transactions are no longer pending, so only the pending future cone remains in the group.
+	require.True(t, tf.MarkAttachmentIncluded("block1"))
+	require.True(t, tf.MarkAttachmentIncluded("block2"))
+	tf.SpendDAG.SetAccepted(tf.TransactionID("tx1"))
+	tf.SpendDAG.SetAccepted(tf.TransactionID("tx2"))
+	tf.RequireAccepted(map[string]bool{"tx1": true, "tx2": true, "tx2*": false, "tx3": false})
+	require.True(t, lo.Return1(tf.TransactionMetadata("tx2*")).IsRejected())
+
+	requireConflictGroup("tx2", "tx3")
+	requireConflictGroup("tx2*", "tx3")
+	requireConflictGroup("tx3", "tx3")
+}
+
+func TestForEachPendingTransaction(t *testing.T, tf *TestFramework) {
+	debug.SetEnabled(true)
+	defer debug.SetEnabled(false)
+
+	pendingTransactionIDs := func(consumer func(transaction mempool.TransactionMetadata) bool) []iotago.TransactionID {
+		transactionIDs := make([]iotago.TransactionID, 0)
+		tf.Instance.ForEachPendingTransaction(func(transaction mempool.TransactionMetadata) bool {
+			transactionIDs = append(transactionIDs, transaction.ID())
+
+			return consumer(transaction)
+		})
+
+		return transactionIDs
+	}
+
+	tf.CreateSignedTransaction("tx1", []string{"genesis"}, 1)
+	tf.CreateSignedTransaction("tx2", []string{"tx1:0"}, 1)
+	tf.CreateSignedTransaction("tx3", []string{"tx2:0"}, 1)
+
+	require.NoError(t, tf.AttachTransaction("tx1-signed", "tx1", "block1", 1))
+	require.NoError(t, tf.AttachTransaction("tx2-signed", "tx2", "block2", 2))
+	tf.RequireBooked("tx1", "tx2")
+
+	// all pending transactions are consumed.
+	require.ElementsMatch(t, lo.Map([]string{"tx1", "tx2"}, tf.TransactionID), pendingTransactionIDs(func(mempool.TransactionMetadata) bool { return true }))
+
+	// the iteration stops if the consumer returns false.
+	require.Len(t, pendingTransactionIDs(func(mempool.TransactionMetadata) bool { return false }), 1)
+
+	// transactions that are attached while iterating are not part of the snapshot.
+	require.ElementsMatch(t, lo.Map([]string{"tx1", "tx2"}, tf.TransactionID), pendingTransactionIDs(func(mempool.TransactionMetadata) bool {
+		if _, exists := tf.Instance.TransactionMetadata(tf.TransactionID("tx3")); !exists {
+			require.NoError(t, tf.AttachTransaction("tx3-signed", "tx3", "block3", 2))
+		}
+
+		return true
+	}))
+	tf.RequireBooked("tx3")
+	require.ElementsMatch(t, lo.Map([]string{"tx1", "tx2", "tx3"}, tf.TransactionID), pendingTransactionIDs(func(mempool.TransactionMetadata) bool { return true }))
+
+	// accepted transactions are no longer pending.
+	require.True(t, tf.MarkAttachmentIncluded("block1"))
+	tf.SpendDAG.SetAccepted(tf.TransactionID("tx1"))
+	tf.RequireAccepted(map[string]bool{"tx1": true, "tx2": false, "tx3": false})
+	require.ElementsMatch(t, lo.Map([]string{"tx2", "tx3"}, tf.TransactionID), pendingTransactionIDs(func(mempool.TransactionMetadata) bool { return true }))
+
+	// the consumer can evict slots while iterating and the transactions that get orphaned are still consumed.
+	require.ElementsMatch(t, lo.Map([]string{"tx2", "tx3"}, tf.TransactionID), pendingTransactionIDs(func(transaction mempool.TransactionMetadata) bool {
+		tf.Instance.Evict(1)
+		tf.Instance.Evict(2)
+
+		require.True(t, lo.Return2(transaction.OrphanedSlot()))
+
+		return true
+	}))
+}
+
+func TestReattachTransaction(t *testing.T, tf *TestFramework) {
+	debug.SetEnabled(true)
+	defer debug.SetEnabled(false)
+
+	tf.CreateSignedTransaction("tx1", []string{"genesis"}, 1)
+	tf.CreateSignedTransaction("tx2", []string{"genesis"}, 1, true)
+
+	require.NoError(t, tf.AttachTransaction("tx1-signed", "tx1", "block1", 1))
+	require.NoError(t, tf.AttachTransaction("tx2-signed", "tx2", "block2", 1))
+	tf.RequireBooked("tx1")
+	tf.RequireInvalid("tx2")
+
+	reattachment := iotago.BlockIDRepresentingData(2, []byte("block1*"))
+	reattachment.RegisterAlias("block1*")
+
+	signedTransactionMetadata, err := tf.Instance.ReattachTransaction(tf.TransactionID("tx1"), func(signedTransaction mempool.SignedTransaction) (iotago.BlockID, error) {
+		require.Equal(t, tf.SignedTransactionID("tx1-signed"), lo.PanicOnErr(signedTransaction.ID()))
+
+		return reattachment, nil
+	})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []iotago.BlockID{tf.BlockID("block1"), reattachment}, signedTransactionMetadata.Attachments())
+
+	reattachedTransaction, exists := tf.Instance.TransactionMetadataByAttachment(reattachment)
+	require.True(t, exists)
+	require.Equal(t, tf.TransactionID("tx1"), reattachedTransaction.ID())
+
+	// the attachment is not registered if the block could not be issued.
+	issueErr := ierrors.New("issue failed")
+	_, err = tf.Instance.ReattachTransaction(tf.TransactionID("tx1"), func(mempool.SignedTransaction) (iotago.BlockID, error) {
+		return iotago.EmptyBlockID, issueErr
+	})
+	require.ErrorIs(t, err, issueErr)
+	require.Len(t, signedTransactionMetadata.Attachments(), 2)
+
+	notIssued := func(mempool.SignedTransaction) (iotago.BlockID, error) {
+		require.FailNow(t, "transaction must not be issued")
+
+		return iotago.EmptyBlockID, nil
+	}
+
+	_, err = tf.Instance.ReattachTransaction(tf.TransactionID("tx2"), notIssued)
+	require.ErrorIs(t, err, mempool.ErrTransactionNotReattachable)
+
+	_, err = tf.Instance.ReattachTransaction(iotago.TransactionIDRepresentingData(0, []byte("unknown")), notIssued)
+	require.ErrorIs(t, err, mempool.ErrTransactionNotFound)
+
+	// orphaned transactions can not be reattached.
+	tf.Instance.Evict(1)
+	tf.Instance.Evict(2)
+
+	_, err = tf.Instance.ReattachTransaction(tf.TransactionID("tx1"), notIssued)
+	require.ErrorIs(t, err, mempool.ErrTransactionNotReattachable)
+}
+
+func TestReattachResolvedTransaction(t *testing.T, tf *TestFramework) {
+	debug.SetEnabled(true)
+	defer debug.SetEnabled(false)
+
+	notIssued := func(mempool.SignedTransaction) (iotago.BlockID, error) {
+		require.FailNow(t, "transaction must not be issued")
+
+		return iotago.EmptyBlockID, nil
+	}
+
+	tf.CreateSignedTransaction("tx1", []string{"genesis"}, 1)
+	tf.CreateSignedTransaction("tx1*", []string{"genesis"}, 1)
+
+	require.NoError(t, tf.AttachTransaction("tx1-signed", "tx1", "block1", 1))
+	require.NoError(t, tf.AttachTransaction("tx1*-signed", "tx1*", "block1*", 1))
+	tf.RequireBooked("tx1", "tx1*")
+
+	require.True(t, tf.MarkAttachmentIncluded("block1"))
+	tf.SpendDAG.SetAccepted(tf.TransactionID("tx1"))
+	tf.RequireAccepted(map[string]bool{"tx1": true, "tx1*": false})
+	require.True(t, lo.Return1(tf.TransactionMetadata("tx1*")).IsRejected())
+
+	// rejected transactions can not be reattached.
+	_, err := tf.Instance.ReattachTransaction(tf.TransactionID("tx1*"), notIssued)
+	require.ErrorIs(t, err, mempool.ErrTransactionNotReattachable)
+
+	// committed transactions can not be reattached.
+	tf.CommitSlot(1)
+	require.True(t, lo.Return2(lo.Return1(tf.TransactionMetadata("tx1")).CommittedSlot()))
+
+	_, err = tf.Instance.ReattachTransaction(tf.TransactionID("tx1"), notIssued)
+	require.ErrorIs(t, err, mempool.ErrTransactionNotReattachable)
+}
+
+func TestStateDiffChanges(t *testing.T, tf *TestFramework) {
+	debug.SetEnabled(true)
+	defer debug.SetEnabled(false)
+
+	views, unsubscribe := subscribeStateDiffViews(tf)
+	defer unsubscribe()
+
+	tf.CreateSignedTransaction("tx1", []string{"genesis"}, 1)
+	tf.CreateSignedTransaction("tx2", []string{"tx1:0"}, 1)
+
+	require.NoError(t, tf.AttachTransaction("tx1-signed", "tx1", "block1", 1))
+	require.NoError(t, tf.AttachTransaction("tx2-signed", "tx2", "block2", 1))
+	tf.RequireBooked("tx1", "tx2")
+
+	require.True(t, tf.MarkAttachmentIncluded("block1"))
+	require.True(t, tf.MarkAttachmentIncluded("block2"))
+
+	tf.SpendDAG.SetAccepted(tf.TransactionID("tx1"))
+	tf.RequireAccepted(map[string]bool{"tx1": true, "tx2": false})
+
+	views.requireState(t, tf, 1, []string{"genesis"}, []string{"tx1:0"}, []string{"tx1"})
+
+	// the output of tx1 is consumed by tx2 within the same slot, so it is compacted away.
+	tf.SpendDAG.SetAccepted(tf.TransactionID("tx2"))
+	tf.RequireAccepted(map[string]bool{"tx1": true, "tx2": true})
+	tf.AssertStateDiff(1, []string{"genesis"}, []string{"tx2:0"}, []string{"tx1", "tx2"})
+
+	views.requireState(t, tf, 1, []string{"genesis"}, []string{"tx2:0"}, []string{"tx1", "tx2"})
+
+	tf.Instance.Evict(1)
+
+	views.requireChangeTypes(t, 1, mempool.StateDiffTransactionAdded, mempool.StateDiffTransactionAdded, mempool.StateDiffEvicted)
+	views.requireState(t, tf, 1, []string{}, []string{}, []string{})
+}
+
+func TestStateDiffChangesRolledBack(t *testing.T, tf *TestFramework) {
+	debug.SetEnabled(true)
+	defer debug.SetEnabled(false)
+
+	views, unsubscribe := subscribeStateDiffViews(tf)
+	defer unsubscribe()
+
+	tf.CreateSignedTransaction("tx1", []string{"genesis"}, 1)
+	tf.CreateSignedTransaction("tx2", []string{"tx1:0"}, 1)
+
+	require.NoError(t, tf.AttachTransaction("tx1-signed", "tx1", "block1.2", 2))
+	require.NoError(t, tf.AttachTransaction("tx2-signed", "tx2", "block2", 2))
+	require.NoError(t, tf.AttachTransaction("tx1-signed", "tx1", "block1.1", 1))
+	tf.RequireBooked("tx1", "tx2")
+
+	require.True(t, tf.MarkAttachmentIncluded("block1.2"))
+	require.True(t, tf.MarkAttachmentIncluded("block2"))
+
+	tf.SpendDAG.SetAccepted(tf.TransactionID("tx1"))
+	tf.SpendDAG.SetAccepted(tf.TransactionID("tx2"))
+	tf.RequireAccepted(map[string]bool{"tx1": true, "tx2": true})
+
+	views.requireState(t, tf, 2, []string{"genesis"}, []string{"tx2:0"}, []string{"tx1", "tx2"})
+
+	// including the earlier attachment of tx1 rolls it back from slot 2 and adds it to slot 1, which turns the output
+	// of tx1 into a destroyed state of slot 2 instead of an internal one.
+	require.True(t, tf.MarkAttachmentIncluded("block1.1"))
+	tf.AssertStateDiff(1, []string{"genesis"}, []string{"tx1:0"}, []string{"tx1"})
+	tf.AssertStateDiff(2, []string{"tx1:0"}, []string{"tx2:0"}, []string{"tx2"})
+
+	views.requireChangeTypes(t, 1, mempool.StateDiffTransactionAdded)
+	views.requireChangeTypes(t, 2, mempool.StateDiffTransactionAdded, mempool.StateDiffTransactionAdded, mempool.StateDiffTransactionRemoved)
+	views.requireState(t, tf, 1, []string{"genesis"}, []string{"tx1:0"}, []string{"tx1"})
+	views.requireState(t, tf, 2, []string{"tx1:0"}, []string{"tx2:0"}, []string{"tx2"})
+
+	// resetting the mempool discards the state diffs of all slots that were not evicted yet.
+	tf.Instance.Reset()
+
+	views.requireChangeTypes(t, 1, mempool.StateDiffTransactionAdded, mempool.StateDiffReset)
+	views.requireState(t, tf, 1, []string{}, []string{}, []string{})
+	views.requireState(t, tf, 2, []string{}, []string{}, []string{})
+}
+
+func TestStateDiffChangesOrphaned(t *testing.T, tf *TestFramework) {
+	debug.SetEnabled(true)
+	defer debug.SetEnabled(false)
+
+	views, unsubscribe := subscribeStateDiffViews(tf)
+	defer unsubscribe()
+
+	tf.CreateSignedTransaction("tx1", []string{"genesis"}, 1)
+	tf.CreateSignedTransaction("tx2", []string{"tx1:0"}, 1)
+
+	require.NoError(t, tf.AttachTransaction("tx1-signed", "tx1", "block1", 2))
+	require.NoError(t, tf.AttachTransaction("tx2-signed", "tx2", "block2", 1))
+	tf.RequireBooked("tx1", "tx2")
+
+	require.True(t, tf.MarkAttachmentIncluded("block1"))
+
+	tf.SpendDAG.SetAccepted(tf.TransactionID("tx1"))
+	tf.SpendDAG.SetAccepted(tf.TransactionID("tx2"))
+	tf.RequireAccepted(map[string]bool{"tx1": true, "tx2": false})
+
+	tx2Metadata, exists := tf.TransactionMetadata("tx2")
+	require.True(t, exists)
+
+	// the only attachment of tx2 is evicted without being included, so tx2 is orphaned without ever being added to a
+	// state diff.
+	tf.Instance.Evict(1)
+	require.True(t, lo.Return2(tx2Metadata.OrphanedSlot()))
+
+	tf.AssertStateDiff(2, []string{"genesis"}, []string{"tx1:0"}, []string{"tx1"})
+
+	require.Empty(t, views.changeTypes(1))
+	views.requireChangeTypes(t, 2, mempool.StateDiffTransactionAdded)
+	views.requireState(t, tf, 2, []string{"genesis"}, []string{"tx1:0"}, []string{"tx1"})
+
+	for _, change := range views.changes {
+		require.NotEqual(t, tf.TransactionID("tx2"), change.Transaction.ID())
+	}
+}
+
+// stateDiffViews maintains a live view of the state diffs of all slots from the emitted StateDiffChanges.
+type stateDiffViews struct {
+	changes              []*mempool.StateDiffChange
+	createdStates        map[iotago.SlotIndex]ds.Set[mempool.StateID]
+	destroyedStates      map[iotago.SlotIndex]ds.Set[mempool.StateID]
+	executedTransactions map[iotago.SlotIndex]ds.Set[iotago.TransactionID]
+}
+
+func subscribeStateDiffViews(tf *TestFramework) (views *stateDiffViews, unsubscribe func()) {
+	views = &stateDiffViews{
+		createdStates:        make(map[iotago.SlotIndex]ds.Set[mempool.StateID]),
+		destroyedStates:      make(map[iotago.SlotIndex]ds.Set[mempool.StateID]),
+		executedTransactions: make(map[iotago.SlotIndex]ds.Set[iotago.TransactionID]),
+	}
+
+	return views, tf.Instance.OnStateDiffChanged(views.apply)
+}
+
+func (v *stateDiffViews) apply(change *mempool.StateDiffChange) {
+	v.changes = append(v.changes, change)
+
+	createdStates, destroyedStates, executedTransactions := v.slot(change.Slot)
+
+	switch change.Type {
+	case mempool.StateDiffTransactionAdded:
+		executedTransactions.Add(change.Transaction.ID())
+	case mempool.StateDiffTransactionRemoved:
+		executedTransactions.Delete(change.Transaction.ID())
+	case mempool.StateDiffReset, mempool.StateDiffEvicted:
+		createdStates.Clear()
+		destroyedStates.Clear()
+		executedTransactions.Clear()
+	}
+
+	for _, state := range change.CreatedStatesAdded {
+		createdStates.Add(state.State().StateID())
+	}
+	for _, state := range change.CreatedStatesRemoved {
+		createdStates.Delete(state.State().StateID())
+	}
+	for _, state := range change.DestroyedStatesAdded {
+		destroyedStates.Add(state.State().StateID())
+	}
+	for _, state := range change.DestroyedStatesRemoved {
+		destroyedStates.Delete(state.State().StateID())
+	}
+}
+
+func (v *stateDiffViews) slot(slot iotago.SlotIndex) (createdStates ds.Set[mempool.StateID], destroyedStates ds.Set[mempool.StateID], executedTransactions ds.Set[iotago.TransactionID]) {
+	if _, exists := v.createdStates[slot]; !exists {
+		v.createdStates[slot] = ds.NewSet[mempool.StateID]()
+		v.destroyedStates[slot] = ds.NewSet[mempool.StateID]()
+		v.executedTransactions[slot] = ds.NewSet[iotago.TransactionID]()
+	}
+
+	return v.createdStates[slot], v.destroyedStates[slot], v.executedTransactions[slot]
+}
+
+func (v *stateDiffViews) changeTypes(slot iotago.SlotIndex) []mempool.StateDiffChangeType {
+	changeTypes := make([]mempool.StateDiffChangeType, 0)
+	for _, change := range v.changes {
+		if change.Slot == slot {
+			changeTypes = append(changeTypes, change.Type)
+		}
+	}
+
+	return changeTypes
+}
+
+func (v *stateDiffViews) requireChangeTypes(t *testing.T, slot iotago.SlotIndex, expectedChangeTypes ...mempool.StateDiffChangeType) {
+	require.Equal(t, expectedChangeTypes, v.changeTypes(slot), "unexpected changes of slot %d", slot)
+}
+
+func (v *stateDiffViews) requireState(t *testing.T, tf *TestFramework, slot iotago.SlotIndex, destroyedStateAliases, createdStateAliases, transactionAliases []string) {
+	createdStates, destroyedStates, executedTransactions := v.slot(slot)
+
+	require.ElementsMatch(t, lo.Map(destroyedStateAliases, tf.StateID), destroyedStates.ToSlice(), "unexpected destroyed states of slot %d", slot)
+	require.ElementsMatch(t, lo.Map(createdStateAliases, tf.StateID), createdStates.ToSlice(), "unexpected created states of slot %d", slot)
+	require.ElementsMatch(t, lo.Map(transactionAliases, tf.TransactionID), executedTransactions.ToSlice(), "unexpected transactions of slot %d", slot)
+}
@@ -0,0 +1,29 @@
+package mempool
+
+// TransactionState is a state of a transaction that can be awaited with MemPool.AwaitTransactionState.
+type TransactionState uint8
+
+const (
+	// TransactionStateAccepted is reached when the transaction is accepted.
+	TransactionStateAccepted TransactionState = iota + 1
+
+	// TransactionStateCommitted is reached when the transaction is committed in a slot.
+	TransactionStateCommitted
+
+	// TransactionStateOrphaned is reached when all attachments of the transaction are orphaned.
+	TransactionStateOrphaned
+)
+
+// String returns a human-readable representation of the TransactionState.
+func (s TransactionState) String() string {
+	switch s {
+	case TransactionStateAccepted:
+		return "accepted"
+	case TransactionStateCommitted:
+		return "committed"
+	case TransactionStateOrphaned:
+		return "orphaned"
+	default:
+		return "unknown"
+	}
+}
@@ -0,0 +1,122 @@
+package mempoolv1
+
+import (
+	"github.com/iotaledger/hive.go/ds"
+	"github.com/iotaledger/hive.go/ds/priorityqueue"
+	"github.com/iotaledger/hive.go/runtime/syncutils"
+	"github.com/iotaledger/hive.go/runtime/workerpool"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// conflictUpdateQueue is a prioritized queue of the spenders whose parents were updated by a fork of the SpendDAG.
+//
+// The transactions in the future cone of a queued spender are re-evaluated by a dedicated worker, so that their
+// acceptance flags converge quickly after a fork instead of being delayed by the execution of other transactions. The
+// spenders of transactions that were included earlier are processed first, as they are the closest to being committed.
+type conflictUpdateQueue struct {
+	// queue contains the queued spenders ordered by their priority.
+	queue *priorityqueue.PriorityQueue[iotago.TransactionID, conflictUpdatePriority]
+
+	// queuedSpenders contains the spenders that are currently queued to not queue them multiple times.
+	queuedSpenders ds.Set[iotago.TransactionID]
+
+	// sequenceNumber is used to process spenders with the same inclusion slot in the order they were queued.
+	sequenceNumber uint64
+
+	// workers is the worker pool that processes the queued spenders.
+	workers *workerpool.WorkerPool
+
+	// processSpender is the function that re-evaluates the future cone of a queued spender.
+	processSpender func(spenderID iotago.TransactionID)
+
+	// mutex is used to synchronize the queueing of spenders.
+	mutex syncutils.Mutex
+}
+
+// newConflictUpdateQueue creates a new conflictUpdateQueue that processes the queued spenders with the given function
+// in the given worker pool.
+func newConflictUpdateQueue(workers *workerpool.WorkerPool, processSpender func(spenderID iotago.TransactionID)) *conflictUpdateQueue {
+	return &conflictUpdateQueue{
+		queue:          priorityqueue.New[iotago.TransactionID, conflictUpdatePriority](),
+		queuedSpenders: ds.NewSet[iotago.TransactionID](),
+		workers:        workers,
+		processSpender: processSpender,
+	}
+}
+
+// Push queues the given spender with the slot of the earliest included attachment of its transaction (0 if the
+// transaction is not included yet) unless it is queued already.
+func (c *conflictUpdateQueue) Push(spenderID iotago.TransactionID, inclusionSlot iotago.SlotIndex) {
+	c.mutex.Lock()
+	defer c.mutex.Unlock()
+
+	if !c.queuedSpenders.Add(spenderID) {
+		return
+	}
+
+	c.sequenceNumber++
+	c.queue.Push(spenderID, conflictUpdatePriority{inclusionSlot: inclusionSlot, sequenceNumber: c.sequenceNumber})
+
+	// every submitted task processes the spender with the highest priority at the time it is executed.
+	c.workers.Submit(c.processNext)
+}
+
+// Size returns the number of queued spenders.
+func (c *conflictUpdateQueue) Size() int {
+	return c.queue.Size()
+}
+
+// processNext processes the queued spender with the highest priority.
+func (c *conflictUpdateQueue) processNext() {
+	spenderID, exists := func() (iotago.TransactionID, bool) {
+		c.mutex.Lock()
+		defer c.mutex.Unlock()
+
+		spenderID, exists := c.queue.Pop()
+		if exists {
+			// the spender is removed before it is processed, so that updates during the processing queue it again.
+			c.queuedSpenders.Delete(spenderID)
+		}
+
+		return spenderID, exists
+	}()
+
+	if exists {
+		c.processSpender(spenderID)
+	}
+}
+
+// conflictUpdatePriority is the priority of a queued spender.
+type conflictUpdatePriority struct {
+	// inclusionSlot is the slot of the earliest included attachment of the transaction (0 if it is not included).
+	inclusionSlot iotago.SlotIndex
+
+	// sequenceNumber is the position of the spender in the order of queueing.
+	sequenceNumber uint64
+}
+
+// CompareTo returns a negative value if the priority is higher than the given priority (the spenders of transactions
+// that were included earlier come first, the spenders of transactions that are not included come last).
+func (c conflictUpdatePriority) CompareTo(other conflictUpdatePriority) int {
+	if c.inclusionSlot != other.inclusionSlot {
+		switch {
+		case c.inclusionSlot == 0:
+			return 1
+		case other.inclusionSlot == 0:
+			return -1
+		case c.inclusionSlot < other.inclusionSlot:
+			return -1
+		default:
+			return 1
+		}
+	}
+
+	switch {
+	case c.sequenceNumber < other.sequenceNumber:
+		return -1
+	case c.sequenceNumber > other.sequenceNumber:
+		return 1
+	default:
+		return 0
+	}
+}
@@ -0,0 +1,51 @@
+package mempoolv1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/runtime/workerpool"
+	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/tpkg"
+)
+
+func TestConflictUpdateQueue(t *testing.T) {
+	workers := workerpool.New(t.Name(), workerpool.WithWorkerCount(1)).Start()
+	defer workers.Shutdown()
+
+	processedSpenders := make([]iotago.TransactionID, 0)
+	queue := newConflictUpdateQueue(workers, func(spenderID iotago.TransactionID) {
+		processedSpenders = append(processedSpenders, spenderID)
+	})
+
+	// block the only worker, so that all spenders are queued before the first one is processed.
+	release := make(chan struct{})
+	workers.Submit(func() { <-release })
+
+	notIncluded := tpkg.RandTransactionID()
+	includedLate := tpkg.RandTransactionID()
+	includedEarly1 := tpkg.RandTransactionID()
+	includedEarly2 := tpkg.RandTransactionID()
+
+	queue.Push(notIncluded, 0)
+	queue.Push(includedLate, 7)
+	queue.Push(includedEarly1, 3)
+	queue.Push(includedEarly2, 3)
+
+	// spenders that are queued already are not queued again.
+	queue.Push(includedLate, 7)
+	require.Equal(t, 4, queue.Size())
+
+	close(release)
+	workers.PendingTasksCounter.WaitIsZero()
+
+	require.Equal(t, []iotago.TransactionID{includedEarly1, includedEarly2, includedLate, notIncluded}, processedSpenders)
+	require.Zero(t, queue.Size())
+
+	// processed spenders can be queued again.
+	queue.Push(includedLate, 7)
+	workers.PendingTasksCounter.WaitIsZero()
+
+	require.Equal(t, includedLate, processedSpenders[len(processedSpenders)-1])
+}
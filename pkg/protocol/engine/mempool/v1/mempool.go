@@ -2,10 +2,13 @@ package mempoolv1
 
 import (
 	"context"
+	"sync"
+	"time"
 
 	"github.com/iotaledger/hive.go/core/memstorage"
 	"github.com/iotaledger/hive.go/ds"
 	"github.com/iotaledger/hive.go/ds/shrinkingmap"
+	"github.com/iotaledger/hive.go/ds/walker"
 	"github.com/iotaledger/hive.go/ierrors"
 	"github.com/iotaledger/hive.go/kvstore"
 	"github.com/iotaledger/hive.go/lo"
@@ -15,6 +18,7 @@ import (
 	"github.com/iotaledger/hive.go/runtime/workerpool"
 	"github.com/iotaledger/iota-core/pkg/core/promise"
 	"github.com/iotaledger/iota-core/pkg/core/vote"
+	"github.com/iotaledger/iota-core/pkg/profiling"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/mempool"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/mempool/spenddag"
 	iotago "github.com/iotaledger/iota.go/v4"
@@ -63,6 +67,12 @@ type MemPool[VoteRank spenddag.VoteRankType[VoteRank]] struct {
 	// executionWorkers is the worker pool that is used to execute the state transitions of transactions.
 	executionWorkers *workerpool.WorkerPool
 
+	// conflictUpdateWorkers is the worker pool that is used to re-evaluate the transactions after forks of the SpendDAG.
+	conflictUpdateWorkers *workerpool.WorkerPool
+
+	// conflictUpdates is the prioritized queue of the spenders whose parents were updated by a fork of the SpendDAG.
+	conflictUpdates *conflictUpdateQueue
+
 	// lastEvictedSlot is the last slot that was evicted from the MemPool.
 	lastEvictedSlot iotago.SlotIndex
 
@@ -72,6 +82,17 @@ type MemPool[VoteRank spenddag.VoteRankType[VoteRank]] struct {
 	signedTransactionAttached *event.Event1[mempool.SignedTransactionMetadata]
 
 	transactionAttached *event.Event1[mempool.TransactionMetadata]
+
+	stateDiffChanged *event.Event1[*mempool.StateDiffChange]
+
+	// quarantine retains the latest transactions that turned invalid for inspection.
+	quarantine *quarantine
+
+	// optsQuarantineSize is the maximum number of invalid transactions that are retained in the quarantine.
+	optsQuarantineSize int
+
+	// writeAheadLog records the attached transactions, so that they can be replayed after a restart (nil = disabled).
+	writeAheadLog *writeAheadLog
 }
 
 // New is the constructor of the MemPool.
@@ -94,7 +115,8 @@ func New[VoteRank spenddag.VoteRankType[VoteRank]](
 		cachedSignedTransactions:   shrinkingmap.New[iotago.SignedTransactionID, *SignedTransactionMetadata](),
 		cachedStateRequests:        shrinkingmap.New[mempool.StateID, *promise.Promise[*StateMetadata]](),
 		stateDiffs:                 shrinkingmap.New[iotago.SlotIndex, *StateDiff](),
-		executionWorkers:           workers.CreatePool("executionWorkers", workerpool.WithWorkerCount(1)),
+		executionWorkers:           profiling.CreatePool(workers, "executionWorkers", workerpool.WithWorkerCount(1)),
+		conflictUpdateWorkers:      profiling.CreatePool(workers, "conflictUpdateWorkers", workerpool.WithWorkerCount(1)),
 		delayedTransactionEviction: shrinkingmap.New[iotago.SlotIndex, ds.Set[iotago.TransactionID]](),
 		delayedOutputStateEviction: shrinkingmap.New[iotago.SlotIndex, *shrinkingmap.ShrinkingMap[iotago.Identifier, *StateMetadata]](),
 		spendDAG:                   spendDAG,
@@ -102,6 +124,8 @@ func New[VoteRank spenddag.VoteRankType[VoteRank]](
 		errorHandler:               errorHandler,
 		signedTransactionAttached:  event.New1[mempool.SignedTransactionMetadata](),
 		transactionAttached:        event.New1[mempool.TransactionMetadata](),
+		stateDiffChanged:           event.New1[*mempool.StateDiffChange](),
+		optsQuarantineSize:         1000,
 	}, opts, (*MemPool[VoteRank]).setup)
 }
 
@@ -120,6 +144,10 @@ func (m *MemPool[VoteRank]) AttachSignedTransaction(signedTransaction mempool.Si
 		m.signedTransactionAttached.Trigger(storedSignedTransaction)
 
 		if isNewTransaction {
+			m.quarantineOnInvalid(storedSignedTransaction.transactionMetadata, blockID)
+
+			m.recordInWriteAheadLog(storedSignedTransaction.transactionMetadata, signedTransaction, blockID)
+
 			m.transactionAttached.Trigger(storedSignedTransaction.transactionMetadata)
 
 			m.solidifyInputs(storedSignedTransaction.transactionMetadata)
@@ -130,6 +158,33 @@ func (m *MemPool[VoteRank]) AttachSignedTransaction(signedTransaction mempool.Si
 	return storedSignedTransaction, nil
 }
 
+// ReattachTransaction hands the stored payload of a known transaction to the issue function, which is expected to issue
+// it in a new block, and registers the returned block as an additional attachment of the transaction. Transactions
+// that are invalid, committed, rejected or orphaned can not be reattached.
+func (m *MemPool[VoteRank]) ReattachTransaction(id iotago.TransactionID, issueFunc func(signedTransaction mempool.SignedTransaction) (iotago.BlockID, error)) (signedTransactionMetadata mempool.SignedTransactionMetadata, err error) {
+	transaction, exists := m.cachedTransactions.Get(id)
+	if !exists {
+		return nil, ierrors.Wrapf(mempool.ErrTransactionNotFound, "transaction %s", id)
+	}
+
+	if err := transaction.reattachable(); err != nil {
+		return nil, ierrors.Wrapf(err, "transaction %s", id)
+	}
+
+	signedTransaction, exists := transaction.validSigningTransaction()
+	if !exists {
+		return nil, ierrors.Wrapf(mempool.ErrTransactionNotReattachable, "transaction %s has no signed transaction with valid signatures", id)
+	}
+
+	// the issue function is called without holding any locks as the issued block is processed by the MemPool as well.
+	blockID, err := issueFunc(signedTransaction.SignedTransaction())
+	if err != nil {
+		return nil, ierrors.Wrapf(err, "failed to issue block for transaction %s", id)
+	}
+
+	return m.AttachSignedTransaction(signedTransaction.SignedTransaction(), transaction.Transaction(), blockID)
+}
+
 func (m *MemPool[VoteRank]) OnSignedTransactionAttached(handler func(signedTransactionMetadata mempool.SignedTransactionMetadata), opts ...event.Option) {
 	m.signedTransactionAttached.Hook(handler, opts...)
 }
@@ -138,6 +193,12 @@ func (m *MemPool[VoteRank]) OnTransactionAttached(handler func(transaction mempo
 	m.transactionAttached.Hook(handler, opts...)
 }
 
+// OnStateDiffChanged registers a callback that is triggered for each incremental change of the StateDiff of a pending
+// (uncommitted) slot.
+func (m *MemPool[VoteRank]) OnStateDiffChanged(handler func(change *mempool.StateDiffChange), opts ...event.Option) (unsubscribe func()) {
+	return m.stateDiffChanged.Hook(handler, opts...).Unhook
+}
+
 // MarkAttachmentIncluded marks the attachment of the given block as included.
 func (m *MemPool[VoteRank]) MarkAttachmentIncluded(blockID iotago.BlockID) bool {
 	return m.updateAttachment(blockID, (*TransactionMetadata).markAttachmentIncluded)
@@ -148,6 +209,43 @@ func (m *MemPool[VoteRank]) TransactionMetadata(id iotago.TransactionID) (transa
 	return m.cachedTransactions.Get(id)
 }
 
+// AwaitTransactionState returns a channel that receives nil once the transaction reaches the given state, or an error
+// if the state becomes unreachable or is not reached within the timeout. Transactions that are not known yet are
+// awaited to be attached.
+func (m *MemPool[VoteRank]) AwaitTransactionState(id iotago.TransactionID, state mempool.TransactionState, timeout time.Duration) <-chan error {
+	awaiter := newTransactionStateAwaiter(id, state)
+
+	timer := time.AfterFunc(timeout, func() {
+		awaiter.resolve(ierrors.Wrapf(mempool.ErrTransactionStateTimeout, "transaction %s did not become %s within %s", id, state, timeout))
+	})
+	awaiter.onResolved(func() { timer.Stop() })
+
+	if transaction, exists := m.TransactionMetadata(id); exists {
+		awaiter.await(transaction)
+
+		return awaiter.result
+	}
+
+	var awaitOnce sync.Once
+	awaitTransaction := func(transaction mempool.TransactionMetadata) {
+		awaitOnce.Do(func() { awaiter.await(transaction) })
+	}
+
+	attachedHook := m.transactionAttached.Hook(func(transaction mempool.TransactionMetadata) {
+		if transaction.ID() == id {
+			awaitTransaction(transaction)
+		}
+	})
+	awaiter.onResolved(attachedHook.Unhook)
+
+	// the transaction might have been attached before we registered the hook.
+	if transaction, exists := m.TransactionMetadata(id); exists {
+		awaitTransaction(transaction)
+	}
+
+	return awaiter.result
+}
+
 // StateMetadata returns the metadata of the output state with the given ID.
 func (m *MemPool[VoteRank]) StateMetadata(stateReference mempool.StateReference) (state mempool.StateMetadata, err error) {
 	stateRequest, exists := m.cachedStateRequests.Get(stateReference.ReferencedStateID())
@@ -174,6 +272,84 @@ func (m *MemPool[VoteRank]) TransactionMetadataByAttachment(blockID iotago.Block
 	return m.transactionByAttachment(blockID)
 }
 
+// ConflictGroup returns the pending transactions that belong to the conflicts of the given transaction, to the
+// conflicts that compete with them or to their future cone (including the transaction itself if it is pending).
+func (m *MemPool[VoteRank]) ConflictGroup(id iotago.TransactionID) (transactions []mempool.TransactionMetadata, exists bool) {
+	transaction, exists := m.cachedTransactions.Get(id)
+	if !exists {
+		return nil, false
+	}
+
+	// walk the spenders that the transaction inherits from and collect the ones that have competitors
+	conflictIDs := ds.NewSet[iotago.TransactionID]()
+	for spenderWalker := walker.New[iotago.TransactionID]().PushAll(transaction.SpenderIDs().ToSlice()...); spenderWalker.HasNext(); {
+		spenderID := spenderWalker.Next()
+
+		if conflictingSpenderIDs, spenderExists := m.spendDAG.ConflictingSpenders(spenderID); spenderExists && !conflictingSpenderIDs.IsEmpty() {
+			conflictIDs.Add(spenderID)
+			conflictIDs.AddAll(conflictingSpenderIDs)
+		}
+
+		if parentIDs, spenderExists := m.spendDAG.SpenderParents(spenderID); spenderExists {
+			spenderWalker.PushAll(parentIDs.ToSlice()...)
+		}
+	}
+
+	futureCone := m.spendDAG.FutureCone(conflictIDs)
+
+	transactions = make([]mempool.TransactionMetadata, 0)
+	m.ForEachPendingTransaction(func(pendingTransaction mempool.TransactionMetadata) bool {
+		if pendingTransaction.ID() == id || !pendingTransaction.SpenderIDs().Intersect(futureCone).IsEmpty() {
+			transactions = append(transactions, pendingTransaction)
+		}
+
+		return true
+	})
+
+	return transactions, true
+}
+
+// ForEachPendingTransaction calls the consumer for each transaction that is pending at the time of the call until the
+// consumer returns false.
+func (m *MemPool[VoteRank]) ForEachPendingTransaction(consumer func(transaction mempool.TransactionMetadata) bool) {
+	for _, pendingTransaction := range m.pendingTransactions() {
+		if !consumer(pendingTransaction) {
+			return
+		}
+	}
+}
+
+// QuarantinedTransaction returns the retained information about the given transaction if it turned invalid.
+func (m *MemPool[VoteRank]) QuarantinedTransaction(id iotago.TransactionID) (transaction *mempool.QuarantinedTransaction, exists bool) {
+	return m.quarantine.Get(id)
+}
+
+// QuarantinedTransactions returns the retained transactions that turned invalid (ordered from oldest to newest).
+func (m *MemPool[VoteRank]) QuarantinedTransactions() []*mempool.QuarantinedTransaction {
+	return m.quarantine.All()
+}
+
+// ReplayWriteAheadLog attaches the transactions that were recorded in the write-ahead log again, so that the payloads
+// that were in flight before a restart can be reattached. Only the records of the last MaxCommittableAge slots up to the
+// given latest committed slot are replayed, as older records can only belong to attachments that are committed by now.
+func (m *MemPool[VoteRank]) ReplayWriteAheadLog(latestCommittedSlot iotago.SlotIndex) error {
+	if m.writeAheadLog == nil {
+		return nil
+	}
+
+	protocolParams := m.apiProvider.APIForSlot(latestCommittedSlot).ProtocolParameters()
+
+	startSlot := protocolParams.GenesisSlot()
+	if latestCommittedSlot > startSlot+protocolParams.MaxCommittableAge() {
+		startSlot = latestCommittedSlot - protocolParams.MaxCommittableAge()
+	}
+
+	return m.writeAheadLog.Replay(startSlot, latestCommittedSlot, func(signedTransaction mempool.SignedTransaction, transaction mempool.Transaction, blockID iotago.BlockID) {
+		// attachments that were committed in the meantime can not be attached again and are dropped from the log
+		_, _ = m.AttachSignedTransaction(signedTransaction, transaction, blockID)
+	})
+}
+
 // StateDiff returns the state diff for the given slot.
 func (m *MemPool[VoteRank]) StateDiff(slot iotago.SlotIndex) (mempool.StateDiff, error) {
 	m.evictionMutex.RLock()
@@ -192,7 +368,7 @@ func (m *MemPool[VoteRank]) stateDiff(slot iotago.SlotIndex) (*StateDiff, error)
 		return nil, ierrors.Wrapf(err, "failed to get state diff for slot %d", slot)
 	}
 
-	return lo.Return1(m.stateDiffs.GetOrCreate(slot, func() *StateDiff { return NewStateDiff(slot, kv) })), nil
+	return lo.Return1(m.stateDiffs.GetOrCreate(slot, func() *StateDiff { return NewStateDiff(slot, kv, m.stateDiffChanged.Trigger) })), nil
 }
 
 // Reset resets the component to a clean state as if it was created at the last commitment.
@@ -203,6 +379,8 @@ func (m *MemPool[VoteRank]) Reset() {
 				if err := stateDiff.Reset(); err != nil {
 					m.errorHandler(ierrors.Wrapf(err, "failed to reset state diff for slot %d", slot))
 				}
+
+				m.stateDiffChanged.Trigger(&mempool.StateDiffChange{Type: mempool.StateDiffReset, Slot: slot})
 			}
 		}
 
@@ -228,13 +406,14 @@ func (m *MemPool[VoteRank]) Reset() {
 
 // Evict evicts the slot with the given slot from the MemPool.
 func (m *MemPool[VoteRank]) Evict(slot iotago.SlotIndex) {
+	var stateDiffEvicted bool
 	if evictedAttachments := func() *shrinkingmap.ShrinkingMap[iotago.BlockID, *SignedTransactionMetadata] {
 		m.evictionMutex.Lock()
 		defer m.evictionMutex.Unlock()
 
 		m.lastEvictedSlot = slot
 
-		m.stateDiffs.Delete(slot)
+		stateDiffEvicted = m.stateDiffs.Delete(slot)
 
 		return m.attachments.Evict(slot)
 	}(); evictedAttachments != nil {
@@ -244,6 +423,10 @@ func (m *MemPool[VoteRank]) Evict(slot iotago.SlotIndex) {
 		})
 	}
 
+	if stateDiffEvicted {
+		m.stateDiffChanged.Trigger(&mempool.StateDiffChange{Type: mempool.StateDiffEvicted, Slot: slot})
+	}
+
 	protocolParams := m.apiProvider.APIForSlot(slot).ProtocolParameters()
 	genesisSlot := protocolParams.GenesisSlot()
 	maxCommittableAge := protocolParams.MaxCommittableAge()
@@ -421,6 +604,22 @@ func (m *MemPool[VoteRank]) updateAttachment(blockID iotago.BlockID, updateFunc
 	return false
 }
 
+// pendingTransactions returns a snapshot of the transactions that are currently pending. The eviction lock is only held
+// while taking the snapshot, so that no slot is evicted while the transactions are collected.
+func (m *MemPool[VoteRank]) pendingTransactions() []*TransactionMetadata {
+	m.evictionMutex.RLock()
+	defer m.evictionMutex.RUnlock()
+
+	pendingTransactions := make([]*TransactionMetadata, 0)
+	for _, cachedTransaction := range m.cachedTransactions.Values() {
+		if cachedTransaction.IsPending() {
+			pendingTransactions = append(pendingTransactions, cachedTransaction)
+		}
+	}
+
+	return pendingTransactions
+}
+
 func (m *MemPool[VoteRank]) transactionByAttachment(blockID iotago.BlockID) (*TransactionMetadata, bool) {
 	if attachmentsInSlot := m.attachments.Get(blockID.Slot()); attachmentsInSlot != nil {
 		if signedTransactionMetadata, exists := attachmentsInSlot.Get(blockID); exists {
@@ -458,12 +657,33 @@ func (m *MemPool[VoteRank]) updateStateDiffs(transaction *TransactionMetadata, p
 	return nil
 }
 
+// reevaluateFutureCone re-evaluates the acceptance of the transactions in the future cone of the given spender after
+// its parents were updated by a fork of the SpendDAG.
+func (m *MemPool[VoteRank]) reevaluateFutureCone(spenderID iotago.TransactionID) {
+	m.spendDAG.FutureCone(ds.NewSet(spenderID)).Range(func(futureConeSpenderID iotago.TransactionID) {
+		if transaction, exists := m.cachedTransactions.Get(futureConeSpenderID); exists && !transaction.IsConflictAccepted() {
+			if m.spendDAG.AcceptanceState(ds.NewSet(futureConeSpenderID)).IsAccepted() {
+				transaction.setConflictAccepted()
+			}
+		}
+	})
+}
+
 func (m *MemPool[VoteRank]) setup() {
+	m.quarantine = newQuarantine(m.optsQuarantineSize)
+	m.conflictUpdates = newConflictUpdateQueue(m.conflictUpdateWorkers, m.reevaluateFutureCone)
+
 	m.spendDAG.Events().SpenderAccepted.Hook(func(id iotago.TransactionID) {
 		if transaction, exists := m.cachedTransactions.Get(id); exists {
 			transaction.setConflictAccepted()
 		}
 	})
+
+	m.spendDAG.Events().SpenderParentsUpdated.Hook(func(id iotago.TransactionID, _ ds.Set[iotago.TransactionID]) {
+		if transaction, exists := m.cachedTransactions.Get(id); exists {
+			m.conflictUpdates.Push(id, transaction.EarliestIncludedAttachment().Slot())
+		}
+	})
 }
 
 func (m *MemPool[VoteRank]) setupTransaction(transaction *TransactionMetadata) {
@@ -546,6 +766,46 @@ func (m *MemPool[VoteRank]) setupOutputState(stateMetadata *StateMetadata) {
 	})
 }
 
+// quarantineOnInvalid retains the given transaction in the quarantine once it turns invalid, together with the block
+// that attached it first.
+func (m *MemPool[VoteRank]) quarantineOnInvalid(transaction *TransactionMetadata, firstAttachment iotago.BlockID) {
+	transaction.OnInvalid(func(reason error) {
+		m.quarantine.Add(&mempool.QuarantinedTransaction{
+			ID:              transaction.ID(),
+			Transaction:     transaction.Transaction(),
+			Reason:          reason,
+			FirstAttachment: firstAttachment,
+			QuarantinedAt:   time.Now(),
+		})
+	})
+}
+
+// recordInWriteAheadLog records the given transaction in the write-ahead log (if enabled) and removes the record again
+// once the transaction can no longer be included.
+func (m *MemPool[VoteRank]) recordInWriteAheadLog(transaction *TransactionMetadata, signedTransaction mempool.SignedTransaction, blockID iotago.BlockID) {
+	if m.writeAheadLog == nil {
+		return
+	}
+
+	if err := m.writeAheadLog.Record(transaction.ID(), signedTransaction, blockID); err != nil {
+		m.errorHandler(ierrors.Wrapf(err, "failed to record transaction %s in write-ahead log", transaction.ID()))
+
+		return
+	}
+
+	deleteRecord := func() {
+		if err := m.writeAheadLog.Delete(transaction.ID()); err != nil {
+			m.errorHandler(ierrors.Wrapf(err, "failed to delete transaction %s from write-ahead log", transaction.ID()))
+		}
+	}
+
+	transaction.OnCommittedSlotUpdated(func(_ iotago.SlotIndex) { deleteRecord() })
+	transaction.OnOrphanedSlotUpdated(func(_ iotago.SlotIndex) { deleteRecord() })
+	transaction.OnInvalid(func(_ error) { deleteRecord() })
+	transaction.OnRejected(deleteRecord)
+	transaction.OnEvicted(deleteRecord)
+}
+
 func (m *MemPool[VoteRank]) setupSignedTransaction(signedTransactionMetadata *SignedTransactionMetadata, transaction *TransactionMetadata) {
 	transaction.addSigningTransaction(signedTransactionMetadata)
 
@@ -571,4 +831,25 @@ func (m *MemPool[VoteRank]) setupSignedTransaction(signedTransactionMetadata *Si
 	})
 }
 
+// WithQuarantineSize sets the maximum number of invalid transactions that are retained for inspection (0 = disabled).
+func WithQuarantineSize[VoteRank spenddag.VoteRankType[VoteRank]](quarantineSize int) options.Option[MemPool[VoteRank]] {
+	return func(m *MemPool[VoteRank]) {
+		m.optsQuarantineSize = quarantineSize
+	}
+}
+
+// WithWriteAheadLog enables the write-ahead log that records the attached transactions in the stores returned by the
+// storeFunc (indexed by the latest committed slot at the time of the attachment), so that they can be replayed after a
+// restart.
+func WithWriteAheadLog[VoteRank spenddag.VoteRankType[VoteRank]](
+	storeFunc func(slot iotago.SlotIndex) (kvstore.KVStore, error),
+	latestCommittedSlot func() iotago.SlotIndex,
+	signedTransactionBytes func(signedTransaction mempool.SignedTransaction) ([]byte, error),
+	signedTransactionFromBytes func(blockID iotago.BlockID, bytes []byte) (signedTransaction mempool.SignedTransaction, transaction mempool.Transaction, err error),
+) options.Option[MemPool[VoteRank]] {
+	return func(m *MemPool[VoteRank]) {
+		m.writeAheadLog = newWriteAheadLog(storeFunc, latestCommittedSlot, signedTransactionBytes, signedTransactionFromBytes)
+	}
+}
+
 var _ mempool.MemPool[vote.MockedRank] = new(MemPool[vote.MockedRank])
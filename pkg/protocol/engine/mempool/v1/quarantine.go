@@ -0,0 +1,80 @@
+package mempoolv1
+
+import (
+	"github.com/iotaledger/hive.go/runtime/syncutils"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/mempool"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// quarantine is a size-bounded store of the transactions that turned invalid.
+//
+// The MemPool forgets about invalid transactions once their slot is evicted, which makes it hard to find out why a
+// transaction never got accepted. The quarantine retains the latest invalid transactions together with the reason of
+// their failure and evicts the oldest ones once it is full.
+type quarantine struct {
+	// maxSize is the maximum number of transactions that are retained (0 = disabled).
+	maxSize int
+
+	// transactions contains the retained transactions by their ID.
+	transactions map[iotago.TransactionID]*mempool.QuarantinedTransaction
+
+	// order contains the IDs of the retained transactions ordered from oldest to newest.
+	order []iotago.TransactionID
+
+	// mutex is used to synchronize the access to the retained transactions.
+	mutex syncutils.RWMutex
+}
+
+// newQuarantine creates a new quarantine that retains at most the given number of transactions.
+func newQuarantine(maxSize int) *quarantine {
+	return &quarantine{
+		maxSize:      maxSize,
+		transactions: make(map[iotago.TransactionID]*mempool.QuarantinedTransaction),
+		order:        make([]iotago.TransactionID, 0),
+	}
+}
+
+// Add retains the given transaction (evicting the oldest one if the quarantine is full) unless it is retained already.
+func (q *quarantine) Add(transaction *mempool.QuarantinedTransaction) {
+	q.mutex.Lock()
+	defer q.mutex.Unlock()
+
+	if q.maxSize <= 0 {
+		return
+	}
+
+	if _, exists := q.transactions[transaction.ID]; exists {
+		return
+	}
+
+	if len(q.order) >= q.maxSize {
+		delete(q.transactions, q.order[0])
+		q.order = q.order[1:]
+	}
+
+	q.transactions[transaction.ID] = transaction
+	q.order = append(q.order, transaction.ID)
+}
+
+// Get returns the retained transaction with the given ID.
+func (q *quarantine) Get(id iotago.TransactionID) (transaction *mempool.QuarantinedTransaction, exists bool) {
+	q.mutex.RLock()
+	defer q.mutex.RUnlock()
+
+	transaction, exists = q.transactions[id]
+
+	return transaction, exists
+}
+
+// All returns the retained transactions ordered from oldest to newest.
+func (q *quarantine) All() []*mempool.QuarantinedTransaction {
+	q.mutex.RLock()
+	defer q.mutex.RUnlock()
+
+	transactions := make([]*mempool.QuarantinedTransaction, 0, len(q.order))
+	for _, id := range q.order {
+		transactions = append(transactions, q.transactions[id])
+	}
+
+	return transactions
+}
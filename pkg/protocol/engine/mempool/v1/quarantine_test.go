@@ -0,0 +1,59 @@
+package mempoolv1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/mempool"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func TestQuarantine(t *testing.T) {
+	transactionID := func(alias string) iotago.TransactionID {
+		return iotago.TransactionIDRepresentingData(0, []byte(alias))
+	}
+
+	quarantinedTransaction := func(alias string) *mempool.QuarantinedTransaction {
+		return &mempool.QuarantinedTransaction{ID: transactionID(alias)}
+	}
+
+	requireRetained := func(q *quarantine, expectedAliases ...string) {
+		retainedIDs := make([]iotago.TransactionID, 0)
+		for _, transaction := range q.All() {
+			retainedIDs = append(retainedIDs, transaction.ID)
+		}
+
+		expectedIDs := make([]iotago.TransactionID, 0)
+		for _, alias := range expectedAliases {
+			expectedIDs = append(expectedIDs, transactionID(alias))
+
+			_, exists := q.Get(transactionID(alias))
+			require.True(t, exists)
+		}
+
+		require.Equal(t, expectedIDs, retainedIDs)
+	}
+
+	q := newQuarantine(2)
+
+	q.Add(quarantinedTransaction("tx1"))
+	q.Add(quarantinedTransaction("tx2"))
+	requireRetained(q, "tx1", "tx2")
+
+	// transactions that are retained already are not added again.
+	q.Add(quarantinedTransaction("tx1"))
+	requireRetained(q, "tx1", "tx2")
+
+	// the oldest transaction is evicted once the quarantine is full.
+	q.Add(quarantinedTransaction("tx3"))
+	requireRetained(q, "tx2", "tx3")
+
+	_, exists := q.Get(transactionID("tx1"))
+	require.False(t, exists)
+
+	// a quarantine without capacity retains nothing.
+	disabled := newQuarantine(0)
+	disabled.Add(quarantinedTransaction("tx1"))
+	requireRetained(disabled)
+}
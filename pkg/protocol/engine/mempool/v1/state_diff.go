@@ -22,11 +22,15 @@ type StateDiff struct {
 	stateUsageCounters *shrinkingmap.ShrinkingMap[mempool.StateID, int]
 
 	mutations ads.Set[iotago.Identifier, iotago.TransactionID]
+
+	// changed is called with the incremental changes of the state diff.
+	changed func(change *mempool.StateDiffChange)
 }
 
-func NewStateDiff(slot iotago.SlotIndex, kv kvstore.KVStore) *StateDiff {
+func NewStateDiff(slot iotago.SlotIndex, kv kvstore.KVStore, changed func(change *mempool.StateDiffChange)) *StateDiff {
 	return &StateDiff{
 		slot:                 slot,
+		changed:              changed,
 		spentOutputs:         shrinkingmap.New[mempool.StateID, mempool.StateMetadata](),
 		createdOutputs:       shrinkingmap.New[mempool.StateID, mempool.StateMetadata](),
 		executedTransactions: orderedmap.New[iotago.TransactionID, mempool.TransactionMetadata](),
@@ -61,17 +65,17 @@ func (s *StateDiff) Mutations() ads.Set[iotago.Identifier, iotago.TransactionID]
 	return s.mutations
 }
 
-func (s *StateDiff) updateCompactedStateChanges(transaction *TransactionMetadata, direction int) {
+func (s *StateDiff) updateCompactedStateChanges(transaction *TransactionMetadata, direction int, change *mempool.StateDiffChange) {
 	for _, input := range transaction.inputs {
 		s.compactStateChanges(input, s.stateUsageCounters.Compute(input.state.StateID(), func(currentValue int, _ bool) int {
 			return currentValue - direction
-		}))
+		}), change)
 	}
 
 	for _, output := range transaction.outputs {
 		s.compactStateChanges(output, s.stateUsageCounters.Compute(output.state.StateID(), func(currentValue int, _ bool) int {
 			return currentValue + direction
-		}))
+		}), change)
 	}
 }
 
@@ -80,7 +84,9 @@ func (s *StateDiff) AddTransaction(transaction *TransactionMetadata, errorHandle
 		if err := s.mutations.Add(transaction.ID()); err != nil {
 			return ierrors.Wrapf(err, "failed to add transaction to state diff, txID: %s", transaction.ID())
 		}
-		s.updateCompactedStateChanges(transaction, 1)
+		change := &mempool.StateDiffChange{Type: mempool.StateDiffTransactionAdded, Slot: s.slot, Transaction: transaction}
+		s.updateCompactedStateChanges(transaction, 1, change)
+		s.notifyChanged(change)
 
 		transaction.OnPending(func() {
 			if err := s.RollbackTransaction(transaction); err != nil {
@@ -98,7 +104,9 @@ func (s *StateDiff) RollbackTransaction(transaction *TransactionMetadata) error
 			return ierrors.Wrapf(err, "failed to delete transaction from state diff's mutations, txID: %s", transaction.ID())
 		}
 
-		s.updateCompactedStateChanges(transaction, -1)
+		change := &mempool.StateDiffChange{Type: mempool.StateDiffTransactionRemoved, Slot: s.slot, Transaction: transaction}
+		s.updateCompactedStateChanges(transaction, -1, change)
+		s.notifyChanged(change)
 	}
 
 	return nil
@@ -128,17 +136,31 @@ func (s *StateDiff) Reset() error {
 	return nil
 }
 
-func (s *StateDiff) compactStateChanges(stateMetadata *StateMetadata, usageCounter int) {
+func (s *StateDiff) compactStateChanges(stateMetadata *StateMetadata, usageCounter int, change *mempool.StateDiffChange) {
 	switch {
 	case usageCounter > 0:
-		s.createdOutputs.Set(stateMetadata.state.StateID(), stateMetadata)
+		if s.createdOutputs.Set(stateMetadata.state.StateID(), stateMetadata) {
+			change.CreatedStatesAdded = append(change.CreatedStatesAdded, stateMetadata)
+		}
 	case usageCounter < 0:
-		if !stateMetadata.state.IsReadOnly() {
-			s.spentOutputs.Set(stateMetadata.state.StateID(), stateMetadata)
+		if !stateMetadata.state.IsReadOnly() && s.spentOutputs.Set(stateMetadata.state.StateID(), stateMetadata) {
+			change.DestroyedStatesAdded = append(change.DestroyedStatesAdded, stateMetadata)
 		}
 	default:
-		s.createdOutputs.Delete(stateMetadata.state.StateID())
-		s.spentOutputs.Delete(stateMetadata.state.StateID())
+		if s.createdOutputs.Delete(stateMetadata.state.StateID()) {
+			change.CreatedStatesRemoved = append(change.CreatedStatesRemoved, stateMetadata)
+		}
+
+		if s.spentOutputs.Delete(stateMetadata.state.StateID()) {
+			change.DestroyedStatesRemoved = append(change.DestroyedStatesRemoved, stateMetadata)
+		}
+	}
+}
+
+// notifyChanged calls the change callback of the state diff (if one was provided).
+func (s *StateDiff) notifyChanged(change *mempool.StateDiffChange) {
+	if s.changed != nil {
+		s.changed(change)
 	}
 }
 
@@ -322,6 +322,38 @@ func (t *TransactionMetadata) addSigningTransaction(signedTransactionMetadata *S
 	return added
 }
 
+// reattachable returns an error if the transaction can not be attached to a new block anymore.
+func (t *TransactionMetadata) reattachable() error {
+	switch {
+	case t.IsEvicted():
+		return ierrors.Wrap(mempool.ErrTransactionNotReattachable, "transaction was evicted")
+	case t.IsInvalid():
+		return ierrors.Wrap(mempool.ErrTransactionNotReattachable, "transaction is invalid")
+	case t.IsRejected():
+		return ierrors.Wrap(mempool.ErrTransactionNotReattachable, "transaction was rejected")
+	case lo.Return2(t.CommittedSlot()):
+		return ierrors.Wrap(mempool.ErrTransactionNotReattachable, "transaction was committed")
+	case lo.Return2(t.OrphanedSlot()):
+		return ierrors.Wrap(mempool.ErrTransactionNotReattachable, "transaction was orphaned")
+	default:
+		return nil
+	}
+}
+
+// validSigningTransaction returns a signed transaction of the transaction whose signatures were successfully validated.
+func (t *TransactionMetadata) validSigningTransaction() (signedTransaction *SignedTransactionMetadata, exists bool) {
+	t.attachmentsMutex.RLock()
+	defer t.attachmentsMutex.RUnlock()
+
+	t.signingTransactions.Range(func(signedTransactionMetadata *SignedTransactionMetadata) {
+		if !exists && signedTransactionMetadata.signaturesValid.WasTriggered() {
+			signedTransaction, exists = signedTransactionMetadata, true
+		}
+	})
+
+	return signedTransaction, exists
+}
+
 func (t *TransactionMetadata) markAttachmentIncluded(blockID iotago.BlockID) (included bool) {
 	t.attachmentsMutex.Lock()
 	defer t.attachmentsMutex.Unlock()
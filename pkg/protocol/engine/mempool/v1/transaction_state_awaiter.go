@@ -0,0 +1,129 @@
+package mempoolv1
+
+import (
+	"fmt"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/runtime/syncutils"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/mempool"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// transactionStateAwaiter resolves a channel once a transaction reaches a certain state or the state becomes
+// unreachable.
+type transactionStateAwaiter struct {
+	// transactionID is the ID of the awaited transaction.
+	transactionID iotago.TransactionID
+
+	// state is the awaited state.
+	state mempool.TransactionState
+
+	// result is the channel that receives the result.
+	result chan error
+
+	// resolved is true if the result was already sent.
+	resolved bool
+
+	// cleanupFuncs are executed once the result was sent.
+	cleanupFuncs []func()
+
+	mutex syncutils.Mutex
+}
+
+// newTransactionStateAwaiter creates a new transactionStateAwaiter.
+func newTransactionStateAwaiter(transactionID iotago.TransactionID, state mempool.TransactionState) *transactionStateAwaiter {
+	return &transactionStateAwaiter{
+		transactionID: transactionID,
+		state:         state,
+		result:        make(chan error, 1),
+	}
+}
+
+// await hooks to the events of the given transaction to resolve the awaiter.
+func (a *transactionStateAwaiter) await(transaction mempool.TransactionMetadata) {
+	switch a.state {
+	case mempool.TransactionStateAccepted:
+		transaction.OnAccepted(func() { a.resolve(nil) })
+	case mempool.TransactionStateCommitted:
+		transaction.OnCommittedSlotUpdated(func(slot iotago.SlotIndex) {
+			if slot != 0 {
+				a.resolve(nil)
+			}
+		})
+	case mempool.TransactionStateOrphaned:
+		transaction.OnOrphanedSlotUpdated(func(slot iotago.SlotIndex) {
+			if slot != 0 {
+				a.resolve(nil)
+			}
+		})
+
+		// committed transactions are never orphaned.
+		transaction.OnCommittedSlotUpdated(func(slot iotago.SlotIndex) {
+			if slot != 0 {
+				a.resolveUnreachable("it was committed in slot %d", slot)
+			}
+		})
+
+		return
+	default:
+		a.resolve(ierrors.Errorf("unknown transaction state %d", a.state))
+
+		return
+	}
+
+	transaction.OnInvalid(func(err error) {
+		if err != nil {
+			a.resolveUnreachable("it is invalid: %s", err)
+		}
+	})
+
+	transaction.OnRejected(func() {
+		a.resolveUnreachable("it was rejected")
+	})
+
+	transaction.OnOrphanedSlotUpdated(func(slot iotago.SlotIndex) {
+		if slot != 0 {
+			a.resolveUnreachable("it was orphaned in slot %d", slot)
+		}
+	})
+}
+
+// onResolved registers a function that is executed once the awaiter is resolved.
+func (a *transactionStateAwaiter) onResolved(cleanup func()) {
+	a.mutex.Lock()
+	if !a.resolved {
+		a.cleanupFuncs = append(a.cleanupFuncs, cleanup)
+		a.mutex.Unlock()
+
+		return
+	}
+	a.mutex.Unlock()
+
+	cleanup()
+}
+
+// resolveUnreachable resolves the awaiter with an ErrTransactionStateUnreachable.
+func (a *transactionStateAwaiter) resolveUnreachable(reason string, args ...any) {
+	a.resolve(ierrors.Wrapf(mempool.ErrTransactionStateUnreachable, "transaction %s can not become %s as %s", a.transactionID, a.state, fmt.Sprintf(reason, args...)))
+}
+
+// resolve sends the given result (if the awaiter was not resolved before) and executes the cleanup functions.
+func (a *transactionStateAwaiter) resolve(err error) {
+	a.mutex.Lock()
+	if a.resolved {
+		a.mutex.Unlock()
+
+		return
+	}
+
+	a.resolved = true
+	a.result <- err
+
+	cleanupFuncs := a.cleanupFuncs
+	a.cleanupFuncs = nil
+	a.mutex.Unlock()
+
+	for _, cleanup := range cleanupFuncs {
+		cleanup()
+	}
+}
@@ -0,0 +1,174 @@
+package mempoolv1
+
+import (
+	"github.com/iotaledger/hive.go/ds/shrinkingmap"
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/hive.go/serializer/v2/byteutils"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/mempool"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// writeAheadLog records the transactions that are attached to the MemPool, so that they can be replayed after a restart
+// of the node. The records are written to the store of the slot that was the latest committed slot at the time of the
+// attachment, as the stores of uncommitted slots are rolled back on startup.
+type writeAheadLog struct {
+	// storeFunc returns the store of the records that were written while the given slot was the latest committed slot.
+	storeFunc func(slot iotago.SlotIndex) (kvstore.KVStore, error)
+
+	// latestCommittedSlot returns the slot that new records are written to.
+	latestCommittedSlot func() iotago.SlotIndex
+
+	// signedTransactionBytes serializes the given signed transaction.
+	signedTransactionBytes func(signedTransaction mempool.SignedTransaction) ([]byte, error)
+
+	// signedTransactionFromBytes deserializes the signed transaction that was attached by the given block and returns it
+	// together with its transaction.
+	signedTransactionFromBytes func(blockID iotago.BlockID, bytes []byte) (signedTransaction mempool.SignedTransaction, transaction mempool.Transaction, err error)
+
+	// recordSlots contains the slots that the records of the recorded transactions were written to.
+	recordSlots *shrinkingmap.ShrinkingMap[iotago.TransactionID, iotago.SlotIndex]
+}
+
+// newWriteAheadLog creates a new writeAheadLog.
+func newWriteAheadLog(
+	storeFunc func(slot iotago.SlotIndex) (kvstore.KVStore, error),
+	latestCommittedSlot func() iotago.SlotIndex,
+	signedTransactionBytes func(signedTransaction mempool.SignedTransaction) ([]byte, error),
+	signedTransactionFromBytes func(blockID iotago.BlockID, bytes []byte) (signedTransaction mempool.SignedTransaction, transaction mempool.Transaction, err error),
+) *writeAheadLog {
+	return &writeAheadLog{
+		storeFunc:                  storeFunc,
+		latestCommittedSlot:        latestCommittedSlot,
+		signedTransactionBytes:     signedTransactionBytes,
+		signedTransactionFromBytes: signedTransactionFromBytes,
+		recordSlots:                shrinkingmap.New[iotago.TransactionID, iotago.SlotIndex](),
+	}
+}
+
+// Record writes the given signed transaction and the block that attached it to the log.
+func (w *writeAheadLog) Record(transactionID iotago.TransactionID, signedTransaction mempool.SignedTransaction, blockID iotago.BlockID) error {
+	signedTransactionBytes, err := w.signedTransactionBytes(signedTransaction)
+	if err != nil {
+		return ierrors.Wrapf(err, "failed to serialize signed transaction of transaction %s", transactionID)
+	}
+
+	slot := w.latestCommittedSlot()
+
+	store, err := w.storeFunc(slot)
+	if err != nil {
+		return ierrors.Wrapf(err, "failed to get write-ahead log of slot %d", slot)
+	}
+
+	if err = store.Set(lo.PanicOnErr(transactionID.Bytes()), byteutils.ConcatBytes(lo.PanicOnErr(blockID.Bytes()), signedTransactionBytes)); err != nil {
+		return ierrors.Wrapf(err, "failed to record transaction %s", transactionID)
+	}
+
+	w.recordSlots.Set(transactionID, slot)
+
+	return nil
+}
+
+// Delete removes the record of the given transaction from the log (if it exists).
+func (w *writeAheadLog) Delete(transactionID iotago.TransactionID) error {
+	slot, exists := w.recordSlots.DeleteAndReturn(transactionID)
+	if !exists {
+		return nil
+	}
+
+	return w.delete(slot, transactionID)
+}
+
+// Replay removes the records that were written in the given range of slots from the log and hands them to the consumer.
+func (w *writeAheadLog) Replay(startSlot iotago.SlotIndex, endSlot iotago.SlotIndex, consumer func(signedTransaction mempool.SignedTransaction, transaction mempool.Transaction, blockID iotago.BlockID)) error {
+	// the records are loaded before they are replayed, as replaying them records them again in the latest committed slot
+	recordsBySlot := make(map[iotago.SlotIndex][]*writeAheadLogRecord)
+	for slot := startSlot; slot <= endSlot; slot++ {
+		records, err := w.records(slot)
+		if err != nil {
+			return ierrors.Wrapf(err, "failed to load write-ahead log of slot %d", slot)
+		}
+
+		recordsBySlot[slot] = records
+	}
+
+	for slot := startSlot; slot <= endSlot; slot++ {
+		for _, record := range recordsBySlot[slot] {
+			consumer(record.signedTransaction, record.transaction, record.blockID)
+
+			// transactions that were attached successfully were recorded again (in the latest committed slot)
+			if recordSlot, exists := w.recordSlots.Get(record.transactionID); exists && recordSlot == slot {
+				continue
+			}
+
+			if err := w.delete(slot, record.transactionID); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// records loads the records that were written while the given slot was the latest committed slot.
+func (w *writeAheadLog) records(slot iotago.SlotIndex) (records []*writeAheadLogRecord, err error) {
+	store, err := w.storeFunc(slot)
+	if err != nil {
+		return nil, ierrors.Wrapf(err, "failed to get write-ahead log of slot %d", slot)
+	}
+
+	var innerErr error
+	if err = store.Iterate(kvstore.EmptyPrefix, func(key kvstore.Key, value kvstore.Value) bool {
+		record := new(writeAheadLogRecord)
+
+		if record.transactionID, _, innerErr = iotago.TransactionIDFromBytes(key); innerErr != nil {
+			innerErr = ierrors.Wrap(innerErr, "failed to parse transaction ID")
+
+			return false
+		}
+
+		consumedBytes := 0
+		if record.blockID, consumedBytes, innerErr = iotago.BlockIDFromBytes(value); innerErr != nil {
+			innerErr = ierrors.Wrapf(innerErr, "failed to parse block ID of transaction %s", record.transactionID)
+
+			return false
+		}
+
+		if record.signedTransaction, record.transaction, innerErr = w.signedTransactionFromBytes(record.blockID, value[consumedBytes:]); innerErr != nil {
+			innerErr = ierrors.Wrapf(innerErr, "failed to parse signed transaction of transaction %s", record.transactionID)
+
+			return false
+		}
+
+		records = append(records, record)
+
+		return true
+	}); err != nil {
+		return nil, ierrors.Wrap(err, "failed to iterate records")
+	}
+
+	return records, innerErr
+}
+
+// delete removes the record of the given transaction from the log of the given slot.
+func (w *writeAheadLog) delete(slot iotago.SlotIndex, transactionID iotago.TransactionID) error {
+	store, err := w.storeFunc(slot)
+	if err != nil {
+		return ierrors.Wrapf(err, "failed to get write-ahead log of slot %d", slot)
+	}
+
+	if err = store.Delete(lo.PanicOnErr(transactionID.Bytes())); err != nil {
+		return ierrors.Wrapf(err, "failed to delete record of transaction %s", transactionID)
+	}
+
+	return nil
+}
+
+// writeAheadLogRecord is a transaction that was recorded in the writeAheadLog.
+type writeAheadLogRecord struct {
+	transactionID     iotago.TransactionID
+	signedTransaction mempool.SignedTransaction
+	transaction       mempool.Transaction
+	blockID           iotago.BlockID
+}
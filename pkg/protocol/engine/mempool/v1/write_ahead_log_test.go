@@ -0,0 +1,86 @@
+package mempoolv1
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/mempool"
+	mempooltests "github.com/iotaledger/iota-core/pkg/protocol/engine/mempool/tests"
+	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/tpkg"
+)
+
+func TestWriteAheadLog(t *testing.T) {
+	stores := make(map[iotago.SlotIndex]kvstore.KVStore)
+	storeFunc := func(slot iotago.SlotIndex) (kvstore.KVStore, error) {
+		if _, exists := stores[slot]; !exists {
+			stores[slot] = mapdb.NewMapDB()
+		}
+
+		return stores[slot], nil
+	}
+
+	// the mocked signed transactions are serialized as their ID and looked up again when they are deserialized.
+	signedTransactions := make(map[iotago.SignedTransactionID]*mempooltests.SignedTransaction)
+	transactions := make(map[iotago.SignedTransactionID]mempool.Transaction)
+	newSignedTransaction := func() (iotago.TransactionID, *mempooltests.SignedTransaction) {
+		transaction := mempooltests.NewTransaction(1)
+		signedTransaction := mempooltests.NewSignedTransaction(transaction)
+
+		signedTransactions[lo.PanicOnErr(signedTransaction.ID())] = signedTransaction
+		transactions[lo.PanicOnErr(signedTransaction.ID())] = transaction
+
+		return lo.PanicOnErr(transaction.ID()), signedTransaction
+	}
+
+	latestCommittedSlot := iotago.SlotIndex(5)
+	wal := newWriteAheadLog(storeFunc, func() iotago.SlotIndex { return latestCommittedSlot }, func(signedTransaction mempool.SignedTransaction) ([]byte, error) {
+		return lo.PanicOnErr(signedTransaction.ID()).Bytes()
+	}, func(_ iotago.BlockID, bytes []byte) (mempool.SignedTransaction, mempool.Transaction, error) {
+		signedTransactionID, _, err := iotago.SignedTransactionIDFromBytes(bytes)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		signedTransaction, exists := signedTransactions[signedTransactionID]
+		if !exists {
+			return nil, nil, ierrors.Errorf("unknown signed transaction %s", signedTransactionID)
+		}
+
+		return signedTransaction, transactions[signedTransactionID], nil
+	})
+
+	pendingTransactionID, pendingSignedTransaction := newSignedTransaction()
+	committedTransactionID, committedSignedTransaction := newSignedTransaction()
+	pendingBlockID := tpkg.RandBlockID()
+
+	require.NoError(t, wal.Record(pendingTransactionID, pendingSignedTransaction, pendingBlockID))
+	require.NoError(t, wal.Record(committedTransactionID, committedSignedTransaction, tpkg.RandBlockID()))
+	require.NoError(t, wal.Delete(committedTransactionID))
+
+	// simulate a restart by replaying the records into a fresh log that attaches them again.
+	latestCommittedSlot = 7
+	restoredWAL := newWriteAheadLog(storeFunc, wal.latestCommittedSlot, wal.signedTransactionBytes, wal.signedTransactionFromBytes)
+
+	replayedBlockIDs := make([]iotago.BlockID, 0)
+	require.NoError(t, restoredWAL.Replay(3, 7, func(signedTransaction mempool.SignedTransaction, transaction mempool.Transaction, blockID iotago.BlockID) {
+		require.Equal(t, pendingSignedTransaction, signedTransaction)
+		require.NoError(t, restoredWAL.Record(lo.PanicOnErr(transaction.ID()), signedTransaction, blockID))
+
+		replayedBlockIDs = append(replayedBlockIDs, blockID)
+	}))
+	require.Equal(t, []iotago.BlockID{pendingBlockID}, replayedBlockIDs)
+
+	// the replayed record was moved to the latest committed slot.
+	require.Empty(t, lo.PanicOnErr(restoredWAL.records(5)))
+	require.Len(t, lo.PanicOnErr(restoredWAL.records(7)), 1)
+
+	// records that are not attached again when they are replayed are dropped.
+	require.NoError(t, newWriteAheadLog(storeFunc, wal.latestCommittedSlot, wal.signedTransactionBytes, wal.signedTransactionFromBytes).Replay(3, 7, func(mempool.SignedTransaction, mempool.Transaction, iotago.BlockID) {}))
+	require.Empty(t, lo.PanicOnErr(restoredWAL.records(7)))
+}
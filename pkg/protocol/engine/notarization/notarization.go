@@ -15,6 +15,9 @@ type Notarization interface {
 
 	AcceptedBlocksCount(index iotago.SlotIndex) int
 
+	// PendingTasks returns the number of accepted blocks and slot commitments that are waiting to be processed.
+	PendingTasks() int
+
 	// Reset resets the component to a clean state as if it was created at the last commitment.
 	Reset()
 
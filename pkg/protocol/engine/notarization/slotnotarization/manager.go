@@ -3,14 +3,17 @@ package slotnotarization
 import (
 	"time"
 
+	"github.com/iotaledger/hive.go/ads"
 	"github.com/iotaledger/hive.go/ierrors"
 	"github.com/iotaledger/hive.go/log"
 	"github.com/iotaledger/hive.go/runtime/event"
 	"github.com/iotaledger/hive.go/runtime/module"
+	"github.com/iotaledger/hive.go/runtime/options"
 	"github.com/iotaledger/hive.go/runtime/syncutils"
 	"github.com/iotaledger/hive.go/runtime/workerpool"
 	"github.com/iotaledger/hive.go/serializer/v2/serix"
 	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/profiling"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/attestation"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
@@ -23,6 +26,9 @@ import (
 	iotago "github.com/iotaledger/iota.go/v4"
 )
 
+// ErrInvalidMinCommittableAge is returned if the configured min committable age is invalid for the protocol parameters.
+var ErrInvalidMinCommittableAge = ierrors.New("invalid min committable age")
+
 // Manager is the component that manages the slot commitments.
 type Manager struct {
 	events        *notarization.Events
@@ -45,16 +51,19 @@ type Manager struct {
 
 	commitmentMutex syncutils.RWMutex
 
+	// optsMinCommittableAge contains the minimum age of a slot before it is committed (0 = use the protocol parameters).
+	optsMinCommittableAge iotago.SlotIndex
+
 	log.Logger
 
 	module.Module
 }
 
-func NewProvider() module.Provider[*engine.Engine, notarization.Notarization] {
+func NewProvider(opts ...options.Option[Manager]) module.Provider[*engine.Engine, notarization.Notarization] {
 	return module.Provide(func(e *engine.Engine) notarization.Notarization {
 		logger := e.NewChildLogger("NotarizationManager")
 
-		m := NewManager(logger, e.Workers.CreateGroup("NotarizationManager"), e.ErrorHandler("notarization"))
+		m := NewManager(logger, profiling.CreateGroup(e.Workers, "NotarizationManager"), e.ErrorHandler("notarization"), opts...)
 		m.HookShutdown(logger.UnsubscribeFromParentLogger)
 
 		m.apiProvider = e
@@ -69,7 +78,7 @@ func NewProvider() module.Provider[*engine.Engine, notarization.Notarization] {
 			m.attestation = e.Attestations
 			m.upgradeOrchestrator = e.UpgradeOrchestrator
 
-			wpBlocks := m.workers.CreatePool("Blocks", workerpool.WithWorkerCount(1)) // Using just 1 worker to avoid contention
+			wpBlocks := profiling.CreatePool(m.workers, "Blocks", workerpool.WithWorkerCount(1)) // Using just 1 worker to avoid contention
 
 			m.acceptedBlockProcessedDetach = e.Events.AcceptedBlockProcessed.Hook(func(block *blocks.Block) {
 				if err := m.notarizeAcceptedBlock(block); err != nil {
@@ -82,6 +91,10 @@ func NewProvider() module.Provider[*engine.Engine, notarization.Notarization] {
 
 			e.Events.Notarization.LinkTo(m.events)
 
+			if err := m.validateMinCommittableAge(e.LatestAPI().ProtocolParameters()); err != nil {
+				panic(ierrors.Wrap(err, "invalid notarization configuration"))
+			}
+
 			m.TriggerInitialized()
 			m.slotMutations = NewSlotMutations(e.Storage.Settings().LatestCommitment().Slot())
 			m.TriggerConstructed()
@@ -93,13 +106,13 @@ func NewProvider() module.Provider[*engine.Engine, notarization.Notarization] {
 	})
 }
 
-func NewManager(logger log.Logger, workers *workerpool.Group, errorHandler func(error)) *Manager {
-	return &Manager{
+func NewManager(logger log.Logger, workers *workerpool.Group, errorHandler func(error), opts ...options.Option[Manager]) *Manager {
+	return options.Apply(&Manager{
 		Logger:       logger,
 		events:       notarization.NewEvents(),
 		workers:      workers,
 		errorHandler: errorHandler,
-	}
+	}, opts)
 }
 
 func (m *Manager) Shutdown() {
@@ -164,7 +177,7 @@ func (m *Manager) IsBootstrapped() bool {
 	// because there are 5 full slots and 1 that is still not finished between slot 10 and slot 4.
 	// All slots smaller or equal to 4 are committable.
 	latestIndex := m.storage.Settings().LatestCommitment().Slot()
-	return latestIndex+m.apiProvider.APIForSlot(latestIndex).ProtocolParameters().MinCommittableAge() >= m.apiProvider.APIForSlot(latestIndex).TimeProvider().SlotFromTime(m.acceptedTimeFunc())
+	return latestIndex+m.minCommittableAge(latestIndex) >= m.apiProvider.APIForSlot(latestIndex).TimeProvider().SlotFromTime(m.acceptedTimeFunc())
 }
 
 func (m *Manager) notarizeAcceptedBlock(block *blocks.Block) (err error) {
@@ -196,7 +209,37 @@ func (m *Manager) tryCommitSlotUntil(acceptedBlockIndex iotago.SlotIndex) {
 }
 
 func (m *Manager) isCommittable(slot iotago.SlotIndex, acceptedBlockSlot iotago.SlotIndex) bool {
-	return slot+m.apiProvider.APIForSlot(slot).ProtocolParameters().MinCommittableAge() <= acceptedBlockSlot
+	return slot+m.minCommittableAge(slot) <= acceptedBlockSlot
+}
+
+// minCommittableAge returns the minimum age of the given slot before it can be committed. The configured age is
+// validated against the protocol parameters at startup, but it is only used for the slots of later protocol versions
+// if it is also valid for their protocol parameters.
+func (m *Manager) minCommittableAge(slot iotago.SlotIndex) iotago.SlotIndex {
+	protocolParameters := m.apiProvider.APIForSlot(slot).ProtocolParameters()
+	if m.optsMinCommittableAge == 0 || m.validateMinCommittableAge(protocolParameters) != nil {
+		return protocolParameters.MinCommittableAge()
+	}
+
+	return m.optsMinCommittableAge
+}
+
+// validateMinCommittableAge checks that the configured min committable age only delays the commitments and still allows
+// to commit slots before they exceed the max committable age of the given protocol parameters.
+func (m *Manager) validateMinCommittableAge(protocolParameters iotago.ProtocolParameters) error {
+	if m.optsMinCommittableAge == 0 {
+		return nil
+	}
+
+	if m.optsMinCommittableAge < protocolParameters.MinCommittableAge() {
+		return ierrors.Wrapf(ErrInvalidMinCommittableAge, "min committable age %d is smaller than the min committable age %d of the protocol parameters", m.optsMinCommittableAge, protocolParameters.MinCommittableAge())
+	}
+
+	if m.optsMinCommittableAge >= protocolParameters.MaxCommittableAge() {
+		return ierrors.Wrapf(ErrInvalidMinCommittableAge, "min committable age %d is not smaller than the max committable age %d of the protocol parameters", m.optsMinCommittableAge, protocolParameters.MaxCommittableAge())
+	}
+
+	return nil
 }
 
 func (m *Manager) createCommitment(slot iotago.SlotIndex) (*model.Commitment, error) {
@@ -276,7 +319,13 @@ func (m *Manager) createCommitment(slot iotago.SlotIndex) (*model.Commitment, er
 		return nil, ierrors.Wrapf(err, "failed to store latest roots for commitment %s", newModelCommitment.ID())
 	}
 
-	if err = m.storage.Commitments().Store(newModelCommitment); err != nil {
+	if err = m.storeBlockCommitments(newModelCommitment.ID(), acceptedBlocks); err != nil {
+		return nil, ierrors.Wrapf(err, "failed to store block commitments for commitment %s", newModelCommitment.ID())
+	}
+
+	// the commitment and the latest commitment of the settings are written in a single batch, so that they are always
+	// consistent when the node restarts.
+	if err = m.storage.StoreLatestCommitment(newModelCommitment); err != nil {
 		return nil, ierrors.Wrapf(err, "failed to store latest commitment %s", newModelCommitment.ID())
 	}
 
@@ -288,10 +337,6 @@ func (m *Manager) createCommitment(slot iotago.SlotIndex) (*model.Commitment, er
 		OutputsConsumed:       consumed,
 	})
 
-	if err = m.storage.Settings().SetLatestCommitment(newModelCommitment); err != nil {
-		return nil, ierrors.Wrap(err, "failed to set latest commitment")
-	}
-
 	m.events.LatestCommitmentUpdated.Trigger(newModelCommitment)
 
 	if err = m.slotMutations.Evict(slot); err != nil {
@@ -301,8 +346,38 @@ func (m *Manager) createCommitment(slot iotago.SlotIndex) (*model.Commitment, er
 	return newModelCommitment, nil
 }
 
+// storeBlockCommitments indexes the accepted blocks of the committed slot by the commitment that includes them.
+func (m *Manager) storeBlockCommitments(commitmentID iotago.CommitmentID, acceptedBlocks ads.Set[iotago.Identifier, iotago.BlockID]) error {
+	blockCommitments, err := m.storage.BlockCommitments(commitmentID.Slot())
+	if err != nil {
+		return ierrors.Wrapf(err, "failed to get block commitments storage for slot %d", commitmentID.Slot())
+	}
+
+	return acceptedBlocks.Stream(func(blockID iotago.BlockID) error {
+		return blockCommitments.Store(blockID, commitmentID)
+	})
+}
+
 func (m *Manager) AcceptedBlocksCount(index iotago.SlotIndex) int {
 	return m.slotMutations.AcceptedBlocksCount(index)
 }
 
+// PendingTasks returns the number of accepted blocks and slot commitments that are waiting to be processed.
+func (m *Manager) PendingTasks() (pendingTasks int) {
+	for _, workerPool := range m.workers.Pools() {
+		pendingTasks += workerPool.PendingTasksCounter.Get()
+	}
+
+	return pendingTasks
+}
+
 var _ notarization.Notarization = new(Manager)
+
+// WithMinCommittableAge sets the minimum age of a slot before it is committed. It can only be used to delay the
+// commitments beyond the min committable age of the protocol parameters and needs to be smaller than their max
+// committable age, otherwise the engine refuses to start (0 = use the protocol parameters).
+func WithMinCommittableAge(minCommittableAge iotago.SlotIndex) options.Option[Manager] {
+	return func(m *Manager) {
+		m.optsMinCommittableAge = minCommittableAge
+	}
+}
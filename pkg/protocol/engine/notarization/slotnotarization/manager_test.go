@@ -0,0 +1,38 @@
+package slotnotarization
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/runtime/options"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func TestManager_MinCommittableAge(t *testing.T) {
+	apiProvider := iotago.SingleVersionProvider(iotago.V3API(iotago.NewV3SnapshotProtocolParameters(
+		iotago.WithLivenessOptions(15, 30, 10, 20, 60),
+	)))
+
+	newManager := func(minCommittableAge iotago.SlotIndex) *Manager {
+		return options.Apply(&Manager{apiProvider: apiProvider}, []options.Option[Manager]{WithMinCommittableAge(minCommittableAge)})
+	}
+
+	// the protocol parameters are used if no age is configured.
+	require.NoError(t, newManager(0).validateMinCommittableAge(apiProvider.LatestAPI().ProtocolParameters()))
+	require.Equal(t, iotago.SlotIndex(10), newManager(0).minCommittableAge(100))
+	require.True(t, newManager(0).isCommittable(90, 100))
+	require.False(t, newManager(0).isCommittable(91, 100))
+
+	// a valid age delays the commitments.
+	require.NoError(t, newManager(15).validateMinCommittableAge(apiProvider.LatestAPI().ProtocolParameters()))
+	require.Equal(t, iotago.SlotIndex(15), newManager(15).minCommittableAge(100))
+	require.True(t, newManager(15).isCommittable(85, 100))
+	require.False(t, newManager(15).isCommittable(86, 100))
+
+	// invalid ages are rejected (and ignored for the slots of protocol versions they are not valid for).
+	for _, invalidAge := range []iotago.SlotIndex{5, 20, 25} {
+		require.ErrorIs(t, newManager(invalidAge).validateMinCommittableAge(apiProvider.LatestAPI().ProtocolParameters()), ErrInvalidMinCommittableAge)
+		require.Equal(t, iotago.SlotIndex(10), newManager(invalidAge).minCommittableAge(100))
+	}
+}
@@ -5,13 +5,15 @@ import (
 )
 
 type Events struct {
-	UpdatedStatus *event.Event1[*SyncStatus]
+	UpdatedStatus       *event.Event1[*SyncStatus]
+	FinalizationStalled *event.Event1[*FinalizationStall]
 
 	event.Group[Events, *Events]
 }
 
 var NewEvents = event.CreateGroupConstructor(func() (newEvents *Events) {
 	return &Events{
-		UpdatedStatus: event.New1[*SyncStatus](),
+		UpdatedStatus:       event.New1[*SyncStatus](),
+		FinalizationStalled: event.New1[*FinalizationStall](),
 	}
 })
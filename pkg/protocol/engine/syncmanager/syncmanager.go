@@ -16,6 +16,10 @@ type SyncManager interface {
 	// IsNodeSynced returns bool indicating if a node is synced.
 	IsNodeSynced() bool
 
+	// IsFinalizationStalled returns bool indicating if the gap between the latest accepted and the latest finalized
+	// slot exceeds the configured threshold.
+	IsFinalizationStalled() bool
+
 	// LastAcceptedBlockSlot returns the slot of the latest accepted block.
 	LastAcceptedBlockSlot() iotago.SlotIndex
 
@@ -49,4 +53,29 @@ type SyncStatus struct {
 	LatestFinalizedSlot    iotago.SlotIndex
 	LastPrunedEpoch        iotago.EpochIndex
 	HasPruned              bool
+	FinalizationStalled    bool
+}
+
+// FinalizationStall contains the diagnostics of a detected finalization stall.
+type FinalizationStall struct {
+	// LastAcceptedBlockSlot is the slot of the latest accepted block.
+	LastAcceptedBlockSlot iotago.SlotIndex
+
+	// LatestFinalizedSlot is the latest finalized slot.
+	LatestFinalizedSlot iotago.SlotIndex
+
+	// LatestCommitmentSlot is the slot of the latest commitment that the attestations were checked for.
+	LatestCommitmentSlot iotago.SlotIndex
+
+	// MissingAttestations contains the committee members that are not attested in the latest commitment.
+	MissingAttestations []iotago.AccountID
+}
+
+// Lag returns the number of slots that the finalization lags behind the acceptance.
+func (f *FinalizationStall) Lag() iotago.SlotIndex {
+	if f.LastAcceptedBlockSlot < f.LatestFinalizedSlot {
+		return 0
+	}
+
+	return f.LastAcceptedBlockSlot - f.LatestFinalizedSlot
 }
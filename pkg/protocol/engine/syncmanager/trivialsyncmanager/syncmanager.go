@@ -3,12 +3,14 @@ package trivialsyncmanager
 import (
 	"time"
 
+	"github.com/iotaledger/hive.go/ds"
 	"github.com/iotaledger/hive.go/runtime/event"
 	"github.com/iotaledger/hive.go/runtime/module"
 	"github.com/iotaledger/hive.go/runtime/options"
 	"github.com/iotaledger/hive.go/runtime/syncutils"
 	"github.com/iotaledger/hive.go/runtime/workerpool"
 	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/profiling"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/syncmanager"
@@ -46,8 +48,12 @@ type SyncManager struct {
 	isBootstrapped     bool
 	isBootstrappedLock syncutils.RWMutex
 
-	optsIsBootstrappedFunc    isBootstrappedFunc
-	optsBootstrappedThreshold time.Duration
+	isFinalizationStalled     bool
+	isFinalizationStalledLock syncutils.RWMutex
+
+	optsIsBootstrappedFunc         isBootstrappedFunc
+	optsBootstrappedThreshold      time.Duration
+	optsFinalizationStallThreshold iotago.SlotIndex
 
 	module.Module
 }
@@ -56,10 +62,11 @@ type SyncManager struct {
 func NewProvider(opts ...options.Option[SyncManager]) module.Provider[*engine.Engine, syncmanager.SyncManager] {
 	return module.Provide(func(e *engine.Engine) syncmanager.SyncManager {
 		s := New(e, e.Storage.Settings().LatestCommitment(), e.Storage.Settings().LatestFinalizedSlot(), opts...)
-		asyncOpt := event.WithWorkerPool(e.Workers.CreatePool("SyncManager", workerpool.WithWorkerCount(1)))
+		asyncOpt := event.WithWorkerPool(profiling.CreatePool(e.Workers, "SyncManager", workerpool.WithWorkerCount(1)))
 
 		e.Events.BlockGadget.BlockAccepted.Hook(func(b *blocks.Block) {
 			if s.updateLastAcceptedBlock(b.ID()) {
+				s.updateFinalizationStallStatus()
 				s.triggerUpdate()
 			}
 		}, asyncOpt)
@@ -85,6 +92,7 @@ func NewProvider(opts ...options.Option[SyncManager]) module.Provider[*engine.En
 
 		e.Events.SlotGadget.SlotFinalized.Hook(func(index iotago.SlotIndex) {
 			if s.updateFinalizedSlot(index) {
+				s.updateFinalizationStallStatus()
 				s.triggerUpdate()
 			}
 		}, asyncOpt)
@@ -146,6 +154,7 @@ func (s *SyncManager) SyncStatus() *syncmanager.SyncStatus {
 		LatestFinalizedSlot:    s.latestFinalizedSlot,
 		LastPrunedEpoch:        s.lastPrunedEpoch,
 		HasPruned:              s.hasPruned,
+		FinalizationStalled:    s.IsFinalizationStalled(),
 	}
 }
 
@@ -241,6 +250,74 @@ func (s *SyncManager) updatePrunedEpoch(index iotago.EpochIndex, hasPruned bool)
 	return false
 }
 
+// updateFinalizationStallStatus updates the finalization stall flag and triggers the FinalizationStalled event if the
+// gap between the latest accepted and the latest finalized slot started to exceed the configured threshold.
+func (s *SyncManager) updateFinalizationStallStatus() {
+	if s.optsFinalizationStallThreshold == 0 {
+		return
+	}
+
+	lastAcceptedBlockSlot := s.LastAcceptedBlockSlot()
+	latestFinalizedSlot := s.LatestFinalizedSlot()
+	stalled := lastAcceptedBlockSlot > latestFinalizedSlot && lastAcceptedBlockSlot-latestFinalizedSlot > s.optsFinalizationStallThreshold
+
+	s.isFinalizationStalledLock.Lock()
+	if s.isFinalizationStalled == stalled {
+		s.isFinalizationStalledLock.Unlock()
+
+		return
+	}
+	s.isFinalizationStalled = stalled
+	s.isFinalizationStalledLock.Unlock()
+
+	if stalled {
+		s.events.FinalizationStalled.Trigger(s.finalizationStall(lastAcceptedBlockSlot, latestFinalizedSlot))
+	}
+}
+
+// finalizationStall collects the diagnostics of a finalization stall.
+func (s *SyncManager) finalizationStall(lastAcceptedBlockSlot iotago.SlotIndex, latestFinalizedSlot iotago.SlotIndex) *syncmanager.FinalizationStall {
+	stall := &syncmanager.FinalizationStall{
+		LastAcceptedBlockSlot: lastAcceptedBlockSlot,
+		LatestFinalizedSlot:   latestFinalizedSlot,
+		LatestCommitmentSlot:  s.LatestCommitment().Slot(),
+		MissingAttestations:   make([]iotago.AccountID, 0),
+	}
+
+	committee, exists := s.engine.SybilProtection.SeatManager().CommitteeInSlot(stall.LatestCommitmentSlot)
+	if !exists {
+		return stall
+	}
+
+	committeeAccounts, err := committee.Accounts()
+	if err != nil {
+		s.engine.LogError("failed to get committee accounts", "slot", stall.LatestCommitmentSlot, "err", err)
+
+		return stall
+	}
+
+	// the attestations are not available for the slots before the end of the first attestation window
+	attestations, err := s.engine.Attestations.Get(stall.LatestCommitmentSlot)
+	if err != nil {
+		s.engine.LogDebug("failed to get attestations", "slot", stall.LatestCommitmentSlot, "err", err)
+
+		return stall
+	}
+
+	attestedAccounts := ds.NewSet[iotago.AccountID]()
+	for _, attestation := range attestations {
+		attestedAccounts.Add(attestation.Header.IssuerID)
+	}
+
+	for _, accountID := range committeeAccounts.IDs() {
+		if !attestedAccounts.Has(accountID) {
+			stall.MissingAttestations = append(stall.MissingAttestations, accountID)
+		}
+	}
+
+	return stall
+}
+
 func (s *SyncManager) IsBootstrapped() bool {
 	s.isBootstrappedLock.RLock()
 	defer s.isBootstrappedLock.RUnlock()
@@ -255,6 +332,13 @@ func (s *SyncManager) IsNodeSynced() bool {
 	return s.isSynced
 }
 
+func (s *SyncManager) IsFinalizationStalled() bool {
+	s.isFinalizationStalledLock.RLock()
+	defer s.isFinalizationStalledLock.RUnlock()
+
+	return s.isFinalizationStalled
+}
+
 func (s *SyncManager) LastAcceptedBlockSlot() iotago.SlotIndex {
 	s.lastAcceptedBlockSlotLock.RLock()
 	defer s.lastAcceptedBlockSlotLock.RUnlock()
@@ -305,3 +389,11 @@ func WithBootstrappedFunc(isBootstrapped func(*engine.Engine) bool) options.Opti
 		s.optsIsBootstrappedFunc = isBootstrapped
 	}
 }
+
+// WithFinalizationStallThreshold sets the number of slots that the latest finalized slot can lag behind the latest
+// accepted block slot before the finalization is considered to be stalled (0 disables the detection).
+func WithFinalizationStallThreshold(threshold iotago.SlotIndex) options.Option[SyncManager] {
+	return func(s *SyncManager) {
+		s.optsFinalizationStallThreshold = threshold
+	}
+}
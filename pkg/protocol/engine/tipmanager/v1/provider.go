@@ -5,6 +5,7 @@ import (
 	"github.com/iotaledger/hive.go/runtime/event"
 	"github.com/iotaledger/hive.go/runtime/module"
 	"github.com/iotaledger/hive.go/runtime/workerpool"
+	"github.com/iotaledger/iota-core/pkg/profiling"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/tipmanager"
 )
@@ -15,7 +16,7 @@ func NewProvider() module.Provider[*engine.Engine, tipmanager.TipManager] {
 		t := New(e.BlockCache.Block)
 
 		e.Constructed.OnTrigger(func() {
-			tipWorker := e.Workers.CreatePool("AddTip", workerpool.WithWorkerCount(2))
+			tipWorker := profiling.CreatePool(e.Workers, "AddTip", workerpool.WithWorkerCount(2))
 			e.Events.Scheduler.BlockScheduled.Hook(lo.Void(t.AddBlock), event.WithWorkerPool(tipWorker))
 			e.Events.Scheduler.BlockSkipped.Hook(lo.Void(t.AddBlock), event.WithWorkerPool(tipWorker))
 			e.BlockCache.Evict.Hook(t.Evict)
@@ -31,6 +31,18 @@ func NewProvider(opts ...options.Option[TipSelection]) module.Provider[*engine.E
 	})
 }
 
+// NewWeightedProvider creates a new TipSelection provider that prefers strong tips with a higher approval weight and a
+// more recent slot commitment. This lowers the risk of issued blocks being orphaned at the cost of a narrower DAG.
+func NewWeightedProvider(opts ...options.Option[TipSelection]) module.Provider[*engine.Engine, tipselection.TipSelection] {
+	return module.Provide(func(e *engine.Engine) tipselection.TipSelection {
+		return NewProvider(append([]options.Option[TipSelection]{
+			WithTipWeight(ApprovalAndCommitmentWeight(e.SybilProtection.SeatManager().OnlineCommittee().Size, func() iotago.SlotIndex {
+				return e.SyncManager.LatestCommitment().Slot()
+			})),
+		}, opts...)...)(e)
+	})
+}
+
 // DynamicLivenessThreshold returns a function that calculates the liveness threshold for a tip.
 func DynamicLivenessThreshold(committeeSizeProvider func() int) func(tip tipmanager.TipMetadata) time.Duration {
 	return func(tip tipmanager.TipMetadata) time.Duration {
@@ -49,3 +61,23 @@ func DynamicLivenessThreshold(committeeSizeProvider func() int) func(tip tipmana
 		return livenessThresholdLowerBound + time.Duration(approvalModifier*livenessWindow)
 	}
 }
+
+// ApprovalAndCommitmentWeight returns a function that calculates the weight of a tip for the weighted tip selection.
+func ApprovalAndCommitmentWeight(committeeSizeProvider func() int, latestCommitmentSlotProvider func() iotago.SlotIndex) func(tip tipmanager.TipMetadata) float64 {
+	return func(tip tipmanager.TipMetadata) float64 {
+		// The weight of a tip is determined by its approval and the age of its slot commitment:
+		//  approval: tips witnessed by 1/3 of the online committee weigh twice as much as tips without witnesses
+		//  commitment age: the weight is divided by the number of slots that the commitment lags behind (+1)
+		var (
+			expectedWitnessCount = math.Max(math.Ceil(float64(committeeSizeProvider())/3.0), 1)
+			approvalModifier     = math.Min(float64(tip.Block().WitnessCount())/expectedWitnessCount, 1.0)
+			commitmentAge        iotago.SlotIndex
+		)
+
+		if latestCommitmentSlot, commitmentSlot := latestCommitmentSlotProvider(), tip.Block().SlotCommitmentID().Slot(); latestCommitmentSlot > commitmentSlot {
+			commitmentAge = latestCommitmentSlot - commitmentSlot
+		}
+
+		return (1 + approvalModifier) / float64(commitmentAge+1)
+	}
+}
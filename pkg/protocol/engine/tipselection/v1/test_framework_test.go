@@ -26,7 +26,8 @@ type TestFramework struct {
 
 	expectedLivenessDuration func(tip tipmanager.TipMetadata) time.Duration
 
-	optCommitteeSize int
+	optCommitteeSize       int
+	optTipSelectionOptions []options.Option[tipselectionv1.TipSelection]
 }
 
 func NewTestFramework(test *testing.T, opts ...options.Option[TestFramework]) *TestFramework {
@@ -47,7 +48,7 @@ func NewTestFramework(test *testing.T, opts ...options.Option[TestFramework]) *T
 
 		t.TipManager = tipmanagertests.NewTestFramework(test)
 
-		t.Instance = tipselectionv1.New().Construct(
+		t.Instance = tipselectionv1.New(t.optTipSelectionOptions...).Construct(
 			t.TipManager.Instance,
 			spenddagv1.New[iotago.TransactionID, mempool.StateID, ledger.BlockVoteRank](t.CommitteeSize),
 			transactionMetadataRetriever,
@@ -90,3 +91,9 @@ func WithCommitteeSize(size int) options.Option[TestFramework] {
 		args.optCommitteeSize = size
 	}
 }
+
+func WithTipSelectionOptions(opts ...options.Option[tipselectionv1.TipSelection]) options.Option[TestFramework] {
+	return func(args *TestFramework) {
+		args.optTipSelectionOptions = opts
+	}
+}
@@ -56,6 +56,10 @@ type TipSelection struct {
 	// optMaxWeakReferences contains the maximum number of weak references that are allowed.
 	optMaxWeakReferences int
 
+	// optTipWeight contains an optional function that is used to weigh the strong tips during the tip selection (nil =
+	// uniformly random tip selection).
+	optTipWeight func(tip tipmanager.TipMetadata) float64
+
 	// livenessThresholdQueueMutex is used to synchronize access to the liveness threshold queue.
 	livenessThresholdQueueMutex syncutils.RWMutex
 
@@ -111,7 +115,7 @@ func (t *TipSelection) SelectTips(amount int) (references model.ParentReferences
 	_ = t.spendDAG.ReadConsistent(func(_ spenddag.ReadLockedSpendDAG[iotago.TransactionID, mempool.StateID, ledger.BlockVoteRank]) error {
 		previousLikedInsteadConflicts := ds.NewSet[iotago.TransactionID]()
 
-		if t.collectReferences(references, iotago.StrongParentType, t.tipManager.StrongTips, func(tip tipmanager.TipMetadata) {
+		if t.collectReferences(references, iotago.StrongParentType, t.strongTips, func(tip tipmanager.TipMetadata) {
 			addedLikedInsteadReferences, updatedLikedInsteadConflicts, err := t.likedInsteadReferences(previousLikedInsteadConflicts, tip)
 			if err != nil {
 				tip.TipPool().Set(tipmanager.WeakTipPool)
@@ -162,6 +166,16 @@ func (t *TipSelection) Shutdown() {
 	t.TriggerStopped()
 }
 
+// strongTips returns the strong tips of the TipManager (with an optional limit) which are either selected uniformly at
+// random or according to their weight if a tip weight function was configured.
+func (t *TipSelection) strongTips(optAmount ...int) []tipmanager.TipMetadata {
+	if t.optTipWeight == nil {
+		return t.tipManager.StrongTips(optAmount...)
+	}
+
+	return weightedTips(t.tipManager.StrongTips(), t.optTipWeight, optAmount...)
+}
+
 // classifyTip determines the initial tip pool of the given tip.
 func (t *TipSelection) classifyTip(tipMetadata tipmanager.TipMetadata) {
 	if t.isValidStrongTip(tipMetadata.Block()) {
@@ -299,6 +313,14 @@ func WithMaxWeakReferences(maxWeakReferences int) options.Option[TipSelection] {
 	}
 }
 
+// WithTipWeight is an option for the TipSelection that allows to configure a function that is used to weigh the strong
+// tips, so that tips with a higher weight are more likely to be selected.
+func WithTipWeight(tipWeight func(tip tipmanager.TipMetadata) float64) options.Option[TipSelection] {
+	return func(tipManager *TipSelection) {
+		tipManager.optTipWeight = tipWeight
+	}
+}
+
 // monotonicallyIncreasing returns the maximum of the two given times which is used as a transformation function to make
 // the acceptance time of the TipSelection monotonically increasing.
 func monotonicallyIncreasing(currentTime time.Time, newTime time.Time) time.Time {
@@ -0,0 +1,48 @@
+package tipselectionv1
+
+import (
+	"math"
+	"math/rand"
+	"sort"
+
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/tipmanager"
+)
+
+// weightedTips returns the given tips (with an optional limit) in a random order in which the probability of a tip to
+// be selected before the others is proportional to its weight (weighted random sampling without replacement).
+//
+// Tips with a weight of zero or less are only returned after all other tips.
+func weightedTips(tips []tipmanager.TipMetadata, tipWeight func(tip tipmanager.TipMetadata) float64, optAmount ...int) []tipmanager.TipMetadata {
+	type weightedTip struct {
+		tip tipmanager.TipMetadata
+		key float64
+	}
+
+	// we use the algorithm of Efraimidis and Spirakis, which assigns the key u^(1/w) to every tip and selects the tips
+	// with the largest keys.
+	weightedCandidates := make([]*weightedTip, 0, len(tips))
+	for _, tip := range tips {
+		key := -1.0
+		if weight := tipWeight(tip); weight > 0 {
+			key = math.Pow(rand.Float64(), 1/weight)
+		}
+
+		weightedCandidates = append(weightedCandidates, &weightedTip{tip: tip, key: key})
+	}
+
+	sort.Slice(weightedCandidates, func(i, j int) bool {
+		return weightedCandidates[i].key > weightedCandidates[j].key
+	})
+
+	amount := len(weightedCandidates)
+	if len(optAmount) > 0 && optAmount[0] < amount {
+		amount = optAmount[0]
+	}
+
+	selectedTips := make([]tipmanager.TipMetadata, 0, amount)
+	for _, weightedCandidate := range weightedCandidates[:amount] {
+		selectedTips = append(selectedTips, weightedCandidate.tip)
+	}
+
+	return selectedTips
+}
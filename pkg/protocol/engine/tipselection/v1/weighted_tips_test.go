@@ -0,0 +1,74 @@
+package tipselectionv1_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/iota-core/pkg/core/account"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/tipmanager"
+	tipselectionv1 "github.com/iotaledger/iota-core/pkg/protocol/engine/tipselection/v1"
+	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/builder"
+)
+
+func TestTipSelection_WeightedStrongTips(t *testing.T) {
+	var preferredBlockID iotago.BlockID
+
+	tf := NewTestFramework(t, WithTipSelectionOptions(tipselectionv1.WithTipWeight(func(tip tipmanager.TipMetadata) float64 {
+		if tip.ID() == preferredBlockID {
+			return 1
+		}
+
+		return 0
+	})))
+
+	tf.TipManager.CreateBlock("Block1", map[iotago.ParentsType][]string{iotago.StrongParentType: {"Genesis"}})
+	tf.TipManager.CreateBlock("Block2", map[iotago.ParentsType][]string{iotago.StrongParentType: {"Genesis"}})
+	tf.TipManager.CreateBlock("Block3", map[iotago.ParentsType][]string{iotago.StrongParentType: {"Genesis"}})
+	tf.TipManager.AddBlock("Block1")
+	tf.TipManager.AddBlock("Block2")
+	tf.TipManager.AddBlock("Block3")
+	tf.TipManager.RequireStrongTips("Block1", "Block2", "Block3")
+
+	// tips with a positive weight are always selected before the tips without weight.
+	for _, alias := range []string{"Block1", "Block2", "Block3"} {
+		preferredBlockID = tf.TipManager.BlockID(alias)
+
+		for i := 0; i < 10; i++ {
+			require.Equal(t, iotago.BlockIDs{preferredBlockID}, tf.Instance.SelectTips(1)[iotago.StrongParentType])
+		}
+	}
+
+	// the tips without weight are still selected if more tips are requested.
+	require.ElementsMatch(t, iotago.BlockIDs{tf.TipManager.BlockID("Block1"), tf.TipManager.BlockID("Block2"), tf.TipManager.BlockID("Block3")}, tf.Instance.SelectTips(3)[iotago.StrongParentType])
+}
+
+func TestTipSelection_ApprovalAndCommitmentWeight(t *testing.T) {
+	tf := NewTestFramework(t)
+
+	latestCommitmentSlot := iotago.SlotIndex(10)
+	tipWeight := tipselectionv1.ApprovalAndCommitmentWeight(tf.CommitteeSize, func() iotago.SlotIndex { return latestCommitmentSlot })
+
+	withSlotCommitment := func(slot iotago.SlotIndex) func(*builder.BasicBlockBuilder) {
+		return func(blockBuilder *builder.BasicBlockBuilder) {
+			blockBuilder.SlotCommitmentID(iotago.NewCommitmentID(slot, iotago.Identifier{}))
+		}
+	}
+
+	tf.TipManager.CreateBlock("Fresh", map[iotago.ParentsType][]string{iotago.StrongParentType: {"Genesis"}}, withSlotCommitment(10))
+	tf.TipManager.CreateBlock("Stale", map[iotago.ParentsType][]string{iotago.StrongParentType: {"Genesis"}}, withSlotCommitment(7))
+
+	require.Equal(t, 1.0, tipWeight(tf.TipManager.AddBlock("Fresh")))
+	require.Equal(t, 0.25, tipWeight(tf.TipManager.AddBlock("Stale")))
+
+	// a third of the committee (rounded up) doubles the weight of a tip.
+	for seat := account.SeatIndex(0); seat < 4; seat++ {
+		tf.TipManager.Block("Fresh").AddWitness(seat)
+	}
+	require.Equal(t, 2.0, tipWeight(tf.TipManager.TipMetadata("Fresh")))
+
+	// commitments that are newer than the latest commitment of the node are not rewarded.
+	latestCommitmentSlot = 5
+	require.Equal(t, 2.0, tipWeight(tf.TipManager.TipMetadata("Fresh")))
+}
@@ -14,6 +14,12 @@ const (
 	StoreKeyPrefixSlotDiffs byte = 4
 
 	StoreKeyPrefixStateTree byte = 5
+
+	// StoreKeyPrefixOutputMetadata defines the prefix for the auxiliary metadata of Outputs.
+	StoreKeyPrefixOutputMetadata byte = 6
+
+	// StoreKeyPrefixOutputIndex defines the prefix for the secondary indexes of the unspent Outputs.
+	StoreKeyPrefixOutputIndex byte = 7
 )
 
 /*
@@ -59,6 +65,27 @@ const (
        Empty
 
 
+   Output metadata:
+   ================
+   Key:
+       StoreKeyPrefixOutputMetadata + iotago.OutputID + OutputMetadataExtensionID
+                 1 byte             +     34 bytes    +          1 byte
+
+   Value:
+       Version + Metadata
+       1 byte  + X bytes
+
+
+   Output index (see indexer.Indexer):
+   ===================================
+   Key:
+       StoreKeyPrefixOutputIndex + AddressHash (iotago.Identifier) + iotago.OutputType + iotago.OutputID
+                 1 byte          +            32 bytes             +       1 byte      +     34 bytes
+
+   Value:
+       Empty
+
+
    Slot diffs:
    ================
    Key:
@@ -0,0 +1,241 @@
+package indexer
+
+import (
+	"bytes"
+	"slices"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/hive.go/serializer/v2"
+	"github.com/iotaledger/hive.go/serializer/v2/stream"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/utxoledger"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// addressKeyLength is the length of the part of the key that identifies an address.
+const addressKeyLength = serializer.OneByte + iotago.IdentifierLength
+
+// Indexer maintains an index from the addresses that are referenced by the unspent outputs to their output IDs.
+//
+// An output is indexed for all addresses of its unlock conditions (address, state controller, governor, immutable
+// account, expiration and storage deposit return addresses), so that it can be looked up by the owner as well as by the
+// accounts, NFTs and anchors that control it. Delegation outputs are additionally indexed for their validator.
+type Indexer struct {
+	ledger *utxoledger.Manager
+}
+
+// New creates a new Indexer for the given ledger that (re-)indexes all unspent outputs and registers itself to be
+// updated together with the ledger.
+func New(ledger *utxoledger.Manager) (*Indexer, error) {
+	i := &Indexer{
+		ledger: ledger,
+	}
+
+	ledger.WriteLockLedger()
+	defer ledger.WriteUnlockLedger()
+
+	// the index is rebuilt from scratch, as the ledger might have been changed while the indexer was not registered.
+	if err := i.rebuild(); err != nil {
+		return nil, ierrors.Wrap(err, "failed to rebuild output index")
+	}
+
+	ledger.RegisterOutputIndexerWithoutLocking(i)
+
+	return i, nil
+}
+
+// OutputIDsByAddress returns the IDs of the unspent outputs that reference the given address.
+func (i *Indexer) OutputIDsByAddress(address iotago.Address) (iotago.OutputIDs, error) {
+	return i.outputIDs(addressKey(address))
+}
+
+// OutputIDsByAddressAndType returns the IDs of the unspent outputs of the given type that reference the given address.
+func (i *Indexer) OutputIDsByAddressAndType(address iotago.Address, outputType iotago.OutputType) (iotago.OutputIDs, error) {
+	return i.outputIDs(append(addressKey(address), byte(outputType)))
+}
+
+// ForEachOutput calls the consumer for the unspent outputs of the given type that reference the given address, ordered by
+// their output ID and starting at the given output ID, until the consumer returns false. The outputs are read while the
+// ledger is locked, so they all belong to the ledger slot that is passed to the consumer and returned.
+func (i *Indexer) ForEachOutput(address iotago.Address, outputType iotago.OutputType, startOutputID iotago.OutputID, consumer func(output *utxoledger.Output, ledgerSlot iotago.SlotIndex) bool) (ledgerSlot iotago.SlotIndex, err error) {
+	i.ledger.ReadLockLedger()
+	defer i.ledger.ReadUnlockLedger()
+
+	if ledgerSlot, err = i.ledger.ReadLedgerIndexWithoutLocking(); err != nil {
+		return 0, ierrors.Wrap(err, "failed to read ledger slot")
+	}
+
+	outputIDs, err := i.outputIDsWithoutLocking(append(addressKey(address), byte(outputType)))
+	if err != nil {
+		return 0, err
+	}
+
+	// the order of the keys depends on the underlying store, so the output IDs are sorted explicitly.
+	slices.SortFunc(outputIDs, func(a iotago.OutputID, b iotago.OutputID) int {
+		return bytes.Compare(a[:], b[:])
+	})
+
+	startIndex, _ := slices.BinarySearchFunc(outputIDs, startOutputID, func(a iotago.OutputID, b iotago.OutputID) int {
+		return bytes.Compare(a[:], b[:])
+	})
+
+	for _, outputID := range outputIDs[startIndex:] {
+		output, err := i.ledger.ReadOutputByOutputIDWithoutLocking(outputID)
+		if err != nil {
+			return 0, ierrors.Wrapf(err, "failed to read indexed output %s", outputID)
+		}
+
+		if !consumer(output, ledgerSlot) {
+			break
+		}
+	}
+
+	return ledgerSlot, nil
+}
+
+// IndexOutput adds the entries of the given output to the index.
+func (i *Indexer) IndexOutput(output *utxoledger.Output, mutations kvstore.BatchedMutations) error {
+	for _, address := range referencedAddresses(output.Output()) {
+		if err := mutations.Set(indexKey(address, output), []byte{}); err != nil {
+			return ierrors.Wrapf(err, "failed to index output %s for address %s", output.OutputID(), address)
+		}
+	}
+
+	return nil
+}
+
+// UnindexOutput removes the entries of the given output from the index.
+func (i *Indexer) UnindexOutput(output *utxoledger.Output, mutations kvstore.BatchedMutations) error {
+	for _, address := range referencedAddresses(output.Output()) {
+		if err := mutations.Delete(indexKey(address, output)); err != nil {
+			return ierrors.Wrapf(err, "failed to unindex output %s for address %s", output.OutputID(), address)
+		}
+	}
+
+	return nil
+}
+
+// outputIDs returns the IDs of the indexed outputs whose keys start with the given prefix.
+func (i *Indexer) outputIDs(keyPrefix []byte) (iotago.OutputIDs, error) {
+	i.ledger.ReadLockLedger()
+	defer i.ledger.ReadUnlockLedger()
+
+	return i.outputIDsWithoutLocking(keyPrefix)
+}
+
+// outputIDsWithoutLocking returns the IDs of the indexed outputs whose keys start with the given prefix without locking
+// the ledger.
+func (i *Indexer) outputIDsWithoutLocking(keyPrefix []byte) (iotago.OutputIDs, error) {
+	outputIDs := make(iotago.OutputIDs, 0)
+
+	var innerErr error
+	if err := i.ledger.KVStore().IterateKeys(keyPrefix, func(key kvstore.Key) bool {
+		outputID, _, err := iotago.OutputIDFromBytes(key[addressKeyLength+serializer.OneByte:])
+		if err != nil {
+			innerErr = err
+
+			return false
+		}
+
+		outputIDs = append(outputIDs, outputID)
+
+		return true
+	}); err != nil {
+		return nil, ierrors.Wrap(err, "failed to iterate output index")
+	}
+
+	if innerErr != nil {
+		return nil, ierrors.Wrap(innerErr, "failed to parse indexed output ID")
+	}
+
+	return outputIDs, nil
+}
+
+// rebuild clears the index and indexes all unspent outputs of the ledger.
+func (i *Indexer) rebuild() error {
+	if err := i.ledger.KVStore().DeletePrefix([]byte{utxoledger.StoreKeyPrefixOutputIndex}); err != nil {
+		return ierrors.Wrap(err, "failed to clear output index")
+	}
+
+	mutations, err := i.ledger.KVStore().Batched()
+	if err != nil {
+		return ierrors.Wrap(err, "failed to create batch")
+	}
+
+	var innerErr error
+	if err = i.ledger.ForEachUnspentOutput(func(output *utxoledger.Output) bool {
+		innerErr = i.IndexOutput(output, mutations)
+
+		return innerErr == nil
+	}, utxoledger.ReadLockLedger(false)); err != nil {
+		mutations.Cancel()
+
+		return ierrors.Wrap(err, "failed to iterate unspent outputs")
+	}
+
+	if innerErr != nil {
+		mutations.Cancel()
+
+		return innerErr
+	}
+
+	return mutations.Commit()
+}
+
+// referencedAddresses returns the distinct addresses that are referenced by the given output.
+func referencedAddresses(output iotago.Output) []iotago.Address {
+	unlockConditions := output.UnlockConditionSet()
+
+	candidates := make([]iotago.Address, 0)
+	if addressUnlockCondition := unlockConditions.Address(); addressUnlockCondition != nil {
+		candidates = append(candidates, addressUnlockCondition.Address)
+	}
+	if stateControllerUnlockCondition := unlockConditions.StateControllerAddress(); stateControllerUnlockCondition != nil {
+		candidates = append(candidates, stateControllerUnlockCondition.Address)
+	}
+	if governorUnlockCondition := unlockConditions.GovernorAddress(); governorUnlockCondition != nil {
+		candidates = append(candidates, governorUnlockCondition.Address)
+	}
+	if immutableAccountUnlockCondition := unlockConditions.ImmutableAccount(); immutableAccountUnlockCondition != nil {
+		candidates = append(candidates, immutableAccountUnlockCondition.Address)
+	}
+	if expirationUnlockCondition := unlockConditions.Expiration(); expirationUnlockCondition != nil {
+		candidates = append(candidates, expirationUnlockCondition.ReturnAddress)
+	}
+	if storageDepositReturnUnlockCondition := unlockConditions.StorageDepositReturn(); storageDepositReturnUnlockCondition != nil {
+		candidates = append(candidates, storageDepositReturnUnlockCondition.ReturnAddress)
+	}
+	if delegationOutput, isDelegationOutput := output.(*iotago.DelegationOutput); isDelegationOutput && delegationOutput.ValidatorAddress != nil {
+		candidates = append(candidates, delegationOutput.ValidatorAddress)
+	}
+
+	seenAddresses := make(map[string]struct{})
+
+	return lo.Filter(candidates, func(address iotago.Address) bool {
+		if _, seen := seenAddresses[address.Key()]; seen {
+			return false
+		}
+
+		seenAddresses[address.Key()] = struct{}{}
+
+		return true
+	})
+}
+
+// addressKey returns the key prefix of the entries of the given address. Addresses have a variable length, so they are
+// hashed to get keys of a fixed length that are not a prefix of each other.
+func addressKey(address iotago.Address) []byte {
+	byteBuffer := stream.NewByteBuffer(addressKeyLength)
+
+	// There can't be any errors.
+	_ = stream.Write(byteBuffer, utxoledger.StoreKeyPrefixOutputIndex)
+	_ = stream.Write(byteBuffer, iotago.IdentifierFromData(address.ID()))
+
+	return lo.PanicOnErr(byteBuffer.Bytes())
+}
+
+// indexKey returns the key of the entry of the given output for the given address.
+func indexKey(address iotago.Address, output *utxoledger.Output) []byte {
+	return append(append(addressKey(address), byte(output.OutputType())), lo.PanicOnErr(output.OutputID().Bytes())...)
+}
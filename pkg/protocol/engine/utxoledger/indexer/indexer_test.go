@@ -0,0 +1,133 @@
+package indexer_test
+
+import (
+	"bytes"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/utxoledger"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/utxoledger/indexer"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/utxoledger/tpkg"
+	iotago "github.com/iotaledger/iota.go/v4"
+	iotago_tpkg "github.com/iotaledger/iota.go/v4/tpkg"
+)
+
+func requireOutputsByAddress(t *testing.T, outputIndexer *indexer.Indexer, address iotago.Address, expected ...*utxoledger.Output) {
+	actual, err := outputIndexer.OutputIDsByAddress(address)
+	require.NoError(t, err)
+
+	requireOutputIDs(t, expected, actual)
+}
+
+func requireOutputsByAddressAndType(t *testing.T, outputIndexer *indexer.Indexer, address iotago.Address, outputType iotago.OutputType, expected ...*utxoledger.Output) {
+	actual, err := outputIndexer.OutputIDsByAddressAndType(address, outputType)
+	require.NoError(t, err)
+
+	requireOutputIDs(t, expected, actual)
+}
+
+func requireOutputIDs(t *testing.T, expected []*utxoledger.Output, actual iotago.OutputIDs) {
+	expectedOutputIDs := make(iotago.OutputIDs, 0, len(expected))
+	for _, output := range expected {
+		expectedOutputIDs = append(expectedOutputIDs, output.OutputID())
+	}
+
+	require.ElementsMatch(t, expectedOutputIDs, actual)
+}
+
+func TestIndexer(t *testing.T) {
+	manager := utxoledger.New(mapdb.NewMapDB(), iotago.SingleVersionProvider(iotago_tpkg.ZeroCostTestAPI))
+
+	ownerAddress := iotago_tpkg.RandEd25519Address()
+	accountAddress := iotago_tpkg.RandAccountAddress()
+	validatorAddress := iotago_tpkg.RandAccountAddress()
+
+	// outputs that exist before the indexer is created are indexed on startup.
+	genesisOutput := tpkg.RandLedgerStateOutputOnAddress(iotago.OutputBasic, ownerAddress)
+	require.NoError(t, manager.AddGenesisUnspentOutput(genesisOutput))
+
+	outputIndexer, err := indexer.New(manager)
+	require.NoError(t, err)
+
+	basicOutput := tpkg.RandLedgerStateOutputOnAddress(iotago.OutputBasic, ownerAddress)
+	nftOutput := tpkg.RandLedgerStateOutputOnAddress(iotago.OutputNFT, ownerAddress)
+	anchorOutput := tpkg.RandLedgerStateOutputOnAddress(iotago.OutputAnchor, ownerAddress)
+	accountOwnedOutput := tpkg.RandLedgerStateOutputOnAddress(iotago.OutputBasic, accountAddress)
+	foundryOutput := tpkg.RandLedgerStateOutputOnAddress(iotago.OutputFoundry, accountAddress)
+	delegationOutput := tpkg.RandLedgerStateOutputWithOutput(&iotago.DelegationOutput{
+		Amount:           100,
+		DelegatedAmount:  100,
+		ValidatorAddress: validatorAddress,
+		UnlockConditions: iotago.DelegationOutputUnlockConditions{
+			&iotago.AddressUnlockCondition{Address: ownerAddress},
+		},
+	})
+
+	slot := iotago.SlotIndex(10)
+	outputs := utxoledger.Outputs{basicOutput, nftOutput, anchorOutput, accountOwnedOutput, foundryOutput, delegationOutput}
+	spents := utxoledger.Spents{tpkg.RandLedgerStateSpentWithOutput(genesisOutput, slot)}
+
+	requireOutputsByAddress(t, outputIndexer, ownerAddress, genesisOutput)
+
+	require.NoError(t, manager.ApplyDiff(slot, outputs, spents))
+
+	// the anchor output references the owner address as state controller and governor, but is only indexed once.
+	requireOutputsByAddress(t, outputIndexer, ownerAddress, basicOutput, nftOutput, anchorOutput, delegationOutput)
+	requireOutputsByAddressAndType(t, outputIndexer, ownerAddress, iotago.OutputNFT, nftOutput)
+	requireOutputsByAddress(t, outputIndexer, accountAddress, accountOwnedOutput, foundryOutput)
+	requireOutputsByAddressAndType(t, outputIndexer, accountAddress, iotago.OutputFoundry, foundryOutput)
+	requireOutputsByAddress(t, outputIndexer, validatorAddress, delegationOutput)
+
+	require.NoError(t, manager.RollbackDiff(slot, outputs, spents))
+
+	requireOutputsByAddress(t, outputIndexer, ownerAddress, genesisOutput)
+	requireOutputsByAddress(t, outputIndexer, accountAddress)
+	requireOutputsByAddress(t, outputIndexer, validatorAddress)
+}
+
+func TestIndexer_ForEachOutput(t *testing.T) {
+	manager := utxoledger.New(mapdb.NewMapDB(), iotago.SingleVersionProvider(iotago_tpkg.ZeroCostTestAPI))
+
+	outputIndexer, err := indexer.New(manager)
+	require.NoError(t, err)
+
+	ownerAddress := iotago_tpkg.RandEd25519Address()
+
+	outputs := make(utxoledger.Outputs, 0)
+	for i := 0; i < 5; i++ {
+		outputs = append(outputs, tpkg.RandLedgerStateOutputOnAddress(iotago.OutputBasic, ownerAddress))
+	}
+	outputs = append(outputs, tpkg.RandLedgerStateOutputOnAddress(iotago.OutputNFT, ownerAddress))
+
+	require.NoError(t, manager.ApplyDiff(7, outputs, utxoledger.Spents{}))
+
+	sortedOutputIDs, err := outputIndexer.OutputIDsByAddressAndType(ownerAddress, iotago.OutputBasic)
+	require.NoError(t, err)
+	slices.SortFunc(sortedOutputIDs, func(a iotago.OutputID, b iotago.OutputID) int {
+		return bytes.Compare(a[:], b[:])
+	})
+
+	readOutputIDs := func(startOutputID iotago.OutputID, limit int) iotago.OutputIDs {
+		outputIDs := make(iotago.OutputIDs, 0)
+
+		ledgerSlot, err := outputIndexer.ForEachOutput(ownerAddress, iotago.OutputBasic, startOutputID, func(output *utxoledger.Output, ledgerSlot iotago.SlotIndex) bool {
+			require.Equal(t, iotago.SlotIndex(7), ledgerSlot)
+
+			outputIDs = append(outputIDs, output.OutputID())
+
+			return len(outputIDs) < limit
+		})
+		require.NoError(t, err)
+		require.Equal(t, iotago.SlotIndex(7), ledgerSlot)
+
+		return outputIDs
+	}
+
+	require.Equal(t, sortedOutputIDs, readOutputIDs(iotago.EmptyOutputID, 10))
+	require.Equal(t, sortedOutputIDs[:2], readOutputIDs(iotago.EmptyOutputID, 2))
+	require.Equal(t, sortedOutputIDs[2:4], readOutputIDs(sortedOutputIDs[2], 2))
+	require.Empty(t, readOutputIDs(iotago.OutputID{0xff, 0xff, 0xff, 0xff}, 10))
+}
@@ -7,6 +7,7 @@ import (
 	"github.com/iotaledger/hive.go/ierrors"
 	"github.com/iotaledger/hive.go/kvstore"
 	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/hive.go/runtime/options"
 	"github.com/iotaledger/hive.go/runtime/syncutils"
 	"github.com/iotaledger/hive.go/serializer/v2/stream"
 	iotago "github.com/iotaledger/iota.go/v4"
@@ -22,10 +23,27 @@ type Manager struct {
 	stateTree ads.Map[iotago.Identifier, iotago.OutputID, *stateTreeMetadata]
 
 	apiProvider iotago.APIProvider
+
+	outputMetadataExtensions map[OutputMetadataExtensionID]OutputMetadataExtension
+
+	// outputIndexers contains the indexers that are updated together with the unspent outputs.
+	outputIndexers []OutputIndexer
+
+	// unspentFilter short-circuits the lookups of output IDs that are not unspent (nil if disabled).
+	unspentFilter *unspentFilter
+
+	// optsUnspentFilterEnabled defines whether the unspent output IDs are tracked in an in-memory bloom filter.
+	optsUnspentFilterEnabled bool
+
+	// optsUnspentFilterFalsePositiveRate defines the false positive rate the bloom filter is sized for.
+	optsUnspentFilterFalsePositiveRate float64
+
+	// optsUnspentFilterCapacity defines the minimum number of output IDs the bloom filter is sized for.
+	optsUnspentFilterCapacity uint64
 }
 
-func New(store kvstore.KVStore, apiProvider iotago.APIProvider) *Manager {
-	return &Manager{
+func New(store kvstore.KVStore, apiProvider iotago.APIProvider, opts ...options.Option[Manager]) *Manager {
+	return options.Apply(&Manager{
 		store: store,
 		stateTree: ads.NewMap[iotago.Identifier](lo.PanicOnErr(store.WithExtendedRealm(kvstore.Realm{StoreKeyPrefixStateTree})),
 			iotago.Identifier.Bytes,
@@ -35,8 +53,17 @@ func New(store kvstore.KVStore, apiProvider iotago.APIProvider) *Manager {
 			(*stateTreeMetadata).Bytes,
 			stateMetadataFromBytes,
 		),
-		apiProvider: apiProvider,
-	}
+		apiProvider:                        apiProvider,
+		outputMetadataExtensions:           make(map[OutputMetadataExtensionID]OutputMetadataExtension),
+		optsUnspentFilterFalsePositiveRate: 0.01,
+		optsUnspentFilterCapacity:          1_000_000,
+	}, opts, func(m *Manager) {
+		if m.optsUnspentFilterEnabled {
+			if err := m.rebuildUnspentFilter(); err != nil {
+				panic(ierrors.Wrap(err, "failed to build unspent output filter"))
+			}
+		}
+	})
 }
 
 // KVStore returns the underlying KVStore.
@@ -55,7 +82,15 @@ func (m *Manager) ClearLedgerState() (err error) {
 		}
 	}()
 
-	return m.store.Clear()
+	if err = m.store.Clear(); err != nil {
+		return err
+	}
+
+	if m.optsUnspentFilterEnabled {
+		return m.rebuildUnspentFilter()
+	}
+
+	return nil
 }
 
 func (m *Manager) ReadLockLedger() {
@@ -97,6 +132,12 @@ func (m *Manager) PruneSlotIndexWithoutLocking(slot iotago.SlotIndex) error {
 			return err
 		}
 
+		if err := m.deleteOutputMetadata(spent.OutputID(), mutations); err != nil {
+			mutations.Cancel()
+
+			return err
+		}
+
 		if err := deleteSpent(spent, mutations); err != nil {
 			mutations.Cancel()
 
@@ -161,9 +202,19 @@ func (m *Manager) ApplyDiffWithoutLocking(slot iotago.SlotIndex, newOutputs Outp
 
 			return err
 		}
+		if err := m.storeOutputMetadata(output, mutations); err != nil {
+			mutations.Cancel()
+
+			return err
+		}
 		if err := markAsUnspent(output, mutations); err != nil {
 			mutations.Cancel()
 
+			return err
+		}
+		if err := m.indexOutput(output, mutations); err != nil {
+			mutations.Cancel()
+
 			return err
 		}
 	}
@@ -172,6 +223,11 @@ func (m *Manager) ApplyDiffWithoutLocking(slot iotago.SlotIndex, newOutputs Outp
 		if err := storeSpentAndMarkOutputAsSpent(spent, mutations); err != nil {
 			mutations.Cancel()
 
+			return err
+		}
+		if err := m.unindexOutput(spent.output, mutations); err != nil {
+			mutations.Cancel()
+
 			return err
 		}
 	}
@@ -198,6 +254,10 @@ func (m *Manager) ApplyDiffWithoutLocking(slot iotago.SlotIndex, newOutputs Outp
 		return err
 	}
 
+	if err := m.addToUnspentFilter(newOutputs...); err != nil {
+		return err
+	}
+
 	for _, output := range newOutputs {
 		if err := m.stateTree.Set(output.OutputID(), newStateMetadata(output)); err != nil {
 			return ierrors.Wrapf(err, "failed to set new oputput in state tree, outputID: %s", output.OutputID())
@@ -237,11 +297,23 @@ func (m *Manager) RollbackDiffWithoutLocking(slot iotago.SlotIndex, newOutputs O
 			return err
 		}
 
+		if err := m.storeOutputMetadata(spent.output, mutations); err != nil {
+			mutations.Cancel()
+
+			return err
+		}
+
 		if err := deleteSpentAndMarkOutputAsUnspent(spent, mutations); err != nil {
 			mutations.Cancel()
 
 			return err
 		}
+
+		if err := m.indexOutput(spent.output, mutations); err != nil {
+			mutations.Cancel()
+
+			return err
+		}
 	}
 
 	// we have to delete the newOutputs of this milestone
@@ -254,6 +326,16 @@ func (m *Manager) RollbackDiffWithoutLocking(slot iotago.SlotIndex, newOutputs O
 		if err := deleteOutputLookups(output, mutations); err != nil {
 			mutations.Cancel()
 
+			return err
+		}
+		if err := m.deleteOutputMetadata(output.OutputID(), mutations); err != nil {
+			mutations.Cancel()
+
+			return err
+		}
+		if err := m.unindexOutput(output, mutations); err != nil {
+			mutations.Cancel()
+
 			return err
 		}
 	}
@@ -274,6 +356,10 @@ func (m *Manager) RollbackDiffWithoutLocking(slot iotago.SlotIndex, newOutputs O
 		return err
 	}
 
+	if err := m.addToUnspentFilter(lo.Map(newSpents, (*Spent).Output)...); err != nil {
+		return err
+	}
+
 	for _, spent := range newSpents {
 		if err := m.stateTree.Set(spent.OutputID(), newStateMetadata(spent.Output())); err != nil {
 			return ierrors.Wrapf(err, "failed to set new spent output in state tree, outputID: %s", spent.OutputID())
@@ -336,16 +422,32 @@ func (m *Manager) importUnspentOutputWithoutLocking(unspentOutput *Output) error
 		return err
 	}
 
+	if err := m.storeOutputMetadata(unspentOutput, mutations); err != nil {
+		mutations.Cancel()
+
+		return err
+	}
+
 	if err := markAsUnspent(unspentOutput, mutations); err != nil {
 		mutations.Cancel()
 
 		return err
 	}
 
+	if err := m.indexOutput(unspentOutput, mutations); err != nil {
+		mutations.Cancel()
+
+		return err
+	}
+
 	if err := mutations.Commit(); err != nil {
 		return err
 	}
 
+	if err := m.addToUnspentFilter(unspentOutput); err != nil {
+		return err
+	}
+
 	if err := m.stateTree.Set(unspentOutput.OutputID(), newStateMetadata(unspentOutput)); err != nil {
 		return ierrors.Wrapf(err, "failed to set state tree entry for output, outputID: %s", unspentOutput.OutputID())
 	}
@@ -403,3 +505,25 @@ func (m *Manager) LedgerStateSHA256Sum() ([]byte, error) {
 	// calculate sha256 hash
 	return ledgerStateHash.Sum(nil), nil
 }
+
+// WithUnspentFilterEnabled defines whether the IDs of the unspent outputs are tracked in an in-memory bloom filter that
+// short-circuits the lookups of output IDs that are not unspent.
+func WithUnspentFilterEnabled(enabled bool) options.Option[Manager] {
+	return func(m *Manager) {
+		m.optsUnspentFilterEnabled = enabled
+	}
+}
+
+// WithUnspentFilterFalsePositiveRate defines the false positive rate the unspent output filter is sized for.
+func WithUnspentFilterFalsePositiveRate(falsePositiveRate float64) options.Option[Manager] {
+	return func(m *Manager) {
+		m.optsUnspentFilterFalsePositiveRate = falsePositiveRate
+	}
+}
+
+// WithUnspentFilterCapacity defines the minimum number of output IDs the unspent output filter is sized for.
+func WithUnspentFilterCapacity(capacity uint64) options.Option[Manager] {
+	return func(m *Manager) {
+		m.optsUnspentFilterCapacity = capacity
+	}
+}
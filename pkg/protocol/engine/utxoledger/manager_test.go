@@ -2,10 +2,15 @@
 package utxoledger_test
 
 import (
+	"bytes"
+	"crypto/sha256"
+	"fmt"
+	"sort"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/iotaledger/hive.go/kvstore"
 	"github.com/iotaledger/hive.go/kvstore/mapdb"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/utxoledger"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/utxoledger/tpkg"
@@ -238,3 +243,154 @@ func TestConfirmationApplyAndRollbackToPreviousLedger(t *testing.T) {
 	}))
 	require.Empty(t, spentByOutputID)
 }
+
+func TestUnspentFilter(t *testing.T) {
+	mapDB := mapdb.NewMapDB()
+
+	requireUnspent := func(manager *utxoledger.Manager, expectedUnspent bool, outputs ...*utxoledger.Output) {
+		for _, output := range outputs {
+			isUnspent, err := manager.IsOutputIDUnspentWithoutLocking(output.OutputID())
+			require.NoError(t, err)
+			require.Equal(t, expectedUnspent, isUnspent, "output %s", output.OutputID())
+		}
+	}
+
+	genesisOutputs := utxoledger.Outputs{
+		tpkg.RandLedgerStateOutputWithType(iotago.OutputBasic),
+		tpkg.RandLedgerStateOutputWithType(iotago.OutputBasic),
+	}
+
+	// the unspent outputs that exist in the store are added to the filter on startup.
+	require.NoError(t, utxoledger.New(mapDB, iotago.SingleVersionProvider(iotago_tpkg.ZeroCostTestAPI)).ApplyDiffWithoutLocking(1, genesisOutputs, utxoledger.Spents{}))
+
+	manager := utxoledger.New(mapDB, iotago.SingleVersionProvider(iotago_tpkg.ZeroCostTestAPI),
+		utxoledger.WithUnspentFilterEnabled(true),
+		utxoledger.WithUnspentFilterFalsePositiveRate(0.001),
+		utxoledger.WithUnspentFilterCapacity(4),
+	)
+	requireUnspent(manager, true, genesisOutputs...)
+	requireUnspent(manager, false, tpkg.RandLedgerStateOutput(), tpkg.RandLedgerStateOutput())
+
+	// more outputs than the filter was sized for cause the filter to be rebuilt.
+	newOutputs := make(utxoledger.Outputs, 0, 10)
+	for i := 0; i < 10; i++ {
+		newOutputs = append(newOutputs, tpkg.RandLedgerStateOutputWithType(iotago.OutputBasic))
+	}
+	spents := utxoledger.Spents{tpkg.RandLedgerStateSpentWithOutput(genesisOutputs[0], 2)}
+
+	require.NoError(t, manager.ApplyDiffWithoutLocking(2, newOutputs, spents))
+	requireUnspent(manager, true, newOutputs...)
+	requireUnspent(manager, true, genesisOutputs[1])
+	requireUnspent(manager, false, genesisOutputs[0])
+
+	// rolled back spents are unspent again.
+	require.NoError(t, manager.RollbackDiffWithoutLocking(2, newOutputs, spents))
+	requireUnspent(manager, true, genesisOutputs...)
+	requireUnspent(manager, false, newOutputs...)
+
+	require.NoError(t, manager.ClearLedgerState())
+	requireUnspent(manager, false, genesisOutputs...)
+}
+
+func TestApplyAndRollbackDiffSymmetry(t *testing.T) {
+	// every slot creates the outputs of the given types and spends the outputs with the given indexes (in the order in
+	// which they were created).
+	type slotChanges struct {
+		created []iotago.OutputType
+		spent   []int
+	}
+
+	slotsChanges := []slotChanges{
+		1: {created: []iotago.OutputType{iotago.OutputBasic, iotago.OutputAccount, iotago.OutputFoundry}},
+		2: {created: []iotago.OutputType{iotago.OutputDelegation, iotago.OutputNFT}, spent: []int{0}},
+		3: {created: []iotago.OutputType{iotago.OutputAnchor, iotago.OutputAccount}, spent: []int{1, 3}},
+		4: {spent: []int{2, 5, 6}},
+	}
+	latestSlot := iotago.SlotIndex(len(slotsChanges) - 1)
+
+	for _, targetSlot := range []iotago.SlotIndex{latestSlot, 3, 2, 1, 0} {
+		t.Run(fmt.Sprintf("rollback to slot %d", targetSlot), func(t *testing.T) {
+			store := mapdb.NewMapDB()
+			manager := utxoledger.New(store, iotago.SingleVersionProvider(iotago_tpkg.ZeroCostTestAPI))
+
+			// the ledger starts at the genesis slot (as if a snapshot was imported).
+			require.NoError(t, manager.StoreLedgerIndex(0))
+
+			slotDiffs := make(map[iotago.SlotIndex]*utxoledger.SlotDiff)
+			ledgerStates := map[iotago.SlotIndex]*ledgerStateSnapshot{0: newLedgerStateSnapshot(t, manager, store)}
+
+			createdOutputs := make(utxoledger.Outputs, 0)
+			for slot := iotago.SlotIndex(1); slot <= latestSlot; slot++ {
+				slotDiff := &utxoledger.SlotDiff{Slot: slot, Outputs: make(utxoledger.Outputs, 0), Spents: make(utxoledger.Spents, 0)}
+				for _, outputIndex := range slotsChanges[slot].spent {
+					slotDiff.Spents = append(slotDiff.Spents, tpkg.RandLedgerStateSpentWithOutput(createdOutputs[outputIndex], slot))
+				}
+				for _, outputType := range slotsChanges[slot].created {
+					slotDiff.Outputs = append(slotDiff.Outputs, tpkg.RandLedgerStateOutputWithType(outputType))
+				}
+
+				require.NoError(t, manager.ApplyDiff(slot, slotDiff.Outputs, slotDiff.Spents))
+				require.True(t, manager.CheckStateTree())
+
+				createdOutputs = append(createdOutputs, slotDiff.Outputs...)
+				slotDiffs[slot] = slotDiff
+				ledgerStates[slot] = newLedgerStateSnapshot(t, manager, store)
+			}
+
+			// the ledger state after the rollback is identical to the ledger state at the target slot.
+			require.NoError(t, manager.Rollback(targetSlot))
+			require.True(t, manager.CheckStateTree())
+			ledgerStates[targetSlot].requireEqual(t, newLedgerStateSnapshot(t, manager, store))
+
+			// re-applying the rolled back diffs restores the original ledger state.
+			for slot := targetSlot + 1; slot <= latestSlot; slot++ {
+				require.NoError(t, manager.ApplyDiff(slot, slotDiffs[slot].Outputs, slotDiffs[slot].Spents))
+				ledgerStates[slot].requireEqual(t, newLedgerStateSnapshot(t, manager, store), "re-apply of slot %d", slot)
+			}
+		})
+	}
+}
+
+// ledgerStateSnapshot contains the state roots of a ledger at a certain slot.
+type ledgerStateSnapshot struct {
+	stateTreeRoot        iotago.Identifier
+	ledgerStateSHA256Sum []byte
+	storeSHA256Sum       []byte
+}
+
+// newLedgerStateSnapshot captures the state roots of the given ledger and the hash of its stored bytes.
+func newLedgerStateSnapshot(t *testing.T, manager *utxoledger.Manager, store kvstore.KVStore) *ledgerStateSnapshot {
+	ledgerStateSHA256Sum, err := manager.LedgerStateSHA256Sum()
+	require.NoError(t, err)
+
+	type keyValue struct{ key, value []byte }
+	keyValues := make([]keyValue, 0)
+	require.NoError(t, store.Iterate(kvstore.EmptyPrefix, func(key kvstore.Key, value kvstore.Value) bool {
+		// the nodes of the state tree depend on the order of the mutations, so the tree is only compared by its root.
+		if key[0] != utxoledger.StoreKeyPrefixStateTree {
+			keyValues = append(keyValues, keyValue{key: bytes.Clone(key), value: bytes.Clone(value)})
+		}
+
+		return true
+	}))
+	sort.Slice(keyValues, func(i, j int) bool { return bytes.Compare(keyValues[i].key, keyValues[j].key) < 0 })
+
+	storeHash := sha256.New()
+	for _, kv := range keyValues {
+		_, _ = storeHash.Write(kv.key)
+		_, _ = storeHash.Write(kv.value)
+	}
+
+	return &ledgerStateSnapshot{
+		stateTreeRoot:        manager.StateTreeRoot(),
+		ledgerStateSHA256Sum: ledgerStateSHA256Sum,
+		storeSHA256Sum:       storeHash.Sum(nil),
+	}
+}
+
+// requireEqual asserts that the given snapshot is identical to this snapshot.
+func (l *ledgerStateSnapshot) requireEqual(t *testing.T, other *ledgerStateSnapshot, msgAndArgs ...any) {
+	require.Equal(t, l.stateTreeRoot, other.stateTreeRoot, msgAndArgs...)
+	require.Equal(t, l.ledgerStateSHA256Sum, other.ledgerStateSHA256Sum, msgAndArgs...)
+	require.Equal(t, l.storeSHA256Sum, other.storeSHA256Sum, msgAndArgs...)
+}
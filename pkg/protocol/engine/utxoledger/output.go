@@ -2,6 +2,7 @@ package utxoledger
 
 import (
 	"bytes"
+	"slices"
 	"sync"
 
 	"github.com/iotaledger/hive.go/ierrors"
@@ -135,6 +136,24 @@ func (o Outputs) ToOutputSet() iotago.OutputSet {
 	return outputSet
 }
 
+// Sort sorts the outputs in place by the ID of the transaction that created them and their output index, so that the
+// order does not depend on the order in which they were collected.
+func (o Outputs) Sort() {
+	slices.SortStableFunc(o, func(a *Output, b *Output) int {
+		return CompareOutputIDs(a.outputID, b.outputID)
+	})
+}
+
+// CompareOutputIDs compares the given output IDs by their transaction ID and (within the same transaction) by their
+// numeric output index.
+func CompareOutputIDs(a iotago.OutputID, b iotago.OutputID) int {
+	if result := bytes.Compare(a[:iotago.TransactionIDLength], b[:iotago.TransactionIDLength]); result != 0 {
+		return result
+	}
+
+	return int(a.Index()) - int(b.Index())
+}
+
 func NewOutput(apiProvider iotago.APIProvider,
 	outputID iotago.OutputID,
 	blockID iotago.BlockID,
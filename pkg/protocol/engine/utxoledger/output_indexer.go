@@ -0,0 +1,51 @@
+package utxoledger
+
+import (
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/kvstore"
+)
+
+// OutputIndexer maintains a secondary index of the unspent outputs. Its entries are written in the same batch as the
+// changes of the ledger, so that the index never diverges from the ledger state (also not on rollbacks).
+type OutputIndexer interface {
+	// IndexOutput adds the entries of the given output that became unspent to the batch.
+	IndexOutput(output *Output, mutations kvstore.BatchedMutations) error
+
+	// UnindexOutput removes the entries of the given output that is no longer unspent in the batch.
+	UnindexOutput(output *Output, mutations kvstore.BatchedMutations) error
+}
+
+// RegisterOutputIndexer registers an indexer that is updated with all outputs that are added to or removed from the
+// unspent outputs from now on.
+func (m *Manager) RegisterOutputIndexer(indexer OutputIndexer) {
+	m.WriteLockLedger()
+	defer m.WriteUnlockLedger()
+
+	m.RegisterOutputIndexerWithoutLocking(indexer)
+}
+
+// RegisterOutputIndexerWithoutLocking registers an indexer without acquiring the ledger lock (e.g. to register it
+// atomically with building its initial state).
+func (m *Manager) RegisterOutputIndexerWithoutLocking(indexer OutputIndexer) {
+	m.outputIndexers = append(m.outputIndexers, indexer)
+}
+
+func (m *Manager) indexOutput(output *Output, mutations kvstore.BatchedMutations) error {
+	for _, indexer := range m.outputIndexers {
+		if err := indexer.IndexOutput(output, mutations); err != nil {
+			return ierrors.Wrapf(err, "failed to index output %s", output.OutputID())
+		}
+	}
+
+	return nil
+}
+
+func (m *Manager) unindexOutput(output *Output, mutations kvstore.BatchedMutations) error {
+	for _, indexer := range m.outputIndexers {
+		if err := indexer.UnindexOutput(output, mutations); err != nil {
+			return ierrors.Wrapf(err, "failed to unindex output %s", output.OutputID())
+		}
+	}
+
+	return nil
+}
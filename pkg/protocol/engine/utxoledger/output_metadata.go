@@ -0,0 +1,203 @@
+package utxoledger
+
+import (
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/hive.go/serializer/v2"
+	"github.com/iotaledger/hive.go/serializer/v2/stream"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// ErrOutputMetadataExtensionAlreadyRegistered is returned if an OutputMetadataExtension with the same ID was already registered.
+var ErrOutputMetadataExtensionAlreadyRegistered = ierrors.New("output metadata extension already registered")
+
+// OutputMetadataExtensionID is the identifier of an OutputMetadataExtension that is used as part of the storage key.
+type OutputMetadataExtensionID byte
+
+// OutputMetadataExtension derives auxiliary (e.g. VM-specific) metadata for the outputs that are added to the ledger.
+// The metadata is stored next to the outputs under a separate key prefix, so that new kinds of metadata can be
+// introduced without changing the serialization of the outputs.
+type OutputMetadataExtension interface {
+	// ID returns the identifier of the extension.
+	ID() OutputMetadataExtensionID
+
+	// Version returns the version of the encoding of the metadata that is derived by the extension.
+	Version() byte
+
+	// DeriveMetadata returns the metadata of the given output (nil if the output has no metadata).
+	DeriveMetadata(output *Output) ([]byte, error)
+}
+
+// OutputMetadata is the auxiliary metadata of an output that was derived by an OutputMetadataExtension.
+type OutputMetadata struct {
+	outputID    iotago.OutputID
+	extensionID OutputMetadataExtensionID
+	version     byte
+	data        []byte
+}
+
+// OutputID returns the ID of the output the metadata belongs to.
+func (o *OutputMetadata) OutputID() iotago.OutputID {
+	return o.outputID
+}
+
+// ExtensionID returns the ID of the extension that derived the metadata.
+func (o *OutputMetadata) ExtensionID() OutputMetadataExtensionID {
+	return o.extensionID
+}
+
+// Version returns the version of the extension that was used to encode the metadata.
+func (o *OutputMetadata) Version() byte {
+	return o.version
+}
+
+// Data returns the encoded metadata.
+func (o *OutputMetadata) Data() []byte {
+	return o.data
+}
+
+// - kvStorable
+
+func outputMetadataStorageKeyPrefixForOutputID(outputID iotago.OutputID) []byte {
+	byteBuffer := stream.NewByteBuffer(serializer.OneByte + iotago.OutputIDLength)
+
+	// There can't be any errors.
+	_ = stream.Write(byteBuffer, StoreKeyPrefixOutputMetadata)
+	_ = stream.Write(byteBuffer, outputID)
+
+	return lo.PanicOnErr(byteBuffer.Bytes())
+}
+
+func outputMetadataStorageKey(outputID iotago.OutputID, extensionID OutputMetadataExtensionID) []byte {
+	return append(outputMetadataStorageKeyPrefixForOutputID(outputID), byte(extensionID))
+}
+
+func (o *OutputMetadata) KVStorableKey() (key []byte) {
+	return outputMetadataStorageKey(o.outputID, o.extensionID)
+}
+
+func (o *OutputMetadata) KVStorableValue() (value []byte) {
+	byteBuffer := stream.NewByteBuffer()
+
+	// There can't be any errors.
+	_ = stream.Write(byteBuffer, o.version)
+	_ = stream.WriteBytes(byteBuffer, o.data)
+
+	return lo.PanicOnErr(byteBuffer.Bytes())
+}
+
+func (o *OutputMetadata) kvStorableLoad(_ *Manager, key []byte, value []byte) error {
+	var err error
+
+	keyReader := stream.NewByteReader(key)
+
+	if _, err = stream.Read[byte](keyReader); err != nil {
+		return ierrors.Wrap(err, "unable to read prefix")
+	}
+	if o.outputID, err = stream.Read[iotago.OutputID](keyReader); err != nil {
+		return ierrors.Wrap(err, "unable to read outputID")
+	}
+	if o.extensionID, err = stream.Read[OutputMetadataExtensionID](keyReader); err != nil {
+		return ierrors.Wrap(err, "unable to read extensionID")
+	}
+
+	if len(value) < serializer.OneByte {
+		return ierrors.New("invalid output metadata length")
+	}
+
+	o.version = value[0]
+	o.data = value[serializer.OneByte:]
+
+	return nil
+}
+
+// - Helper
+
+func (m *Manager) storeOutputMetadata(output *Output, mutations kvstore.BatchedMutations) error {
+	for _, extension := range m.outputMetadataExtensions {
+		data, err := extension.DeriveMetadata(output)
+		if err != nil {
+			return ierrors.Wrapf(err, "failed to derive metadata of extension %d for output %s", extension.ID(), output.OutputID())
+		}
+
+		if data == nil {
+			continue
+		}
+
+		outputMetadata := &OutputMetadata{
+			outputID:    output.OutputID(),
+			extensionID: extension.ID(),
+			version:     extension.Version(),
+			data:        data,
+		}
+
+		if err := mutations.Set(outputMetadata.KVStorableKey(), outputMetadata.KVStorableValue()); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// deleteOutputMetadata deletes the metadata of all extensions (including the ones that are no longer registered).
+func (m *Manager) deleteOutputMetadata(outputID iotago.OutputID, mutations kvstore.BatchedMutations) error {
+	var keys []kvstore.Key
+	if err := m.store.IterateKeys(outputMetadataStorageKeyPrefixForOutputID(outputID), func(key kvstore.Key) bool {
+		keys = append(keys, lo.CopySlice(key))
+
+		return true
+	}); err != nil {
+		return err
+	}
+
+	for _, key := range keys {
+		if err := mutations.Delete(key); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// - Manager
+
+// RegisterOutputMetadataExtension registers an extension that derives the metadata of all outputs that are added to
+// the ledger from now on.
+func (m *Manager) RegisterOutputMetadataExtension(extension OutputMetadataExtension) error {
+	m.WriteLockLedger()
+	defer m.WriteUnlockLedger()
+
+	if _, exists := m.outputMetadataExtensions[extension.ID()]; exists {
+		return ierrors.Wrapf(ErrOutputMetadataExtensionAlreadyRegistered, "extension %d", extension.ID())
+	}
+
+	m.outputMetadataExtensions[extension.ID()] = extension
+
+	return nil
+}
+
+func (m *Manager) ReadOutputMetadataWithoutLocking(outputID iotago.OutputID, extensionID OutputMetadataExtensionID) (*OutputMetadata, error) {
+	key := outputMetadataStorageKey(outputID, extensionID)
+	value, err := m.store.Get(key)
+	if err != nil {
+		return nil, err
+	}
+
+	outputMetadata := new(OutputMetadata)
+	if err := outputMetadata.kvStorableLoad(m, key, value); err != nil {
+		return nil, err
+	}
+
+	return outputMetadata, nil
+}
+
+func (m *Manager) ReadOutputMetadata(outputID iotago.OutputID, extensionID OutputMetadataExtensionID) (*OutputMetadata, error) {
+	m.ReadLockLedger()
+	defer m.ReadUnlockLedger()
+
+	return m.ReadOutputMetadataWithoutLocking(outputID, extensionID)
+}
+
+// code guards.
+var _ kvStorable = &OutputMetadata{}
@@ -0,0 +1,100 @@
+//nolint:forcetypeassert,varnamelen,revive,exhaustruct // we don't care about these linters in test cases
+package utxoledger_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/utxoledger"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/utxoledger/tpkg"
+	iotago "github.com/iotaledger/iota.go/v4"
+	iotago_tpkg "github.com/iotaledger/iota.go/v4/tpkg"
+)
+
+// outputTypeExtension is a test extension that stores the type of account and NFT outputs.
+type outputTypeExtension struct {
+	version byte
+}
+
+func (o *outputTypeExtension) ID() utxoledger.OutputMetadataExtensionID {
+	return 1
+}
+
+func (o *outputTypeExtension) Version() byte {
+	return o.version
+}
+
+func (o *outputTypeExtension) DeriveMetadata(output *utxoledger.Output) ([]byte, error) {
+	switch output.OutputType() {
+	case iotago.OutputAccount, iotago.OutputNFT:
+		return []byte{byte(output.OutputType())}, nil
+	default:
+		return nil, nil
+	}
+}
+
+func TestOutputMetadataExtension(t *testing.T) {
+	manager := utxoledger.New(mapdb.NewMapDB(), iotago.SingleVersionProvider(iotago_tpkg.ZeroCostTestAPI))
+
+	extension := &outputTypeExtension{version: 1}
+	require.NoError(t, manager.RegisterOutputMetadataExtension(extension))
+	require.ErrorIs(t, manager.RegisterOutputMetadataExtension(extension), utxoledger.ErrOutputMetadataExtensionAlreadyRegistered)
+
+	outputs := utxoledger.Outputs{
+		tpkg.RandLedgerStateOutputWithType(iotago.OutputBasic),
+		tpkg.RandLedgerStateOutputWithType(iotago.OutputAccount),
+		tpkg.RandLedgerStateOutputWithType(iotago.OutputNFT), // spent
+	}
+
+	slot := iotago.SlotIndex(10)
+	spents := utxoledger.Spents{
+		tpkg.RandLedgerStateSpentWithOutput(outputs[2], slot),
+	}
+
+	require.NoError(t, manager.ApplyDiffWithoutLocking(slot, outputs, spents))
+
+	_, err := manager.ReadOutputMetadata(outputs[0].OutputID(), extension.ID())
+	require.True(t, ierrors.Is(err, kvstore.ErrKeyNotFound))
+
+	accountMetadata, err := manager.ReadOutputMetadata(outputs[1].OutputID(), extension.ID())
+	require.NoError(t, err)
+	require.Equal(t, outputs[1].OutputID(), accountMetadata.OutputID())
+	require.Equal(t, extension.ID(), accountMetadata.ExtensionID())
+	require.Equal(t, byte(1), accountMetadata.Version())
+	require.Equal(t, []byte{byte(iotago.OutputAccount)}, accountMetadata.Data())
+
+	// the metadata of spent outputs is kept until the slot is pruned
+	nftMetadata, err := manager.ReadOutputMetadata(outputs[2].OutputID(), extension.ID())
+	require.NoError(t, err)
+	require.Equal(t, []byte{byte(iotago.OutputNFT)}, nftMetadata.Data())
+
+	// outputs that were added with an older version of the extension keep their version
+	extension.version = 2
+	newOutput := tpkg.RandLedgerStateOutputWithType(iotago.OutputAccount)
+	require.NoError(t, manager.ApplyDiffWithoutLocking(slot+1, utxoledger.Outputs{newOutput}, utxoledger.Spents{}))
+
+	newMetadata, err := manager.ReadOutputMetadata(newOutput.OutputID(), extension.ID())
+	require.NoError(t, err)
+	require.Equal(t, byte(2), newMetadata.Version())
+
+	accountMetadata, err = manager.ReadOutputMetadata(outputs[1].OutputID(), extension.ID())
+	require.NoError(t, err)
+	require.Equal(t, byte(1), accountMetadata.Version())
+
+	require.NoError(t, manager.RollbackDiffWithoutLocking(slot+1, utxoledger.Outputs{newOutput}, utxoledger.Spents{}))
+
+	_, err = manager.ReadOutputMetadata(newOutput.OutputID(), extension.ID())
+	require.True(t, ierrors.Is(err, kvstore.ErrKeyNotFound))
+
+	require.NoError(t, manager.PruneSlotIndexWithoutLocking(slot))
+
+	_, err = manager.ReadOutputMetadata(outputs[2].OutputID(), extension.ID())
+	require.True(t, ierrors.Is(err, kvstore.ErrKeyNotFound))
+
+	_, err = manager.ReadOutputMetadata(outputs[1].OutputID(), extension.ID())
+	require.NoError(t, err)
+}
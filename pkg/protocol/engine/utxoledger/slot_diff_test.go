@@ -2,7 +2,9 @@
 package utxoledger_test
 
 import (
+	"bytes"
 	"encoding/binary"
+	"math/rand"
 	"sort"
 	"testing"
 
@@ -95,3 +97,85 @@ func TestSlotDiffSerialization(t *testing.T) {
 	tpkg.EqualOutputs(t, utxoledger.Outputs(sortedOutputs), readDiff.Outputs)
 	tpkg.EqualSpents(t, utxoledger.Spents(sortedSpents), readDiff.Spents)
 }
+
+func TestSlotDiffDeterministicOrdering(t *testing.T) {
+	slot := iotago.SlotIndex(756)
+
+	newOutput := func(transactionID iotago.TransactionID, index uint16) *utxoledger.Output {
+		output := iotago_tpkg.RandOutput(iotago.OutputBasic)
+		proof := lo.PanicOnErr(iotago.NewOutputIDProof(iotago_tpkg.ZeroCostTestAPI, transactionID.Identifier(), transactionID.Slot(), iotago.TxEssenceOutputs{output}, 0))
+
+		return utxoledger.CreateOutput(iotago.SingleVersionProvider(iotago_tpkg.ZeroCostTestAPI), iotago.OutputIDFromTransactionIDAndIndex(transactionID, index), iotago_tpkg.RandBlockID(), slot, output, proof)
+	}
+
+	transactionA, transactionB := iotago_tpkg.RandTransactionID(), iotago_tpkg.RandTransactionID()
+	outputs := utxoledger.Outputs{
+		newOutput(transactionA, 256),
+		newOutput(transactionA, 1),
+		newOutput(transactionB, 0),
+		newOutput(transactionA, 0),
+		newOutput(transactionB, 3),
+	}
+
+	spendingTransactionA, spendingTransactionB := iotago_tpkg.RandTransactionID(), iotago_tpkg.RandTransactionID()
+	spents := utxoledger.Spents{
+		utxoledger.NewSpent(tpkg.RandLedgerStateOutput(), spendingTransactionA, slot),
+		utxoledger.NewSpent(tpkg.RandLedgerStateOutput(), spendingTransactionB, slot),
+		utxoledger.NewSpent(tpkg.RandLedgerStateOutput(), spendingTransactionA, slot),
+		utxoledger.NewSpent(outputs[2], spendingTransactionB, slot),
+	}
+
+	shuffled := func() (utxoledger.Outputs, utxoledger.Spents) {
+		shuffledOutputs := append(utxoledger.Outputs{}, outputs...)
+		rand.Shuffle(len(shuffledOutputs), func(i, j int) { shuffledOutputs[i], shuffledOutputs[j] = shuffledOutputs[j], shuffledOutputs[i] })
+
+		shuffledSpents := append(utxoledger.Spents{}, spents...)
+		rand.Shuffle(len(shuffledSpents), func(i, j int) { shuffledSpents[i], shuffledSpents[j] = shuffledSpents[j], shuffledSpents[i] })
+
+		shuffledOutputs.Sort()
+		shuffledSpents.Sort()
+
+		return shuffledOutputs, shuffledSpents
+	}
+
+	expectedOutputs, expectedSpents := shuffled()
+
+	// outputs of the same transaction are ordered by their numeric output index
+	var outputIndexesA []uint16
+	for _, output := range expectedOutputs {
+		if output.OutputID().TransactionID() == transactionA {
+			outputIndexesA = append(outputIndexesA, output.OutputID().Index())
+		}
+	}
+	require.Equal(t, []uint16{0, 1, 256}, outputIndexesA)
+
+	// spents are grouped by the transaction that spent them
+	for i := 1; i < len(expectedSpents); i++ {
+		previousTransactionID, transactionID := expectedSpents[i-1].TransactionIDSpent(), expectedSpents[i].TransactionIDSpent()
+		require.LessOrEqual(t, bytes.Compare(previousTransactionID[:], transactionID[:]), 0)
+	}
+
+	// the order and the resulting roots are the same across repeated runs and across nodes
+	var expectedStateRoot iotago.Identifier
+	var expectedLedgerHash []byte
+	for i := 0; i < 10; i++ {
+		sortedOutputs, sortedSpents := shuffled()
+		require.Equal(t, lo.Map(expectedOutputs, (*utxoledger.Output).OutputID), lo.Map(sortedOutputs, (*utxoledger.Output).OutputID))
+		require.Equal(t, lo.Map(expectedSpents, (*utxoledger.Spent).OutputID), lo.Map(sortedSpents, (*utxoledger.Spent).OutputID))
+
+		manager := utxoledger.New(mapdb.NewMapDB(), iotago.SingleVersionProvider(iotago_tpkg.ZeroCostTestAPI))
+		require.NoError(t, manager.ApplyDiffWithoutLocking(slot, sortedOutputs, sortedSpents))
+
+		ledgerHash, err := manager.LedgerStateSHA256Sum()
+		require.NoError(t, err)
+
+		if i == 0 {
+			expectedStateRoot, expectedLedgerHash = manager.StateTreeRoot(), ledgerHash
+
+			continue
+		}
+
+		require.Equal(t, expectedStateRoot, manager.StateTreeRoot())
+		require.Equal(t, expectedLedgerHash, ledgerHash)
+	}
+}
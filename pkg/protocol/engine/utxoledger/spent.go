@@ -2,6 +2,7 @@ package utxoledger
 
 import (
 	"bytes"
+	"slices"
 
 	"github.com/iotaledger/hive.go/ierrors"
 	"github.com/iotaledger/hive.go/kvstore"
@@ -77,6 +78,18 @@ func (s *Spent) SlotSpent() iotago.SlotIndex {
 
 type Spents []*Spent
 
+// Sort sorts the spents in place by the ID of the transaction that spent them and the IDs of the spent outputs, so that
+// the order does not depend on the order in which they were collected.
+func (s Spents) Sort() {
+	slices.SortStableFunc(s, func(a *Spent, b *Spent) int {
+		if result := bytes.Compare(a.transactionIDSpent[:], b.transactionIDSpent[:]); result != 0 {
+			return result
+		}
+
+		return CompareOutputIDs(a.outputID, b.outputID)
+	})
+}
+
 func NewSpent(output *Output, transactionIDSpent iotago.TransactionID, slotSpent iotago.SlotIndex) *Spent {
 	return &Spent{
 		outputID:           output.outputID,
@@ -52,10 +52,18 @@ func deleteOutputLookups(output *Output, mutations kvstore.BatchedMutations) err
 }
 
 func (m *Manager) IsOutputIDUnspentWithoutLocking(outputID iotago.OutputID) (bool, error) {
+	if m.unspentFilter != nil && !m.unspentFilter.MayContain(outputID) {
+		return false, nil
+	}
+
 	return m.store.Has(lookupKeyUnspentOutput(outputID))
 }
 
 func (m *Manager) IsOutputUnspentWithoutLocking(output *Output) (bool, error) {
+	if m.unspentFilter != nil && !m.unspentFilter.MayContain(output.OutputID()) {
+		return false, nil
+	}
+
 	return m.store.Has(output.UnspentLookupKey())
 }
 
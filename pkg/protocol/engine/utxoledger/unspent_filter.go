@@ -0,0 +1,148 @@
+package utxoledger
+
+import (
+	"hash/maphash"
+	"math"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/runtime/syncutils"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// unspentFilter is a bloom filter over the IDs of the unspent outputs that is used to short-circuit the lookups of
+// output IDs that are not unspent. Spent outputs are not removed from the filter (bloom filters do not support
+// deletions), so they only increase the false positive rate until the filter is rebuilt.
+type unspentFilter struct {
+	// bits is the bitset of the filter.
+	bits []uint64
+
+	// bitCount is the number of bits of the filter.
+	bitCount uint64
+
+	// hashCount is the number of bits that are set per output ID.
+	hashCount uint64
+
+	// capacity is the number of output IDs the filter was sized for.
+	capacity uint64
+
+	// addedCount is the number of output IDs that were added to the filter.
+	addedCount uint64
+
+	// seed1 and seed2 are the seeds of the two hash functions that are combined to derive the bit positions.
+	seed1 maphash.Seed
+	seed2 maphash.Seed
+
+	mutex syncutils.RWMutex
+}
+
+// newUnspentFilter creates a new unspentFilter that holds the given number of output IDs with the given false positive
+// rate.
+func newUnspentFilter(capacity uint64, falsePositiveRate float64) *unspentFilter {
+	capacity = max(capacity, 1)
+
+	bitCount := uint64(math.Ceil(-float64(capacity) * math.Log(falsePositiveRate) / (math.Ln2 * math.Ln2)))
+	bitCount = max(bitCount, 64)
+
+	return &unspentFilter{
+		bits:      make([]uint64, (bitCount+63)/64),
+		bitCount:  bitCount,
+		hashCount: max(uint64(math.Round(float64(bitCount)/float64(capacity)*math.Ln2)), 1),
+		capacity:  capacity,
+		seed1:     maphash.MakeSeed(),
+		seed2:     maphash.MakeSeed(),
+	}
+}
+
+// Add adds the given output ID to the filter.
+func (f *unspentFilter) Add(outputID iotago.OutputID) {
+	f.mutex.Lock()
+	defer f.mutex.Unlock()
+
+	hash1, hash2 := f.hashes(outputID)
+	for i := uint64(0); i < f.hashCount; i++ {
+		position := (hash1 + i*hash2) % f.bitCount
+		f.bits[position/64] |= 1 << (position % 64)
+	}
+
+	f.addedCount++
+}
+
+// MayContain returns false if the given output ID was never added to the filter.
+func (f *unspentFilter) MayContain(outputID iotago.OutputID) bool {
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+
+	hash1, hash2 := f.hashes(outputID)
+	for i := uint64(0); i < f.hashCount; i++ {
+		position := (hash1 + i*hash2) % f.bitCount
+		if f.bits[position/64]&(1<<(position%64)) == 0 {
+			return false
+		}
+	}
+
+	return true
+}
+
+// IsSaturated returns true if more output IDs were added than the filter was sized for.
+func (f *unspentFilter) IsSaturated() bool {
+	f.mutex.RLock()
+	defer f.mutex.RUnlock()
+
+	return f.addedCount > f.capacity
+}
+
+// hashes returns the two hashes of the given output ID that are used for double hashing.
+func (f *unspentFilter) hashes(outputID iotago.OutputID) (hash1 uint64, hash2 uint64) {
+	// the second hash is made odd, so that it is never zero.
+	return maphash.Bytes(f.seed1, outputID[:]), maphash.Bytes(f.seed2, outputID[:]) | 1
+}
+
+// rebuildUnspentFilter creates a new unspentFilter that contains the IDs of all unspent outputs and is sized for at
+// least twice their number.
+func (m *Manager) rebuildUnspentFilter() error {
+	var unspentOutputIDs []iotago.OutputID
+	var innerErr error
+	if err := m.store.IterateKeys([]byte{StoreKeyPrefixOutputUnspent}, func(key kvstore.Key) bool {
+		outputID, err := outputIDFromDatabaseKey(key)
+		if err != nil {
+			innerErr = err
+
+			return false
+		}
+
+		unspentOutputIDs = append(unspentOutputIDs, outputID)
+
+		return true
+	}); err != nil {
+		return ierrors.Wrap(err, "failed to iterate unspent outputs")
+	} else if innerErr != nil {
+		return ierrors.Wrap(innerErr, "failed to parse unspent output ID")
+	}
+
+	filter := newUnspentFilter(max(m.optsUnspentFilterCapacity, 2*uint64(len(unspentOutputIDs))), m.optsUnspentFilterFalsePositiveRate)
+	for _, outputID := range unspentOutputIDs {
+		filter.Add(outputID)
+	}
+
+	m.unspentFilter = filter
+
+	return nil
+}
+
+// addToUnspentFilter adds the given outputs to the unspentFilter (if enabled) and rebuilds it once it is saturated.
+func (m *Manager) addToUnspentFilter(outputs ...*Output) error {
+	if m.unspentFilter == nil {
+		return nil
+	}
+
+	for _, output := range outputs {
+		m.unspentFilter.Add(output.OutputID())
+	}
+
+	if !m.unspentFilter.IsSaturated() {
+		return nil
+	}
+
+	return m.rebuildUnspentFilter()
+}
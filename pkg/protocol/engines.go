@@ -13,6 +13,7 @@ import (
 	"github.com/iotaledger/hive.go/runtime/module"
 	"github.com/iotaledger/hive.go/runtime/options"
 	"github.com/iotaledger/hive.go/runtime/workerpool"
+	"github.com/iotaledger/iota-core/pkg/profiling"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/accounts/accountsledger"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
@@ -46,7 +47,7 @@ func newEngines(protocol *Protocol) *Engines {
 		Main:           reactive.NewVariable[*engine.Engine](),
 		ReactiveModule: protocol.NewReactiveSubModule("Engines"),
 		protocol:       protocol,
-		worker:         protocol.Workers.CreatePool("Engines", workerpool.WithWorkerCount(1)),
+		worker:         profiling.CreatePool(protocol.Workers, "Engines", workerpool.WithWorkerCount(1)),
 		directory:      utils.NewDirectory(protocol.Options.BaseDirectory),
 	}
 
@@ -78,8 +79,8 @@ func (e *Engines) ForkAtSlot(slot iotago.SlotIndex) (*engine.Engine, error) {
 		e.protocol.LogError("engine error", "err", err, "name", newEngineAlias[0:8])
 	}
 
-	// copy raw data on disk.
-	newStorage, err := storage.Clone(e.Main.Get().Storage, e.directory.Path(newEngineAlias), DatabaseVersion, errorHandler, e.protocol.Options.StorageOptions...)
+	// copy raw data on disk (only the prunable data that is required to fork at the given slot).
+	newStorage, err := storage.CloneForFork(e.Main.Get().Storage, e.directory.Path(newEngineAlias), DatabaseVersion, slot, errorHandler, e.protocol.Options.StorageOptions...)
 	if err != nil {
 		return nil, ierrors.Wrapf(err, "failed to copy storage from active engine instance (%s) to new engine instance (%s)", e.Main.Get().Storage.Directory(), e.directory.Path(newEngineAlias))
 	}
@@ -94,7 +95,7 @@ func (e *Engines) ForkAtSlot(slot iotago.SlotIndex) (*engine.Engine, error) {
 	evictionState.Initialize(latestCommitment.Slot())
 
 	blockCache := blocks.New(evictionState, newStorage.Settings().APIProvider())
-	accountsManager := accountsledger.New(newStorage.Settings().APIProvider(), blockCache.Block, newStorage.AccountDiffs, newStorage.Accounts())
+	accountsManager := accountsledger.New(newStorage.Settings().APIProvider(), blockCache.Block, newStorage.AccountDiffs, newStorage.AccountsAggregates, newStorage.Accounts())
 
 	accountsManager.SetLatestCommittedSlot(latestCommitment.Slot())
 	if err = accountsManager.Rollback(slot); err != nil {
@@ -121,7 +122,7 @@ func (e *Engines) ForkAtSlot(slot iotago.SlotIndex) (*engine.Engine, error) {
 		return nil, err
 	}
 
-	candidateEngine := e.loadEngineInstanceWithStorage(newEngineAlias, newStorage)
+	candidateEngine := e.loadEngineInstanceWithStorage(newEngineAlias, newStorage, engine.WithForkingSlot(slot))
 
 	// rollback attestations already on created engine instance, because this action modifies the in-memory storage.
 	if err = candidateEngine.Attestations.Rollback(slot); err != nil {
@@ -196,7 +197,7 @@ func (e *Engines) loadEngineInstanceFromSnapshot(engineAlias string, snapshotPat
 func (e *Engines) loadEngineInstanceWithStorage(engineAlias string, storage *storage.Storage, engineOptions ...options.Option[engine.Engine]) *engine.Engine {
 	return engine.New(
 		e.protocol.Logger,
-		e.protocol.Workers.CreateGroup(engineAlias),
+		profiling.LabelGroup(e.protocol.Workers.CreateGroup(engineAlias), profiling.LabelEngine, engineAlias),
 		storage,
 		e.protocol.Options.PreSolidFilterProvider,
 		e.protocol.Options.PostSolidFilterProvider,
@@ -230,8 +231,12 @@ func (e *Engines) syncMainEngineFromMainChain() (shutdown func()) {
 
 // syncMainEngineInfoFile syncs the engine info file with the main engine.
 func (e *Engines) syncMainEngineInfoFile() (shutdown func()) {
-	return e.Main.OnUpdate(func(_ *engine.Engine, mainEngine *engine.Engine) {
+	return e.Main.OnUpdate(func(previousMainEngine *engine.Engine, mainEngine *engine.Engine) {
 		if mainEngine != nil {
+			if previousMainEngine != nil {
+				e.LogInfo("switched main engine", "from", previousMainEngine.LogName(), "to", mainEngine.LogName())
+			}
+
 			if err := ioutils.WriteJSONToFile(e.infoFilePath(), &engineInfo{Name: filepath.Base(mainEngine.Storage.Directory())}, 0o644); err != nil {
 				e.LogError("unable to write engine info file", "err", err)
 			}
@@ -10,4 +10,13 @@ var (
 
 	// ErrorSlotEvicted is returned for requests for commitments that belong to evicted slots.
 	ErrorSlotEvicted = ierrors.New("slot evicted")
+
+	// ErrorChainNotFound is returned for requests for chains that are not managed by the protocol.
+	ErrorChainNotFound = ierrors.New("chain not found")
+
+	// ErrorMainChain is returned for requests that are only allowed for candidate chains but target the main chain.
+	ErrorMainChain = ierrors.New("chain is the main chain")
+
+	// ErrorEngineNotRunning is returned for requests for the engine of a chain that has no engine running.
+	ErrorEngineNotRunning = ierrors.New("engine not running")
 )
@@ -1,9 +1,12 @@
 package protocol
 
 import (
+	"time"
+
 	"github.com/iotaledger/hive.go/core/eventticker"
 	"github.com/iotaledger/hive.go/runtime/module"
 	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/iota-core/pkg/network/protocols/core"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/attestation"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/attestation/slotattestation"
@@ -51,12 +54,47 @@ type Options struct {
 	// SnapshotPath is the path to the snapshot file that should be used to initialize the protocol.
 	SnapshotPath string
 
+	// WarmStandby contains a flag that indicates whether the engine of the heaviest attested candidate chain should be
+	// started (and kept in sync) before the chain switching threshold is reached.
+	WarmStandby bool
+
+	// CommitmentBroadcastRetryInterval contains the interval in which the latest commitment is sent again to the
+	// neighbors that did not acknowledge it yet (0 = disabled).
+	CommitmentBroadcastRetryInterval time.Duration
+
+	// CommitmentBroadcastMaxRetries contains the maximum number of times the latest commitment is sent again to the
+	// neighbors that did not acknowledge it yet.
+	CommitmentBroadcastMaxRetries int
+
+	// ChainBlockBufferSize contains the maximum number of blocks that are kept in memory for the engine of a non-main
+	// chain before they are spilled to disk or dropped (0 = disabled).
+	ChainBlockBufferSize int
+
+	// ChainBlockBufferMaxPendingTasks contains the number of pending tasks in the engine of a non-main chain at which no
+	// further blocks are dispatched from its block buffer.
+	ChainBlockBufferMaxPendingTasks int
+
+	// ChainBlockBufferSpillToDisk contains a flag that indicates whether blocks that exceed the capacity of the block
+	// buffer of a non-main chain are spilled to disk instead of being dropped.
+	ChainBlockBufferSpillToDisk bool
+
+	// WarpSyncStateDiffs contains a flag that indicates whether the state diffs of the slots are requested while warp
+	// syncing, so that the accepted transactions are applied without re-executing them.
+	WarpSyncStateDiffs bool
+
+	// MaxFailedVerificationsPerChain contains the number of failed proof verifications after which no more data of a
+	// chain is requested from a neighbor (0 = disabled).
+	MaxFailedVerificationsPerChain int
+
 	// EngineOptions contains the options for the Engines.
 	EngineOptions []options.Option[engine.Engine]
 
 	// StorageOptions contains the options for the Storage.
 	StorageOptions []options.Option[storage.Storage]
 
+	// NetworkProtocolOptions contains the options for the core network protocol.
+	NetworkProtocolOptions []options.Option[core.Protocol]
+
 	CommitmentRequesterOptions  []options.Option[eventticker.EventTicker[iotago.SlotIndex, iotago.CommitmentID]]
 	AttestationRequesterOptions []options.Option[eventticker.EventTicker[iotago.SlotIndex, iotago.CommitmentID]]
 	WarpSyncRequesterOptions    []options.Option[eventticker.EventTicker[iotago.SlotIndex, iotago.CommitmentID]]
@@ -118,6 +156,13 @@ func NewDefaultOptions() *Options {
 	return &Options{
 		BaseDirectory: "",
 
+		CommitmentBroadcastRetryInterval: 2 * time.Second,
+		CommitmentBroadcastMaxRetries:    3,
+
+		MaxFailedVerificationsPerChain: 3,
+
+		ChainBlockBufferMaxPendingTasks: 1000,
+
 		PreSolidFilterProvider:      presolidblockfilter.NewProvider(),
 		PostSolidFilterProvider:     postsolidblockfilter.NewProvider(),
 		BlockDAGProvider:            inmemoryblockdag.NewProvider(),
@@ -152,6 +197,69 @@ func WithSnapshotPath(snapshot string) options.Option[Protocol] {
 	}
 }
 
+// WithWarmStandby is an option for the Protocol that allows to enable the warm standby of candidate engines.
+func WithWarmStandby(enabled bool) options.Option[Protocol] {
+	return func(p *Protocol) {
+		p.Options.WarmStandby = enabled
+	}
+}
+
+// WithCommitmentBroadcastRetryInterval is an option for the Protocol that allows to set the interval in which the
+// latest commitment is sent again to the neighbors that did not acknowledge it yet (0 = disabled).
+func WithCommitmentBroadcastRetryInterval(retryInterval time.Duration) options.Option[Protocol] {
+	return func(p *Protocol) {
+		p.Options.CommitmentBroadcastRetryInterval = retryInterval
+	}
+}
+
+// WithCommitmentBroadcastMaxRetries is an option for the Protocol that allows to set the maximum number of times the
+// latest commitment is sent again to the neighbors that did not acknowledge it yet.
+func WithCommitmentBroadcastMaxRetries(maxRetries int) options.Option[Protocol] {
+	return func(p *Protocol) {
+		p.Options.CommitmentBroadcastMaxRetries = maxRetries
+	}
+}
+
+// WithChainBlockBufferSize is an option for the Protocol that allows to set the maximum number of blocks that are kept
+// in memory for the engine of a non-main chain (0 = disabled).
+func WithChainBlockBufferSize(size int) options.Option[Protocol] {
+	return func(p *Protocol) {
+		p.Options.ChainBlockBufferSize = size
+	}
+}
+
+// WithChainBlockBufferMaxPendingTasks is an option for the Protocol that allows to set the number of pending tasks in
+// the engine of a non-main chain at which no further blocks are dispatched from its block buffer.
+func WithChainBlockBufferMaxPendingTasks(maxPendingTasks int) options.Option[Protocol] {
+	return func(p *Protocol) {
+		p.Options.ChainBlockBufferMaxPendingTasks = maxPendingTasks
+	}
+}
+
+// WithChainBlockBufferSpillToDisk is an option for the Protocol that allows to enable the spilling of blocks that
+// exceed the capacity of the block buffer of a non-main chain to disk.
+func WithChainBlockBufferSpillToDisk(enabled bool) options.Option[Protocol] {
+	return func(p *Protocol) {
+		p.Options.ChainBlockBufferSpillToDisk = enabled
+	}
+}
+
+// WithMaxFailedVerificationsPerChain is an option for the Protocol that allows to set the number of failed proof
+// verifications after which no more data of a chain is requested from a neighbor.
+func WithMaxFailedVerificationsPerChain(maxFailedVerifications int) options.Option[Protocol] {
+	return func(p *Protocol) {
+		p.Options.MaxFailedVerificationsPerChain = maxFailedVerifications
+	}
+}
+
+// WithWarpSyncStateDiffs is an option for the Protocol that allows to enable the requesting of the state diffs of the
+// slots while warp syncing.
+func WithWarpSyncStateDiffs(enabled bool) options.Option[Protocol] {
+	return func(p *Protocol) {
+		p.Options.WarpSyncStateDiffs = enabled
+	}
+}
+
 // WithPreSolidFilterProvider is an option for the Protocol that allows to set the PreSolidFilterProvider.
 func WithPreSolidFilterProvider(optsFilterProvider module.Provider[*engine.Engine, presolidfilter.PreSolidFilter]) options.Option[Protocol] {
 	return func(p *Protocol) {
@@ -264,6 +372,13 @@ func WithSyncManagerProvider(optsSyncManagerProvider module.Provider[*engine.Eng
 	}
 }
 
+// WithSchedulerProvider is an option for the Protocol that allows to set the SchedulerProvider.
+func WithSchedulerProvider(optsSchedulerProvider module.Provider[*engine.Engine, scheduler.Scheduler]) options.Option[Protocol] {
+	return func(p *Protocol) {
+		p.Options.SchedulerProvider = optsSchedulerProvider
+	}
+}
+
 // WithEngineOptions is an option for the Protocol that allows to set the EngineOptions.
 func WithEngineOptions(opts ...options.Option[engine.Engine]) options.Option[Protocol] {
 	return func(p *Protocol) {
@@ -278,6 +393,13 @@ func WithStorageOptions(opts ...options.Option[storage.Storage]) options.Option[
 	}
 }
 
+// WithNetworkProtocolOptions is an option for the Protocol that allows to set the options for the core network protocol.
+func WithNetworkProtocolOptions(opts ...options.Option[core.Protocol]) options.Option[Protocol] {
+	return func(p *Protocol) {
+		p.Options.NetworkProtocolOptions = append(p.Options.NetworkProtocolOptions, opts...)
+	}
+}
+
 func WithCommitmentRequesterOptions(opts ...options.Option[eventticker.EventTicker[iotago.SlotIndex, iotago.CommitmentID]]) options.Option[Protocol] {
 	return func(p *Protocol) {
 		p.Options.CommitmentRequesterOptions = append(p.Options.CommitmentRequesterOptions, opts...)
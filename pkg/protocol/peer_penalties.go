@@ -0,0 +1,151 @@
+package protocol
+
+import (
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/hive.go/log"
+	"github.com/iotaledger/hive.go/runtime/event"
+	"github.com/iotaledger/hive.go/runtime/syncutils"
+)
+
+// PeerPenalties is a subcomponent of the protocol that keeps track of the neighbors that sent data that failed the
+// proof verification (attestations or warp sync responses). Neighbors that repeatedly failed the verification for a
+// chain are no longer asked for the data of that chain and all penalized neighbors are deprioritized in future
+// requests.
+type PeerPenalties struct {
+	// protocol contains a reference to the Protocol instance that this component belongs to.
+	protocol *Protocol
+
+	// failedVerifications contains the total number of failed verifications per peer.
+	failedVerifications map[peer.ID]int
+
+	// failedVerificationsByChain contains the number of failed verifications per peer for each chain.
+	failedVerificationsByChain map[*Chain]map[peer.ID]int
+
+	// peerPenalized is triggered when a peer failed a verification.
+	peerPenalized *event.Event2[peer.ID, int]
+
+	// mutex is used to synchronize access to the failed verifications.
+	mutex syncutils.RWMutex
+
+	// Logger embeds a logger that can be used to log messages emitted by this component.
+	log.Logger
+}
+
+// newPeerPenalties creates a new peer penalties instance for the given protocol.
+func newPeerPenalties(protocol *Protocol) *PeerPenalties {
+	p := &PeerPenalties{
+		Logger:                     lo.Return1(protocol.Logger.NewChildLogger("PeerPenalties")),
+		protocol:                   protocol,
+		failedVerifications:        make(map[peer.ID]int),
+		failedVerificationsByChain: make(map[*Chain]map[peer.ID]int),
+		peerPenalized:              event.New2[peer.ID, int](),
+	}
+
+	protocol.Constructed.OnTrigger(func() {
+		protocol.Shutdown.OnTrigger(protocol.Chains.WithElements(func(chain *Chain) (shutdown func()) {
+			return func() {
+				p.mutex.Lock()
+				defer p.mutex.Unlock()
+
+				delete(p.failedVerificationsByChain, chain)
+			}
+		}))
+	})
+
+	return p
+}
+
+// RecordFailedVerification records that the data of the given chain that was received from the given peer failed the
+// verification.
+func (p *PeerPenalties) RecordFailedVerification(chain *Chain, from peer.ID, reason string) {
+	failedVerifications := func() int {
+		p.mutex.Lock()
+		defer p.mutex.Unlock()
+
+		p.failedVerifications[from]++
+
+		if chain != nil {
+			failedVerificationsOfChain, exists := p.failedVerificationsByChain[chain]
+			if !exists {
+				failedVerificationsOfChain = make(map[peer.ID]int)
+				p.failedVerificationsByChain[chain] = failedVerificationsOfChain
+			}
+
+			failedVerificationsOfChain[from]++
+		}
+
+		return p.failedVerifications[from]
+	}()
+
+	if chain != nil && p.IsIgnored(chain, from) {
+		p.LogWarn("ignoring peer for chain", "chain", chain.LogName(), "peer", from, "reason", reason, "failedVerifications", failedVerifications)
+	} else {
+		p.LogDebug("peer failed verification", "peer", from, "reason", reason, "failedVerifications", failedVerifications)
+	}
+
+	p.peerPenalized.Trigger(from, failedVerifications)
+}
+
+// FailedVerifications returns the total number of failed verifications of the given peer.
+func (p *PeerPenalties) FailedVerifications(id peer.ID) int {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	return p.failedVerifications[id]
+}
+
+// IsIgnored returns true if the given peer failed the verification of the data of the given chain too often.
+func (p *PeerPenalties) IsIgnored(chain *Chain, id peer.ID) bool {
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	return p.isIgnored(chain, id)
+}
+
+// OnPeerPenalized registers a callback that is triggered when a peer failed a verification (it receives the total
+// number of failed verifications of the peer).
+func (p *PeerPenalties) OnPeerPenalized(callback func(id peer.ID, failedVerifications int)) (unsubscribe func()) {
+	return p.peerPenalized.Hook(callback).Unhook
+}
+
+// requestTargets returns the neighbors that the data of the given chain should be requested from. It returns a nil
+// slice (which means all neighbors) if none of the neighbors was penalized yet and send=false if all neighbors are
+// ignored for the chain.
+func (p *PeerPenalties) requestTargets(chain *Chain) (targets []peer.ID, send bool) {
+	neighbors := p.protocol.Network.Neighbors()
+
+	p.mutex.RLock()
+	defer p.mutex.RUnlock()
+
+	if len(p.failedVerifications) == 0 {
+		return nil, true
+	}
+
+	// only the neighbors with the least failed verifications are asked (ignored neighbors are skipped).
+	minFailedVerifications := -1
+	for _, neighbor := range neighbors {
+		if p.isIgnored(chain, neighbor) {
+			continue
+		}
+
+		switch failedVerifications := p.failedVerifications[neighbor]; {
+		case minFailedVerifications == -1 || failedVerifications < minFailedVerifications:
+			minFailedVerifications = failedVerifications
+			targets = []peer.ID{neighbor}
+		case failedVerifications == minFailedVerifications:
+			targets = append(targets, neighbor)
+		}
+	}
+
+	return targets, len(targets) != 0
+}
+
+// isIgnored returns true if the given peer failed the verification of the data of the given chain too often (without
+// locking).
+func (p *PeerPenalties) isIgnored(chain *Chain, id peer.ID) bool {
+	maxFailedVerifications := p.protocol.Options.MaxFailedVerificationsPerChain
+
+	return chain != nil && maxFailedVerifications > 0 && p.failedVerificationsByChain[chain][id] >= maxFailedVerifications
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/iotaledger/iota-core/pkg/model"
 	"github.com/iotaledger/iota-core/pkg/network"
 	"github.com/iotaledger/iota-core/pkg/network/protocols/core"
+	"github.com/iotaledger/iota-core/pkg/profiling"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine"
 	iotago "github.com/iotaledger/iota.go/v4"
 )
@@ -47,6 +48,13 @@ type Protocol struct {
 	// WarpSync contains the subcomponent that is responsible for handling warp sync requests and responses.
 	WarpSync *WarpSync
 
+	// PeerPenalties contains the subcomponent that is responsible for penalizing peers that sent data that failed the
+	// proof verification.
+	PeerPenalties *PeerPenalties
+
+	// CommitmentBroadcast contains the subcomponent that is responsible for gossiping the commitments of the main engine.
+	CommitmentBroadcast *CommitmentBroadcast
+
 	// Engines contains the engines that are managed by the protocol.
 	Engines *Engines
 
@@ -144,10 +152,12 @@ func (p *Protocol) LatestAPI() iotago.API {
 
 // initSubcomponents initializes the subcomponents of the protocol and returns a function that shuts them down.
 func (p *Protocol) initSubcomponents(networkEndpoint network.Endpoint) (shutdown func()) {
-	p.Network = core.NewProtocol(networkEndpoint, p.Workers.CreatePool("NetworkProtocol"), p)
+	p.Network = core.NewProtocol(networkEndpoint, profiling.CreatePool(p.Workers, "NetworkProtocol"), p, p.Options.NetworkProtocolOptions...)
 	p.Blocks = newBlocks(p)
+	p.PeerPenalties = newPeerPenalties(p)
 	p.Attestations = newAttestations(p)
 	p.WarpSync = newWarpSync(p)
+	p.CommitmentBroadcast = newCommitmentBroadcast(p)
 	p.Commitments = newCommitments(p)
 	p.Chains = newChains(p)
 	p.Engines = newEngines(p)
@@ -155,6 +165,7 @@ func (p *Protocol) initSubcomponents(networkEndpoint network.Endpoint) (shutdown
 	return func() {
 		p.Blocks.Shutdown()
 		p.WarpSync.Shutdown()
+		p.CommitmentBroadcast.Shutdown()
 		p.Network.Shutdown()
 		p.Workers.WaitChildren()
 		p.Engines.Shutdown.Trigger()
@@ -195,6 +206,7 @@ func (p *Protocol) initNetwork() (shutdown func()) {
 		p.Network.OnAttestationsRequestReceived(p.Attestations.processRequest),
 		p.Network.OnWarpSyncResponseReceived(p.WarpSync.ProcessResponse),
 		p.Network.OnWarpSyncRequestReceived(p.WarpSync.ProcessRequest),
+		p.CommitmentBroadcast.initAcknowledgements(),
 	)
 }
 
@@ -5,8 +5,10 @@ import (
 
 	"github.com/iotaledger/hive.go/ds"
 	"github.com/iotaledger/hive.go/ds/shrinkingmap"
+	"github.com/iotaledger/hive.go/runtime/options"
 	"github.com/iotaledger/hive.go/runtime/syncutils"
 	"github.com/iotaledger/hive.go/runtime/timed"
+	"github.com/iotaledger/iota-core/pkg/core/acceptance"
 	"github.com/iotaledger/iota-core/pkg/core/account"
 	"github.com/iotaledger/iota-core/pkg/protocol/sybilprotection/activitytracker"
 	iotago "github.com/iotaledger/iota.go/v4"
@@ -23,17 +25,31 @@ type ActivityTracker struct {
 	activityMutex    syncutils.RWMutex
 
 	activityWindow time.Duration
+
+	// optsActivityHysteresis is the additional time that an online seat stays online after its activity window
+	// elapsed, so that seats that are active at intervals close to the activity window do not flap.
+	optsActivityHysteresis time.Duration
 }
 
-func NewActivityTracker(activityWindow time.Duration) *ActivityTracker {
-	return &ActivityTracker{
+func NewActivityTracker(activityWindow time.Duration, opts ...options.Option[ActivityTracker]) *ActivityTracker {
+	return options.Apply(&ActivityTracker{
 		Events:          activitytracker.NewEvents(),
 		onlineCommittee: ds.NewSet[account.SeatIndex](),
 		inactivityQueue: timed.NewPriorityQueue[account.SeatIndex](true),
 		lastActivities:  shrinkingmap.New[account.SeatIndex, time.Time](),
 
 		activityWindow: activityWindow,
-	}
+	}, opts)
+}
+
+// ActivityWindow returns the duration within which a seat needs to be active to come online.
+func (a *ActivityTracker) ActivityWindow() time.Duration {
+	return a.activityWindow
+}
+
+// ActivityHysteresis returns the additional time that an online seat stays online after its activity window elapsed.
+func (a *ActivityTracker) ActivityHysteresis() time.Duration {
+	return a.optsActivityHysteresis
 }
 
 // OnlineCommittee returns the set of validators selected to be part of the committee that has been seen recently.
@@ -53,6 +69,7 @@ func (a *ActivityTracker) MarkSeatActive(seat account.SeatIndex, id iotago.Accou
 	} else if !exists {
 		a.onlineCommittee.Add(seat)
 		a.Events.OnlineCommitteeSeatAdded.Trigger(seat, id)
+		a.triggerOnlineWeightChanged(seat, true, seatActivityTime)
 	}
 
 	a.lastActivities.Set(seat, seatActivityTime)
@@ -65,21 +82,44 @@ func (a *ActivityTracker) MarkSeatActive(seat account.SeatIndex, id iotago.Accou
 
 	a.lastActivityTime = seatActivityTime
 
-	activityThreshold := seatActivityTime.Add(-a.activityWindow)
-	for _, inactiveSeat := range a.inactivityQueue.PopUntil(activityThreshold) {
-		if lastActivityForInactiveSeat, exists := a.lastActivities.Get(inactiveSeat); exists && lastActivityForInactiveSeat.After(activityThreshold) {
+	// Seats only go offline once the hysteresis elapsed as well, while coming online requires an activity within the
+	// activity window.
+	inactivityThreshold := seatActivityTime.Add(-(a.activityWindow + a.optsActivityHysteresis))
+	for _, inactiveSeat := range a.inactivityQueue.PopUntil(inactivityThreshold) {
+		if lastActivityForInactiveSeat, exists := a.lastActivities.Get(inactiveSeat); exists && lastActivityForInactiveSeat.After(inactivityThreshold) {
 			continue
 		}
 
-		a.markSeatInactive(inactiveSeat)
+		a.markSeatInactive(inactiveSeat, seatActivityTime)
 	}
 }
 
-func (a *ActivityTracker) markSeatInactive(seat account.SeatIndex) {
+func (a *ActivityTracker) markSeatInactive(seat account.SeatIndex, activityTime time.Time) {
 	a.lastActivities.Delete(seat)
 
 	// Only trigger the event if online committee member is removed.
 	if a.onlineCommittee.Delete(seat) {
 		a.Events.OnlineCommitteeSeatRemoved.Trigger(seat)
+		a.triggerOnlineWeightChanged(seat, false, activityTime)
 	}
 }
+
+// triggerOnlineWeightChanged triggers the OnlineWeightChanged event for a seat that was just added to or removed from
+// the online committee.
+func (a *ActivityTracker) triggerOnlineWeightChanged(seat account.SeatIndex, online bool, activityTime time.Time) {
+	onlineSeats := a.onlineCommittee.Size()
+	previousOnlineSeats := onlineSeats + 1
+	if online {
+		previousOnlineSeats = onlineSeats - 1
+	}
+
+	a.Events.OnlineWeightChanged.Trigger(&activitytracker.OnlineWeightChange{
+		Seat:                        seat,
+		Online:                      online,
+		Time:                        activityTime,
+		PreviousOnlineSeats:         previousOnlineSeats,
+		OnlineSeats:                 onlineSeats,
+		PreviousAcceptanceThreshold: acceptance.Threshold(int64(previousOnlineSeats)),
+		AcceptanceThreshold:         acceptance.Threshold(int64(onlineSeats)),
+	})
+}
@@ -0,0 +1,75 @@
+package activitytrackerv1
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/iota-core/pkg/core/account"
+	"github.com/iotaledger/iota-core/pkg/protocol/sybilprotection/activitytracker"
+	"github.com/iotaledger/iota.go/v4/tpkg"
+)
+
+func TestActivityTracker_Hysteresis(t *testing.T) {
+	activityTracker := NewActivityTracker(10*time.Second, WithActivityHysteresis(5*time.Second))
+
+	changes := make([]*activitytracker.OnlineWeightChange, 0)
+	activityTracker.Events.OnlineWeightChanged.Hook(func(change *activitytracker.OnlineWeightChange) {
+		changes = append(changes, change)
+	})
+
+	start := time.Unix(1000, 0)
+	seat0, seat1, seat2 := account.SeatIndex(0), account.SeatIndex(1), account.SeatIndex(2)
+
+	activityTracker.MarkSeatActive(seat0, tpkg.RandAccountID(), start)
+	activityTracker.MarkSeatActive(seat1, tpkg.RandAccountID(), start)
+	activityTracker.MarkSeatActive(seat2, tpkg.RandAccountID(), start.Add(time.Second))
+
+	require.ElementsMatch(t, []account.SeatIndex{seat0, seat1, seat2}, activityTracker.OnlineCommittee().ToSlice())
+	require.Len(t, changes, 3)
+	require.Equal(t, &activitytracker.OnlineWeightChange{
+		Seat:                        seat2,
+		Online:                      true,
+		Time:                        start.Add(time.Second),
+		PreviousOnlineSeats:         2,
+		OnlineSeats:                 3,
+		PreviousAcceptanceThreshold: 2,
+		AcceptanceThreshold:         3,
+	}, changes[2])
+
+	// seat0 and seat1 are inactive for longer than the activity window but stay online thanks to the hysteresis.
+	activityTracker.MarkSeatActive(seat2, tpkg.RandAccountID(), start.Add(14*time.Second))
+	require.ElementsMatch(t, []account.SeatIndex{seat0, seat1, seat2}, activityTracker.OnlineCommittee().ToSlice())
+	require.Len(t, changes, 3)
+
+	// seat0 renews its activity before the hysteresis elapsed and does not flap.
+	activityTracker.MarkSeatActive(seat0, tpkg.RandAccountID(), start.Add(14*time.Second))
+	require.Len(t, changes, 3)
+
+	// seat1 goes offline once the hysteresis elapsed as well.
+	activityTracker.MarkSeatActive(seat2, tpkg.RandAccountID(), start.Add(16*time.Second))
+	require.ElementsMatch(t, []account.SeatIndex{seat0, seat2}, activityTracker.OnlineCommittee().ToSlice())
+	require.Len(t, changes, 4)
+	require.Equal(t, &activitytracker.OnlineWeightChange{
+		Seat:                        seat1,
+		Online:                      false,
+		Time:                        start.Add(16 * time.Second),
+		PreviousOnlineSeats:         3,
+		OnlineSeats:                 2,
+		PreviousAcceptanceThreshold: 3,
+		AcceptanceThreshold:         2,
+	}, changes[3])
+
+	// activities that are older than the activity window do not bring a seat back online, even if they are within the
+	// hysteresis.
+	activityTracker.MarkSeatActive(seat1, tpkg.RandAccountID(), start.Add(4*time.Second))
+	require.ElementsMatch(t, []account.SeatIndex{seat0, seat2}, activityTracker.OnlineCommittee().ToSlice())
+	require.Len(t, changes, 4)
+
+	// recent activities bring the seat back online.
+	activityTracker.MarkSeatActive(seat1, tpkg.RandAccountID(), start.Add(16*time.Second))
+	require.ElementsMatch(t, []account.SeatIndex{seat0, seat1, seat2}, activityTracker.OnlineCommittee().ToSlice())
+	require.Len(t, changes, 5)
+	require.True(t, changes[4].Online)
+}
@@ -0,0 +1,14 @@
+package activitytrackerv1
+
+import (
+	"time"
+
+	"github.com/iotaledger/hive.go/runtime/options"
+)
+
+// WithActivityHysteresis sets the additional time that an online seat stays online after its activity window elapsed.
+func WithActivityHysteresis(activityHysteresis time.Duration) options.Option[ActivityTracker] {
+	return func(a *ActivityTracker) {
+		a.optsActivityHysteresis = activityHysteresis
+	}
+}
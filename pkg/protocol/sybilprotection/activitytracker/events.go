@@ -1,6 +1,8 @@
 package activitytracker
 
 import (
+	"time"
+
 	"github.com/iotaledger/hive.go/runtime/event"
 	"github.com/iotaledger/iota-core/pkg/core/account"
 	iotago "github.com/iotaledger/iota.go/v4"
@@ -9,6 +11,7 @@ import (
 type Events struct {
 	OnlineCommitteeSeatAdded   *event.Event2[account.SeatIndex, iotago.AccountID]
 	OnlineCommitteeSeatRemoved *event.Event1[account.SeatIndex]
+	OnlineWeightChanged        *event.Event1[*OnlineWeightChange]
 
 	event.Group[Events, *Events]
 }
@@ -18,5 +21,30 @@ var NewEvents = event.CreateGroupConstructor(func() (newEvents *Events) {
 	return &Events{
 		OnlineCommitteeSeatAdded:   event.New2[account.SeatIndex, iotago.AccountID](),
 		OnlineCommitteeSeatRemoved: event.New1[account.SeatIndex](),
+		OnlineWeightChanged:        event.New1[*OnlineWeightChange](),
 	}
 })
+
+// OnlineWeightChange contains the details of a change of the online committee.
+type OnlineWeightChange struct {
+	// Seat is the seat that came online or went offline.
+	Seat account.SeatIndex
+
+	// Online is true if the seat came online and false if it went offline.
+	Online bool
+
+	// Time is the activity time at which the change happened.
+	Time time.Time
+
+	// PreviousOnlineSeats is the number of online seats before the change.
+	PreviousOnlineSeats int
+
+	// OnlineSeats is the number of online seats after the change.
+	OnlineSeats int
+
+	// PreviousAcceptanceThreshold is the number of online seats that was required for acceptance before the change.
+	PreviousAcceptanceThreshold int64
+
+	// AcceptanceThreshold is the number of online seats that is required for acceptance after the change.
+	AcceptanceThreshold int64
+}
@@ -4,6 +4,7 @@ import (
 	"github.com/iotaledger/hive.go/runtime/event"
 	"github.com/iotaledger/iota-core/pkg/core/account"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
+	"github.com/iotaledger/iota-core/pkg/protocol/sybilprotection/activitytracker"
 	iotago "github.com/iotaledger/iota.go/v4"
 )
 
@@ -11,6 +12,7 @@ type Events struct {
 	BlockProcessed             *event.Event1[*blocks.Block]
 	OnlineCommitteeSeatAdded   *event.Event2[account.SeatIndex, iotago.AccountID]
 	OnlineCommitteeSeatRemoved *event.Event1[account.SeatIndex]
+	OnlineWeightChanged        *event.Event1[*activitytracker.OnlineWeightChange]
 
 	event.Group[Events, *Events]
 }
@@ -21,5 +23,6 @@ var NewEvents = event.CreateGroupConstructor(func() (newEvents *Events) {
 		BlockProcessed:             event.New1[*blocks.Block](),
 		OnlineCommitteeSeatAdded:   event.New2[account.SeatIndex, iotago.AccountID](),
 		OnlineCommitteeSeatRemoved: event.New1[account.SeatIndex](),
+		OnlineWeightChanged:        event.New1[*activitytracker.OnlineWeightChange](),
 	}
 })
@@ -189,6 +189,14 @@ func (m *ManualPOA) RotateCommittee(epoch iotago.EpochIndex, validators accounts
 	return m.committee, nil
 }
 
+func (m *ManualPOA) PreviewCommittee(epoch iotago.EpochIndex, validators accounts.AccountsData) (accounts.AccountsData, *account.SeatedAccounts, error) {
+	if m.committee == nil {
+		return nil, nil, ierrors.Errorf("committee for epoch %d not set", epoch)
+	}
+
+	return validators, m.committee, nil
+}
+
 func (m *ManualPOA) SetCommittee(epoch iotago.EpochIndex, validators *account.Accounts) error {
 	if m.committee == nil || m.accounts.Size() == 0 {
 		m.accounts = validators
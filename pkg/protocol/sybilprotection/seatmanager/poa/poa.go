@@ -31,6 +31,7 @@ type SeatManager struct {
 	committeeMutex syncutils.RWMutex
 
 	optsActivityWindow         time.Duration
+	optsActivityHysteresis     time.Duration
 	optsOnlineCommitteeStartup []iotago.AccountID
 
 	module.Module
@@ -47,10 +48,11 @@ func NewProvider(opts ...options.Option[SeatManager]) module.Provider[*engine.En
 
 				optsActivityWindow: time.Second * 30,
 			}, opts, func(s *SeatManager) {
-				activityTracker := activitytrackerv1.NewActivityTracker(s.optsActivityWindow)
+				activityTracker := activitytrackerv1.NewActivityTracker(s.optsActivityWindow, activitytrackerv1.WithActivityHysteresis(s.optsActivityHysteresis))
 				s.activityTracker = activityTracker
 				s.events.OnlineCommitteeSeatAdded.LinkTo(activityTracker.Events.OnlineCommitteeSeatAdded)
 				s.events.OnlineCommitteeSeatRemoved.LinkTo(activityTracker.Events.OnlineCommitteeSeatRemoved)
+				s.events.OnlineWeightChanged.LinkTo(activityTracker.Events.OnlineWeightChanged)
 
 				e.Events.SeatManager.LinkTo(s.events)
 
@@ -112,6 +114,19 @@ func (s *SeatManager) RotateCommittee(epoch iotago.EpochIndex, validators accoun
 	return s.committee, nil
 }
 
+// PreviewCommittee returns the committee that RotateCommittee would return for the given candidates. As the committee of
+// the PoA SeatManager does not rotate, this is always the current committee.
+func (s *SeatManager) PreviewCommittee(epoch iotago.EpochIndex, candidates accounts.AccountsData) (accounts.AccountsData, *account.SeatedAccounts, error) {
+	s.committeeMutex.RLock()
+	defer s.committeeMutex.RUnlock()
+
+	if s.committee == nil {
+		return nil, nil, ierrors.Errorf("committee for epoch %d not set", epoch)
+	}
+
+	return candidates, s.committee, nil
+}
+
 // CommitteeInSlot returns the set of validators selected to be part of the committee in the given slot.
 func (s *SeatManager) CommitteeInSlot(slot iotago.SlotIndex) (*account.SeatedAccounts, bool) {
 	s.committeeMutex.RLock()
@@ -15,6 +15,10 @@ type SeatManager interface {
 	// RotateCommittee rotates the committee evaluating the given set of candidates to produce the new committee.
 	RotateCommittee(epoch iotago.EpochIndex, candidates accounts.AccountsData) (*account.SeatedAccounts, error)
 
+	// PreviewCommittee returns the committee that RotateCommittee would produce for the given set of candidates
+	// without storing it, together with the candidates ordered by their rank in the selection.
+	PreviewCommittee(epoch iotago.EpochIndex, candidates accounts.AccountsData) (rankedCandidates accounts.AccountsData, committee *account.SeatedAccounts, err error)
+
 	// SetCommittee sets the committee for a given slot.
 	// This is used when re-using the same committee for consecutive epochs.
 	SetCommittee(epoch iotago.EpochIndex, committee *account.Accounts) error
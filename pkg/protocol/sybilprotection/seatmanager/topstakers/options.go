@@ -14,6 +14,13 @@ func WithActivityWindow(activityWindow time.Duration) options.Option[SeatManager
 	}
 }
 
+// WithActivityHysteresis sets the additional time that a validator stays active after its activity window elapsed.
+func WithActivityHysteresis(activityHysteresis time.Duration) options.Option[SeatManager] {
+	return func(p *SeatManager) {
+		p.optsActivityHysteresis = activityHysteresis
+	}
+}
+
 func WithOnlineCommitteeStartup(optsOnlineCommittee ...iotago.AccountID) options.Option[SeatManager] {
 	return func(p *SeatManager) {
 		p.optsOnlineCommitteeStartup = optsOnlineCommittee
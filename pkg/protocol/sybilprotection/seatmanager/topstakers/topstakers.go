@@ -32,6 +32,7 @@ type SeatManager struct {
 	activityTracker activitytracker.ActivityTracker
 
 	optsActivityWindow         time.Duration
+	optsActivityHysteresis     time.Duration
 	optsOnlineCommitteeStartup []iotago.AccountID
 
 	module.Module
@@ -48,10 +49,11 @@ func NewProvider(opts ...options.Option[SeatManager]) module.Provider[*engine.En
 
 				optsActivityWindow: time.Second * 30,
 			}, opts, func(s *SeatManager) {
-				activityTracker := activitytrackerv1.NewActivityTracker(s.optsActivityWindow)
+				activityTracker := activitytrackerv1.NewActivityTracker(s.optsActivityWindow, activitytrackerv1.WithActivityHysteresis(s.optsActivityHysteresis))
 				s.activityTracker = activityTracker
 				s.events.OnlineCommitteeSeatAdded.LinkTo(activityTracker.Events.OnlineCommitteeSeatAdded)
 				s.events.OnlineCommitteeSeatRemoved.LinkTo(activityTracker.Events.OnlineCommitteeSeatRemoved)
+				s.events.OnlineWeightChanged.LinkTo(activityTracker.Events.OnlineWeightChanged)
 
 				e.Events.SeatManager.LinkTo(s.events)
 
@@ -106,6 +108,25 @@ func (s *SeatManager) RotateCommittee(epoch iotago.EpochIndex, candidates accoun
 	return committee, nil
 }
 
+// PreviewCommittee returns the committee that RotateCommittee would select from the given candidates without storing it.
+// The returned candidates are ordered by their rank in the selection, i.e. after applying all tie-breaking rules.
+func (s *SeatManager) PreviewCommittee(epoch iotago.EpochIndex, candidates accounts.AccountsData) (accounts.AccountsData, *account.SeatedAccounts, error) {
+	if len(candidates) == 0 {
+		return nil, nil, ierrors.New("candidates must not be empty")
+	}
+
+	// selectNewCommittee sorts the candidates in place, so we work on a copy to not modify the passed slice.
+	rankedCandidates := make(accounts.AccountsData, len(candidates))
+	copy(rankedCandidates, candidates)
+
+	committee, err := s.selectNewCommittee(epoch, rankedCandidates)
+	if err != nil {
+		return nil, nil, ierrors.Wrap(err, "error while selecting new committee")
+	}
+
+	return rankedCandidates, committee, nil
+}
+
 // CommitteeInSlot returns the set of validators selected to be part of the committee in the given slot.
 func (s *SeatManager) CommitteeInSlot(slot iotago.SlotIndex) (*account.SeatedAccounts, bool) {
 	s.committeeMutex.RLock()
@@ -284,6 +284,77 @@ func TestTopStakers_RotateCommittee(t *testing.T) {
 	}
 }
 
+func TestTopStakers_PreviewCommittee(t *testing.T) {
+	var testAPI = iotago.V3API(
+		iotago.NewV3SnapshotProtocolParameters(
+			iotago.WithWorkScoreOptions(0, 1, 0, 0, 0, 0, 0, 0, 0, 0), // all zero except block offset gives all blocks workscore = 1
+			iotago.WithTargetCommitteeSize(3),
+		),
+	)
+
+	committeeStore := epochstore.NewStore(kvstore.Realm{}, mapdb.NewMapDB(), 0, (*account.Accounts).Bytes, account.AccountsFromBytes)
+
+	s := &SeatManager{
+		apiProvider:     iotago.SingleVersionProvider(testAPI),
+		committeeStore:  committeeStore,
+		events:          seatmanager.NewEvents(),
+		activityTracker: activitytrackerv1.NewActivityTracker(time.Second * 30),
+	}
+
+	// Previewing a committee without candidates fails like rotating it.
+	_, _, err := s.PreviewCommittee(1, make(accounts.AccountsData, 0))
+	require.Error(t, err)
+
+	candidates := accounts.AccountsData{
+		// Loses against all other candidates on pool stake.
+		{ID: tpkg.RandAccountID(), ValidatorStake: 100, DelegationStake: 800, StakeEndEpoch: 100, FixedCost: 1},
+		// Ties with the next three candidates on pool stake, but has the smallest validator stake.
+		{ID: tpkg.RandAccountID(), ValidatorStake: 400, DelegationStake: 600, StakeEndEpoch: 100, FixedCost: 1},
+		// Ties with the next candidate on pool stake and validator stake, but has a larger fixed cost.
+		{ID: tpkg.RandAccountID(), ValidatorStake: 500, DelegationStake: 500, StakeEndEpoch: 100, FixedCost: 2},
+		{ID: tpkg.RandAccountID(), ValidatorStake: 500, DelegationStake: 500, StakeEndEpoch: 100, FixedCost: 1},
+		// Ties with the previous candidates on pool stake and validator stake, but stakes for longer.
+		{ID: tpkg.RandAccountID(), ValidatorStake: 500, DelegationStake: 500, StakeEndEpoch: 200, FixedCost: 5},
+	}
+	candidateIDs := lo.Map(candidates, func(candidate *accounts.AccountData) iotago.AccountID { return candidate.ID })
+
+	rankedCandidates, committee, err := s.PreviewCommittee(1, candidates)
+	require.NoError(t, err)
+
+	// The ranking applies all tie-breaking rules.
+	require.Equal(t, []iotago.AccountID{candidateIDs[4], candidateIDs[3], candidateIDs[2], candidateIDs[1], candidateIDs[0]}, lo.Map(rankedCandidates, func(candidate *accounts.AccountData) iotago.AccountID { return candidate.ID }))
+
+	// The passed candidates are not reordered.
+	require.Equal(t, candidateIDs, lo.Map(candidates, func(candidate *accounts.AccountData) iotago.AccountID { return candidate.ID }))
+
+	// The top ranked candidates get the seats of the committee.
+	expectedCommittee := account.NewAccounts()
+	for _, candidate := range rankedCandidates[:3] {
+		require.NoError(t, expectedCommittee.Set(candidate.ID, &account.Pool{
+			PoolStake:      candidate.ValidatorStake + candidate.DelegationStake,
+			ValidatorStake: candidate.ValidatorStake,
+			FixedCost:      candidate.FixedCost,
+		}))
+	}
+	assertCommittee(t, expectedCommittee, committee)
+
+	// The previewed committee is not stored.
+	_, exists := s.CommitteeInEpoch(1)
+	require.False(t, exists)
+
+	// The previewed committee is the one that is selected when rotating the committee.
+	rotatedCommittee, err := s.RotateCommittee(1, candidates)
+	require.NoError(t, err)
+	assertCommittee(t, expectedCommittee, rotatedCommittee)
+
+	for _, candidateID := range candidateIDs {
+		previewedSeat, previewedExists := committee.GetSeat(candidateID)
+		rotatedSeat, rotatedExists := rotatedCommittee.GetSeat(candidateID)
+		require.Equal(t, rotatedExists, previewedExists)
+		require.Equal(t, rotatedSeat, previewedSeat)
+	}
+}
+
 func addCommitteeMember(t *testing.T, committee *account.Accounts, pool *account.Pool) iotago.AccountID {
 	accountID := tpkg.RandAccountID()
 	require.NoError(t, committee.Set(accountID, pool))
@@ -4,6 +4,7 @@ import (
 	"io"
 
 	"github.com/iotaledger/hive.go/runtime/module"
+	"github.com/iotaledger/iota-core/pkg/model"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/accounts"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
 	"github.com/iotaledger/iota-core/pkg/protocol/sybilprotection/seatmanager"
@@ -15,7 +16,12 @@ type SybilProtection interface {
 	TrackBlock(block *blocks.Block)
 	EligibleValidators(epoch iotago.EpochIndex) (accounts.AccountsData, error)
 	OrderedRegisteredCandidateValidatorsList(epoch iotago.EpochIndex) ([]*api.ValidatorResponse, error)
+	// CommitteePreview returns the committee that is expected to be selected for the epoch following the epoch of the
+	// last committed slot, including the ranking of all candidates.
+	CommitteePreview() (*model.CommitteePreview, error)
 	IsCandidateActive(validatorID iotago.AccountID, epoch iotago.EpochIndex) (bool, error)
+	// ValidatorPerformanceFactor returns the performance factor that the given validator achieved in the given slot.
+	ValidatorPerformanceFactor(validatorID iotago.AccountID, slot iotago.SlotIndex) (uint64, error)
 	// ValidatorReward returns the amount of mana that a validator with the given staking feature has earned in the feature's epoch range.
 	//
 	// The first epoch in which rewards existed is returned (firstRewardEpoch).
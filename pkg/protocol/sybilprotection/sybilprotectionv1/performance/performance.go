@@ -174,6 +174,29 @@ func (t *Tracker) getValidatorCandidates(epoch iotago.EpochIndex) (ds.Set[iotago
 	return candidates, nil
 }
 
+// ValidatorPerformanceFactor returns the performance factor that the given validator achieved in the given slot, which
+// is the number of subslots in which it issued validation blocks (or 0 if it issued more blocks than allowed).
+func (t *Tracker) ValidatorPerformanceFactor(validatorID iotago.AccountID, slot iotago.SlotIndex) (uint64, error) {
+	t.performanceFactorsMutex.RLock()
+	defer t.performanceFactorsMutex.RUnlock()
+
+	validatorPerformances, err := t.validatorPerformancesFunc(slot)
+	if err != nil {
+		return 0, ierrors.Wrapf(err, "failed to load performance factors for slot %d", slot)
+	}
+
+	validatorPerformance, exists, err := validatorPerformances.Load(validatorID)
+	if err != nil {
+		return 0, ierrors.Wrapf(err, "failed to load performance factor for account %s in slot %d", validatorID, slot)
+	}
+
+	if !exists || validatorPerformance.BlocksIssuedCount > t.apiProvider.APIForSlot(slot).ProtocolParameters().ValidationBlocksPerSlot() {
+		return 0, nil
+	}
+
+	return uint64(bits.OnesCount32(validatorPerformance.SlotActivityVector)), nil
+}
+
 func (t *Tracker) LoadCommitteeForEpoch(epoch iotago.EpochIndex) (committee *account.Accounts, exists bool) {
 	c, err := t.committeeStore.Load(epoch)
 	if err != nil {
@@ -141,3 +141,56 @@ func TestManager_Candidates(t *testing.T) {
 	require.True(t, lo.PanicOnErr(ts.Instance.EligibleValidatorCandidates(1)).IsEmpty())
 	require.True(t, lo.PanicOnErr(ts.Instance.ValidatorCandidates(1)).IsEmpty())
 }
+
+func TestManager_ValidatorPerformanceFactor(t *testing.T) {
+	ts := NewTestSuite(t)
+
+	epoch := iotago.EpochIndex(2)
+	epochActions := map[string]*EpochActions{
+		"A": {
+			PoolStake:                   200,
+			ValidatorStake:              40,
+			Delegators:                  []iotago.BaseToken{20, 40, 40, 40, 20},
+			FixedCost:                   10,
+			ActiveSlotsCount:            2, // the validator dropped out after two slots
+			ValidationBlocksSentPerSlot: 10,
+			SlotPerformance:             10,
+		},
+		"B": {
+			PoolStake:                   200,
+			ValidatorStake:              40,
+			Delegators:                  []iotago.BaseToken{20, 20, 10, 30, 80},
+			FixedCost:                   10,
+			ActiveSlotsCount:            8,
+			ValidationBlocksSentPerSlot: 10, // many blocks in one subslot
+			SlotPerformance:             4,
+		},
+		"C": {
+			PoolStake:                   10,
+			ValidatorStake:              5,
+			Delegators:                  []iotago.BaseToken{3, 2},
+			FixedCost:                   100,
+			ActiveSlotsCount:            8,
+			ValidationBlocksSentPerSlot: uint64(ts.api.ProtocolParameters().ValidationBlocksPerSlot() + 2), // more blocks than allowed
+			SlotPerformance:             10,
+		},
+	}
+	ts.ApplyEpochActions(epoch, epochActions)
+
+	firstSlot := ts.api.TimeProvider().EpochStart(epoch)
+	for alias, expectedPerformanceFactors := range map[string][]uint64{
+		"A": {10, 10, 0},
+		"B": {4, 4, 4},
+		"C": {0, 0, 0},
+	} {
+		for i, expectedPerformanceFactor := range expectedPerformanceFactors {
+			performanceFactor, err := ts.Instance.ValidatorPerformanceFactor(ts.Account(alias, false), firstSlot+iotago.SlotIndex(i))
+			require.NoError(t, err)
+			require.Equal(t, expectedPerformanceFactor, performanceFactor, "unexpected performance factor of %s in slot %d", alias, firstSlot+iotago.SlotIndex(i))
+		}
+	}
+
+	performanceFactor, err := ts.Instance.ValidatorPerformanceFactor(tpkg.RandAccountID(), firstSlot)
+	require.NoError(t, err)
+	require.Zero(t, performanceFactor)
+}
@@ -12,6 +12,7 @@ import (
 	"github.com/iotaledger/hive.go/runtime/options"
 	"github.com/iotaledger/hive.go/runtime/syncutils"
 	"github.com/iotaledger/iota-core/pkg/core/account"
+	"github.com/iotaledger/iota-core/pkg/model"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/accounts"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
@@ -299,6 +300,11 @@ func (o *SybilProtection) IsCandidateActive(validatorID iotago.AccountID, epoch
 	return activeCandidates.Has(validatorID), nil
 }
 
+// ValidatorPerformanceFactor returns the performance factor that the given validator achieved in the given slot.
+func (o *SybilProtection) ValidatorPerformanceFactor(validatorID iotago.AccountID, slot iotago.SlotIndex) (uint64, error) {
+	return o.performanceTracker.ValidatorPerformanceFactor(validatorID, slot)
+}
+
 // EligibleValidators returns the currently known list of recently active validator candidates for the given epoch.
 func (o *SybilProtection) EligibleValidators(epoch iotago.EpochIndex) (accounts.AccountsData, error) {
 	candidates, err := o.performanceTracker.EligibleValidatorCandidates(epoch)
@@ -379,6 +385,154 @@ func (o *SybilProtection) OrderedRegisteredCandidateValidatorsList(epoch iotago.
 	return validatorResp, nil
 }
 
+// CommitteePreview returns the committee that is expected to be selected for the epoch following the epoch of the last
+// committed slot. The candidates are ranked by the SeatManager using the accounts ledger state of the last committed
+// slot, so the preview can still change until the committee is selected.
+func (o *SybilProtection) CommitteePreview() (*model.CommitteePreview, error) {
+	o.mutex.Lock()
+	defer o.mutex.Unlock()
+
+	currentEpoch := o.apiProvider.APIForSlot(o.lastCommittedSlot).TimeProvider().EpochFromSlot(o.lastCommittedSlot)
+	nextEpoch := currentEpoch + 1
+
+	preview := &model.CommitteePreview{
+		Epoch: nextEpoch,
+		Slot:  o.lastCommittedSlot,
+	}
+
+	// If the committee was already selected, there is nothing left to preview and we return the final committee.
+	if committee, exists := o.seatManager.CommitteeInEpoch(nextEpoch); exists {
+		committeeCandidates, reused, err := o.committeeCandidates(committee)
+		if err != nil {
+			return nil, ierrors.Wrapf(err, "failed to retrieve members of committee for epoch %d", nextEpoch)
+		}
+
+		preview.Selected = true
+		preview.Reused = reused
+		preview.Candidates = committeeCandidates
+
+		return preview, nil
+	}
+
+	candidates, err := o.performanceTracker.EligibleValidatorCandidates(currentEpoch)
+	if err != nil {
+		return nil, ierrors.Wrapf(err, "failed to retrieve candidates for epoch %d", nextEpoch)
+	}
+
+	// If there's no candidate, the current committee is going to be reused.
+	if candidates.Size() == 0 {
+		committee, exists := o.seatManager.CommitteeInEpoch(currentEpoch)
+		if !exists {
+			return nil, ierrors.Errorf("committee for current epoch %d not found", currentEpoch)
+		}
+
+		committeeCandidates, _, err := o.committeeCandidates(committee)
+		if err != nil {
+			return nil, ierrors.Wrapf(err, "failed to retrieve members of committee for epoch %d", currentEpoch)
+		}
+
+		preview.Reused = true
+		preview.Candidates = committeeCandidates
+
+		return preview, nil
+	}
+
+	candidateAccounts := make(accounts.AccountsData, 0)
+	if err := candidates.ForEach(func(candidate iotago.AccountID) error {
+		accountData, exists, err := o.ledger.Account(candidate, o.lastCommittedSlot)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return ierrors.Errorf("account of committee candidate %s does not exist in slot %d", candidate, o.lastCommittedSlot)
+		}
+
+		candidateAccounts = append(candidateAccounts, accountData)
+
+		return nil
+	}); err != nil {
+		return nil, ierrors.Wrap(err, "failed to iterate through candidates")
+	}
+
+	rankedCandidates, committee, err := o.seatManager.PreviewCommittee(nextEpoch, candidateAccounts)
+	if err != nil {
+		return nil, ierrors.Wrap(err, "failed to preview committee")
+	}
+
+	preview.Candidates = make([]*model.CommitteeCandidate, 0, len(rankedCandidates))
+	for rank, candidate := range rankedCandidates {
+		seat, selected := committee.GetSeat(candidate.ID)
+
+		preview.Candidates = append(preview.Candidates, &model.CommitteeCandidate{
+			Rank:           rank,
+			AccountID:      candidate.ID,
+			PoolStake:      candidate.ValidatorStake + candidate.DelegationStake,
+			ValidatorStake: candidate.ValidatorStake,
+			FixedCost:      candidate.FixedCost,
+			StakeEndEpoch:  candidate.StakeEndEpoch,
+			Selected:       selected,
+			Seat:           seat,
+		})
+	}
+
+	return preview, nil
+}
+
+// committeeCandidates returns the members of the given committee ordered by their seats and whether the committee was reused.
+func (o *SybilProtection) committeeCandidates(committee *account.SeatedAccounts) ([]*model.CommitteeCandidate, bool, error) {
+	committeeAccounts, err := committee.Accounts()
+	if err != nil {
+		return nil, false, ierrors.Wrap(err, "failed to get accounts from committee")
+	}
+
+	committeeCandidates := make([]*model.CommitteeCandidate, 0, committeeAccounts.Size())
+	committeeAccounts.ForEach(func(accountID iotago.AccountID, pool *account.Pool) bool {
+		seat, exists := committee.GetSeat(accountID)
+		if !exists {
+			err = ierrors.Errorf("account %s has no seat in committee", accountID)
+
+			return false
+		}
+
+		accountData, exists, accountErr := o.ledger.Account(accountID, o.lastCommittedSlot)
+		if accountErr != nil {
+			err = ierrors.Wrapf(accountErr, "failed to load account data for committee member %s", accountID)
+
+			return false
+		}
+
+		var stakeEndEpoch iotago.EpochIndex
+		if exists {
+			stakeEndEpoch = accountData.StakeEndEpoch
+		}
+
+		committeeCandidates = append(committeeCandidates, &model.CommitteeCandidate{
+			AccountID:      accountID,
+			PoolStake:      pool.PoolStake,
+			ValidatorStake: pool.ValidatorStake,
+			FixedCost:      pool.FixedCost,
+			StakeEndEpoch:  stakeEndEpoch,
+			Selected:       true,
+			Seat:           seat,
+		})
+
+		return true
+	})
+	if err != nil {
+		return nil, false, err
+	}
+
+	sort.Slice(committeeCandidates, func(i int, j int) bool {
+		return committeeCandidates[i].Seat < committeeCandidates[j].Seat
+	})
+
+	for rank, committeeCandidate := range committeeCandidates {
+		committeeCandidate.Rank = rank
+	}
+
+	return committeeCandidates, committeeAccounts.IsReused(), nil
+}
+
 func (o *SybilProtection) reuseCommittee(currentEpoch iotago.EpochIndex, targetEpoch iotago.EpochIndex) (*account.Accounts, error) {
 	committee, exists := o.seatManager.CommitteeInEpoch(currentEpoch)
 	if !exists {
@@ -2,5 +2,5 @@ package protocol
 
 const (
 	// DatabaseVersion defines the current version of the database.
-	DatabaseVersion byte = 1
+	DatabaseVersion byte = 2
 )
@@ -14,6 +14,8 @@ import (
 	"github.com/iotaledger/hive.go/lo"
 	"github.com/iotaledger/hive.go/log"
 	"github.com/iotaledger/hive.go/runtime/workerpool"
+	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/profiling"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine"
 	iotago "github.com/iotaledger/iota.go/v4"
 	"github.com/iotaledger/iota.go/v4/merklehasher"
@@ -39,7 +41,7 @@ func newWarpSync(protocol *Protocol) *WarpSync {
 	c := &WarpSync{
 		Logger:     lo.Return1(protocol.Logger.NewChildLogger("WarpSync")),
 		protocol:   protocol,
-		workerPool: protocol.Workers.CreatePool("WarpSync", workerpool.WithWorkerCount(1)),
+		workerPool: profiling.CreatePool(protocol.Workers, "WarpSync", workerpool.WithWorkerCount(1)),
 		ticker:     eventticker.New[iotago.SlotIndex, iotago.CommitmentID](protocol.Options.WarpSyncRequesterOptions...),
 	}
 
@@ -69,26 +71,39 @@ func newWarpSync(protocol *Protocol) *WarpSync {
 	return c
 }
 
-// SendRequest sends a warp sync request for the given commitment ID to all peers.
+// SendRequest sends a warp sync request for the given commitment ID to all peers (that were not penalized for the
+// corresponding chain).
 func (w *WarpSync) SendRequest(commitmentID iotago.CommitmentID) {
 	w.workerPool.Submit(func() {
-		w.protocol.Network.SendWarpSyncRequest(commitmentID)
+		var chain *Chain
+		if commitment, err := w.protocol.Commitments.Get(commitmentID, false); err == nil {
+			chain = commitment.Chain.Get()
+		}
+
+		targets, send := w.protocol.PeerPenalties.requestTargets(chain)
+		if !send {
+			w.LogDebug("no eligible peers to request warp sync data from", "commitmentID", commitmentID)
+
+			return
+		}
+
+		w.protocol.Network.SendWarpSyncRequest(commitmentID, w.protocol.Options.WarpSyncStateDiffs, targets...)
 
-		w.LogDebug("request", "commitmentID", commitmentID)
+		w.LogDebug("request", "commitmentID", commitmentID, "includeStateDiff", w.protocol.Options.WarpSyncStateDiffs)
 	})
 }
 
 // SendResponse sends a warp sync response for the given commitment ID to the given peer.
-func (w *WarpSync) SendResponse(commitment *Commitment, blockIDsBySlotCommitment map[iotago.CommitmentID]iotago.BlockIDs, roots *iotago.Roots, transactionIDs iotago.TransactionIDs, to peer.ID) {
+func (w *WarpSync) SendResponse(commitment *Commitment, blockIDsBySlotCommitment map[iotago.CommitmentID]iotago.BlockIDs, roots *iotago.Roots, transactionIDs iotago.TransactionIDs, stateDiff *model.SlotStateDiff, to peer.ID) {
 	w.workerPool.Submit(func() {
-		w.protocol.Network.SendWarpSyncResponse(commitment.ID(), blockIDsBySlotCommitment, roots.TangleProof(), transactionIDs, roots.MutationProof(), to)
+		w.protocol.Network.SendWarpSyncResponse(commitment.ID(), blockIDsBySlotCommitment, roots.TangleProof(), transactionIDs, roots.MutationProof(), stateDiff, to)
 
 		w.LogTrace("sent response", "commitment", commitment.LogName(), "toPeer", to)
 	})
 }
 
 // ProcessResponse processes the given warp sync response.
-func (w *WarpSync) ProcessResponse(commitmentID iotago.CommitmentID, blockIDsBySlotCommitment map[iotago.CommitmentID]iotago.BlockIDs, proof *merklehasher.Proof[iotago.Identifier], transactionIDs iotago.TransactionIDs, mutationProof *merklehasher.Proof[iotago.Identifier], from peer.ID) {
+func (w *WarpSync) ProcessResponse(commitmentID iotago.CommitmentID, blockIDsBySlotCommitment map[iotago.CommitmentID]iotago.BlockIDs, proof *merklehasher.Proof[iotago.Identifier], transactionIDs iotago.TransactionIDs, mutationProof *merklehasher.Proof[iotago.Identifier], stateDiff *model.SlotStateDiff, from peer.ID) {
 	w.workerPool.Submit(func() {
 		commitment, err := w.protocol.Commitments.Get(commitmentID)
 		if err != nil {
@@ -114,6 +129,12 @@ func (w *WarpSync) ProcessResponse(commitmentID iotago.CommitmentID, blockIDsByS
 			return
 		}
 
+		if w.protocol.PeerPenalties.IsIgnored(chain, from) {
+			w.LogTrace("ignoring response of penalized peer", "chain", chain.LogName(), "fromPeer", from)
+
+			return
+		}
+
 		targetEngine := commitment.TargetEngine()
 		if targetEngine == nil {
 			w.LogDebug("failed to get target engine for response", "commitment", commitment.LogName())
@@ -141,6 +162,8 @@ func (w *WarpSync) ProcessResponse(commitmentID iotago.CommitmentID, blockIDsByS
 			if !iotago.VerifyProof(proof, acceptedBlocks.Root(), commitment.RootsID()) {
 				w.LogError("failed to verify blocks proof", "commitment", commitment.LogName(), "blockIDs", blockIDsBySlotCommitment, "proof", proof, "fromPeer", from)
 
+				w.protocol.PeerPenalties.RecordFailedVerification(chain, from, "blocks proof")
+
 				return blocksToWarpSync
 			}
 
@@ -152,9 +175,22 @@ func (w *WarpSync) ProcessResponse(commitmentID iotago.CommitmentID, blockIDsByS
 			if !iotago.VerifyProof(mutationProof, acceptedTransactionIDs.Root(), commitment.RootsID()) {
 				w.LogError("failed to verify mutations proof", "commitment", commitment.LogName(), "transactionIDs", transactionIDs, "proof", mutationProof, "fromPeer", from)
 
+				w.protocol.PeerPenalties.RecordFailedVerification(chain, from, "mutations proof")
+
 				return blocksToWarpSync
 			}
 
+			// the state diff is optional and allows to apply the accepted transactions without re-executing them
+			if stateDiff != nil {
+				if err := stateDiff.Verify(commitment.Commitment, transactionIDs); err != nil {
+					w.LogError("failed to verify state diff", "commitment", commitment.LogName(), "fromPeer", from, "err", err)
+
+					w.protocol.PeerPenalties.RecordFailedVerification(chain, from, "state diff")
+
+					return blocksToWarpSync
+				}
+			}
+
 			w.ticker.StopTicker(commitmentID)
 
 			targetEngine.Workers.WaitChildren()
@@ -165,6 +201,14 @@ func (w *WarpSync) ProcessResponse(commitmentID iotago.CommitmentID, blockIDsByS
 				return blocksToWarpSync
 			}
 
+			if stateDiff != nil {
+				targetEngine.Ledger.SetWarpSyncStateDiff(commitmentID.Slot(), stateDiff, transactionIDs, func(err error) {
+					w.LogError("state diff does not match the ledger changes", "commitment", commitment.LogName(), "fromPeer", from, "err", err)
+
+					w.protocol.PeerPenalties.RecordFailedVerification(chain, from, "state diff")
+				})
+			}
+
 			// Once all blocks are booked we
 			//   1. Mark all transactions as accepted
 			//   2. Mark all blocks as accepted
@@ -288,7 +332,7 @@ func (w *WarpSync) ProcessResponse(commitmentID iotago.CommitmentID, blockIDsByS
 }
 
 // ProcessRequest processes the given warp sync request.
-func (w *WarpSync) ProcessRequest(commitmentID iotago.CommitmentID, from peer.ID) {
+func (w *WarpSync) ProcessRequest(commitmentID iotago.CommitmentID, includeStateDiff bool, from peer.ID) {
 	loggedWorkerPoolTask(w.workerPool, func() (err error) {
 		commitmentAPI, err := w.protocol.Commitments.API(commitmentID)
 		if err != nil {
@@ -300,7 +344,15 @@ func (w *WarpSync) ProcessRequest(commitmentID iotago.CommitmentID, from peer.ID
 			return ierrors.Wrap(err, "failed to get mutations")
 		}
 
-		w.protocol.Network.SendWarpSyncResponse(commitmentID, blocks, blocksProof, transactionIDs, transactionIDsProof, from)
+		var stateDiff *model.SlotStateDiff
+		if includeStateDiff {
+			// the state diff is optional, so we still respond if it is not available (e.g. because it was pruned)
+			if stateDiff, err = commitmentAPI.StateDiff(); err != nil {
+				w.LogDebug("failed to get state diff", "commitmentID", commitmentID, "err", err)
+			}
+		}
+
+		w.protocol.Network.SendWarpSyncResponse(commitmentID, blocks, blocksProof, transactionIDs, transactionIDsProof, stateDiff, from)
 
 		return nil
 	}, w, "commitmentID", commitmentID, "fromPeer", from)
@@ -0,0 +1,25 @@
+package reattacher
+
+import (
+	"github.com/iotaledger/hive.go/runtime/event"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// Events contains the events of the Reattacher.
+type Events struct {
+	// TransactionReattached is triggered when a watched transaction was issued in a new block.
+	TransactionReattached *event.Event2[iotago.TransactionID, iotago.BlockID]
+
+	// TransactionFinalized is triggered when a watched transaction reached its final status and is no longer watched.
+	TransactionFinalized *event.Event1[*TransactionResult]
+
+	event.Group[Events, *Events]
+}
+
+// NewEvents contains the constructor of the Events object (it is generated by a generic factory).
+var NewEvents = event.CreateGroupConstructor(func() (newEvents *Events) {
+	return &Events{
+		TransactionReattached: event.New2[iotago.TransactionID, iotago.BlockID](),
+		TransactionFinalized:  event.New1[*TransactionResult](),
+	}
+})
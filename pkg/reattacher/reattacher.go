@@ -0,0 +1,244 @@
+package reattacher
+
+import (
+	"context"
+	"time"
+
+	"github.com/iotaledger/hive.go/ds/shrinkingmap"
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/mempool"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// TransactionStatus is the final status of a watched transaction.
+type TransactionStatus uint8
+
+const (
+	// TransactionStatusCommitted is the status of transactions that were committed.
+	TransactionStatusCommitted TransactionStatus = iota
+	// TransactionStatusRejected is the status of transactions that are invalid or lost a conflict.
+	TransactionStatusRejected
+	// TransactionStatusOrphaned is the status of transactions whose attachments were orphaned before they could be
+	// reattached or that are no longer known to the MemPool.
+	TransactionStatusOrphaned
+	// TransactionStatusAbandoned is the status of transactions that were not included after the retry budget was used up.
+	TransactionStatusAbandoned
+)
+
+// String returns a human-readable representation of the TransactionStatus.
+func (t TransactionStatus) String() string {
+	switch t {
+	case TransactionStatusCommitted:
+		return "committed"
+	case TransactionStatusRejected:
+		return "rejected"
+	case TransactionStatusOrphaned:
+		return "orphaned"
+	case TransactionStatusAbandoned:
+		return "abandoned"
+	default:
+		return "unknown"
+	}
+}
+
+// TransactionResult contains the final status of a watched transaction.
+type TransactionResult struct {
+	// TransactionID is the ID of the transaction.
+	TransactionID iotago.TransactionID
+	// Status is the final status of the transaction.
+	Status TransactionStatus
+	// Reattachments is the number of times the transaction was reattached.
+	Reattachments int
+}
+
+// MemPool is the part of the MemPool that is used by the Reattacher.
+type MemPool interface {
+	TransactionMetadata(id iotago.TransactionID) (transaction mempool.TransactionMetadata, exists bool)
+
+	ReattachTransaction(id iotago.TransactionID, issueFunc func(signedTransaction mempool.SignedTransaction) (iotago.BlockID, error)) (signedTransactionMetadata mempool.SignedTransactionMetadata, err error)
+}
+
+// Reattacher watches the transactions that were submitted through the node and issues them in new blocks if their
+// attachments were not included in time, until they reach a final status or the retry budget is used up.
+type Reattacher struct {
+	// Events contains the events of the Reattacher.
+	Events *Events
+
+	// memPool returns the MemPool of the main engine.
+	memPool func() MemPool
+
+	// issueBlock issues a new block that contains the given signed transaction.
+	issueBlock func(ctx context.Context, signedTransaction mempool.SignedTransaction) (iotago.BlockID, error)
+
+	// watchedTransactions contains the transactions that did not reach a final status yet.
+	watchedTransactions *shrinkingmap.ShrinkingMap[iotago.TransactionID, *watchedTransaction]
+
+	// optsMaxReattachments is the maximum number of times a transaction is reattached.
+	optsMaxReattachments int
+
+	// optsReattachmentDelay is the time after the last issuance of a transaction after which it is reattached if none of
+	// its attachments was included.
+	optsReattachmentDelay time.Duration
+
+	// optsCheckInterval is the interval in which the watched transactions are checked.
+	optsCheckInterval time.Duration
+}
+
+// watchedTransaction contains the reattachment state of a watched transaction.
+type watchedTransaction struct {
+	// reattachments is the number of times the transaction was reattached.
+	reattachments int
+
+	// lastIssued is the time when the transaction was issued the last time.
+	lastIssued time.Time
+}
+
+// New creates a new Reattacher.
+func New(memPool func() MemPool, issueBlock func(ctx context.Context, signedTransaction mempool.SignedTransaction) (iotago.BlockID, error), opts ...options.Option[Reattacher]) *Reattacher {
+	return options.Apply(&Reattacher{
+		Events:                NewEvents(),
+		memPool:               memPool,
+		issueBlock:            issueBlock,
+		watchedTransactions:   shrinkingmap.New[iotago.TransactionID, *watchedTransaction](),
+		optsMaxReattachments:  3,
+		optsReattachmentDelay: 30 * time.Second,
+		optsCheckInterval:     5 * time.Second,
+	}, opts)
+}
+
+// Watch starts watching the transaction with the given ID. Transactions that are already watched are ignored.
+func (r *Reattacher) Watch(transactionID iotago.TransactionID) {
+	r.watchedTransactions.GetOrCreate(transactionID, func() *watchedTransaction {
+		return &watchedTransaction{lastIssued: time.Now()}
+	})
+}
+
+// WatchedTransactionsCount returns the number of transactions that did not reach a final status yet.
+func (r *Reattacher) WatchedTransactionsCount() int {
+	return r.watchedTransactions.Size()
+}
+
+// Run checks the watched transactions in the configured interval until the given context is done.
+func (r *Reattacher) Run(ctx context.Context, errorHandler func(error)) {
+	ticker := time.NewTicker(r.optsCheckInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.CheckTransactions(ctx, errorHandler)
+		}
+	}
+}
+
+// CheckTransactions finalizes the watched transactions that reached a final status and reattaches the ones whose
+// attachments were not included within the reattachment delay.
+func (r *Reattacher) CheckTransactions(ctx context.Context, errorHandler func(error)) {
+	memPool := r.memPool()
+
+	// the transactions are collected first, as the issued blocks are watched while the transactions are checked.
+	for _, transactionID := range r.watchedTransactions.Keys() {
+		if ctx.Err() != nil {
+			return
+		}
+
+		if transaction, exists := r.watchedTransactions.Get(transactionID); exists {
+			if err := r.checkTransaction(ctx, memPool, transactionID, transaction); err != nil {
+				errorHandler(err)
+			}
+		}
+	}
+}
+
+// checkTransaction checks the mempool flags of the given transaction and reattaches or finalizes it if necessary.
+func (r *Reattacher) checkTransaction(ctx context.Context, memPool MemPool, transactionID iotago.TransactionID, transaction *watchedTransaction) error {
+	metadata, exists := memPool.TransactionMetadata(transactionID)
+	if !exists {
+		// the transaction might not have been booked yet, so it is only dropped once the reattachment delay passed.
+		if time.Since(transaction.lastIssued) >= r.optsReattachmentDelay {
+			r.finalize(transactionID, transaction, TransactionStatusOrphaned)
+		}
+
+		return nil
+	}
+
+	switch {
+	case lo.Return2(metadata.CommittedSlot()):
+		r.finalize(transactionID, transaction, TransactionStatusCommitted)
+	case metadata.IsInvalid() || metadata.IsRejected():
+		r.finalize(transactionID, transaction, TransactionStatusRejected)
+	case lo.Return2(metadata.OrphanedSlot()):
+		r.finalize(transactionID, transaction, TransactionStatusOrphaned)
+	case metadata.EarliestIncludedAttachment().Slot() != 0, time.Since(transaction.lastIssued) < r.optsReattachmentDelay:
+		// the transaction is included or its latest attachment might still be included.
+	case transaction.reattachments >= r.optsMaxReattachments:
+		r.finalize(transactionID, transaction, TransactionStatusAbandoned)
+	default:
+		return r.reattach(ctx, memPool, transactionID, transaction)
+	}
+
+	return nil
+}
+
+// reattach issues the given transaction in a new block.
+func (r *Reattacher) reattach(ctx context.Context, memPool MemPool, transactionID iotago.TransactionID, transaction *watchedTransaction) error {
+	// failed issuances count towards the retry budget, so that transactions that can not be issued are not retried forever.
+	transaction.reattachments++
+	transaction.lastIssued = time.Now()
+
+	var blockID iotago.BlockID
+	if _, err := memPool.ReattachTransaction(transactionID, func(signedTransaction mempool.SignedTransaction) (iotago.BlockID, error) {
+		var err error
+		blockID, err = r.issueBlock(ctx, signedTransaction)
+
+		return blockID, err
+	}); err != nil {
+		// transactions that can no longer be reattached are finalized by the next check based on their flags.
+		if ierrors.Is(err, mempool.ErrTransactionNotReattachable) {
+			return nil
+		}
+
+		return ierrors.Wrapf(err, "failed to reattach transaction %s", transactionID)
+	}
+
+	r.Events.TransactionReattached.Trigger(transactionID, blockID)
+
+	return nil
+}
+
+// finalize stops watching the given transaction and reports its final status.
+func (r *Reattacher) finalize(transactionID iotago.TransactionID, transaction *watchedTransaction, status TransactionStatus) {
+	if r.watchedTransactions.Delete(transactionID) {
+		r.Events.TransactionFinalized.Trigger(&TransactionResult{
+			TransactionID: transactionID,
+			Status:        status,
+			Reattachments: transaction.reattachments,
+		})
+	}
+}
+
+// WithMaxReattachments sets the maximum number of times a transaction is reattached.
+func WithMaxReattachments(maxReattachments int) options.Option[Reattacher] {
+	return func(r *Reattacher) {
+		r.optsMaxReattachments = maxReattachments
+	}
+}
+
+// WithReattachmentDelay sets the time after the last issuance of a transaction after which it is reattached if none of
+// its attachments was included.
+func WithReattachmentDelay(reattachmentDelay time.Duration) options.Option[Reattacher] {
+	return func(r *Reattacher) {
+		r.optsReattachmentDelay = reattachmentDelay
+	}
+}
+
+// WithCheckInterval sets the interval in which the watched transactions are checked.
+func WithCheckInterval(checkInterval time.Duration) options.Option[Reattacher] {
+	return func(r *Reattacher) {
+		r.optsCheckInterval = checkInterval
+	}
+}
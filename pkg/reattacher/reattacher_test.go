@@ -0,0 +1,113 @@
+package reattacher
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/iotaledger/hive.go/runtime/workerpool"
+	"github.com/iotaledger/iota-core/pkg/core/account"
+	"github.com/iotaledger/iota-core/pkg/core/promise"
+	"github.com/iotaledger/iota-core/pkg/core/vote"
+	ledgertests "github.com/iotaledger/iota-core/pkg/protocol/engine/ledger/tests"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/mempool"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/mempool/spenddag/spenddagv1"
+	mempooltests "github.com/iotaledger/iota-core/pkg/protocol/engine/mempool/tests"
+	mempoolv1 "github.com/iotaledger/iota-core/pkg/protocol/engine/mempool/v1"
+	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/tpkg"
+)
+
+func newTestFramework(t *testing.T) *mempooltests.TestFramework {
+	workers := workerpool.NewGroup(t.Name())
+
+	ledgerState := ledgertests.New(ledgertests.NewMockedState(iotago.EmptyTransactionID, 0))
+	spendDAG := spenddagv1.New[iotago.TransactionID, mempool.StateID, vote.MockedRank](account.NewAccounts().SelectCommittee().SeatCount)
+
+	mutationsFunc := func(iotago.SlotIndex) (kvstore.KVStore, error) {
+		return mapdb.NewMapDB(), nil
+	}
+
+	return mempooltests.NewTestFramework(t, mempoolv1.New[vote.MockedRank](new(mempooltests.VM), func(reference mempool.StateReference) *promise.Promise[mempool.State] {
+		return ledgerState.ResolveOutputState(reference)
+	}, mutationsFunc, workers, spendDAG, iotago.SingleVersionProvider(tpkg.ZeroCostTestAPI), func(error) {}), spendDAG, ledgerState, workers)
+}
+
+func TestReattacher(t *testing.T) {
+	tf := newTestFramework(t)
+	defer tf.Cleanup()
+
+	issuedBlocks := make(map[iotago.SignedTransactionID][]iotago.BlockID)
+	reattacher := New(func() MemPool { return tf.Instance }, func(_ context.Context, signedTransaction mempool.SignedTransaction) (iotago.BlockID, error) {
+		signedTransactionID, err := signedTransaction.ID()
+		require.NoError(t, err)
+
+		blockID := iotago.BlockIDRepresentingData(2, signedTransactionID[:])
+		issuedBlocks[signedTransactionID] = append(issuedBlocks[signedTransactionID], blockID)
+
+		return blockID, nil
+	}, WithMaxReattachments(1), WithReattachmentDelay(0))
+
+	results := make(map[iotago.TransactionID]*TransactionResult)
+	reattacher.Events.TransactionFinalized.Hook(func(result *TransactionResult) {
+		results[result.TransactionID] = result
+	})
+
+	reattachedTransactions := make(map[iotago.TransactionID]iotago.BlockID)
+	reattacher.Events.TransactionReattached.Hook(func(transactionID iotago.TransactionID, blockID iotago.BlockID) {
+		reattachedTransactions[transactionID] = blockID
+	})
+
+	checkTransactions := func() {
+		reattacher.CheckTransactions(context.Background(), func(err error) {
+			require.NoError(t, err)
+		})
+	}
+
+	tf.CreateSignedTransaction("tx1", []string{"genesis"}, 1)
+	tf.CreateSignedTransaction("tx2", []string{"tx1:0"}, 1)
+	tf.CreateSignedTransaction("tx3", []string{"genesis"}, 1, true)
+
+	require.NoError(t, tf.AttachTransaction("tx1-signed", "tx1", "block1", 1))
+	require.NoError(t, tf.AttachTransaction("tx2-signed", "tx2", "block2", 1))
+	require.NoError(t, tf.AttachTransaction("tx3-signed", "tx3", "block3", 1))
+	tf.RequireBooked("tx1", "tx2")
+	tf.RequireInvalid("tx3")
+
+	unknownTransactionID := iotago.TransactionIDRepresentingData(0, []byte("unknown"))
+	for _, transactionID := range []iotago.TransactionID{tf.TransactionID("tx1"), tf.TransactionID("tx2"), tf.TransactionID("tx3"), unknownTransactionID} {
+		reattacher.Watch(transactionID)
+	}
+
+	// tx1 is included and committed, so it is never reattached.
+	require.True(t, tf.MarkAttachmentIncluded("block1"))
+	tf.SpendDAG.SetAccepted(tf.TransactionID("tx1"))
+	tf.CommitSlot(1)
+
+	checkTransactions()
+
+	require.Equal(t, map[iotago.TransactionID]*TransactionResult{
+		tf.TransactionID("tx1"): {TransactionID: tf.TransactionID("tx1"), Status: TransactionStatusCommitted},
+		tf.TransactionID("tx3"): {TransactionID: tf.TransactionID("tx3"), Status: TransactionStatusRejected},
+		unknownTransactionID:    {TransactionID: unknownTransactionID, Status: TransactionStatusOrphaned},
+	}, results)
+
+	// tx2 is not included, so it is reattached in a new block.
+	require.Len(t, reattachedTransactions, 1)
+	require.Equal(t, issuedBlocks[tf.SignedTransactionID("tx2-signed")], []iotago.BlockID{reattachedTransactions[tf.TransactionID("tx2")]})
+
+	tx2Metadata, exists := tf.TransactionMetadata("tx2")
+	require.True(t, exists)
+	require.ElementsMatch(t, []iotago.BlockID{tf.BlockID("block2"), reattachedTransactions[tf.TransactionID("tx2")]}, tx2Metadata.ValidAttachments())
+	require.Equal(t, 1, reattacher.WatchedTransactionsCount())
+
+	// the retry budget of tx2 is used up after the first reattachment was not included either.
+	checkTransactions()
+
+	require.Len(t, issuedBlocks[tf.SignedTransactionID("tx2-signed")], 1)
+	require.Equal(t, &TransactionResult{TransactionID: tf.TransactionID("tx2"), Status: TransactionStatusAbandoned, Reattachments: 1}, results[tf.TransactionID("tx2")])
+	require.Equal(t, 0, reattacher.WatchedTransactionsCount())
+}
@@ -0,0 +1,93 @@
+package recorder
+
+import (
+	"bufio"
+	"os"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/runtime/syncutils"
+	"github.com/iotaledger/hive.go/serializer/v2"
+	"github.com/iotaledger/hive.go/serializer/v2/stream"
+	"github.com/iotaledger/iota-core/pkg/model"
+)
+
+// Recorder serializes incoming blocks together with the time they were received to a file, so that they can be fed
+// into a fresh engine by the Replayer to deterministically reproduce the behavior of a node.
+type Recorder struct {
+	// file is the file the recording is written to.
+	file *os.File
+
+	// writer buffers the writes to the file.
+	writer *bufio.Writer
+
+	// recordedBlocks is the number of blocks that were recorded so far.
+	recordedBlocks int
+
+	mutex syncutils.Mutex
+}
+
+// NewRecorder creates a new Recorder that writes to the given file (an existing file is overwritten).
+func NewRecorder(filePath string) (*Recorder, error) {
+	file, err := os.Create(filePath)
+	if err != nil {
+		return nil, ierrors.Wrapf(err, "failed to create recording file %s", filePath)
+	}
+
+	return &Recorder{
+		file:   file,
+		writer: bufio.NewWriter(file),
+	}, nil
+}
+
+// Record appends the given block, the peer it was received from and the time it was received to the recording.
+func (r *Recorder) Record(block *model.Block, source peer.ID, receivedTime time.Time) error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if err := stream.Write(r.writer, receivedTime.UnixNano()); err != nil {
+		return ierrors.Wrapf(err, "failed to write received time of block %s", block.ID())
+	}
+
+	if err := stream.WriteBytesWithSize(r.writer, []byte(source), serializer.SeriLengthPrefixTypeAsByte); err != nil {
+		return ierrors.Wrapf(err, "failed to write source of block %s", block.ID())
+	}
+
+	if err := stream.WriteBytesWithSize(r.writer, block.Data(), serializer.SeriLengthPrefixTypeAsUint32); err != nil {
+		return ierrors.Wrapf(err, "failed to write block %s", block.ID())
+	}
+
+	r.recordedBlocks++
+
+	return nil
+}
+
+// RecordedBlocks returns the number of blocks that were recorded so far.
+func (r *Recorder) RecordedBlocks() int {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return r.recordedBlocks
+}
+
+// Flush writes all buffered blocks to the file.
+func (r *Recorder) Flush() error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	return r.writer.Flush()
+}
+
+// Close flushes all buffered blocks and closes the file.
+func (r *Recorder) Close() error {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	if err := r.writer.Flush(); err != nil {
+		return ierrors.Wrap(err, "failed to flush recording")
+	}
+
+	return r.file.Close()
+}
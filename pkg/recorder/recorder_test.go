@@ -0,0 +1,96 @@
+package recorder_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/recorder"
+	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/tpkg"
+)
+
+func TestRecorder_Replay(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "recording.bin")
+	apiProvider := iotago.SingleVersionProvider(tpkg.ZeroCostTestAPI)
+
+	rec, err := recorder.NewRecorder(filePath)
+	require.NoError(t, err)
+
+	startTime := time.Now()
+	recordedBlocks := make([]*recorder.RecordedBlock, 0)
+	for i := 0; i < 3; i++ {
+		block, err := model.BlockFromBlock(tpkg.RandBasicBlockWithIssuerAndRMC(tpkg.ZeroCostTestAPI, tpkg.RandAccountID(), 0))
+		require.NoError(t, err)
+
+		recordedBlock := &recorder.RecordedBlock{
+			Block:        block,
+			Source:       peer.ID(tpkg.RandBytes(34)),
+			ReceivedTime: startTime.Add(time.Duration(i) * 100 * time.Millisecond),
+		}
+		require.NoError(t, rec.Record(recordedBlock.Block, recordedBlock.Source, recordedBlock.ReceivedTime))
+
+		recordedBlocks = append(recordedBlocks, recordedBlock)
+	}
+	require.Equal(t, 3, rec.RecordedBlocks())
+	require.NoError(t, rec.Close())
+
+	replay := func(opts ...options.Option[recorder.Replayer]) (replayedBlocks []*recorder.RecordedBlock, duration time.Duration) {
+		replayStart := time.Now()
+
+		count, err := recorder.NewReplayer(filePath, apiProvider, opts...).Replay(context.Background(), func(block *model.Block, source peer.ID) {
+			replayedBlocks = append(replayedBlocks, &recorder.RecordedBlock{Block: block, Source: source})
+		})
+		require.NoError(t, err)
+		require.Equal(t, len(recordedBlocks), count)
+
+		return replayedBlocks, time.Since(replayStart)
+	}
+
+	assertReplayedBlocks := func(replayedBlocks []*recorder.RecordedBlock) {
+		require.Len(t, replayedBlocks, len(recordedBlocks))
+		for i, recordedBlock := range recordedBlocks {
+			require.Equal(t, recordedBlock.Block.ID(), replayedBlocks[i].Block.ID())
+			require.Equal(t, recordedBlock.Block.Data(), replayedBlocks[i].Block.Data())
+			require.Equal(t, recordedBlock.Source, replayedBlocks[i].Source)
+		}
+	}
+
+	// replay at the recorded pace
+	replayedBlocks, duration := replay()
+	assertReplayedBlocks(replayedBlocks)
+	require.GreaterOrEqual(t, duration, 200*time.Millisecond)
+
+	// replay at max speed
+	replayedBlocks, duration = replay(recorder.WithMaxSpeed(true))
+	assertReplayedBlocks(replayedBlocks)
+	require.Less(t, duration, 200*time.Millisecond)
+}
+
+func TestReplayer_TruncatedRecording(t *testing.T) {
+	filePath := filepath.Join(t.TempDir(), "recording.bin")
+
+	rec, err := recorder.NewRecorder(filePath)
+	require.NoError(t, err)
+
+	block, err := model.BlockFromBlock(tpkg.RandBasicBlockWithIssuerAndRMC(tpkg.ZeroCostTestAPI, tpkg.RandAccountID(), 0))
+	require.NoError(t, err)
+	require.NoError(t, rec.Record(block, "self", time.Now()))
+	require.NoError(t, rec.Close())
+
+	fileInfo, err := os.Stat(filePath)
+	require.NoError(t, err)
+	require.NoError(t, os.Truncate(filePath, fileInfo.Size()-1))
+
+	_, err = recorder.NewReplayer(filePath, iotago.SingleVersionProvider(tpkg.ZeroCostTestAPI), recorder.WithMaxSpeed(true)).Replay(context.Background(), func(*model.Block, peer.ID) {
+		require.FailNow(t, "truncated block should not be replayed")
+	})
+	require.Error(t, err)
+}
@@ -0,0 +1,159 @@
+package recorder
+
+import (
+	"bufio"
+	"context"
+	"io"
+	"os"
+	"time"
+
+	"github.com/libp2p/go-libp2p/core/peer"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/hive.go/serializer/v2/stream"
+	"github.com/iotaledger/iota-core/pkg/model"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// RecordedBlock is a block of a recording together with the peer it was received from and the time it was received.
+type RecordedBlock struct {
+	Block        *model.Block
+	Source       peer.ID
+	ReceivedTime time.Time
+}
+
+// Replayer feeds the blocks of a recording into an engine, either at the pace they were originally received or at
+// max speed.
+type Replayer struct {
+	// filePath is the path of the recording.
+	filePath string
+
+	// apiProvider is used to deserialize the recorded blocks.
+	apiProvider iotago.APIProvider
+
+	// optsMaxSpeed defines whether the blocks are replayed as fast as possible instead of at the recorded pace.
+	optsMaxSpeed bool
+}
+
+// NewReplayer creates a new Replayer for the recording at the given path.
+func NewReplayer(filePath string, apiProvider iotago.APIProvider, opts ...options.Option[Replayer]) *Replayer {
+	return options.Apply(&Replayer{
+		filePath:    filePath,
+		apiProvider: apiProvider,
+	}, opts)
+}
+
+// Replay reads the recording and passes its blocks to the given callback in the order they were recorded. Unless
+// max speed is enabled, the time between two blocks matches the time between their original receipt.
+func (r *Replayer) Replay(ctx context.Context, processBlock func(block *model.Block, source peer.ID)) (replayedBlocks int, err error) {
+	file, err := os.Open(r.filePath)
+	if err != nil {
+		return 0, ierrors.Wrapf(err, "failed to open recording file %s", r.filePath)
+	}
+	defer file.Close()
+
+	reader := bufio.NewReader(file)
+
+	var firstReceivedTime, replayStartTime time.Time
+	for {
+		recordedBlock, readErr := r.readRecordedBlock(reader)
+		if readErr != nil {
+			if ierrors.Is(readErr, io.EOF) {
+				return replayedBlocks, nil
+			}
+
+			return replayedBlocks, ierrors.Wrapf(readErr, "failed to read block %d of recording", replayedBlocks)
+		}
+
+		if replayedBlocks == 0 {
+			firstReceivedTime = recordedBlock.ReceivedTime
+			replayStartTime = time.Now()
+		}
+
+		if !r.optsMaxSpeed {
+			if err := waitUntil(ctx, replayStartTime.Add(recordedBlock.ReceivedTime.Sub(firstReceivedTime))); err != nil {
+				return replayedBlocks, err
+			}
+		} else if ctx.Err() != nil {
+			return replayedBlocks, ctx.Err()
+		}
+
+		processBlock(recordedBlock.Block, recordedBlock.Source)
+		replayedBlocks++
+	}
+}
+
+// readRecordedBlock reads the next block of the recording. It returns io.EOF if the end of the recording was reached.
+func (r *Replayer) readRecordedBlock(reader io.Reader) (*RecordedBlock, error) {
+	receivedTime, err := stream.Read[int64](reader)
+	if err != nil {
+		// a clean EOF is only expected before the first field of an entry.
+		return nil, err
+	}
+
+	sourceSize, err := stream.Read[uint8](reader)
+	if err != nil {
+		return nil, ierrors.Wrap(unexpectedEOF(err), "failed to read source size")
+	}
+
+	sourceBytes := make([]byte, sourceSize)
+	if _, err = io.ReadFull(reader, sourceBytes); err != nil {
+		return nil, ierrors.Wrap(unexpectedEOF(err), "failed to read source")
+	}
+
+	blockSize, err := stream.Read[uint32](reader)
+	if err != nil {
+		return nil, ierrors.Wrap(unexpectedEOF(err), "failed to read block size")
+	}
+
+	blockBytes := make([]byte, blockSize)
+	if _, err = io.ReadFull(reader, blockBytes); err != nil {
+		return nil, ierrors.Wrap(unexpectedEOF(err), "failed to read block")
+	}
+
+	block, err := model.BlockFromBytes(blockBytes, r.apiProvider)
+	if err != nil {
+		return nil, ierrors.Wrap(err, "failed to deserialize block")
+	}
+
+	return &RecordedBlock{
+		Block:        block,
+		Source:       peer.ID(sourceBytes),
+		ReceivedTime: time.Unix(0, receivedTime),
+	}, nil
+}
+
+// unexpectedEOF converts an io.EOF in the middle of an entry into an io.ErrUnexpectedEOF (a truncated recording).
+func unexpectedEOF(err error) error {
+	if ierrors.Is(err, io.EOF) {
+		return io.ErrUnexpectedEOF
+	}
+
+	return err
+}
+
+// waitUntil blocks until the given time is reached or the context is canceled.
+func waitUntil(ctx context.Context, targetTime time.Time) error {
+	waitDuration := time.Until(targetTime)
+	if waitDuration <= 0 {
+		return ctx.Err()
+	}
+
+	timer := time.NewTimer(waitDuration)
+	defer timer.Stop()
+
+	select {
+	case <-timer.C:
+		return nil
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// WithMaxSpeed defines whether the blocks are replayed as fast as possible instead of at the recorded pace.
+func WithMaxSpeed(maxSpeed bool) options.Option[Replayer] {
+	return func(r *Replayer) {
+		r.optsMaxSpeed = maxSpeed
+	}
+}
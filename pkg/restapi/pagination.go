@@ -0,0 +1,147 @@
+package restapi
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/inx-app/pkg/httpserver"
+	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/storage"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+var (
+	// ErrPaginationTokenInvalidated is returned for pagination tokens whose commitment is no longer part of the state of
+	// the node (because it was pruned or rolled back), so that the following pages would be inconsistent.
+	ErrPaginationTokenInvalidated = ierrors.New("pagination token invalidated")
+)
+
+// PaginationToken is the continuation token of a paginated query that is bound to the commitment whose state was used
+// to create the first page.
+type PaginationToken struct {
+	// CommitmentID is the ID of the commitment that the pages are created for.
+	CommitmentID iotago.CommitmentID
+	// Index is the index of the first element of the next page.
+	Index uint32
+}
+
+// NewPaginationToken creates a new pagination token for the given commitment and index.
+func NewPaginationToken(commitmentID iotago.CommitmentID, index uint32) *PaginationToken {
+	return &PaginationToken{
+		CommitmentID: commitmentID,
+		Index:        index,
+	}
+}
+
+// ParsePaginationToken parses a pagination token from its string representation.
+func ParsePaginationToken(token string) (*PaginationToken, error) {
+	commitmentIDHex, indexString, found := strings.Cut(token, ",")
+	if !found {
+		return nil, ierrors.Errorf("invalid pagination token %s: missing index", token)
+	}
+
+	commitmentID, err := iotago.CommitmentIDFromHexString(commitmentIDHex)
+	if err != nil {
+		return nil, ierrors.Wrapf(err, "invalid pagination token %s: failed to parse commitment ID", token)
+	}
+
+	index, err := strconv.ParseUint(indexString, 10, 32)
+	if err != nil {
+		return nil, ierrors.Wrapf(err, "invalid pagination token %s: failed to parse index", token)
+	}
+
+	return NewPaginationToken(commitmentID, uint32(index)), nil
+}
+
+// ParsePaginationTokenQueryParam parses the pagination token of the given query parameter (nil if it is not set).
+func ParsePaginationTokenQueryParam(c echo.Context, paramName string) (*PaginationToken, error) {
+	tokenString := c.QueryParam(paramName)
+	if tokenString == "" {
+		//nolint:nilnil // no token means that the first page is requested
+		return nil, nil
+	}
+
+	token, err := ParsePaginationToken(tokenString)
+	if err != nil {
+		return nil, ierrors.Wrapf(httpserver.ErrInvalidParameter, "invalid query parameter %s: %s", paramName, err)
+	}
+
+	return token, nil
+}
+
+// Validate checks that the state the token was created at is still available, so that the next page is consistent
+// with the previous ones. It returns an error wrapping ErrPaginationTokenInvalidated otherwise.
+func (p *PaginationToken) Validate(state PaginationState) error {
+	if state.IsPruned(p.CommitmentID.Slot()) {
+		return ierrors.Wrapf(ErrPaginationTokenInvalidated, "state of commitment %s was pruned", p.CommitmentID)
+	}
+
+	commitment, err := state.Commitment(p.CommitmentID.Slot())
+	if err != nil {
+		return ierrors.Wrapf(ErrPaginationTokenInvalidated, "failed to load commitment of slot %d: %s", p.CommitmentID.Slot(), err)
+	}
+
+	if commitment.ID() != p.CommitmentID {
+		return ierrors.Wrapf(ErrPaginationTokenInvalidated, "commitment %s was rolled back (current commitment %s)", p.CommitmentID, commitment.ID())
+	}
+
+	return nil
+}
+
+// String returns the string representation of the token.
+func (p *PaginationToken) String() string {
+	return fmt.Sprintf("%s,%d", p.CommitmentID.ToHex(), p.Index)
+}
+
+// Paginate returns the page of the given elements that starts at the given index and the token of the next page (empty
+// if it is the last page).
+func Paginate[T any](elements []T, commitmentID iotago.CommitmentID, startIndex uint32, pageSize uint32) (page []T, nextToken string) {
+	if startIndex >= uint32(len(elements)) {
+		return make([]T, 0), ""
+	}
+
+	endIndex := lo.Min(startIndex+pageSize, uint32(len(elements)))
+	if endIndex < uint32(len(elements)) {
+		nextToken = NewPaginationToken(commitmentID, endIndex).String()
+	}
+
+	return elements[startIndex:endIndex], nextToken
+}
+
+// PaginationState is the state of the node that pagination tokens are validated against.
+type PaginationState interface {
+	// Commitment returns the commitment of the given slot.
+	Commitment(slot iotago.SlotIndex) (*model.Commitment, error)
+
+	// IsPruned returns whether the data of the given slot was pruned.
+	IsPruned(slot iotago.SlotIndex) bool
+}
+
+// NewStoragePaginationState creates a PaginationState that validates the tokens against the given storage.
+func NewStoragePaginationState(storage *storage.Storage) PaginationState {
+	return &storagePaginationState{storage: storage}
+}
+
+// storagePaginationState is a PaginationState that is backed by the storage of an engine.
+type storagePaginationState struct {
+	storage *storage.Storage
+}
+
+func (s *storagePaginationState) Commitment(slot iotago.SlotIndex) (*model.Commitment, error) {
+	if latestCommitment := s.storage.Settings().LatestCommitment(); slot > latestCommitment.Slot() {
+		return nil, ierrors.Errorf("slot %d is not committed (latest committed slot %d)", slot, latestCommitment.Slot())
+	}
+
+	return s.storage.Commitments().Load(slot)
+}
+
+func (s *storagePaginationState) IsPruned(slot iotago.SlotIndex) bool {
+	lastPrunedEpoch, hasPruned := s.storage.LastPrunedEpoch()
+
+	return hasPruned && s.storage.Settings().APIProvider().APIForSlot(slot).TimeProvider().EpochFromSlot(slot) <= lastPrunedEpoch
+}
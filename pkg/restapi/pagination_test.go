@@ -0,0 +1,83 @@
+package restapi
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/iota-core/pkg/model"
+	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/tpkg"
+)
+
+func TestPaginationToken_String(t *testing.T) {
+	token := NewPaginationToken(tpkg.RandCommitmentID(), 42)
+
+	parsedToken, err := ParsePaginationToken(token.String())
+	require.NoError(t, err)
+	require.Equal(t, token, parsedToken)
+
+	for _, invalidToken := range []string{"", "42", "0x1234,42", token.CommitmentID.ToHex() + ",-1"} {
+		_, err = ParsePaginationToken(invalidToken)
+		require.Error(t, err, invalidToken)
+	}
+}
+
+func TestPaginate(t *testing.T) {
+	commitmentID := tpkg.RandCommitmentID()
+	elements := []int{0, 1, 2, 3, 4}
+
+	page, nextToken := Paginate(elements, commitmentID, 0, 2)
+	require.Equal(t, []int{0, 1}, page)
+	require.Equal(t, NewPaginationToken(commitmentID, 2).String(), nextToken)
+
+	page, nextToken = Paginate(elements, commitmentID, 2, 3)
+	require.Equal(t, []int{2, 3, 4}, page)
+	require.Empty(t, nextToken)
+
+	page, nextToken = Paginate(elements, commitmentID, 5, 3)
+	require.Empty(t, page)
+	require.Empty(t, nextToken)
+}
+
+func TestPaginationToken_Validate(t *testing.T) {
+	testAPI := tpkg.ZeroCostTestAPI
+
+	commitment := lo.PanicOnErr(model.CommitmentFromCommitment(iotago.NewCommitment(testAPI.Version(), 10, tpkg.RandCommitmentID(), tpkg.Rand32ByteArray(), 0, 0), testAPI))
+	state := &mockPaginationState{
+		commitments: map[iotago.SlotIndex]*model.Commitment{10: commitment},
+	}
+
+	// the token is valid as long as its commitment is part of the state.
+	require.NoError(t, NewPaginationToken(commitment.ID(), 5).Validate(state))
+
+	// the commitment was rolled back.
+	require.ErrorIs(t, NewPaginationToken(iotago.NewCommitmentID(10, tpkg.Rand32ByteArray()), 5).Validate(state), ErrPaginationTokenInvalidated)
+
+	// the commitment is unknown.
+	require.ErrorIs(t, NewPaginationToken(iotago.NewCommitmentID(11, tpkg.Rand32ByteArray()), 5).Validate(state), ErrPaginationTokenInvalidated)
+
+	// the state of the commitment was pruned.
+	state.prunedSlot = 10
+	require.ErrorIs(t, NewPaginationToken(commitment.ID(), 5).Validate(state), ErrPaginationTokenInvalidated)
+}
+
+type mockPaginationState struct {
+	commitments map[iotago.SlotIndex]*model.Commitment
+	prunedSlot  iotago.SlotIndex
+}
+
+func (m *mockPaginationState) Commitment(slot iotago.SlotIndex) (*model.Commitment, error) {
+	commitment, exists := m.commitments[slot]
+	if !exists {
+		return nil, ierrors.Errorf("commitment of slot %d not found", slot)
+	}
+
+	return commitment, nil
+}
+
+func (m *mockPaginationState) IsPruned(slot iotago.SlotIndex) bool {
+	return slot <= m.prunedSlot
+}
@@ -13,6 +13,13 @@ type BlockMetadata struct {
 	TransactionID            iotago.TransactionID
 	TransactionState         api.TransactionState
 	TransactionFailureReason api.TransactionFailureReason
+
+	// AcceptedSlot is the slot of the accepted tangle time at which the block was accepted (0 if not accepted yet).
+	AcceptedSlot iotago.SlotIndex
+	// ConfirmedSlot is the slot of the accepted tangle time at which the block was confirmed (0 if not confirmed yet).
+	ConfirmedSlot iotago.SlotIndex
+	// FinalizedSlot is the slot of the accepted tangle time at which the block was finalized (0 if not finalized yet).
+	FinalizedSlot iotago.SlotIndex
 }
 
 func (m *BlockMetadata) BlockMetadataResponse() *api.BlockMetadataResponse {
@@ -0,0 +1,100 @@
+package retainer
+
+import (
+	"fmt"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// BlockStatus is the status of a retained block that blocks can be queried by.
+type BlockStatus byte
+
+const (
+	// BlockStatusPending is the status of blocks that were attached but are neither accepted nor orphaned yet.
+	BlockStatusPending BlockStatus = iota
+
+	// BlockStatusAccepted is the status of blocks that were accepted (including confirmed and finalized blocks).
+	BlockStatusAccepted
+
+	// BlockStatusOrphaned is the status of blocks that were attached but never accepted.
+	BlockStatusOrphaned
+
+	// BlockStatusFiltered is the status of blocks that were dropped by the filters.
+	BlockStatusFiltered
+)
+
+// BlockStatusFromString returns the BlockStatus with the given name.
+func BlockStatusFromString(name string) (BlockStatus, error) {
+	for _, status := range []BlockStatus{BlockStatusPending, BlockStatusAccepted, BlockStatusOrphaned, BlockStatusFiltered} {
+		if status.String() == name {
+			return status, nil
+		}
+	}
+
+	return 0, ierrors.Errorf("unknown block status: %s", name)
+}
+
+// String returns a human-readable representation of the BlockStatus.
+func (b BlockStatus) String() string {
+	switch b {
+	case BlockStatusPending:
+		return "pending"
+	case BlockStatusAccepted:
+		return "accepted"
+	case BlockStatusOrphaned:
+		return "orphaned"
+	case BlockStatusFiltered:
+		return "filtered"
+	default:
+		return fmt.Sprintf("unknown(%d)", byte(b))
+	}
+}
+
+// BlockQuery is a query over the retained blocks.
+type BlockQuery struct {
+	// IssuerID restricts the result to the blocks of the given issuer (nil to query the blocks of all issuers).
+	IssuerID *iotago.AccountID
+
+	// StartSlot is the first slot whose blocks are queried.
+	StartSlot iotago.SlotIndex
+
+	// EndSlot is the last slot whose blocks are queried.
+	EndSlot iotago.SlotIndex
+
+	// Statuses restricts the result to the blocks with one of the given statuses (empty to query all statuses).
+	Statuses []BlockStatus
+
+	// Cursor is the ID of the first block of the requested page (iotago.EmptyBlockID to request the first page).
+	Cursor iotago.BlockID
+
+	// PageSize is the maximum number of blocks that are returned.
+	PageSize int
+}
+
+// MatchesStatus returns true if the query includes blocks with the given status.
+func (b *BlockQuery) MatchesStatus(status BlockStatus) bool {
+	if len(b.Statuses) == 0 {
+		return true
+	}
+
+	for _, queriedStatus := range b.Statuses {
+		if queriedStatus == status {
+			return true
+		}
+	}
+
+	return false
+}
+
+// QueriedBlock is a block that matched a BlockQuery.
+type QueriedBlock struct {
+	// BlockID is the ID of the block.
+	BlockID iotago.BlockID
+
+	// IssuerID is the ID of the account that issued the block.
+	IssuerID iotago.AccountID
+
+	// Status is the status of the block.
+	Status BlockStatus
+}
@@ -2,6 +2,7 @@ package retainer
 
 import (
 	"github.com/iotaledger/hive.go/runtime/module"
+	"github.com/iotaledger/iota-core/pkg/model"
 	iotago "github.com/iotaledger/iota.go/v4"
 	"github.com/iotaledger/iota.go/v4/api"
 )
@@ -16,6 +17,13 @@ type Retainer interface {
 	RetainBlockFailure(iotago.BlockID, api.BlockFailureReason)
 	RetainTransactionFailure(iotago.BlockID, error)
 
+	// FilteredBlocks returns the blocks of the given slot that were dropped by the filters.
+	FilteredBlocks(slot iotago.SlotIndex) (map[iotago.BlockID]*model.FilteredBlock, error)
+
+	// QueryBlocks returns the blocks that match the given query (ordered by slot and block ID) and the cursor of the next
+	// page (iotago.EmptyBlockID if there are no more blocks).
+	QueryBlocks(query *BlockQuery) (blocks []*QueriedBlock, nextCursor iotago.BlockID, err error)
+
 	// Reset resets the component to a clean state as if it was created at the last commitment.
 	Reset()
 
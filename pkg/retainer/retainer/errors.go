@@ -2,6 +2,7 @@ package retainer
 
 import (
 	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/filter/presolidfilter"
 	iotago "github.com/iotaledger/iota.go/v4"
 	"github.com/iotaledger/iota.go/v4/api"
 )
@@ -15,6 +16,10 @@ var blocksErrorsFailureReasonMap = map[error]api.BlockFailureReason{
 	iotago.ErrNegativeBIC:               api.BlockFailureAccountInvalid,
 	iotago.ErrAccountExpired:            api.BlockFailureAccountInvalid,
 	iotago.ErrInvalidSignature:          api.BlockFailureSignatureInvalid,
+	iotago.ErrWeakParentsInvalid:        api.BlockFailureParentInvalid,
+
+	// pre-solid filter errors
+	presolidfilter.ErrInvalidBlockVersion: api.BlockFailureVersionInvalid,
 }
 
 func determineBlockFailureReason(err error) api.BlockFailureReason {
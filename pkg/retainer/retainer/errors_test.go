@@ -0,0 +1,18 @@
+package retainer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/filter/presolidfilter"
+	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/api"
+)
+
+func TestDetermineBlockFailureReason(t *testing.T) {
+	require.Equal(t, api.BlockFailureVersionInvalid, determineBlockFailureReason(ierrors.Wrap(presolidfilter.ErrInvalidBlockVersion, "invalid protocol version 2")))
+	require.Equal(t, api.BlockFailureParentInvalid, determineBlockFailureReason(ierrors.Wrap(iotago.ErrWeakParentsInvalid, "weak parent is also a strong parent")))
+	require.Equal(t, api.BlockFailureInvalid, determineBlockFailureReason(ierrors.Wrap(presolidfilter.ErrBlockTimeTooFarAheadInFuture, "issuing time ahead")))
+}
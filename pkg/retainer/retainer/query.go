@@ -0,0 +1,126 @@
+package retainer
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/retainer"
+	"github.com/iotaledger/iota-core/pkg/storage/database"
+	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/api"
+)
+
+// QueryBlocks returns the blocks that match the given query (ordered by slot and block ID) and the cursor of the next
+// page (iotago.EmptyBlockID if there are no more blocks). Slots that were already pruned are skipped.
+func (r *Retainer) QueryBlocks(query *retainer.BlockQuery) (blocks []*retainer.QueriedBlock, nextCursor iotago.BlockID, err error) {
+	startSlot := query.StartSlot
+	if query.Cursor != iotago.EmptyBlockID && query.Cursor.Slot() > startSlot {
+		startSlot = query.Cursor.Slot()
+	}
+
+	blocks = make([]*retainer.QueriedBlock, 0)
+	for slot := startSlot; slot <= query.EndSlot; slot++ {
+		slotBlocks, err := r.blocksOfSlot(slot, query.IssuerID)
+		if err != nil {
+			if ierrors.Is(err, database.ErrEpochPruned) {
+				continue
+			}
+
+			return nil, iotago.EmptyBlockID, ierrors.Wrapf(err, "failed to query blocks of slot %d", slot)
+		}
+
+		for _, block := range slotBlocks {
+			if query.Cursor != iotago.EmptyBlockID && slot == query.Cursor.Slot() && bytes.Compare(block.BlockID[:], query.Cursor[:]) < 0 {
+				continue
+			}
+
+			if !query.MatchesStatus(block.Status) {
+				continue
+			}
+
+			if len(blocks) == query.PageSize {
+				return blocks, block.BlockID, nil
+			}
+
+			blocks = append(blocks, block)
+		}
+
+		// prevent an overflow of the slot index if the end of the range is the maximum slot
+		if slot == query.EndSlot {
+			break
+		}
+	}
+
+	return blocks, iotago.EmptyBlockID, nil
+}
+
+// blocksOfSlot returns the retained blocks of the given slot (ordered by their ID), optionally restricted to the blocks
+// of the given issuer.
+func (r *Retainer) blocksOfSlot(slot iotago.SlotIndex, issuerID *iotago.AccountID) ([]*retainer.QueriedBlock, error) {
+	filteredBlocksStore, err := r.filteredBlocksStore(slot)
+	if err != nil {
+		return nil, ierrors.Wrapf(err, "could not get filtered blocks store for slot %d", slot)
+	}
+
+	filteredBlocks := make(map[iotago.BlockID]*retainer.QueriedBlock)
+	if err = filteredBlocksStore.Stream(func(blockID iotago.BlockID, filteredBlock *model.FilteredBlock) error {
+		if issuerID == nil || filteredBlock.IssuerID == *issuerID {
+			filteredBlocks[blockID] = &retainer.QueriedBlock{
+				BlockID:  blockID,
+				IssuerID: filteredBlock.IssuerID,
+				Status:   retainer.BlockStatusFiltered,
+			}
+		}
+
+		return nil
+	}); err != nil {
+		return nil, ierrors.Wrapf(err, "failed to stream filtered blocks of slot %d", slot)
+	}
+
+	store, err := r.store(slot)
+	if err != nil {
+		return nil, ierrors.Wrapf(err, "could not get retainer store for slot %d", slot)
+	}
+
+	blocks := make([]*retainer.QueriedBlock, 0, len(filteredBlocks))
+	if err = store.ForEachAttachedBlock(issuerID, func(blockID iotago.BlockID, blockIssuerID iotago.AccountID) bool {
+		// blocks that were dropped by the filters after they were attached are reported as filtered
+		if _, isFiltered := filteredBlocks[blockID]; !isFiltered {
+			blocks = append(blocks, &retainer.QueriedBlock{
+				BlockID:  blockID,
+				IssuerID: blockIssuerID,
+				Status:   r.queriedBlockStatus(blockID),
+			})
+		}
+
+		return true
+	}); err != nil {
+		return nil, ierrors.Wrapf(err, "failed to iterate attached blocks of slot %d", slot)
+	}
+
+	for _, filteredBlock := range filteredBlocks {
+		blocks = append(blocks, filteredBlock)
+	}
+
+	sort.Slice(blocks, func(i, j int) bool {
+		return bytes.Compare(blocks[i].BlockID[:], blocks[j].BlockID[:]) < 0
+	})
+
+	return blocks, nil
+}
+
+// queriedBlockStatus returns the status of the given attached block.
+func (r *Retainer) queriedBlockStatus(blockID iotago.BlockID) retainer.BlockStatus {
+	blockState, _ := r.blockStatus(blockID)
+
+	switch blockState {
+	case api.BlockStateAccepted, api.BlockStateConfirmed, api.BlockStateFinalized:
+		return retainer.BlockStatusAccepted
+	case api.BlockStateRejected, api.BlockStateFailed:
+		return retainer.BlockStatusOrphaned
+	default:
+		return retainer.BlockStatusPending
+	}
+}
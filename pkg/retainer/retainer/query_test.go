@@ -0,0 +1,127 @@
+package retainer
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/hive.go/runtime/workerpool"
+	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/retainer"
+	"github.com/iotaledger/iota-core/pkg/storage/database"
+	"github.com/iotaledger/iota-core/pkg/storage/prunable/slotstore"
+	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/tpkg"
+)
+
+func TestRetainer_QueryBlocks(t *testing.T) {
+	const prunedSlot = 1
+
+	stores := make(map[iotago.SlotIndex]kvstore.KVStore)
+	slotStore := func(slot iotago.SlotIndex, prefix byte) (kvstore.KVStore, error) {
+		if slot <= prunedSlot {
+			return nil, database.ErrEpochPruned
+		}
+
+		if _, exists := stores[slot]; !exists {
+			stores[slot] = mapdb.NewMapDB()
+		}
+
+		return lo.PanicOnErr(stores[slot].WithExtendedRealm(kvstore.Realm{prefix})), nil
+	}
+
+	r := New(workerpool.NewGroup(t.Name()), func(slot iotago.SlotIndex) (*slotstore.Retainer, error) {
+		store, err := slotStore(slot, 0)
+		if err != nil {
+			return nil, err
+		}
+
+		return slotstore.NewRetainer(slot, store), nil
+	}, func(slot iotago.SlotIndex) (*slotstore.Store[iotago.BlockID, *model.FilteredBlock], error) {
+		store, err := slotStore(slot, 1)
+		if err != nil {
+			return nil, err
+		}
+
+		return slotstore.NewStore(slot, store, iotago.BlockID.Bytes, iotago.BlockIDFromBytes, (*model.FilteredBlock).Bytes, model.FilteredBlockFromBytes), nil
+	}, func() iotago.SlotIndex {
+		return 3
+	}, func() iotago.SlotIndex {
+		return 0
+	}, func() iotago.SlotIndex {
+		return 4
+	}, func(err error) {
+		require.NoError(t, err)
+	})
+
+	issuer1, issuer2 := tpkg.RandAccountID(), tpkg.RandAccountID()
+
+	attachBlock := func(slot iotago.SlotIndex, issuerID iotago.AccountID) iotago.BlockID {
+		blockID := iotago.NewBlockID(slot, tpkg.Rand32ByteArray())
+		require.NoError(t, r.onBlockAttached(blockID, issuerID))
+
+		return blockID
+	}
+
+	filterBlock := func(slot iotago.SlotIndex, issuerID iotago.AccountID) iotago.BlockID {
+		blockID := iotago.NewBlockID(slot, tpkg.Rand32ByteArray())
+		r.retainFilteredBlock(blockID, issuerID, model.BlockFilterPreSolid, ierrors.New("filtered"), 10)
+
+		return blockID
+	}
+
+	acceptedBlock := attachBlock(2, issuer1)
+	require.NoError(t, r.onBlockAccepted(acceptedBlock))
+	orphanedBlock := attachBlock(2, issuer2)
+	filteredBlock := filterBlock(3, issuer1)
+
+	// blocks that are dropped by the post-solid filter were attached before.
+	postSolidFilteredBlock := attachBlock(3, issuer2)
+	r.retainFilteredBlock(postSolidFilteredBlock, issuer2, model.BlockFilterPostSolid, ierrors.New("filtered"), 10)
+	pendingBlock := attachBlock(5, issuer1)
+
+	expectedBlocks := map[iotago.BlockID]*retainer.QueriedBlock{
+		acceptedBlock:          {BlockID: acceptedBlock, IssuerID: issuer1, Status: retainer.BlockStatusAccepted},
+		orphanedBlock:          {BlockID: orphanedBlock, IssuerID: issuer2, Status: retainer.BlockStatusOrphaned},
+		filteredBlock:          {BlockID: filteredBlock, IssuerID: issuer1, Status: retainer.BlockStatusFiltered},
+		postSolidFilteredBlock: {BlockID: postSolidFilteredBlock, IssuerID: issuer2, Status: retainer.BlockStatusFiltered},
+		pendingBlock:           {BlockID: pendingBlock, IssuerID: issuer1, Status: retainer.BlockStatusPending},
+	}
+
+	assertQuery := func(query *retainer.BlockQuery, expectedCursor iotago.BlockID, expectedBlockIDs ...iotago.BlockID) {
+		blocks, cursor, err := r.QueryBlocks(query)
+		require.NoError(t, err)
+		require.Equal(t, expectedCursor, cursor)
+
+		require.Equal(t, lo.Map(expectedBlockIDs, func(blockID iotago.BlockID) *retainer.QueriedBlock {
+			return expectedBlocks[blockID]
+		}), blocks)
+	}
+
+	// blocks are ordered by their slot and pruned slots are skipped.
+	allBlocksOfSlot2 := sortedBlockIDs(acceptedBlock, orphanedBlock)
+	allBlocksOfSlot3 := sortedBlockIDs(filteredBlock, postSolidFilteredBlock)
+	assertQuery(&retainer.BlockQuery{StartSlot: 0, EndSlot: 6, PageSize: 10}, iotago.EmptyBlockID, append(append(allBlocksOfSlot2, allBlocksOfSlot3...), pendingBlock)...)
+
+	// the blocks can be filtered by issuer, slot range and status.
+	assertQuery(&retainer.BlockQuery{IssuerID: &issuer1, StartSlot: 0, EndSlot: 6, PageSize: 10}, iotago.EmptyBlockID, acceptedBlock, filteredBlock, pendingBlock)
+	assertQuery(&retainer.BlockQuery{StartSlot: 3, EndSlot: 4, PageSize: 10}, iotago.EmptyBlockID, allBlocksOfSlot3...)
+	assertQuery(&retainer.BlockQuery{StartSlot: 0, EndSlot: 6, Statuses: []retainer.BlockStatus{retainer.BlockStatusAccepted, retainer.BlockStatusOrphaned}, PageSize: 10}, iotago.EmptyBlockID, allBlocksOfSlot2...)
+
+	// the cursor points to the first block of the next page.
+	assertQuery(&retainer.BlockQuery{StartSlot: 0, EndSlot: 6, PageSize: 3}, allBlocksOfSlot3[1], append(allBlocksOfSlot2, allBlocksOfSlot3[0])...)
+	assertQuery(&retainer.BlockQuery{StartSlot: 0, EndSlot: 6, PageSize: 3, Cursor: allBlocksOfSlot3[1]}, iotago.EmptyBlockID, allBlocksOfSlot3[1], pendingBlock)
+}
+
+// sortedBlockIDs returns the given block IDs in the order in which they are returned by a query.
+func sortedBlockIDs(blockIDs ...iotago.BlockID) []iotago.BlockID {
+	if string(blockIDs[0][:]) > string(blockIDs[1][:]) {
+		return []iotago.BlockID{blockIDs[1], blockIDs[0]}
+	}
+
+	return blockIDs
+}
@@ -6,11 +6,15 @@ import (
 	"github.com/iotaledger/hive.go/runtime/event"
 	"github.com/iotaledger/hive.go/runtime/module"
 	"github.com/iotaledger/hive.go/runtime/workerpool"
+	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/profiling"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/filter/postsolidfilter"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/filter/presolidfilter"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/mempool"
 	"github.com/iotaledger/iota-core/pkg/retainer"
+	"github.com/iotaledger/iota-core/pkg/storage/database"
 	"github.com/iotaledger/iota-core/pkg/storage/prunable/slotstore"
 	iotago "github.com/iotaledger/iota.go/v4"
 	"github.com/iotaledger/iota.go/v4/api"
@@ -19,8 +23,10 @@ import (
 type (
 	//nolint:revive
 	RetainerFunc            func(iotago.SlotIndex) (*slotstore.Retainer, error)
+	FilteredBlocksFunc      func(iotago.SlotIndex) (*slotstore.Store[iotago.BlockID, *model.FilteredBlock], error)
 	LatestCommittedSlotFunc func() iotago.SlotIndex
 	FinalizedSlotFunc       func() iotago.SlotIndex
+	AcceptedSlotFunc        func() iotago.SlotIndex
 )
 
 const MaxStakersResponsesCacheNum = 10
@@ -28,10 +34,15 @@ const MaxStakersResponsesCacheNum = 10
 // Retainer keeps and resolves all the information needed in the API and INX.
 type Retainer struct {
 	store                   RetainerFunc
+	filteredBlocksStore     FilteredBlocksFunc
 	latestCommittedSlotFunc LatestCommittedSlotFunc
 	finalizedSlotFunc       FinalizedSlotFunc
+	acceptedSlotFunc        AcceptedSlotFunc
 	errorHandler            func(error)
 
+	// lastFinalizedSlot is the latest slot whose finalization was recorded.
+	lastFinalizedSlot iotago.SlotIndex
+
 	stakersResponses *shrinkingmap.ShrinkingMap[uint32, []*api.ValidatorResponse]
 
 	workerPool *workerpool.WorkerPool
@@ -39,13 +50,15 @@ type Retainer struct {
 	module.Module
 }
 
-func New(workersGroup *workerpool.Group, retainerFunc RetainerFunc, latestCommittedSlotFunc LatestCommittedSlotFunc, finalizedSlotFunc FinalizedSlotFunc, errorHandler func(error)) *Retainer {
+func New(workersGroup *workerpool.Group, retainerFunc RetainerFunc, filteredBlocksFunc FilteredBlocksFunc, latestCommittedSlotFunc LatestCommittedSlotFunc, finalizedSlotFunc FinalizedSlotFunc, acceptedSlotFunc AcceptedSlotFunc, errorHandler func(error)) *Retainer {
 	return &Retainer{
-		workerPool:              workersGroup.CreatePool("Retainer", workerpool.WithWorkerCount(1)),
+		workerPool:              profiling.CreatePool(workersGroup, "Retainer", workerpool.WithWorkerCount(1)),
 		store:                   retainerFunc,
+		filteredBlocksStore:     filteredBlocksFunc,
 		stakersResponses:        shrinkingmap.New[uint32, []*api.ValidatorResponse](),
 		latestCommittedSlotFunc: latestCommittedSlotFunc,
 		finalizedSlotFunc:       finalizedSlotFunc,
+		acceptedSlotFunc:        acceptedSlotFunc,
 		errorHandler:            errorHandler,
 	}
 }
@@ -53,22 +66,31 @@ func New(workersGroup *workerpool.Group, retainerFunc RetainerFunc, latestCommit
 // NewProvider creates a new Retainer provider.
 func NewProvider() module.Provider[*engine.Engine, retainer.Retainer] {
 	return module.Provide(func(e *engine.Engine) retainer.Retainer {
-		r := New(e.Workers.CreateGroup("Retainer"),
+		r := New(profiling.CreateGroup(e.Workers, "Retainer"),
 			e.Storage.Retainer,
+			e.Storage.FilteredBlocks,
 			e.Storage.Settings().LatestCommitment().Slot,
 			e.Storage.Settings().LatestFinalizedSlot,
+			func() iotago.SlotIndex {
+				return e.LatestAPI().TimeProvider().SlotFromTime(e.Clock.Accepted().Time())
+			},
 			e.ErrorHandler("retainer"))
 
 		asyncOpt := event.WithWorkerPool(r.workerPool)
 
 		e.Events.BlockDAG.BlockAttached.Hook(func(b *blocks.Block) {
-			if err := r.onBlockAttached(b.ID()); err != nil {
+			if err := r.onBlockAttached(b.ID(), b.ProtocolBlock().Header.IssuerID); err != nil {
 				r.errorHandler(ierrors.Wrap(err, "failed to store on BlockAttached in retainer"))
 			}
 		}, asyncOpt)
 
-		e.Events.PostSolidFilter.BlockFiltered.Hook(func(e *postsolidfilter.BlockFilteredEvent) {
-			r.RetainBlockFailure(e.Block.ID(), determineBlockFailureReason(e.Reason))
+		e.Events.PreSolidFilter.BlockPreFiltered.Hook(func(event *presolidfilter.BlockPreFilteredEvent) {
+			r.retainFilteredBlock(event.Block.ID(), event.Block.ProtocolBlock().Header.IssuerID, model.BlockFilterPreSolid, event.Reason, e.APIForSlot(event.Block.ID().Slot()).ProtocolParameters().MaxCommittableAge())
+		}, asyncOpt)
+
+		e.Events.PostSolidFilter.BlockFiltered.Hook(func(event *postsolidfilter.BlockFilteredEvent) {
+			r.RetainBlockFailure(event.Block.ID(), determineBlockFailureReason(event.Reason))
+			r.retainFilteredBlock(event.Block.ID(), event.Block.ProtocolBlock().Header.IssuerID, model.BlockFilterPostSolid, event.Reason, e.APIForSlot(event.Block.ID().Slot()).ProtocolParameters().MaxCommittableAge())
 		}, asyncOpt)
 
 		e.Events.BlockGadget.BlockAccepted.Hook(func(b *blocks.Block) {
@@ -83,11 +105,17 @@ func NewProvider() module.Provider[*engine.Engine, retainer.Retainer] {
 			}
 		}, asyncOpt)
 
+		e.Events.SlotGadget.SlotFinalized.Hook(func(slot iotago.SlotIndex) {
+			r.onSlotFinalized(slot)
+		}, asyncOpt)
+
 		e.Events.Scheduler.BlockDropped.Hook(func(b *blocks.Block, err error) {
 			r.RetainBlockFailure(b.ID(), api.BlockFailureDroppedDueToCongestion)
 		})
 
 		e.Initialized.OnTrigger(func() {
+			r.lastFinalizedSlot = e.Storage.Settings().LatestFinalizedSlot()
+
 			e.Ledger.MemPool().OnSignedTransactionAttached(func(signedTransactionMetadata mempool.SignedTransactionMetadata) {
 				attachment := signedTransactionMetadata.Attachments()[0]
 
@@ -163,6 +191,8 @@ func (r *Retainer) BlockMetadata(blockID iotago.BlockID) (*retainer.BlockMetadat
 
 	txID, txStatus, txFailureReason := r.transactionStatus(blockID)
 
+	acceptedSlot, confirmedSlot, finalizedSlot := r.confirmationPath(blockID, blockStatus)
+
 	return &retainer.BlockMetadata{
 		BlockID:                  blockID,
 		BlockState:               blockStatus,
@@ -170,6 +200,9 @@ func (r *Retainer) BlockMetadata(blockID iotago.BlockID) (*retainer.BlockMetadat
 		TransactionID:            txID,
 		TransactionState:         txStatus,
 		TransactionFailureReason: txFailureReason,
+		AcceptedSlot:             acceptedSlot,
+		ConfirmedSlot:            confirmedSlot,
+		FinalizedSlot:            finalizedSlot,
 	}, nil
 }
 
@@ -185,6 +218,25 @@ func (r *Retainer) RetainBlockFailure(blockID iotago.BlockID, failureCode api.Bl
 	}
 }
 
+// FilteredBlocks returns the blocks of the given slot that were dropped by the filters.
+func (r *Retainer) FilteredBlocks(slot iotago.SlotIndex) (map[iotago.BlockID]*model.FilteredBlock, error) {
+	store, err := r.filteredBlocksStore(slot)
+	if err != nil {
+		return nil, ierrors.Wrapf(err, "could not get filtered blocks store for slot %d", slot)
+	}
+
+	filteredBlocks := make(map[iotago.BlockID]*model.FilteredBlock)
+	if err := store.Stream(func(blockID iotago.BlockID, filteredBlock *model.FilteredBlock) error {
+		filteredBlocks[blockID] = filteredBlock
+
+		return nil
+	}); err != nil {
+		return nil, ierrors.Wrapf(err, "failed to stream filtered blocks of slot %d", slot)
+	}
+
+	return filteredBlocks, nil
+}
+
 func (r *Retainer) RetainTransactionFailure(blockID iotago.BlockID, err error) {
 	store, storeErr := r.store(blockID.Slot())
 	if storeErr != nil {
@@ -240,6 +292,27 @@ func (r *Retainer) blockStatus(blockID iotago.BlockID) (api.BlockState, api.Bloc
 	return blockData.State, blockData.FailureReason
 }
 
+// confirmationPath returns the slots of the accepted tangle time at which the block reached the different levels of
+// finality.
+func (r *Retainer) confirmationPath(blockID iotago.BlockID, blockState api.BlockState) (acceptedSlot iotago.SlotIndex, confirmedSlot iotago.SlotIndex, finalizedSlot iotago.SlotIndex) {
+	store, err := r.store(blockID.Slot())
+	if err != nil {
+		r.errorHandler(ierrors.Wrapf(err, "could not get retainer store for slot %d", blockID.Slot()))
+		return 0, 0, 0
+	}
+
+	blockData, exists := store.GetBlock(blockID)
+	if !exists {
+		return 0, 0, 0
+	}
+
+	if blockState == api.BlockStateFinalized {
+		finalizedSlot, _ = store.SlotFinalizedAt()
+	}
+
+	return blockData.AcceptedSlot, blockData.ConfirmedSlot, finalizedSlot
+}
+
 func (r *Retainer) transactionStatus(blockID iotago.BlockID) (iotago.TransactionID, api.TransactionState, api.TransactionFailureReason) {
 	store, err := r.store(blockID.Slot())
 	if err != nil {
@@ -267,13 +340,36 @@ func (r *Retainer) transactionStatus(blockID iotago.BlockID) (iotago.Transaction
 	return txData.TransactionID, txData.State, txData.FailureReason
 }
 
-func (r *Retainer) onBlockAttached(blockID iotago.BlockID) error {
+// retainFilteredBlock persists the decision of a filter to drop the given block.
+//
+// Blocks of slots that are already pruned or that lie further in the future than the max committable age are ignored,
+// so that invalid blocks can not be used to create storage buckets for arbitrary slots.
+func (r *Retainer) retainFilteredBlock(blockID iotago.BlockID, issuerID iotago.AccountID, filter model.BlockFilter, reason error, maxCommittableAge iotago.SlotIndex) {
+	if blockID.Slot() > r.latestCommittedSlotFunc()+maxCommittableAge {
+		return
+	}
+
+	store, err := r.filteredBlocksStore(blockID.Slot())
+	if err != nil {
+		if !ierrors.Is(err, database.ErrEpochPruned) {
+			r.errorHandler(ierrors.Wrapf(err, "could not get filtered blocks store for slot %d", blockID.Slot()))
+		}
+
+		return
+	}
+
+	if err := store.Store(blockID, model.NewFilteredBlock(issuerID, filter, determineBlockFailureReason(reason), reason)); err != nil {
+		r.errorHandler(ierrors.Wrap(err, "failed to store filtered block in retainer"))
+	}
+}
+
+func (r *Retainer) onBlockAttached(blockID iotago.BlockID, issuerID iotago.AccountID) error {
 	store, err := r.store(blockID.Slot())
 	if err != nil {
 		return ierrors.Wrapf(err, "could not get retainer store for slot %d", blockID.Slot())
 	}
 
-	return store.StoreBlockAttached(blockID)
+	return store.StoreBlockAttached(blockID, issuerID)
 }
 
 func (r *Retainer) onBlockAccepted(blockID iotago.BlockID) error {
@@ -282,7 +378,7 @@ func (r *Retainer) onBlockAccepted(blockID iotago.BlockID) error {
 		return ierrors.Wrapf(err, "could not get retainer store for slot %d", blockID.Slot())
 	}
 
-	return store.StoreBlockAccepted(blockID)
+	return store.StoreBlockAccepted(blockID, r.acceptedSlotFunc())
 }
 
 func (r *Retainer) onBlockConfirmed(blockID iotago.BlockID) error {
@@ -291,7 +387,26 @@ func (r *Retainer) onBlockConfirmed(blockID iotago.BlockID) error {
 		return ierrors.Wrapf(err, "could not get retainer store for slot %d", blockID.Slot())
 	}
 
-	return store.StoreBlockConfirmed(blockID)
+	return store.StoreBlockConfirmed(blockID, r.acceptedSlotFunc())
+}
+
+// onSlotFinalized records the slot of the accepted tangle time for all slots that got finalized since the last call.
+func (r *Retainer) onSlotFinalized(finalizedSlot iotago.SlotIndex) {
+	finalizedAtSlot := r.acceptedSlotFunc()
+
+	for slot := r.lastFinalizedSlot + 1; slot <= finalizedSlot; slot++ {
+		store, err := r.store(slot)
+		if err != nil {
+			r.errorHandler(ierrors.Wrapf(err, "could not get retainer store for slot %d", slot))
+			continue
+		}
+
+		if err := store.StoreSlotFinalized(finalizedAtSlot); err != nil {
+			r.errorHandler(ierrors.Wrapf(err, "failed to store finalization of slot %d in retainer", slot))
+		}
+	}
+
+	r.lastFinalizedSlot = max(r.lastFinalizedSlot, finalizedSlot)
 }
 
 func (r *Retainer) onTransactionAttached(blockID iotago.BlockID) error {
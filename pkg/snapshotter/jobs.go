@@ -0,0 +1,237 @@
+package snapshotter
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/log"
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/hive.go/runtime/syncutils"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+const snapshotJobFilePrefix = "snapshot_slot_"
+
+var (
+	// ErrJobRunning is returned if a snapshot job is started while another one is still running.
+	ErrJobRunning = ierrors.New("a snapshot job is already running")
+	// ErrJobNotFound is returned if a snapshot job is not known.
+	ErrJobNotFound = ierrors.New("snapshot job not found")
+	// ErrSlotNotFinalized is returned if a snapshot job is started for a slot that is not finalized yet.
+	ErrSlotNotFinalized = ierrors.New("slot is not finalized")
+)
+
+// JobStatus is the status of a snapshot job.
+type JobStatus string
+
+const (
+	// JobStatusRunning is the status of jobs that are still writing the snapshot.
+	JobStatusRunning JobStatus = "running"
+	// JobStatusSucceeded is the status of jobs whose snapshot was written completely.
+	JobStatusSucceeded JobStatus = "succeeded"
+	// JobStatusFailed is the status of jobs whose snapshot could not be written.
+	JobStatusFailed JobStatus = "failed"
+)
+
+// Job contains the progress of an on-demand snapshot export.
+type Job struct {
+	// ID is the identifier of the job.
+	ID uint64
+	// Slot is the finalized slot the snapshot is created for.
+	Slot iotago.SlotIndex
+	// FilePath is the path of the snapshot file.
+	FilePath string
+	// Status is the status of the job.
+	Status JobStatus
+	// Stage is the name of the latest stage that was exported.
+	Stage string
+	// CompletedStages is the number of stages that were exported.
+	CompletedStages int
+	// TotalStages is the number of stages of the snapshot (0 until the first stage was exported).
+	TotalStages int
+	// StartTime is the time when the job was started.
+	StartTime time.Time
+	// EndTime is the time when the job finished (zero while it is running).
+	EndTime time.Time
+	// Err is the error of failed jobs.
+	Err error
+}
+
+// JobManager creates snapshots of arbitrary finalized slots on demand, one at a time and in the background, and keeps
+// track of their progress.
+type JobManager struct {
+	// latestFinalizedSlot returns the slot of the latest finalized slot of the node.
+	latestFinalizedSlot func() iotago.SlotIndex
+
+	// writeSnapshot writes the snapshot of the given slot to the given file and reports the exported stages.
+	writeSnapshot func(filePath string, targetSlot iotago.SlotIndex, progress func(stage string, completedStages int, totalStages int)) error
+
+	// jobs contains the running and the latest finished jobs by their ID.
+	jobs map[uint64]*Job
+
+	// lastJobID is the ID of the latest started job.
+	lastJobID uint64
+
+	// runningJob is the job that is currently running (nil if none is running).
+	runningJob *Job
+
+	// optsDirectory is the directory the snapshots are written to.
+	optsDirectory string
+
+	// optsRetainedJobs is the number of finished jobs that are kept to be queried.
+	optsRetainedJobs int
+
+	mutex syncutils.RWMutex
+
+	log.Logger
+}
+
+// NewJobManager creates a new JobManager.
+func NewJobManager(latestFinalizedSlot func() iotago.SlotIndex, writeSnapshot func(filePath string, targetSlot iotago.SlotIndex, progress func(stage string, completedStages int, totalStages int)) error, logger log.Logger, opts ...options.Option[JobManager]) *JobManager {
+	return options.Apply(&JobManager{
+		latestFinalizedSlot: latestFinalizedSlot,
+		writeSnapshot:       writeSnapshot,
+		jobs:                make(map[uint64]*Job),
+		optsDirectory:       "snapshots",
+		optsRetainedJobs:    10,
+		Logger:              logger,
+	}, opts)
+}
+
+// Start starts a job that writes the snapshot of the given finalized slot in the background. The latest finalized slot
+// is used if the slot is 0.
+func (j *JobManager) Start(ctx context.Context, slot iotago.SlotIndex) (*Job, error) {
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	if j.runningJob != nil {
+		return nil, ierrors.Wrapf(ErrJobRunning, "job %d", j.runningJob.ID)
+	}
+
+	if latestFinalizedSlot := j.latestFinalizedSlot(); slot == 0 {
+		slot = latestFinalizedSlot
+	} else if slot > latestFinalizedSlot {
+		return nil, ierrors.Wrapf(ErrSlotNotFinalized, "slot %d (latest finalized slot %d)", slot, latestFinalizedSlot)
+	}
+
+	if err := os.MkdirAll(j.optsDirectory, 0o700); err != nil {
+		return nil, ierrors.Wrapf(err, "failed to create snapshot directory %s", j.optsDirectory)
+	}
+
+	j.lastJobID++
+	job := &Job{
+		ID:        j.lastJobID,
+		Slot:      slot,
+		FilePath:  filepath.Join(j.optsDirectory, fmt.Sprintf("%s%d%s", snapshotJobFilePrefix, slot, snapshotFileExtension)),
+		Status:    JobStatusRunning,
+		StartTime: time.Now(),
+	}
+
+	j.jobs[job.ID] = job
+	j.runningJob = job
+
+	go j.run(ctx, job)
+
+	return j.copyJob(job), nil
+}
+
+// Job returns a copy of the job with the given ID.
+func (j *JobManager) Job(id uint64) (*Job, error) {
+	j.mutex.RLock()
+	defer j.mutex.RUnlock()
+
+	job, exists := j.jobs[id]
+	if !exists {
+		return nil, ierrors.Wrapf(ErrJobNotFound, "job %d", id)
+	}
+
+	return j.copyJob(job), nil
+}
+
+// Jobs returns copies of the running and the latest finished jobs ordered by their ID.
+func (j *JobManager) Jobs() []*Job {
+	j.mutex.RLock()
+	defer j.mutex.RUnlock()
+
+	jobs := make([]*Job, 0, len(j.jobs))
+	for _, job := range j.jobs {
+		jobs = append(jobs, j.copyJob(job))
+	}
+
+	sort.Slice(jobs, func(a, b int) bool {
+		return jobs[a].ID < jobs[b].ID
+	})
+
+	return jobs
+}
+
+// run writes the snapshot of the given job to a temporary file and moves it into place once it is complete.
+func (j *JobManager) run(ctx context.Context, job *Job) {
+	tempFilePath := job.FilePath + tempFileExtension
+
+	err := j.writeSnapshot(tempFilePath, job.Slot, func(stage string, completedStages int, totalStages int) {
+		j.mutex.Lock()
+		defer j.mutex.Unlock()
+
+		job.Stage, job.CompletedStages, job.TotalStages = stage, completedStages, totalStages
+	})
+	if err == nil {
+		err = ctx.Err()
+	}
+
+	if err != nil {
+		_ = os.Remove(tempFilePath)
+	} else if renameErr := os.Rename(tempFilePath, job.FilePath); renameErr != nil {
+		err = ierrors.Wrap(renameErr, "failed to move snapshot into place")
+	}
+
+	j.mutex.Lock()
+	defer j.mutex.Unlock()
+
+	job.EndTime = time.Now()
+	job.Status = JobStatusSucceeded
+	if err != nil {
+		job.Status, job.Err = JobStatusFailed, err
+
+		j.LogError("failed to create snapshot", "job", job.ID, "slot", job.Slot, "err", err)
+	} else {
+		j.LogInfo("created snapshot", "job", job.ID, "slot", job.Slot, "path", job.FilePath)
+	}
+
+	j.runningJob = nil
+	j.evictFinishedJobs()
+}
+
+// evictFinishedJobs removes the oldest jobs that exceed the number of retained jobs (only called while no job is running).
+func (j *JobManager) evictFinishedJobs() {
+	// the IDs of the kept jobs are consecutive, as the oldest jobs are always removed first.
+	for oldestJobID := j.lastJobID - uint64(len(j.jobs)) + 1; len(j.jobs) > j.optsRetainedJobs; oldestJobID++ {
+		delete(j.jobs, oldestJobID)
+	}
+}
+
+// copyJob returns a copy of the given job that can be handed out without holding the mutex.
+func (j *JobManager) copyJob(job *Job) *Job {
+	jobCopy := *job
+
+	return &jobCopy
+}
+
+// WithJobsDirectory sets the directory the snapshots of the jobs are written to.
+func WithJobsDirectory(directory string) options.Option[JobManager] {
+	return func(j *JobManager) {
+		j.optsDirectory = directory
+	}
+}
+
+// WithRetainedJobs sets the number of finished jobs that are kept to be queried.
+func WithRetainedJobs(retainedJobs int) options.Option[JobManager] {
+	return func(j *JobManager) {
+		j.optsRetainedJobs = retainedJobs
+	}
+}
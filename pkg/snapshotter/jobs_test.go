@@ -0,0 +1,101 @@
+package snapshotter_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/log"
+	"github.com/iotaledger/iota-core/pkg/snapshotter"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func TestJobManager(t *testing.T) {
+	directory := t.TempDir()
+
+	stageExported := make(chan struct{})
+	continueExport := make(chan struct{})
+	writeErr := ierrors.New("export failed")
+
+	jobManager := snapshotter.NewJobManager(func() iotago.SlotIndex {
+		return 10
+	}, func(filePath string, targetSlot iotago.SlotIndex, progress func(stage string, completedStages int, totalStages int)) error {
+		if targetSlot == 5 {
+			return writeErr
+		}
+
+		progress("settings", 1, 2)
+		stageExported <- struct{}{}
+		<-continueExport
+		progress("ledger", 2, 2)
+
+		return os.WriteFile(filePath, []byte{}, 0o600)
+	}, log.NewLogger(), snapshotter.WithJobsDirectory(directory), snapshotter.WithRetainedJobs(1))
+
+	awaitFinished := func(id uint64) *snapshotter.Job {
+		var job *snapshotter.Job
+		require.Eventually(t, func() bool {
+			var err error
+			job, err = jobManager.Job(id)
+			require.NoError(t, err)
+
+			return job.Status != snapshotter.JobStatusRunning
+		}, 5*time.Second, 10*time.Millisecond)
+
+		return job
+	}
+
+	// slots that are not finalized yet are rejected.
+	_, err := jobManager.Start(context.Background(), 11)
+	require.ErrorIs(t, err, snapshotter.ErrSlotNotFinalized)
+
+	// the latest finalized slot is used if no slot is given.
+	job, err := jobManager.Start(context.Background(), 0)
+	require.NoError(t, err)
+	require.Equal(t, iotago.SlotIndex(10), job.Slot)
+	require.Equal(t, filepath.Join(directory, "snapshot_slot_10.bin"), job.FilePath)
+
+	<-stageExported
+
+	runningJob, err := jobManager.Job(job.ID)
+	require.NoError(t, err)
+	require.Equal(t, snapshotter.JobStatusRunning, runningJob.Status)
+	require.Equal(t, "settings", runningJob.Stage)
+	require.Equal(t, 1, runningJob.CompletedStages)
+	require.Equal(t, 2, runningJob.TotalStages)
+	require.NoFileExists(t, job.FilePath)
+
+	// only a single job can run at a time.
+	_, err = jobManager.Start(context.Background(), 8)
+	require.ErrorIs(t, err, snapshotter.ErrJobRunning)
+
+	close(continueExport)
+
+	finishedJob := awaitFinished(job.ID)
+	require.Equal(t, snapshotter.JobStatusSucceeded, finishedJob.Status)
+	require.Equal(t, 2, finishedJob.CompletedStages)
+	require.False(t, finishedJob.EndTime.IsZero())
+	require.FileExists(t, job.FilePath)
+
+	// failed jobs report their error and do not leave a snapshot behind.
+	failedJob, err := jobManager.Start(context.Background(), 5)
+	require.NoError(t, err)
+
+	failedJob = awaitFinished(failedJob.ID)
+	require.Equal(t, snapshotter.JobStatusFailed, failedJob.Status)
+	require.ErrorIs(t, failedJob.Err, writeErr)
+	require.NoFileExists(t, failedJob.FilePath)
+
+	// only the latest finished jobs are retained.
+	_, err = jobManager.Job(job.ID)
+	require.ErrorIs(t, err, snapshotter.ErrJobNotFound)
+
+	jobs := jobManager.Jobs()
+	require.Len(t, jobs, 1)
+	require.Equal(t, failedJob.ID, jobs[0].ID)
+}
@@ -0,0 +1,268 @@
+package snapshotter
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync/atomic"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/log"
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/hive.go/runtime/syncutils"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+const (
+	snapshotFilePrefix    = "snapshot_epoch_"
+	snapshotFileExtension = ".bin"
+	tempFileExtension     = ".tmp"
+)
+
+// Snapshotter automatically exports a snapshot every N finalized epochs into a rotating directory.
+type Snapshotter struct {
+	// apiProvider is used to map the finalized slots to epochs.
+	apiProvider iotago.APIProvider
+
+	// latestCommittedSlot returns the slot of the latest commitment of the node.
+	latestCommittedSlot func() iotago.SlotIndex
+
+	// writeSnapshot writes the snapshot of the given slot to the given file.
+	writeSnapshot func(filePath string, targetSlot iotago.SlotIndex) error
+
+	// lastSnapshotEpoch is the epoch of the latest snapshot that was created.
+	lastSnapshotEpoch iotago.EpochIndex
+
+	// isSnapshotting is used to skip finalized slots while a snapshot is being created.
+	isSnapshotting atomic.Bool
+
+	// optsInterval is the number of finalized epochs between two snapshots.
+	optsInterval iotago.EpochIndex
+
+	// optsDirectory is the directory the snapshots are written to.
+	optsDirectory string
+
+	// optsRetainedSnapshots is the number of snapshots that are kept in the directory.
+	optsRetainedSnapshots int
+
+	// optsUploadCommand is the command that is executed with the path of every new snapshot as its last argument.
+	optsUploadCommand string
+
+	mutex syncutils.Mutex
+
+	log.Logger
+}
+
+// New creates a new Snapshotter.
+func New(apiProvider iotago.APIProvider, latestCommittedSlot func() iotago.SlotIndex, writeSnapshot func(filePath string, targetSlot iotago.SlotIndex) error, logger log.Logger, opts ...options.Option[Snapshotter]) *Snapshotter {
+	return options.Apply(&Snapshotter{
+		apiProvider:           apiProvider,
+		latestCommittedSlot:   latestCommittedSlot,
+		writeSnapshot:         writeSnapshot,
+		optsInterval:          10,
+		optsDirectory:         "snapshots",
+		optsRetainedSnapshots: 5,
+		Logger:                logger,
+	}, opts)
+}
+
+// Init creates the snapshot directory and continues the schedule of the snapshots that are already stored in it.
+func (s *Snapshotter) Init() error {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	if err := os.MkdirAll(s.optsDirectory, 0o700); err != nil {
+		return ierrors.Wrapf(err, "failed to create snapshot directory %s", s.optsDirectory)
+	}
+
+	epochs, err := s.snapshotEpochs()
+	if err != nil {
+		return err
+	}
+
+	if len(epochs) > 0 {
+		s.lastSnapshotEpoch = epochs[len(epochs)-1]
+	}
+
+	return nil
+}
+
+// OnSlotFinalized creates a snapshot at the end of the latest finalized epoch if it is due.
+func (s *Snapshotter) OnSlotFinalized(ctx context.Context, finalizedSlot iotago.SlotIndex) {
+	if !s.isSnapshotting.CompareAndSwap(false, true) {
+		return
+	}
+	defer s.isSnapshotting.Store(false)
+
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+
+	targetEpoch, due := s.dueEpoch(finalizedSlot)
+	if !due {
+		return
+	}
+
+	targetSlot := s.apiProvider.APIForEpoch(targetEpoch).TimeProvider().EpochEnd(targetEpoch)
+	if latestCommittedSlot := s.latestCommittedSlot(); targetSlot > latestCommittedSlot {
+		// the snapshot will be created with the next finalized slot once the end of the epoch is committed
+		return
+	}
+
+	filePath, err := s.createSnapshot(targetEpoch, targetSlot)
+	if err != nil {
+		s.LogError("failed to create snapshot", "epoch", targetEpoch, "slot", targetSlot, "err", err)
+
+		return
+	}
+
+	s.lastSnapshotEpoch = targetEpoch
+
+	s.LogInfo("created snapshot", "epoch", targetEpoch, "slot", targetSlot, "path", filePath)
+
+	if err := s.removeOutdatedSnapshots(); err != nil {
+		s.LogError("failed to remove outdated snapshots", "err", err)
+	}
+
+	if err := s.upload(ctx, filePath); err != nil {
+		s.LogError("failed to upload snapshot", "path", filePath, "err", err)
+	}
+}
+
+// dueEpoch returns the latest epoch that is fully finalized and whose snapshot is due.
+func (s *Snapshotter) dueEpoch(finalizedSlot iotago.SlotIndex) (targetEpoch iotago.EpochIndex, due bool) {
+	timeProvider := s.apiProvider.APIForSlot(finalizedSlot).TimeProvider()
+
+	// the epoch of the finalized slot is only fully finalized if the slot is its last slot
+	finalizedEpoch := timeProvider.EpochFromSlot(finalizedSlot)
+	if timeProvider.EpochEnd(finalizedEpoch) != finalizedSlot {
+		if finalizedEpoch == 0 {
+			return 0, false
+		}
+
+		finalizedEpoch--
+	}
+
+	targetEpoch = finalizedEpoch - finalizedEpoch%s.optsInterval
+
+	return targetEpoch, targetEpoch > s.lastSnapshotEpoch
+}
+
+// createSnapshot writes the snapshot to a temporary file and moves it into place once it is complete.
+func (s *Snapshotter) createSnapshot(epoch iotago.EpochIndex, slot iotago.SlotIndex) (filePath string, err error) {
+	filePath = s.snapshotFilePath(epoch)
+	tempFilePath := filePath + tempFileExtension
+
+	if err = s.writeSnapshot(tempFilePath, slot); err != nil {
+		_ = os.Remove(tempFilePath)
+
+		return "", ierrors.Wrap(err, "failed to write snapshot")
+	}
+
+	if err = os.Rename(tempFilePath, filePath); err != nil {
+		return "", ierrors.Wrap(err, "failed to move snapshot into place")
+	}
+
+	return filePath, nil
+}
+
+// removeOutdatedSnapshots removes all but the latest optsRetainedSnapshots snapshots from the directory.
+func (s *Snapshotter) removeOutdatedSnapshots() error {
+	epochs, err := s.snapshotEpochs()
+	if err != nil {
+		return err
+	}
+
+	for len(epochs) > s.optsRetainedSnapshots {
+		if err := os.Remove(s.snapshotFilePath(epochs[0])); err != nil {
+			return ierrors.Wrapf(err, "failed to remove snapshot of epoch %d", epochs[0])
+		}
+
+		s.LogDebug("removed outdated snapshot", "epoch", epochs[0])
+
+		epochs = epochs[1:]
+	}
+
+	return nil
+}
+
+// upload executes the upload command with the path of the snapshot as its last argument.
+func (s *Snapshotter) upload(ctx context.Context, filePath string) error {
+	commandFields := strings.Fields(s.optsUploadCommand)
+	if len(commandFields) == 0 {
+		return nil
+	}
+
+	//nolint:gosec // the command is configured by the node operator
+	output, err := exec.CommandContext(ctx, commandFields[0], append(commandFields[1:], filePath)...).CombinedOutput()
+	if err != nil {
+		return ierrors.Wrapf(err, "upload command failed: %s", strings.TrimSpace(string(output)))
+	}
+
+	s.LogInfo("uploaded snapshot", "path", filePath)
+
+	return nil
+}
+
+// snapshotEpochs returns the epochs of the snapshots in the directory in ascending order.
+func (s *Snapshotter) snapshotEpochs() ([]iotago.EpochIndex, error) {
+	entries, err := os.ReadDir(s.optsDirectory)
+	if err != nil {
+		return nil, ierrors.Wrapf(err, "failed to read snapshot directory %s", s.optsDirectory)
+	}
+
+	epochs := make([]iotago.EpochIndex, 0, len(entries))
+	for _, entry := range entries {
+		var epoch iotago.EpochIndex
+		if entry.IsDir() || !strings.HasPrefix(entry.Name(), snapshotFilePrefix) || !strings.HasSuffix(entry.Name(), snapshotFileExtension) {
+			continue
+		}
+
+		if _, err := fmt.Sscanf(strings.TrimSuffix(strings.TrimPrefix(entry.Name(), snapshotFilePrefix), snapshotFileExtension), "%d", &epoch); err != nil {
+			continue
+		}
+
+		epochs = append(epochs, epoch)
+	}
+
+	sort.Slice(epochs, func(i, j int) bool {
+		return epochs[i] < epochs[j]
+	})
+
+	return epochs, nil
+}
+
+func (s *Snapshotter) snapshotFilePath(epoch iotago.EpochIndex) string {
+	return filepath.Join(s.optsDirectory, fmt.Sprintf("%s%d%s", snapshotFilePrefix, epoch, snapshotFileExtension))
+}
+
+// WithInterval sets the number of finalized epochs between two snapshots.
+func WithInterval(interval iotago.EpochIndex) options.Option[Snapshotter] {
+	return func(s *Snapshotter) {
+		s.optsInterval = interval
+	}
+}
+
+// WithDirectory sets the directory the snapshots are written to.
+func WithDirectory(directory string) options.Option[Snapshotter] {
+	return func(s *Snapshotter) {
+		s.optsDirectory = directory
+	}
+}
+
+// WithRetainedSnapshots sets the number of snapshots that are kept in the directory.
+func WithRetainedSnapshots(retainedSnapshots int) options.Option[Snapshotter] {
+	return func(s *Snapshotter) {
+		s.optsRetainedSnapshots = retainedSnapshots
+	}
+}
+
+// WithUploadCommand sets the command that is executed with the path of every new snapshot as its last argument.
+func WithUploadCommand(uploadCommand string) options.Option[Snapshotter] {
+	return func(s *Snapshotter) {
+		s.optsUploadCommand = uploadCommand
+	}
+}
@@ -0,0 +1,90 @@
+package snapshotter_test
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/log"
+	"github.com/iotaledger/iota-core/pkg/snapshotter"
+	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/tpkg"
+)
+
+func TestSnapshotter(t *testing.T) {
+	directory := t.TempDir()
+	apiProvider := iotago.SingleVersionProvider(tpkg.ZeroCostTestAPI)
+	timeProvider := apiProvider.LatestAPI().TimeProvider()
+
+	latestCommittedSlot := iotago.SlotIndex(0)
+	writtenSlots := make([]iotago.SlotIndex, 0)
+	uploadDirectory := t.TempDir()
+
+	// the upload command receives the snapshot path as its last argument, so it is wrapped in a script that copies it
+	// with the portable form of cp.
+	uploadScript := filepath.Join(t.TempDir(), "upload.sh")
+	//nolint:gosec // the script needs to be executable
+	require.NoError(t, os.WriteFile(uploadScript, []byte("#!/bin/sh\ncp \"$1\" \""+uploadDirectory+"\"\n"), 0o700))
+
+	s := snapshotter.New(apiProvider, func() iotago.SlotIndex {
+		return latestCommittedSlot
+	}, func(filePath string, targetSlot iotago.SlotIndex) error {
+		writtenSlots = append(writtenSlots, targetSlot)
+
+		return os.WriteFile(filePath, []byte{}, 0o600)
+	}, log.NewLogger(),
+		snapshotter.WithDirectory(directory),
+		snapshotter.WithInterval(2),
+		snapshotter.WithRetainedSnapshots(2),
+		snapshotter.WithUploadCommand(uploadScript),
+	)
+	require.NoError(t, s.Init())
+
+	finalize := func(slot iotago.SlotIndex) {
+		latestCommittedSlot = max(latestCommittedSlot, slot)
+		s.OnSlotFinalized(context.Background(), slot)
+	}
+
+	// no snapshot is created before the end of an epoch that is a multiple of the interval is finalized
+	finalize(timeProvider.EpochEnd(1))
+	finalize(timeProvider.EpochStart(2))
+	require.Empty(t, writtenSlots)
+
+	finalize(timeProvider.EpochEnd(2))
+	require.Equal(t, []iotago.SlotIndex{timeProvider.EpochEnd(2)}, writtenSlots)
+	require.FileExists(t, filepath.Join(directory, "snapshot_epoch_2.bin"))
+
+	// the snapshot is only created once the end of the epoch is committed
+	s.OnSlotFinalized(context.Background(), timeProvider.EpochEnd(4))
+	require.Len(t, writtenSlots, 1)
+
+	finalize(timeProvider.EpochEnd(4))
+	require.Equal(t, []iotago.SlotIndex{timeProvider.EpochEnd(2), timeProvider.EpochEnd(4)}, writtenSlots)
+
+	// skipped epochs are snapshotted at the latest multiple of the interval and old snapshots are removed
+	finalize(timeProvider.EpochStart(8))
+	require.Equal(t, []iotago.SlotIndex{timeProvider.EpochEnd(2), timeProvider.EpochEnd(4), timeProvider.EpochEnd(6)}, writtenSlots)
+	require.NoFileExists(t, filepath.Join(directory, "snapshot_epoch_2.bin"))
+	require.FileExists(t, filepath.Join(directory, "snapshot_epoch_4.bin"))
+	require.FileExists(t, filepath.Join(directory, "snapshot_epoch_6.bin"))
+
+	// the upload command is called with the path of every new snapshot
+	require.FileExists(t, filepath.Join(uploadDirectory, "snapshot_epoch_2.bin"))
+	require.FileExists(t, filepath.Join(uploadDirectory, "snapshot_epoch_6.bin"))
+
+	// a restarted snapshotter continues the schedule of the existing snapshots
+	restarted := snapshotter.New(apiProvider, func() iotago.SlotIndex {
+		return latestCommittedSlot
+	}, func(filePath string, targetSlot iotago.SlotIndex) error {
+		writtenSlots = append(writtenSlots, targetSlot)
+
+		return os.WriteFile(filePath, []byte{}, 0o600)
+	}, log.NewLogger(), snapshotter.WithDirectory(directory), snapshotter.WithInterval(2))
+	require.NoError(t, restarted.Init())
+
+	restarted.OnSlotFinalized(context.Background(), timeProvider.EpochEnd(7))
+	require.Len(t, writtenSlots, 3)
+}
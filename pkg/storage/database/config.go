@@ -7,9 +7,42 @@ type Config struct {
 	Directory    string
 	Version      byte
 	PrefixHealth []byte
+	ReadOnly     bool
+
+	// Settings contains the engine settings that are applied when the database is opened.
+	Settings Settings
+
+	// Migrations contains the migrations that are executed when the database is opened with a newer Version than it
+	// was created with. If it is nil, the content of the database is not migrated.
+	Migrations VersionMigrations
 }
 
 func (c Config) WithDirectory(directory string) Config {
 	c.Directory = directory
 	return c
 }
+
+// WithMigrations returns a copy of the Config that uses the given migrations.
+func (c Config) WithMigrations(migrations VersionMigrations) Config {
+	c.Migrations = migrations
+	return c
+}
+
+// WithSettings returns a copy of the Config that uses the given engine settings.
+func (c Config) WithSettings(settings Settings) Config {
+	c.Settings = settings
+	return c
+}
+
+// Settings contains the engine settings of a database. The zero value uses the defaults of the engine.
+type Settings struct {
+	// BloomFilterBitsPerKey is the number of bits per key of the bloom filters that are stored in the tables of the
+	// database to skip the tables that don't contain a key on point lookups (0 = no bloom filters).
+	BloomFilterBitsPerKey int
+
+	// TombstoneCompactionThreshold is the number of deleted key prefixes after which the key ranges of the deleted
+	// prefixes are compacted in the background to drop the deleted entries and their tombstones (0 = the tombstones are
+	// only dropped by the regular compactions of the engine). It is only supported by the pebble engine, RocksDB
+	// databases fail to open with ErrTombstoneCompactionNotSupported if it is set.
+	TombstoneCompactionThreshold int
+}
@@ -19,10 +19,12 @@ var (
 		hivedb.EngineAuto,
 		hivedb.EngineMapDB,
 		hivedb.EngineRocksDB,
+		EnginePebble,
 	}
 
 	AllowedEnginesStorage = []hivedb.Engine{
 		hivedb.EngineRocksDB,
+		EnginePebble,
 	}
 
 	AllowedEnginesStorageAuto = append(AllowedEnginesStorage, hivedb.EngineAuto)
@@ -157,7 +159,12 @@ func CheckEngine(dbPath string, createDatabaseIfNotExists bool, dbEngine hivedb.
 // StoreWithDefaultSettings returns a kvstore with default settings.
 // It also checks if the database engine is correct.
 func StoreWithDefaultSettings(path string, createDatabaseIfNotExists bool, dbEngine hivedb.Engine, allowedEngines ...hivedb.Engine) (kvstore.KVStore, error) {
+	return StoreWithSettings(path, createDatabaseIfNotExists, dbEngine, Settings{}, allowedEngines...)
+}
 
+// StoreWithSettings returns a kvstore that uses the given engine settings.
+// It also checks if the database engine is correct.
+func StoreWithSettings(path string, createDatabaseIfNotExists bool, dbEngine hivedb.Engine, settings Settings, allowedEngines ...hivedb.Engine) (kvstore.KVStore, error) {
 	tmpAllowedEngines := AllowedEnginesDefault
 	if len(allowedEngines) > 0 {
 		tmpAllowedEngines = allowedEngines
@@ -170,13 +177,25 @@ func StoreWithDefaultSettings(path string, createDatabaseIfNotExists bool, dbEng
 
 	switch targetEngine {
 	case hivedb.EngineRocksDB:
-		db, err := NewRocksDB(path)
+		if settings.TombstoneCompactionThreshold > 0 {
+			return nil, ErrTombstoneCompactionNotSupported
+		}
+
+		db, err := NewRocksDB(path, settings)
 		if err != nil {
 			return nil, err
 		}
 
 		return rocksdb.New(db), nil
 
+	case EnginePebble:
+		db, err := NewPebbleDB(path, settings)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewPebbleStore(db, settings), nil
+
 	case hivedb.EngineMapDB:
 		return mapdb.NewMapDB(), nil
 
@@ -184,3 +203,48 @@ func StoreWithDefaultSettings(path string, createDatabaseIfNotExists bool, dbEng
 		return nil, ierrors.Errorf("unknown database engine: %s, supported engines: pebble/rocksdb/mapdb", dbEngine)
 	}
 }
+
+// StoreReadOnlyWithDefaultSettings opens an existing database in read-only mode and returns a kvstore with default settings.
+// It also checks if the database engine is correct.
+func StoreReadOnlyWithDefaultSettings(path string, dbEngine hivedb.Engine, allowedEngines ...hivedb.Engine) (kvstore.KVStore, error) {
+	return StoreReadOnlyWithSettings(path, dbEngine, Settings{}, allowedEngines...)
+}
+
+// StoreReadOnlyWithSettings opens an existing database in read-only mode and returns a kvstore that uses the given
+// engine settings. It also checks if the database engine is correct.
+func StoreReadOnlyWithSettings(path string, dbEngine hivedb.Engine, settings Settings, allowedEngines ...hivedb.Engine) (kvstore.KVStore, error) {
+	tmpAllowedEngines := AllowedEnginesStorageAuto
+	if len(allowedEngines) > 0 {
+		tmpAllowedEngines = allowedEngines
+	}
+
+	targetEngine, err := CheckEngine(path, false, dbEngine, tmpAllowedEngines...)
+	if err != nil {
+		return nil, err
+	}
+
+	switch targetEngine {
+	case hivedb.EngineRocksDB:
+		if settings.TombstoneCompactionThreshold > 0 {
+			return nil, ErrTombstoneCompactionNotSupported
+		}
+
+		db, err := NewRocksDBReadOnly(path, settings)
+		if err != nil {
+			return nil, err
+		}
+
+		return rocksdb.New(db), nil
+
+	case EnginePebble:
+		db, err := NewPebbleDBReadOnly(path, settings)
+		if err != nil {
+			return nil, err
+		}
+
+		return NewPebbleStore(db, settings), nil
+
+	default:
+		return nil, ierrors.Errorf("unsupported database engine for read-only access: %s, supported engines: pebble/rocksdb", targetEngine)
+	}
+}
@@ -18,7 +18,7 @@ type DBInstance struct {
 }
 
 func NewDBInstance(dbConfig Config, openedCallback func(d *DBInstance)) *DBInstance {
-	db, err := StoreWithDefaultSettings(dbConfig.Directory, true, dbConfig.Engine)
+	db, err := openStore(dbConfig, true)
 	if err != nil {
 		panic(err)
 	}
@@ -57,12 +57,22 @@ func NewDBInstance(dbConfig Config, openedCallback func(d *DBInstance)) *DBInsta
 
 	dbInstance.store = lockableKVStore
 
+	// a read-only instance must not modify the database, so it does not track the health of the store.
+	if dbConfig.ReadOnly {
+		return dbInstance
+	}
+
 	// HealthTracker state is only modified while holding the lock on the lockableKVStore;
 	//  that's why it needs to use openableKVStore (which does not lock) instead of lockableKVStore to avoid a deadlock.
-	storeHealthTracker, err := kvstore.NewStoreHealthTracker(lockableKVStore.openableKVStore, dbConfig.PrefixHealth, dbConfig.Version, nil)
+	storeHealthTracker, err := kvstore.NewStoreHealthTracker(lockableKVStore.openableKVStore, dbConfig.PrefixHealth, dbConfig.Version, func(oldVersion byte, newVersion byte) error {
+		return dbConfig.Migrations.Run(lockableKVStore.openableKVStore, oldVersion, newVersion)
+	})
 	if err != nil {
 		panic(ierrors.Wrapf(err, "database in %s is corrupted, delete database and resync node", dbConfig.Directory))
 	}
+	if err = migrate(storeHealthTracker, dbConfig); err != nil {
+		panic(ierrors.Wrapf(err, "failed to migrate database in %s to version %d, delete database and resync node", dbConfig.Directory, dbConfig.Version))
+	}
 	if err = storeHealthTracker.MarkCorrupted(); err != nil {
 		panic(err)
 	}
@@ -82,7 +92,7 @@ func (d *DBInstance) Flush() {
 	d.store.LockAccess()
 	defer d.store.UnlockAccess()
 
-	if !d.isClosed.Load() {
+	if !d.isClosed.Load() && !d.dbConfig.ReadOnly {
 		if instance, err := d.store.instance(); err == nil {
 			_ = instance.Flush()
 		}
@@ -98,12 +108,14 @@ func (d *DBInstance) Close() {
 
 func (d *DBInstance) CloseWithoutLocking() {
 	if !d.isClosed.Load() {
-		if err := d.healthTracker.MarkHealthy(); err != nil {
-			panic(err)
-		}
+		if !d.dbConfig.ReadOnly {
+			if err := d.healthTracker.MarkHealthy(); err != nil {
+				panic(err)
+			}
 
-		if err := d.store.topParent().storeInstance.Flush(); err != nil {
-			panic(err)
+			if err := d.store.topParent().storeInstance.Flush(); err != nil {
+				panic(err)
+			}
 		}
 
 		if err := d.store.topParent().storeInstance.Close(); err != nil {
@@ -125,10 +137,14 @@ func (d *DBInstance) Open() error {
 		return ErrDatabaseShutdown
 	}
 
-	d.store.Replace(lo.PanicOnErr(StoreWithDefaultSettings(d.dbConfig.Directory, false, d.dbConfig.Engine)))
+	d.store.Replace(lo.PanicOnErr(openStore(d.dbConfig, false)))
 
 	d.isClosed.Store(false)
 
+	if d.dbConfig.ReadOnly {
+		return nil
+	}
+
 	if err := d.healthTracker.MarkCorrupted(); err != nil {
 		// panic immediately as in this case the database state is corrupted
 		panic(err)
@@ -148,3 +164,12 @@ func (d *DBInstance) UnlockAccess() {
 func (d *DBInstance) KVStore() kvstore.KVStore {
 	return d.store
 }
+
+// openStore opens the underlying store of the DBInstance, honoring the read-only setting of the given config.
+func openStore(dbConfig Config, createDatabaseIfNotExists bool) (kvstore.KVStore, error) {
+	if dbConfig.ReadOnly {
+		return StoreReadOnlyWithSettings(dbConfig.Directory, dbConfig.Engine, dbConfig.Settings)
+	}
+
+	return StoreWithSettings(dbConfig.Directory, createDatabaseIfNotExists, dbConfig.Engine, dbConfig.Settings)
+}
@@ -0,0 +1,9 @@
+//go:build rocksdb
+
+package database
+
+import (
+	hivedb "github.com/iotaledger/hive.go/kvstore/database"
+)
+
+var testEngines = []hivedb.Engine{EnginePebble, hivedb.EngineRocksDB}
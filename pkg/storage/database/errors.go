@@ -8,4 +8,6 @@ var (
 	ErrDatabaseFull      = ierrors.New("database full")
 	ErrDatabaseShutdown  = ierrors.New("cannot open DBInstance that is shutdown")
 	ErrDatabaseNotClosed = ierrors.New("cannot open DBInstance that is not closed")
+
+	ErrTombstoneCompactionNotSupported = ierrors.New("tombstone compaction is only supported by the pebble engine")
 )
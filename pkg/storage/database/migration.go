@@ -0,0 +1,115 @@
+package database
+
+import (
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/kvstore"
+	hivedb "github.com/iotaledger/hive.go/kvstore/database"
+	"github.com/iotaledger/hive.go/runtime/ioutils"
+)
+
+const (
+	// dbInfoFileName is the name of the file that contains the engine of a database.
+	dbInfoFileName = "dbinfo"
+
+	// migrationBatchSize is the number of entries that are written in a single batch during a migration.
+	migrationBatchSize = 100_000
+)
+
+// Migrate copies the content of the database in sourcePath to a newly created database in targetPath that uses the
+// given engine.
+func Migrate(sourcePath string, targetPath string, targetEngine hivedb.Engine) error {
+	targetExists, err := ioutils.DirExistsAndIsNotEmpty(targetPath)
+	if err != nil {
+		return ierrors.Wrapf(err, "unable to check target database (%s)", targetPath)
+	} else if targetExists {
+		return ierrors.Errorf("target database (%s) already exists", targetPath)
+	}
+
+	source, err := StoreWithDefaultSettings(sourcePath, false, hivedb.EngineAuto, AllowedEnginesStorageAuto...)
+	if err != nil {
+		return ierrors.Wrapf(err, "unable to open source database (%s)", sourcePath)
+	}
+	defer source.Close()
+
+	target, err := StoreWithDefaultSettings(targetPath, true, targetEngine, AllowedEnginesStorage...)
+	if err != nil {
+		return ierrors.Wrapf(err, "unable to create target database (%s)", targetPath)
+	}
+	defer target.Close()
+
+	if err := kvstore.CopyBatched(source, target, migrationBatchSize); err != nil {
+		return ierrors.Wrapf(err, "unable to copy database (%s) to (%s)", sourcePath, targetPath)
+	}
+
+	return nil
+}
+
+// MigrateDirectory migrates all databases below sourceDirectory to the given engine and writes them to the same relative
+// paths below targetDirectory. Files that do not belong to a database are copied as they are. It returns the relative
+// paths of the migrated databases.
+func MigrateDirectory(sourceDirectory string, targetDirectory string, targetEngine hivedb.Engine) (migratedDatabases []string, err error) {
+	if err = filepath.WalkDir(sourceDirectory, func(path string, entry fs.DirEntry, walkErr error) error {
+		if walkErr != nil {
+			return walkErr
+		}
+
+		relativePath, err := filepath.Rel(sourceDirectory, path)
+		if err != nil {
+			return err
+		}
+		targetPath := filepath.Join(targetDirectory, relativePath)
+
+		if !entry.IsDir() {
+			return copyFile(path, targetPath)
+		}
+
+		if isDatabase, _, err := ioutils.PathExists(filepath.Join(path, dbInfoFileName)); err != nil {
+			return err
+		} else if !isDatabase {
+			return ioutils.CreateDirectory(targetPath, 0700)
+		}
+
+		if err := Migrate(path, targetPath, targetEngine); err != nil {
+			return err
+		}
+		migratedDatabases = append(migratedDatabases, relativePath)
+
+		return filepath.SkipDir
+	}); err != nil {
+		return nil, ierrors.Wrapf(err, "failed to migrate databases in %s", sourceDirectory)
+	}
+
+	return migratedDatabases, nil
+}
+
+// copyFile copies the file in sourcePath to targetPath.
+func copyFile(sourcePath string, targetPath string) error {
+	source, err := os.Open(sourcePath)
+	if err != nil {
+		return err
+	}
+	defer source.Close()
+
+	info, err := source.Stat()
+	if err != nil {
+		return err
+	}
+
+	target, err := os.OpenFile(targetPath, os.O_CREATE|os.O_EXCL|os.O_WRONLY, info.Mode().Perm())
+	if err != nil {
+		return err
+	}
+
+	if _, err := io.Copy(target, source); err != nil {
+		_ = target.Close()
+
+		return err
+	}
+
+	return target.Close()
+}
@@ -0,0 +1,358 @@
+package database
+
+import (
+	"fmt"
+	"sync"
+	"sync/atomic"
+
+	"github.com/cockroachdb/pebble"
+	"github.com/cockroachdb/pebble/bloom"
+
+	"github.com/iotaledger/hive.go/ds/types"
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/kvstore"
+	hivedb "github.com/iotaledger/hive.go/kvstore/database"
+	"github.com/iotaledger/hive.go/kvstore/utils"
+	"github.com/iotaledger/hive.go/serializer/v2/byteutils"
+)
+
+// EnginePebble is the database engine that uses Pebble, a pure Go key-value store that does not require CGO.
+const EnginePebble hivedb.Engine = "pebble"
+
+// NewPebbleDB creates a new Pebble instance.
+func NewPebbleDB(path string, settings Settings) (*pebble.DB, error) {
+	return openPebbleDB(path, false, settings)
+}
+
+// NewPebbleDBReadOnly opens an existing Pebble instance in read-only mode.
+// Pebble locks its directory, so the database must not be in use by another process.
+func NewPebbleDBReadOnly(path string, settings Settings) (*pebble.DB, error) {
+	return openPebbleDB(path, true, settings)
+}
+
+func openPebbleDB(path string, readOnly bool, settings Settings) (*pebble.DB, error) {
+	cache := pebble.NewCache(128 << 20) // 128MB
+	defer cache.Unref()
+
+	opts := &pebble.Options{
+		Cache:                       cache,
+		MemTableSize:                64 << 20, // 64MB
+		MaxConcurrentCompactions:    func() int { return 4 },
+		L0CompactionThreshold:       2,
+		L0StopWritesThreshold:       1000,
+		LBaseMaxBytes:               64 << 20, // 64MB
+		MaxOpenFiles:                1000,
+		MemTableStopWritesThreshold: 4,
+		Logger:                      pebbleLogger{},
+		ReadOnly:                    readOnly,
+	}
+
+	// the options of the last level apply to all deeper levels, so a single level configures the filters of all levels.
+	if settings.BloomFilterBitsPerKey > 0 {
+		opts.Levels = []pebble.LevelOptions{{
+			FilterPolicy: bloom.FilterPolicy(settings.BloomFilterBitsPerKey),
+			FilterType:   pebble.TableFilter,
+		}}
+	}
+	opts.EnsureDefaults()
+
+	return pebble.Open(path, opts)
+}
+
+// pebbleLogger is a pebble.Logger that discards the informational messages of Pebble.
+type pebbleLogger struct{}
+
+func (pebbleLogger) Infof(_ string, _ ...any) {}
+
+func (pebbleLogger) Fatalf(format string, args ...any) {
+	panic(fmt.Sprintf(format, args...))
+}
+
+type pebbleStore struct {
+	instance  *pebble.DB
+	dbPrefix  []byte
+	closed    *atomic.Bool
+	compactor *tombstoneCompactor
+}
+
+// NewPebbleStore creates a new KVStore with the underlying Pebble instance.
+func NewPebbleStore(db *pebble.DB, settings Settings) kvstore.KVStore {
+	return &pebbleStore{
+		instance:  db,
+		closed:    new(atomic.Bool),
+		compactor: newTombstoneCompactor(db, settings.TombstoneCompactionThreshold),
+	}
+}
+
+func (s *pebbleStore) WithRealm(realm kvstore.Realm) (kvstore.KVStore, error) {
+	if s.closed.Load() {
+		return nil, kvstore.ErrStoreClosed
+	}
+
+	return &pebbleStore{
+		instance:  s.instance,
+		closed:    s.closed,
+		dbPrefix:  realm,
+		compactor: s.compactor,
+	}, nil
+}
+
+func (s *pebbleStore) WithExtendedRealm(realm kvstore.Realm) (kvstore.KVStore, error) {
+	return s.WithRealm(byteutils.ConcatBytes(s.Realm(), realm))
+}
+
+func (s *pebbleStore) Realm() []byte {
+	return s.dbPrefix
+}
+
+// buildKeyPrefix builds a key usable using the realm and the given prefix.
+func (s *pebbleStore) buildKeyPrefix(prefix kvstore.KeyPrefix) kvstore.KeyPrefix {
+	return byteutils.ConcatBytes(s.dbPrefix, prefix)
+}
+
+// iterate calls the consumer for all entries with the given prefix in the given direction.
+func (s *pebbleStore) iterate(prefix kvstore.KeyPrefix, consumerFunc func(it *pebble.Iterator) bool, iterDirection ...kvstore.IterDirection) error {
+	if s.closed.Load() {
+		return kvstore.ErrStoreClosed
+	}
+
+	keyPrefix := s.buildKeyPrefix(prefix)
+
+	it, err := s.instance.NewIter(&pebble.IterOptions{
+		LowerBound: keyPrefix,
+		// the upper bound is nil (unbounded) if the prefix consists of 0xff bytes only.
+		UpperBound: utils.KeyPrefixUpperBound(keyPrefix),
+	})
+	if err != nil {
+		return ierrors.Wrap(err, "failed to create iterator")
+	}
+
+	startFunc, moveFunc := it.First, it.Next
+	if kvstore.GetIterDirection(iterDirection...) == kvstore.IterDirectionBackward {
+		startFunc, moveFunc = it.Last, it.Prev
+	}
+
+	for valid := startFunc(); valid; valid = moveFunc() {
+		if !consumerFunc(it) {
+			break
+		}
+	}
+
+	if err := it.Error(); err != nil {
+		_ = it.Close()
+
+		return ierrors.Wrap(err, "failed to iterate")
+	}
+
+	return it.Close()
+}
+
+// Iterate iterates over all keys and values with the provided prefix. You can pass kvstore.EmptyPrefix to iterate over all keys and values.
+// Optionally the direction for the iteration can be passed (default: IterDirectionForward).
+func (s *pebbleStore) Iterate(prefix kvstore.KeyPrefix, consumerFunc kvstore.IteratorKeyValueConsumerFunc, iterDirection ...kvstore.IterDirection) error {
+	return s.iterate(prefix, func(it *pebble.Iterator) bool {
+		return consumerFunc(utils.CopyBytes(it.Key())[len(s.dbPrefix):], utils.CopyBytes(it.Value()))
+	}, iterDirection...)
+}
+
+// IterateKeys iterates over all keys with the provided prefix. You can pass kvstore.EmptyPrefix to iterate over all keys.
+// Optionally the direction for the iteration can be passed (default: IterDirectionForward).
+func (s *pebbleStore) IterateKeys(prefix kvstore.KeyPrefix, consumerFunc kvstore.IteratorKeyConsumerFunc, iterDirection ...kvstore.IterDirection) error {
+	return s.iterate(prefix, func(it *pebble.Iterator) bool {
+		return consumerFunc(utils.CopyBytes(it.Key())[len(s.dbPrefix):])
+	}, iterDirection...)
+}
+
+func (s *pebbleStore) Clear() error {
+	if s.closed.Load() {
+		return kvstore.ErrStoreClosed
+	}
+
+	return s.DeletePrefix(kvstore.EmptyPrefix)
+}
+
+func (s *pebbleStore) Get(key kvstore.Key) (kvstore.Value, error) {
+	if s.closed.Load() {
+		return nil, kvstore.ErrStoreClosed
+	}
+
+	value, closer, err := s.instance.Get(byteutils.ConcatBytes(s.dbPrefix, key))
+	if err != nil {
+		if ierrors.Is(err, pebble.ErrNotFound) {
+			return nil, kvstore.ErrKeyNotFound
+		}
+
+		return nil, err
+	}
+	defer func() { _ = closer.Close() }()
+
+	return utils.CopyBytes(value), nil
+}
+
+func (s *pebbleStore) Set(key kvstore.Key, value kvstore.Value) error {
+	if s.closed.Load() {
+		return kvstore.ErrStoreClosed
+	}
+
+	return s.instance.Set(byteutils.ConcatBytes(s.dbPrefix, key), value, pebble.NoSync)
+}
+
+func (s *pebbleStore) Has(key kvstore.Key) (bool, error) {
+	if s.closed.Load() {
+		return false, kvstore.ErrStoreClosed
+	}
+
+	_, closer, err := s.instance.Get(byteutils.ConcatBytes(s.dbPrefix, key))
+	if err != nil {
+		if ierrors.Is(err, pebble.ErrNotFound) {
+			return false, nil
+		}
+
+		return false, err
+	}
+
+	return true, closer.Close()
+}
+
+func (s *pebbleStore) Delete(key kvstore.Key) error {
+	if s.closed.Load() {
+		return kvstore.ErrStoreClosed
+	}
+
+	return s.instance.Delete(byteutils.ConcatBytes(s.dbPrefix, key), pebble.NoSync)
+}
+
+func (s *pebbleStore) DeletePrefix(prefix kvstore.KeyPrefix) error {
+	if s.closed.Load() {
+		return kvstore.ErrStoreClosed
+	}
+
+	keyPrefix := s.buildKeyPrefix(prefix)
+
+	if prefixUpperBound := utils.KeyPrefixUpperBound(keyPrefix); prefixUpperBound != nil {
+		if err := s.instance.DeleteRange(keyPrefix, prefixUpperBound, pebble.NoSync); err != nil {
+			return err
+		}
+
+		s.compactor.Add(keyPrefix, prefixUpperBound)
+
+		return nil
+	}
+
+	// without an upper bound, the keys are deleted one by one.
+	batch := s.instance.NewBatch()
+	defer batch.Close()
+
+	if err := s.IterateKeys(prefix, func(key kvstore.Key) bool {
+		return batch.Delete(byteutils.ConcatBytes(s.dbPrefix, key), nil) == nil
+	}); err != nil {
+		return err
+	}
+
+	return batch.Commit(pebble.NoSync)
+}
+
+func (s *pebbleStore) Flush() error {
+	if s.closed.Load() {
+		return kvstore.ErrStoreClosed
+	}
+
+	return s.instance.Flush()
+}
+
+func (s *pebbleStore) Close() error {
+	if s.closed.Swap(true) {
+		// was already closed
+		return nil
+	}
+
+	// the running compactions must be finished before the instance is closed.
+	s.compactor.Shutdown()
+
+	return s.instance.Close()
+}
+
+func (s *pebbleStore) Batched() (kvstore.BatchedMutations, error) {
+	if s.closed.Load() {
+		return nil, kvstore.ErrStoreClosed
+	}
+
+	return &pebbleBatchedMutations{
+		store:            s.instance,
+		dbPrefix:         s.dbPrefix,
+		setOperations:    make(map[string]kvstore.Value),
+		deleteOperations: make(map[string]types.Empty),
+		closed:           s.closed,
+	}, nil
+}
+
+// pebbleBatchedMutations is a wrapper around a Batch of a Pebble instance.
+type pebbleBatchedMutations struct {
+	store            *pebble.DB
+	dbPrefix         []byte
+	setOperations    map[string]kvstore.Value
+	deleteOperations map[string]types.Empty
+	operationsMutex  sync.Mutex
+	closed           *atomic.Bool
+}
+
+func (b *pebbleBatchedMutations) Set(key kvstore.Key, value kvstore.Value) error {
+	stringKey := byteutils.ConcatBytesToString(b.dbPrefix, key)
+
+	b.operationsMutex.Lock()
+	defer b.operationsMutex.Unlock()
+
+	delete(b.deleteOperations, stringKey)
+	b.setOperations[stringKey] = value
+
+	return nil
+}
+
+func (b *pebbleBatchedMutations) Delete(key kvstore.Key) error {
+	stringKey := byteutils.ConcatBytesToString(b.dbPrefix, key)
+
+	b.operationsMutex.Lock()
+	defer b.operationsMutex.Unlock()
+
+	delete(b.setOperations, stringKey)
+	b.deleteOperations[stringKey] = types.Void
+
+	return nil
+}
+
+func (b *pebbleBatchedMutations) Cancel() {
+	b.operationsMutex.Lock()
+	defer b.operationsMutex.Unlock()
+
+	b.setOperations = make(map[string]kvstore.Value)
+	b.deleteOperations = make(map[string]types.Empty)
+}
+
+func (b *pebbleBatchedMutations) Commit() error {
+	if b.closed.Load() {
+		return kvstore.ErrStoreClosed
+	}
+
+	batch := b.store.NewBatch()
+	defer batch.Close()
+
+	b.operationsMutex.Lock()
+	defer b.operationsMutex.Unlock()
+
+	for key, value := range b.setOperations {
+		if err := batch.Set([]byte(key), value, nil); err != nil {
+			return err
+		}
+	}
+
+	for key := range b.deleteOperations {
+		if err := batch.Delete([]byte(key), nil); err != nil {
+			return err
+		}
+	}
+
+	return batch.Commit(pebble.NoSync)
+}
+
+var _ kvstore.KVStore = &pebbleStore{}
+var _ kvstore.BatchedMutations = &pebbleBatchedMutations{}
@@ -0,0 +1,115 @@
+package database
+
+import (
+	"bytes"
+	"sort"
+	"sync"
+
+	"github.com/cockroachdb/pebble"
+)
+
+// tombstoneCompactor compacts the key ranges of deleted key prefixes of a Pebble instance in the background.
+//
+// Pebble has no compaction filters, so the entries of a deleted prefix (e.g. the entries of a pruned epoch) stay in the
+// tables below a range tombstone until a regular compaction happens to cover the range. Until then, reads need to
+// check the tombstones and skip the deleted entries, which increases the read amplification. The compactor collects
+// the deleted ranges and compacts them lazily once the threshold is reached, which drops the deleted entries together
+// with their tombstones.
+type tombstoneCompactor struct {
+	instance  *pebble.DB
+	threshold int
+
+	pendingRanges []keyRange
+	isShutdown    bool
+	mutex         sync.Mutex
+
+	runningCompactions sync.WaitGroup
+}
+
+// keyRange is a key range with an inclusive start and an exclusive end.
+type keyRange struct {
+	start []byte
+	end   []byte
+}
+
+// newTombstoneCompactor creates a new tombstoneCompactor that compacts the deleted ranges once the given number of
+// ranges was deleted. It returns nil if the threshold is 0 (the tombstones are only dropped by regular compactions).
+func newTombstoneCompactor(instance *pebble.DB, threshold int) *tombstoneCompactor {
+	if threshold <= 0 {
+		return nil
+	}
+
+	return &tombstoneCompactor{
+		instance:  instance,
+		threshold: threshold,
+	}
+}
+
+// Add adds the given deleted key range and starts a compaction of the pending ranges if the threshold is reached.
+func (t *tombstoneCompactor) Add(start []byte, end []byte) {
+	if t == nil {
+		return
+	}
+
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+
+	if t.isShutdown {
+		return
+	}
+
+	t.pendingRanges = append(t.pendingRanges, keyRange{start: start, end: end})
+	if len(t.pendingRanges) < t.threshold {
+		return
+	}
+
+	pendingRanges := mergeKeyRanges(t.pendingRanges)
+	t.pendingRanges = nil
+
+	t.runningCompactions.Add(1)
+	go func() {
+		defer t.runningCompactions.Done()
+
+		for _, pendingRange := range pendingRanges {
+			// the compaction is only an optimization, if it fails the tombstones are dropped by the regular compactions.
+			_ = t.instance.Compact(pendingRange.start, pendingRange.end, false)
+		}
+	}()
+}
+
+// Shutdown discards the pending ranges and waits for the running compactions to finish.
+func (t *tombstoneCompactor) Shutdown() {
+	if t == nil {
+		return
+	}
+
+	t.mutex.Lock()
+	t.isShutdown = true
+	t.pendingRanges = nil
+	t.mutex.Unlock()
+
+	t.runningCompactions.Wait()
+}
+
+// mergeKeyRanges sorts the given key ranges and merges the ranges that overlap or are adjacent, so that the deleted
+// prefixes of consecutive epochs are compacted at once.
+func mergeKeyRanges(keyRanges []keyRange) []keyRange {
+	sort.Slice(keyRanges, func(i, j int) bool {
+		return bytes.Compare(keyRanges[i].start, keyRanges[j].start) < 0
+	})
+
+	merged := make([]keyRange, 0, len(keyRanges))
+	for _, currentRange := range keyRanges {
+		if lastIndex := len(merged) - 1; lastIndex >= 0 && bytes.Compare(currentRange.start, merged[lastIndex].end) <= 0 {
+			if bytes.Compare(currentRange.end, merged[lastIndex].end) > 0 {
+				merged[lastIndex].end = currentRange.end
+			}
+
+			continue
+		}
+
+		merged = append(merged, currentRange)
+	}
+
+	return merged
+}
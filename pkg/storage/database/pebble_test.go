@@ -0,0 +1,289 @@
+package database
+
+import (
+	"encoding/binary"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/kvstore"
+	hivedb "github.com/iotaledger/hive.go/kvstore/database"
+)
+
+func newTestStore(t testing.TB, engine hivedb.Engine) kvstore.KVStore {
+	store, err := StoreWithDefaultSettings(t.TempDir(), true, engine)
+	require.NoError(t, err)
+
+	t.Cleanup(func() { require.NoError(t, store.Close()) })
+
+	return store
+}
+
+func iteratedKeys(t *testing.T, store kvstore.KVStore, prefix kvstore.KeyPrefix, direction ...kvstore.IterDirection) []string {
+	keys := make([]string, 0)
+	require.NoError(t, store.IterateKeys(prefix, func(key kvstore.Key) bool {
+		keys = append(keys, string(key))
+
+		return true
+	}, direction...))
+
+	return keys
+}
+
+func TestPebbleStore(t *testing.T) {
+	store := newTestStore(t, EnginePebble)
+
+	realmA, err := store.WithRealm([]byte("A"))
+	require.NoError(t, err)
+	realmB, err := store.WithRealm([]byte("B"))
+	require.NoError(t, err)
+
+	for _, key := range []string{"a1", "a2", "b1", "b2", "c1"} {
+		require.NoError(t, realmA.Set([]byte(key), []byte("value"+key)))
+	}
+	require.NoError(t, realmB.Set([]byte("a1"), []byte("other")))
+
+	value, err := realmA.Get([]byte("a1"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("valuea1"), value)
+
+	_, err = realmA.Get([]byte("x"))
+	require.ErrorIs(t, err, kvstore.ErrKeyNotFound)
+
+	has, err := realmB.Has([]byte("a1"))
+	require.NoError(t, err)
+	require.True(t, has)
+
+	has, err = realmB.Has([]byte("a2"))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	require.Equal(t, []string{"a1", "a2", "b1", "b2", "c1"}, iteratedKeys(t, realmA, kvstore.EmptyPrefix))
+	require.Equal(t, []string{"b2", "b1"}, iteratedKeys(t, realmA, []byte("b"), kvstore.IterDirectionBackward))
+
+	require.NoError(t, realmA.DeletePrefix([]byte("a")))
+	require.Equal(t, []string{"b1", "b2", "c1"}, iteratedKeys(t, realmA, kvstore.EmptyPrefix))
+
+	require.NoError(t, realmA.Delete([]byte("c1")))
+	require.Equal(t, []string{"b1", "b2"}, iteratedKeys(t, realmA, kvstore.EmptyPrefix))
+
+	batch, err := realmA.Batched()
+	require.NoError(t, err)
+	require.NoError(t, batch.Set([]byte("d1"), []byte("valued1")))
+	require.NoError(t, batch.Delete([]byte("b1")))
+	require.Equal(t, []string{"b1", "b2"}, iteratedKeys(t, realmA, kvstore.EmptyPrefix))
+	require.NoError(t, batch.Commit())
+	require.Equal(t, []string{"b2", "d1"}, iteratedKeys(t, realmA, kvstore.EmptyPrefix))
+
+	require.NoError(t, realmA.Clear())
+	require.Empty(t, iteratedKeys(t, realmA, kvstore.EmptyPrefix))
+	require.Equal(t, []string{"a1"}, iteratedKeys(t, realmB, kvstore.EmptyPrefix))
+
+	// the store without a realm can not be cleared with a range deletion, as the empty prefix has no upper bound.
+	require.NoError(t, store.Clear())
+	require.Empty(t, iteratedKeys(t, store, kvstore.EmptyPrefix))
+}
+
+func TestMigrateDirectory(t *testing.T) {
+	for _, engine := range testEngines {
+		t.Run(string(engine), func(t *testing.T) {
+			sourceDirectory, targetDirectory := t.TempDir(), filepath.Join(t.TempDir(), "target")
+
+			for _, databasePath := range []string{"permanent", filepath.Join("prunable", "1")} {
+				source, err := StoreWithDefaultSettings(filepath.Join(sourceDirectory, databasePath), true, engine)
+				require.NoError(t, err)
+
+				for i := 0; i < 1000; i++ {
+					require.NoError(t, source.Set([]byte(fmt.Sprintf("%s%04d", databasePath, i)), []byte{byte(i)}))
+				}
+				require.NoError(t, source.Close())
+			}
+			require.NoError(t, os.WriteFile(filepath.Join(sourceDirectory, "prunable", "info"), []byte("info"), 0600))
+
+			migratedDatabases, err := MigrateDirectory(sourceDirectory, targetDirectory, EnginePebble)
+			require.NoError(t, err)
+			require.ElementsMatch(t, []string{"permanent", filepath.Join("prunable", "1")}, migratedDatabases)
+
+			for _, databasePath := range migratedDatabases {
+				target, err := StoreWithDefaultSettings(filepath.Join(targetDirectory, databasePath), false, EnginePebble)
+				require.NoError(t, err)
+
+				keys := iteratedKeys(t, target, kvstore.EmptyPrefix)
+				require.Len(t, keys, 1000)
+				require.Equal(t, databasePath+"0999", keys[999])
+				require.NoError(t, target.Close())
+			}
+
+			info, err := os.ReadFile(filepath.Join(targetDirectory, "prunable", "info"))
+			require.NoError(t, err)
+			require.Equal(t, []byte("info"), info)
+
+			// an existing target is never overwritten.
+			_, err = MigrateDirectory(sourceDirectory, targetDirectory, EnginePebble)
+			require.Error(t, err)
+		})
+	}
+}
+
+func benchmarkKey(i int) []byte {
+	key := make([]byte, 8)
+	binary.BigEndian.PutUint64(key, uint64(i))
+
+	return key
+}
+
+func BenchmarkStore_Set(b *testing.B) {
+	for _, engine := range testEngines {
+		b.Run(string(engine), func(b *testing.B) {
+			store := newTestStore(b, engine)
+			value := make([]byte, 256)
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if err := store.Set(benchmarkKey(i), value); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkStore_Get(b *testing.B) {
+	for _, engine := range testEngines {
+		b.Run(string(engine), func(b *testing.B) {
+			store := newTestStore(b, engine)
+			value := make([]byte, 256)
+
+			for i := 0; i < b.N; i++ {
+				require.NoError(b, store.Set(benchmarkKey(i), value))
+			}
+			require.NoError(b, store.Flush())
+
+			b.ResetTimer()
+			for i := 0; i < b.N; i++ {
+				if _, err := store.Get(benchmarkKey(i)); err != nil {
+					b.Fatal(err)
+				}
+			}
+		})
+	}
+}
+
+func BenchmarkStore_BatchedSet(b *testing.B) {
+	for _, engine := range testEngines {
+		b.Run(string(engine), func(b *testing.B) {
+			store := newTestStore(b, engine)
+			value := make([]byte, 256)
+
+			b.ResetTimer()
+			batch, err := store.Batched()
+			require.NoError(b, err)
+			for i := 0; i < b.N; i++ {
+				require.NoError(b, batch.Set(benchmarkKey(i), value))
+			}
+			require.NoError(b, batch.Commit())
+		})
+	}
+}
+
+func BenchmarkStore_Iterate(b *testing.B) {
+	for _, engine := range testEngines {
+		b.Run(string(engine), func(b *testing.B) {
+			store := newTestStore(b, engine)
+			value := make([]byte, 256)
+
+			for i := 0; i < b.N; i++ {
+				require.NoError(b, store.Set(benchmarkKey(i), value))
+			}
+			require.NoError(b, store.Flush())
+
+			b.ResetTimer()
+			require.NoError(b, store.Iterate(kvstore.EmptyPrefix, func(_ kvstore.Key, _ kvstore.Value) bool {
+				return true
+			}))
+		})
+	}
+}
+
+func TestDBInstanceReadOnly(t *testing.T) {
+	dbConfig := Config{
+		Engine:       EnginePebble,
+		Directory:    t.TempDir(),
+		Version:      1,
+		PrefixHealth: []byte{255},
+	}
+
+	writableInstance := NewDBInstance(dbConfig, nil)
+	require.NoError(t, writableInstance.KVStore().Set([]byte("key"), []byte("value")))
+	writableInstance.Shutdown()
+
+	dbConfig.ReadOnly = true
+	readOnlyInstance := NewDBInstance(dbConfig, nil)
+	defer readOnlyInstance.Shutdown()
+
+	value, err := readOnlyInstance.KVStore().Get([]byte("key"))
+	require.NoError(t, err)
+	require.Equal(t, []byte("value"), value)
+
+	require.Error(t, readOnlyInstance.KVStore().Set([]byte("key"), []byte("other")))
+
+	// a read-only instance can not create a database.
+	_, err = StoreReadOnlyWithDefaultSettings(t.TempDir(), EnginePebble)
+	require.Error(t, err)
+}
+
+func TestPebbleStoreTombstoneCompaction(t *testing.T) {
+	store, err := StoreWithSettings(t.TempDir(), true, EnginePebble, Settings{
+		BloomFilterBitsPerKey:        10,
+		TombstoneCompactionThreshold: 2,
+	})
+	require.NoError(t, err)
+	defer func() { require.NoError(t, store.Close()) }()
+
+	//nolint:forcetypeassert // the pebble engine always creates a pebbleStore
+	compactor := store.(*pebbleStore).compactor
+	require.NotNil(t, compactor)
+
+	for epoch := byte(0); epoch < 3; epoch++ {
+		for i := 0; i < 100; i++ {
+			require.NoError(t, store.Set([]byte{epoch, byte(i)}, []byte("value")))
+		}
+	}
+	require.NoError(t, store.Flush())
+
+	require.NoError(t, store.DeletePrefix([]byte{0}))
+	require.Len(t, compactor.pendingRanges, 1)
+
+	// reaching the threshold starts the compaction of the pending ranges.
+	require.NoError(t, store.DeletePrefix([]byte{1}))
+	require.Empty(t, compactor.pendingRanges)
+	compactor.runningCompactions.Wait()
+
+	require.Empty(t, iteratedKeys(t, store, []byte{0}))
+	require.Empty(t, iteratedKeys(t, store, []byte{1}))
+	require.Len(t, iteratedKeys(t, store, []byte{2}), 100)
+
+	has, err := store.Has([]byte{2, 0})
+	require.NoError(t, err)
+	require.True(t, has)
+}
+
+func TestRocksDBTombstoneCompactionNotSupported(t *testing.T) {
+	_, err := StoreWithSettings(t.TempDir(), true, hivedb.EngineRocksDB, Settings{TombstoneCompactionThreshold: 2})
+	require.ErrorIs(t, err, ErrTombstoneCompactionNotSupported)
+}
+
+func TestMergeKeyRanges(t *testing.T) {
+	require.Equal(t, []keyRange{
+		{start: []byte{1}, end: []byte{4}},
+		{start: []byte{6}, end: []byte{7}},
+	}, mergeKeyRanges([]keyRange{
+		{start: []byte{6}, end: []byte{7}},
+		{start: []byte{2}, end: []byte{3}},
+		{start: []byte{1}, end: []byte{2}},
+		{start: []byte{3}, end: []byte{4}},
+	}))
+}
@@ -1,23 +1,37 @@
 package database
 
 import (
+	"fmt"
 	"runtime"
 
 	"github.com/iotaledger/hive.go/kvstore/rocksdb"
 )
 
 // NewRocksDB creates a new RocksDB instance.
-func NewRocksDB(path string) (*rocksdb.RocksDB, error) {
+func NewRocksDB(path string, settings Settings) (*rocksdb.RocksDB, error) {
+	return rocksdb.CreateDB(path, rocksDBOptions(settings)...)
+}
 
-	opts := []rocksdb.Option{
-		rocksdb.IncreaseParallelism(runtime.NumCPU() - 1),
-		rocksdb.Custom([]string{
-			"periodic_compaction_seconds=43200",
-			"level_compaction_dynamic_level_bytes=true",
-			"keep_log_file_num=2",
-			"max_log_file_size=50000000", // 50MB per log file
-		}),
+// NewRocksDBReadOnly opens an existing RocksDB instance in read-only mode.
+func NewRocksDBReadOnly(path string, settings Settings) (*rocksdb.RocksDB, error) {
+	return rocksdb.OpenDBReadOnly(path, rocksDBOptions(settings)...)
+}
+
+func rocksDBOptions(settings Settings) []rocksdb.Option {
+	customOptions := []string{
+		"periodic_compaction_seconds=43200",
+		"level_compaction_dynamic_level_bytes=true",
+		"keep_log_file_num=2",
+		"max_log_file_size=50000000", // 50MB per log file
 	}
 
-	return rocksdb.CreateDB(path, opts...)
+	// the deleted prefixes are dropped by the periodic compactions, as the wrapper does not expose manual compactions.
+	if settings.BloomFilterBitsPerKey > 0 {
+		customOptions = append(customOptions, fmt.Sprintf("block_based_table_factory={filter_policy=bloomfilter:%d:false}", settings.BloomFilterBitsPerKey))
+	}
+
+	return []rocksdb.Option{
+		rocksdb.IncreaseParallelism(runtime.NumCPU() - 1),
+		rocksdb.Custom(customOptions),
+	}
 }
@@ -0,0 +1,123 @@
+package database
+
+import (
+	"sort"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/lo"
+)
+
+// ErrDatabaseNotMigratable is returned if the content of a database can not be migrated to the configured version.
+var ErrDatabaseNotMigratable = ierrors.New("database can not be migrated")
+
+// VersionMigration migrates the content of a database from the previous version to the version it is registered for.
+type VersionMigration func(store kvstore.KVStore) error
+
+// VersionMigrations contains the migrations of a database keyed by the version they migrate to. Versions without a
+// registered migration do not change the content of the database.
+type VersionMigrations map[byte]VersionMigration
+
+// Run executes the migrations that are required to migrate the content of the store from oldVersion to newVersion.
+func (v VersionMigrations) Run(store kvstore.KVStore, oldVersion byte, newVersion byte) error {
+	if oldVersion > newVersion {
+		return ierrors.Wrapf(ErrDatabaseNotMigratable, "downgrade from version %d to %d is not supported", oldVersion, newVersion)
+	}
+
+	versions := lo.Keys(v)
+	sort.Slice(versions, func(i, j int) bool { return versions[i] < versions[j] })
+
+	for _, version := range versions {
+		if version <= oldVersion || version > newVersion {
+			continue
+		}
+
+		if err := v[version](store); err != nil {
+			return ierrors.Wrapf(err, "failed to migrate database to version %d", version)
+		}
+	}
+
+	return nil
+}
+
+// MigrateValues replaces the values of the entries with the given prefix that are selected by the filter with the
+// result of the migration function.
+func MigrateValues(store kvstore.KVStore, prefix kvstore.KeyPrefix, filter func(key kvstore.Key) bool, migrateValue func(value kvstore.Value) (kvstore.Value, error)) error {
+	batch, err := store.Batched()
+	if err != nil {
+		return ierrors.Wrap(err, "failed to create batch")
+	}
+
+	var batchSize int
+	var innerErr error
+	if err := store.Iterate(prefix, func(key kvstore.Key, value kvstore.Value) bool {
+		if !filter(key) {
+			return true
+		}
+
+		migratedValue, err := migrateValue(value)
+		if err != nil {
+			innerErr = ierrors.Wrapf(err, "failed to migrate value of key %X", key)
+
+			return false
+		}
+
+		if innerErr = batch.Set(key, migratedValue); innerErr != nil {
+			return false
+		}
+
+		if batchSize++; batchSize >= migrationBatchSize {
+			if innerErr = batch.Commit(); innerErr != nil {
+				return false
+			}
+
+			if batch, innerErr = store.Batched(); innerErr != nil {
+				return false
+			}
+			batchSize = 0
+		}
+
+		return true
+	}); err != nil {
+		innerErr = ierrors.Join(innerErr, err)
+	}
+
+	if innerErr != nil {
+		if batch != nil {
+			batch.Cancel()
+		}
+
+		return innerErr
+	}
+
+	return batch.Commit()
+}
+
+// migrate migrates the content of the database to the configured version if it was created with an older version.
+func migrate(healthTracker *kvstore.StoreHealthTracker, dbConfig Config) error {
+	if dbConfig.Migrations == nil || dbConfig.Version == kvstore.StoreVersionNone {
+		return nil
+	}
+
+	if upToDate, err := healthTracker.CheckCorrectStoreVersion(); err != nil {
+		return ierrors.Wrap(err, "failed to check database version")
+	} else if upToDate {
+		return nil
+	}
+
+	// the migrations change the content of the database in place, so we only migrate databases that were shut down
+	// cleanly (and mark them as corrupted until they are shut down again) to never migrate partially migrated data.
+	if corrupted, err := healthTracker.IsCorrupted(); err != nil {
+		return ierrors.Wrap(err, "failed to check database health")
+	} else if corrupted {
+		return ierrors.Wrap(ErrDatabaseNotMigratable, "database was not shut down cleanly")
+	}
+
+	if err := healthTracker.MarkCorrupted(); err != nil {
+		return ierrors.Wrap(err, "failed to mark database as corrupted")
+	}
+
+	_, err := healthTracker.UpdateStoreVersion()
+
+	return err
+}
@@ -0,0 +1,105 @@
+package database
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/kvstore"
+)
+
+var testPrefixHealth = []byte{255}
+
+func TestVersionMigrations_Run(t *testing.T) {
+	var executedMigrations []byte
+	migrations := VersionMigrations{
+		4: func(kvstore.KVStore) error { executedMigrations = append(executedMigrations, 4); return nil },
+		2: func(kvstore.KVStore) error { executedMigrations = append(executedMigrations, 2); return nil },
+		3: func(kvstore.KVStore) error { executedMigrations = append(executedMigrations, 3); return nil },
+	}
+
+	require.NoError(t, migrations.Run(nil, 1, 3))
+	require.Equal(t, []byte{2, 3}, executedMigrations)
+
+	executedMigrations = nil
+	require.NoError(t, migrations.Run(nil, 3, 5))
+	require.Equal(t, []byte{4}, executedMigrations)
+
+	require.ErrorIs(t, migrations.Run(nil, 3, 2), ErrDatabaseNotMigratable)
+}
+
+func TestNewDBInstance_Migrations(t *testing.T) {
+	for _, engine := range testEngines {
+		t.Run(string(engine), func(t *testing.T) {
+			dbConfig := Config{
+				Engine:       engine,
+				Directory:    t.TempDir(),
+				Version:      1,
+				PrefixHealth: testPrefixHealth,
+				Migrations:   VersionMigrations{},
+			}
+
+			db := NewDBInstance(dbConfig, nil)
+			require.NoError(t, db.KVStore().Set([]byte("migrate"), []byte("value")))
+			require.NoError(t, db.KVStore().Set([]byte("keep"), []byte("value")))
+			db.Shutdown()
+
+			dbConfig.Version = 2
+			dbConfig.Migrations = VersionMigrations{
+				2: func(store kvstore.KVStore) error {
+					return MigrateValues(store, []byte("migrate"), func(kvstore.Key) bool { return true }, func(value kvstore.Value) (kvstore.Value, error) {
+						return append([]byte("migrated-"), value...), nil
+					})
+				},
+			}
+
+			db = NewDBInstance(dbConfig, nil)
+			migratedValue, err := db.KVStore().Get([]byte("migrate"))
+			require.NoError(t, err)
+			require.Equal(t, []byte("migrated-value"), migratedValue)
+
+			keptValue, err := db.KVStore().Get([]byte("keep"))
+			require.NoError(t, err)
+			require.Equal(t, []byte("value"), keptValue)
+
+			version, err := db.healthTracker.StoreVersion()
+			require.NoError(t, err)
+			require.EqualValues(t, 2, version)
+			db.Shutdown()
+
+			// the migration is not executed again once the database was migrated.
+			db = NewDBInstance(dbConfig, nil)
+			migratedValue, err = db.KVStore().Get([]byte("migrate"))
+			require.NoError(t, err)
+			require.Equal(t, []byte("migrated-value"), migratedValue)
+			db.Shutdown()
+		})
+	}
+}
+
+func TestNewDBInstance_MigrationOfCorruptedDatabase(t *testing.T) {
+	for _, engine := range testEngines {
+		t.Run(string(engine), func(t *testing.T) {
+			dbConfig := Config{
+				Engine:       engine,
+				Directory:    t.TempDir(),
+				Version:      1,
+				PrefixHealth: testPrefixHealth,
+				Migrations:   VersionMigrations{},
+			}
+
+			NewDBInstance(dbConfig, nil).Shutdown()
+
+			// simulate a node that was not shut down cleanly.
+			store, err := StoreWithDefaultSettings(dbConfig.Directory, false, engine)
+			require.NoError(t, err)
+			healthTracker, err := kvstore.NewStoreHealthTracker(store, testPrefixHealth, kvstore.StoreVersionNone, nil)
+			require.NoError(t, err)
+			require.NoError(t, healthTracker.MarkCorrupted())
+			require.NoError(t, store.Close())
+
+			dbConfig.Version = 2
+			require.Panics(t, func() { NewDBInstance(dbConfig, nil) })
+		})
+	}
+}
@@ -5,6 +5,7 @@ import (
 
 	hivedb "github.com/iotaledger/hive.go/kvstore/database"
 	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/iota-core/pkg/storage/database"
 	"github.com/iotaledger/iota-core/pkg/storage/permanent"
 	"github.com/iotaledger/iota-core/pkg/storage/prunable"
 	iotago "github.com/iotaledger/iota.go/v4"
@@ -22,6 +23,29 @@ func WithAllowedDBEngines(optsAllowedDBEngines []hivedb.Engine) options.Option[S
 	}
 }
 
+// WithReadOnly opens the databases of the storage in read-only mode, which allows to inspect the databases of a node
+// without modifying them.
+func WithReadOnly(readOnly bool) options.Option[Storage] {
+	return func(s *Storage) {
+		s.optsReadOnly = readOnly
+	}
+}
+
+// WithPermanentDBSettings sets the engine settings of the permanent database.
+func WithPermanentDBSettings(settings database.Settings) options.Option[Storage] {
+	return func(s *Storage) {
+		s.optsPermanentDBSettings = settings
+	}
+}
+
+// WithPrunableDBSettings sets the engine settings of the prunable databases (the buckets of the epochs and the
+// semi-permanent database).
+func WithPrunableDBSettings(settings database.Settings) options.Option[Storage] {
+	return func(s *Storage) {
+		s.optsPrunableDBSettings = settings
+	}
+}
+
 func WithBucketManagerOptions(opts ...options.Option[prunable.BucketManager]) options.Option[Storage] {
 	return func(s *Storage) {
 		s.optsBucketManagerOptions = append(s.optsBucketManagerOptions, opts...)
@@ -34,6 +58,22 @@ func WithPruningDelay(optsPruningDelay iotago.EpochIndex) options.Option[Storage
 	}
 }
 
+// WithPruningDelayOverride overrides the pruning delay for the given store type. Buckets are only deleted once the store
+// with the longest retention window can be pruned, while stores with a shorter retention window are cleared earlier.
+func WithPruningDelayOverride(storeType prunable.StoreType, pruningDelay iotago.EpochIndex) options.Option[Storage] {
+	return func(s *Storage) {
+		s.optsPruningDelayOverrides[storeType] = pruningDelay
+	}
+}
+
+// WithForkRetainedEpochs sets the number of epochs before the forking point whose prunable data is cloned when forking
+// the storage for a candidate engine (0 = all epochs are cloned).
+func WithForkRetainedEpochs(epochs iotago.EpochIndex) options.Option[Storage] {
+	return func(s *Storage) {
+		s.optsForkRetainedEpochs = epochs
+	}
+}
+
 func WithPruningSizeEnable(pruningSizeEnabled bool) options.Option[Storage] {
 	return func(p *Storage) {
 		p.optPruningSizeEnabled = pruningSizeEnabled
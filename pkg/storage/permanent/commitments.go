@@ -27,8 +27,8 @@ func NewCommitments(store kvstore.KVStore, apiProvider iotago.APIProvider) *Comm
 		store: kvstore.NewTypedStore(store,
 			iotago.SlotIndex.Bytes,
 			iotago.SlotIndexFromBytes,
-			(*model.Commitment).Bytes,
-			model.CommitmentFromBytes(apiProvider),
+			(*model.Commitment).StorageBytes,
+			model.CommitmentFromStorageBytes(apiProvider),
 		),
 	}
 }
@@ -37,6 +37,16 @@ func (c *Commitments) Store(commitment *model.Commitment) error {
 	return c.store.Set(commitment.Commitment().Slot, commitment)
 }
 
+// storeBatched adds the mutation that stores the given commitment to the given batch.
+func (c *Commitments) storeBatched(batch kvstore.BatchedMutations, commitment *model.Commitment) error {
+	commitmentBytes, err := commitment.StorageBytes()
+	if err != nil {
+		return ierrors.Wrapf(err, "failed to serialize commitment %s", commitment.ID())
+	}
+
+	return batch.Set(lo.PanicOnErr(commitment.Slot().Bytes()), commitmentBytes)
+}
+
 func (c *Commitments) Load(slot iotago.SlotIndex) (commitment *model.Commitment, err error) {
 	genesisSlot := c.apiProvider.CommittedAPI().ProtocolParameters().GenesisSlot()
 	if slot < genesisSlot {
@@ -50,12 +60,12 @@ func (c *Commitments) Export(writer io.WriteSeeker, targetSlot iotago.SlotIndex)
 	if err := stream.WriteCollection(writer, serializer.SeriLengthPrefixTypeAsUint32, func() (elementsCount int, err error) {
 		var count int
 		for slot := c.apiProvider.CommittedAPI().ProtocolParameters().GenesisSlot(); slot <= targetSlot; slot++ {
-			commitmentBytes, err := c.store.KVStore().Get(lo.PanicOnErr(slot.Bytes()))
+			commitment, err := c.store.Get(slot)
 			if err != nil {
 				return 0, ierrors.Wrapf(err, "failed to load commitment for slot %d", slot)
 			}
 
-			if err := stream.WriteBytesWithSize(writer, commitmentBytes, serializer.SeriLengthPrefixTypeAsUint16); err != nil {
+			if err := stream.WriteBytesWithSize(writer, commitment.Data(), serializer.SeriLengthPrefixTypeAsUint16); err != nil {
 				return 0, ierrors.Wrapf(err, "failed to write commitment for slot %d", slot)
 			}
 
@@ -0,0 +1,25 @@
+package permanent
+
+import (
+	"bytes"
+
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/storage/database"
+)
+
+// migrations contains the migrations of the permanent database keyed by the database version they migrate to.
+var migrations = database.VersionMigrations{
+	2: migrateVersionedCommitments,
+}
+
+// migrateVersionedCommitments converts the stored commitments to the versioned storage format.
+func migrateVersionedCommitments(store kvstore.KVStore) error {
+	if err := database.MigrateValues(store, kvstore.KeyPrefix{commitmentsPrefix}, func(kvstore.Key) bool { return true }, model.StorageBytesFromUnversioned); err != nil {
+		return err
+	}
+
+	settingsLatestCommitmentKey := kvstore.Key{settingsPrefix, latestCommitmentKey}
+
+	return database.MigrateValues(store, settingsLatestCommitmentKey, func(key kvstore.Key) bool { return bytes.Equal(key, settingsLatestCommitmentKey) }, model.StorageBytesFromUnversioned)
+}
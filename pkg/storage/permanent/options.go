@@ -2,6 +2,7 @@ package permanent
 
 import (
 	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/utxoledger"
 	iotago "github.com/iotaledger/iota.go/v4"
 )
 
@@ -10,3 +11,16 @@ func WithEpochBasedProviderOptions(opts ...options.Option[iotago.EpochBasedProvi
 		p.optsEpochBasedProvider = append(p.optsEpochBasedProvider, opts...)
 	}
 }
+
+func WithUTXOLedgerOptions(opts ...options.Option[utxoledger.Manager]) options.Option[Permanent] {
+	return func(p *Permanent) {
+		p.optsUTXOLedger = append(p.optsUTXOLedger, opts...)
+	}
+}
+
+// WithOutputIndexEnabled defines whether the unspent outputs are indexed by the addresses they reference.
+func WithOutputIndexEnabled(enabled bool) options.Option[Permanent] {
+	return func(p *Permanent) {
+		p.optsOutputIndexEnabled = enabled
+	}
+}
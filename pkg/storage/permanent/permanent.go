@@ -8,7 +8,9 @@ import (
 	"github.com/iotaledger/hive.go/lo"
 	"github.com/iotaledger/hive.go/runtime/ioutils"
 	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/iota-core/pkg/model"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/utxoledger"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/utxoledger/indexer"
 	"github.com/iotaledger/iota-core/pkg/storage/database"
 	iotago "github.com/iotaledger/iota.go/v4"
 )
@@ -28,10 +30,13 @@ type Permanent struct {
 	settings    *Settings
 	commitments *Commitments
 
-	utxoLedger *utxoledger.Manager
-	accounts   kvstore.KVStore
+	utxoLedger    *utxoledger.Manager
+	outputIndexer *indexer.Indexer
+	accounts      kvstore.KVStore
 
 	optsEpochBasedProvider []options.Option[iotago.EpochBasedProvider]
+	optsUTXOLedger         []options.Option[utxoledger.Manager]
+	optsOutputIndexEnabled bool
 }
 
 // New returns a new permanent storage instance.
@@ -41,11 +46,15 @@ func New(dbConfig database.Config, errorHandler func(error), opts ...options.Opt
 		dbConfig:     dbConfig,
 	}, opts, func(p *Permanent) {
 		// openedCallback is nil because we don't need to do anything upon reopening
-		p.store = database.NewDBInstance(p.dbConfig, nil)
+		p.store = database.NewDBInstance(p.dbConfig.WithMigrations(migrations), nil)
 		p.settings = NewSettings(lo.PanicOnErr(p.store.KVStore().WithExtendedRealm(kvstore.Realm{settingsPrefix})), p.optsEpochBasedProvider...)
 		p.commitments = NewCommitments(lo.PanicOnErr(p.store.KVStore().WithExtendedRealm(kvstore.Realm{commitmentsPrefix})), p.settings.APIProvider())
-		p.utxoLedger = utxoledger.New(lo.PanicOnErr(p.store.KVStore().WithExtendedRealm(kvstore.Realm{ledgerPrefix})), p.settings.APIProvider())
+		p.utxoLedger = utxoledger.New(lo.PanicOnErr(p.store.KVStore().WithExtendedRealm(kvstore.Realm{ledgerPrefix})), p.settings.APIProvider(), p.optsUTXOLedger...)
 		p.accounts = lo.PanicOnErr(p.store.KVStore().WithExtendedRealm(kvstore.Realm{accountsPrefix}))
+
+		if p.optsOutputIndexEnabled {
+			p.outputIndexer = lo.PanicOnErr(indexer.New(p.utxoLedger))
+		}
 	})
 }
 
@@ -70,6 +79,36 @@ func (p *Permanent) Commitments() *Commitments {
 	return p.commitments
 }
 
+// StoreLatestCommitment stores the given commitment and sets it as the latest commitment of the settings in a single
+// batch, so that a crash can not leave the commitment storage and the settings out of sync.
+func (p *Permanent) StoreLatestCommitment(commitment *model.Commitment) error {
+	batch, err := p.store.KVStore().Batched()
+	if err != nil {
+		return ierrors.Wrap(err, "failed to create batch")
+	}
+
+	if err = p.commitments.storeBatched(newRealmBatch(batch, kvstore.Realm{commitmentsPrefix}), commitment); err != nil {
+		batch.Cancel()
+
+		return ierrors.Wrapf(err, "failed to store commitment %s", commitment.ID())
+	}
+
+	onCommitted, err := p.settings.setLatestCommitmentBatched(newRealmBatch(batch, kvstore.Realm{settingsPrefix}), commitment)
+	if err != nil {
+		batch.Cancel()
+
+		return ierrors.Wrapf(err, "failed to set latest commitment %s", commitment.ID())
+	}
+
+	if err = batch.Commit(); err != nil {
+		return ierrors.Wrapf(err, "failed to commit batch for commitment %s", commitment.ID())
+	}
+
+	onCommitted()
+
+	return nil
+}
+
 // Accounts returns the Accounts storage (or a specialized sub-storage if a realm is provided).
 func (p *Permanent) Accounts(optRealm ...byte) kvstore.KVStore {
 	if len(optRealm) == 0 {
@@ -83,6 +122,11 @@ func (p *Permanent) UTXOLedger() *utxoledger.Manager {
 	return p.utxoLedger
 }
 
+// OutputIndexer returns the index of the unspent outputs by address (nil if the index is disabled).
+func (p *Permanent) OutputIndexer() *indexer.Indexer {
+	return p.outputIndexer
+}
+
 // Size returns the size of the permanent storage.
 func (p *Permanent) Size() int64 {
 	dbSize, err := ioutils.FolderSize(p.dbConfig.Directory)
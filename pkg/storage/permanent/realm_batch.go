@@ -0,0 +1,32 @@
+package permanent
+
+import (
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/serializer/v2/byteutils"
+)
+
+// realmBatch is a kvstore.BatchedMutations that prefixes all keys with a realm, so that the sub-stores of the permanent
+// storage can add their mutations to a batch of the underlying store.
+type realmBatch struct {
+	kvstore.BatchedMutations
+
+	realm kvstore.Realm
+}
+
+// newRealmBatch creates a new realmBatch that adds the mutations to the given batch.
+func newRealmBatch(batch kvstore.BatchedMutations, realm kvstore.Realm) *realmBatch {
+	return &realmBatch{
+		BatchedMutations: batch,
+		realm:            realm,
+	}
+}
+
+// Set sets the given key and value.
+func (r *realmBatch) Set(key kvstore.Key, value kvstore.Value) error {
+	return r.BatchedMutations.Set(byteutils.ConcatBytes(r.realm, key), value)
+}
+
+// Delete deletes the entry for the given key.
+func (r *realmBatch) Delete(key kvstore.Key) error {
+	return r.BatchedMutations.Delete(byteutils.ConcatBytes(r.realm, key))
+}
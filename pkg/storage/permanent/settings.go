@@ -3,6 +3,7 @@ package permanent
 import (
 	"fmt"
 	"io"
+	"sync/atomic"
 
 	"github.com/iotaledger/hive.go/ds/types"
 	"github.com/iotaledger/hive.go/ierrors"
@@ -34,6 +35,7 @@ type Settings struct {
 	store                            kvstore.KVStore
 	storeSnapshotImported            *kvstore.TypedValue[bool]
 	storeLatestCommitment            *kvstore.TypedValue[*model.Commitment]
+	latestCommitmentCache            atomic.Pointer[model.Commitment]
 	storeLatestNonEmptySlot          *kvstore.TypedValue[iotago.SlotIndex]
 	storeLatestFinalizedSlot         *kvstore.TypedValue[iotago.SlotIndex]
 	storeLatestStoredSlot            *kvstore.TypedValue[iotago.SlotIndex]
@@ -70,8 +72,8 @@ func NewSettings(store kvstore.KVStore, opts ...options.Option[iotago.EpochBased
 		storeLatestCommitment: kvstore.NewTypedValue(
 			store,
 			[]byte{latestCommitmentKey},
-			(*model.Commitment).Bytes,
-			model.CommitmentFromBytes(apiProvider),
+			(*model.Commitment).StorageBytes,
+			model.CommitmentFromStorageBytes(apiProvider),
 		),
 		storeLatestFinalizedSlot: kvstore.NewTypedValue(
 			store,
@@ -188,6 +190,96 @@ func (s *Settings) APIProvider() *iotago.EpochBasedProvider {
 	return s.apiProvider
 }
 
+// CheckProtocolParameters checks that the protocol version epoch mapping, the stored protocol parameters and the hashes
+// of the future protocol parameters resolve to the same protocol parameters for every epoch and that the latest
+// commitment uses the protocol version that is active in its slot. All inconsistencies are reported at once, so that a
+// node with diverging parameters fails at startup instead of producing diverging commitments later.
+func (s *Settings) CheckProtocolParameters() error {
+	s.mutex.RLock()
+	defer s.mutex.RUnlock()
+
+	protocolEpochVersions := s.apiProvider.ProtocolEpochVersions()
+	if len(protocolEpochVersions) == 0 {
+		return ierrors.New("no protocol version is mapped to an epoch")
+	}
+
+	inconsistencies := make([]error, 0)
+	addInconsistency := func(format string, args ...any) {
+		inconsistencies = append(inconsistencies, ierrors.Errorf(format, args...))
+	}
+
+	latestCommitment := s.latestCommitment()
+	committedEpoch := s.apiProvider.CommittedAPI().TimeProvider().EpochFromSlot(latestCommitment.Slot())
+
+	var genesisProtocolParams iotago.ProtocolParameters
+	for i, protocolEpochVersion := range protocolEpochVersions {
+		version, startEpoch := protocolEpochVersion.Version, protocolEpochVersion.StartEpoch
+
+		if i > 0 && startEpoch <= protocolEpochVersions[i-1].StartEpoch {
+			addInconsistency("protocol version %d starts at epoch %d, which is not after the start epoch %d of the previous protocol version %d", version, startEpoch, protocolEpochVersions[i-1].StartEpoch, protocolEpochVersions[i-1].Version)
+		}
+
+		storedStartEpoch, err := s.storeProtocolVersionEpochMapping.Get(version)
+		if err != nil {
+			addInconsistency("protocol version %d starts at epoch %d, but its epoch mapping is not stored: %s", version, startEpoch, err)
+		} else if storedStartEpoch != startEpoch {
+			addInconsistency("protocol version %d starts at epoch %d, but the stored epoch mapping starts it at epoch %d", version, startEpoch, storedStartEpoch)
+		}
+
+		futureProtocolParams, err := s.storeFutureProtocolParameters.Get(version)
+		if err != nil && !ierrors.Is(err, kvstore.ErrKeyNotFound) {
+			return ierrors.Wrapf(err, "failed to load future protocol parameters of version %d", version)
+		} else if err == nil && futureProtocolParams.A != startEpoch {
+			addInconsistency("protocol version %d starts at epoch %d, but it was decided to start at epoch %d", version, startEpoch, futureProtocolParams.A)
+		}
+
+		protocolParams := s.apiProvider.ProtocolParameters(version)
+		if protocolParams == nil {
+			if startEpoch <= committedEpoch {
+				addInconsistency("protocol version %d is active since epoch %d, but its protocol parameters are unknown", version, startEpoch)
+			} else if err != nil {
+				addInconsistency("protocol version %d starts at epoch %d, but neither its protocol parameters nor their hash are known", version, startEpoch)
+			}
+
+			continue
+		}
+
+		if protocolParams.Version() != version {
+			addInconsistency("protocol parameters of version %d are stored for protocol version %d", protocolParams.Version(), version)
+		}
+
+		protocolParamsHash, err := protocolParams.Hash()
+		if err != nil {
+			return ierrors.Wrapf(err, "failed to hash protocol parameters of version %d", version)
+		} else if futureProtocolParams != nil && futureProtocolParams.B != protocolParamsHash {
+			addInconsistency("protocol parameters of version %d have hash %s, but protocol parameters with hash %s were decided", version, protocolParamsHash, futureProtocolParams.B)
+		}
+
+		// the slots and epochs are converted with the time provider of the latest protocol parameters, so all versions
+		// need to agree on it
+		if genesisProtocolParams == nil {
+			genesisProtocolParams = protocolParams
+		} else if protocolParams.GenesisSlot() != genesisProtocolParams.GenesisSlot() ||
+			protocolParams.GenesisUnixTimestamp() != genesisProtocolParams.GenesisUnixTimestamp() ||
+			protocolParams.SlotDurationInSeconds() != genesisProtocolParams.SlotDurationInSeconds() ||
+			protocolParams.SlotsPerEpochExponent() != genesisProtocolParams.SlotsPerEpochExponent() {
+			addInconsistency("protocol parameters of version %d use a different time provider than the protocol parameters of version %d", version, genesisProtocolParams.Version())
+		}
+	}
+
+	if s.IsSnapshotImported() {
+		if activeVersion := s.apiProvider.VersionForSlot(latestCommitment.Slot()); latestCommitment.Commitment().ProtocolVersion != activeVersion {
+			addInconsistency("latest commitment %s uses protocol version %d, but protocol version %d is active in its slot", latestCommitment.ID(), latestCommitment.Commitment().ProtocolVersion, activeVersion)
+		}
+	}
+
+	if len(inconsistencies) > 0 {
+		return ierrors.Wrapf(ierrors.Join(inconsistencies...), "found %d protocol parameters inconsistencies", len(inconsistencies))
+	}
+
+	return nil
+}
+
 func (s *Settings) StoreProtocolParametersForStartEpoch(params iotago.ProtocolParameters, startEpoch iotago.EpochIndex) error {
 	if err := s.StoreProtocolParameters(params); err != nil {
 		return ierrors.Wrap(err, "failed to store protocol parameters")
@@ -256,15 +348,56 @@ func (s *Settings) LatestCommitment() *model.Commitment {
 }
 
 func (s *Settings) SetLatestCommitment(latestCommitment *model.Commitment) (err error) {
-	s.apiProvider.SetCommittedSlot(latestCommitment.Slot())
+	batch, err := s.store.Batched()
+	if err != nil {
+		return ierrors.Wrap(err, "failed to create batch")
+	}
+
+	onCommitted, err := s.setLatestCommitmentBatched(batch, latestCommitment)
+	if err != nil {
+		batch.Cancel()
+
+		return err
+	}
+
+	if err = batch.Commit(); err != nil {
+		return ierrors.Wrapf(err, "failed to commit latest commitment %s", latestCommitment.ID())
+	}
+
+	onCommitted()
+
+	return nil
+}
+
+// setLatestCommitmentBatched adds the mutations that set the latest commitment to the given batch and returns a function
+// that needs to be called after the batch was committed to update the in-memory state of the settings.
+func (s *Settings) setLatestCommitmentBatched(batch kvstore.BatchedMutations, latestCommitment *model.Commitment) (onCommitted func(), err error) {
+	commitmentBytes, err := latestCommitment.StorageBytes()
+	if err != nil {
+		return nil, ierrors.Wrapf(err, "failed to serialize latest commitment %s", latestCommitment.ID())
+	}
+
+	if err = batch.Set([]byte{latestCommitmentKey}, commitmentBytes); err != nil {
+		return nil, ierrors.Wrap(err, "failed to set latest commitment")
+	}
 
 	// Delete the old future protocol parameters if they exist.
-	_ = s.storeFutureProtocolParameters.Delete(s.apiProvider.VersionForSlot(latestCommitment.Slot()))
+	if err = batch.Delete(byteutils.ConcatBytes([]byte{futureProtocolParametersKey}, lo.PanicOnErr(s.apiProvider.VersionForSlot(latestCommitment.Slot()).Bytes()))); err != nil {
+		return nil, ierrors.Wrap(err, "failed to delete future protocol parameters")
+	}
 
-	return s.storeLatestCommitment.Set(latestCommitment)
+	return func() {
+		s.apiProvider.SetCommittedSlot(latestCommitment.Slot())
+		s.latestCommitmentCache.Store(latestCommitment)
+	}, nil
 }
 
 func (s *Settings) latestCommitment() *model.Commitment {
+	// the latest commitment is written in batches that bypass the typed value, so the cache takes precedence over it.
+	if commitment := s.latestCommitmentCache.Load(); commitment != nil {
+		return commitment
+	}
+
 	commitment, err := s.storeLatestCommitment.Get()
 	if err != nil {
 		if ierrors.Is(err, kvstore.ErrKeyNotFound) {
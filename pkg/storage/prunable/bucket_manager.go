@@ -11,6 +11,7 @@ import (
 	"github.com/iotaledger/hive.go/lo"
 	"github.com/iotaledger/hive.go/runtime/options"
 	"github.com/iotaledger/hive.go/runtime/syncutils"
+	"github.com/iotaledger/hive.go/serializer/v2/byteutils"
 	"github.com/iotaledger/iota-core/pkg/model"
 	"github.com/iotaledger/iota-core/pkg/storage/database"
 	iotago "github.com/iotaledger/iota.go/v4"
@@ -65,6 +66,15 @@ func (b *BucketManager) Get(epoch iotago.EpochIndex, realm kvstore.Realm) (kvsto
 		return nil, ierrors.Wrapf(database.ErrEpochPruned, "epoch %d", epoch)
 	}
 
+	// a read-only bucket manager can not create missing buckets, so they are treated like pruned ones.
+	if b.dbConfig.ReadOnly {
+		if exists, err := PathExists(dbPathFromIndex(b.dbConfig.Directory, epoch)); err != nil {
+			return nil, ierrors.Wrapf(err, "failed to check if bucket for epoch %d exists", epoch)
+		} else if !exists {
+			return nil, ierrors.Wrapf(database.ErrEpochPruned, "bucket for epoch %d does not exist", epoch)
+		}
+	}
+
 	kv := b.getDBInstance(epoch).KVStore()
 
 	return lo.PanicOnErr(kv.WithExtendedRealm(realm)), nil
@@ -194,6 +204,16 @@ func (b *BucketManager) RestoreFromDisk() (lastPrunedEpoch iotago.EpochIndex) {
 	return
 }
 
+// markPruned marks the buckets up to the given epoch as pruned. The bucket of the next epoch is created, so that the
+// same pruned epoch is derived when the buckets are restored from disk.
+func (b *BucketManager) markPruned(epoch iotago.EpochIndex) {
+	b.lastPrunedMutex.Lock()
+	defer b.lastPrunedMutex.Unlock()
+
+	b.lastPrunedEpoch.MarkEvicted(epoch)
+	b.getDBInstance(epoch + 1)
+}
+
 // getDBInstance returns the DB instance for the given epochIndex or creates a new one if it does not yet exist.
 // DBs are created as follows where each db is located in m.basedir/<starting epochIndex>/
 //
@@ -323,3 +343,27 @@ func PathExists(path string) (bool, error) {
 
 	return true, nil
 }
+
+// PruneRealm deletes the data stored under the given realm for all slots in the range [from, to] in the given epoch.
+// Buckets that do not exist (anymore) are skipped without being created.
+func (b *BucketManager) PruneRealm(epoch iotago.EpochIndex, startPruneRange iotago.SlotIndex, endPruneRange iotago.SlotIndex, realm kvstore.Realm) error {
+	if b.IsTooOld(epoch) {
+		return nil
+	}
+
+	if exists, err := PathExists(dbPathFromIndex(b.dbConfig.Directory, epoch)); err != nil {
+		return ierrors.Wrapf(err, "failed to check bucket for epoch %d", epoch)
+	} else if !exists {
+		return nil
+	}
+
+	epochStore := b.getDBInstance(epoch).KVStore()
+
+	for slot := startPruneRange; slot <= endPruneRange; slot++ {
+		if err := epochStore.DeletePrefix(byteutils.ConcatBytes(slot.MustBytes(), realm)); err != nil {
+			return ierrors.Wrapf(err, "error while clearing realm %v of slot %d in bucket for epoch %d", realm, slot, epoch)
+		}
+	}
+
+	return nil
+}
@@ -74,6 +74,43 @@ func (e *EpochKVStore) DeleteEpoch(epoch iotago.EpochIndex) error {
 	return e.kv.DeletePrefix(epoch.MustBytes())
 }
 
+// DeleteRange deletes the entries of the epochs in the given (inclusive) range.
+func (e *EpochKVStore) DeleteRange(from iotago.EpochIndex, to iotago.EpochIndex) error {
+	for epoch := from; epoch <= to && epoch >= from; epoch++ {
+		if err := e.DeleteEpoch(epoch); err != nil {
+			return ierrors.Wrapf(err, "failed to delete epoch %d in realm %v", epoch, e.realm)
+		}
+	}
+
+	return nil
+}
+
+// Count returns the number of entries stored across all epochs.
+func (e *EpochKVStore) Count() (count int, err error) {
+	if err = e.kv.IterateKeys(kvstore.EmptyPrefix, func(_ kvstore.Key) bool {
+		count++
+
+		return true
+	}); err != nil {
+		return 0, ierrors.Wrapf(err, "failed to count entries of epoch store for realm %v", e.realm)
+	}
+
+	return count, nil
+}
+
+// Size returns the accumulated size of the stored keys and values across all epochs in bytes.
+func (e *EpochKVStore) Size() (size int, err error) {
+	if err = e.kv.Iterate(kvstore.EmptyPrefix, func(key kvstore.Key, value kvstore.Value) bool {
+		size += len(key) + len(value)
+
+		return true
+	}); err != nil {
+		return 0, ierrors.Wrapf(err, "failed to determine size of epoch store for realm %v", e.realm)
+	}
+
+	return size, nil
+}
+
 func (e *EpochKVStore) Prune(epoch iotago.EpochIndex, defaultPruningDelay iotago.EpochIndex) error {
 	// The epoch we're trying to prune already takes into account the defaultPruningDelay.
 	// Therefore, we don't need to do anything if it is greater equal e.pruningDelay and take the difference otherwise.
@@ -89,15 +126,15 @@ func (e *EpochKVStore) Prune(epoch iotago.EpochIndex, defaultPruningDelay iotago
 		return nil
 	}
 
-	for i := e.lastPrunedEpoch.NextIndex(); i <= epoch-pruningDelay; i++ {
-		err := e.kv.DeletePrefix(i.MustBytes())
-		if err != nil {
-			return ierrors.Wrapf(err, "failed to prune epoch store for realm %v at epoch %d", e.realm, i)
-		}
-		err = e.lastPrunedEpoch.MarkEvicted(i)
-		if err != nil {
-			return ierrors.Wrapf(err, "failed to store lastPrunedEpoch for epoch %d in Prune", i)
-		}
+	targetEpoch := epoch - pruningDelay
+	if nextEpoch := e.lastPrunedEpoch.NextIndex(); nextEpoch > targetEpoch {
+		return nil
+	} else if err := e.DeleteRange(nextEpoch, targetEpoch); err != nil {
+		return ierrors.Wrapf(err, "failed to prune epoch store for realm %v up to epoch %d", e.realm, targetEpoch)
+	}
+
+	if err := e.lastPrunedEpoch.MarkEvicted(targetEpoch); err != nil {
+		return ierrors.Wrapf(err, "failed to store lastPrunedEpoch for epoch %d in Prune", targetEpoch)
 	}
 
 	return nil
@@ -109,10 +146,8 @@ func (e *EpochKVStore) RollbackEpochs(epoch iotago.EpochIndex) (lastPrunedEpoch
 		return lastAccessedEpoch, ierrors.Wrap(err, "failed to get last accessed epoch")
 	}
 
-	for epochToPrune := epoch; epochToPrune <= lastAccessedEpoch; epochToPrune++ {
-		if err = e.DeleteEpoch(epochToPrune); err != nil {
-			return epochToPrune, ierrors.Wrapf(err, "error while deleting epoch %d", epochToPrune)
-		}
+	if err = e.DeleteRange(epoch, lastAccessedEpoch); err != nil {
+		return epoch, ierrors.Wrapf(err, "error while deleting epochs [%d, %d]", epoch, lastAccessedEpoch)
 	}
 
 	return lastAccessedEpoch, nil
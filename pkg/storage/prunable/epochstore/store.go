@@ -101,6 +101,32 @@ func (s *Store[V]) Stream(consumer func(epoch iotago.EpochIndex, value V) error)
 	return nil
 }
 
+// StreamRange streams the values of the epochs in the given (inclusive) range, skipping epochs that were already pruned
+// or that have no value stored. Epoch keys are not stored in iteration order, so the range is resolved with point
+// lookups instead of a full stream of the store.
+func (s *Store[V]) StreamRange(from iotago.EpochIndex, to iotago.EpochIndex, consumer func(epoch iotago.EpochIndex, value V) error) error {
+	if prunedEpoch, hasPruned := s.lastPrunedEpoch.Index(); hasPruned && from <= prunedEpoch {
+		from = prunedEpoch + 1
+	}
+
+	for epoch := from; epoch <= to && epoch >= from; epoch++ {
+		value, err := s.kv.Get(epoch)
+		if err != nil {
+			if ierrors.Is(err, kvstore.ErrKeyNotFound) {
+				continue
+			}
+
+			return ierrors.Wrapf(err, "failed to get value for epoch %d in realm %v", epoch, s.realm)
+		}
+
+		if err = consumer(epoch, value); err != nil {
+			return ierrors.Wrapf(err, "failed to stream range [%d, %d] of store for realm %v", from, to, s.realm)
+		}
+	}
+
+	return nil
+}
+
 func (s *Store[V]) StreamBytes(consumer func([]byte, []byte) error) error {
 	var innerErr error
 	if storageErr := s.kv.KVStore().Iterate(kvstore.EmptyPrefix, func(key kvstore.Key, value kvstore.Value) (advance bool) {
@@ -121,6 +147,54 @@ func (s *Store[V]) DeleteEpoch(epoch iotago.EpochIndex) error {
 	return s.kv.DeletePrefix(epoch.MustBytes())
 }
 
+// DeleteRange deletes the values of the epochs in the given (inclusive) range in a single batch.
+func (s *Store[V]) DeleteRange(from iotago.EpochIndex, to iotago.EpochIndex) error {
+	batch, err := s.kv.KVStore().Batched()
+	if err != nil {
+		return ierrors.Wrapf(err, "failed to create batch for realm %v", s.realm)
+	}
+
+	for epoch := from; epoch <= to && epoch >= from; epoch++ {
+		if err = batch.Delete(epoch.MustBytes()); err != nil {
+			batch.Cancel()
+
+			return ierrors.Wrapf(err, "failed to delete epoch %d in realm %v", epoch, s.realm)
+		}
+	}
+
+	if err = batch.Commit(); err != nil {
+		return ierrors.Wrapf(err, "failed to delete range [%d, %d] in realm %v", from, to, s.realm)
+	}
+
+	return nil
+}
+
+// Count returns the number of epochs that have a value stored.
+func (s *Store[V]) Count() (count int, err error) {
+	if err = s.kv.KVStore().IterateKeys(kvstore.EmptyPrefix, func(_ kvstore.Key) bool {
+		count++
+
+		return true
+	}); err != nil {
+		return 0, ierrors.Wrapf(err, "failed to count entries of store for realm %v", s.realm)
+	}
+
+	return count, nil
+}
+
+// Size returns the accumulated size of the stored keys and values in bytes.
+func (s *Store[V]) Size() (size int, err error) {
+	if err = s.kv.KVStore().Iterate(kvstore.EmptyPrefix, func(key kvstore.Key, value kvstore.Value) bool {
+		size += len(key) + len(value)
+
+		return true
+	}); err != nil {
+		return 0, ierrors.Wrapf(err, "failed to determine size of store for realm %v", s.realm)
+	}
+
+	return size, nil
+}
+
 func (s *Store[V]) Prune(epoch iotago.EpochIndex, defaultPruningDelay iotago.EpochIndex) error {
 	// The epoch we're trying to prune already takes into account the defaultPruningDelay.
 	// Therefore, we don't need to do anything if it is greater equal s.pruningDelay and take the difference otherwise.
@@ -136,15 +210,15 @@ func (s *Store[V]) Prune(epoch iotago.EpochIndex, defaultPruningDelay iotago.Epo
 		return nil
 	}
 
-	for i := s.lastPrunedEpoch.NextIndex(); i <= epoch-pruningDelay; i++ {
-		err := s.kv.DeletePrefix(i.MustBytes())
-		if err != nil {
-			return ierrors.Wrapf(err, "failed to prune epoch store for realm %v at epoch %d", s.realm, i)
-		}
-		err = s.lastPrunedEpoch.MarkEvicted(i)
-		if err != nil {
-			return ierrors.Wrapf(err, "failed to store lastPrunedEpoch for epoch %d in Prune", i)
-		}
+	targetEpoch := epoch - pruningDelay
+	if nextEpoch := s.lastPrunedEpoch.NextIndex(); nextEpoch > targetEpoch {
+		return nil
+	} else if err := s.DeleteRange(nextEpoch, targetEpoch); err != nil {
+		return ierrors.Wrapf(err, "failed to prune epoch store for realm %v up to epoch %d", s.realm, targetEpoch)
+	}
+
+	if err := s.lastPrunedEpoch.MarkEvicted(targetEpoch); err != nil {
+		return ierrors.Wrapf(err, "failed to store lastPrunedEpoch for epoch %d in Prune", targetEpoch)
 	}
 
 	return nil
@@ -156,10 +230,8 @@ func (s *Store[V]) RollbackEpochs(epoch iotago.EpochIndex) (lastPrunedEpoch iota
 		return lastAccessedEpoch, ierrors.Wrap(err, "failed to get last accessed epoch")
 	}
 
-	for epochToPrune := epoch; epochToPrune <= lastAccessedEpoch; epochToPrune++ {
-		if err = s.DeleteEpoch(epochToPrune); err != nil {
-			return epochToPrune, ierrors.Wrapf(err, "error while deleting epoch %d", epochToPrune)
-		}
+	if err = s.DeleteRange(epoch, lastAccessedEpoch); err != nil {
+		return epoch, ierrors.Wrapf(err, "error while deleting epochs [%d, %d]", epoch, lastAccessedEpoch)
 	}
 
 	return lastAccessedEpoch, nil
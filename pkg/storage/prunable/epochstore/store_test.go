@@ -0,0 +1,71 @@
+package epochstore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/kvstore/mapdb"
+	"github.com/iotaledger/hive.go/lo"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func newTestStore(pruningDelay iotago.EpochIndex) *Store[iotago.EpochIndex] {
+	return NewStore(kvstore.Realm{0}, mapdb.NewMapDB(), pruningDelay, iotago.EpochIndex.Bytes, iotago.EpochIndexFromBytes)
+}
+
+func streamedEpochs(t *testing.T, store *Store[iotago.EpochIndex], from iotago.EpochIndex, to iotago.EpochIndex) []iotago.EpochIndex {
+	epochs := make([]iotago.EpochIndex, 0)
+	require.NoError(t, store.StreamRange(from, to, func(epoch iotago.EpochIndex, value iotago.EpochIndex) error {
+		require.Equal(t, epoch, value)
+
+		epochs = append(epochs, epoch)
+
+		return nil
+	}))
+
+	return epochs
+}
+
+func TestStore_StreamRange(t *testing.T) {
+	store := newTestStore(0)
+
+	for _, epoch := range []iotago.EpochIndex{1, 2, 3, 5, 255, 256, 300} {
+		require.NoError(t, store.Store(epoch, epoch))
+	}
+
+	require.Equal(t, []iotago.EpochIndex{1, 2, 3, 5}, streamedEpochs(t, store, 0, 10))
+	require.Equal(t, []iotago.EpochIndex{255, 256}, streamedEpochs(t, store, 200, 299))
+	require.Equal(t, []iotago.EpochIndex{}, streamedEpochs(t, store, 6, 254))
+	require.Equal(t, []iotago.EpochIndex{}, streamedEpochs(t, store, 10, 5))
+
+	require.NoError(t, store.Prune(2, 0))
+	require.Equal(t, []iotago.EpochIndex{3, 5}, streamedEpochs(t, store, 0, 10))
+}
+
+func TestStore_DeleteRangeAndIntrospection(t *testing.T) {
+	store := newTestStore(0)
+
+	for epoch := iotago.EpochIndex(0); epoch < 10; epoch++ {
+		require.NoError(t, store.Store(epoch, epoch))
+	}
+
+	require.Equal(t, 10, lo.PanicOnErr(store.Count()))
+	require.Equal(t, 10*2*iotago.EpochIndexLength, lo.PanicOnErr(store.Size()))
+
+	require.NoError(t, store.DeleteRange(3, 6))
+	require.Equal(t, 6, lo.PanicOnErr(store.Count()))
+	require.Equal(t, []iotago.EpochIndex{0, 1, 2, 7, 8, 9}, streamedEpochs(t, store, 0, 9))
+
+	lastAccessedEpoch, err := store.RollbackEpochs(8)
+	require.NoError(t, err)
+	require.Equal(t, iotago.EpochIndex(9), lastAccessedEpoch)
+	require.Equal(t, []iotago.EpochIndex{0, 1, 2, 7}, streamedEpochs(t, store, 0, 9))
+
+	require.NoError(t, store.Prune(1, 0))
+	lastPrunedEpoch, hasPruned := store.LastPrunedEpoch()
+	require.True(t, hasPruned)
+	require.Equal(t, iotago.EpochIndex(1), lastPrunedEpoch)
+	require.Equal(t, 2, lo.PanicOnErr(store.Count()))
+}
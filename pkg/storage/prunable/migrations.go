@@ -0,0 +1,34 @@
+package prunable
+
+import (
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/storage/database"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+var (
+	// bucketMigrations contains the migrations of the epoch buckets keyed by the database version they migrate to.
+	bucketMigrations = database.VersionMigrations{
+		2: migrateVersionedBlocks,
+	}
+
+	// semiPermanentMigrations contains the migrations of the semi-permanent database keyed by the database version they
+	// migrate to.
+	semiPermanentMigrations = database.VersionMigrations{}
+)
+
+// migrateVersionedBlocks converts the stored blocks and buffered blocks of an epoch bucket to the versioned storage
+// format.
+func migrateVersionedBlocks(store kvstore.KVStore) error {
+	slotLength := len(iotago.SlotIndex(0).MustBytes())
+
+	return database.MigrateValues(store, kvstore.EmptyPrefix, func(key kvstore.Key) bool {
+		// the keys of the slot stores are prefixed with the slot and the prefix of the store.
+		if len(key) != slotLength+1+iotago.BlockIDLength {
+			return false
+		}
+
+		return key[slotLength] == slotPrefixBlocks || key[slotLength] == slotPrefixBufferedBlocks
+	}, model.StorageBytesFromUnversioned)
+}
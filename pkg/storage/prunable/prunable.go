@@ -1,6 +1,10 @@
 package prunable
 
 import (
+	"os"
+	"path/filepath"
+	"strconv"
+
 	copydir "github.com/otiai10/copy"
 
 	"github.com/iotaledger/hive.go/ierrors"
@@ -31,14 +35,14 @@ type Prunable struct {
 
 func New(dbConfig database.Config, apiProvider iotago.APIProvider, errorHandler func(error), opts ...options.Option[BucketManager]) *Prunable {
 	dir := utils.NewDirectory(dbConfig.Directory, true)
-	semiPermanentDBConfig := dbConfig.WithDirectory(dir.PathWithCreate("semipermanent"))
+	semiPermanentDBConfig := dbConfig.WithDirectory(dir.PathWithCreate("semipermanent")).WithMigrations(semiPermanentMigrations)
 	// openedCallback is nil because we don't need to do anything when reopening the store.
 	semiPermanentDB := database.NewDBInstance(semiPermanentDBConfig, nil)
 
 	return &Prunable{
 		apiProvider:       apiProvider,
 		errorHandler:      errorHandler,
-		prunableSlotStore: NewBucketManager(dbConfig, errorHandler, opts...),
+		prunableSlotStore: NewBucketManager(dbConfig.WithMigrations(bucketMigrations), errorHandler, opts...),
 
 		semiPermanentDBConfig: semiPermanentDBConfig,
 		semiPermanentDB:       semiPermanentDB,
@@ -50,6 +54,12 @@ func New(dbConfig database.Config, apiProvider iotago.APIProvider, errorHandler
 }
 
 func Clone(source *Prunable, dbConfig database.Config, apiProvider iotago.APIProvider, errorHandler func(error), opts ...options.Option[BucketManager]) (*Prunable, error) {
+	return CloneEpochs(source, dbConfig, apiProvider, 0, iotago.MaxEpochIndex, errorHandler, opts...)
+}
+
+// CloneEpochs clones the semi-permanent storage and the buckets of the epochs in the range [startEpoch, endEpoch] of the
+// given source. The buckets of older epochs are marked as pruned in the clone.
+func CloneEpochs(source *Prunable, dbConfig database.Config, apiProvider iotago.APIProvider, startEpoch iotago.EpochIndex, endEpoch iotago.EpochIndex, errorHandler func(error), opts ...options.Option[BucketManager]) (*Prunable, error) {
 	// Lock semi-permanent DB and prunable slot store so that nobody can try to use or open them while cloning.
 	source.semiPermanentDB.LockAccess()
 	defer source.semiPermanentDB.UnlockAccess()
@@ -61,12 +71,32 @@ func Clone(source *Prunable, dbConfig database.Config, apiProvider iotago.APIPro
 	source.semiPermanentDB.CloseWithoutLocking()
 	source.prunableSlotStore.CloseWithoutLocking()
 
-	// Copy the storage on disk to new location.
-	if err := copydir.Copy(source.prunableSlotStore.dbConfig.Directory, dbConfig.Directory); err != nil {
+	// Copy the storage on disk to new location, skipping the buckets of the epochs outside the range.
+	sourceDirectory := filepath.Clean(source.prunableSlotStore.dbConfig.Directory)
+	if err := copydir.Copy(sourceDirectory, dbConfig.Directory, copydir.Options{
+		Skip: func(srcInfo os.FileInfo, src string, _ string) (bool, error) {
+			if !srcInfo.IsDir() || filepath.Dir(src) != sourceDirectory {
+				return false, nil
+			}
+
+			epoch, err := strconv.ParseUint(srcInfo.Name(), 10, 32)
+			if err != nil {
+				// not a bucket (i.e. the semi-permanent storage).
+				return false, nil //nolint:nilerr // the error only indicates that the directory is not a bucket
+			}
+
+			return iotago.EpochIndex(epoch) < startEpoch || iotago.EpochIndex(epoch) > endEpoch, nil
+		},
+	}); err != nil {
 		return nil, ierrors.Wrap(err, "failed to copy prunable storage directory to new storage path")
 	}
 
-	return New(dbConfig, apiProvider, errorHandler, opts...), nil
+	prunableClone := New(dbConfig, apiProvider, errorHandler, opts...)
+	if startEpoch > 0 {
+		prunableClone.prunableSlotStore.markPruned(startEpoch - 1)
+	}
+
+	return prunableClone, nil
 }
 
 func (p *Prunable) RestoreFromDisk() (lastPrunedEpoch iotago.EpochIndex) {
@@ -114,6 +144,18 @@ func (p *Prunable) Prune(epoch iotago.EpochIndex, defaultPruningDelay iotago.Epo
 	return nil
 }
 
+// PruneStore prunes the data of the given store type for all slots of the given epoch, while keeping the data of the
+// other stores that share the same bucket.
+func (p *Prunable) PruneStore(epoch iotago.EpochIndex, storeType StoreType) error {
+	timeProvider := p.apiProvider.APIForEpoch(epoch).TimeProvider()
+
+	if err := p.prunableSlotStore.PruneRealm(epoch, timeProvider.EpochStart(epoch), timeProvider.EpochEnd(epoch), kvstore.Realm{byte(storeType)}); err != nil {
+		return ierrors.Wrapf(err, "prune %s failed for epoch %d", storeType, epoch)
+	}
+
+	return nil
+}
+
 func (p *Prunable) BucketSize(epoch iotago.EpochIndex) (int64, error) {
 	return p.prunableSlotStore.BucketSize(epoch)
 }
@@ -22,6 +22,13 @@ const (
 	slotPrefixRoots
 	slotPrefixRetainer
 	epochPrefixCommitteeCandidates
+	slotPrefixSpenders
+	slotPrefixManaTraces
+	slotPrefixBufferedBlocks
+	slotPrefixFilteredBlocks
+	slotPrefixAccountsAggregates
+	slotPrefixBlockCommitments
+	slotPrefixMemPoolWriteAheadLog
 )
 
 func (p *Prunable) getKVStoreFromSlot(slot iotago.SlotIndex, prefix kvstore.Realm) (kvstore.KVStore, error) {
@@ -154,3 +161,91 @@ func (p *Prunable) Retainer(slot iotago.SlotIndex) (*slotstore.Retainer, error)
 
 	return slotstore.NewRetainer(slot, kv), nil
 }
+
+func (p *Prunable) Spenders(slot iotago.SlotIndex) (*slotstore.Store[iotago.TransactionID, *model.Spender], error) {
+	kv, err := p.getKVStoreFromSlot(slot, kvstore.Realm{slotPrefixSpenders})
+	if err != nil {
+		return nil, ierrors.Wrapf(database.ErrEpochPruned, "could not get spenders with slot %d", slot)
+	}
+
+	return slotstore.NewStore(slot, kv,
+		iotago.TransactionID.Bytes,
+		iotago.TransactionIDFromBytes,
+		(*model.Spender).Bytes,
+		model.SpenderFromBytes,
+	), nil
+}
+
+func (p *Prunable) ManaTraces(slot iotago.SlotIndex) (*slotstore.Store[iotago.TransactionID, *model.ManaTrace], error) {
+	kv, err := p.getKVStoreFromSlot(slot, kvstore.Realm{slotPrefixManaTraces})
+	if err != nil {
+		return nil, ierrors.Wrapf(database.ErrEpochPruned, "could not get mana traces with slot %d", slot)
+	}
+
+	return slotstore.NewStore(slot, kv,
+		iotago.TransactionID.Bytes,
+		iotago.TransactionIDFromBytes,
+		(*model.ManaTrace).Bytes,
+		model.ManaTraceFromBytes,
+	), nil
+}
+
+func (p *Prunable) BufferedBlocks(slot iotago.SlotIndex) (*slotstore.Blocks, error) {
+	kv, err := p.getKVStoreFromSlot(slot, kvstore.Realm{slotPrefixBufferedBlocks})
+	if err != nil {
+		return nil, ierrors.Wrapf(database.ErrEpochPruned, "could not get buffered blocks with slot %d", slot)
+	}
+
+	return slotstore.NewBlocks(slot, kv, p.apiProvider.APIForSlot(slot)), nil
+}
+
+func (p *Prunable) FilteredBlocks(slot iotago.SlotIndex) (*slotstore.Store[iotago.BlockID, *model.FilteredBlock], error) {
+	kv, err := p.getKVStoreFromSlot(slot, kvstore.Realm{slotPrefixFilteredBlocks})
+	if err != nil {
+		return nil, ierrors.Wrapf(database.ErrEpochPruned, "could not get filtered blocks with slot %d", slot)
+	}
+
+	return slotstore.NewStore(slot, kv,
+		iotago.BlockID.Bytes,
+		iotago.BlockIDFromBytes,
+		(*model.FilteredBlock).Bytes,
+		model.FilteredBlockFromBytes,
+	), nil
+}
+
+func (p *Prunable) AccountsAggregates(slot iotago.SlotIndex) (*slotstore.Store[iotago.SlotIndex, *model.AccountsAggregates], error) {
+	kv, err := p.getKVStoreFromSlot(slot, kvstore.Realm{slotPrefixAccountsAggregates})
+	if err != nil {
+		return nil, ierrors.Wrapf(database.ErrEpochPruned, "could not get accounts aggregates with slot %d", slot)
+	}
+
+	return slotstore.NewStore(slot, kv,
+		iotago.SlotIndex.Bytes,
+		iotago.SlotIndexFromBytes,
+		(*model.AccountsAggregates).Bytes,
+		model.AccountsAggregatesFromBytes,
+	), nil
+}
+
+func (p *Prunable) BlockCommitments(slot iotago.SlotIndex) (*slotstore.Store[iotago.BlockID, iotago.CommitmentID], error) {
+	kv, err := p.getKVStoreFromSlot(slot, kvstore.Realm{slotPrefixBlockCommitments})
+	if err != nil {
+		return nil, ierrors.Wrapf(database.ErrEpochPruned, "could not get block commitments with slot %d", slot)
+	}
+
+	return slotstore.NewStore(slot, kv,
+		iotago.BlockID.Bytes,
+		iotago.BlockIDFromBytes,
+		iotago.CommitmentID.Bytes,
+		iotago.CommitmentIDFromBytes,
+	), nil
+}
+
+func (p *Prunable) MemPoolWriteAheadLog(slot iotago.SlotIndex) (kvstore.KVStore, error) {
+	kv, err := p.getKVStoreFromSlot(slot, kvstore.Realm{slotPrefixMemPoolWriteAheadLog})
+	if err != nil {
+		return nil, ierrors.Wrapf(database.ErrEpochPruned, "could not get mempool write-ahead log with slot %d", slot)
+	}
+
+	return kv, nil
+}
@@ -33,12 +33,12 @@ func (b *Blocks) Load(id iotago.BlockID) (*model.Block, error) {
 		return nil, ierrors.Wrapf(err, "failed to get block %s", id)
 	}
 
-	return model.BlockFromIDAndBytes(id, blockBytes, b.apiForSlot)
+	return model.BlockFromIDAndStorageBytes(id, blockBytes, b.apiForSlot)
 }
 
 func (b *Blocks) Store(block *model.Block) error {
 	blockID := block.ID()
-	return b.store.Set(blockID[:], block.Data())
+	return b.store.Set(blockID[:], block.StorageBytes())
 }
 
 func (b *Blocks) Delete(id iotago.BlockID) (err error) {
@@ -72,7 +72,7 @@ func (b *Blocks) ForEachBlockInSlot(consumer func(block *model.Block) error) err
 		var id iotago.BlockID
 		id, _, innerErr = iotago.BlockIDFromBytes(key)
 		var block *model.Block
-		block, innerErr = model.BlockFromIDAndBytes(id, value, b.apiForSlot)
+		block, innerErr = model.BlockFromIDAndStorageBytes(id, value, b.apiForSlot)
 
 		if innerErr != nil {
 			return false
@@ -4,6 +4,7 @@ import (
 	"github.com/iotaledger/hive.go/ierrors"
 	"github.com/iotaledger/hive.go/kvstore"
 	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/hive.go/serializer/v2/byteutils"
 	"github.com/iotaledger/hive.go/serializer/v2/stream"
 	iotago "github.com/iotaledger/iota.go/v4"
 	"github.com/iotaledger/iota.go/v4/api"
@@ -12,22 +13,48 @@ import (
 const (
 	blockStorePrefix byte = iota
 	transactionStorePrefix
+	finalizationStorePrefix
+	issuerStorePrefix
+)
+
+var finalizedAtKey = []byte{0}
+
+const (
+	// blockRetainerDataVersion is the version of the serialized BlockRetainerData.
+	blockRetainerDataVersion byte = 1
+
+	// legacyBlockRetainerDataLength is the length of the BlockRetainerData that was serialized without a version
+	// (only containing the state and the failure reason).
+	legacyBlockRetainerDataLength = 2
 )
 
 type BlockRetainerData struct {
 	State         api.BlockState
 	FailureReason api.BlockFailureReason
+	// AcceptedSlot is the slot of the accepted tangle time at which the block was accepted (0 if not accepted yet).
+	AcceptedSlot iotago.SlotIndex
+	// ConfirmedSlot is the slot of the accepted tangle time at which the block was confirmed (0 if not confirmed yet).
+	ConfirmedSlot iotago.SlotIndex
 }
 
 func (b *BlockRetainerData) Bytes() ([]byte, error) {
-	byteBuffer := stream.NewByteBuffer(2)
+	byteBuffer := stream.NewByteBuffer(3 + 2*iotago.SlotIndexLength)
 
+	if err := stream.Write(byteBuffer, blockRetainerDataVersion); err != nil {
+		return nil, ierrors.Wrap(err, "failed to write version")
+	}
 	if err := stream.Write(byteBuffer, b.State); err != nil {
 		return nil, ierrors.Wrap(err, "failed to write block state")
 	}
 	if err := stream.Write(byteBuffer, b.FailureReason); err != nil {
 		return nil, ierrors.Wrap(err, "failed to write block failure reason")
 	}
+	if err := stream.Write(byteBuffer, b.AcceptedSlot); err != nil {
+		return nil, ierrors.Wrap(err, "failed to write accepted slot")
+	}
+	if err := stream.Write(byteBuffer, b.ConfirmedSlot); err != nil {
+		return nil, ierrors.Wrap(err, "failed to write confirmed slot")
+	}
 
 	return byteBuffer.Bytes()
 }
@@ -38,6 +65,18 @@ func BlockRetainerDataFromBytes(bytes []byte) (*BlockRetainerData, int, error) {
 	var err error
 	b := new(BlockRetainerData)
 
+	// data that was stored before the versioning was introduced only contains the state and the failure reason.
+	isLegacy := len(bytes) == legacyBlockRetainerDataLength
+	if !isLegacy {
+		version, versionErr := stream.Read[byte](byteReader)
+		if versionErr != nil {
+			return nil, 0, ierrors.Wrap(versionErr, "failed to read version")
+		}
+		if version != blockRetainerDataVersion {
+			return nil, 0, ierrors.Errorf("unsupported block retainer data version %d", version)
+		}
+	}
+
 	if b.State, err = stream.Read[api.BlockState](byteReader); err != nil {
 		return nil, 0, ierrors.Wrap(err, "failed to read block state")
 	}
@@ -45,6 +84,17 @@ func BlockRetainerDataFromBytes(bytes []byte) (*BlockRetainerData, int, error) {
 		return nil, 0, ierrors.Wrap(err, "failed to read block failure reason")
 	}
 
+	if isLegacy {
+		return b, byteReader.BytesRead(), nil
+	}
+
+	if b.AcceptedSlot, err = stream.Read[iotago.SlotIndex](byteReader); err != nil {
+		return nil, 0, ierrors.Wrap(err, "failed to read accepted slot")
+	}
+	if b.ConfirmedSlot, err = stream.Read[iotago.SlotIndex](byteReader); err != nil {
+		return nil, 0, ierrors.Wrap(err, "failed to read confirmed slot")
+	}
+
 	return b, byteReader.BytesRead(), nil
 }
 
@@ -94,6 +144,10 @@ type Retainer struct {
 	blockStore *kvstore.TypedStore[iotago.BlockID, *BlockRetainerData]
 	// we store transaction metadata per blockID as in API requests we always request by blockID
 	transactionStore *kvstore.TypedStore[iotago.BlockID, *TransactionRetainerData]
+	// finalizationStore keeps the slot of the accepted tangle time at which the slot was finalized.
+	finalizationStore kvstore.KVStore
+	// issuerStore indexes the attached blocks by their issuer (the keys are the issuer ID followed by the block ID).
+	issuerStore kvstore.KVStore
 }
 
 func NewRetainer(slot iotago.SlotIndex, store kvstore.KVStore) (newRetainer *Retainer) {
@@ -111,16 +165,55 @@ func NewRetainer(slot iotago.SlotIndex, store kvstore.KVStore) (newRetainer *Ret
 			(*TransactionRetainerData).Bytes,
 			TransactionRetainerDataFromBytes,
 		),
+		finalizationStore: lo.PanicOnErr(store.WithExtendedRealm(kvstore.Realm{finalizationStorePrefix})),
+		issuerStore:       lo.PanicOnErr(store.WithExtendedRealm(kvstore.Realm{issuerStorePrefix})),
 	}
 }
 
-func (r *Retainer) StoreBlockAttached(blockID iotago.BlockID) error {
+func (r *Retainer) StoreBlockAttached(blockID iotago.BlockID, issuerID iotago.AccountID) error {
+	if err := r.issuerStore.Set(byteutils.ConcatBytes(lo.PanicOnErr(issuerID.Bytes()), lo.PanicOnErr(blockID.Bytes())), []byte{}); err != nil {
+		return ierrors.Wrap(err, "failed to index block by issuer")
+	}
+
 	return r.blockStore.Set(blockID, &BlockRetainerData{
 		State:         api.BlockStatePending,
 		FailureReason: api.BlockFailureNone,
 	})
 }
 
+// ForEachAttachedBlock iterates over the attached blocks of the slot together with their issuers. If an issuer is
+// given, only the blocks of that issuer are iterated.
+func (r *Retainer) ForEachAttachedBlock(issuerID *iotago.AccountID, consumer func(blockID iotago.BlockID, issuerID iotago.AccountID) bool) error {
+	prefix := kvstore.EmptyPrefix
+	if issuerID != nil {
+		prefix = lo.PanicOnErr(issuerID.Bytes())
+	}
+
+	var innerErr error
+	if err := r.issuerStore.IterateKeys(prefix, func(key kvstore.Key) bool {
+		blockIssuerID, _, err := iotago.AccountIDFromBytes(key)
+		if err != nil {
+			innerErr = ierrors.Wrap(err, "failed to parse issuer ID")
+
+			return false
+		}
+
+		// AccountIDFromBytes reports all bytes as consumed, so we skip the issuer ID by its length
+		blockID, _, err := iotago.BlockIDFromBytes(key[iotago.AccountIDLength:])
+		if err != nil {
+			innerErr = ierrors.Wrap(err, "failed to parse block ID")
+
+			return false
+		}
+
+		return consumer(blockID, blockIssuerID)
+	}); err != nil {
+		return ierrors.Wrap(err, "failed to iterate attached blocks")
+	}
+
+	return innerErr
+}
+
 func (r *Retainer) GetBlock(blockID iotago.BlockID) (*BlockRetainerData, bool) {
 	blockData, err := r.blockStore.Get(blockID)
 	if err != nil {
@@ -139,20 +232,49 @@ func (r *Retainer) GetTransaction(blockID iotago.BlockID) (*TransactionRetainerD
 	return txData, true
 }
 
-func (r *Retainer) StoreBlockAccepted(blockID iotago.BlockID) error {
+func (r *Retainer) StoreBlockAccepted(blockID iotago.BlockID, acceptedSlot iotago.SlotIndex) error {
 	return r.blockStore.Set(blockID, &BlockRetainerData{
 		State:         api.BlockStateAccepted,
 		FailureReason: api.BlockFailureNone,
+		AcceptedSlot:  acceptedSlot,
 	})
 }
 
-func (r *Retainer) StoreBlockConfirmed(blockID iotago.BlockID) error {
+func (r *Retainer) StoreBlockConfirmed(blockID iotago.BlockID, confirmedSlot iotago.SlotIndex) error {
+	// keep the slot at which the block was accepted
+	var acceptedSlot iotago.SlotIndex
+	if blockData, exists := r.GetBlock(blockID); exists {
+		acceptedSlot = blockData.AcceptedSlot
+	}
+
 	return r.blockStore.Set(blockID, &BlockRetainerData{
 		State:         api.BlockStateConfirmed,
 		FailureReason: api.BlockFailureNone,
+		AcceptedSlot:  acceptedSlot,
+		ConfirmedSlot: confirmedSlot,
 	})
 }
 
+// StoreSlotFinalized stores the slot of the accepted tangle time at which the slot of the store was finalized.
+func (r *Retainer) StoreSlotFinalized(finalizedAtSlot iotago.SlotIndex) error {
+	return r.finalizationStore.Set(finalizedAtKey, lo.PanicOnErr(finalizedAtSlot.Bytes()))
+}
+
+// SlotFinalizedAt returns the slot of the accepted tangle time at which the slot of the store was finalized.
+func (r *Retainer) SlotFinalizedAt() (iotago.SlotIndex, bool) {
+	finalizedAtBytes, err := r.finalizationStore.Get(finalizedAtKey)
+	if err != nil {
+		return 0, false
+	}
+
+	finalizedAtSlot, _, err := iotago.SlotIndexFromBytes(finalizedAtBytes)
+	if err != nil {
+		return 0, false
+	}
+
+	return finalizedAtSlot, true
+}
+
 func (r *Retainer) StoreTransactionPending(blockID iotago.BlockID) error {
 	return r.transactionStore.Set(blockID, &TransactionRetainerData{
 		State:         api.TransactionStatePending,
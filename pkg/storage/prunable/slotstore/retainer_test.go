@@ -0,0 +1,43 @@
+package slotstore
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/api"
+)
+
+func TestBlockRetainerData_Bytes(t *testing.T) {
+	data := &BlockRetainerData{
+		State:         api.BlockStateConfirmed,
+		FailureReason: api.BlockFailureNone,
+		AcceptedSlot:  iotago.SlotIndex(5),
+		ConfirmedSlot: iotago.SlotIndex(7),
+	}
+
+	dataBytes, err := data.Bytes()
+	require.NoError(t, err)
+
+	parsedData, bytesRead, err := BlockRetainerDataFromBytes(dataBytes)
+	require.NoError(t, err)
+	require.Equal(t, len(dataBytes), bytesRead)
+	require.Equal(t, data, parsedData)
+
+	// unknown versions are rejected.
+	dataBytes[0] = blockRetainerDataVersion + 1
+	_, _, err = BlockRetainerDataFromBytes(dataBytes)
+	require.Error(t, err)
+}
+
+func TestBlockRetainerData_FromLegacyBytes(t *testing.T) {
+	// data that was stored before the versioning only contains the state and the failure reason.
+	parsedData, bytesRead, err := BlockRetainerDataFromBytes([]byte{byte(api.BlockStateFailed), byte(api.BlockFailureParentInvalid)})
+	require.NoError(t, err)
+	require.Equal(t, legacyBlockRetainerDataLength, bytesRead)
+	require.Equal(t, &BlockRetainerData{
+		State:         api.BlockStateFailed,
+		FailureReason: api.BlockFailureParentInvalid,
+	}, parsedData)
+}
@@ -0,0 +1,97 @@
+package prunable
+
+import (
+	"fmt"
+
+	"github.com/iotaledger/hive.go/ierrors"
+)
+
+// StoreType identifies one of the slot based stores that share the buckets of the prunable storage.
+type StoreType byte
+
+const (
+	StoreTypeBlocks               = StoreType(slotPrefixBlocks)
+	StoreTypeRootBlocks           = StoreType(slotPrefixRootBlocks)
+	StoreTypeMutations            = StoreType(slotPrefixMutations)
+	StoreTypeAttestations         = StoreType(slotPrefixAttestations)
+	StoreTypeAccountDiffs         = StoreType(slotPrefixAccountDiffs)
+	StoreTypePerformanceFactors   = StoreType(slotPrefixPerformanceFactors)
+	StoreTypeUpgradeSignals       = StoreType(slotPrefixUpgradeSignals)
+	StoreTypeRoots                = StoreType(slotPrefixRoots)
+	StoreTypeRetainer             = StoreType(slotPrefixRetainer)
+	StoreTypeSpenders             = StoreType(slotPrefixSpenders)
+	StoreTypeManaTraces           = StoreType(slotPrefixManaTraces)
+	StoreTypeBufferedBlocks       = StoreType(slotPrefixBufferedBlocks)
+	StoreTypeFilteredBlocks       = StoreType(slotPrefixFilteredBlocks)
+	StoreTypeAccountsAggregates   = StoreType(slotPrefixAccountsAggregates)
+	StoreTypeMemPoolWriteAheadLog = StoreType(slotPrefixMemPoolWriteAheadLog)
+)
+
+// StoreTypes returns all store types that can be pruned individually.
+func StoreTypes() []StoreType {
+	return []StoreType{
+		StoreTypeBlocks,
+		StoreTypeRootBlocks,
+		StoreTypeMutations,
+		StoreTypeAttestations,
+		StoreTypeAccountDiffs,
+		StoreTypePerformanceFactors,
+		StoreTypeUpgradeSignals,
+		StoreTypeRoots,
+		StoreTypeRetainer,
+		StoreTypeSpenders,
+		StoreTypeManaTraces,
+		StoreTypeBufferedBlocks,
+		StoreTypeFilteredBlocks,
+		StoreTypeAccountsAggregates,
+		StoreTypeMemPoolWriteAheadLog,
+	}
+}
+
+// StoreTypeFromString returns the StoreType with the given name.
+func StoreTypeFromString(name string) (StoreType, error) {
+	for _, storeType := range StoreTypes() {
+		if storeType.String() == name {
+			return storeType, nil
+		}
+	}
+
+	return 0, ierrors.Errorf("unknown store type: %s", name)
+}
+
+func (s StoreType) String() string {
+	switch s {
+	case StoreTypeBlocks:
+		return "blocks"
+	case StoreTypeRootBlocks:
+		return "rootBlocks"
+	case StoreTypeMutations:
+		return "mutations"
+	case StoreTypeAttestations:
+		return "attestations"
+	case StoreTypeAccountDiffs:
+		return "accountDiffs"
+	case StoreTypePerformanceFactors:
+		return "performanceFactors"
+	case StoreTypeUpgradeSignals:
+		return "upgradeSignals"
+	case StoreTypeRoots:
+		return "roots"
+	case StoreTypeRetainer:
+		return "retainer"
+	case StoreTypeSpenders:
+		return "spenders"
+	case StoreTypeManaTraces:
+		return "manaTraces"
+	case StoreTypeBufferedBlocks:
+		return "bufferedBlocks"
+	case StoreTypeFilteredBlocks:
+		return "filteredBlocks"
+	case StoreTypeAccountsAggregates:
+		return "accountsAggregates"
+	case StoreTypeMemPoolWriteAheadLog:
+		return "memPoolWriteAheadLog"
+	default:
+		return fmt.Sprintf("unknown(%d)", byte(s))
+	}
+}
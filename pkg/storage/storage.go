@@ -41,17 +41,24 @@ type Storage struct {
 	pruningLock        sync.RWMutex
 	lastPrunedEpoch    *model.EvictionIndex[iotago.EpochIndex]
 	lastPrunedSizeTime time.Time
-	lastAccessedBlocks reactive.Variable[iotago.SlotIndex]
+	// lastPrunedStoreEpochs tracks the pruning progress of the stores that are pruned ahead of their buckets.
+	lastPrunedStoreEpochs map[prunable.StoreType]*model.EvictionIndex[iotago.EpochIndex]
+	lastAccessedBlocks    reactive.Variable[iotago.SlotIndex]
 
 	optsDBEngine                       hivedb.Engine
 	optsAllowedDBEngines               []hivedb.Engine
+	optsReadOnly                       bool
 	optsPruningDelay                   iotago.EpochIndex
+	optsPruningDelayOverrides          map[prunable.StoreType]iotago.EpochIndex
 	optPruningSizeEnabled              bool
 	optsPruningSizeMaxTargetSizeBytes  int64
 	optsPruningSizeReductionPercentage float64
 	optsBucketManagerOptions           []options.Option[prunable.BucketManager]
 	optsPruningSizeCooldownTime        time.Duration
 	optsPermanent                      []options.Option[permanent.Permanent]
+	optsForkRetainedEpochs             iotago.EpochIndex
+	optsPermanentDBSettings            database.Settings
+	optsPrunableDBSettings             database.Settings
 }
 
 // New creates a new storage instance with the named database version in the given directory.
@@ -60,9 +67,11 @@ func New(directory string, errorHandler func(error), opts ...options.Option[Stor
 		dir:                                utils.NewDirectory(directory, true),
 		errorHandler:                       errorHandler,
 		lastPrunedEpoch:                    model.NewEvictionIndex[iotago.EpochIndex](),
+		lastPrunedStoreEpochs:              make(map[prunable.StoreType]*model.EvictionIndex[iotago.EpochIndex]),
 		lastAccessedBlocks:                 reactive.NewVariable[iotago.SlotIndex](),
 		optsDBEngine:                       hivedb.EngineRocksDB,
 		optsPruningDelay:                   30,
+		optsPruningDelayOverrides:          make(map[prunable.StoreType]iotago.EpochIndex),
 		optPruningSizeEnabled:              false,
 		optsPruningSizeMaxTargetSizeBytes:  30 * 1024 * 1024 * 1024, // 30GB
 		optsPruningSizeReductionPercentage: 0.1,
@@ -79,10 +88,11 @@ func Create(directory string, dbVersion byte, errorHandler func(error), opts ...
 		Directory:    s.dir.PathWithCreate(permanentDirName),
 		Version:      dbVersion,
 		PrefixHealth: []byte{storePrefixHealth},
+		ReadOnly:     s.optsReadOnly,
 	}
 
-	s.permanent = permanent.New(dbConfig, errorHandler, s.optsPermanent...)
-	s.prunable = prunable.New(dbConfig.WithDirectory(s.dir.PathWithCreate(prunableDirName)), s.Settings().APIProvider(), s.errorHandler, s.optsBucketManagerOptions...)
+	s.permanent = permanent.New(dbConfig.WithSettings(s.optsPermanentDBSettings), errorHandler, s.optsPermanent...)
+	s.prunable = prunable.New(dbConfig.WithDirectory(s.dir.PathWithCreate(prunableDirName)).WithSettings(s.optsPrunableDBSettings), s.Settings().APIProvider(), s.errorHandler, s.optsBucketManagerOptions...)
 
 	return s
 }
@@ -90,6 +100,46 @@ func Create(directory string, dbVersion byte, errorHandler func(error), opts ...
 // Clone creates a new storage instance with the named database version in the given directory and cloning the permannent
 // and prunable counterparts from the given source storage.
 func Clone(source *Storage, directory string, dbVersion byte, errorHandler func(error), opts ...options.Option[Storage]) (*Storage, error) {
+	return clone(source, directory, dbVersion, func(*Storage) (iotago.EpochIndex, iotago.EpochIndex) {
+		return 0, iotago.MaxEpochIndex
+	}, errorHandler, opts...)
+}
+
+// CloneForFork creates a new storage instance like Clone, but only clones the prunable data that is required by an
+// engine that forks at the given slot: the buckets of the epochs that are older than the configured fork retention are
+// considered pruned. The buckets up to the epoch of the latest commitment are always cloned, as rolling back the
+// engine to the forking point reads the diffs of the slots after it.
+func CloneForFork(source *Storage, directory string, dbVersion byte, forkingSlot iotago.SlotIndex, errorHandler func(error), opts ...options.Option[Storage]) (*Storage, error) {
+	return clone(source, directory, dbVersion, func(s *Storage) (iotago.EpochIndex, iotago.EpochIndex) {
+		api := source.Settings().APIProvider().APIForSlot(forkingSlot)
+		forkingEpoch := api.TimeProvider().EpochFromSlot(forkingSlot)
+		latestCommitmentEpoch := api.TimeProvider().EpochFromSlot(source.Settings().LatestCommitment().Slot())
+		endEpoch := max(forkingEpoch, latestCommitmentEpoch)
+
+		if s.optsForkRetainedEpochs == 0 {
+			return 0, endEpoch
+		}
+
+		// always keep the slots within the max committable age, as they are required to rebuild the engine state.
+		var oldestRequiredSlot iotago.SlotIndex
+		if maxCommittableAge := api.ProtocolParameters().MaxCommittableAge(); forkingSlot > maxCommittableAge {
+			oldestRequiredSlot = forkingSlot - maxCommittableAge
+		}
+
+		startEpoch := api.TimeProvider().EpochFromSlot(oldestRequiredSlot)
+		if forkingEpoch > s.optsForkRetainedEpochs {
+			startEpoch = min(startEpoch, forkingEpoch-s.optsForkRetainedEpochs)
+		} else {
+			startEpoch = 0
+		}
+
+		return startEpoch, endEpoch
+	}, errorHandler, opts...)
+}
+
+// clone creates a new storage instance that clones the permanent storage and the prunable storage within the epoch range
+// returned by the given function from the given source storage.
+func clone(source *Storage, directory string, dbVersion byte, prunableEpochRange func(s *Storage) (startEpoch iotago.EpochIndex, endEpoch iotago.EpochIndex), errorHandler func(error), opts ...options.Option[Storage]) (*Storage, error) {
 	s := New(directory, errorHandler, opts...)
 
 	dbConfig := database.Config{
@@ -99,11 +149,13 @@ func Clone(source *Storage, directory string, dbVersion byte, errorHandler func(
 		PrefixHealth: []byte{storePrefixHealth},
 	}
 
-	permanentClone, err := permanent.Clone(source.permanent, dbConfig, errorHandler)
+	startEpoch, endEpoch := prunableEpochRange(s)
+
+	permanentClone, err := permanent.Clone(source.permanent, dbConfig.WithSettings(s.optsPermanentDBSettings), errorHandler)
 	if err != nil {
 		return nil, ierrors.Wrap(err, "error while cloning permanent storage")
 	}
-	prunableClone, err := prunable.Clone(source.prunable, dbConfig.WithDirectory(s.dir.PathWithCreate(prunableDirName)), permanentClone.Settings().APIProvider(), s.errorHandler, s.optsBucketManagerOptions...)
+	prunableClone, err := prunable.CloneEpochs(source.prunable, dbConfig.WithDirectory(s.dir.PathWithCreate(prunableDirName)).WithSettings(s.optsPrunableDBSettings), permanentClone.Settings().APIProvider(), startEpoch, endEpoch, s.errorHandler, s.optsBucketManagerOptions...)
 	if err != nil {
 		return nil, ierrors.Wrap(err, "error while cloning prunable storage")
 	}
@@ -111,6 +163,11 @@ func Clone(source *Storage, directory string, dbVersion byte, errorHandler func(
 	s.permanent = permanentClone
 	s.prunable = prunableClone
 
+	// the epochs that were not cloned are pruned in the clone.
+	if startEpoch > 0 {
+		s.lastPrunedEpoch.MarkEvicted(startEpoch - 1)
+	}
+
 	return s, nil
 }
 
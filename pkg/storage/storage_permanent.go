@@ -2,7 +2,9 @@ package storage
 
 import (
 	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/iota-core/pkg/model"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/utxoledger"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/utxoledger/indexer"
 	"github.com/iotaledger/iota-core/pkg/storage/permanent"
 )
 
@@ -23,3 +25,13 @@ func (s *Storage) Accounts(optRealm ...byte) kvstore.KVStore {
 func (s *Storage) Ledger() *utxoledger.Manager {
 	return s.permanent.UTXOLedger()
 }
+
+// OutputIndexer returns the index of the unspent outputs by address (nil if the index is disabled).
+func (s *Storage) OutputIndexer() *indexer.Indexer {
+	return s.permanent.OutputIndexer()
+}
+
+// StoreLatestCommitment atomically stores the given commitment and sets it as the latest commitment.
+func (s *Storage) StoreLatestCommitment(commitment *model.Commitment) error {
+	return s.permanent.StoreLatestCommitment(commitment)
+}
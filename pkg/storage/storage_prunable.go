@@ -50,7 +50,7 @@ func (s *Storage) Reset() {
 }
 
 func (s *Storage) RootBlocks(slot iotago.SlotIndex) (*slotstore.Store[iotago.BlockID, iotago.CommitmentID], error) {
-	if err := s.permanent.Settings().AdvanceLatestStoredSlot(slot); err != nil {
+	if err := s.advanceLatestStoredSlot(slot); err != nil {
 		return nil, ierrors.Wrap(err, "failed to advance latest stored slot when accessing root blocks")
 	}
 
@@ -62,7 +62,7 @@ func (s *Storage) GenesisRootBlockID() iotago.BlockID {
 }
 
 func (s *Storage) Mutations(slot iotago.SlotIndex) (kvstore.KVStore, error) {
-	if err := s.permanent.Settings().AdvanceLatestStoredSlot(slot); err != nil {
+	if err := s.advanceLatestStoredSlot(slot); err != nil {
 		return nil, ierrors.Wrap(err, "failed to advance latest stored slot when accessing mutations")
 	}
 
@@ -70,7 +70,7 @@ func (s *Storage) Mutations(slot iotago.SlotIndex) (kvstore.KVStore, error) {
 }
 
 func (s *Storage) Attestations(slot iotago.SlotIndex) (kvstore.KVStore, error) {
-	if err := s.permanent.Settings().AdvanceLatestStoredSlot(slot); err != nil {
+	if err := s.advanceLatestStoredSlot(slot); err != nil {
 		return nil, ierrors.Wrap(err, "failed to advance latest stored slot when accessing attestations")
 	}
 
@@ -78,7 +78,7 @@ func (s *Storage) Attestations(slot iotago.SlotIndex) (kvstore.KVStore, error) {
 }
 
 func (s *Storage) AccountDiffs(slot iotago.SlotIndex) (*slotstore.AccountDiffs, error) {
-	if err := s.permanent.Settings().AdvanceLatestStoredSlot(slot); err != nil {
+	if err := s.advanceLatestStoredSlot(slot); err != nil {
 		return nil, ierrors.Wrap(err, "failed to advance latest stored slot when accessing account diffs")
 	}
 
@@ -86,7 +86,7 @@ func (s *Storage) AccountDiffs(slot iotago.SlotIndex) (*slotstore.AccountDiffs,
 }
 
 func (s *Storage) ValidatorPerformances(slot iotago.SlotIndex) (*slotstore.Store[iotago.AccountID, *model.ValidatorPerformance], error) {
-	if err := s.permanent.Settings().AdvanceLatestStoredSlot(slot); err != nil {
+	if err := s.advanceLatestStoredSlot(slot); err != nil {
 		return nil, ierrors.Wrap(err, "failed to advance latest stored slot when accessing validator performances")
 	}
 
@@ -94,7 +94,7 @@ func (s *Storage) ValidatorPerformances(slot iotago.SlotIndex) (*slotstore.Store
 }
 
 func (s *Storage) UpgradeSignals(slot iotago.SlotIndex) (*slotstore.Store[account.SeatIndex, *model.SignaledBlock], error) {
-	if err := s.permanent.Settings().AdvanceLatestStoredSlot(slot); err != nil {
+	if err := s.advanceLatestStoredSlot(slot); err != nil {
 		return nil, ierrors.Wrap(err, "failed to advance latest stored slot when accessing upgrade signals")
 	}
 
@@ -102,7 +102,7 @@ func (s *Storage) UpgradeSignals(slot iotago.SlotIndex) (*slotstore.Store[accoun
 }
 
 func (s *Storage) Roots(slot iotago.SlotIndex) (*slotstore.Store[iotago.CommitmentID, *iotago.Roots], error) {
-	if err := s.permanent.Settings().AdvanceLatestStoredSlot(slot); err != nil {
+	if err := s.advanceLatestStoredSlot(slot); err != nil {
 		return nil, ierrors.Wrap(err, "failed to advance latest stored slot when accessing roots")
 	}
 
@@ -110,13 +110,78 @@ func (s *Storage) Roots(slot iotago.SlotIndex) (*slotstore.Store[iotago.Commitme
 }
 
 func (s *Storage) Retainer(slot iotago.SlotIndex) (*slotstore.Retainer, error) {
-	if err := s.permanent.Settings().AdvanceLatestStoredSlot(slot); err != nil {
+	if err := s.advanceLatestStoredSlot(slot); err != nil {
 		return nil, ierrors.Wrap(err, "failed to advance latest stored slot when accessing retainer")
 	}
 
 	return s.prunable.Retainer(slot)
 }
 
+func (s *Storage) Spenders(slot iotago.SlotIndex) (*slotstore.Store[iotago.TransactionID, *model.Spender], error) {
+	if err := s.advanceLatestStoredSlot(slot); err != nil {
+		return nil, ierrors.Wrap(err, "failed to advance latest stored slot when accessing spenders")
+	}
+
+	return s.prunable.Spenders(slot)
+}
+
+func (s *Storage) ManaTraces(slot iotago.SlotIndex) (*slotstore.Store[iotago.TransactionID, *model.ManaTrace], error) {
+	if err := s.advanceLatestStoredSlot(slot); err != nil {
+		return nil, ierrors.Wrap(err, "failed to advance latest stored slot when accessing mana traces")
+	}
+
+	return s.prunable.ManaTraces(slot)
+}
+
+// BufferedBlocks returns the temporary bucket that the chains use to spill the blocks of the given slot to disk that
+// exceed the capacity of their in-memory block buffers.
+func (s *Storage) BufferedBlocks(slot iotago.SlotIndex) (*slotstore.Blocks, error) {
+	if err := s.advanceLatestStoredSlot(slot); err != nil {
+		return nil, ierrors.Wrap(err, "failed to advance latest stored slot when accessing buffered blocks")
+	}
+
+	return s.prunable.BufferedBlocks(slot)
+}
+
+// FilteredBlocks returns the store that keeps track of the blocks of the given slot that were dropped by the filters.
+func (s *Storage) FilteredBlocks(slot iotago.SlotIndex) (*slotstore.Store[iotago.BlockID, *model.FilteredBlock], error) {
+	if err := s.advanceLatestStoredSlot(slot); err != nil {
+		return nil, ierrors.Wrap(err, "failed to advance latest stored slot when accessing filtered blocks")
+	}
+
+	return s.prunable.FilteredBlocks(slot)
+}
+
+// AccountsAggregates returns the store that keeps track of the aggregated statistics of the accounts ledger at the given
+// slot.
+func (s *Storage) AccountsAggregates(slot iotago.SlotIndex) (*slotstore.Store[iotago.SlotIndex, *model.AccountsAggregates], error) {
+	if err := s.advanceLatestStoredSlot(slot); err != nil {
+		return nil, ierrors.Wrap(err, "failed to advance latest stored slot when accessing accounts aggregates")
+	}
+
+	return s.prunable.AccountsAggregates(slot)
+}
+
+// BlockCommitments returns the store that maps the blocks that were accepted in the given slot to the commitment that
+// includes them.
+func (s *Storage) BlockCommitments(slot iotago.SlotIndex) (*slotstore.Store[iotago.BlockID, iotago.CommitmentID], error) {
+	if err := s.advanceLatestStoredSlot(slot); err != nil {
+		return nil, ierrors.Wrap(err, "failed to advance latest stored slot when accessing block commitments")
+	}
+
+	return s.prunable.BlockCommitments(slot)
+}
+
+// MemPoolWriteAheadLog returns the store that the MemPool records the attached transactions to, which were written while
+// the given slot was the latest committed slot.
+func (s *Storage) MemPoolWriteAheadLog(slot iotago.SlotIndex) (kvstore.KVStore, error) {
+	if err := s.advanceLatestStoredSlot(slot); err != nil {
+		return nil, ierrors.Wrap(err, "failed to advance latest stored slot when accessing mempool write-ahead log")
+	}
+
+	return s.prunable.MemPoolWriteAheadLog(slot)
+}
+
 func (s *Storage) RestoreFromDisk() {
 	s.pruningLock.Lock()
 	defer s.pruningLock.Unlock()
@@ -149,3 +214,12 @@ func (s *Storage) pruningRange(targetSlot iotago.SlotIndex) (targetEpoch iotago.
 
 	return targetEpoch, startSlot, endSlot
 }
+
+// advanceLatestStoredSlot advances the latest stored slot of the settings, unless the storage was opened read-only.
+func (s *Storage) advanceLatestStoredSlot(slot iotago.SlotIndex) error {
+	if s.optsReadOnly {
+		return nil
+	}
+
+	return s.permanent.Settings().AdvanceLatestStoredSlot(slot)
+}
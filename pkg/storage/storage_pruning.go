@@ -5,7 +5,9 @@ import (
 
 	"github.com/iotaledger/hive.go/ierrors"
 	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/iota-core/pkg/model"
 	"github.com/iotaledger/iota-core/pkg/storage/database"
+	"github.com/iotaledger/iota-core/pkg/storage/prunable"
 	iotago "github.com/iotaledger/iota.go/v4"
 )
 
@@ -31,8 +33,13 @@ func (s *Storage) LastPrunedEpoch() (epoch iotago.EpochIndex, hasPruned bool) {
 }
 
 func (s *Storage) TryPrune() error {
-	// Prune finalizedEpoch - s.optsPruningDelay if possible.
-	if _, _, err := s.PruneByDepth(s.optsPruningDelay); err != nil {
+	// Prune the stores that have a shorter retention window than the buckets they are stored in.
+	if err := s.pruneStoresByDepth(); err != nil {
+		return ierrors.Wrap(err, "failed to prune stores by depth")
+	}
+
+	// Prune finalizedEpoch - s.bucketPruningDelay() if possible.
+	if _, _, err := s.PruneByDepth(s.bucketPruningDelay()); err != nil {
 		if ierrors.Is(err, database.ErrNoPruningNeeded) || ierrors.Is(err, database.ErrEpochPruned) {
 			return nil
 		}
@@ -52,6 +59,17 @@ func (s *Storage) TryPrune() error {
 	return nil
 }
 
+// SetPruningSizeOptions changes the options of the pruning by size at runtime.
+func (s *Storage) SetPruningSizeOptions(enabled bool, maxTargetSizeBytes int64, reductionPercentage float64, cooldownTime time.Duration) {
+	s.pruningLock.Lock()
+	defer s.pruningLock.Unlock()
+
+	s.optPruningSizeEnabled = enabled
+	s.optsPruningSizeMaxTargetSizeBytes = maxTargetSizeBytes
+	s.optsPruningSizeReductionPercentage = reductionPercentage
+	s.optsPruningSizeCooldownTime = cooldownTime
+}
+
 // PruneByEpochIndex prunes the database until the given epoch. It returns an error if the epoch is too old or too new.
 // It is to be called by the user e.g. via the WebAPI.
 func (s *Storage) PruneByEpochIndex(epoch iotago.EpochIndex) error {
@@ -114,14 +132,14 @@ func (s *Storage) PruneByDepth(epochDepth iotago.EpochIndex) (firstPruned iotago
 }
 
 func (s *Storage) PruneBySize(targetSizeMaxBytes ...int64) error {
+	s.pruningLock.Lock()
+	defer s.pruningLock.Unlock()
+
 	// pruning by size deactivated
 	if !s.optPruningSizeEnabled && len(targetSizeMaxBytes) == 0 {
 		return database.ErrNoPruningNeeded
 	}
 
-	s.pruningLock.Lock()
-	defer s.pruningLock.Unlock()
-
 	if time.Since(s.lastPrunedSizeTime) < s.optsPruningSizeCooldownTime {
 		return ierrors.Wrapf(database.ErrNoPruningNeeded, "last pruning by size was %s ago, cooldown time is %s", time.Since(s.lastPrunedSizeTime), s.optsPruningSizeCooldownTime)
 	}
@@ -187,6 +205,69 @@ func (s *Storage) PruneBySize(targetSizeMaxBytes ...int64) error {
 	return nil
 }
 
+// bucketPruningDelay returns the pruning delay of the buckets, which is the longest retention window of all stores.
+func (s *Storage) bucketPruningDelay() iotago.EpochIndex {
+	pruningDelay := s.optsPruningDelay
+	for _, storePruningDelay := range s.optsPruningDelayOverrides {
+		pruningDelay = max(pruningDelay, storePruningDelay)
+	}
+
+	return pruningDelay
+}
+
+// storePruningDelay returns the pruning delay of the given store type.
+func (s *Storage) storePruningDelay(storeType prunable.StoreType) iotago.EpochIndex {
+	if pruningDelay, exists := s.optsPruningDelayOverrides[storeType]; exists {
+		return pruningDelay
+	}
+
+	return s.optsPruningDelay
+}
+
+// pruneStoresByDepth prunes the data of all stores whose pruning delay is shorter than the one of the buckets, as their
+// data would otherwise be retained until the whole bucket is deleted.
+func (s *Storage) pruneStoresByDepth() error {
+	// Depth of 0 and 1 means we prune to the latestPrunableEpoch.
+	bucketPruningDelay := max(s.bucketPruningDelay(), 1)
+	latestPrunableEpoch := s.latestPrunableEpoch()
+
+	s.pruningLock.Lock()
+	defer s.pruningLock.Unlock()
+
+	s.setIsPruning(true)
+	defer s.setIsPruning(false)
+
+	for _, storeType := range prunable.StoreTypes() {
+		epochDepth := max(s.storePruningDelay(storeType), 1)
+		if epochDepth >= bucketPruningDelay || epochDepth > latestPrunableEpoch {
+			continue
+		}
+
+		lastPrunedStoreEpoch, exists := s.lastPrunedStoreEpochs[storeType]
+		if !exists {
+			lastPrunedStoreEpoch = model.NewEvictionIndex[iotago.EpochIndex]()
+			s.lastPrunedStoreEpochs[storeType] = lastPrunedStoreEpoch
+		}
+
+		// Epochs whose bucket is already deleted do not need to be pruned again.
+		start := max(s.lastPrunedEpoch.NextIndex(), lastPrunedStoreEpoch.NextIndex())
+		end := latestPrunableEpoch - (epochDepth - 1)
+		if start > end {
+			continue
+		}
+
+		for epoch := start; epoch <= end; epoch++ {
+			if err := s.prunable.PruneStore(epoch, storeType); err != nil {
+				return ierrors.Wrapf(err, "failed to prune store %s from epoch %d to %d", storeType, start, end)
+			}
+		}
+
+		lastPrunedStoreEpoch.MarkEvicted(end)
+	}
+
+	return nil
+}
+
 func (s *Storage) getPruningStart(epoch iotago.EpochIndex) (iotago.EpochIndex, bool) {
 	lastPrunedEpoch, hasPruned := s.lastPrunedEpoch.Index()
 	if hasPruned && epoch <= lastPrunedEpoch {
@@ -1,15 +1,25 @@
 package storage_test
 
 import (
+	"os"
+	"path/filepath"
+	"strconv"
 	"testing"
 
 	"github.com/stretchr/testify/require"
 
+	"github.com/iotaledger/hive.go/ds"
 	"github.com/iotaledger/hive.go/ds/types"
 	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/accounts/accountsledger"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
 	"github.com/iotaledger/iota-core/pkg/storage"
 	"github.com/iotaledger/iota-core/pkg/storage/database"
+	"github.com/iotaledger/iota-core/pkg/storage/prunable"
 	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/tpkg"
 )
 
 func TestStorage_PruneByEpochIndex(t *testing.T) {
@@ -321,3 +331,211 @@ func TestStorage_CopyFromForkedStorageEmpty(t *testing.T) {
 		}))
 	}
 }
+
+func TestStorage_CloneForFork(t *testing.T) {
+	tf := NewTestFramework(t, t.TempDir())
+	defer tf.Shutdown()
+
+	totalEpochs := 14
+	for i := 0; i <= totalEpochs; i++ {
+		tf.GeneratePrunableData(iotago.EpochIndex(i), 10*KB)
+		tf.GenerateSemiPermanentData(iotago.EpochIndex(i))
+	}
+
+	// fork in epoch 10 and retain 3 epochs before the forking point.
+	forkingSlot := tf.apiProvider.CommittedAPI().TimeProvider().EpochStart(10) + 5
+
+	clonedDirectory := t.TempDir()
+	clonedStorage, err := storage.CloneForFork(tf.Instance, clonedDirectory, 0, forkingSlot, func(err error) {
+		t.Log(err)
+	}, storage.WithForkRetainedEpochs(3))
+	require.NoError(t, err)
+	defer clonedStorage.Shutdown()
+
+	for i := 0; i <= totalEpochs; i++ {
+		_, statErr := os.Stat(filepath.Join(clonedDirectory, "prunable", strconv.Itoa(i)))
+		require.Equal(t, i >= 7 && i <= 10, statErr == nil, "unexpected bucket state for epoch %d", i)
+	}
+
+	// the semi-permanent storage is cloned and the buckets that were not cloned are considered pruned.
+	_, err = os.Stat(filepath.Join(clonedDirectory, "prunable", "semipermanent"))
+	require.NoError(t, err)
+
+	lastPrunedEpoch, hasPruned := clonedStorage.LastPrunedEpoch()
+	require.True(t, hasPruned)
+	require.Equal(t, iotago.EpochIndex(6), lastPrunedEpoch)
+
+	clonedStorage.RestoreFromDisk()
+
+	lastPrunedEpoch, hasPruned = clonedStorage.LastPrunedEpoch()
+	require.True(t, hasPruned)
+	require.Equal(t, iotago.EpochIndex(6), lastPrunedEpoch)
+}
+
+func TestStorage_CloneForForkAcrossEpochs(t *testing.T) {
+	tf := NewTestFramework(t, t.TempDir())
+	defer tf.Shutdown()
+
+	timeProvider := tf.apiProvider.CommittedAPI().TimeProvider()
+	blockFunc := func(iotago.BlockID) (*blocks.Block, bool) { return nil, false }
+
+	accountsLedger := accountsledger.New(tf.Instance.Settings().APIProvider(), blockFunc, tf.Instance.AccountDiffs, tf.Instance.AccountsAggregates, tf.Instance.Accounts())
+
+	accountID := tpkg.RandAccountID()
+	previousOutputID, previousUpdatedSlot := iotago.EmptyOutputID, iotago.SlotIndex(0)
+	applyDiff := func(slot iotago.SlotIndex) {
+		accountDiff := model.NewAccountDiff()
+		accountDiff.BICChange = 100
+		accountDiff.PreviousUpdatedSlot = previousUpdatedSlot
+		accountDiff.PreviousOutputID = previousOutputID
+		accountDiff.NewOutputID = tpkg.RandOutputIDWithCreationSlot(slot)
+
+		accountsLedger.SetLatestCommittedSlot(slot - 1)
+		require.NoError(t, accountsLedger.ApplyDiff(slot, 0, map[iotago.AccountID]*model.AccountDiff{accountID: accountDiff}, ds.NewSet[iotago.AccountID]()))
+
+		previousOutputID, previousUpdatedSlot = accountDiff.NewOutputID, slot
+	}
+
+	// change the account in epoch 1 and in the epochs after the forking point.
+	accountSlot := timeProvider.EpochStart(1) + 1
+	applyDiff(accountSlot)
+	expectedAccount, exists, err := accountsLedger.Account(accountID, accountSlot)
+	require.NoError(t, err)
+	require.True(t, exists)
+
+	applyDiff(timeProvider.EpochStart(2) + 1)
+	latestCommittedSlot := timeProvider.EpochStart(3) + 1
+	applyDiff(latestCommittedSlot)
+
+	latestCommitment, err := model.CommitmentFromCommitment(iotago.NewCommitment(tf.apiProvider.CommittedAPI().Version(), latestCommittedSlot, iotago.EmptyCommitmentID, iotago.EmptyIdentifier, 0, 0), tf.apiProvider.CommittedAPI())
+	require.NoError(t, err)
+	require.NoError(t, tf.Instance.Settings().SetLatestCommitment(latestCommitment))
+
+	forkingSlot := accountSlot + 5
+	clonedDirectory := t.TempDir()
+	clonedStorage, err := storage.CloneForFork(tf.Instance, clonedDirectory, 0, forkingSlot, func(err error) {
+		t.Log(err)
+	}, storage.WithForkRetainedEpochs(1))
+	require.NoError(t, err)
+	defer clonedStorage.Shutdown()
+
+	// the buckets up to the epoch of the latest commitment are cloned, as they are required to roll back the fork.
+	for i := 1; i <= 3; i++ {
+		_, statErr := os.Stat(filepath.Join(clonedDirectory, "prunable", strconv.Itoa(i)))
+		require.NoError(t, statErr, "missing bucket for epoch %d", i)
+	}
+
+	clonedAccountsLedger := accountsledger.New(clonedStorage.Settings().APIProvider(), blockFunc, clonedStorage.AccountDiffs, clonedStorage.AccountsAggregates, clonedStorage.Accounts())
+	clonedAccountsLedger.SetLatestCommittedSlot(latestCommittedSlot)
+	require.NoError(t, clonedAccountsLedger.Rollback(forkingSlot))
+	clonedAccountsLedger.SetLatestCommittedSlot(forkingSlot)
+
+	rolledBackAccount, exists, err := clonedAccountsLedger.Account(accountID, forkingSlot)
+	require.NoError(t, err)
+	require.True(t, exists)
+	require.Equal(t, expectedAccount.OutputID, rolledBackAccount.OutputID)
+	require.Equal(t, expectedAccount.Credits, rolledBackAccount.Credits)
+}
+
+func TestStorage_PruneWithPruningDelayOverride(t *testing.T) {
+	tf := NewTestFramework(t, t.TempDir(),
+		storage.WithPruningDelay(2),
+		storage.WithPruningDelayOverride(prunable.StoreTypeAttestations, 5),
+	)
+	defer tf.Shutdown()
+
+	key := []byte("key")
+	epochStartSlot := func(epoch iotago.EpochIndex) iotago.SlotIndex {
+		return tf.apiProvider.APIForEpoch(epoch).TimeProvider().EpochStart(epoch)
+	}
+
+	totalEpochs := 10
+	for i := 0; i <= totalEpochs; i++ {
+		slot := epochStartSlot(iotago.EpochIndex(i))
+
+		mutations, err := tf.Instance.Mutations(slot)
+		require.NoError(t, err)
+		require.NoError(t, mutations.Set(key, key))
+
+		attestations, err := tf.Instance.Attestations(slot)
+		require.NoError(t, err)
+		require.NoError(t, attestations.Set(key, key))
+	}
+
+	tf.SetLatestFinalizedEpoch(9)
+
+	// The latest prunable epoch is 8: buckets are pruned until 8-(5-1)=4, mutations until 8-(2-1)=7.
+	require.NoError(t, tf.Instance.TryPrune())
+
+	lastPrunedEpoch, hasPruned := tf.Instance.LastPrunedEpoch()
+	require.True(t, hasPruned)
+	require.EqualValues(t, 4, lastPrunedEpoch)
+
+	for i := 0; i <= totalEpochs; i++ {
+		epoch := iotago.EpochIndex(i)
+		slot := epochStartSlot(epoch)
+
+		mutations, mutationsErr := tf.Instance.Mutations(slot)
+		attestations, attestationsErr := tf.Instance.Attestations(slot)
+
+		if epoch <= 4 {
+			require.ErrorIs(t, mutationsErr, database.ErrEpochPruned)
+			require.ErrorIs(t, attestationsErr, database.ErrEpochPruned)
+
+			continue
+		}
+
+		require.NoError(t, mutationsErr)
+		require.NoError(t, attestationsErr)
+
+		hasMutation, err := mutations.Has(key)
+		require.NoError(t, err)
+		require.Equal(t, epoch > 7, hasMutation, "mutations of epoch %d", epoch)
+
+		hasAttestation, err := attestations.Has(key)
+		require.NoError(t, err)
+		require.True(t, hasAttestation, "attestations of epoch %d", epoch)
+	}
+}
+
+func TestStorage_StoreLatestCommitment(t *testing.T) {
+	tf := NewTestFramework(t, t.TempDir())
+	defer tf.Shutdown()
+
+	apiForSlot := tf.Instance.Settings().APIProvider().LatestAPI()
+	previousCommitment := lo.PanicOnErr(model.CommitmentFromCommitment(iotago.NewCommitment(apiForSlot.Version(), 3, tpkg.RandCommitmentID(), tpkg.Rand32ByteArray(), 5, 20), apiForSlot))
+	commitment := lo.PanicOnErr(model.CommitmentFromCommitment(iotago.NewCommitment(apiForSlot.Version(), 5, tpkg.RandCommitmentID(), tpkg.Rand32ByteArray(), 10, 20), apiForSlot))
+
+	require.NoError(t, tf.Instance.Settings().SetLatestCommitment(previousCommitment))
+	require.Equal(t, previousCommitment.ID(), tf.Instance.Settings().LatestCommitment().ID())
+
+	// the in-memory state of the settings follows the batched write.
+	require.NoError(t, tf.Instance.StoreLatestCommitment(commitment))
+	require.Equal(t, commitment.ID(), tf.Instance.Settings().LatestCommitment().ID())
+	require.Equal(t, commitment.ID(), lo.PanicOnErr(tf.Instance.Commitments().Load(5)).ID())
+
+	// the commitment and the settings are persisted together.
+	tf.RestoreFromDisk()
+
+	require.Equal(t, commitment.ID(), tf.Instance.Settings().LatestCommitment().ID())
+	require.Equal(t, commitment.ID(), lo.PanicOnErr(tf.Instance.Commitments().Load(5)).ID())
+}
+
+func TestStorage_CheckProtocolParameters(t *testing.T) {
+	tf := NewTestFramework(t, t.TempDir())
+	defer tf.Shutdown()
+
+	require.NoError(t, tf.Instance.Settings().CheckProtocolParameters())
+
+	// a decided hash that matches the stored protocol parameters is consistent.
+	protocolParams := tf.Instance.Settings().APIProvider().LatestAPI().ProtocolParameters()
+	require.NoError(t, tf.Instance.Settings().StoreFutureProtocolParametersHash(protocolParams.Version(), lo.PanicOnErr(protocolParams.Hash()), 0))
+	require.NoError(t, tf.Instance.Settings().CheckProtocolParameters())
+
+	// every divergence from the stored protocol parameters and their epoch mapping is reported.
+	require.NoError(t, tf.Instance.Settings().StoreFutureProtocolParametersHash(protocolParams.Version(), tpkg.Rand32ByteArray(), 0))
+	require.ErrorContains(t, tf.Instance.Settings().CheckProtocolParameters(), "found 1 protocol parameters inconsistencies")
+
+	require.NoError(t, tf.Instance.Settings().StoreFutureProtocolParametersHash(protocolParams.Version(), tpkg.Rand32ByteArray(), 2))
+	require.ErrorContains(t, tf.Instance.Settings().CheckProtocolParameters(), "found 3 protocol parameters inconsistencies")
+}
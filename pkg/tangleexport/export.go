@@ -0,0 +1,63 @@
+package tangleexport
+
+import (
+	"io"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine"
+	"github.com/iotaledger/iota-core/pkg/storage/prunable/slotstore"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// ErrInvalidSlotRange is returned when the end of the requested slot range lies before its start.
+var ErrInvalidSlotRange = ierrors.New("invalid slot range")
+
+// Export writes all blocks (and their parent references) of the slots in the range [startSlot, endSlot] that are
+// contained in the block storage of the given engine to the given io.Writer.
+//
+// The blocks are enriched with the acceptance flags of the retainer of the engine. The storage buckets of all slots are
+// opened before anything is written, so that an error caused by already pruned slots is returned before the output is
+// touched.
+func Export(engineInstance *engine.Engine, startSlot iotago.SlotIndex, endSlot iotago.SlotIndex, format Format, w io.Writer) error {
+	if endSlot < startSlot {
+		return ierrors.Wrapf(ErrInvalidSlotRange, "end slot %d is before start slot %d", endSlot, startSlot)
+	}
+
+	blockStores := make([]*slotstore.Blocks, 0, endSlot-startSlot+1)
+	for slot := startSlot; slot <= endSlot; slot++ {
+		blockStore, err := engineInstance.Storage.Blocks(slot)
+		if err != nil {
+			return ierrors.Wrapf(err, "failed to get block storage bucket for slot %d", slot)
+		}
+
+		blockStores = append(blockStores, blockStore)
+	}
+
+	writer, err := NewWriter(format, w)
+	if err != nil {
+		return err
+	}
+
+	for i, blockStore := range blockStores {
+		// the errors of the consumer are not propagated by the storage, so we keep track of them ourselves
+		var writeErr error
+		if err = blockStore.ForEachBlockInSlot(func(block *model.Block) error {
+			metadata, metadataErr := engineInstance.Retainer.BlockMetadata(block.ID())
+			if metadataErr != nil {
+				// the block is still exported but its acceptance flags are unknown
+				metadata = nil
+			}
+
+			writeErr = writer.Write(NewVertex(block, metadata))
+
+			return writeErr
+		}); err != nil {
+			return ierrors.Wrapf(err, "failed to export blocks of slot %d", startSlot+iotago.SlotIndex(i))
+		} else if writeErr != nil {
+			return ierrors.Wrapf(writeErr, "failed to write blocks of slot %d", startSlot+iotago.SlotIndex(i))
+		}
+	}
+
+	return writer.Close()
+}
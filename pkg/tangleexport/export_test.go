@@ -0,0 +1,152 @@
+package tangleexport_test
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"encoding/xml"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/retainer"
+	"github.com/iotaledger/iota-core/pkg/tangleexport"
+	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/api"
+	"github.com/iotaledger/iota.go/v4/tpkg"
+)
+
+func TestNewVertex(t *testing.T) {
+	block := lo.PanicOnErr(model.BlockFromBlock(tpkg.RandBlock(tpkg.RandValidationBlockBody(tpkg.ZeroCostTestAPI), tpkg.ZeroCostTestAPI, 0)))
+
+	vertex := tangleexport.NewVertex(block, nil)
+	require.Equal(t, block.ID(), vertex.ID)
+	require.Equal(t, tangleexport.BlockTypeValidation, vertex.Type)
+	require.Equal(t, block.ProtocolBlock().Body.StrongParentIDs(), vertex.StrongParents)
+	require.Equal(t, api.BlockStateUnknown.String(), vertex.BlockState)
+	require.False(t, vertex.Accepted)
+
+	vertex = tangleexport.NewVertex(block, &retainer.BlockMetadata{BlockState: api.BlockStateAccepted, AcceptedSlot: 5})
+	require.True(t, vertex.Accepted)
+	require.False(t, vertex.Confirmed)
+	require.False(t, vertex.Finalized)
+
+	// finalized blocks that were confirmed before keep their confirmation flag.
+	vertex = tangleexport.NewVertex(block, &retainer.BlockMetadata{BlockState: api.BlockStateFinalized, AcceptedSlot: 5, ConfirmedSlot: 6, FinalizedSlot: 7})
+	require.True(t, vertex.Accepted)
+	require.True(t, vertex.Confirmed)
+	require.True(t, vertex.Finalized)
+}
+
+func TestWriter_JSONL(t *testing.T) {
+	vertices := testVertices()
+
+	var buffer bytes.Buffer
+	writer := lo.PanicOnErr(tangleexport.NewWriter(tangleexport.FormatJSONL, &buffer))
+	for _, vertex := range vertices {
+		require.NoError(t, writer.Write(vertex))
+	}
+	require.NoError(t, writer.Close())
+
+	decodedVertices := make([]*tangleexport.Vertex, 0)
+	for scanner := bufio.NewScanner(&buffer); scanner.Scan(); {
+		decodedVertex := new(tangleexport.Vertex)
+		require.NoError(t, json.Unmarshal(scanner.Bytes(), decodedVertex))
+
+		decodedVertices = append(decodedVertices, decodedVertex)
+	}
+
+	require.Len(t, decodedVertices, len(vertices))
+	for i, decodedVertex := range decodedVertices {
+		require.Equal(t, vertices[i].ID, decodedVertex.ID)
+		require.Equal(t, vertices[i].StrongParents, decodedVertex.StrongParents)
+		require.Equal(t, vertices[i].Accepted, decodedVertex.Accepted)
+	}
+}
+
+func TestWriter_GraphML(t *testing.T) {
+	vertices := testVertices()
+
+	var buffer bytes.Buffer
+	writer := lo.PanicOnErr(tangleexport.NewWriter(tangleexport.FormatGraphML, &buffer))
+	for _, vertex := range vertices {
+		require.NoError(t, writer.Write(vertex))
+	}
+	require.NoError(t, writer.Close())
+
+	var document struct {
+		Keys  []struct{} `xml:"key"`
+		Graph struct {
+			EdgeDefault string `xml:"edgedefault,attr"`
+			Nodes       []struct {
+				ID   string `xml:"id,attr"`
+				Data []struct {
+					Key   string `xml:"key,attr"`
+					Value string `xml:",chardata"`
+				} `xml:"data"`
+			} `xml:"node"`
+			Edges []struct {
+				Source string `xml:"source,attr"`
+				Target string `xml:"target,attr"`
+				Data   []struct {
+					Key   string `xml:"key,attr"`
+					Value string `xml:",chardata"`
+				} `xml:"data"`
+			} `xml:"edge"`
+		} `xml:"graph"`
+	}
+	require.NoError(t, xml.Unmarshal(buffer.Bytes(), &document))
+
+	require.NotEmpty(t, document.Keys)
+	require.Equal(t, "directed", document.Graph.EdgeDefault)
+
+	// the two exported vertices and the placeholder of the parent outside the exported range.
+	require.Len(t, document.Graph.Nodes, 3)
+	require.Equal(t, vertices[0].ID.ToHex(), document.Graph.Nodes[0].ID)
+	require.Equal(t, vertices[1].ID.ToHex(), document.Graph.Nodes[1].ID)
+	require.Equal(t, vertices[0].StrongParents[0].ToHex(), document.Graph.Nodes[2].ID)
+	require.Contains(t, document.Graph.Nodes[2].Data, struct {
+		Key   string `xml:"key,attr"`
+		Value string `xml:",chardata"`
+	}{Key: "placeholder", Value: "true"})
+
+	require.Len(t, document.Graph.Edges, 3)
+	require.Equal(t, vertices[1].ID.ToHex(), document.Graph.Edges[1].Source)
+	require.Equal(t, vertices[0].ID.ToHex(), document.Graph.Edges[1].Target)
+	require.Equal(t, tangleexport.ReferenceTypeStrong, document.Graph.Edges[1].Data[0].Value)
+	require.Equal(t, tangleexport.ReferenceTypeWeak, document.Graph.Edges[2].Data[0].Value)
+}
+
+func TestParseFormat(t *testing.T) {
+	require.Equal(t, tangleexport.FormatGraphML, lo.PanicOnErr(tangleexport.ParseFormat("graphml")))
+	require.Equal(t, tangleexport.FormatJSONL, lo.PanicOnErr(tangleexport.ParseFormat("jsonl")))
+
+	_, err := tangleexport.ParseFormat("dot")
+	require.ErrorIs(t, err, tangleexport.ErrUnknownFormat)
+}
+
+// testVertices returns two vertices where the second one references the first one strongly and a block outside the
+// exported range weakly.
+func testVertices() []*tangleexport.Vertex {
+	outOfRangeParent := tpkg.RandBlockID()
+
+	first := &tangleexport.Vertex{
+		ID:            tpkg.RandBlockID(),
+		Type:          tangleexport.BlockTypeValidation,
+		StrongParents: iotago.BlockIDs{outOfRangeParent},
+		BlockState:    api.BlockStateAccepted.String(),
+		Accepted:      true,
+	}
+
+	second := &tangleexport.Vertex{
+		ID:            tpkg.RandBlockID(),
+		Type:          tangleexport.BlockTypeBasic,
+		StrongParents: iotago.BlockIDs{first.ID},
+		WeakParents:   iotago.BlockIDs{outOfRangeParent},
+		BlockState:    api.BlockStatePending.String(),
+	}
+
+	return []*tangleexport.Vertex{first, second}
+}
@@ -0,0 +1,98 @@
+package tangleexport
+
+import (
+	"time"
+
+	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/retainer"
+	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/api"
+)
+
+const (
+	// BlockTypeBasic is the type of basic blocks.
+	BlockTypeBasic = "basic"
+
+	// BlockTypeValidation is the type of validation blocks.
+	BlockTypeValidation = "validation"
+
+	// ReferenceTypeStrong is the type of strong parent references.
+	ReferenceTypeStrong = "strong"
+
+	// ReferenceTypeWeak is the type of weak parent references.
+	ReferenceTypeWeak = "weak"
+
+	// ReferenceTypeShallowLike is the type of shallow like parent references.
+	ReferenceTypeShallowLike = "shallowLike"
+)
+
+// Vertex is the exported representation of a block and its references in the tangle.
+type Vertex struct {
+	ID                 iotago.BlockID      `json:"id"`
+	Slot               iotago.SlotIndex    `json:"slot"`
+	Type               string              `json:"type"`
+	IssuerID           iotago.AccountID    `json:"issuerId"`
+	IssuingTime        time.Time           `json:"issuingTime"`
+	SlotCommitmentID   iotago.CommitmentID `json:"slotCommitmentId"`
+	StrongParents      iotago.BlockIDs     `json:"strongParents"`
+	WeakParents        iotago.BlockIDs     `json:"weakParents"`
+	ShallowLikeParents iotago.BlockIDs     `json:"shallowLikeParents"`
+	BlockState         string              `json:"blockState"`
+	Accepted           bool                `json:"accepted"`
+	Confirmed          bool                `json:"confirmed"`
+	Finalized          bool                `json:"finalized"`
+}
+
+// NewVertex creates a new Vertex from the given block and its (optional) metadata of the retainer.
+func NewVertex(block *model.Block, metadata *retainer.BlockMetadata) *Vertex {
+	protocolBlock := block.ProtocolBlock()
+
+	v := &Vertex{
+		ID:                 block.ID(),
+		Slot:               block.ID().Slot(),
+		Type:               BlockTypeBasic,
+		IssuerID:           protocolBlock.Header.IssuerID,
+		IssuingTime:        protocolBlock.Header.IssuingTime,
+		SlotCommitmentID:   protocolBlock.Header.SlotCommitmentID,
+		StrongParents:      protocolBlock.Body.StrongParentIDs(),
+		WeakParents:        protocolBlock.Body.WeakParentIDs(),
+		ShallowLikeParents: protocolBlock.Body.ShallowLikeParentIDs(),
+		BlockState:         api.BlockStateUnknown.String(),
+	}
+
+	if _, isValidationBlock := block.ValidationBlock(); isValidationBlock {
+		v.Type = BlockTypeValidation
+	}
+
+	if metadata != nil {
+		v.BlockState = metadata.BlockState.String()
+		v.Finalized = metadata.BlockState == api.BlockStateFinalized || metadata.FinalizedSlot != 0
+		v.Confirmed = metadata.BlockState == api.BlockStateConfirmed || metadata.ConfirmedSlot != 0
+		v.Accepted = v.Confirmed || v.Finalized || metadata.BlockState == api.BlockStateAccepted || metadata.AcceptedSlot != 0
+	}
+
+	return v
+}
+
+// References returns the references of the vertex to its parents (in the order strong, weak, shallow like).
+func (v *Vertex) References() []*Reference {
+	references := make([]*Reference, 0, len(v.StrongParents)+len(v.WeakParents)+len(v.ShallowLikeParents))
+	appendReferences := func(referenceType string, parents iotago.BlockIDs) {
+		for _, parent := range parents {
+			references = append(references, &Reference{Child: v.ID, Parent: parent, Type: referenceType})
+		}
+	}
+
+	appendReferences(ReferenceTypeStrong, v.StrongParents)
+	appendReferences(ReferenceTypeWeak, v.WeakParents)
+	appendReferences(ReferenceTypeShallowLike, v.ShallowLikeParents)
+
+	return references
+}
+
+// Reference is a directed reference of a block to one of its parents.
+type Reference struct {
+	Child  iotago.BlockID
+	Parent iotago.BlockID
+	Type   string
+}
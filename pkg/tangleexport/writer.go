@@ -0,0 +1,269 @@
+package tangleexport
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/iotaledger/hive.go/ds"
+	"github.com/iotaledger/hive.go/ds/orderedmap"
+	"github.com/iotaledger/hive.go/ds/types"
+	"github.com/iotaledger/hive.go/ierrors"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// Format is the format of an export.
+type Format string
+
+const (
+	// FormatJSONL writes one JSON encoded vertex per line.
+	FormatJSONL Format = "jsonl"
+
+	// FormatGraphML writes the vertices and their references as a directed GraphML graph.
+	FormatGraphML Format = "graphml"
+)
+
+// ErrUnknownFormat is returned when an unknown export format is requested.
+var ErrUnknownFormat = ierrors.New("unknown export format")
+
+// ParseFormat parses the given string into a Format.
+func ParseFormat(format string) (Format, error) {
+	switch Format(format) {
+	case FormatJSONL, FormatGraphML:
+		return Format(format), nil
+	default:
+		return "", ierrors.Wrapf(ErrUnknownFormat, "format %q", format)
+	}
+}
+
+// ContentType returns the MIME type of the format.
+func (f Format) ContentType() string {
+	switch f {
+	case FormatGraphML:
+		return "application/graphml+xml"
+	default:
+		return "application/x-ndjson"
+	}
+}
+
+// Writer writes vertices to an underlying io.Writer.
+type Writer interface {
+	// Write writes the given vertex.
+	Write(vertex *Vertex) error
+
+	// Close finishes the export (it does not close the underlying io.Writer).
+	Close() error
+}
+
+// NewWriter creates a new Writer for the given format.
+func NewWriter(format Format, w io.Writer) (Writer, error) {
+	switch format {
+	case FormatJSONL:
+		return newJSONLWriter(w), nil
+	case FormatGraphML:
+		return newGraphMLWriter(w), nil
+	default:
+		return nil, ierrors.Wrapf(ErrUnknownFormat, "format %q", format)
+	}
+}
+
+// jsonlWriter writes one JSON encoded vertex per line.
+type jsonlWriter struct {
+	encoder *json.Encoder
+}
+
+func newJSONLWriter(w io.Writer) *jsonlWriter {
+	return &jsonlWriter{
+		encoder: json.NewEncoder(w),
+	}
+}
+
+func (j *jsonlWriter) Write(vertex *Vertex) error {
+	return j.encoder.Encode(vertex)
+}
+
+func (j *jsonlWriter) Close() error {
+	return nil
+}
+
+// graphMLWriter writes the vertices as nodes and their references as edges of a directed GraphML graph.
+type graphMLWriter struct {
+	encoder *xml.Encoder
+
+	// writtenNodes contains the IDs of the nodes that were written already.
+	writtenNodes ds.Set[iotago.BlockID]
+
+	// referencedNodes contains the IDs of all referenced parents (in the order of their first reference), so that the
+	// parents outside the exported range can be written as placeholder nodes when closing the writer.
+	referencedNodes *orderedmap.OrderedMap[iotago.BlockID, types.Empty]
+
+	// headerWritten indicates whether the opening elements of the document were written.
+	headerWritten bool
+}
+
+func newGraphMLWriter(w io.Writer) *graphMLWriter {
+	encoder := xml.NewEncoder(w)
+	encoder.Indent("", "  ")
+
+	return &graphMLWriter{
+		encoder:         encoder,
+		writtenNodes:    ds.NewSet[iotago.BlockID](),
+		referencedNodes: orderedmap.New[iotago.BlockID, types.Empty](),
+	}
+}
+
+func (g *graphMLWriter) Write(vertex *Vertex) error {
+	if err := g.writeHeader(); err != nil {
+		return err
+	}
+
+	if err := g.writeNode(vertex.ID, []*graphMLData{
+		{Key: "slot", Value: strconv.FormatUint(uint64(vertex.Slot), 10)},
+		{Key: "type", Value: vertex.Type},
+		{Key: "issuerId", Value: vertex.IssuerID.ToHex()},
+		{Key: "issuingTime", Value: vertex.IssuingTime.UTC().Format(time.RFC3339Nano)},
+		{Key: "slotCommitmentId", Value: vertex.SlotCommitmentID.ToHex()},
+		{Key: "blockState", Value: vertex.BlockState},
+		{Key: "accepted", Value: strconv.FormatBool(vertex.Accepted)},
+		{Key: "confirmed", Value: strconv.FormatBool(vertex.Confirmed)},
+		{Key: "finalized", Value: strconv.FormatBool(vertex.Finalized)},
+	}); err != nil {
+		return err
+	}
+
+	for _, reference := range vertex.References() {
+		g.referencedNodes.Set(reference.Parent, types.Void)
+
+		if err := g.encoder.Encode(&graphMLEdge{
+			Source: reference.Child.ToHex(),
+			Target: reference.Parent.ToHex(),
+			Data:   []*graphMLData{{Key: "referenceType", Value: reference.Type}},
+		}); err != nil {
+			return ierrors.Wrapf(err, "failed to write edge from %s to %s", reference.Child, reference.Parent)
+		}
+	}
+
+	return nil
+}
+
+func (g *graphMLWriter) Close() (err error) {
+	if err = g.writeHeader(); err != nil {
+		return err
+	}
+
+	g.referencedNodes.ForEach(func(blockID iotago.BlockID, _ types.Empty) bool {
+		if !g.writtenNodes.Has(blockID) {
+			err = g.writeNode(blockID, []*graphMLData{
+				{Key: "slot", Value: strconv.FormatUint(uint64(blockID.Slot()), 10)},
+				{Key: "placeholder", Value: "true"},
+			})
+		}
+
+		return err == nil
+	})
+	if err != nil {
+		return err
+	}
+
+	if err = g.encoder.EncodeToken(xml.EndElement{Name: xml.Name{Local: "graph"}}); err != nil {
+		return ierrors.Wrap(err, "failed to close graph element")
+	}
+
+	if err = g.encoder.EncodeToken(xml.EndElement{Name: xml.Name{Local: "graphml"}}); err != nil {
+		return ierrors.Wrap(err, "failed to close graphml element")
+	}
+
+	return g.encoder.Flush()
+}
+
+// writeHeader writes the XML declaration, the key definitions and the opening graph element (if not written yet).
+func (g *graphMLWriter) writeHeader() error {
+	if g.headerWritten {
+		return nil
+	}
+	g.headerWritten = true
+
+	if err := g.encoder.EncodeToken(xml.ProcInst{Target: "xml", Inst: []byte(`version="1.0" encoding="UTF-8"`)}); err != nil {
+		return ierrors.Wrap(err, "failed to write XML declaration")
+	}
+
+	if err := g.encoder.EncodeToken(xml.StartElement{
+		Name: xml.Name{Local: "graphml"},
+		Attr: []xml.Attr{{Name: xml.Name{Local: "xmlns"}, Value: "http://graphml.graphdrawing.org/xmlns"}},
+	}); err != nil {
+		return ierrors.Wrap(err, "failed to open graphml element")
+	}
+
+	for _, key := range graphMLKeys {
+		if err := g.encoder.Encode(key); err != nil {
+			return ierrors.Wrapf(err, "failed to write key %s", key.ID)
+		}
+	}
+
+	if err := g.encoder.EncodeToken(xml.StartElement{
+		Name: xml.Name{Local: "graph"},
+		Attr: []xml.Attr{
+			{Name: xml.Name{Local: "id"}, Value: "tangle"},
+			{Name: xml.Name{Local: "edgedefault"}, Value: "directed"},
+		},
+	}); err != nil {
+		return ierrors.Wrap(err, "failed to open graph element")
+	}
+
+	return nil
+}
+
+// writeNode writes a node with the given ID and data.
+func (g *graphMLWriter) writeNode(blockID iotago.BlockID, data []*graphMLData) error {
+	g.writtenNodes.Add(blockID)
+
+	if err := g.encoder.Encode(&graphMLNode{ID: blockID.ToHex(), Data: data}); err != nil {
+		return ierrors.Wrapf(err, "failed to write node %s", blockID)
+	}
+
+	return nil
+}
+
+// graphMLKeys contains the definitions of the attributes of the nodes and edges.
+var graphMLKeys = []*graphMLKey{
+	{ID: "slot", For: "node", Name: "slot", Type: "long"},
+	{ID: "type", For: "node", Name: "type", Type: "string"},
+	{ID: "issuerId", For: "node", Name: "issuerId", Type: "string"},
+	{ID: "issuingTime", For: "node", Name: "issuingTime", Type: "string"},
+	{ID: "slotCommitmentId", For: "node", Name: "slotCommitmentId", Type: "string"},
+	{ID: "blockState", For: "node", Name: "blockState", Type: "string"},
+	{ID: "accepted", For: "node", Name: "accepted", Type: "boolean"},
+	{ID: "confirmed", For: "node", Name: "confirmed", Type: "boolean"},
+	{ID: "finalized", For: "node", Name: "finalized", Type: "boolean"},
+	{ID: "placeholder", For: "node", Name: "placeholder", Type: "boolean", Default: "false"},
+	{ID: "referenceType", For: "edge", Name: "referenceType", Type: "string"},
+}
+
+type graphMLKey struct {
+	XMLName xml.Name `xml:"key"`
+	ID      string   `xml:"id,attr"`
+	For     string   `xml:"for,attr"`
+	Name    string   `xml:"attr.name,attr"`
+	Type    string   `xml:"attr.type,attr"`
+	Default string   `xml:"default,omitempty"`
+}
+
+type graphMLNode struct {
+	XMLName xml.Name       `xml:"node"`
+	ID      string         `xml:"id,attr"`
+	Data    []*graphMLData `xml:"data"`
+}
+
+type graphMLEdge struct {
+	XMLName xml.Name       `xml:"edge"`
+	Source  string         `xml:"source,attr"`
+	Target  string         `xml:"target,attr"`
+	Data    []*graphMLData `xml:"data"`
+}
+
+type graphMLData struct {
+	Key   string `xml:"key,attr"`
+	Value string `xml:",chardata"`
+}
@@ -334,6 +334,133 @@ func Test_StakeDelegateAndDelayedClaim(t *testing.T) {
 	}, ts.Nodes()...)
 }
 
+// Test_AccountTransitionsWithinSlot tests that an account that is transitioned multiple times in a single slot and a
+// delegation that is created and destroyed in the same slot result in a single, compacted account diff.
+func Test_AccountTransitionsWithinSlot(t *testing.T) {
+	ts := testsuite.NewTestSuite(t,
+		testsuite.WithProtocolParametersOptions(
+			iotago.WithTimeProviderOptions(
+				0,
+				testsuite.GenesisTimeWithOffsetBySlots(100, testsuite.DefaultSlotDurationInSeconds),
+				testsuite.DefaultSlotDurationInSeconds,
+				testsuite.DefaultSlotsPerEpochExponent,
+			),
+		),
+	)
+	defer ts.Shutdown()
+
+	node1 := ts.AddValidatorNode("node1")
+	_ = ts.AddNode("node2")
+	ts.AddDefaultWallet(node1)
+
+	ts.Run(true)
+
+	// CREATE NEW ACCOUNT WITH BLOCK ISSUER FEATURE FROM BASIC UTXO
+	newAccountBlockIssuerKey := tpkg.RandBlockIssuerKey()
+	newAccountExpirySlot := node1.Protocol.Engines.Main.Get().Storage.Settings().LatestCommitment().Slot() + ts.API.ProtocolParameters().MaxCommittableAge()
+
+	stakedAmount := iotago.BaseToken(10000)
+
+	newAccountAmount, err := depositcalculator.MinDeposit(ts.API.ProtocolParameters(), iotago.OutputAccount,
+		depositcalculator.WithAddress(&iotago.Ed25519Address{}),
+		depositcalculator.WithBlockIssuerKeys(1),
+		depositcalculator.WithStakedAmount(stakedAmount),
+	)
+	require.NoError(t, err)
+
+	var block1Slot iotago.SlotIndex = 1
+	tx1 := ts.DefaultWallet().CreateAccountFromInput(
+		"TX1",
+		"Genesis:0",
+		ts.DefaultWallet(),
+		mock.WithBlockIssuerFeature(iotago.BlockIssuerKeys{newAccountBlockIssuerKey}, newAccountExpirySlot),
+		mock.WithAccountAmount(newAccountAmount),
+		mock.WithAccountMana(mock.MaxBlockManaCost(ts.DefaultWallet().Node.Protocol.CommittedAPI().ProtocolParameters())),
+	)
+
+	ts.SetCurrentSlot(block1Slot)
+	block1 := ts.IssueBasicBlockWithOptions("block1", ts.DefaultWallet(), tx1)
+	latestParents := ts.CommitUntilSlot(block1Slot, block1.ID())
+
+	newAccount := ts.DefaultWallet().AccountOutput("TX1:0")
+	newAccountID := iotago.AccountIDFromOutputID(newAccount.OutputID())
+
+	ts.AssertEqualStoredRootsAtIndex(block1Slot, ts.Nodes()...)
+
+	// TRANSITION THE ACCOUNT TWICE, ADD AND REMOVE STAKING, AND CREATE AND DESTROY A DELEGATION IN THE SAME SLOT
+	block2Slot := ts.CurrentSlot()
+	latestCommitmentSlot := node1.Protocol.Engines.Main.Get().Storage.Settings().LatestCommitment().Slot()
+	stakeStartEpoch := ts.API.TimeProvider().EpochFromSlot(latestCommitmentSlot + ts.API.ProtocolParameters().MaxCommittableAge())
+	stakeEndEpoch := stakeStartEpoch + ts.API.ProtocolParameters().StakingUnbondingPeriod()
+	updatedExpirySlot := newAccountExpirySlot + 5
+
+	// add the staking feature.
+	tx2 := ts.DefaultWallet().TransitionAccount("TX2", "TX1:0", mock.WithStakingFeature(stakedAmount, 421, stakeStartEpoch, stakeEndEpoch))
+	block2 := ts.IssueBasicBlockWithOptions("block2", ts.DefaultWallet(), tx2, mock.WithStrongParents(latestParents...))
+
+	// update the block issuer feature of the same account.
+	tx3 := ts.DefaultWallet().TransitionAccount("TX3", "TX2:0", mock.WithBlockIssuerExpirySlot(updatedExpirySlot))
+	block3 := ts.IssueBasicBlockWithOptions("block3", ts.DefaultWallet(), tx3, mock.WithStrongParents(block2.ID()))
+
+	// removing the staking feature again is not allowed while the stake is bonded.
+	tx4 := ts.DefaultWallet().RemoveFeatureFromAccount(iotago.FeatureStaking, "TX4", "TX3:0")
+	ts.IssueBasicBlockWithOptions("block4", ts.DefaultWallet(), tx4, mock.WithStrongParents(block3.ID()))
+
+	// blocks with invalid transactions are not forwarded by node1 (iotaledger/iota-core#580), so only node1 is checked.
+	ts.AssertTransactionsInCacheInvalid([]*iotago.Transaction{tx4.Transaction}, true, node1)
+	ts.AssertTransactionFailure(lo.PanicOnErr(tx4.ID()), iotago.ErrInvalidStakingBondedRemoval, node1)
+
+	// create a delegation to the account and destroy it again.
+	accountAddress := iotago.AccountAddress(newAccountID)
+	tx5 := ts.DefaultWallet().CreateDelegationFromInput(
+		"TX5",
+		"TX1:1",
+		mock.WithDelegatedValidatorAddress(&accountAddress),
+		mock.WithDelegationStartEpoch(ts.DefaultWallet().DelegationStartFromSlot(block2Slot)),
+	)
+	block5 := ts.IssueBasicBlockWithOptions("block5", ts.DefaultWallet(), tx5, mock.WithStrongParents(block3.ID()))
+
+	tx6 := ts.DefaultWallet().ClaimDelegatorRewards("TX6", "TX5:0")
+	block6 := ts.IssueBasicBlockWithOptions("block6", ts.DefaultWallet(), tx6, mock.WithStrongParents(block5.ID()))
+
+	ts.CommitUntilSlot(block2Slot, block6.ID())
+
+	ts.AssertTransactionsInCacheAccepted([]*iotago.Transaction{tx2.Transaction, tx3.Transaction, tx5.Transaction, tx6.Transaction}, true, ts.Nodes()...)
+
+	// the diff only reflects the net transition from the output created in the previous slot to the latest output, and
+	// the delegation that was created and destroyed in the same slot does not change the delegation stake.
+	transitionedAccount := ts.DefaultWallet().AccountOutput("TX3:0")
+
+	ts.AssertAccountDiff(newAccountID, block2Slot, &model.AccountDiff{
+		BICChange:              0,
+		PreviousUpdatedSlot:    0,
+		NewExpirySlot:          updatedExpirySlot,
+		PreviousExpirySlot:     newAccountExpirySlot,
+		NewOutputID:            transitionedAccount.OutputID(),
+		PreviousOutputID:       newAccount.OutputID(),
+		BlockIssuerKeysAdded:   iotago.NewBlockIssuerKeys(),
+		BlockIssuerKeysRemoved: iotago.NewBlockIssuerKeys(),
+		ValidatorStakeChange:   int64(stakedAmount),
+		StakeEndEpochChange:    int64(stakeEndEpoch),
+		FixedCostChange:        421,
+		DelegationStakeChange:  0,
+	}, false, ts.Nodes()...)
+
+	ts.AssertAccountData(&accounts.AccountData{
+		ID:              newAccountID,
+		Credits:         accounts.NewBlockIssuanceCredits(0, block1Slot),
+		ExpirySlot:      updatedExpirySlot,
+		OutputID:        transitionedAccount.OutputID(),
+		BlockIssuerKeys: iotago.NewBlockIssuerKeys(newAccountBlockIssuerKey),
+		StakeEndEpoch:   stakeEndEpoch,
+		FixedCost:       421,
+		DelegationStake: 0,
+		ValidatorStake:  stakedAmount,
+	}, ts.Nodes()...)
+
+	ts.AssertEqualStoredRootsAtIndex(block2Slot, ts.Nodes()...)
+}
+
 func Test_ImplicitAccounts(t *testing.T) {
 	ts := testsuite.NewTestSuite(t,
 		testsuite.WithProtocolParametersOptions(
@@ -578,13 +705,25 @@ func Test_NegativeBIC_BlockIssuerLocked(t *testing.T) {
 
 	// Allot some mana to the locked account to unlock it.
 	// The locked wallet 2 is preparing and signs the transaction, but it's issued by wallet 1 whose account is not locked.
+	// The mana that is allotted to an account that does not exist is burned.
 	{
 		allottedBIC := iotago.BlockIssuanceCredits(1000)
-		tx1 := wallet2.AllotManaFromInputs("TX1",
-			iotago.Allotments{&iotago.Allotment{
+		burnedMana := iotago.Mana(500)
+		nonExistingAccountID := tpkg.RandAccountID()
+
+		allotments := iotago.Allotments{
+			&iotago.Allotment{
 				AccountID: wallet2.BlockIssuer.AccountID,
 				Mana:      iotago.Mana(allottedBIC),
-			}}, "Genesis:0")
+			},
+			&iotago.Allotment{
+				AccountID: nonExistingAccountID,
+				Mana:      burnedMana,
+			},
+		}
+		allotments.Sort()
+
+		tx1 := wallet2.AllotManaFromInputs("TX1", allotments, "Genesis:0")
 
 		block3Commitment := node1.Protocol.Engines.Main.Get().Storage.Settings().LatestCommitment().Commitment()
 		// Wallet 1 whose account is not locked is issuing the block to unlock the account of wallet 2.
@@ -597,6 +736,18 @@ func Test_NegativeBIC_BlockIssuerLocked(t *testing.T) {
 		wallet1BIC -= burned
 		wallet2BIC += allottedBIC
 
+		expectedManaTrace := &model.ManaTrace{Allotments: make([]*model.AllotmentTrace, 0, len(allotments))}
+		for _, allotment := range allotments {
+			expectedManaTrace.Allotments = append(expectedManaTrace.Allotments, &model.AllotmentTrace{
+				AccountID: allotment.AccountID,
+				Mana:      allotment.Mana,
+				Burned:    allotment.AccountID == nonExistingAccountID,
+			})
+		}
+		ts.AssertManaTrace(lo.PanicOnErr(tx1.Transaction.ID()), block31.ID().Slot(), expectedManaTrace, ts.Nodes()...)
+		require.Equal(t, iotago.Mana(allottedBIC), expectedManaTrace.AllottedMana())
+		require.Equal(t, burnedMana, expectedManaTrace.BurnedMana())
+
 		ts.AssertAccountData(&accounts.AccountData{
 			ID:              wallet1.BlockIssuer.AccountID,
 			Credits:         accounts.NewBlockIssuanceCredits(wallet1BIC, block3Slot),
@@ -0,0 +1,114 @@
+package tests
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"testing"
+	"time"
+
+	p2pcrypto "github.com/libp2p/go-libp2p/core/crypto"
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/ds"
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/iota-core/pkg/blockfactory"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
+	"github.com/iotaledger/iota-core/pkg/testsuite"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func Test_BlockFactory(t *testing.T) {
+	ts := testsuite.NewTestSuite(t,
+		testsuite.WithProtocolParametersOptions(
+			iotago.WithTimeProviderOptions(
+				0,
+				testsuite.GenesisTimeWithOffsetBySlots(100, testsuite.DefaultSlotDurationInSeconds),
+				testsuite.DefaultSlotDurationInSeconds,
+				3,
+			),
+			iotago.WithLivenessOptions(
+				10,
+				10,
+				2,
+				4,
+				5,
+			),
+		),
+	)
+	defer ts.Shutdown()
+
+	node0 := ts.AddValidatorNode("node0")
+	ts.AddValidatorNode("node1")
+
+	ts.Run(true, nil)
+
+	// the skeletons are only rebuilt synchronously if they were built by a different engine.
+	factory := blockfactory.New(node0.Protocol, blockfactory.WithMaxSkeletonAge(time.Hour))
+	defer factory.Shutdown()
+
+	assertSkeleton := func(expectedStrongParents []*blocks.Block, expectedLatestCommitmentSlot iotago.SlotIndex) {
+		ts.Eventually(func() error {
+			skeleton, err := factory.Skeleton()
+			if err != nil {
+				return err
+			}
+
+			expectedStrongParentIDs := lo.Map(expectedStrongParents, (*blocks.Block).ID)
+			if !ds.NewSet(expectedStrongParentIDs...).Equals(ds.NewSet(skeleton.References[iotago.StrongParentType]...)) {
+				return ierrors.Errorf("Test_BlockFactory: expected strong parents %s, got %s", expectedStrongParentIDs, skeleton.References[iotago.StrongParentType])
+			}
+
+			if skeleton.LatestCommitment.Slot() != expectedLatestCommitmentSlot {
+				return ierrors.Errorf("Test_BlockFactory: expected latest commitment slot %d, got %d", expectedLatestCommitmentSlot, skeleton.LatestCommitment.Slot())
+			}
+
+			return nil
+		})
+	}
+
+	// the parents of the skeleton follow the tips.
+	{
+		ts.IssueBlocksAtSlots("", []iotago.SlotIndex{1}, 1, "Genesis", ts.Nodes(), false, false)
+		ts.AssertStrongTips(ts.BlocksWithPrefix("1.0"), node0)
+		assertSkeleton(ts.BlocksWithPrefix("1.0"), 0)
+
+		ts.IssueBlocksAtSlots("", []iotago.SlotIndex{2}, 2, "1.0", ts.Nodes(), false, false)
+		ts.AssertStrongTips(ts.BlocksWithPrefix("2.1"), node0)
+		assertSkeleton(ts.BlocksWithPrefix("2.1"), 0)
+	}
+
+	// the commitment of the skeleton follows the latest commitment.
+	{
+		ts.IssueBlocksAtSlots("", []iotago.SlotIndex{3, 4, 5, 6}, 3, "2.1", ts.Nodes(), true, false)
+		ts.AssertLatestCommitmentSlotIndex(4, node0)
+		assertSkeleton(ts.BlocksWithPrefix("6.2"), 4)
+
+		skeleton, err := factory.Skeleton()
+		require.NoError(t, err)
+		require.Equal(t, node0.Protocol.Engines.Main.Get().Storage.Settings().LatestCommitment().ID(), skeleton.LatestCommitment.ID())
+	}
+}
+
+func Test_BlockFactoryValidateIssuer(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	defer ts.Shutdown()
+
+	node0 := ts.AddValidatorNode("node0")
+
+	ts.Run(true, nil)
+
+	privateKey, _ := node0.KeyManager.KeyPair()
+	require.NoError(t, blockfactory.ValidateIssuer(node0.Protocol, node0.Validator.AccountID, privateKey))
+
+	_, unknownPrivateKey, err := ed25519.GenerateKey(rand.Reader)
+	require.NoError(t, err)
+	require.ErrorIs(t, blockfactory.ValidateIssuer(node0.Protocol, node0.Validator.AccountID, unknownPrivateKey), blockfactory.ErrUnknownIssuerKey)
+
+	require.ErrorIs(t, blockfactory.ValidateIssuer(node0.Protocol, iotago.AccountID{1}, privateKey), blockfactory.ErrIssuerAccountNotFound)
+
+	// the p2p identity key of the node must not be used to issue blocks.
+	identityKey := lo.PanicOnErr(p2pcrypto.UnmarshalEd25519PrivateKey(privateKey)).GetPublic()
+	require.ErrorIs(t, blockfactory.ValidateKeySeparation(privateKey, identityKey), blockfactory.ErrIdentityKeyReused)
+	require.NoError(t, blockfactory.ValidateKeySeparation(unknownPrivateKey, identityKey))
+}
@@ -4,6 +4,7 @@ import (
 	"testing"
 	"time"
 
+	"github.com/iotaledger/iota-core/pkg/model"
 	"github.com/iotaledger/iota-core/pkg/testsuite"
 	"github.com/iotaledger/iota-core/pkg/testsuite/mock"
 	iotago "github.com/iotaledger/iota.go/v4"
@@ -55,4 +56,5 @@ func Test_BlockTimeMonotonicity(t *testing.T) {
 	}
 
 	ts.AssertBlockFiltered(ts.Blocks("block1", "block2"), iotago.ErrBlockIssuingTimeNonMonotonic, node0)
+	ts.AssertRetainerFilteredBlocks(ts.Blocks("block1", "block2"), model.BlockFilterPostSolid, iotago.ErrBlockIssuingTimeNonMonotonic, node0)
 }
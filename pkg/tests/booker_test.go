@@ -210,6 +210,47 @@ func Test_DoubleSpend(t *testing.T) {
 	}
 }
 
+func Test_DoubleSpend_MultipleConflicts(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	defer ts.Shutdown()
+
+	node1 := ts.AddValidatorNode("node1")
+	node2 := ts.AddValidatorNode("node2")
+	wallet := ts.AddDefaultWallet(node1)
+
+	ts.Run(true, map[string][]options.Option[protocol.Protocol]{})
+
+	// Create and issue three transactions that spend the same output.
+	{
+		tx1 := wallet.CreateBasicOutputsEquallyFromInput("tx1", 1, "Genesis:0")
+		tx2 := wallet.CreateBasicOutputsEquallyFromInput("tx2", 1, "Genesis:0")
+		tx3 := wallet.CreateBasicOutputsEquallyFromInput("tx3", 1, "Genesis:0")
+
+		ts.IssueBasicBlockWithOptions("block1", wallet, tx1, mock.WithStrongParents(ts.BlockID("Genesis")))
+		ts.IssueBasicBlockWithOptions("block2", wallet, tx2, mock.WithStrongParents(ts.BlockID("Genesis")))
+		ts.IssueBasicBlockWithOptions("block3", wallet, tx3, mock.WithStrongParents(ts.BlockID("Genesis")))
+
+		ts.AssertTransactionsInCacheBooked(wallet.Transactions("tx1", "tx2", "tx3"), true, node1, node2)
+		ts.AssertConflictStates(map[string]acceptance.State{
+			"tx1": acceptance.Pending,
+			"tx2": acceptance.Pending,
+			"tx3": acceptance.Pending,
+		}, node1, node2)
+	}
+
+	// Issue validation blocks that like the last conflict and resolve the double spend in its favor.
+	{
+		ts.IssueValidationBlockWithHeaderOptions("block4", node1, mock.WithStrongParents(ts.BlockIDs("block1", "block2")...), mock.WithShallowLikeParents(ts.BlockID("block3")))
+		ts.IssueValidationBlockWithHeaderOptions("block5", node2, mock.WithStrongParents(ts.BlockID("block4")))
+		ts.IssueValidationBlockWithHeaderOptions("block6", node1, mock.WithStrongParents(ts.BlockID("block5")))
+
+		ts.AssertBlocksInCacheConflicts(map[*blocks.Block][]string{
+			ts.Block("block4"): {"tx3"},
+		}, node1, node2)
+		ts.AssertDoubleSpendResolved("tx3", []string{"tx1", "tx2"}, node1, node2)
+	}
+}
+
 func Test_MultipleAttachments(t *testing.T) {
 	ts := testsuite.NewTestSuite(t)
 	defer ts.Shutdown()
@@ -0,0 +1,363 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/iota-core/pkg/core/account"
+	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
+	"github.com/iotaledger/iota-core/pkg/testsuite"
+	"github.com/iotaledger/iota-core/pkg/testsuite/mock"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func Test_CommitmentAPIBlockIDs(t *testing.T) {
+	ts := testsuite.NewTestSuite(t,
+		testsuite.WithProtocolParametersOptions(
+			iotago.WithTimeProviderOptions(
+				0,
+				testsuite.GenesisTimeWithOffsetBySlots(100, testsuite.DefaultSlotDurationInSeconds),
+				testsuite.DefaultSlotDurationInSeconds,
+				3,
+			),
+			iotago.WithLivenessOptions(
+				10,
+				10,
+				2,
+				4,
+				5,
+			),
+		),
+	)
+	defer ts.Shutdown()
+
+	ts.AddValidatorNode("node0")
+	ts.AddValidatorNode("node1")
+
+	ts.Run(true, nil)
+
+	ts.IssueBlocksAtSlots("", []iotago.SlotIndex{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, 3, "Genesis", ts.Nodes(), true, false)
+
+	ts.AssertLatestFinalizedSlot(7, ts.Nodes()...)
+
+	expectedBlockIDs := lo.Map(ts.BlocksWithPrefix("2."), (*blocks.Block).ID)
+
+	for _, node := range ts.Nodes() {
+		engineInstance := node.Protocol.Engines.Main.Get()
+		commitmentAPI := engine.NewCommitmentAPI(engineInstance, lo.PanicOnErr(engineInstance.Storage.Commitments().Load(2)).ID())
+
+		acceptedBlockIDs, err := commitmentAPI.AcceptedBlockIDs()
+		require.NoError(t, err)
+		require.ElementsMatch(t, expectedBlockIDs, acceptedBlockIDs)
+
+		confirmedBlockIDs, err := commitmentAPI.ConfirmedBlockIDs()
+		require.NoError(t, err)
+		require.ElementsMatch(t, expectedBlockIDs, confirmedBlockIDs)
+
+		require.True(t, commitmentAPI.IsFinalized())
+	}
+}
+
+func Test_CommitmentAPIBlockInclusionProof(t *testing.T) {
+	ts := testsuite.NewTestSuite(t,
+		testsuite.WithProtocolParametersOptions(
+			iotago.WithTimeProviderOptions(
+				0,
+				testsuite.GenesisTimeWithOffsetBySlots(100, testsuite.DefaultSlotDurationInSeconds),
+				testsuite.DefaultSlotDurationInSeconds,
+				3,
+			),
+			iotago.WithLivenessOptions(
+				10,
+				10,
+				2,
+				4,
+				5,
+			),
+		),
+	)
+	defer ts.Shutdown()
+
+	node0 := ts.AddValidatorNode("node0")
+	ts.AddValidatorNode("node1")
+
+	ts.Run(true, nil)
+
+	ts.IssueBlocksAtSlots("", []iotago.SlotIndex{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, 3, "Genesis", ts.Nodes(), true, false)
+
+	engineInstance := node0.Protocol.Engines.Main.Get()
+	commitment2 := lo.PanicOnErr(engineInstance.Storage.Commitments().Load(2))
+	commitment3 := lo.PanicOnErr(engineInstance.Storage.Commitments().Load(3))
+	commitmentAPI := engine.NewCommitmentAPI(engineInstance, commitment2.ID())
+
+	for _, block := range ts.BlocksWithPrefix("2.") {
+		proof, err := commitmentAPI.BlockInclusionProof(block.ID())
+		require.NoError(t, err)
+		require.NoError(t, proof.Verify(commitment2.Commitment()))
+
+		// the proof survives a serialization round trip.
+		proofBytes, err := proof.Bytes()
+		require.NoError(t, err)
+
+		parsedProof, bytesRead, err := model.BlockInclusionProofFromBytes(proofBytes)
+		require.NoError(t, err)
+		require.Equal(t, len(proofBytes), bytesRead)
+		require.NoError(t, parsedProof.Verify(commitment2.Commitment()))
+
+		// the proof is not valid for a different commitment.
+		require.ErrorIs(t, proof.Verify(commitment3.Commitment()), model.ErrInvalidBlockInclusionProof)
+
+		// the proof is not valid for a different block of the same slot.
+		parsedProof.BlockID = iotago.NewBlockID(block.ID().Slot(), iotago.Identifier{1})
+		require.ErrorIs(t, parsedProof.Verify(commitment2.Commitment()), model.ErrInvalidBlockInclusionProof)
+	}
+
+	// blocks of other slots are not part of the commitment.
+	_, err := commitmentAPI.BlockInclusionProof(ts.BlocksWithPrefix("3.")[0].ID())
+	require.Error(t, err)
+
+	// blocks that were not accepted in the slot can not be proven.
+	_, err = commitmentAPI.BlockInclusionProof(iotago.NewBlockID(2, iotago.Identifier{1}))
+	require.ErrorIs(t, err, model.ErrBlockNotIncluded)
+}
+
+func Test_CommitmentAPIStreamAcceptedBlocks(t *testing.T) {
+	ts := testsuite.NewTestSuite(t,
+		testsuite.WithProtocolParametersOptions(
+			iotago.WithTimeProviderOptions(
+				0,
+				testsuite.GenesisTimeWithOffsetBySlots(100, testsuite.DefaultSlotDurationInSeconds),
+				testsuite.DefaultSlotDurationInSeconds,
+				3,
+			),
+			iotago.WithLivenessOptions(
+				10,
+				10,
+				2,
+				4,
+				5,
+			),
+		),
+	)
+	defer ts.Shutdown()
+
+	node0 := ts.AddValidatorNode("node0")
+	ts.AddValidatorNode("node1")
+
+	ts.Run(true, nil)
+
+	ts.IssueBlocksAtSlots("", []iotago.SlotIndex{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, 3, "Genesis", ts.Nodes(), true, false)
+
+	ts.AssertLatestFinalizedSlot(7, ts.Nodes()...)
+
+	engineInstance := node0.Protocol.Engines.Main.Get()
+
+	streamedSlots := make([]iotago.SlotIndex, 0)
+	require.NoError(t, engineInstance.StreamAcceptedBlocks(2, 4, true, func(commitment *model.Commitment, acceptedBlockIDs iotago.BlockIDs) error {
+		streamedSlots = append(streamedSlots, commitment.Slot())
+
+		expectedBlockIDs := lo.Map(ts.BlocksWithPrefix(fmt.Sprintf("%d.", commitment.Slot())), (*blocks.Block).ID)
+		require.ElementsMatch(t, expectedBlockIDs, acceptedBlockIDs)
+
+		return nil
+	}))
+	require.Equal(t, []iotago.SlotIndex{2, 3, 4}, streamedSlots)
+
+	// the streaming is aborted with the error of the consumer.
+	consumerErr := ierrors.New("consumer error")
+	require.ErrorIs(t, engineInstance.StreamAcceptedBlocks(2, 4, false, func(*model.Commitment, iotago.BlockIDs) error {
+		return consumerErr
+	}), consumerErr)
+
+	// the accepted blocks can be streamed without collecting them first.
+	commitmentAPI := engine.NewCommitmentAPI(engineInstance, lo.PanicOnErr(engineInstance.Storage.Commitments().Load(2)).ID())
+
+	streamedBlockIDs := make(iotago.BlockIDs, 0)
+	require.NoError(t, commitmentAPI.StreamAcceptedBlockIDs(func(blockID iotago.BlockID) error {
+		streamedBlockIDs = append(streamedBlockIDs, blockID)
+
+		return nil
+	}))
+	require.ElementsMatch(t, lo.Map(ts.BlocksWithPrefix("2."), (*blocks.Block).ID), streamedBlockIDs)
+}
+
+func Test_BlockCommitment(t *testing.T) {
+	ts := testsuite.NewTestSuite(t,
+		testsuite.WithProtocolParametersOptions(
+			iotago.WithTimeProviderOptions(
+				0,
+				testsuite.GenesisTimeWithOffsetBySlots(100, testsuite.DefaultSlotDurationInSeconds),
+				testsuite.DefaultSlotDurationInSeconds,
+				3,
+			),
+			iotago.WithLivenessOptions(
+				10,
+				10,
+				2,
+				4,
+				5,
+			),
+		),
+	)
+	defer ts.Shutdown()
+
+	ts.AddValidatorNode("node0")
+	ts.AddValidatorNode("node1")
+
+	ts.Run(true, nil)
+
+	ts.IssueBlocksAtSlots("", []iotago.SlotIndex{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, 3, "Genesis", ts.Nodes(), true, false)
+
+	ts.AssertLatestCommitmentSlotIndex(8, ts.Nodes()...)
+
+	for _, node := range ts.Nodes() {
+		engineInstance := node.Protocol.Engines.Main.Get()
+
+		for _, slot := range []iotago.SlotIndex{2, 5, 8} {
+			expectedCommitment := lo.PanicOnErr(engineInstance.Storage.Commitments().Load(slot))
+
+			for _, block := range ts.BlocksWithPrefix(fmt.Sprintf("%d.", slot)) {
+				commitment, err := engineInstance.BlockCommitment(block.ID())
+				require.NoError(t, err)
+				require.Equal(t, expectedCommitment.ID(), commitment.ID())
+			}
+		}
+
+		// blocks of slots that are not committed yet are not part of a commitment.
+		_, err := engineInstance.BlockCommitment(ts.BlocksWithPrefix("10.")[0].ID())
+		require.ErrorIs(t, err, model.ErrBlockNotIncluded)
+
+		// blocks that were not accepted in a committed slot are not part of a commitment.
+		_, err = engineInstance.BlockCommitment(iotago.NewBlockID(2, iotago.Identifier{1}))
+		require.ErrorIs(t, err, model.ErrBlockNotIncluded)
+	}
+}
+
+func Test_CommitmentAPIWeight(t *testing.T) {
+	ts := testsuite.NewTestSuite(t,
+		testsuite.WithProtocolParametersOptions(
+			iotago.WithTimeProviderOptions(
+				0,
+				testsuite.GenesisTimeWithOffsetBySlots(100, testsuite.DefaultSlotDurationInSeconds),
+				testsuite.DefaultSlotDurationInSeconds,
+				3,
+			),
+			iotago.WithLivenessOptions(
+				10,
+				10,
+				2,
+				4,
+				5,
+			),
+		),
+	)
+	defer ts.Shutdown()
+
+	ts.AddValidatorNode("node0")
+	ts.AddValidatorNode("node1")
+
+	ts.Run(true, nil)
+
+	ts.IssueBlocksAtSlots("", []iotago.SlotIndex{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, 3, "Genesis", ts.Nodes(), true, false)
+
+	ts.AssertLatestCommitmentSlotIndex(8, ts.Nodes()...)
+
+	for _, node := range ts.Nodes() {
+		engineInstance := node.Protocol.Engines.Main.Get()
+		committee, exists := engineInstance.SybilProtection.SeatManager().CommitteeInSlot(5)
+		require.True(t, exists)
+
+		commitment := lo.PanicOnErr(engineInstance.Storage.Commitments().Load(5))
+		parentCommitment := lo.PanicOnErr(engineInstance.Storage.Commitments().Load(4))
+
+		weight, err := engine.NewCommitmentAPI(engineInstance, commitment.ID()).Weight()
+		require.NoError(t, err)
+
+		require.Equal(t, commitment.ID(), weight.CommitmentID)
+		require.Equal(t, commitment.CumulativeWeight(), weight.CumulativeWeight)
+		require.Equal(t, parentCommitment.CumulativeWeight(), weight.ParentCumulativeWeight)
+		require.Equal(t, commitment.CumulativeWeight()-parentCommitment.CumulativeWeight(), weight.WeightDelta())
+		require.Equal(t, committee.SeatCount(), weight.CommitteeSize)
+
+		// both validators attested to the commitment with the seat they hold in the committee.
+		expectedSeats := lo.Map(ts.Validators(), func(validator *mock.Node) account.SeatIndex {
+			return lo.Return1(committee.GetSeat(validator.Validator.AccountID))
+		})
+		require.ElementsMatch(t, expectedSeats, weight.AttestedSeats.Seats())
+
+		require.Len(t, weight.Attestations, len(ts.Validators()))
+		for _, attestation := range weight.Attestations {
+			seat, isMember := committee.GetSeat(attestation.IssuerID)
+			require.True(t, isMember)
+			require.Equal(t, seat, attestation.Seat)
+			require.True(t, weight.AttestedSeats.Has(attestation.Seat))
+		}
+	}
+
+	// the weight of a commitment that does not exist can not be computed.
+	_, err := engine.NewCommitmentAPI(ts.Node("node0").Protocol.Engines.Main.Get(), iotago.NewCommitmentID(5, iotago.Identifier{1})).Weight()
+	require.Error(t, err)
+}
+
+func Test_CommitmentAPIRoots(t *testing.T) {
+	ts := testsuite.NewTestSuite(t,
+		testsuite.WithProtocolParametersOptions(
+			iotago.WithTimeProviderOptions(
+				0,
+				testsuite.GenesisTimeWithOffsetBySlots(100, testsuite.DefaultSlotDurationInSeconds),
+				testsuite.DefaultSlotDurationInSeconds,
+				3,
+			),
+			iotago.WithLivenessOptions(
+				10,
+				10,
+				2,
+				4,
+				5,
+			),
+		),
+	)
+	defer ts.Shutdown()
+
+	ts.AddValidatorNode("node0")
+	ts.AddValidatorNode("node1")
+
+	ts.Run(true, nil)
+
+	ts.IssueBlocksAtSlots("", []iotago.SlotIndex{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, 3, "Genesis", ts.Nodes(), true, false)
+
+	ts.AssertLatestCommitmentSlotIndex(8, ts.Nodes()...)
+
+	for _, node := range ts.Nodes() {
+		engineInstance := node.Protocol.Engines.Main.Get()
+
+		for slot := iotago.SlotIndex(1); slot <= 8; slot++ {
+			commitment := lo.PanicOnErr(engineInstance.Storage.Commitments().Load(slot))
+
+			roots, err := engine.NewCommitmentAPI(engineInstance, commitment.ID()).Roots()
+			require.NoError(t, err)
+
+			// the decomposed roots hash to the roots ID that is contained in the commitment.
+			require.Equal(t, commitment.RootsID(), roots.ID())
+
+			// the serialized roots can be decoded by downstream verifiers.
+			rootsBytes, err := engineInstance.APIForSlot(slot).Encode(roots)
+			require.NoError(t, err)
+
+			decodedRoots := new(iotago.Roots)
+			_, err = engineInstance.APIForSlot(slot).Decode(rootsBytes, decodedRoots)
+			require.NoError(t, err)
+			require.Equal(t, roots, decodedRoots)
+		}
+
+		// the roots of slots that are not committed yet are not available.
+		_, err := engine.NewCommitmentAPI(engineInstance, iotago.NewCommitmentID(9, iotago.EmptyIdentifier)).Roots()
+		require.Error(t, err)
+	}
+}
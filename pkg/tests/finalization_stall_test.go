@@ -0,0 +1,100 @@
+package tests
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/hive.go/runtime/syncutils"
+	"github.com/iotaledger/iota-core/pkg/protocol"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/syncmanager"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/syncmanager/trivialsyncmanager"
+	"github.com/iotaledger/iota-core/pkg/protocol/sybilprotection/seatmanager/topstakers"
+	"github.com/iotaledger/iota-core/pkg/protocol/sybilprotection/sybilprotectionv1"
+	"github.com/iotaledger/iota-core/pkg/testsuite"
+	"github.com/iotaledger/iota-core/pkg/testsuite/mock"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func Test_FinalizationStalled(t *testing.T) {
+	ts := testsuite.NewTestSuite(t,
+		testsuite.WithProtocolParametersOptions(
+			iotago.WithTimeProviderOptions(
+				0,
+				testsuite.GenesisTimeWithOffsetBySlots(100, testsuite.DefaultSlotDurationInSeconds),
+				testsuite.DefaultSlotDurationInSeconds,
+				3,
+			),
+			iotago.WithLivenessOptions(
+				10,
+				10,
+				2,
+				4,
+				5,
+			),
+			iotago.WithTargetCommitteeSize(4),
+		),
+	)
+	defer ts.Shutdown()
+
+	nodeA := ts.AddValidatorNode("nodeA")
+	nodeB := ts.AddValidatorNode("nodeB")
+	nodeC := ts.AddValidatorNode("nodeC")
+	nodeD := ts.AddValidatorNode("nodeD")
+
+	nodeOpts := []options.Option[protocol.Protocol]{
+		protocol.WithSybilProtectionProvider(
+			sybilprotectionv1.NewProvider(
+				sybilprotectionv1.WithSeatManagerProvider(
+					topstakers.NewProvider(
+						topstakers.WithOnlineCommitteeStartup(nodeA.Validator.AccountID),
+						topstakers.WithActivityWindow(2*time.Minute),
+					),
+				),
+			),
+		),
+		protocol.WithSyncManagerProvider(
+			trivialsyncmanager.NewProvider(
+				trivialsyncmanager.WithFinalizationStallThreshold(8),
+			),
+		),
+	}
+	ts.Run(true, map[string][]options.Option[protocol.Protocol]{
+		"nodeA": nodeOpts,
+		"nodeB": nodeOpts,
+		"nodeC": nodeOpts,
+		"nodeD": nodeOpts,
+	})
+
+	var (
+		stall      *syncmanager.FinalizationStall
+		stallMutex syncutils.Mutex
+	)
+	nodeA.Protocol.Events.Engine.SyncManager.FinalizationStalled.Hook(func(finalizationStall *syncmanager.FinalizationStall) {
+		stallMutex.Lock()
+		defer stallMutex.Unlock()
+
+		stall = finalizationStall
+	})
+
+	// only node A is online, so that blocks are accepted but the slots can not be finalized without the attestations
+	// of the other committee members.
+	ts.IssueBlocksAtSlots("", []iotago.SlotIndex{1, 2, 3, 4, 5, 6, 7, 8, 9, 10, 11, 12}, 2, "Genesis", []*mock.Node{nodeA}, true, false)
+
+	ts.AssertLatestFinalizedSlot(0, nodeA)
+
+	require.Eventually(t, func() bool {
+		return nodeA.Protocol.Engines.Main.Get().SyncManager.IsFinalizationStalled()
+	}, 10*time.Second, 10*time.Millisecond)
+	require.True(t, nodeA.Protocol.Engines.Main.Get().SyncManager.SyncStatus().FinalizationStalled)
+
+	stallMutex.Lock()
+	defer stallMutex.Unlock()
+
+	require.NotNil(t, stall)
+	require.Equal(t, iotago.SlotIndex(0), stall.LatestFinalizedSlot)
+	require.Greater(t, stall.Lag(), iotago.SlotIndex(8))
+	require.ElementsMatch(t, []iotago.AccountID{nodeB.Validator.AccountID, nodeC.Validator.AccountID, nodeD.Validator.AccountID}, stall.MissingAttestations)
+}
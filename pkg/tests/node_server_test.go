@@ -0,0 +1,82 @@
+package tests
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/iota-core/pkg/testsuite"
+	"github.com/iotaledger/iota-core/pkg/testsuite/mock"
+	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/nodeclient"
+)
+
+func Test_NodeServer(t *testing.T) {
+	ts := testsuite.NewTestSuite(t,
+		testsuite.WithProtocolParametersOptions(
+			iotago.WithTimeProviderOptions(
+				0,
+				testsuite.GenesisTimeWithOffsetBySlots(100, testsuite.DefaultSlotDurationInSeconds),
+				testsuite.DefaultSlotDurationInSeconds,
+				3,
+			),
+			iotago.WithLivenessOptions(
+				10,
+				10,
+				2,
+				4,
+				5,
+			),
+		),
+	)
+	defer ts.Shutdown()
+
+	node0 := ts.AddValidatorNode("node0")
+	ts.AddValidatorNode("node1")
+	wallet := ts.AddGenesisWallet("default", node0)
+
+	ts.Run(true, nil)
+
+	ts.IssueBlocksAtSlots("", []iotago.SlotIndex{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, 3, "Genesis", ts.Nodes(), true, false)
+
+	nodeServer := mock.NewNodeServer(t, node0, node0.Validator)
+	defer nodeServer.Shutdown()
+
+	ctx := context.Background()
+
+	client, err := nodeclient.New(nodeServer.URL())
+	require.NoError(t, err)
+
+	info, err := client.Info(ctx)
+	require.NoError(t, err)
+	require.Equal(t, node0.Name, info.Name)
+	require.Equal(t, node0.Protocol.Engines.Main.Get().Storage.Settings().LatestCommitment().ID(), info.Status.LatestCommitmentID)
+	require.Equal(t, ts.API.ProtocolParameters().NetworkID(), client.CommittedAPI().ProtocolParameters().NetworkID())
+
+	issuance, err := client.BlockIssuance(ctx)
+	require.NoError(t, err)
+	require.NotEmpty(t, issuance.StrongParents)
+	require.Equal(t, info.Status.LatestCommitmentID, issuance.LatestCommitment.MustID())
+
+	genesisOutput := wallet.Output("Genesis:0")
+	output, err := client.OutputByID(ctx, genesisOutput.OutputID())
+	require.NoError(t, err)
+	require.Equal(t, genesisOutput.BaseTokenAmount(), output.BaseTokenAmount())
+
+	_, err = client.OutputByID(ctx, iotago.EmptyOutputID)
+	require.Error(t, err)
+
+	block, err := node0.Validator.CreateBasicBlock(ctx, "submitted", node0, mock.WithBasicBlockHeader(
+		mock.WithIssuingTime(ts.API.TimeProvider().SlotStartTime(11)),
+		mock.WithStrongParents(issuance.StrongParents...),
+	))
+	require.NoError(t, err)
+
+	blockID, err := client.SubmitBlock(ctx, block.ProtocolBlock())
+	require.NoError(t, err)
+	require.Equal(t, block.ID(), blockID)
+
+	ts.RegisterBlock("submitted", block)
+	ts.AssertBlocksExist(ts.Blocks("submitted"), true, node0)
+}
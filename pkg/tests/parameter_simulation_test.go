@@ -0,0 +1,47 @@
+package tests
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/iota-core/pkg/testsuite"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func TestCompareProtocolParameters(t *testing.T) {
+	livenessOptions := func(minCommittableAge iotago.SlotIndex) testsuite.ParameterSet {
+		return testsuite.ParameterSet{
+			Name: fmt.Sprintf("minCommittableAge%d", minCommittableAge),
+			Options: []options.Option[iotago.V3ProtocolParameters]{
+				iotago.WithTimeProviderOptions(0, testsuite.GenesisTimeWithOffsetBySlots(1000, testsuite.DefaultSlotDurationInSeconds), testsuite.DefaultSlotDurationInSeconds, 4),
+				iotago.WithLivenessOptions(10, 10, minCommittableAge, 2*minCommittableAge, 2*minCommittableAge+2),
+			},
+		}
+	}
+
+	comparison := testsuite.CompareProtocolParameters(t, testsuite.SimulationWorkload{
+		Setup: func(ts *testsuite.TestSuite) {
+			ts.AddValidatorNode("node0")
+			ts.AddValidatorNode("node1")
+
+			ts.Run(true)
+		},
+		Run: func(ts *testsuite.TestSuite) {
+			ts.IssueBlocksAtSlots("", []iotago.SlotIndex{1, 2, 3, 4, 5, 6, 7, 8}, 3, "Genesis", ts.Nodes(), true, false)
+		},
+	}, livenessOptions(2), livenessOptions(4))
+
+	for _, report := range []*testsuite.SimulationReport{comparison.Baseline, comparison.Candidate} {
+		require.Equal(t, 8*3*2, report.AttachedBlocks)
+		require.Equal(t, report.AttachedBlocks, report.AcceptedBlocks+report.OrphanedBlocks)
+		require.NotZero(t, report.AcceptedBlocks)
+		require.NotZero(t, report.Commitments)
+	}
+
+	// a higher minCommittableAge delays the commitments of the slots.
+	require.Greater(t, comparison.Baseline.Commitments, comparison.Candidate.Commitments)
+	require.Contains(t, comparison.String(), "minCommittableAge4")
+}
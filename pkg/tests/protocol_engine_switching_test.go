@@ -3,11 +3,15 @@ package tests
 import (
 	"context"
 	"fmt"
+	"strings"
 	"sync"
 	"testing"
 	"time"
 
+	"github.com/stretchr/testify/require"
+
 	"github.com/iotaledger/hive.go/core/eventticker"
+	"github.com/iotaledger/hive.go/ierrors"
 	"github.com/iotaledger/hive.go/lo"
 	"github.com/iotaledger/hive.go/runtime/module"
 	"github.com/iotaledger/hive.go/runtime/options"
@@ -28,6 +32,23 @@ import (
 )
 
 func TestProtocol_EngineSwitching(t *testing.T) {
+	testProtocolEngineSwitching(t)
+}
+
+func TestProtocol_EngineSwitching_ChainBlockBuffer(t *testing.T) {
+	// use a tiny buffer so that the blocks of the candidate chain are spilled to disk and dispatched one by one.
+	testProtocolEngineSwitching(t,
+		protocol.WithChainBlockBufferSize(1),
+		protocol.WithChainBlockBufferMaxPendingTasks(1),
+		protocol.WithChainBlockBufferSpillToDisk(true),
+	)
+}
+
+func TestProtocol_EngineSwitching_WarmStandby(t *testing.T) {
+	testProtocolEngineSwitching(t, protocol.WithWarmStandby(true))
+}
+
+func testProtocolEngineSwitching(t *testing.T, protocolOpts ...options.Option[protocol.Protocol]) {
 	ts := testsuite.NewTestSuite(t,
 		testsuite.WithProtocolParametersOptions(
 			iotago.WithTimeProviderOptions(
@@ -106,6 +127,7 @@ func TestProtocol_EngineSwitching(t *testing.T) {
 				storage.WithPruningDelay(20),
 			),
 		}
+		nodeOptions[node.Name] = append(nodeOptions[node.Name], protocolOpts...)
 	}
 
 	ts.Run(false, nodeOptions)
@@ -361,6 +383,30 @@ func TestProtocol_EngineSwitching(t *testing.T) {
 		// Here we need to let enough time pass for the nodes to sync up the candidate engines and switch them
 		ts.AssertMainEngineSwitchedCount(1, nodesP2...)
 
+		// The engine of the main chain can't be started or aborted manually.
+		for _, node := range nodesP2 {
+			mainChain := node.Protocol.Chains.Main.Get()
+			chain, exists := node.Protocol.Chains.ChainByForkingPoint(mainChain.ForkingPoint.Get().ID())
+			require.True(t, exists)
+			require.Equal(t, mainChain, chain)
+
+			require.ErrorIs(t, node.Protocol.Chains.StartCandidateEngine(mainChain.ForkingPoint.Get().ID()), protocol.ErrorMainChain)
+			require.ErrorIs(t, node.Protocol.Chains.AbortCandidateEngine(mainChain.ForkingPoint.Get().ID()), protocol.ErrorMainChain)
+			require.ErrorIs(t, node.Protocol.Chains.AbortCandidateEngine(iotago.EmptyCommitmentID), protocol.ErrorChainNotFound)
+
+			// the engine that was switched to is named after the slot at which it was forked.
+			mainEngine := node.Protocol.Engines.Main.Get()
+			require.Equal(t, mainChain.ForkingPoint.Get().Slot()-1, mainEngine.ForkingSlot())
+			require.True(t, strings.HasPrefix(mainEngine.Name(), mainEngine.Alias()))
+			require.Equal(t, fmt.Sprintf("Engine[%s@%d]", mainEngine.Alias(), mainEngine.ForkingSlot()), mainEngine.LogName())
+		}
+
+		for _, node := range nodesP1 {
+			mainEngine := node.Protocol.Engines.Main.Get()
+			require.Zero(t, mainEngine.ForkingSlot())
+			require.Equal(t, fmt.Sprintf("Engine[%s]", mainEngine.Alias()), mainEngine.LogName())
+		}
+
 		ctxP1Cancel()
 		wg.Wait()
 	}
@@ -373,6 +419,21 @@ func TestProtocol_EngineSwitching(t *testing.T) {
 	ts.AssertBlocksExist(ts.BlocksWithPrefix("P2"), false, ts.Nodes()...)
 
 	ts.AssertEqualStoredCommitmentAtIndex(expectedCommittedSlotAfterPartitionMerge, ts.Nodes()...)
+
+	// The engines that were kept in standby are released once the nodes switched to their chain.
+	ts.Eventually(func() error {
+		for _, node := range ts.Nodes() {
+			if warmStandby := node.Protocol.Chains.WarmStandby.Get(); warmStandby != nil {
+				return ierrors.Errorf("%s: warm standby %s was not released", node.Name, warmStandby.LogName())
+			}
+
+			if lag := node.Protocol.Chains.WarmStandbyLag.Get(); lag != 0 {
+				return ierrors.Errorf("%s: warm standby lag is %d", node.Name, lag)
+			}
+		}
+
+		return nil
+	})
 }
 
 func TestProtocol_EngineSwitching_CommitteeRotation(t *testing.T) {
@@ -0,0 +1,44 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/iotaledger/iota-core/pkg/testsuite"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func Test_RetainerConfirmationPath(t *testing.T) {
+	ts := testsuite.NewTestSuite(t,
+		testsuite.WithProtocolParametersOptions(
+			iotago.WithTimeProviderOptions(
+				0,
+				testsuite.GenesisTimeWithOffsetBySlots(100, testsuite.DefaultSlotDurationInSeconds),
+				testsuite.DefaultSlotDurationInSeconds,
+				3,
+			),
+			iotago.WithLivenessOptions(
+				10,
+				10,
+				2,
+				4,
+				5,
+			),
+		),
+	)
+	defer ts.Shutdown()
+
+	ts.AddValidatorNode("node0")
+	ts.AddValidatorNode("node1")
+
+	ts.Run(true, nil)
+
+	ts.IssueBlocksAtSlots("", []iotago.SlotIndex{1, 2, 3}, 3, "Genesis", ts.Nodes(), true, false)
+
+	// blocks of the first slot are confirmed but not finalized yet
+	ts.AssertRetainerBlocksConfirmationPath(ts.BlocksWithPrefix("1."), false, ts.Nodes()...)
+
+	ts.IssueBlocksAtSlots("", []iotago.SlotIndex{4, 5, 6, 7, 8, 9, 10}, 3, "3.2", ts.Nodes(), true, false)
+
+	ts.AssertLatestFinalizedSlot(7, ts.Nodes()...)
+	ts.AssertRetainerBlocksConfirmationPath(ts.BlocksWithPrefix("1."), true, ts.Nodes()...)
+}
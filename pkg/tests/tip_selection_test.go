@@ -0,0 +1,167 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/iota-core/pkg/core/acceptance"
+	"github.com/iotaledger/iota-core/pkg/protocol"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
+	"github.com/iotaledger/iota-core/pkg/testsuite"
+	"github.com/iotaledger/iota-core/pkg/testsuite/mock"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func Test_TipSelection_LikedInsteadReferences(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	defer ts.Shutdown()
+
+	node1 := ts.AddValidatorNode("node1")
+	node2 := ts.AddValidatorNode("node2")
+	wallet := ts.AddDefaultWallet(node1)
+
+	ts.Run(true, map[string][]options.Option[protocol.Protocol]{})
+
+	ts.AssertSybilProtectionCommittee(0, []iotago.AccountID{
+		node1.Validator.AccountID,
+		node2.Validator.AccountID,
+	}, ts.Nodes()...)
+
+	// Create double spends and issue them on different nodes.
+	{
+		tx1 := wallet.CreateBasicOutputsEquallyFromInput("tx1", 1, "Genesis:0")
+		tx2 := wallet.CreateBasicOutputsEquallyFromInput("tx2", 1, "Genesis:0")
+
+		ts.IssueBasicBlockWithOptions("block1", wallet, tx1, mock.WithStrongParents(ts.BlockID("Genesis")))
+
+		wallet.SetDefaultNode(node2)
+		ts.IssueBasicBlockWithOptions("block2", wallet, tx2, mock.WithStrongParents(ts.BlockID("Genesis")))
+		wallet.SetDefaultNode(node1)
+
+		ts.AssertTransactionsExist(wallet.Transactions("tx1", "tx2"), true, node1, node2)
+		ts.AssertTransactionsInCacheBooked(wallet.Transactions("tx1", "tx2"), true, node1, node2)
+		ts.AssertTransactionsInCachePending(wallet.Transactions("tx1", "tx2"), true, node1, node2)
+		ts.AssertStrongTips(ts.Blocks("block1", "block2"), node1, node2)
+	}
+
+	// Vote for tx2 so that it becomes the liked conflict of the conflict set (the vote of node2 is cast as soon as its
+	// block is pre-accepted by the validation block of node1, whose own vote is not cast yet).
+	{
+		ts.IssueValidationBlockWithHeaderOptions("block3", node2, mock.WithStrongParents(ts.BlockID("block2")))
+		ts.IssueValidationBlockWithHeaderOptions("block4", node1, mock.WithStrongParents(ts.BlockID("block3")))
+
+		ts.AssertBlocksInCacheConflicts(map[*blocks.Block][]string{
+			ts.Block("block3"): {"tx2"},
+			ts.Block("block4"): {"tx2"},
+		}, node1, node2)
+		ts.AssertSpendersInCacheAcceptanceState([]string{"tx1", "tx2"}, acceptance.Pending, node1, node2)
+		ts.AssertSpendersInCacheLikedInstead([]string{"tx1"}, []string{"tx2"}, node1, node2)
+		ts.AssertSpendersInCacheLikedInstead([]string{"tx2"}, []string{}, node1, node2)
+		ts.AssertStrongTips(ts.Blocks("block1", "block4"), node1, node2)
+	}
+
+	// Issue a block using tip selection and make sure that block1 (supporting the disliked tx1) is only referenced
+	// together with a shallow like reference to the attachment of the liked tx2.
+	{
+		block5 := ts.IssueValidationBlockWithHeaderOptions("block5", node2)
+
+		ts.AssertBlockReferences(block5, map[iotago.ParentsType][]*blocks.Block{
+			iotago.StrongParentType:      ts.Blocks("block1", "block4"),
+			iotago.ShallowLikeParentType: ts.Blocks("block2"),
+		})
+
+		ts.AssertBlocksInCacheConflicts(map[*blocks.Block][]string{
+			ts.Block("block5"): {"tx2"},
+		}, node1, node2)
+		ts.AssertStrongTips(ts.Blocks("block5"), node1, node2)
+	}
+
+	// Issue another block using tip selection that accepts tx2 and rejects tx1.
+	{
+		block6 := ts.IssueValidationBlockWithHeaderOptions("block6", node1)
+
+		ts.AssertBlockReferences(block6, map[iotago.ParentsType][]*blocks.Block{
+			iotago.StrongParentType: ts.Blocks("block5"),
+		})
+
+		ts.AssertTransactionsInCacheAccepted(wallet.Transactions("tx2"), true, node1, node2)
+		ts.AssertTransactionsInCacheRejected(wallet.Transactions("tx1"), true, node1, node2)
+	}
+}
+
+func Test_TipSelection_RejectedConflicts(t *testing.T) {
+	ts := testsuite.NewTestSuite(t)
+	defer ts.Shutdown()
+
+	node1 := ts.AddValidatorNode("node1")
+	node2 := ts.AddValidatorNode("node2")
+	wallet := ts.AddDefaultWallet(node1)
+
+	ts.Run(true, map[string][]options.Option[protocol.Protocol]{})
+
+	ts.AssertSybilProtectionCommittee(0, []iotago.AccountID{
+		node1.Validator.AccountID,
+		node2.Validator.AccountID,
+	}, ts.Nodes()...)
+
+	tx1 := wallet.CreateBasicOutputsEquallyFromInput("tx1", 1, "Genesis:0")
+	tx2 := wallet.CreateBasicOutputsEquallyFromInput("tx2", 1, "Genesis:0")
+
+	// Issue the double spends on different nodes and resolve the conflict in favor of tx2.
+	{
+		ts.IssueBasicBlockWithOptions("block1", wallet, tx1, mock.WithStrongParents(ts.BlockID("Genesis")))
+
+		wallet.SetDefaultNode(node2)
+		ts.IssueBasicBlockWithOptions("block2", wallet, tx2, mock.WithStrongParents(ts.BlockID("Genesis")))
+		wallet.SetDefaultNode(node1)
+
+		ts.IssueValidationBlockWithHeaderOptions("block3", node1, mock.WithStrongParents(ts.BlockID("block2")))
+		ts.IssueValidationBlockWithHeaderOptions("block4", node2, mock.WithStrongParents(ts.BlockID("block3")))
+		ts.IssueValidationBlockWithHeaderOptions("block5", node1, mock.WithStrongParents(ts.BlockID("block4")))
+
+		ts.AssertTransactionsInCacheAccepted(wallet.Transactions("tx2"), true, node1, node2)
+		ts.AssertTransactionsInCacheRejected(wallet.Transactions("tx1"), true, node1, node2)
+		ts.AssertStrongTips(ts.Blocks("block1", "block5"), node1, node2)
+	}
+
+	// Blocks that arrive after the conflict was rejected and inherit the rejected conflict are not added to the strong
+	// tip pool.
+	{
+		ts.IssueBasicBlockWithOptions("block6", wallet, &iotago.TaggedData{}, mock.WithStrongParents(ts.BlockID("block1")))
+
+		ts.AssertBlocksInCacheConflicts(map[*blocks.Block][]string{
+			ts.Block("block6"): {"tx1"},
+		}, node1, node2)
+		ts.AssertStrongTips(ts.Blocks("block1", "block5"), node1, node2)
+		ts.AssertWeakTips(ts.Blocks("block6"), node1, node2)
+	}
+
+	// Blocks that carry the rejected transaction in their payload are dropped and therefore not even selected as weak
+	// parents.
+	{
+		ts.IssueBasicBlockWithOptions("block7", wallet, tx1, mock.WithStrongParents(ts.BlockID("block5")))
+
+		ts.AssertBlocksInCacheConflicts(map[*blocks.Block][]string{
+			ts.Block("block7"): {"tx1"},
+		}, node1, node2)
+		ts.AssertStrongTips(ts.Blocks("block1", "block5"), node1, node2)
+		ts.AssertWeakTips(ts.Blocks("block6"), node1, node2)
+	}
+
+	// Tip selection references the block that was a strong tip before the conflict got rejected together with a shallow
+	// like reference to the accepted conflict and references the block that inherits the rejected conflict only weakly.
+	{
+		block8 := ts.IssueValidationBlockWithHeaderOptions("block8", node1)
+
+		ts.AssertBlockReferences(block8, map[iotago.ParentsType][]*blocks.Block{
+			iotago.StrongParentType:      ts.Blocks("block1", "block5"),
+			iotago.WeakParentType:        ts.Blocks("block6"),
+			iotago.ShallowLikeParentType: ts.Blocks("block2"),
+		})
+
+		// the conflict is resolved, so the block does not inherit any conflicts anymore
+		ts.AssertBlocksInCacheConflicts(map[*blocks.Block][]string{
+			ts.Block("block8"): {},
+		}, node1, node2)
+	}
+}
@@ -0,0 +1,145 @@
+package tests
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/iota-core/pkg/protocol/engine"
+	"github.com/iotaledger/iota-core/pkg/testsuite"
+	"github.com/iotaledger/iota-core/pkg/testsuite/mock"
+	"github.com/iotaledger/iota-core/pkg/txbuilder"
+	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/tpkg"
+)
+
+func Test_TransactionBuilder(t *testing.T) {
+	ts := testsuite.NewTestSuite(t,
+		testsuite.WithProtocolParametersOptions(
+			iotago.WithTimeProviderOptions(
+				0,
+				testsuite.GenesisTimeWithOffsetBySlots(100, testsuite.DefaultSlotDurationInSeconds),
+				testsuite.DefaultSlotDurationInSeconds,
+				3,
+			),
+			iotago.WithLivenessOptions(
+				10,
+				10,
+				2,
+				4,
+				5,
+			),
+		),
+	)
+	defer ts.Shutdown()
+
+	node0 := ts.AddValidatorNode("node0")
+	ts.AddValidatorNode("node1")
+	wallet := ts.AddGenesisWallet("default", node0)
+
+	ts.Run(true, nil)
+
+	_, lastBlockRow := ts.IssueBlocksAtSlots("", []iotago.SlotIndex{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}, 3, "Genesis", ts.Nodes(), true, false)
+	latestParents := []iotago.BlockID{lastBlockRow[0].ID()}
+
+	txBuilder := txbuilder.New(func() *engine.Engine {
+		return node0.Protocol.Engines.Main.Get()
+	})
+
+	// issueAndCommit signs the given transaction with the wallet, issues it and commits the slot it was issued in.
+	issueAndCommit := func(name string, unsignedTx *txbuilder.UnsignedTransaction) {
+		signedTx, err := unsignedTx.Sign(wallet.AddressSigner())
+		require.NoError(t, err)
+
+		blockSlot := ts.CurrentSlot()
+		block := ts.IssueBasicBlockWithOptions(name, wallet, signedTx, mock.WithStrongParents(latestParents...))
+		latestParents = ts.CommitUntilSlot(blockSlot, block.ID())
+
+		ts.AssertTransactionsInCacheAccepted([]*iotago.Transaction{signedTx.Transaction}, true, ts.Nodes()...)
+	}
+
+	// SEND BASE TOKENS
+	{
+		receiverAddress := tpkg.RandEd25519Address()
+
+		unsignedTx, err := txBuilder.Send(&txbuilder.SendIntent{
+			SenderAddress:   wallet.Address(),
+			ReceiverAddress: receiverAddress,
+			Amount:          1_000_000,
+		})
+		require.NoError(t, err)
+		require.Len(t, unsignedTx.Inputs, 1)
+		require.Equal(t, wallet.Output("Genesis:0").OutputID(), unsignedTx.Inputs[0].OutputID())
+		require.Len(t, unsignedTx.Transaction.Outputs, 2)
+		require.Equal(t, iotago.BaseToken(1_000_000), unsignedTx.Transaction.Outputs[0].BaseTokenAmount())
+
+		issueAndCommit("send", unsignedTx)
+	}
+
+	// DELEGATE STAKE
+	{
+		validatorAddress := iotago.AccountAddress(node0.Validator.AccountID)
+
+		unsignedTx, err := txBuilder.Delegate(&txbuilder.DelegateIntent{
+			Address:          wallet.Address(),
+			ValidatorAddress: &validatorAddress,
+			Amount:           2_000_000,
+		})
+		require.NoError(t, err)
+		require.Len(t, unsignedTx.Transaction.Outputs, 2)
+		require.IsType(t, &iotago.DelegationOutput{}, unsignedTx.Transaction.Outputs[0])
+
+		issueAndCommit("delegate", unsignedTx)
+	}
+
+	// CREATE ACCOUNT WITH BLOCK ISSUER FEATURE
+	{
+		_, publicKey := wallet.KeyPair()
+
+		unsignedTx, err := txBuilder.CreateAccount(&txbuilder.CreateAccountIntent{
+			Address:         wallet.Address(),
+			BlockIssuerKeys: iotago.NewBlockIssuerKeys(iotago.Ed25519PublicKeyHashBlockIssuerKeyFromPublicKey(publicKey)),
+		})
+		require.NoError(t, err)
+		require.IsType(t, &iotago.AccountOutput{}, unsignedTx.Transaction.Outputs[0])
+
+		issueAndCommit("createAccount", unsignedTx)
+	}
+
+	// SEND BASE TOKENS TO MULTIPLE RECEIVERS AND ALLOT MANA
+	{
+		unsignedTx, err := txBuilder.SendMany(&txbuilder.SendManyIntent{
+			SenderAddress: wallet.Address(),
+			Transfers: []*txbuilder.Transfer{
+				{ReceiverAddress: tpkg.RandEd25519Address(), Amount: 1_000_000},
+				{ReceiverAddress: tpkg.RandEd25519Address(), Amount: 2_000_000},
+			},
+			Allotments: iotago.Allotments{
+				{AccountID: node0.Validator.AccountID, Mana: 10},
+			},
+		})
+		require.NoError(t, err)
+		require.Len(t, unsignedTx.Transaction.Outputs, 3)
+		require.Equal(t, iotago.BaseToken(2_000_000), unsignedTx.Transaction.Outputs[1].BaseTokenAmount())
+		require.Len(t, unsignedTx.Transaction.Allotments, 1)
+
+		issueAndCommit("sendMany", unsignedTx)
+
+		_, err = txBuilder.SendMany(&txbuilder.SendManyIntent{
+			SenderAddress: wallet.Address(),
+			Transfers:     []*txbuilder.Transfer{{ReceiverAddress: tpkg.RandEd25519Address(), Amount: 1_000_000}},
+			Allotments:    iotago.Allotments{{AccountID: node0.Validator.AccountID, Mana: iotago.MaxMana}},
+		})
+		require.ErrorIs(t, err, txbuilder.ErrInsufficientMana)
+	}
+
+	// INSUFFICIENT FUNDS
+	{
+		_, err := txBuilder.Send(&txbuilder.SendIntent{
+			SenderAddress:   tpkg.RandEd25519Address(),
+			ReceiverAddress: wallet.Address(),
+			Amount:          1_000_000,
+		})
+		require.ErrorIs(t, err, txbuilder.ErrInsufficientBaseTokens)
+	}
+}
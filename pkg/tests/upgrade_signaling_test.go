@@ -417,3 +417,104 @@ func Test_Upgrade_Signaling(t *testing.T) {
 		testsuite.WithEqualStoredCommitmentAtIndex(69),
 	)
 }
+
+func Test_Upgrade_MixedCommittee(t *testing.T) {
+	ts := testsuite.NewTestSuite(t,
+		testsuite.WithProtocolParametersOptions(
+			iotago.WithTimeProviderOptions(
+				0,
+				testsuite.GenesisTimeWithOffsetBySlots(1000, testsuite.DefaultSlotDurationInSeconds),
+				testsuite.DefaultSlotDurationInSeconds,
+				3,
+			),
+			iotago.WithLivenessOptions(
+				10,
+				10,
+				2,
+				4,
+				5,
+			),
+			iotago.WithVersionSignalingOptions(7, 5, 2),
+		),
+	)
+	defer ts.Shutdown()
+
+	// We "pretend" to have version 5 but reuse the same protocol parameters as for version 3.
+	v5ProtocolParameters := iotago.NewV3SnapshotProtocolParameters(
+		append(
+			ts.ProtocolParameterOptions,
+			iotago.WithVersion(5),
+		)...,
+	)
+
+	nodeOptions := []options.Option[protocol.Protocol]{
+		protocol.WithSybilProtectionProvider(
+			sybilprotectionv1.NewProvider(
+				sybilprotectionv1.WithSeatManagerProvider(
+					topstakers.NewProvider(
+						// We need to make sure that the halted nodes are evicted from the committee to continue acceptance.
+						topstakers.WithActivityWindow(15 * time.Second),
+					),
+				),
+			),
+		),
+	}
+
+	nodeA := ts.AddValidatorNode("nodeA")
+	ts.AddValidatorNode("nodeB")
+	ts.AddValidatorNode("nodeC")
+	ts.AddValidatorNode("nodeD")
+	ts.AddNode("nodeE")
+	ts.AddDefaultWallet(nodeA)
+
+	ts.Run(true, map[string][]options.Option[protocol.Protocol]{
+		"nodeA": append(nodeOptions, ts.ProtocolVersionOptions(v5ProtocolParameters)...),
+		"nodeB": append(nodeOptions, ts.ProtocolVersionOptions(v5ProtocolParameters)...),
+		"nodeC": append(nodeOptions, ts.ProtocolVersionOptions(v5ProtocolParameters)...),
+		"nodeD": append(nodeOptions, ts.ProtocolVersionOptions()...),
+		"nodeE": append(nodeOptions, ts.ProtocolVersionOptions()...),
+	})
+
+	upgradedNodes := ts.Nodes("nodeA", "nodeB", "nodeC")
+	oldNodes := ts.Nodes("nodeD", "nodeE")
+
+	// The upgraded nodes hold 3 out of 4 seats, which is enough to reach the supermajority of the upgrade signaling.
+	ts.SignalProtocolVersion(v5ProtocolParameters, upgradedNodes...)
+
+	parentsPrefix := "Genesis"
+	for epoch := iotago.EpochIndex(0); epoch < 6; epoch++ {
+		ts.IssueBlocksAtEpoch("", epoch, 4, parentsPrefix, ts.Nodes(), true, false)
+		parentsPrefix = fmt.Sprintf("%d.3", ts.API.TimeProvider().EpochEnd(epoch))
+	}
+
+	// The signaling window reached its target ratio in epoch 4, so the version gets activated after the activation
+	// offset of 2 epochs. All nodes know about the activation, but only the upgraded ones know the new parameters.
+	ts.AssertEpochVersions(map[iotago.Version]iotago.EpochIndex{
+		3: 0,
+		5: 6,
+	}, ts.Nodes()...)
+
+	ts.AssertVersionAndProtocolParametersHashes(map[iotago.Version]iotago.Identifier{
+		3: lo.PanicOnErr(ts.API.ProtocolParameters().Hash()),
+		5: lo.PanicOnErr(v5ProtocolParameters.Hash()),
+	}, ts.Nodes()...)
+
+	// Only the upgraded nodes are able to issue and process blocks with the new version.
+	activationSlot := ts.API.TimeProvider().EpochStart(6)
+	ts.IssueBlocksAtSlots("", []iotago.SlotIndex{activationSlot, activationSlot + 1}, 4, parentsPrefix, upgradedNodes, false, false)
+	ts.IssueBlocksAtSlots("", ts.SlotsForEpoch(6)[2:], 4, fmt.Sprintf("%d.3", activationSlot+1), upgradedNodes, true, false)
+
+	ts.AssertProtocolVersionActivated(v5ProtocolParameters, 6, upgradedNodes...)
+	ts.AssertProtocolVersionHalted(v5ProtocolParameters, 6, oldNodes...)
+
+	ts.AssertNodeState(upgradedNodes,
+		testsuite.WithLatestCommitmentSlotIndex(ts.API.TimeProvider().EpochEnd(6)-2),
+		testsuite.WithEqualStoredCommitmentAtIndex(ts.API.TimeProvider().EpochEnd(6)-2),
+	)
+
+	// The old nodes halted cleanly at the last slot they were able to commit and agree with the upgraded nodes on it.
+	ts.AssertNodeState(oldNodes,
+		testsuite.WithLatestCommitmentSlotIndex(activationSlot-3),
+	)
+	ts.AssertEqualStoredCommitmentAtIndex(activationSlot-3, ts.Nodes()...)
+}
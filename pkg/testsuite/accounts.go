@@ -184,3 +184,23 @@ func (t *TestSuite) AssertAccountDiff(accountID iotago.AccountID, index iotago.S
 		})
 	}
 }
+
+func (t *TestSuite) AssertManaTrace(transactionID iotago.TransactionID, slot iotago.SlotIndex, expectedManaTrace *model.ManaTrace, nodes ...*mock.Node) {
+	for _, node := range nodes {
+		t.Eventually(func() error {
+			actualManaTrace, exists, err := node.Protocol.Engines.Main.Get().Ledger.ManaTrace(transactionID, slot)
+			if err != nil {
+				return ierrors.Wrapf(err, "AssertManaTrace: %s: failed to load mana trace of transaction %s", node.Name, transactionID)
+			}
+			if !exists {
+				return ierrors.Errorf("AssertManaTrace: %s: mana trace of transaction %s does not exist in slot %d", node.Name, transactionID, slot)
+			}
+
+			if !assert.Equal(t.fakeTesting, expectedManaTrace, actualManaTrace) {
+				return ierrors.Errorf("AssertManaTrace: %s: transaction %s expected mana trace %v, got %v", node.Name, transactionID, expectedManaTrace, actualManaTrace)
+			}
+
+			return nil
+		})
+	}
+}
@@ -26,6 +26,19 @@ func (t *TestSuite) AssertActiveRootBlocks(expectedBlocks []*blocks.Block, nodes
 				return ierrors.Errorf("AssertActiveRootBlocks: %s: expected %v, got %v", node.Name, expectedRootBlocks, activeRootBlocks)
 			}
 
+			latestCommitment, engineRootBlocks, err := node.Protocol.Engines.Main.Get().ActiveRootBlocks()
+			if err != nil {
+				return ierrors.Wrapf(err, "AssertActiveRootBlocks: %s: failed to get active root blocks of engine", node.Name)
+			}
+
+			if !assert.Equal(t.fakeTesting, expectedRootBlocks, engineRootBlocks) {
+				return ierrors.Errorf("AssertActiveRootBlocks: %s: expected %v from engine, got %v", node.Name, expectedRootBlocks, engineRootBlocks)
+			}
+
+			if latestCommittedSlot := node.Protocol.Engines.Main.Get().Storage.Settings().LatestCommitment().Slot(); latestCommitment.Slot() != latestCommittedSlot {
+				return ierrors.Errorf("AssertActiveRootBlocks: %s: expected root blocks of slot %d, got %d", node.Name, latestCommittedSlot, latestCommitment.Slot())
+			}
+
 			return nil
 		})
 	}
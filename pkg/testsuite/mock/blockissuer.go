@@ -76,7 +76,7 @@ func NewBlockIssuer(t *testing.T, name string, keyManager *wallet.KeyManager, ac
 		Name:                          name,
 		Validator:                     validator,
 		events:                        NewEvents(),
-		workerPool:                    workerpool.New("BlockIssuer"),
+		workerPool:                    workerpool.New("BlockIssuer").Start(),
 		privateKey:                    priv,
 		PublicKey:                     pub,
 		AccountID:                     accountID,
@@ -114,6 +114,20 @@ func (e *Endpoint) LocalPeerID() peer.ID {
 	return e.id
 }
 
+func (e *Endpoint) AllNeighborsIDs() []peer.ID {
+	e.network.dispatchersMutex.RLock()
+	defer e.network.dispatchersMutex.RUnlock()
+
+	neighborIDs := make([]peer.ID, 0, len(e.network.dispatchersByPartition[e.partition]))
+	for id := range e.network.dispatchersByPartition[e.partition] {
+		if id != e.id {
+			neighborIDs = append(neighborIDs, id)
+		}
+	}
+
+	return neighborIDs
+}
+
 func (e *Endpoint) RegisterProtocol(_ func() proto.Message, handler func(peer.ID, proto.Message) error) {
 	e.network.dispatchersMutex.Lock()
 	defer e.network.dispatchersMutex.Unlock()
@@ -26,6 +26,7 @@ import (
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/filter/presolidfilter"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/mempool"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/notarization"
+	"github.com/iotaledger/iota-core/pkg/protocol/sybilprotection/activitytracker"
 	iotago "github.com/iotaledger/iota.go/v4"
 	"github.com/iotaledger/iota.go/v4/wallet"
 )
@@ -385,6 +386,10 @@ func (n *Node) attachEngineLogsWithName(failOnBlockFiltered bool, instance *engi
 		instance.LogTrace("SybilProtection.OnlineCommitteeSeatRemoved", "seat", seat)
 	})
 
+	events.SeatManager.OnlineWeightChanged.Hook(func(change *activitytracker.OnlineWeightChange) {
+		instance.LogTrace("SybilProtection.OnlineWeightChanged", "seat", change.Seat, "online", change.Online, "onlineSeats", change.OnlineSeats, "acceptanceThreshold", change.AcceptanceThreshold)
+	})
+
 	events.SybilProtection.CommitteeSelected.Hook(func(committee *account.Accounts, epoch iotago.EpochIndex) {
 		instance.LogTrace("SybilProtection.CommitteeSelected", "epoch", epoch, "committee", committee.IDs())
 	})
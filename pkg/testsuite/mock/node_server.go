@@ -0,0 +1,203 @@
+package mock
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/labstack/echo/v4"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/inx-app/pkg/httpserver"
+	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/api"
+)
+
+// NodeServer is a lightweight in-process server that serves the subset of the core REST API that is required by SDKs
+// and tools like the evil spammer (info, block issuance, block submission and outputs). It is backed by the engine of
+// a mock Node, which allows to run their integration tests without spinning up a docker network.
+type NodeServer struct {
+	Testing *testing.T
+
+	node        *Node
+	blockIssuer *BlockIssuer
+	echo        *echo.Echo
+	server      *httptest.Server
+}
+
+// NewNodeServer creates a new NodeServer for the given node and starts serving its routes. Submitted blocks are
+// attached through the given BlockIssuer.
+func NewNodeServer(t *testing.T, node *Node, blockIssuer *BlockIssuer) *NodeServer {
+	s := &NodeServer{
+		Testing:     t,
+		node:        node,
+		blockIssuer: blockIssuer,
+		echo:        httpserver.NewEcho(node.logger, nil, false),
+	}
+
+	s.registerRoutes()
+	s.server = httptest.NewServer(s.echo)
+
+	return s
+}
+
+// URL returns the base URL of the server that can be passed to the node clients.
+func (s *NodeServer) URL() string {
+	return s.server.URL
+}
+
+// Shutdown stops the server and closes all open connections.
+func (s *NodeServer) Shutdown() {
+	s.server.Close()
+}
+
+func (s *NodeServer) registerRoutes() {
+	s.echo.GET(api.CoreRouteInfo, func(c echo.Context) error {
+		return s.responseByHeader(c, s.info())
+	})
+
+	s.echo.GET(api.CoreRouteBlockIssuance, func(c echo.Context) error {
+		resp, err := s.blockIssuance()
+		if err != nil {
+			return err
+		}
+
+		return s.responseByHeader(c, resp)
+	})
+
+	s.echo.POST(api.CoreRouteBlocks, func(c echo.Context) error {
+		resp, err := s.sendBlock(c)
+		if err != nil {
+			return err
+		}
+		c.Response().Header().Set(echo.HeaderLocation, resp.BlockID.ToHex())
+
+		return s.responseByHeader(c, resp, http.StatusCreated)
+	})
+
+	s.echo.GET(api.EndpointWithEchoParameters(api.CoreRouteOutput), func(c echo.Context) error {
+		resp, err := s.outputByID(c)
+		if err != nil {
+			return err
+		}
+
+		return s.responseByHeader(c, resp)
+	})
+}
+
+func (s *NodeServer) info() *api.InfoResponse {
+	engineInstance := s.node.Protocol.Engines.Main.Get()
+	clSnapshot := engineInstance.Clock.Snapshot()
+	syncStatus := engineInstance.SyncManager.SyncStatus()
+
+	protocolParameters := make([]*api.InfoResProtocolParameters, 0)
+	apiProvider := engineInstance.Storage.Settings().APIProvider()
+	for _, version := range apiProvider.ProtocolEpochVersions() {
+		if versionParameters := apiProvider.ProtocolParameters(version.Version); versionParameters != nil {
+			protocolParameters = append(protocolParameters, &api.InfoResProtocolParameters{
+				StartEpoch: version.StartEpoch,
+				Parameters: versionParameters,
+			})
+		}
+	}
+
+	return &api.InfoResponse{
+		Name:    s.node.Name,
+		Version: "mock",
+		Status: &api.InfoResNodeStatus{
+			IsHealthy:                   syncStatus.NodeSynced,
+			AcceptedTangleTime:          clSnapshot.AcceptedTime,
+			RelativeAcceptedTangleTime:  clSnapshot.RelativeAcceptedTime,
+			ConfirmedTangleTime:         clSnapshot.ConfirmedTime,
+			RelativeConfirmedTangleTime: clSnapshot.RelativeConfirmedTime,
+			LatestCommitmentID:          syncStatus.LatestCommitment.ID(),
+			LatestFinalizedSlot:         syncStatus.LatestFinalizedSlot,
+			LatestAcceptedBlockSlot:     syncStatus.LastAcceptedBlockSlot,
+			LatestConfirmedBlockSlot:    syncStatus.LastConfirmedBlockSlot,
+			PruningEpoch:                syncStatus.LastPrunedEpoch,
+		},
+		Metrics:            &api.InfoResNodeMetrics{},
+		ProtocolParameters: protocolParameters,
+		BaseToken: &api.InfoResBaseToken{
+			Name:         "TestToken",
+			TickerSymbol: "TEST",
+			Unit:         "TEST",
+			Decimals:     6,
+		},
+		Features: []string{},
+	}
+}
+
+func (s *NodeServer) blockIssuance() (*api.IssuanceBlockHeaderResponse, error) {
+	engineInstance := s.node.Protocol.Engines.Main.Get()
+
+	references := engineInstance.TipSelection.SelectTips(iotago.BasicBlockMaxParents)
+	if len(references[iotago.StrongParentType]) == 0 {
+		return nil, ierrors.Wrap(echo.ErrServiceUnavailable, "no strong parents available")
+	}
+
+	var latestParentBlockIssuingTime time.Time
+	for _, parentType := range []iotago.ParentsType{iotago.StrongParentType, iotago.WeakParentType, iotago.ShallowLikeParentType} {
+		for _, blockID := range references[parentType] {
+			block, exists := engineInstance.Block(blockID)
+			if !exists {
+				return nil, ierrors.Wrapf(echo.ErrNotFound, "no block found for parent, block ID: %s", blockID.ToHex())
+			}
+
+			if latestParentBlockIssuingTime.Before(block.ProtocolBlock().Header.IssuingTime) {
+				latestParentBlockIssuingTime = block.ProtocolBlock().Header.IssuingTime
+			}
+		}
+	}
+
+	return &api.IssuanceBlockHeaderResponse{
+		StrongParents:                references[iotago.StrongParentType],
+		WeakParents:                  references[iotago.WeakParentType],
+		ShallowLikeParents:           references[iotago.ShallowLikeParentType],
+		LatestParentBlockIssuingTime: latestParentBlockIssuingTime,
+		LatestFinalizedSlot:          engineInstance.SyncManager.LatestFinalizedSlot(),
+		LatestCommitment:             engineInstance.SyncManager.LatestCommitment().Commitment(),
+	}, nil
+}
+
+func (s *NodeServer) sendBlock(c echo.Context) (*api.BlockCreatedResponse, error) {
+	iotaBlock, err := httpserver.ParseRequestByHeader(c, s.node.Protocol.CommittedAPI(), iotago.BlockFromBytes(s.node.Protocol))
+	if err != nil {
+		return nil, err
+	}
+
+	blockID, err := s.blockIssuer.AttachBlock(c.Request().Context(), iotaBlock, s.node)
+	if err != nil {
+		if ierrors.Is(err, ErrBlockAttacherInvalidBlock) || ierrors.Is(err, ErrBlockAttacherIncompleteBlockNotAllowed) {
+			return nil, ierrors.Wrapf(httpserver.ErrInvalidParameter, "failed to attach block: %s", err)
+		}
+
+		return nil, ierrors.Wrapf(echo.ErrInternalServerError, "failed to attach block: %s", err)
+	}
+
+	return &api.BlockCreatedResponse{
+		BlockID: blockID,
+	}, nil
+}
+
+func (s *NodeServer) outputByID(c echo.Context) (*api.OutputResponse, error) {
+	outputID, err := httpserver.ParseOutputIDParam(c, api.ParameterOutputID)
+	if err != nil {
+		return nil, ierrors.Wrapf(err, "failed to parse output ID %s", c.Param(api.ParameterOutputID))
+	}
+
+	output, err := s.node.Protocol.Engines.Main.Get().Ledger.Output(outputID)
+	if err != nil {
+		return nil, ierrors.Wrapf(echo.ErrNotFound, "failed to get output %s from the Ledger: %s", outputID.ToHex(), err)
+	}
+
+	return &api.OutputResponse{
+		Output:        output.Output(),
+		OutputIDProof: output.OutputIDProof(),
+	}, nil
+}
+
+func (s *NodeServer) responseByHeader(c echo.Context, obj any, httpStatusCode ...int) error {
+	return httpserver.SendResponseByHeader(c, s.node.Protocol.CommittedAPI(), obj, httpStatusCode...)
+}
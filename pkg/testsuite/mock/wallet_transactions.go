@@ -183,6 +183,10 @@ func (w *Wallet) TransitionAccount(transactionName string, inputName string, opt
 	}
 
 	accountBuilder := builder.NewAccountOutputBuilderFromPrevious(accountOutput)
+	// accounts that were created by the input transaction are identified by the ID of their first output.
+	if accountOutput.AccountID.Empty() {
+		accountBuilder.AccountID(iotago.AccountIDFromOutputID(input.OutputID()))
+	}
 	accountOutput = options.Apply(accountBuilder, opts).MustBuild()
 
 	signedTransaction := w.createSignedTransactionWithOptions(
@@ -353,7 +357,12 @@ func (w *Wallet) RemoveFeatureFromAccount(featureType iotago.FeatureType, transa
 	}
 
 	// clone the output but remove the feature of the specified type.
-	accountOutput := builder.NewAccountOutputBuilderFromPrevious(inputAccount).RemoveFeature(featureType).MustBuild()
+	accountBuilder := builder.NewAccountOutputBuilderFromPrevious(inputAccount).RemoveFeature(featureType)
+	// accounts that were created by the input transaction are identified by the ID of their first output.
+	if inputAccount.AccountID.Empty() {
+		accountBuilder.AccountID(iotago.AccountIDFromOutputID(input.OutputID()))
+	}
+	accountOutput := accountBuilder.MustBuild()
 
 	signedTransaction := w.createSignedTransactionWithOptions(
 		transactionName,
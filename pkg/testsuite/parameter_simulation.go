@@ -0,0 +1,275 @@
+package testsuite
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/hive.go/runtime/syncutils"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/mempool"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/notarization"
+	"github.com/iotaledger/iota-core/pkg/testsuite/mock"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// ParameterSet is a named set of protocol parameter options that is simulated by CompareProtocolParameters.
+type ParameterSet struct {
+	// Name is the name of the parameter set that is used in the report.
+	Name string
+
+	// Options are the protocol parameter options that are applied on top of the options of the TestSuite.
+	Options []options.Option[iotago.V3ProtocolParameters]
+}
+
+// SimulationWorkload is a scripted workload that is executed under every simulated ParameterSet.
+type SimulationWorkload struct {
+	// Setup adds the nodes and wallets to the TestSuite and starts it.
+	Setup func(ts *TestSuite)
+
+	// Run issues the blocks and transactions of the workload. The TestSuite is observed while Run is executed.
+	Run func(ts *TestSuite)
+
+	// Observer is the name of the node whose perspective is used for the report (the first node if empty).
+	Observer string
+}
+
+// SimulationReport contains the measurements of a SimulationWorkload from the perspective of the observer node.
+type SimulationReport struct {
+	ParameterSet string
+
+	AttachedBlocks int
+	AcceptedBlocks int
+	DroppedBlocks  int
+
+	// OrphanedBlocks is the number of attached blocks that were not accepted until the end of the workload.
+	OrphanedBlocks int
+
+	AcceptanceLatencyAvg time.Duration
+	AcceptanceLatencyP95 time.Duration
+	AcceptanceLatencyMax time.Duration
+
+	AttachedTransactions int
+	AcceptedTransactions int
+	OrphanedTransactions int
+
+	Commitments                 int
+	CommitmentBlocksAvg         float64
+	CommitmentBlocksMax         int
+	CommitmentOutputsCreatedAvg float64
+	CommitmentOutputsSpentAvg   float64
+}
+
+// ParameterComparison contains the reports of the same SimulationWorkload executed under two parameter sets.
+type ParameterComparison struct {
+	Baseline  *SimulationReport
+	Candidate *SimulationReport
+}
+
+// String returns a human-readable table that compares the baseline with the candidate.
+func (p *ParameterComparison) String() string {
+	var builder strings.Builder
+
+	row := func(name string, baseline, candidate any, delta string) {
+		builder.WriteString(fmt.Sprintf("%-32s %16v %16v %16s\n", name, baseline, candidate, delta))
+	}
+	intRow := func(name string, baseline, candidate int) {
+		row(name, baseline, candidate, fmt.Sprintf("%+d", candidate-baseline))
+	}
+	floatRow := func(name string, baseline, candidate float64) {
+		row(name, fmt.Sprintf("%.2f", baseline), fmt.Sprintf("%.2f", candidate), fmt.Sprintf("%+.2f", candidate-baseline))
+	}
+	durationRow := func(name string, baseline, candidate time.Duration) {
+		if delta := candidate - baseline; delta < 0 {
+			row(name, baseline, candidate, delta.String())
+		} else {
+			row(name, baseline, candidate, "+"+delta.String())
+		}
+	}
+
+	row("", p.Baseline.ParameterSet, p.Candidate.ParameterSet, "delta")
+	intRow("AttachedBlocks", p.Baseline.AttachedBlocks, p.Candidate.AttachedBlocks)
+	intRow("AcceptedBlocks", p.Baseline.AcceptedBlocks, p.Candidate.AcceptedBlocks)
+	intRow("DroppedBlocks", p.Baseline.DroppedBlocks, p.Candidate.DroppedBlocks)
+	intRow("OrphanedBlocks", p.Baseline.OrphanedBlocks, p.Candidate.OrphanedBlocks)
+	durationRow("AcceptanceLatencyAvg", p.Baseline.AcceptanceLatencyAvg, p.Candidate.AcceptanceLatencyAvg)
+	durationRow("AcceptanceLatencyP95", p.Baseline.AcceptanceLatencyP95, p.Candidate.AcceptanceLatencyP95)
+	durationRow("AcceptanceLatencyMax", p.Baseline.AcceptanceLatencyMax, p.Candidate.AcceptanceLatencyMax)
+	intRow("AttachedTransactions", p.Baseline.AttachedTransactions, p.Candidate.AttachedTransactions)
+	intRow("AcceptedTransactions", p.Baseline.AcceptedTransactions, p.Candidate.AcceptedTransactions)
+	intRow("OrphanedTransactions", p.Baseline.OrphanedTransactions, p.Candidate.OrphanedTransactions)
+	intRow("Commitments", p.Baseline.Commitments, p.Candidate.Commitments)
+	floatRow("CommitmentBlocksAvg", p.Baseline.CommitmentBlocksAvg, p.Candidate.CommitmentBlocksAvg)
+	intRow("CommitmentBlocksMax", p.Baseline.CommitmentBlocksMax, p.Candidate.CommitmentBlocksMax)
+	floatRow("CommitmentOutputsCreatedAvg", p.Baseline.CommitmentOutputsCreatedAvg, p.Candidate.CommitmentOutputsCreatedAvg)
+	floatRow("CommitmentOutputsSpentAvg", p.Baseline.CommitmentOutputsSpentAvg, p.Candidate.CommitmentOutputsSpentAvg)
+
+	return builder.String()
+}
+
+// CompareProtocolParameters executes the workload once under the baseline and once under the candidate parameters
+// (each in a fresh TestSuite running as a subtest) and returns a comparative report of both runs.
+func CompareProtocolParameters(testingT *testing.T, workload SimulationWorkload, baseline ParameterSet, candidate ParameterSet, opts ...options.Option[TestSuite]) *ParameterComparison {
+	comparison := &ParameterComparison{
+		Baseline:  SimulateProtocolParameters(testingT, workload, baseline, opts...),
+		Candidate: SimulateProtocolParameters(testingT, workload, candidate, opts...),
+	}
+
+	testingT.Logf("protocol parameter comparison:\n%s", comparison)
+
+	return comparison
+}
+
+// SimulateProtocolParameters executes the workload under the given parameters in a fresh TestSuite and returns the
+// measurements of the run.
+func SimulateProtocolParameters(testingT *testing.T, workload SimulationWorkload, parameterSet ParameterSet, opts ...options.Option[TestSuite]) *SimulationReport {
+	var report *SimulationReport
+
+	testingT.Run(parameterSet.Name, func(t *testing.T) {
+		ts := NewTestSuite(t, append(opts, WithProtocolParametersOptions(parameterSet.Options...))...)
+		defer ts.Shutdown()
+
+		workload.Setup(ts)
+
+		observer := ts.Nodes()[0]
+		if workload.Observer != "" {
+			observer = ts.Node(workload.Observer)
+		}
+
+		recorder := newSimulationRecorder(observer)
+
+		workload.Run(ts)
+		ts.Wait(ts.Nodes()...)
+
+		report = recorder.report(parameterSet.Name)
+	})
+
+	if report == nil {
+		testingT.Fatalf("simulation of parameter set %s failed", parameterSet.Name)
+	}
+
+	return report
+}
+
+// simulationRecorder records the events of a node that are required to create a SimulationReport.
+type simulationRecorder struct {
+	attachTimes          map[iotago.BlockID]time.Time
+	acceptanceLatencies  []time.Duration
+	droppedBlocks        int
+	transactions         map[iotago.TransactionID]mempool.TransactionMetadata
+	committedSlotDetails []*notarization.SlotCommittedDetails
+	mutex                syncutils.Mutex
+}
+
+// newSimulationRecorder creates a new simulationRecorder that records the events of the given node.
+func newSimulationRecorder(node *mock.Node) *simulationRecorder {
+	r := &simulationRecorder{
+		attachTimes:  make(map[iotago.BlockID]time.Time),
+		transactions: make(map[iotago.TransactionID]mempool.TransactionMetadata),
+	}
+
+	events := node.Protocol.Events.Engine
+
+	events.BlockDAG.BlockAttached.Hook(func(block *blocks.Block) {
+		r.mutex.Lock()
+		defer r.mutex.Unlock()
+
+		r.attachTimes[block.ID()] = time.Now()
+	})
+
+	events.BlockGadget.BlockAccepted.Hook(func(block *blocks.Block) {
+		r.mutex.Lock()
+		defer r.mutex.Unlock()
+
+		if attachTime, exists := r.attachTimes[block.ID()]; exists {
+			r.acceptanceLatencies = append(r.acceptanceLatencies, time.Since(attachTime))
+		}
+	})
+
+	events.Scheduler.BlockDropped.Hook(func(_ *blocks.Block, _ error) {
+		r.mutex.Lock()
+		defer r.mutex.Unlock()
+
+		r.droppedBlocks++
+	})
+
+	events.Notarization.SlotCommitted.Hook(func(details *notarization.SlotCommittedDetails) {
+		r.mutex.Lock()
+		defer r.mutex.Unlock()
+
+		r.committedSlotDetails = append(r.committedSlotDetails, details)
+	})
+
+	node.Protocol.Engines.Main.Get().Ledger.OnTransactionAttached(func(transactionMetadata mempool.TransactionMetadata) {
+		r.mutex.Lock()
+		defer r.mutex.Unlock()
+
+		r.transactions[transactionMetadata.ID()] = transactionMetadata
+	})
+
+	return r
+}
+
+// report creates a SimulationReport from the recorded events.
+func (r *simulationRecorder) report(parameterSetName string) *SimulationReport {
+	r.mutex.Lock()
+	defer r.mutex.Unlock()
+
+	report := &SimulationReport{
+		ParameterSet:         parameterSetName,
+		AttachedBlocks:       len(r.attachTimes),
+		AcceptedBlocks:       len(r.acceptanceLatencies),
+		DroppedBlocks:        r.droppedBlocks,
+		OrphanedBlocks:       len(r.attachTimes) - len(r.acceptanceLatencies),
+		AttachedTransactions: len(r.transactions),
+		Commitments:          len(r.committedSlotDetails),
+	}
+
+	if len(r.acceptanceLatencies) != 0 {
+		sortedLatencies := make([]time.Duration, len(r.acceptanceLatencies))
+		copy(sortedLatencies, r.acceptanceLatencies)
+		sort.Slice(sortedLatencies, func(i, j int) bool { return sortedLatencies[i] < sortedLatencies[j] })
+
+		var totalLatency time.Duration
+		for _, latency := range sortedLatencies {
+			totalLatency += latency
+		}
+
+		report.AcceptanceLatencyAvg = totalLatency / time.Duration(len(sortedLatencies))
+		report.AcceptanceLatencyP95 = sortedLatencies[(len(sortedLatencies)*95+99)/100-1]
+		report.AcceptanceLatencyMax = sortedLatencies[len(sortedLatencies)-1]
+	}
+
+	for _, transactionMetadata := range r.transactions {
+		if transactionMetadata.IsAccepted() {
+			report.AcceptedTransactions++
+		}
+
+		if _, isOrphaned := transactionMetadata.OrphanedSlot(); isOrphaned {
+			report.OrphanedTransactions++
+		}
+	}
+
+	if len(r.committedSlotDetails) != 0 {
+		var totalBlocks, totalOutputsCreated, totalOutputsSpent int
+		for _, details := range r.committedSlotDetails {
+			committedBlocks := details.AcceptedBlocks.Size()
+			if committedBlocks > report.CommitmentBlocksMax {
+				report.CommitmentBlocksMax = committedBlocks
+			}
+
+			totalBlocks += committedBlocks
+			totalOutputsCreated += len(details.OutputsCreated)
+			totalOutputsSpent += len(details.OutputsConsumed)
+		}
+
+		report.CommitmentBlocksAvg = float64(totalBlocks) / float64(len(r.committedSlotDetails))
+		report.CommitmentOutputsCreatedAvg = float64(totalOutputsCreated) / float64(len(r.committedSlotDetails))
+		report.CommitmentOutputsSpentAvg = float64(totalOutputsSpent) / float64(len(r.committedSlotDetails))
+	}
+
+	return report
+}
@@ -0,0 +1,85 @@
+package testsuite
+
+import (
+	"strings"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
+	"github.com/iotaledger/iota-core/pkg/testsuite/mock"
+	"github.com/iotaledger/iota.go/v4/api"
+)
+
+// AssertRetainerBlocksConfirmationPath asserts that the retainer recorded the slots at which the blocks were accepted
+// and confirmed (and finalized if expectedFinalized is set) in the order of the levels of finality.
+func (t *TestSuite) AssertRetainerBlocksConfirmationPath(blocks []*blocks.Block, expectedFinalized bool, nodes ...*mock.Node) {
+	mustNodes(nodes)
+
+	for _, node := range nodes {
+		for _, block := range blocks {
+			t.Eventually(func() error {
+				blockMetadata, err := node.Protocol.Engines.Main.Get().Retainer.BlockMetadata(block.ID())
+				if err != nil {
+					return ierrors.Errorf("AssertRetainerBlocksConfirmationPath: %s: failed to retrieve metadata of block %s: %s", node.Name, block.ID(), err)
+				}
+
+				if blockMetadata.AcceptedSlot == 0 || blockMetadata.AcceptedSlot < block.ID().Slot() {
+					return ierrors.Errorf("AssertRetainerBlocksConfirmationPath: %s: block %s: unexpected accepted slot %d", node.Name, block.ID(), blockMetadata.AcceptedSlot)
+				}
+
+				if blockMetadata.ConfirmedSlot < blockMetadata.AcceptedSlot {
+					return ierrors.Errorf("AssertRetainerBlocksConfirmationPath: %s: block %s: confirmed slot %d before accepted slot %d", node.Name, block.ID(), blockMetadata.ConfirmedSlot, blockMetadata.AcceptedSlot)
+				}
+
+				if !expectedFinalized {
+					return nil
+				}
+
+				if blockMetadata.BlockState != api.BlockStateFinalized {
+					return ierrors.Errorf("AssertRetainerBlocksConfirmationPath: %s: block %s: expected state %s, got %s", node.Name, block.ID(), api.BlockStateFinalized, blockMetadata.BlockState)
+				}
+
+				if blockMetadata.FinalizedSlot < blockMetadata.ConfirmedSlot {
+					return ierrors.Errorf("AssertRetainerBlocksConfirmationPath: %s: block %s: finalized slot %d before confirmed slot %d", node.Name, block.ID(), blockMetadata.FinalizedSlot, blockMetadata.ConfirmedSlot)
+				}
+
+				return nil
+			})
+		}
+	}
+}
+
+// AssertRetainerFilteredBlocks asserts that the retainer persisted the decision of the given filter to drop the blocks.
+func (t *TestSuite) AssertRetainerFilteredBlocks(blocks []*blocks.Block, filter model.BlockFilter, reason error, nodes ...*mock.Node) {
+	mustNodes(nodes)
+
+	for _, node := range nodes {
+		for _, block := range blocks {
+			t.Eventually(func() error {
+				filteredBlocks, err := node.Protocol.Engines.Main.Get().Retainer.FilteredBlocks(block.ID().Slot())
+				if err != nil {
+					return ierrors.Errorf("AssertRetainerFilteredBlocks: %s: failed to retrieve filtered blocks of slot %d: %s", node.Name, block.ID().Slot(), err)
+				}
+
+				filteredBlock, exists := filteredBlocks[block.ID()]
+				if !exists {
+					return ierrors.Errorf("AssertRetainerFilteredBlocks: %s: block %s was not persisted as filtered", node.Name, block.ID())
+				}
+
+				if filteredBlock.IssuerID != block.ProtocolBlock().Header.IssuerID {
+					return ierrors.Errorf("AssertRetainerFilteredBlocks: %s: block %s: expected issuer %s, got %s", node.Name, block.ID(), block.ProtocolBlock().Header.IssuerID, filteredBlock.IssuerID)
+				}
+
+				if filteredBlock.Filter != filter {
+					return ierrors.Errorf("AssertRetainerFilteredBlocks: %s: block %s: expected filter %s, got %s", node.Name, block.ID(), filter, filteredBlock.Filter)
+				}
+
+				if !strings.Contains(filteredBlock.Reason, reason.Error()) {
+					return ierrors.Errorf("AssertRetainerFilteredBlocks: %s: block %s: expected reason %q, got %q", node.Name, block.ID(), reason, filteredBlock.Reason)
+				}
+
+				return nil
+			})
+		}
+	}
+}
@@ -3,24 +3,82 @@ package testsuite
 import (
 	"github.com/iotaledger/hive.go/ds"
 	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/lo"
 	"github.com/iotaledger/iota-core/pkg/core/acceptance"
 	"github.com/iotaledger/iota-core/pkg/testsuite/mock"
+	iotago "github.com/iotaledger/iota.go/v4"
 )
 
+// ConflictID returns the ID of the conflict that is created by the transaction with the given alias.
+func (t *TestSuite) ConflictID(conflictAlias string) iotago.TransactionID {
+	return t.DefaultWallet().TransactionID(conflictAlias)
+}
+
+// ConflictIDs returns the IDs of the conflicts that are created by the transactions with the given aliases.
+func (t *TestSuite) ConflictIDs(conflictAliases ...string) ds.Set[iotago.TransactionID] {
+	return ds.NewSet(lo.Map(conflictAliases, t.ConflictID)...)
+}
+
+// AssertConflictState asserts that the conflict with the given alias has the expected acceptance state in the spend
+// DAG of all given nodes.
+func (t *TestSuite) AssertConflictState(conflictAlias string, expectedState acceptance.State, nodes ...*mock.Node) {
+	mustNodes(nodes)
+
+	for _, node := range nodes {
+		t.Eventually(func() error {
+			acceptanceState := node.Protocol.Engines.Main.Get().Ledger.SpendDAG().AcceptanceState(t.ConflictIDs(conflictAlias))
+
+			if acceptanceState != expectedState {
+				return ierrors.Errorf("AssertConflictState: %s: conflict %s is %s, but expected %s", node.Name, conflictAlias, acceptanceState, expectedState)
+			}
+
+			return nil
+		})
+	}
+}
+
+// AssertConflictStates asserts that the conflicts with the given aliases have the expected acceptance states in the
+// spend DAG of all given nodes.
+func (t *TestSuite) AssertConflictStates(expectedStates map[string]acceptance.State, nodes ...*mock.Node) {
+	for conflictAlias, expectedState := range expectedStates {
+		t.AssertConflictState(conflictAlias, expectedState, nodes...)
+	}
+}
+
+// AssertDoubleSpendResolved asserts that the double spend was resolved in favor of the accepted conflict on all given
+// nodes, i.e. that the accepted conflict and its transaction are accepted while the competing conflicts and their
+// transactions are rejected.
+func (t *TestSuite) AssertDoubleSpendResolved(acceptedConflictAlias string, rejectedConflictAliases []string, nodes ...*mock.Node) {
+	t.AssertConflictState(acceptedConflictAlias, acceptance.Accepted, nodes...)
+	t.AssertTransactionsInCacheAccepted(t.DefaultWallet().Transactions(acceptedConflictAlias), true, nodes...)
+
+	for _, rejectedConflictAlias := range rejectedConflictAliases {
+		t.AssertConflictState(rejectedConflictAlias, acceptance.Rejected, nodes...)
+	}
+	t.AssertTransactionsInCacheRejected(t.DefaultWallet().Transactions(rejectedConflictAliases...), true, nodes...)
+}
+
 func (t *TestSuite) AssertSpendersInCacheAcceptanceState(expectedConflictAliases []string, expectedState acceptance.State, nodes ...*mock.Node) {
+	for _, conflictAlias := range expectedConflictAliases {
+		t.AssertConflictState(conflictAlias, expectedState, nodes...)
+	}
+}
+
+func (t *TestSuite) AssertSpendersInCacheLikedInstead(spenderAliases []string, expectedLikedInsteadAliases []string, nodes ...*mock.Node) {
 	mustNodes(nodes)
 
+	spenderIDs := t.ConflictIDs(spenderAliases...)
+	expectedLikedInstead := t.ConflictIDs(expectedLikedInsteadAliases...)
+
 	for _, node := range nodes {
-		for _, conflictAlias := range expectedConflictAliases {
-			t.Eventually(func() error {
-				acceptanceState := node.Protocol.Engines.Main.Get().Ledger.SpendDAG().AcceptanceState(ds.NewSet(t.DefaultWallet().TransactionID(conflictAlias)))
+		t.Eventually(func() error {
+			likedInstead := node.Protocol.Engines.Main.Get().Ledger.SpendDAG().LikedInstead(spenderIDs)
 
-				if acceptanceState != expectedState {
-					return ierrors.Errorf("assertTransactionsInCacheWithFunc: %s: conflict %s is %s, but expected %s", node.Name, conflictAlias, acceptanceState, expectedState)
-				}
+			if likedInstead.Size() != expectedLikedInstead.Size() || !likedInstead.HasAll(expectedLikedInstead) {
+				return ierrors.Errorf("AssertSpendersInCacheLikedInstead: %s: spenders %s like %s instead, but expected %s", node.Name, spenderIDs, likedInstead, expectedLikedInstead)
+			}
 
-				return nil
-			})
-		}
+			return nil
+		})
 	}
 }
@@ -94,3 +94,49 @@ func (t *TestSuite) AssertStorageCommitmentBlocks(slot iotago.SlotIndex, expecte
 		})
 	}
 }
+
+// AssertEqualStoredRootsAtIndex asserts that the roots stored for the commitment of the given slot match the RootsID of
+// the commitment and are equal on all nodes.
+func (t *TestSuite) AssertEqualStoredRootsAtIndex(index iotago.SlotIndex, nodes ...*mock.Node) {
+	mustNodes(nodes)
+
+	t.Eventually(func() error {
+		var roots *iotago.Roots
+		var rootsNode *mock.Node
+		for _, node := range nodes {
+			storedCommitment, err := node.Protocol.Engines.Main.Get().Storage.Commitments().Load(index)
+			if err != nil {
+				return ierrors.Wrapf(err, "AssertEqualStoredRootsAtIndex: %s: error loading commitment for slot: %d", node.Name, index)
+			}
+
+			rootsStorage, err := node.Protocol.Engines.Main.Get().Storage.Roots(index)
+			if err != nil {
+				return ierrors.Wrapf(err, "AssertEqualStoredRootsAtIndex: %s: error loading roots storage for slot: %d", node.Name, index)
+			}
+
+			storedRoots, exists, err := rootsStorage.Load(storedCommitment.ID())
+			if err != nil {
+				return ierrors.Wrapf(err, "AssertEqualStoredRootsAtIndex: %s: error loading roots for slot: %d", node.Name, index)
+			} else if !exists {
+				return ierrors.Errorf("AssertEqualStoredRootsAtIndex: %s: roots for slot %d do not exist", node.Name, index)
+			}
+
+			if storedRoots.ID() != storedCommitment.Commitment().RootsID {
+				return ierrors.Errorf("AssertEqualStoredRootsAtIndex: %s: roots %s do not match RootsID %s of commitment %s", node.Name, storedRoots.ID(), storedCommitment.Commitment().RootsID, storedCommitment.ID())
+			}
+
+			if roots == nil {
+				roots = storedRoots
+				rootsNode = node
+
+				continue
+			}
+
+			if !assert.Equal(t.fakeTesting, *roots, *storedRoots) {
+				return ierrors.Errorf("AssertEqualStoredRootsAtIndex: %s: expected %s (from %s), got %s", node.Name, roots, rootsNode.Name, storedRoots)
+			}
+		}
+
+		return nil
+	})
+}
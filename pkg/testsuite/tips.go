@@ -2,12 +2,14 @@ package testsuite
 
 import (
 	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
 
 	"github.com/iotaledger/hive.go/ierrors"
 	"github.com/iotaledger/hive.go/lo"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
 	"github.com/iotaledger/iota-core/pkg/protocol/engine/tipmanager"
 	"github.com/iotaledger/iota-core/pkg/testsuite/mock"
+	iotago "github.com/iotaledger/iota.go/v4"
 )
 
 func (t *TestSuite) AssertStrongTips(expectedBlocks []*blocks.Block, nodes ...*mock.Node) {
@@ -28,3 +30,36 @@ func (t *TestSuite) AssertStrongTips(expectedBlocks []*blocks.Block, nodes ...*m
 		})
 	}
 }
+
+func (t *TestSuite) AssertWeakTips(expectedBlocks []*blocks.Block, nodes ...*mock.Node) {
+	mustNodes(nodes)
+
+	expectedBlockIDs := lo.Map(expectedBlocks, (*blocks.Block).ID)
+
+	for _, node := range nodes {
+		t.Eventually(func() error {
+			storedTipsBlocks := node.Protocol.Engines.Main.Get().TipManager.WeakTips()
+			storedTipsBlockIDs := lo.Map(storedTipsBlocks, tipmanager.TipMetadata.ID)
+
+			if !assert.ElementsMatch(t.fakeTesting, expectedBlockIDs, storedTipsBlockIDs) {
+				return ierrors.Errorf("AssertWeakTips: %s: expected %s, got %s", node.Name, expectedBlockIDs, storedTipsBlockIDs)
+			}
+
+			return nil
+		})
+	}
+}
+
+// AssertBlockReferences asserts that the given block references exactly the expected blocks with the given parent types.
+func (t *TestSuite) AssertBlockReferences(block *blocks.Block, expectedReferences map[iotago.ParentsType][]*blocks.Block) {
+	actualReferences := make(map[iotago.ParentsType][]iotago.BlockID)
+	for _, parent := range block.ParentsWithType() {
+		actualReferences[parent.Type] = append(actualReferences[parent.Type], parent.ID)
+	}
+
+	for _, parentsType := range []iotago.ParentsType{iotago.StrongParentType, iotago.WeakParentType, iotago.ShallowLikeParentType} {
+		expectedBlockIDs := lo.Map(expectedReferences[parentsType], (*blocks.Block).ID)
+
+		require.ElementsMatchf(t.Testing, expectedBlockIDs, actualReferences[parentsType], "AssertBlockReferences: block %s: unexpected %s", block.ID(), parentsType)
+	}
+}
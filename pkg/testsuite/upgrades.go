@@ -3,10 +3,96 @@ package testsuite
 import (
 	"github.com/iotaledger/hive.go/ierrors"
 	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/hive.go/runtime/options"
+	"github.com/iotaledger/iota-core/pkg/protocol"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/upgrade/signalingupgradeorchestrator"
+	"github.com/iotaledger/iota-core/pkg/storage"
+	"github.com/iotaledger/iota-core/pkg/storage/permanent"
 	"github.com/iotaledger/iota-core/pkg/testsuite/mock"
 	iotago "github.com/iotaledger/iota.go/v4"
 )
 
+// ProtocolVersionOptions returns the options for a node that follows the given (newer) protocol parameters in addition
+// to the ones of the TestSuite. Nodes started without them only know the protocol version of the TestSuite and halt
+// once a newer version gets activated.
+func (t *TestSuite) ProtocolVersionOptions(protocolParameters ...iotago.ProtocolParameters) []options.Option[protocol.Protocol] {
+	apisByVersion := map[iotago.Version]iotago.API{
+		t.API.Version(): t.API,
+	}
+	for _, parameters := range protocolParameters {
+		apisByVersion[parameters.Version()] = iotago.V3API(parameters)
+	}
+
+	return []options.Option[protocol.Protocol]{
+		protocol.WithUpgradeOrchestratorProvider(
+			signalingupgradeorchestrator.NewProvider(signalingupgradeorchestrator.WithProtocolParameters(protocolParameters...)),
+		),
+		protocol.WithStorageOptions(
+			storage.WithPermanentOptions(
+				permanent.WithEpochBasedProviderOptions(
+					iotago.WithAPIForMissingVersionCallback(func(parameters iotago.ProtocolParameters) (iotago.API, error) {
+						if api, exists := apisByVersion[parameters.Version()]; exists {
+							return api, nil
+						}
+
+						return nil, ierrors.Errorf("can't create API due to unsupported protocol version: %d", parameters.Version())
+					}),
+				),
+			),
+		),
+	}
+}
+
+// SignalProtocolVersion makes the given nodes signal support for the given protocol parameters in the blocks they issue.
+func (t *TestSuite) SignalProtocolVersion(protocolParameters iotago.ProtocolParameters, nodes ...*mock.Node) {
+	mustNodes(nodes)
+
+	for _, node := range nodes {
+		node.SetHighestSupportedVersion(protocolParameters.Version())
+		node.SetProtocolParametersHash(lo.PanicOnErr(protocolParameters.Hash()))
+	}
+}
+
+// AssertProtocolVersionActivated asserts that the given protocol parameters got activated at the given epoch on the
+// given nodes and that the nodes are able to use them.
+func (t *TestSuite) AssertProtocolVersionActivated(protocolParameters iotago.ProtocolParameters, epoch iotago.EpochIndex, nodes ...*mock.Node) {
+	mustNodes(nodes)
+
+	t.AssertEpochVersions(map[iotago.Version]iotago.EpochIndex{protocolParameters.Version(): epoch}, nodes...)
+	t.AssertVersionAndProtocolParameters(map[iotago.Version]iotago.ProtocolParameters{protocolParameters.Version(): protocolParameters}, nodes...)
+
+	for _, node := range nodes {
+		t.Eventually(func() error {
+			if apiVersion := node.Protocol.Engines.Main.Get().APIForEpoch(epoch).Version(); apiVersion != protocolParameters.Version() {
+				return ierrors.Errorf("AssertProtocolVersionActivated: %s: expected API version %d for epoch %d, got %d", node.Name, protocolParameters.Version(), epoch, apiVersion)
+			}
+
+			return nil
+		})
+	}
+}
+
+// AssertProtocolVersionHalted asserts that the given nodes learned about the activation of the given protocol
+// parameters at the given epoch but, as they don't know the parameters, stopped committing before that epoch.
+func (t *TestSuite) AssertProtocolVersionHalted(protocolParameters iotago.ProtocolParameters, epoch iotago.EpochIndex, nodes ...*mock.Node) {
+	mustNodes(nodes)
+
+	t.AssertEpochVersions(map[iotago.Version]iotago.EpochIndex{protocolParameters.Version(): epoch}, nodes...)
+	t.AssertVersionAndProtocolParameters(map[iotago.Version]iotago.ProtocolParameters{protocolParameters.Version(): nil}, nodes...)
+	t.AssertVersionAndProtocolParametersHashes(map[iotago.Version]iotago.Identifier{protocolParameters.Version(): lo.PanicOnErr(protocolParameters.Hash())}, nodes...)
+
+	for _, node := range nodes {
+		t.Eventually(func() error {
+			latestCommittedSlot := node.Protocol.Engines.Main.Get().Storage.Settings().LatestCommitment().Slot()
+			if epochStart := t.API.TimeProvider().EpochStart(epoch); latestCommittedSlot >= epochStart {
+				return ierrors.Errorf("AssertProtocolVersionHalted: %s: expected latest commitment before slot %d, got %d", node.Name, epochStart, latestCommittedSlot)
+			}
+
+			return nil
+		})
+	}
+}
+
 func (t *TestSuite) AssertEpochVersions(epochVersions map[iotago.Version]iotago.EpochIndex, nodes ...*mock.Node) {
 	mustNodes(nodes)
 
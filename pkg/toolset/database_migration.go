@@ -0,0 +1,66 @@
+package toolset
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	flag "github.com/spf13/pflag"
+
+	"github.com/iotaledger/hive.go/app/configuration"
+	hivedb "github.com/iotaledger/hive.go/kvstore/database"
+	"github.com/iotaledger/iota-core/pkg/storage/database"
+)
+
+func databaseMigration(args []string) error {
+	fs := configuration.NewUnsortedFlagSet("", flag.ContinueOnError)
+	databasePathSourceFlag := fs.String(FlagToolDatabasePathSource, DefaultValueDatabasePath, "the path to the source database folder")
+	databasePathTargetFlag := fs.String(FlagToolDatabasePathTarget, "", "the path to the target database folder")
+	databaseEngineTargetFlag := fs.String(FlagToolDatabaseEngineTarget, string(database.EnginePebble), "the engine of the target database (values: pebble, rocksdb)")
+
+	fs.Usage = func() {
+		_, _ = fmt.Fprintf(os.Stderr, "Usage of %s:\n", ToolDatabaseMigration)
+		fs.PrintDefaults()
+		println(fmt.Sprintf("\nexample: %s --%s %s --%s %s --%s %s",
+			ToolDatabaseMigration,
+			FlagToolDatabasePathSource,
+			DefaultValueDatabasePath,
+			FlagToolDatabasePathTarget,
+			"testnet/database_pebble",
+			FlagToolDatabaseEngineTarget,
+			database.EnginePebble,
+		))
+	}
+
+	if err := parseFlagSet(fs, args); err != nil {
+		return err
+	}
+
+	if len(*databasePathSourceFlag) == 0 {
+		return fmt.Errorf("'%s' not specified", FlagToolDatabasePathSource)
+	}
+	if len(*databasePathTargetFlag) == 0 {
+		return fmt.Errorf("'%s' not specified", FlagToolDatabasePathTarget)
+	}
+
+	targetEngine, err := hivedb.EngineFromStringAllowed(*databaseEngineTargetFlag, database.AllowedEnginesStorage)
+	if err != nil {
+		return err
+	}
+
+	fmt.Printf("migrating databases in %s to %s (engine: %s)...\n", *databasePathSourceFlag, *databasePathTargetFlag, targetEngine)
+
+	start := time.Now()
+	migratedDatabases, err := database.MigrateDirectory(*databasePathSourceFlag, *databasePathTargetFlag, targetEngine)
+	if err != nil {
+		return err
+	}
+
+	for _, migratedDatabase := range migratedDatabases {
+		fmt.Printf("migrated database %s\n", migratedDatabase)
+	}
+
+	fmt.Printf("successfully migrated %d databases, took %v\n", len(migratedDatabases), time.Since(start).Truncate(time.Millisecond))
+
+	return nil
+}
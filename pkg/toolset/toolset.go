@@ -15,6 +15,10 @@ import (
 const (
 	FlagToolDatabasePath = "databasePath"
 
+	FlagToolDatabasePathSource   = "sourcePath"
+	FlagToolDatabasePathTarget   = "targetPath"
+	FlagToolDatabaseEngineTarget = "targetEngine"
+
 	FlagToolOutputPath = "outputPath"
 
 	FlagToolPrivateKey = "privateKey"
@@ -38,11 +42,13 @@ const (
 	ToolEd25519Addr        = "ed25519-addr"
 	ToolJWTApi             = "jwt-api"
 	ToolNodeInfo           = "node-info"
+	ToolDatabaseMigration  = "db-migration"
 )
 
 const (
 	DefaultValueAPIJWTTokenSalt = "IOTA"
 	DefaultValueP2PDatabasePath = "testnet/p2pstore"
+	DefaultValueDatabasePath    = "testnet/database"
 )
 
 // ShouldHandleTools checks if tools were requested.
@@ -74,6 +80,7 @@ func HandleTools() {
 		ToolEd25519Addr:        generateEd25519Address,
 		ToolJWTApi:             generateJWTApiToken,
 		ToolNodeInfo:           nodeInfo,
+		ToolDatabaseMigration:  databaseMigration,
 	}
 
 	tool, exists := tools[strings.ToLower(args[1])]
@@ -103,6 +110,7 @@ func listTools() {
 	fmt.Printf("%-20s generates an ed25519 address from a public key\n", fmt.Sprintf("%s:", ToolEd25519Addr))
 	fmt.Printf("%-20s generates a JWT token for REST-API access\n", fmt.Sprintf("%s:", ToolJWTApi))
 	fmt.Printf("%-20s queries the info endpoint of a node\n", fmt.Sprintf("%s:", ToolNodeInfo))
+	fmt.Printf("%-20s migrates the databases to another database engine\n", fmt.Sprintf("%s:", ToolDatabaseMigration))
 }
 
 func yesOrNo(value bool) string {
@@ -0,0 +1,471 @@
+package txbuilder
+
+import (
+	"github.com/iotaledger/hive.go/core/safemath"
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/utxoledger"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+var (
+	// ErrInvalidIntent is returned if an intent can not be turned into a transaction.
+	ErrInvalidIntent = ierrors.New("invalid intent")
+	// ErrInsufficientBaseTokens is returned if the unspent outputs of an address do not cover the requested amount.
+	ErrInsufficientBaseTokens = ierrors.New("insufficient base tokens")
+	// ErrInsufficientMana is returned if the mana of the selected inputs does not cover the requested allotments.
+	ErrInsufficientMana = ierrors.New("insufficient mana")
+)
+
+// Builder constructs unsigned transactions from high-level intents. The inputs are selected from the unspent outputs of
+// the ledger of the engine, and the resulting transactions need to be signed externally.
+type Builder struct {
+	engineFunc func() *engine.Engine
+}
+
+// New creates a new Builder that selects the inputs from the engine returned by the given function.
+func New(engineFunc func() *engine.Engine) *Builder {
+	return &Builder{
+		engineFunc: engineFunc,
+	}
+}
+
+// SendIntent describes the transfer of base tokens from one address to another.
+type SendIntent struct {
+	// SenderAddress is the address whose unspent outputs are used as inputs.
+	SenderAddress iotago.Address
+	// ReceiverAddress is the address that receives the base tokens.
+	ReceiverAddress iotago.Address
+	// Amount is the amount of base tokens that is sent.
+	Amount iotago.BaseToken
+}
+
+// Transfer describes a single transfer of base tokens within a SendManyIntent.
+type Transfer struct {
+	// ReceiverAddress is the address that receives the base tokens.
+	ReceiverAddress iotago.Address
+	// Amount is the amount of base tokens that is sent.
+	Amount iotago.BaseToken
+}
+
+// SendManyIntent describes the transfer of base tokens from one address to multiple addresses and the allotment of the
+// mana of the inputs to accounts.
+type SendManyIntent struct {
+	// SenderAddress is the address whose unspent outputs are used as inputs.
+	SenderAddress iotago.Address
+	// Transfers are the transfers of base tokens.
+	Transfers []*Transfer
+	// Allotments are the amounts of mana that are allotted to accounts.
+	Allotments iotago.Allotments
+}
+
+// CreateAccountIntent describes the creation of an account with a block issuer feature.
+type CreateAccountIntent struct {
+	// Address is the address whose unspent outputs are used as inputs and that controls the created account.
+	Address iotago.Address
+	// Amount is the amount of base tokens that is locked in the account, the minimum deposit is used if it is zero.
+	Amount iotago.BaseToken
+	// BlockIssuerKeys are the keys that are allowed to issue blocks for the account.
+	BlockIssuerKeys iotago.BlockIssuerKeys
+	// ExpirySlot is the slot at which the block issuer feature expires, it does not expire if it is zero.
+	ExpirySlot iotago.SlotIndex
+}
+
+// DelegateIntent describes the delegation of base tokens to a validator.
+type DelegateIntent struct {
+	// Address is the address whose unspent outputs are used as inputs and that controls the delegation.
+	Address iotago.Address
+	// ValidatorAddress is the address of the validator that the base tokens are delegated to.
+	ValidatorAddress *iotago.AccountAddress
+	// Amount is the amount of base tokens that is delegated.
+	Amount iotago.BaseToken
+}
+
+// UnsignedTransaction is a transaction that was constructed by the Builder and still needs to be signed.
+type UnsignedTransaction struct {
+	// Transaction is the transaction that needs to be signed.
+	Transaction *iotago.Transaction
+	// Inputs are the consumed outputs in the order of the inputs of the transaction.
+	Inputs utxoledger.Outputs
+	// UnlockAddress is the address whose signature unlocks all inputs.
+	UnlockAddress iotago.Address
+}
+
+// SigningMessage returns the message that needs to be signed to unlock the inputs of the transaction.
+func (u *UnsignedTransaction) SigningMessage() ([]byte, error) {
+	return u.Transaction.SigningMessage()
+}
+
+// Sign signs the transaction with the given signer and returns the resulting SignedTransaction.
+func (u *UnsignedTransaction) Sign(signer iotago.AddressSigner) (*iotago.SignedTransaction, error) {
+	signingMessage, err := u.SigningMessage()
+	if err != nil {
+		return nil, ierrors.Wrap(err, "failed to compute signing message")
+	}
+
+	signature, err := signer.Sign(u.UnlockAddress, signingMessage)
+	if err != nil {
+		return nil, ierrors.Wrap(err, "failed to sign transaction")
+	}
+
+	unlocks := iotago.Unlocks{&iotago.SignatureUnlock{Signature: signature}}
+	for i := 1; i < len(u.Inputs); i++ {
+		unlocks = append(unlocks, &iotago.ReferenceUnlock{Reference: 0})
+	}
+
+	return &iotago.SignedTransaction{
+		API:         u.Transaction.API,
+		Transaction: u.Transaction,
+		Unlocks:     unlocks,
+	}, nil
+}
+
+// Send constructs a transaction that transfers base tokens from the sender to the receiver.
+func (b *Builder) Send(intent *SendIntent) (*UnsignedTransaction, error) {
+	if intent.ReceiverAddress == nil {
+		return nil, ierrors.Wrap(ErrInvalidIntent, "receiver address is missing")
+	}
+
+	return b.build(intent.SenderAddress, false, nil, func(apiForSlot iotago.API, _ *model.Commitment) (iotago.Output, error) {
+		return basicOutput(intent.ReceiverAddress, intent.Amount), nil
+	})
+}
+
+// SendMany constructs a transaction that transfers base tokens from the sender to multiple receivers and allots the
+// given amounts of mana to accounts.
+func (b *Builder) SendMany(intent *SendManyIntent) (*UnsignedTransaction, error) {
+	if len(intent.Transfers) == 0 {
+		return nil, ierrors.Wrap(ErrInvalidIntent, "at least one transfer is required")
+	}
+
+	if len(intent.Transfers) >= iotago.MaxOutputsCount {
+		return nil, ierrors.Wrapf(ErrInvalidIntent, "at most %d transfers are allowed", iotago.MaxOutputsCount-1)
+	}
+
+	outputs := make(iotago.TxEssenceOutputs, 0, len(intent.Transfers))
+	for _, transfer := range intent.Transfers {
+		if transfer.ReceiverAddress == nil {
+			return nil, ierrors.Wrap(ErrInvalidIntent, "receiver address is missing")
+		}
+
+		if transfer.Amount == 0 {
+			return nil, ierrors.Wrap(ErrInvalidIntent, "amount must be greater than zero")
+		}
+
+		outputs = append(outputs, basicOutput(transfer.ReceiverAddress, transfer.Amount))
+	}
+
+	return b.buildWithOutputs(intent.SenderAddress, false, intent.Allotments, func(apiForSlot iotago.API, _ *model.Commitment) (iotago.TxEssenceOutputs, error) {
+		return outputs, nil
+	})
+}
+
+// CreateAccount constructs a transaction that creates an account with a block issuer feature.
+func (b *Builder) CreateAccount(intent *CreateAccountIntent) (*UnsignedTransaction, error) {
+	if len(intent.BlockIssuerKeys) == 0 {
+		return nil, ierrors.Wrap(ErrInvalidIntent, "at least one block issuer key is required")
+	}
+
+	return b.build(intent.Address, true, nil, func(apiForSlot iotago.API, latestCommitment *model.Commitment) (iotago.Output, error) {
+		expirySlot := intent.ExpirySlot
+		if expirySlot == 0 {
+			expirySlot = iotago.MaxSlotIndex
+		} else if expirySlot <= latestCommitment.Slot()+apiForSlot.ProtocolParameters().MaxCommittableAge() {
+			return nil, ierrors.Wrapf(ErrInvalidIntent, "expiry slot %d is too early", expirySlot)
+		}
+
+		blockIssuerKeys := intent.BlockIssuerKeys.Clone()
+		blockIssuerKeys.Sort()
+
+		accountOutput := &iotago.AccountOutput{
+			Amount:    intent.Amount,
+			AccountID: iotago.EmptyAccountID,
+			UnlockConditions: iotago.AccountOutputUnlockConditions{
+				&iotago.AddressUnlockCondition{Address: intent.Address},
+			},
+			Features: iotago.AccountOutputFeatures{
+				&iotago.BlockIssuerFeature{
+					BlockIssuerKeys: blockIssuerKeys,
+					ExpirySlot:      expirySlot,
+				},
+			},
+			ImmutableFeatures: iotago.AccountOutputImmFeatures{},
+		}
+
+		if accountOutput.Amount == 0 {
+			minDeposit, err := apiForSlot.StorageScoreStructure().MinDeposit(accountOutput)
+			if err != nil {
+				return nil, ierrors.Wrap(err, "failed to compute minimum deposit of account output")
+			}
+
+			accountOutput.Amount = minDeposit
+		}
+
+		return accountOutput, nil
+	})
+}
+
+// Delegate constructs a transaction that delegates base tokens to a validator.
+func (b *Builder) Delegate(intent *DelegateIntent) (*UnsignedTransaction, error) {
+	if intent.ValidatorAddress == nil || intent.ValidatorAddress.AccountID() == iotago.EmptyAccountID {
+		return nil, ierrors.Wrap(ErrInvalidIntent, "validator address is missing")
+	}
+
+	return b.build(intent.Address, true, nil, func(apiForSlot iotago.API, latestCommitment *model.Commitment) (iotago.Output, error) {
+		return &iotago.DelegationOutput{
+			Amount:           intent.Amount,
+			DelegatedAmount:  intent.Amount,
+			DelegationID:     iotago.EmptyDelegationID(),
+			ValidatorAddress: intent.ValidatorAddress,
+			StartEpoch:       delegationStartEpoch(apiForSlot, latestCommitment.Slot()),
+			EndEpoch:         0,
+			UnlockConditions: iotago.DelegationOutputUnlockConditions{
+				&iotago.AddressUnlockCondition{Address: intent.Address},
+			},
+		}, nil
+	})
+}
+
+// build constructs a transaction that creates the output returned by the given function, funded by the unspent basic
+// outputs of the given address. Any remaining base tokens and all the mana of the inputs are stored in a remainder output.
+func (b *Builder) build(address iotago.Address, needsCommitmentInput bool, allotments iotago.Allotments, outputFunc func(apiForSlot iotago.API, latestCommitment *model.Commitment) (iotago.Output, error)) (*UnsignedTransaction, error) {
+	return b.buildWithOutputs(address, needsCommitmentInput, allotments, func(apiForSlot iotago.API, latestCommitment *model.Commitment) (iotago.TxEssenceOutputs, error) {
+		output, err := outputFunc(apiForSlot, latestCommitment)
+		if err != nil {
+			return nil, err
+		}
+
+		return iotago.TxEssenceOutputs{output}, nil
+	})
+}
+
+// buildWithOutputs constructs a transaction that creates the outputs returned by the given function, funded by the
+// unspent basic outputs of the given address. The mana of the inputs is allotted according to the given allotments, and
+// any remaining base tokens and mana are stored in a remainder output.
+func (b *Builder) buildWithOutputs(address iotago.Address, needsCommitmentInput bool, allotments iotago.Allotments, outputsFunc func(apiForSlot iotago.API, latestCommitment *model.Commitment) (iotago.TxEssenceOutputs, error)) (*UnsignedTransaction, error) {
+	if address == nil {
+		return nil, ierrors.Wrap(ErrInvalidIntent, "address is missing")
+	}
+
+	if _, isChainAddress := address.(iotago.ChainAddress); isChainAddress {
+		return nil, ierrors.Wrapf(ErrInvalidIntent, "address %s of type %s can not be unlocked with a signature", address, address.Type())
+	}
+
+	engineInstance := b.engineFunc()
+	latestCommitment := engineInstance.Storage.Settings().LatestCommitment()
+	creationSlot := max(engineInstance.LatestAPI().TimeProvider().SlotFromTime(engineInstance.Clock.Accepted().Time()), latestCommitment.Slot())
+	apiForSlot := engineInstance.APIForSlot(creationSlot)
+
+	outputs, err := outputsFunc(apiForSlot, latestCommitment)
+	if err != nil {
+		return nil, err
+	}
+
+	var requiredAmount iotago.BaseToken
+	for _, output := range outputs {
+		if requiredAmount, err = safemath.SafeAdd(requiredAmount, output.BaseTokenAmount()); err != nil {
+			return nil, ierrors.Wrapf(ErrInvalidIntent, "total amount overflows: %s", err)
+		}
+	}
+
+	if requiredAmount == 0 {
+		return nil, ierrors.Wrap(ErrInvalidIntent, "amount must be greater than zero")
+	}
+
+	var allottedMana iotago.Mana
+	for _, allotment := range allotments {
+		if allottedMana, err = safemath.SafeAdd(allottedMana, allotment.Mana); err != nil {
+			return nil, ierrors.Wrapf(ErrInvalidIntent, "total allotted mana overflows: %s", err)
+		}
+	}
+
+	remainderOutput := &iotago.BasicOutput{
+		UnlockConditions: iotago.BasicOutputUnlockConditions{
+			&iotago.AddressUnlockCondition{Address: address},
+		},
+		Features: iotago.BasicOutputFeatures{},
+	}
+
+	minRemainderAmount, err := apiForSlot.StorageScoreStructure().MinDeposit(remainderOutput)
+	if err != nil {
+		return nil, ierrors.Wrap(err, "failed to compute minimum deposit of remainder output")
+	}
+
+	// Only basic and account outputs can take the mana of the inputs, so other outputs always need a remainder output.
+	canStoreMana := len(outputs) == 1
+	switch outputs[0].(type) {
+	case *iotago.BasicOutput, *iotago.AccountOutput:
+	default:
+		canStoreMana = false
+	}
+
+	inputs, inputAmount, inputMana, err := b.selectInputs(engineInstance, apiForSlot, address, creationSlot, requiredAmount, minRemainderAmount, !canStoreMana)
+	if err != nil {
+		return nil, err
+	}
+
+	remainingMana, err := safemath.SafeSub(inputMana, allottedMana)
+	if err != nil {
+		return nil, ierrors.Wrapf(ErrInsufficientMana, "selected inputs hold %d mana, but %d are allotted", inputMana, allottedMana)
+	}
+
+	if remainderOutput.Amount = inputAmount - requiredAmount; remainderOutput.Amount > 0 {
+		remainderOutput.Mana = remainingMana
+		outputs = append(outputs, remainderOutput)
+	} else {
+		setStoredMana(outputs[0], remainingMana)
+	}
+
+	transaction := &iotago.Transaction{
+		API: apiForSlot,
+		TransactionEssence: &iotago.TransactionEssence{
+			NetworkID:     apiForSlot.ProtocolParameters().NetworkID(),
+			CreationSlot:  creationSlot,
+			ContextInputs: iotago.TxEssenceContextInputs{},
+			Inputs:        iotago.TxEssenceInputs{},
+			Allotments:    iotago.Allotments{},
+			Capabilities:  iotago.TransactionCapabilitiesBitMask{},
+		},
+		Outputs: outputs,
+	}
+
+	if len(allotments) != 0 {
+		transaction.TransactionEssence.Allotments = allotments.Clone()
+		transaction.TransactionEssence.Allotments.Sort()
+	}
+
+	if needsCommitmentInput {
+		transaction.TransactionEssence.ContextInputs = append(transaction.TransactionEssence.ContextInputs, &iotago.CommitmentInput{
+			CommitmentID: latestCommitment.ID(),
+		})
+	}
+
+	for _, input := range inputs {
+		transaction.TransactionEssence.Inputs = append(transaction.TransactionEssence.Inputs, &iotago.UTXOInput{
+			TransactionID:          input.OutputID().TransactionID(),
+			TransactionOutputIndex: input.OutputID().Index(),
+		})
+	}
+
+	return &UnsignedTransaction{
+		Transaction:   transaction,
+		Inputs:        inputs,
+		UnlockAddress: address,
+	}, nil
+}
+
+// selectInputs selects unspent basic outputs of the given address until they cover the required amount. If a remainder
+// is required or the selected amount exceeds the required amount, the remainder needs to cover its minimum deposit.
+func (b *Builder) selectInputs(engineInstance *engine.Engine, apiForSlot iotago.API, address iotago.Address, creationSlot iotago.SlotIndex, requiredAmount iotago.BaseToken, minRemainderAmount iotago.BaseToken, remainderRequired bool) (inputs utxoledger.Outputs, inputAmount iotago.BaseToken, inputMana iotago.Mana, err error) {
+	isCovered := func() bool {
+		if inputAmount == requiredAmount && !remainderRequired {
+			return true
+		}
+
+		return inputAmount >= requiredAmount+minRemainderAmount
+	}
+
+	if forEachErr := engineInstance.Ledger.ForEachUnspentOutput(func(output *utxoledger.Output) bool {
+		if !isUnlockableBy(output, address) || output.SlotCreated() > creationSlot {
+			return true
+		}
+
+		outputMana, manaErr := totalMana(apiForSlot, output, creationSlot)
+		if manaErr != nil {
+			err = ierrors.Wrapf(manaErr, "failed to compute mana of output %s", output.OutputID())
+
+			return false
+		}
+
+		if inputAmount, err = safemath.SafeAdd(inputAmount, output.BaseTokenAmount()); err != nil {
+			return false
+		}
+
+		if inputMana, err = safemath.SafeAdd(inputMana, outputMana); err != nil {
+			return false
+		}
+
+		inputs = append(inputs, output)
+
+		return !isCovered()
+	}); forEachErr != nil {
+		return nil, 0, 0, ierrors.Wrap(forEachErr, "failed to iterate unspent outputs")
+	}
+
+	if err != nil {
+		return nil, 0, 0, err
+	}
+
+	if !isCovered() {
+		return nil, 0, 0, ierrors.Wrapf(ErrInsufficientBaseTokens, "unspent outputs of address %s hold %d base tokens, but %d are required", address, inputAmount, requiredAmount)
+	}
+
+	if len(inputs) > iotago.MaxInputsCount {
+		return nil, 0, 0, ierrors.Wrapf(ErrInsufficientBaseTokens, "required amount %d needs %d inputs, but at most %d are allowed", requiredAmount, len(inputs), iotago.MaxInputsCount)
+	}
+
+	return inputs, inputAmount, inputMana, nil
+}
+
+// isUnlockableBy checks if the output is a basic output that only needs a signature of the given address to be unlocked.
+func isUnlockableBy(output *utxoledger.Output, address iotago.Address) bool {
+	basicOutput, isBasicOutput := output.Output().(*iotago.BasicOutput)
+	if !isBasicOutput || len(basicOutput.UnlockConditions) != 1 || basicOutput.FeatureSet().NativeToken() != nil {
+		return false
+	}
+
+	addressUnlockCondition := basicOutput.UnlockConditionSet().Address()
+
+	return addressUnlockCondition != nil && addressUnlockCondition.Address.Equal(address)
+}
+
+// totalMana returns the decayed stored mana and the potential mana of the output at the given slot.
+func totalMana(apiForSlot iotago.API, output *utxoledger.Output, slot iotago.SlotIndex) (iotago.Mana, error) {
+	potentialMana, err := iotago.PotentialMana(apiForSlot.ManaDecayProvider(), apiForSlot.StorageScoreStructure(), output.Output(), output.SlotCreated(), slot)
+	if err != nil {
+		return 0, ierrors.Wrap(err, "failed to compute potential mana")
+	}
+
+	storedMana, err := apiForSlot.ManaDecayProvider().DecayManaBySlots(output.StoredMana(), output.SlotCreated(), slot)
+	if err != nil {
+		return 0, ierrors.Wrap(err, "failed to compute decayed stored mana")
+	}
+
+	return safemath.SafeAdd(potentialMana, storedMana)
+}
+
+// basicOutput returns a basic output that holds the given amount of base tokens and is unlockable by the given address.
+func basicOutput(address iotago.Address, amount iotago.BaseToken) *iotago.BasicOutput {
+	return &iotago.BasicOutput{
+		Amount: amount,
+		UnlockConditions: iotago.BasicOutputUnlockConditions{
+			&iotago.AddressUnlockCondition{Address: address},
+		},
+		Features: iotago.BasicOutputFeatures{},
+	}
+}
+
+func setStoredMana(output iotago.Output, mana iotago.Mana) {
+	switch typedOutput := output.(type) {
+	case *iotago.BasicOutput:
+		typedOutput.Mana = mana
+	case *iotago.AccountOutput:
+		typedOutput.Mana = mana
+	}
+}
+
+// delegationStartEpoch returns the first epoch that a delegation created at the given commitment slot is counted for.
+func delegationStartEpoch(apiForSlot iotago.API, commitmentSlot iotago.SlotIndex) iotago.EpochIndex {
+	timeProvider := apiForSlot.TimeProvider()
+
+	pastBoundedSlot := commitmentSlot + apiForSlot.ProtocolParameters().MaxCommittableAge()
+	pastBoundedEpoch := timeProvider.EpochFromSlot(pastBoundedSlot)
+	registrationSlot := timeProvider.EpochEnd(pastBoundedEpoch) - apiForSlot.ProtocolParameters().EpochNearingThreshold()
+
+	if pastBoundedSlot <= registrationSlot {
+		return pastBoundedEpoch + 1
+	}
+
+	return pastBoundedEpoch + 2
+}
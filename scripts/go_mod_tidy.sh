@@ -11,4 +11,12 @@ pushd tools/genesis-snapshot
 go mod tidy
 popd
 
+pushd tools/storage-bench
+go mod tidy
+popd
+
+pushd tools/dev-console
+go mod tidy
+popd
+
 popd
\ No newline at end of file
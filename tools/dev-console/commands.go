@@ -0,0 +1,350 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/hive.go/kvstore"
+	"github.com/iotaledger/hive.go/lo"
+	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/accounts/accountsledger"
+	"github.com/iotaledger/iota-core/pkg/protocol/engine/blocks"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// defaultChainWalkLength is the number of commitments that are walked by the chain command if no count is given.
+const defaultChainWalkLength = 10
+
+func (c *Console) registerCommands() {
+	c.register(&command{
+		name:        "help",
+		usage:       "[command]",
+		description: "shows the available commands or the usage of the given command",
+		run:         c.help,
+	})
+	c.register(&command{
+		name:        "info",
+		description: "shows the latest commitment and the stored slots of the database",
+		run:         c.info,
+	})
+	c.register(&command{
+		name:        "account",
+		usage:       "<accountID|bech32 address> [slot]",
+		description: "shows the state of an account at the given slot (default: latest committed slot)",
+		run:         c.account,
+	})
+	c.register(&command{
+		name:        "output",
+		usage:       "<outputID>",
+		description: "shows an output of the ledger and whether it was spent",
+		run:         c.output,
+	})
+	c.register(&command{
+		name:        "commitment",
+		usage:       "[slot]",
+		description: "shows the commitment of the given slot (default: latest commitment)",
+		run:         c.commitment,
+	})
+	c.register(&command{
+		name:        "chain",
+		usage:       "[slot] [count]",
+		description: "walks the commitment chain backwards from the given slot (default: latest commitment) and verifies its links",
+		run:         c.chain,
+	})
+	c.register(&command{
+		name:        "spenders",
+		usage:       "<slot>",
+		description: "dumps the persisted spenders of the given slot and the conflicts between them",
+		run:         c.spenders,
+	})
+	c.register(&command{
+		name:        "exit",
+		description: "exits the console",
+		run: func([]string) error {
+			return errExit
+		},
+	})
+}
+
+func (c *Console) help(args []string) error {
+	if len(args) > 0 {
+		cmd, exists := c.commands[args[0]]
+		if !exists {
+			return ierrors.Errorf("unknown command '%s'", args[0])
+		}
+
+		c.printf("%s %s\n    %s\n", cmd.name, cmd.usage, cmd.description)
+
+		return nil
+	}
+
+	for _, name := range c.commandNames("") {
+		cmd := c.commands[name]
+		c.printf("%-42s %s\n", strings.TrimSpace(cmd.name+" "+cmd.usage), cmd.description)
+	}
+
+	return nil
+}
+
+func (c *Console) info(_ []string) error {
+	settings := c.storage.Settings()
+
+	latestCommitment := settings.LatestCommitment()
+	c.printf("latest commitment:      %s\n", latestCommitment.ID())
+	c.printf("latest finalized slot:  %d\n", settings.LatestFinalizedSlot())
+	c.printf("latest stored slot:     %d\n", settings.LatestStoredSlot())
+	c.printf("latest non-empty slot:  %d\n", settings.LatestNonEmptySlot())
+
+	ledgerSlot, err := c.storage.Ledger().ReadLedgerSlot()
+	if err != nil {
+		return ierrors.Wrap(err, "failed to read ledger slot")
+	}
+	c.printf("ledger slot:            %d\n", ledgerSlot)
+	c.printf("protocol version:       %d\n", settings.APIProvider().APIForSlot(latestCommitment.Slot()).Version())
+
+	return nil
+}
+
+func (c *Console) account(args []string) error {
+	if len(args) == 0 {
+		return ierrors.New("missing account ID")
+	}
+
+	accountID, err := parseAccountID(args[0])
+	if err != nil {
+		return err
+	}
+
+	latestCommittedSlot := c.storage.Settings().LatestCommitment().Slot()
+	targetSlot, err := parseOptionalSlot(args[1:], latestCommittedSlot)
+	if err != nil {
+		return err
+	}
+
+	accountsLedger := accountsledger.New(c.storage.Settings().APIProvider(), func(iotago.BlockID) (*blocks.Block, bool) {
+		return nil, false
+	}, c.storage.AccountDiffs, c.storage.AccountsAggregates, c.storage.Accounts())
+	accountsLedger.SetLatestCommittedSlot(latestCommittedSlot)
+
+	accountData, exists, err := accountsLedger.Account(accountID, targetSlot)
+	if err != nil {
+		return ierrors.Wrapf(err, "failed to load account %s at slot %d", accountID, targetSlot)
+	}
+	if !exists {
+		return ierrors.Errorf("account %s does not exist at slot %d", accountID, targetSlot)
+	}
+
+	c.printf("account:           %s (slot %d)\n", accountData.ID, targetSlot)
+	c.printf("output:            %s\n", accountData.OutputID)
+	c.printf("credits:           %d (updated in slot %d)\n", accountData.Credits.Value, accountData.Credits.UpdateSlot)
+	c.printf("expiry slot:       %d\n", accountData.ExpirySlot)
+	c.printf("issuer keys:       %d\n", len(accountData.BlockIssuerKeys))
+	c.printf("validator stake:   %d\n", accountData.ValidatorStake)
+	c.printf("delegation stake:  %d\n", accountData.DelegationStake)
+	c.printf("fixed cost:        %d\n", accountData.FixedCost)
+	c.printf("stake end epoch:   %d\n", accountData.StakeEndEpoch)
+	c.printf("supported version: %d (%s)\n", accountData.LatestSupportedProtocolVersionAndHash.Version, accountData.LatestSupportedProtocolVersionAndHash.Hash)
+
+	return nil
+}
+
+func (c *Console) output(args []string) error {
+	if len(args) == 0 {
+		return ierrors.New("missing output ID")
+	}
+
+	outputID, err := iotago.OutputIDFromHexString(args[0])
+	if err != nil {
+		return ierrors.Wrapf(err, "invalid output ID '%s'", args[0])
+	}
+
+	ledger := c.storage.Ledger()
+	ledger.ReadLockLedger()
+	defer ledger.ReadUnlockLedger()
+
+	output, err := ledger.ReadOutputByOutputIDWithoutLocking(outputID)
+	if err != nil {
+		return ierrors.Wrapf(err, "failed to read output %s", outputID)
+	}
+
+	outputJSON, err := c.storage.Settings().APIProvider().APIForSlot(output.SlotCreated()).JSONEncode(output.Output())
+	if err != nil {
+		return ierrors.Wrap(err, "failed to encode output")
+	}
+
+	var indentedOutputJSON bytes.Buffer
+	if err := json.Indent(&indentedOutputJSON, outputJSON, "", "  "); err != nil {
+		return ierrors.Wrap(err, "failed to indent output")
+	}
+
+	c.printf("output:       %s (%s)\n", output.OutputID(), output.OutputType())
+	c.printf("block:        %s\n", output.BlockID())
+	c.printf("slot booked:  %d\n", output.SlotBooked())
+	c.printf("slot created: %d\n", output.SlotCreated())
+
+	spent, err := ledger.ReadSpentForOutputIDWithoutLocking(outputID)
+	switch {
+	case err == nil:
+		c.printf("spent:        in slot %d by transaction %s\n", spent.SlotSpent(), spent.TransactionIDSpent())
+	case ierrors.Is(err, kvstore.ErrKeyNotFound):
+		c.printf("spent:        no\n")
+	default:
+		return ierrors.Wrapf(err, "failed to read spent status of output %s", outputID)
+	}
+
+	c.printf("%s\n", indentedOutputJSON.String())
+
+	return nil
+}
+
+func (c *Console) commitment(args []string) error {
+	slot, err := parseOptionalSlot(args, c.storage.Settings().LatestCommitment().Slot())
+	if err != nil {
+		return err
+	}
+
+	commitment, err := c.storage.Commitments().Load(slot)
+	if err != nil {
+		return ierrors.Wrapf(err, "failed to load commitment of slot %d", slot)
+	}
+
+	c.printf("commitment:          %s\n", commitment.ID())
+	c.printf("previous commitment: %s\n", commitment.PreviousCommitmentID())
+	c.printf("roots:               %s\n", commitment.RootsID())
+	c.printf("cumulative weight:   %d\n", commitment.CumulativeWeight())
+	c.printf("reference mana cost: %d\n", commitment.ReferenceManaCost())
+
+	return nil
+}
+
+func (c *Console) chain(args []string) error {
+	slot, err := parseOptionalSlot(args, c.storage.Settings().LatestCommitment().Slot())
+	if err != nil {
+		return err
+	}
+
+	count := defaultChainWalkLength
+	if len(args) > 1 {
+		if count, err = strconv.Atoi(args[1]); err != nil || count <= 0 {
+			return ierrors.Errorf("invalid count '%s'", args[1])
+		}
+	}
+
+	commitment, err := c.storage.Commitments().Load(slot)
+	if err != nil {
+		return ierrors.Wrapf(err, "failed to load commitment of slot %d", slot)
+	}
+
+	for i := 0; ; i++ {
+		c.printf("%s cumulative weight: %d\n", commitment.ID(), commitment.CumulativeWeight())
+
+		if i+1 >= count || commitment.Slot() == 0 {
+			return nil
+		}
+
+		previousCommitment, err := c.storage.Commitments().Load(commitment.Slot() - 1)
+		if err != nil {
+			return ierrors.Wrapf(err, "failed to load commitment of slot %d", commitment.Slot()-1)
+		}
+
+		if previousCommitment.ID() != commitment.PreviousCommitmentID() {
+			return ierrors.Errorf("broken chain: commitment %s references %s, but %s is stored", commitment.ID(), commitment.PreviousCommitmentID(), previousCommitment.ID())
+		}
+
+		commitment = previousCommitment
+	}
+}
+
+func (c *Console) spenders(args []string) error {
+	if len(args) == 0 {
+		return ierrors.New("missing slot")
+	}
+
+	slot, err := parseSlot(args[0])
+	if err != nil {
+		return err
+	}
+
+	store, err := c.storage.Spenders(slot)
+	if err != nil {
+		return ierrors.Wrapf(err, "failed to access spenders of slot %d", slot)
+	}
+
+	spendersByResource := make(map[iotago.Identifier][]iotago.TransactionID)
+	if err := store.Stream(func(transactionID iotago.TransactionID, spender *model.Spender) error {
+		c.printf("%s parents: %d, votes: %d\n", transactionID, len(spender.Parents), len(spender.Votes))
+		for _, parent := range spender.Parents {
+			c.printf("    parent %s\n", parent)
+		}
+		for _, vote := range spender.Votes {
+			c.printf("    vote of seat %d in block %s\n", vote.Seat, vote.BlockID)
+		}
+
+		for _, resourceID := range spender.SpentResources {
+			spendersByResource[resourceID] = append(spendersByResource[resourceID], transactionID)
+		}
+
+		return nil
+	}); err != nil {
+		return ierrors.Wrapf(err, "failed to stream spenders of slot %d", slot)
+	}
+
+	resourceIDs := lo.Keys(spendersByResource)
+	sort.Slice(resourceIDs, func(i, j int) bool {
+		return bytes.Compare(resourceIDs[i][:], resourceIDs[j][:]) < 0
+	})
+
+	for _, resourceID := range resourceIDs {
+		if conflictingSpenders := spendersByResource[resourceID]; len(conflictingSpenders) > 1 {
+			c.printf("conflict on resource %s: %s\n", resourceID, strings.Join(lo.Map(conflictingSpenders, iotago.TransactionID.String), ", "))
+		}
+	}
+
+	return nil
+}
+
+// parseAccountID parses an account ID from its hex representation or from a bech32 encoded account address.
+func parseAccountID(value string) (iotago.AccountID, error) {
+	if strings.HasPrefix(value, "0x") {
+		accountID, err := iotago.AccountIDFromHexString(value)
+		if err != nil {
+			return iotago.EmptyAccountID, ierrors.Wrapf(err, "invalid account ID '%s'", value)
+		}
+
+		return accountID, nil
+	}
+
+	_, address, err := iotago.ParseBech32(value)
+	if err != nil {
+		return iotago.EmptyAccountID, ierrors.Wrapf(err, "invalid bech32 address '%s'", value)
+	}
+
+	accountAddress, isAccountAddress := address.(*iotago.AccountAddress)
+	if !isAccountAddress {
+		return iotago.EmptyAccountID, ierrors.Errorf("address '%s' is not an account address", value)
+	}
+
+	return accountAddress.AccountID(), nil
+}
+
+// parseOptionalSlot parses the slot from the first argument or returns the given default slot if there are no arguments.
+func parseOptionalSlot(args []string, defaultSlot iotago.SlotIndex) (iotago.SlotIndex, error) {
+	if len(args) == 0 {
+		return defaultSlot, nil
+	}
+
+	return parseSlot(args[0])
+}
+
+func parseSlot(value string) (iotago.SlotIndex, error) {
+	slot, err := strconv.ParseUint(value, 10, 32)
+	if err != nil {
+		return 0, ierrors.Wrapf(err, "invalid slot '%s'", value)
+	}
+
+	return iotago.SlotIndex(slot), nil
+}
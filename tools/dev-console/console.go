@@ -0,0 +1,201 @@
+package main
+
+import (
+	"bufio"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+
+	"golang.org/x/term"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	"github.com/iotaledger/iota-core/pkg/storage"
+)
+
+const prompt = "dev-console> "
+
+// errExit is returned by a command to stop the console.
+var errExit = ierrors.New("exit")
+
+// command is a command that can be executed in the console.
+type command struct {
+	// name is the name that is used to invoke the command.
+	name string
+	// usage describes the arguments of the command.
+	usage string
+	// description is a short description of the command.
+	description string
+	// run executes the command with the given arguments.
+	run func(args []string) error
+}
+
+// Console is an interactive console that runs queries against the storage of a node.
+type Console struct {
+	storage  *storage.Storage
+	commands map[string]*command
+	writer   io.Writer
+	terminal *term.Terminal
+}
+
+func newConsole(s *storage.Storage) *Console {
+	c := &Console{
+		storage:  s,
+		commands: make(map[string]*command),
+	}
+
+	c.registerCommands()
+
+	return c
+}
+
+// Run reads commands from the given input and writes their results to the given output until the input is exhausted
+// or the console is exited. If the input is a terminal, the commands are read interactively with tab completion.
+func (c *Console) Run(input *os.File, output io.Writer) error {
+	if !term.IsTerminal(int(input.Fd())) {
+		c.writer = output
+
+		return c.runScript(input)
+	}
+
+	oldState, err := term.MakeRaw(int(input.Fd()))
+	if err != nil {
+		return ierrors.Wrap(err, "failed to switch terminal to raw mode")
+	}
+	//nolint:errcheck // nothing we can do if restoring the terminal fails
+	defer term.Restore(int(input.Fd()), oldState)
+
+	c.terminal = term.NewTerminal(struct {
+		io.Reader
+		io.Writer
+	}{input, output}, prompt)
+	c.terminal.AutoCompleteCallback = c.complete
+	c.writer = c.terminal
+
+	if width, height, err := term.GetSize(int(input.Fd())); err == nil {
+		_ = c.terminal.SetSize(width, height)
+	}
+
+	c.printf("connected to %s (type 'help' for a list of commands)\n", c.storage.Directory())
+
+	for {
+		line, err := c.terminal.ReadLine()
+		if err != nil {
+			if ierrors.Is(err, io.EOF) {
+				return nil
+			}
+
+			return ierrors.Wrap(err, "failed to read line")
+		}
+
+		if err := c.execute(line); ierrors.Is(err, errExit) {
+			return nil
+		}
+	}
+}
+
+// runScript executes the commands of a non-interactive input line by line.
+func (c *Console) runScript(input io.Reader) error {
+	scanner := bufio.NewScanner(input)
+	for scanner.Scan() {
+		if err := c.execute(scanner.Text()); ierrors.Is(err, errExit) {
+			return nil
+		}
+	}
+
+	return scanner.Err()
+}
+
+// execute runs a single command line and prints the error of the command (if any).
+func (c *Console) execute(line string) (err error) {
+	fields := strings.Fields(line)
+	if len(fields) == 0 {
+		return nil
+	}
+
+	cmd, exists := c.commands[fields[0]]
+	if !exists {
+		c.printf("unknown command '%s' (type 'help' for a list of commands)\n", fields[0])
+
+		return nil
+	}
+
+	// the storage panics on some unrecoverable errors (e.g. a database that can not be opened), which must not
+	// terminate the console.
+	defer func() {
+		if r := recover(); r != nil {
+			c.printf("error: %s panicked: %v\n", cmd.name, r)
+		}
+	}()
+
+	if err = cmd.run(fields[1:]); err != nil && !ierrors.Is(err, errExit) {
+		c.printf("error: %s\n", err)
+	}
+
+	return err
+}
+
+// complete is the AutoCompleteCallback of the terminal that completes command names when tab is pressed.
+func (c *Console) complete(line string, pos int, key rune) (newLine string, newPos int, ok bool) {
+	if key != '\t' {
+		return "", 0, false
+	}
+
+	// only the first word of the line and the argument of 'help' are completed.
+	prefix := line[:pos]
+	completedPrefix := ""
+	if fields := strings.Fields(prefix); len(fields) == 2 && fields[0] == "help" && !strings.HasSuffix(prefix, " ") {
+		completedPrefix, prefix = "help ", fields[1]
+	} else if strings.Contains(prefix, " ") {
+		return "", 0, false
+	}
+
+	candidates := c.commandNames(prefix)
+	switch len(candidates) {
+	case 0:
+		return "", 0, false
+	case 1:
+		newLine = completedPrefix + candidates[0] + " "
+	default:
+		newLine = completedPrefix + commonPrefix(candidates)
+		if len(newLine) == pos {
+			c.printf("%s\n", strings.Join(candidates, "  "))
+		}
+	}
+
+	return newLine + line[pos:], len(newLine), true
+}
+
+// commandNames returns the sorted names of the commands that start with the given prefix.
+func (c *Console) commandNames(prefix string) []string {
+	names := make([]string, 0, len(c.commands))
+	for name := range c.commands {
+		if strings.HasPrefix(name, prefix) {
+			names = append(names, name)
+		}
+	}
+	sort.Strings(names)
+
+	return names
+}
+
+func (c *Console) register(cmd *command) {
+	c.commands[cmd.name] = cmd
+}
+
+func (c *Console) printf(format string, args ...any) {
+	_, _ = fmt.Fprintf(c.writer, format, args...)
+}
+
+// commonPrefix returns the longest common prefix of the given strings.
+func commonPrefix(values []string) string {
+	prefix := values[0]
+	for _, value := range values[1:] {
+		for !strings.HasPrefix(value, prefix) {
+			prefix = prefix[:len(prefix)-1]
+		}
+	}
+
+	return prefix
+}
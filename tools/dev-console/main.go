@@ -0,0 +1,94 @@
+package main
+
+import (
+	"log"
+	"os"
+	"path/filepath"
+
+	flag "github.com/spf13/pflag"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	hivedb "github.com/iotaledger/hive.go/kvstore/database"
+	"github.com/iotaledger/hive.go/runtime/ioutils"
+	"github.com/iotaledger/iota-core/pkg/protocol"
+	"github.com/iotaledger/iota-core/pkg/storage"
+	"github.com/iotaledger/iota-core/pkg/storage/database"
+)
+
+// engineInfoFile is the name of the file in the database directory of a node that contains the name of the active engine.
+const engineInfoFile = "info"
+
+// Config contains the configuration of the dev console.
+type Config struct {
+	// DatabasePath is the path to the database directory of the node.
+	DatabasePath string
+	// EngineName is the name of the engine directory that is opened (the active engine is used if empty).
+	EngineName string
+	// Engine is the database engine of the node.
+	Engine hivedb.Engine
+}
+
+func main() {
+	config, err := parseFlags()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	engineDirectory, err := config.engineDirectory()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	s := storage.Create(engineDirectory, protocol.DatabaseVersion, func(err error) {
+		log.Printf("storage error: %s", err)
+	}, storage.WithDBEngine(config.Engine), storage.WithReadOnly(true))
+	defer s.Shutdown()
+
+	if err := newConsole(s).Run(os.Stdin, os.Stdout); err != nil {
+		log.Fatal(ierrors.Wrap(err, "failed to run dev console"))
+	}
+}
+
+func parseFlags() (*Config, error) {
+	databasePath := flag.String("databasePath", "testnet/database", "the path to the database directory of the node")
+	engineName := flag.String("engineName", "", "the name of the engine whose storage is opened (the active engine of the node is used if empty)")
+	engine := flag.String("engine", string(hivedb.EngineAuto), "the database engine of the node (values: auto, pebble, rocksdb - requires building with '-tags rocksdb')")
+
+	flag.Parse()
+
+	dbEngine, err := hivedb.EngineFromStringAllowed(*engine, database.AllowedEnginesStorageAuto)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Config{
+		DatabasePath: *databasePath,
+		EngineName:   *engineName,
+		Engine:       dbEngine,
+	}, nil
+}
+
+// engineDirectory returns the directory of the engine storage that is opened by the console.
+func (c *Config) engineDirectory() (string, error) {
+	engineName := c.EngineName
+	if engineName == "" {
+		info := &struct {
+			Name string `json:"name"`
+		}{}
+
+		if err := ioutils.ReadJSONFromFile(filepath.Join(c.DatabasePath, engineInfoFile), info); err != nil {
+			return "", ierrors.Wrapf(err, "failed to read engine info file of database %s", c.DatabasePath)
+		}
+
+		engineName = info.Name
+	}
+
+	engineDirectory := filepath.Join(c.DatabasePath, engineName)
+	if exists, isDirectory, err := ioutils.PathExists(engineDirectory); err != nil {
+		return "", ierrors.Wrapf(err, "failed to check engine directory %s", engineDirectory)
+	} else if !exists || !isDirectory {
+		return "", ierrors.Errorf("engine directory %s does not exist", engineDirectory)
+	}
+
+	return engineDirectory, nil
+}
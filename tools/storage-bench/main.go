@@ -0,0 +1,95 @@
+package main
+
+import (
+	"log"
+	"os"
+
+	flag "github.com/spf13/pflag"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	hivedb "github.com/iotaledger/hive.go/kvstore/database"
+	"github.com/iotaledger/iota-core/pkg/storage/database"
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+func main() {
+	config, err := parseFlags()
+	if err != nil {
+		log.Fatal(err)
+	}
+
+	if config.Directory == "" {
+		if config.Directory, err = os.MkdirTemp("", "storage-bench"); err != nil {
+			log.Fatal(ierrors.Wrap(err, "failed to create temporary directory"))
+		}
+	}
+
+	if !config.KeepDirectory {
+		defer os.RemoveAll(config.Directory)
+	}
+
+	log.Printf("running storage benchmark with engine %s in %s...", config.Engine, config.Directory)
+
+	report, err := newWorkload(config).Run()
+	if err != nil {
+		log.Fatal(ierrors.Wrap(err, "failed to run storage benchmark"))
+	}
+
+	report.Print(os.Stdout)
+}
+
+func parseFlags() (*Config, error) {
+	engine := flag.String("engine", string(database.EnginePebble), "the database engine to benchmark (values: pebble, rocksdb - requires building with '-tags rocksdb')")
+	directory := flag.String("directory", "", "the directory of the benchmarked database (a temporary directory is used if empty)")
+	keepDirectory := flag.Bool("keepDirectory", false, "whether to keep the database after the benchmark")
+	epochs := flag.Uint32("epochs", 10, "the number of epochs to write")
+	slotsPerEpochExponent := flag.Uint8("slotsPerEpochExponent", 5, "the exponent of the number of slots per epoch (min: 3)")
+	blocksPerSlot := flag.Int("blocksPerSlot", 100, "the number of blocks written per slot")
+	blockPayloadSize := flag.Int("blockPayloadSize", 1024, "the size of the tagged data payload of the written blocks in bytes")
+	accountDiffsPerSlot := flag.Int("accountDiffsPerSlot", 20, "the number of account diffs written per slot")
+	pruningDelay := flag.Uint32("pruningDelay", 2, "the number of finalized epochs that are kept before being pruned")
+	readsPerEpoch := flag.Int("readsPerEpoch", 1000, "the number of block lookups after every epoch (half of them for blocks that do not exist)")
+	bloomFilterBitsPerKey := flag.Int("bloomFilterBitsPerKey", 10, "the number of bits per key of the bloom filters of the databases (0 = disabled)")
+	tombstoneCompactionThreshold := flag.Int("tombstoneCompactionThreshold", 16, "the number of deleted key prefixes after which the deleted key ranges are compacted (0 = disabled, ignored by rocksdb)")
+
+	flag.Parse()
+
+	dbEngine, err := hivedb.EngineFromStringAllowed(*engine, database.AllowedEnginesStorage)
+	if err != nil {
+		return nil, err
+	}
+
+	if *epochs == 0 {
+		return nil, ierrors.New("'epochs' must be greater than 0")
+	}
+
+	if *slotsPerEpochExponent < 3 {
+		return nil, ierrors.New("'slotsPerEpochExponent' must be at least 3")
+	}
+
+	if *readsPerEpoch < 0 || *bloomFilterBitsPerKey < 0 || *tombstoneCompactionThreshold < 0 {
+		return nil, ierrors.New("'readsPerEpoch', 'bloomFilterBitsPerKey' and 'tombstoneCompactionThreshold' must not be negative")
+	}
+
+	// the tombstone compaction is only supported by pebble.
+	if dbEngine != database.EnginePebble {
+		*tombstoneCompactionThreshold = 0
+	}
+
+	return &Config{
+		Engine:                dbEngine,
+		Directory:             *directory,
+		KeepDirectory:         *keepDirectory,
+		Epochs:                iotago.EpochIndex(*epochs),
+		SlotsPerEpochExponent: *slotsPerEpochExponent,
+		BlocksPerSlot:         *blocksPerSlot,
+		BlockPayloadSize:      *blockPayloadSize,
+		AccountDiffsPerSlot:   *accountDiffsPerSlot,
+		PruningDelay:          iotago.EpochIndex(*pruningDelay),
+		ReadsPerEpoch:         *readsPerEpoch,
+		DatabaseSettings: database.Settings{
+			BloomFilterBitsPerKey:        *bloomFilterBitsPerKey,
+			TombstoneCompactionThreshold: *tombstoneCompactionThreshold,
+		},
+	}, nil
+}
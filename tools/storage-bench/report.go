@@ -0,0 +1,142 @@
+package main
+
+import (
+	"fmt"
+	"io"
+	"runtime"
+	"sort"
+	"text/tabwriter"
+	"time"
+
+	iotago "github.com/iotaledger/iota.go/v4"
+)
+
+// Report contains the results of a storage benchmark.
+type Report struct {
+	// Config is the config of the benchmarked workload.
+	Config *Config
+	// Duration is the total duration of the benchmark.
+	Duration time.Duration
+	// BlockWrites contains the statistics of the block writes.
+	BlockWrites *OperationStats
+	// AccountDiffWrites contains the statistics of the account diff writes.
+	AccountDiffWrites *OperationStats
+	// BlockReads contains the statistics of the lookups of existing blocks.
+	BlockReads *OperationStats
+	// MissingBlockReads contains the statistics of the lookups of blocks that do not exist.
+	MissingBlockReads *OperationStats
+	// PruningCycles contains the statistics of the pruning cycles that pruned at least one epoch.
+	PruningCycles *OperationStats
+	// PrunableDatabaseSize is the size of the prunable database at the end of the benchmark.
+	PrunableDatabaseSize int64
+	// PermanentDatabaseSize is the size of the permanent database at the end of the benchmark.
+	PermanentDatabaseSize int64
+	// LastPrunedEpoch is the last epoch that was pruned (only valid if HasPruned is true).
+	LastPrunedEpoch iotago.EpochIndex
+	// HasPruned indicates whether any epoch was pruned.
+	HasPruned bool
+	// SpaceAmplification is the ratio between the size of the prunable database and the size of the retained data at
+	// the end of the benchmark.
+	SpaceAmplification float64
+	// MaxSpaceAmplification is the highest space amplification that was sampled after an epoch.
+	MaxSpaceAmplification float64
+}
+
+// newReport creates a new empty report for the given config.
+func newReport(config *Config) *Report {
+	return &Report{
+		Config:            config,
+		BlockWrites:       new(OperationStats),
+		AccountDiffWrites: new(OperationStats),
+		BlockReads:        new(OperationStats),
+		MissingBlockReads: new(OperationStats),
+		PruningCycles:     new(OperationStats),
+	}
+}
+
+// Print writes the report in a human-readable form to the given writer.
+func (r *Report) Print(writer io.Writer) {
+	_, _ = fmt.Fprintf(writer, "\nplatform: %s/%s (%d CPUs), engine: %s, duration: %s\n", runtime.GOOS, runtime.GOARCH, runtime.NumCPU(), r.Config.Engine, r.Duration.Truncate(time.Millisecond))
+	_, _ = fmt.Fprintf(writer, "workload: %d epochs of %d slots, %d blocks (%d bytes payload) and %d account diffs per slot, pruning delay %d\n",
+		r.Config.Epochs, 1<<r.Config.SlotsPerEpochExponent, r.Config.BlocksPerSlot, r.Config.BlockPayloadSize, r.Config.AccountDiffsPerSlot, r.Config.PruningDelay)
+	_, _ = fmt.Fprintf(writer, "database: %d bloom filter bits per key, tombstone compaction threshold %d, %d reads per epoch\n\n",
+		r.Config.DatabaseSettings.BloomFilterBitsPerKey, r.Config.DatabaseSettings.TombstoneCompactionThreshold, r.Config.ReadsPerEpoch)
+
+	tabWriter := tabwriter.NewWriter(writer, 0, 0, 2, ' ', tabwriter.AlignRight)
+	_, _ = fmt.Fprintln(tabWriter, "operation\tcount\tops/s\tMB/s\tp50\tp99\tmax\t")
+	r.printOperation(tabWriter, "block writes", r.BlockWrites)
+	r.printOperation(tabWriter, "account diff writes", r.AccountDiffWrites)
+	r.printOperation(tabWriter, "block reads", r.BlockReads)
+	r.printOperation(tabWriter, "missing block reads", r.MissingBlockReads)
+	r.printOperation(tabWriter, "pruning cycles", r.PruningCycles)
+	_ = tabWriter.Flush()
+
+	_, _ = fmt.Fprintf(writer, "\nprunable database: %.2f MB, permanent database: %.2f MB", toMB(r.PrunableDatabaseSize), toMB(r.PermanentDatabaseSize))
+	if r.HasPruned {
+		_, _ = fmt.Fprintf(writer, ", last pruned epoch: %d", r.LastPrunedEpoch)
+	}
+	_, _ = fmt.Fprintf(writer, "\nspace amplification: %.2f (max %.2f)\n", r.SpaceAmplification, r.MaxSpaceAmplification)
+}
+
+// printOperation writes the statistics of the given operation as a row of the table.
+func (r *Report) printOperation(writer io.Writer, name string, stats *OperationStats) {
+	_, _ = fmt.Fprintf(writer, "%s\t%d\t%.0f\t%.2f\t%s\t%s\t%s\t\n", name, stats.Count(), stats.Throughput(), toMB(int64(stats.BytesPerSecond())), stats.Percentile(0.5), stats.Percentile(0.99), stats.Percentile(1))
+}
+
+// OperationStats collects the latencies and sizes of a type of operation.
+type OperationStats struct {
+	latencies  []time.Duration
+	totalTime  time.Duration
+	totalBytes int64
+	isSorted   bool
+}
+
+// Record records an operation of the given size that took the given duration.
+func (o *OperationStats) Record(duration time.Duration, size int64) {
+	o.latencies = append(o.latencies, duration)
+	o.totalTime += duration
+	o.totalBytes += size
+	o.isSorted = false
+}
+
+// Count returns the number of recorded operations.
+func (o *OperationStats) Count() int {
+	return len(o.latencies)
+}
+
+// Throughput returns the number of operations per second spent in the operations.
+func (o *OperationStats) Throughput() float64 {
+	if o.totalTime == 0 {
+		return 0
+	}
+
+	return float64(len(o.latencies)) / o.totalTime.Seconds()
+}
+
+// BytesPerSecond returns the number of bytes written or read per second spent in the operations.
+func (o *OperationStats) BytesPerSecond() float64 {
+	if o.totalTime == 0 {
+		return 0
+	}
+
+	return float64(o.totalBytes) / o.totalTime.Seconds()
+}
+
+// Percentile returns the latency below which the given share of the operations completed.
+func (o *OperationStats) Percentile(share float64) time.Duration {
+	if len(o.latencies) == 0 {
+		return 0
+	}
+
+	if !o.isSorted {
+		sort.Slice(o.latencies, func(i, j int) bool { return o.latencies[i] < o.latencies[j] })
+		o.isSorted = true
+	}
+
+	return o.latencies[int(share*float64(len(o.latencies)-1))]
+}
+
+// toMB converts the given number of bytes to megabytes.
+func toMB(bytes int64) float64 {
+	return float64(bytes) / (1024 * 1024)
+}
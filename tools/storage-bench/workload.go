@@ -0,0 +1,284 @@
+package main
+
+import (
+	"log"
+	"math/rand"
+	"time"
+
+	"github.com/iotaledger/hive.go/ierrors"
+	hivedb "github.com/iotaledger/hive.go/kvstore/database"
+	"github.com/iotaledger/iota-core/pkg/model"
+	"github.com/iotaledger/iota-core/pkg/storage"
+	"github.com/iotaledger/iota-core/pkg/storage/database"
+	iotago "github.com/iotaledger/iota.go/v4"
+	"github.com/iotaledger/iota.go/v4/tpkg"
+)
+
+// Config contains the parameters of the generated workload.
+type Config struct {
+	// Engine is the database engine that is benchmarked.
+	Engine hivedb.Engine
+	// Directory is the directory of the benchmarked database.
+	Directory string
+	// KeepDirectory indicates whether the database is kept after the benchmark.
+	KeepDirectory bool
+	// Epochs is the number of epochs that are written.
+	Epochs iotago.EpochIndex
+	// SlotsPerEpochExponent is the exponent of the number of slots per epoch.
+	SlotsPerEpochExponent uint8
+	// BlocksPerSlot is the number of blocks that are written per slot.
+	BlocksPerSlot int
+	// BlockPayloadSize is the size of the tagged data payload of the written blocks.
+	BlockPayloadSize int
+	// AccountDiffsPerSlot is the number of account diffs that are written per slot.
+	AccountDiffsPerSlot int
+	// PruningDelay is the number of finalized epochs that are kept before being pruned.
+	PruningDelay iotago.EpochIndex
+	// ReadsPerEpoch is the number of block lookups after every epoch (half of them for blocks that do not exist).
+	ReadsPerEpoch int
+	// DatabaseSettings contains the engine settings of the benchmarked databases.
+	DatabaseSettings database.Settings
+}
+
+// Workload writes blocks and account diffs slot by slot and runs a pruning cycle at the end of every epoch.
+type Workload struct {
+	config  *Config
+	storage *storage.Storage
+	report  *Report
+
+	// writtenBytesPerEpoch contains the logical size of the data written per epoch to calculate the space amplification.
+	writtenBytesPerEpoch map[iotago.EpochIndex]int64
+
+	// writtenBlocksPerEpoch contains the IDs of the blocks of the retained epochs that are looked up by the reads.
+	writtenBlocksPerEpoch map[iotago.EpochIndex][]iotago.BlockID
+}
+
+// newWorkload creates a new workload with the given config.
+func newWorkload(config *Config) *Workload {
+	return &Workload{
+		config:                config,
+		report:                newReport(config),
+		writtenBytesPerEpoch:  make(map[iotago.EpochIndex]int64),
+		writtenBlocksPerEpoch: make(map[iotago.EpochIndex][]iotago.BlockID),
+	}
+}
+
+// Run runs the workload and returns the report of the benchmark.
+func (w *Workload) Run() (*Report, error) {
+	w.storage = storage.Create(w.config.Directory, 0, func(err error) {
+		log.Printf("storage error: %s", err)
+	},
+		storage.WithDBEngine(w.config.Engine),
+		storage.WithPruningDelay(w.config.PruningDelay),
+		storage.WithPermanentDBSettings(w.config.DatabaseSettings),
+		storage.WithPrunableDBSettings(w.config.DatabaseSettings),
+	)
+	defer w.storage.Shutdown()
+
+	// the liveness options only need to be consistent with the (potentially small) epochs, they do not affect the storage
+	if err := w.storage.Settings().StoreProtocolParametersForStartEpoch(iotago.NewV3SnapshotProtocolParameters(
+		iotago.WithTimeProviderOptions(0, time.Now().Unix(), 10, w.config.SlotsPerEpochExponent),
+		iotago.WithLivenessOptions(5, 5, 1, 2, 3),
+	), 0); err != nil {
+		return nil, ierrors.Wrap(err, "failed to store protocol parameters")
+	}
+
+	apiForEpoch := w.storage.Settings().APIProvider().APIForEpoch
+
+	start := time.Now()
+	for epoch := iotago.EpochIndex(0); epoch < w.config.Epochs; epoch++ {
+		api := apiForEpoch(epoch)
+
+		for slot := api.TimeProvider().EpochStart(epoch); slot <= api.TimeProvider().EpochEnd(epoch); slot++ {
+			if err := w.writeBlocks(api, slot); err != nil {
+				return nil, ierrors.Wrapf(err, "failed to write blocks of slot %d", slot)
+			}
+
+			if err := w.writeAccountDiffs(api, slot); err != nil {
+				return nil, ierrors.Wrapf(err, "failed to write account diffs of slot %d", slot)
+			}
+		}
+
+		w.storage.Flush()
+
+		if err := w.prune(api, epoch); err != nil {
+			return nil, ierrors.Wrapf(err, "failed to prune after epoch %d", epoch)
+		}
+
+		if err := w.readBlocks(); err != nil {
+			return nil, ierrors.Wrapf(err, "failed to read blocks after epoch %d", epoch)
+		}
+
+		w.sampleSpaceAmplification()
+
+		log.Printf("finished epoch %d/%d", epoch+1, w.config.Epochs)
+	}
+	w.report.Duration = time.Since(start)
+
+	w.report.PrunableDatabaseSize = w.storage.PrunableDatabaseSize()
+	w.report.PermanentDatabaseSize = w.storage.PermanentDatabaseSize()
+	w.report.LastPrunedEpoch, w.report.HasPruned = w.storage.LastPrunedEpoch()
+
+	return w.report, nil
+}
+
+// writeBlocks writes the configured number of blocks to the given slot.
+func (w *Workload) writeBlocks(api iotago.API, slot iotago.SlotIndex) error {
+	blocks, err := w.storage.Blocks(slot)
+	if err != nil {
+		return err
+	}
+
+	for i := 0; i < w.config.BlocksPerSlot; i++ {
+		block := tpkg.RandBlock(&iotago.BasicBlockBody{
+			API:           api,
+			StrongParents: tpkg.SortedRandBlockIDs(iotago.BasicBlockMaxParents),
+			Payload:       &iotago.TaggedData{Data: tpkg.RandBytes(w.config.BlockPayloadSize)},
+		}, api, 0)
+		block.Header.IssuingTime = api.TimeProvider().SlotStartTime(slot)
+
+		modelBlock, err := model.BlockFromBlock(block)
+		if err != nil {
+			return ierrors.Wrap(err, "failed to create block")
+		}
+
+		start := time.Now()
+		if err = blocks.Store(modelBlock); err != nil {
+			return ierrors.Wrapf(err, "failed to store block %s", modelBlock.ID())
+		}
+
+		epoch := api.TimeProvider().EpochFromSlot(slot)
+		w.recordWrite(w.report.BlockWrites, epoch, time.Since(start), int64(iotago.BlockIDLength+len(modelBlock.Data())))
+		w.writtenBlocksPerEpoch[epoch] = append(w.writtenBlocksPerEpoch[epoch], modelBlock.ID())
+	}
+
+	return nil
+}
+
+// readBlocks looks up random blocks of the retained epochs and random block IDs of the same slots that do not exist,
+// which need to check every level of the database unless they are skipped by the bloom filters.
+func (w *Workload) readBlocks() error {
+	retainedBlocks := make([]iotago.BlockID, 0)
+	for _, blockIDs := range w.writtenBlocksPerEpoch {
+		retainedBlocks = append(retainedBlocks, blockIDs...)
+	}
+
+	if len(retainedBlocks) == 0 {
+		return nil
+	}
+
+	for i := 0; i < w.config.ReadsPerEpoch; i++ {
+		blockID := retainedBlocks[rand.Intn(len(retainedBlocks))]
+
+		stats, isMissing := w.report.BlockReads, i%2 == 1
+		if isMissing {
+			stats, blockID = w.report.MissingBlockReads, iotago.NewBlockID(blockID.Slot(), tpkg.Rand32ByteArray())
+		}
+
+		blocks, err := w.storage.Blocks(blockID.Slot())
+		if err != nil {
+			return err
+		}
+
+		start := time.Now()
+		block, err := blocks.Load(blockID)
+		if err != nil {
+			return ierrors.Wrapf(err, "failed to load block %s", blockID)
+		}
+		duration := time.Since(start)
+
+		if (block == nil) != isMissing {
+			return ierrors.Errorf("unexpected lookup result of block %s (missing: %t)", blockID, block == nil)
+		}
+
+		var size int64
+		if block != nil {
+			size = int64(iotago.BlockIDLength + len(block.Data()))
+		}
+
+		stats.Record(duration, size)
+	}
+
+	return nil
+}
+
+// writeAccountDiffs writes the configured number of account diffs to the given slot.
+func (w *Workload) writeAccountDiffs(api iotago.API, slot iotago.SlotIndex) error {
+	accountDiffs, err := w.storage.AccountDiffs(slot)
+	if err != nil {
+		return err
+	}
+
+	for i := 0; i < w.config.AccountDiffsPerSlot; i++ {
+		accountID := tpkg.RandAccountID()
+
+		accountDiff := model.NewAccountDiff()
+		accountDiff.BICChange = iotago.BlockIssuanceCredits(tpkg.RandInt64(1_000_000))
+		accountDiff.PreviousUpdatedSlot = slot
+		accountDiff.NewOutputID = tpkg.RandOutputID(0)
+		accountDiff.PreviousOutputID = tpkg.RandOutputID(0)
+
+		accountDiffBytes, err := accountDiff.Bytes()
+		if err != nil {
+			return ierrors.Wrap(err, "failed to serialize account diff")
+		}
+
+		start := time.Now()
+		if err = accountDiffs.Store(accountID, accountDiff, false); err != nil {
+			return ierrors.Wrapf(err, "failed to store account diff of %s", accountID)
+		}
+
+		w.recordWrite(w.report.AccountDiffWrites, api.TimeProvider().EpochFromSlot(slot), time.Since(start), int64(iotago.AccountIDLength+len(accountDiffBytes)))
+	}
+
+	return nil
+}
+
+// prune marks the given epoch as finalized and runs a pruning cycle.
+func (w *Workload) prune(api iotago.API, epoch iotago.EpochIndex) error {
+	if err := w.storage.Settings().SetLatestFinalizedSlot(api.TimeProvider().EpochStart(epoch + 1)); err != nil {
+		return ierrors.Wrap(err, "failed to set latest finalized slot")
+	}
+
+	lastPrunedEpochBefore, hasPrunedBefore := w.storage.LastPrunedEpoch()
+
+	start := time.Now()
+	if err := w.storage.TryPrune(); err != nil {
+		return err
+	}
+
+	if lastPrunedEpoch, hasPruned := w.storage.LastPrunedEpoch(); hasPruned && (!hasPrunedBefore || lastPrunedEpoch > lastPrunedEpochBefore) {
+		w.report.PruningCycles.Record(time.Since(start), 0)
+
+		for prunedEpoch := range w.writtenBytesPerEpoch {
+			if prunedEpoch <= lastPrunedEpoch {
+				delete(w.writtenBytesPerEpoch, prunedEpoch)
+				delete(w.writtenBlocksPerEpoch, prunedEpoch)
+			}
+		}
+	}
+
+	return nil
+}
+
+// recordWrite records a write of the given size to the given epoch that took the given duration.
+func (w *Workload) recordWrite(stats *OperationStats, epoch iotago.EpochIndex, duration time.Duration, size int64) {
+	stats.Record(duration, size)
+
+	w.writtenBytesPerEpoch[epoch] += size
+}
+
+// sampleSpaceAmplification compares the size of the prunable database to the logical size of the data it retains.
+func (w *Workload) sampleSpaceAmplification() {
+	var retainedBytes int64
+	for _, writtenBytes := range w.writtenBytesPerEpoch {
+		retainedBytes += writtenBytes
+	}
+
+	if retainedBytes == 0 {
+		return
+	}
+
+	w.report.SpaceAmplification = float64(w.storage.PrunableDatabaseSize()) / float64(retainedBytes)
+	w.report.MaxSpaceAmplification = max(w.report.MaxSpaceAmplification, w.report.SpaceAmplification)
+}
@@ -0,0 +1,70 @@
+package main
+
+import (
+	"bytes"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/iotaledger/iota-core/pkg/storage/database"
+)
+
+func TestWorkload_Run(t *testing.T) {
+	config := &Config{
+		Engine:                database.EnginePebble,
+		Directory:             t.TempDir(),
+		Epochs:                4,
+		SlotsPerEpochExponent: 3,
+		BlocksPerSlot:         5,
+		BlockPayloadSize:      64,
+		AccountDiffsPerSlot:   2,
+		PruningDelay:          1,
+		ReadsPerEpoch:         10,
+		DatabaseSettings: database.Settings{
+			BloomFilterBitsPerKey:        10,
+			TombstoneCompactionThreshold: 16,
+		},
+	}
+
+	report, err := newWorkload(config).Run()
+	require.NoError(t, err)
+
+	slots := int(config.Epochs) * (1 << config.SlotsPerEpochExponent)
+	require.Equal(t, slots*config.BlocksPerSlot, report.BlockWrites.Count())
+	require.Equal(t, slots*config.AccountDiffsPerSlot, report.AccountDiffWrites.Count())
+	require.Equal(t, int(config.Epochs)*config.ReadsPerEpoch, report.BlockReads.Count()+report.MissingBlockReads.Count())
+
+	// the epochs that are older than the pruning delay were pruned.
+	require.True(t, report.HasPruned)
+	require.NotZero(t, report.PruningCycles.Count())
+	require.Less(t, report.LastPrunedEpoch, config.Epochs-config.PruningDelay)
+
+	require.Positive(t, report.PrunableDatabaseSize)
+	require.Positive(t, report.MaxSpaceAmplification)
+	require.GreaterOrEqual(t, report.MaxSpaceAmplification, report.SpaceAmplification)
+
+	var output bytes.Buffer
+	report.Print(&output)
+	require.Contains(t, output.String(), "engine: pebble")
+}
+
+func TestOperationStats(t *testing.T) {
+	stats := new(OperationStats)
+	require.Zero(t, stats.Throughput())
+	require.Zero(t, stats.BytesPerSecond())
+	require.Zero(t, stats.Percentile(0.5))
+
+	for i := 10; i >= 1; i-- {
+		stats.Record(time.Duration(i)*time.Millisecond, 100)
+	}
+
+	require.Equal(t, 10, stats.Count())
+	require.InDelta(t, 10/0.055, stats.Throughput(), 0.001)
+	require.InDelta(t, 1000/0.055, stats.BytesPerSecond(), 0.001)
+
+	require.Equal(t, time.Millisecond, stats.Percentile(0))
+	require.Equal(t, 5*time.Millisecond, stats.Percentile(0.5))
+	require.Equal(t, 9*time.Millisecond, stats.Percentile(0.99))
+	require.Equal(t, 10*time.Millisecond, stats.Percentile(1))
+}